@@ -0,0 +1,562 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builder provides fluent constructors for QiskitJob resources and a
+// library of canned example circuits for tests, the CLI and user automation.
+package builder
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// JobBuilder incrementally assembles a QiskitJob
+type JobBuilder struct {
+	job quantumv1.QiskitJob
+}
+
+// NewJob starts a QiskitJob targeting the local simulator with default execution settings
+func NewJob(name, namespace string) *JobBuilder {
+	return &JobBuilder{
+		job: quantumv1.QiskitJob{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: quantumv1.GroupVersion.String(),
+				Kind:       "QiskitJob",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: quantumv1.QiskitJobSpec{
+				Backend: quantumv1.BackendSpec{
+					Type: "local_simulator",
+				},
+				Execution: quantumv1.ExecutionSpec{
+					Shots:             1024,
+					OptimizationLevel: 1,
+					Priority:          "normal",
+				},
+			},
+		},
+	}
+}
+
+// WithLabels merges the given labels into the job metadata
+func (b *JobBuilder) WithLabels(labels map[string]string) *JobBuilder {
+	if b.job.Labels == nil {
+		b.job.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		b.job.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations merges the given annotations into the job metadata
+func (b *JobBuilder) WithAnnotations(annotations map[string]string) *JobBuilder {
+	if b.job.Annotations == nil {
+		b.job.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		b.job.Annotations[k] = v
+	}
+	return b
+}
+
+// WithBackend sets the backend type and, optionally, a specific backend name
+func (b *JobBuilder) WithBackend(backendType, name string) *JobBuilder {
+	b.job.Spec.Backend.Type = backendType
+	b.job.Spec.Backend.Name = name
+	return b
+}
+
+// WithBackendRef runs the job on the named QuantumBackend, which supplies
+// the backend and its credentials in place of the job's own
+func (b *JobBuilder) WithBackendRef(name string) *JobBuilder {
+	b.job.Spec.Backend = quantumv1.BackendSpec{}
+	b.job.Spec.BackendRef = &quantumv1.QuantumBackendRef{Name: name}
+	return b
+}
+
+// WithBackendSelector runs the job on an available QuantumBackend carrying
+// the labels, picked when the job is first reconciled
+func (b *JobBuilder) WithBackendSelector(labels map[string]string) *JobBuilder {
+	b.job.Spec.Backend = quantumv1.BackendSpec{}
+	b.job.Spec.BackendRef = &quantumv1.QuantumBackendRef{Selector: &metav1.LabelSelector{MatchLabels: labels}}
+	return b
+}
+
+// WithHTTPBackend targets an in-house QPU driven through a generic_http API
+func (b *JobBuilder) WithHTTPBackend(name string, spec quantumv1.HTTPBackendSpec) *JobBuilder {
+	b.job.Spec.Backend = quantumv1.BackendSpec{
+		Type: "generic_http",
+		Name: name,
+		HTTP: &spec,
+	}
+	return b
+}
+
+// WithInlineCircuit sets inline Qiskit Python code as the circuit source
+func (b *JobBuilder) WithInlineCircuit(code string) *JobBuilder {
+	b.job.Spec.Circuit = quantumv1.CircuitSpec{
+		Source: "inline",
+		Code:   code,
+	}
+	return b
+}
+
+// WithBundle runs the job from an archive of a Python project
+func (b *JobBuilder) WithBundle(bundle quantumv1.BundleSpec) *JobBuilder {
+	b.job.Spec.Circuit = quantumv1.CircuitSpec{Source: "bundle", Bundle: &bundle}
+	return b
+}
+
+// WithEntrypoint sets what runs a git or bundle source and its arguments
+func (b *JobBuilder) WithEntrypoint(entrypoint string, args ...string) *JobBuilder {
+	b.job.Spec.Circuit.Entrypoint = entrypoint
+	b.job.Spec.Circuit.Args = append(b.job.Spec.Circuit.Args, args...)
+	return b
+}
+
+// WithConfigMapCircuit reads the circuit from a key of a ConfigMap
+func (b *JobBuilder) WithConfigMapCircuit(name, key string) *JobBuilder {
+	b.job.Spec.Circuit = quantumv1.CircuitSpec{
+		Source:       "configmap",
+		ConfigMapRef: &quantumv1.ConfigMapRef{Name: name, Key: key},
+	}
+	return b
+}
+
+// WithURLCircuit fetches the circuit from a URL, refusing code that does not
+// match sha256
+func (b *JobBuilder) WithURLCircuit(url, sha256 string) *JobBuilder {
+	b.job.Spec.Circuit = quantumv1.CircuitSpec{
+		Source: "url",
+		URL:    url,
+		SHA256: sha256,
+	}
+	return b
+}
+
+// WithGitCircuit reads the circuit from a file in a Git repository
+func (b *JobBuilder) WithGitCircuit(repository, branch, path string) *JobBuilder {
+	b.job.Spec.Circuit = quantumv1.CircuitSpec{
+		Source: "git",
+		GitRef: &quantumv1.GitRef{Repository: repository, Branch: branch, Path: path},
+	}
+	return b
+}
+
+// WithInput adds a data input put in the executor's workspace under
+// /workspace/inputs; calling it again adds another
+func (b *JobBuilder) WithInput(input quantumv1.InputSpec) *JobBuilder {
+	b.job.Spec.Inputs = append(b.job.Spec.Inputs, input)
+	return b
+}
+
+// WithShots sets the number of measurements
+func (b *JobBuilder) WithShots(shots int) *JobBuilder {
+	b.job.Spec.Execution.Shots = shots
+	return b
+}
+
+// WithOptimizationLevel sets the Qiskit transpiler optimization level
+func (b *JobBuilder) WithOptimizationLevel(level int) *JobBuilder {
+	b.job.Spec.Execution.OptimizationLevel = level
+	return b
+}
+
+// WithTranspiler sets the options the executor transpiles the circuit with
+func (b *JobBuilder) WithTranspiler(transpiler quantumv1.TranspilerSpec) *JobBuilder {
+	b.job.Spec.Execution.Transpiler = &transpiler
+	return b
+}
+
+// WithErrorMitigation sets the error suppression and mitigation the backend
+// applies to the job
+func (b *JobBuilder) WithErrorMitigation(mitigation quantumv1.ErrorMitigationSpec) *JobBuilder {
+	b.job.Spec.Execution.ErrorMitigation = &mitigation
+	return b
+}
+
+// WithPriority sets the job priority (low, normal, high, urgent)
+func (b *JobBuilder) WithPriority(priority string) *JobBuilder {
+	b.job.Spec.Execution.Priority = priority
+	return b
+}
+
+// WithQiskitVersion selects the Qiskit version the job executes with
+func (b *JobBuilder) WithQiskitVersion(version string) *JobBuilder {
+	b.job.Spec.Execution.QiskitVersion = version
+	return b
+}
+
+// WithTags adds provider job tags (IBM Runtime job tags)
+func (b *JobBuilder) WithTags(tags ...string) *JobBuilder {
+	b.job.Spec.Execution.Tags = append(b.job.Spec.Execution.Tags, tags...)
+	return b
+}
+
+// WithExtraPackages installs additional pip requirements into the executor
+func (b *JobBuilder) WithExtraPackages(requirements ...string) *JobBuilder {
+	b.job.Spec.Execution.ExtraPackages = append(b.job.Spec.Execution.ExtraPackages, requirements...)
+	return b
+}
+
+// WithExecutorImage runs the executor from a pre-built image
+func (b *JobBuilder) WithExecutorImage(image string) *JobBuilder {
+	b.job.Spec.Execution.Image = image
+	return b
+}
+
+// WithScratch gives the executor size of scratch space, on an emptyDir or,
+// if storageClassName is not empty, a generic ephemeral volume of that class
+func (b *JobBuilder) WithScratch(size, storageClassName string) *JobBuilder {
+	b.job.Spec.Execution.Scratch = &quantumv1.ScratchSpec{Size: resource.MustParse(size)}
+	if storageClassName != "" {
+		b.job.Spec.Execution.Scratch.StorageClassName = &storageClassName
+	}
+	return b
+}
+
+// WithAccelerator sets the device the local simulator runs on ("cpu", "gpu")
+func (b *JobBuilder) WithAccelerator(accelerator string) *JobBuilder {
+	b.job.Spec.Execution.Accelerator = accelerator
+	return b
+}
+
+// WithMaxExecutionTime sets how long the job may run (e.g., "2h")
+func (b *JobBuilder) WithMaxExecutionTime(maxTime string) *JobBuilder {
+	b.job.Spec.Execution.MaxExecutionTime = maxTime
+	return b
+}
+
+// WithResources sets the resources of the execution pod, e.g.
+// {"nvidia.com/gpu": "1"}; either map may be nil
+func (b *JobBuilder) WithResources(requests, limits map[string]string) *JobBuilder {
+	b.job.Spec.Resources = &quantumv1.ResourceRequirements{Requests: requests, Limits: limits}
+	return b
+}
+
+// WithEnv sets an environment variable of the executor
+func (b *JobBuilder) WithEnv(name, value string) *JobBuilder {
+	b.job.Spec.Execution.Env = append(b.job.Spec.Execution.Env, corev1.EnvVar{Name: name, Value: value})
+	return b
+}
+
+// WithEnvFromConfigMap turns the keys of a ConfigMap into environment
+// variables of the executor
+func (b *JobBuilder) WithEnvFromConfigMap(name string) *JobBuilder {
+	b.job.Spec.Execution.EnvFrom = append(b.job.Spec.Execution.EnvFrom, corev1.EnvFromSource{
+		ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}},
+	})
+	return b
+}
+
+// WithDeadline lets the operator hold the job for a cheaper calendar window until deadline
+func (b *JobBuilder) WithDeadline(deadline time.Time) *JobBuilder {
+	b.job.Spec.Execution.Deadline = &metav1.Time{Time: deadline}
+	return b
+}
+
+// WithoutFallback keeps the job on its backend rather than falling back to a simulator
+func (b *JobBuilder) WithoutFallback() *JobBuilder {
+	b.job.Spec.Execution.DisableFallback = true
+	return b
+}
+
+// WithSandbox runs the job's executors in a sandbox namespace of their own
+func (b *JobBuilder) WithSandbox() *JobBuilder {
+	b.job.Spec.Execution.Sandbox = true
+	return b
+}
+
+// WithoutNetworkEgress cuts the job's executors off from the network
+func (b *JobBuilder) WithoutNetworkEgress() *JobBuilder {
+	allow := false
+	b.job.Spec.Security = &quantumv1.SecuritySpec{AllowNetworkEgress: &allow}
+	return b
+}
+
+// WithCachePolicy sets whether the job reuses and caches results of identical executions
+func (b *JobBuilder) WithCachePolicy(policy string) *JobBuilder {
+	b.job.Spec.Execution.CachePolicy = policy
+	return b
+}
+
+// WithShadow also runs the circuit on a second backend and compares the results
+func (b *JobBuilder) WithShadow(backendType, name string) *JobBuilder {
+	b.job.Spec.Shadow = &quantumv1.ShadowSpec{
+		Backend: quantumv1.BackendSpec{Type: backendType, Name: name},
+	}
+	return b
+}
+
+// WithVerify runs the circuit a second time with the same seed, on the given
+// Qiskit version and executor image if set, and fails unless the counts match
+func (b *JobBuilder) WithVerify(seed int64, qiskitVersion, image string) *JobBuilder {
+	b.job.Spec.Verify = &quantumv1.VerifySpec{Seed: seed, QiskitVersion: qiskitVersion, Image: image}
+	return b
+}
+
+// WithTranspiledArtifacts publishes the circuit as transpiled for the backend
+func (b *JobBuilder) WithTranspiledArtifacts() *JobBuilder {
+	b.job.Spec.Artifacts = &quantumv1.ArtifactsSpec{TranspiledCircuit: true}
+	return b
+}
+
+// WithOptimizer minimizes the expectation value of the circuit's observable
+// over its parameters before sampling
+func (b *JobBuilder) WithOptimizer(method string, maxIterations int, tolerance float64) *JobBuilder {
+	b.job.Spec.Optimizer = &quantumv1.OptimizerSpec{
+		Method:        method,
+		MaxIterations: maxIterations,
+		Tolerance:     tolerance,
+	}
+	return b
+}
+
+// WithEstimator estimates the expectation values of the circuit's
+// observables instead of sampling it, at most maxObservablesPerCall at a
+// time
+func (b *JobBuilder) WithEstimator(maxObservablesPerCall int) *JobBuilder {
+	b.job.Spec.Estimator = &quantumv1.EstimatorSpec{MaxObservablesPerCall: maxObservablesPerCall}
+	return b
+}
+
+// WithPrimitive selects the Qiskit primitive that runs the circuit,
+// "sampler" or "estimator"
+func (b *JobBuilder) WithPrimitive(primitive string) *JobBuilder {
+	b.job.Spec.Execution.Primitive = primitive
+	return b
+}
+
+// WithObservable adds an observable the estimator estimates, the sum of its
+// weighted Pauli strings; calling it again adds another
+func (b *JobBuilder) WithObservable(name string, terms ...quantumv1.PauliTerm) *JobBuilder {
+	b.job.Spec.Execution.Observables = append(b.job.Spec.Execution.Observables,
+		quantumv1.Observable{Name: name, Terms: terms})
+	return b
+}
+
+// WithSweep runs the circuit once per binding of its parameters
+func (b *JobBuilder) WithSweep(sweep quantumv1.SweepSpec) *JobBuilder {
+	b.job.Spec.Sweep = &sweep
+	return b
+}
+
+// WithTomography reconstructs the state of the qubits from one run per
+// measurement setting
+func (b *JobBuilder) WithTomography(tomography quantumv1.TomographySpec) *JobBuilder {
+	b.job.Spec.Tomography = &tomography
+	return b
+}
+
+// WithSplit splits the shots across the job's backend and other equivalent
+// backends running at the same time
+func (b *JobBuilder) WithSplit(split quantumv1.SplitSpec) *JobBuilder {
+	b.job.Spec.Split = &split
+	return b
+}
+
+// WithOutput adds an output results are stored in; calling it again adds
+// another
+func (b *JobBuilder) WithOutput(outputType, location string) *JobBuilder {
+	b.job.Spec.Outputs = append(b.job.Spec.Outputs, quantumv1.OutputSpec{
+		Type:     outputType,
+		Location: location,
+		Format:   "json",
+	})
+	return b
+}
+
+// WithOutputName names the last output added, to tell outputs of the same
+// type apart; call after WithOutput
+func (b *JobBuilder) WithOutputName(name string) *JobBuilder {
+	if output := b.lastOutput(); output != nil {
+		output.Name = name
+	}
+	return b
+}
+
+// WithCompression compresses stored results (none, gzip, zstd); call after WithOutput
+func (b *JobBuilder) WithCompression(algorithm string) *JobBuilder {
+	if output := b.lastOutput(); output != nil {
+		output.Compression = algorithm
+	}
+	return b
+}
+
+// WithShardSize splits stored counts into shards of at most size outcomes; call after WithOutput
+func (b *JobBuilder) WithShardSize(size int) *JobBuilder {
+	if output := b.lastOutput(); output != nil {
+		output.ShardSize = size
+	}
+	return b
+}
+
+// WithS3Output uploads results to bucket under path, with the credentials
+// in the named Secret
+func (b *JobBuilder) WithS3Output(bucket, path, secretName string) *JobBuilder {
+	b.WithOutput("s3", bucket)
+	output := b.lastOutput()
+	output.Path = path
+	output.SecretName = secretName
+	return b
+}
+
+// WithPVCOutput has the executor write results to the named
+// PersistentVolumeClaim under path
+func (b *JobBuilder) WithPVCOutput(claim, path string) *JobBuilder {
+	b.WithOutput("pvc", claim)
+	b.lastOutput().Path = path
+	return b
+}
+
+// WithOutputFormat sets the format results are stored in (json, csv,
+// pickle, qpy) and how long they are kept; call after WithOutput
+func (b *JobBuilder) WithOutputFormat(format, retention string) *JobBuilder {
+	if output := b.lastOutput(); output != nil {
+		output.Format = format
+		output.Retention = retention
+	}
+	return b
+}
+
+// lastOutput returns the output added last, or nil if there is none
+func (b *JobBuilder) lastOutput() *quantumv1.OutputSpec {
+	if len(b.job.Spec.Outputs) == 0 {
+		return nil
+	}
+	return &b.job.Spec.Outputs[len(b.job.Spec.Outputs)-1]
+}
+
+// WithCredentials references a Secret holding backend credentials
+func (b *JobBuilder) WithCredentials(secretName string) *JobBuilder {
+	b.job.Spec.Credentials = &quantumv1.CredentialsSpec{
+		SecretRef: &quantumv1.SecretRef{Name: secretName},
+	}
+	return b
+}
+
+// WithVaultCredentials reads the backend credentials from a HashiCorp Vault
+// path instead of a Secret
+func (b *JobBuilder) WithVaultCredentials(path string) *JobBuilder {
+	b.job.Spec.Credentials = &quantumv1.CredentialsSpec{VaultPath: path}
+	return b
+}
+
+// WithSecretsStore mounts the job's credentials with the Secrets Store CSI
+// driver from the given SecretProviderClass
+func (b *JobBuilder) WithSecretsStore(secretProviderClass string) *JobBuilder {
+	if b.job.Spec.Credentials == nil {
+		b.job.Spec.Credentials = &quantumv1.CredentialsSpec{}
+	}
+	b.job.Spec.Credentials.SecretsStore = &quantumv1.SecretsStoreSpec{SecretProviderClass: secretProviderClass}
+	return b
+}
+
+// WithBudget sets the maximum cost and cost center of the job
+func (b *JobBuilder) WithBudget(maxCost, costCenter string) *JobBuilder {
+	b.job.Spec.Budget = &quantumv1.BudgetSpec{
+		MaxCost:    maxCost,
+		CostCenter: costCenter,
+	}
+	return b
+}
+
+// WithSession runs the job inside an IBM Quantum Runtime session
+func (b *JobBuilder) WithSession(name, mode string, maxTime int) *JobBuilder {
+	b.job.Spec.Session = &quantumv1.SessionSpec{
+		Name:    name,
+		Mode:    mode,
+		MaxTime: maxTime,
+	}
+	return b
+}
+
+// WithDeduplication enables duplicate submission detection
+func (b *JobBuilder) WithDeduplication(policy, window string) *JobBuilder {
+	b.job.Spec.Deduplication = &quantumv1.DeduplicationSpec{
+		Policy: policy,
+		Window: window,
+	}
+	return b
+}
+
+// WithAllowedRegions pins the job to the given provider regions
+func (b *JobBuilder) WithAllowedRegions(regions ...string) *JobBuilder {
+	b.job.Spec.Placement = &quantumv1.PlacementSpec{
+		AllowedRegions: regions,
+	}
+	return b
+}
+
+// WithCluster dispatches the job to the given spoke cluster
+func (b *JobBuilder) WithCluster(cluster string) *JobBuilder {
+	if b.job.Spec.Placement == nil {
+		b.job.Spec.Placement = &quantumv1.PlacementSpec{}
+	}
+	b.job.Spec.Placement.Cluster = cluster
+	return b
+}
+
+// WithScheduling places the execution pod on nodes of the cluster
+func (b *JobBuilder) WithScheduling(scheduling quantumv1.SchedulingSpec) *JobBuilder {
+	b.job.Spec.Scheduling = &scheduling
+	return b
+}
+
+// WithRetryPolicy sets how failed attempts of the job are retried
+func (b *JobBuilder) WithRetryPolicy(policy quantumv1.RetryPolicy) *JobBuilder {
+	b.job.Spec.RetryPolicy = &policy
+	return b
+}
+
+// WithNotification tells an endpoint when the job finishes
+func (b *JobBuilder) WithNotification(notification quantumv1.NotificationSpec) *JobBuilder {
+	b.job.Spec.Notifications = append(b.job.Spec.Notifications, notification)
+	return b
+}
+
+// WithTTLAfterFinished deletes the job the given seconds after it finished
+func (b *JobBuilder) WithTTLAfterFinished(seconds int32) *JobBuilder {
+	b.job.Spec.TTLSecondsAfterFinished = &seconds
+	return b
+}
+
+// WithTemplate instantiates the job from a QiskitJobTemplate; the settings the
+// template owns replace the job's own when it is admitted
+func (b *JobBuilder) WithTemplate(name string) *JobBuilder {
+	b.job.Spec.TemplateRef = &quantumv1.TemplateRef{Name: name}
+	return b
+}
+
+// WithOverrides changes template settings for this job, within the template's allowedOverrides
+func (b *JobBuilder) WithOverrides(overrides quantumv1.JobOverrides) *JobBuilder {
+	b.job.Spec.Overrides = &overrides
+	return b
+}
+
+// Build returns a copy of the assembled job; the builder can be reused afterwards
+func (b *JobBuilder) Build() *quantumv1.QiskitJob {
+	return b.job.DeepCopy()
+}
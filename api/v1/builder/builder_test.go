@@ -0,0 +1,150 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+var _ = Describe("JobBuilder", func() {
+	It("should start a job on the local simulator with default execution settings", func() {
+		job := NewJob("plain", "quantum-lab").Build()
+		Expect(job.APIVersion).To(Equal(quantumv1.GroupVersion.String()))
+		Expect(job.Kind).To(Equal("QiskitJob"))
+		Expect(job.Name).To(Equal("plain"))
+		Expect(job.Namespace).To(Equal("quantum-lab"))
+		Expect(job.Spec.Backend).To(Equal(quantumv1.BackendSpec{Type: "local_simulator"}))
+		Expect(job.Spec.Execution).To(Equal(quantumv1.ExecutionSpec{Shots: 1024, OptimizationLevel: 1, Priority: "normal"}))
+	})
+
+	It("should apply every setting given", func() {
+		job := NewJob("tuned", "quantum-lab").
+			WithLabels(map[string]string{"team": "a"}).
+			WithLabels(map[string]string{"run": "1"}).
+			WithBackend("ibm_quantum", "ibm_brisbane").
+			WithInlineCircuit("qc = QuantumCircuit(1)").
+			WithShots(4096).
+			WithOptimizationLevel(3).
+			WithEnv("DEPTH", "4").
+			WithOutput("configmap", "tuned-results").
+			WithOutput("s3", "bucket").
+			WithOutputName("archive").
+			WithCompression("zstd").
+			WithCredentials("ibm-credentials").
+			WithDeduplication("link", "5m").
+			Build()
+		Expect(job.Labels).To(Equal(map[string]string{"team": "a", "run": "1"}))
+		Expect(job.Spec.Backend).To(Equal(quantumv1.BackendSpec{Type: "ibm_quantum", Name: "ibm_brisbane"}))
+		Expect(job.Spec.Circuit).To(Equal(quantumv1.CircuitSpec{Source: "inline", Code: "qc = QuantumCircuit(1)"}))
+		Expect(job.Spec.Execution.Shots).To(Equal(4096))
+		Expect(job.Spec.Execution.OptimizationLevel).To(Equal(3))
+		Expect(job.Spec.Execution.Env).To(Equal([]corev1.EnvVar{{Name: "DEPTH", Value: "4"}}))
+		Expect(job.Spec.Outputs).To(Equal([]quantumv1.OutputSpec{
+			{Type: "configmap", Location: "tuned-results", Format: "json"},
+			{Type: "s3", Location: "bucket", Format: "json", Name: "archive", Compression: "zstd"},
+		}), "output settings apply to the last output")
+		Expect(job.Spec.Credentials.SecretRef.Name).To(Equal("ibm-credentials"))
+		Expect(job.Spec.Deduplication).To(Equal(&quantumv1.DeduplicationSpec{Policy: "link", Window: "5m"}))
+	})
+
+	It("should build independent copies", func() {
+		b := NewJob("copied", "default").WithLabels(map[string]string{"run": "1"})
+		first := b.Build()
+		first.Labels["run"] = "changed"
+		Expect(b.Build().Labels).To(HaveKeyWithValue("run", "1"))
+	})
+
+	It("should ignore output settings before any output", func() {
+		job := NewJob("no-output", "default").WithOutputName("archive").WithCompression("gzip").Build()
+		Expect(job.Spec.Outputs).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Example circuits", func() {
+	It("should prepare and measure a GHZ state on every qubit", func() {
+		code, err := GHZCircuit(4)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(code).To(ContainSubstring("qc = QuantumCircuit(4, 4)\n"))
+		Expect(code).To(ContainSubstring("for i in range(3):\n    qc.cx(i, i + 1)\n"))
+		Expect(code).To(HaveSuffix("qc.measure(range(4), range(4))\n"))
+
+		code, err = GHZCircuit(1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(code).To(ContainSubstring("for i in range(0):\n"), "a single qubit needs no entangling gates")
+	})
+
+	It("should transform and measure every qubit with the QFT", func() {
+		code, err := QFTCircuit(5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(code).To(ContainSubstring("from qiskit.circuit.library import QFT\n"))
+		Expect(code).To(ContainSubstring("qc.compose(QFT(5), inplace=True)\n"))
+		Expect(code).To(HaveSuffix("qc.measure(range(5), range(5))\n"))
+	})
+
+	DescribeTable("should reject circuits without qubits",
+		func(circuit func(int) (string, error), qubits int) {
+			code, err := circuit(qubits)
+			Expect(err).To(MatchError(ContainSubstring("needs at least 1 qubit")))
+			Expect(code).To(BeEmpty())
+		},
+		Entry("GHZ with none", GHZCircuit, 0),
+		Entry("GHZ with a negative count", GHZCircuit, -2),
+		Entry("QFT with none", QFTCircuit, 0),
+		Entry("QFT with a negative count", QFTCircuit, -1),
+	)
+
+	It("should reject example jobs without qubits", func() {
+		_, err := NewGHZJob("ghz", "default", 0)
+		Expect(err).To(HaveOccurred())
+		_, err = NewQFTJob("qft", "default", 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should define the cost Hamiltonian of a QAOA ring next to its ansatz", func() {
+		code := QAOAMaxCutCircuit(4, 2)
+		Expect(code).To(ContainSubstring("edges = [(i, (i + 1) % 4) for i in range(4)]\n"))
+		Expect(code).To(ContainSubstring("num_qubits=4)\n"))
+		Expect(code).To(ContainSubstring("QAOAAnsatz(observable, reps=2)"))
+	})
+
+	It("should build every example by name and label it with its circuit family", func() {
+		Expect(ExampleNames()).To(Equal([]string{"bell", "ghz", "qaoa", "qft"}))
+		for _, example := range ExampleNames() {
+			b, err := NewExampleJob(example, example+"-job", "default")
+			Expect(err).NotTo(HaveOccurred(), example)
+			job := b.Build()
+			Expect(job.Name).To(Equal(example + "-job"))
+			Expect(job.Spec.Circuit.Source).To(Equal("inline"))
+			Expect(job.Spec.Circuit.Code).To(ContainSubstring("qc = "), example)
+			Expect(job.Labels).To(HaveKey(CircuitFamilyLabel))
+		}
+
+		b, err := NewExampleJob("qaoa", "qaoa", "default")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.Build().Spec.Optimizer.Method).To(Equal("COBYLA"))
+		Expect(NewBellStateJob("bell", "default").Build().Spec.Circuit.Code).To(Equal(BellStateCircuit()))
+	})
+
+	It("should name the examples there are when asked for another", func() {
+		_, err := NewExampleJob("grover", "grover", "default")
+		Expect(err).To(MatchError(`unknown example "grover", must be one of: bell, ghz, qaoa, qft`))
+	})
+})
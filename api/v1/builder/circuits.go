@@ -0,0 +1,156 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CircuitFamilyLabel tags a job with the kind of circuit it runs, so its
+// results can be searched by family
+const CircuitFamilyLabel = "quantum.io/circuit-family"
+
+// Canned circuits follow the executor convention of defining a QuantumCircuit named qc.
+
+// BellStateCircuit returns code preparing and measuring a 2-qubit Bell state
+func BellStateCircuit() string {
+	return `from qiskit import QuantumCircuit
+
+qc = QuantumCircuit(2, 2)
+qc.h(0)
+qc.cx(0, 1)
+qc.measure([0, 1], [0, 1])
+`
+}
+
+// GHZCircuit returns code preparing and measuring an n-qubit GHZ state
+func GHZCircuit(qubits int) (string, error) {
+	if qubits < 1 {
+		return "", fmt.Errorf("a GHZ circuit needs at least 1 qubit, got %d", qubits)
+	}
+	var b strings.Builder
+	b.WriteString("from qiskit import QuantumCircuit\n\n")
+	fmt.Fprintf(&b, "qc = QuantumCircuit(%d, %d)\n", qubits, qubits)
+	b.WriteString("qc.h(0)\n")
+	fmt.Fprintf(&b, "for i in range(%d):\n", qubits-1)
+	b.WriteString("    qc.cx(i, i + 1)\n")
+	fmt.Fprintf(&b, "qc.measure(range(%d), range(%d))\n", qubits, qubits)
+	return b.String(), nil
+}
+
+// QFTCircuit returns code applying an n-qubit quantum Fourier transform to |0...0> and measuring
+func QFTCircuit(qubits int) (string, error) {
+	if qubits < 1 {
+		return "", fmt.Errorf("a QFT circuit needs at least 1 qubit, got %d", qubits)
+	}
+	var b strings.Builder
+	b.WriteString("from qiskit import QuantumCircuit\n")
+	b.WriteString("from qiskit.circuit.library import QFT\n\n")
+	fmt.Fprintf(&b, "qc = QuantumCircuit(%d, %d)\n", qubits, qubits)
+	fmt.Fprintf(&b, "qc.compose(QFT(%d), inplace=True)\n", qubits)
+	fmt.Fprintf(&b, "qc.measure(range(%d), range(%d))\n", qubits, qubits)
+	return b.String(), nil
+}
+
+// QAOAMaxCutCircuit returns code for a QAOA ansatz with the given number of
+// layers for MaxCut on an n-qubit ring. It also defines the cost Hamiltonian
+// as observable, for the optimizer loop to minimize.
+func QAOAMaxCutCircuit(qubits, layers int) string {
+	var b strings.Builder
+	b.WriteString("from qiskit.circuit.library import QAOAAnsatz\n")
+	b.WriteString("from qiskit.quantum_info import SparsePauliOp\n\n")
+	fmt.Fprintf(&b, "edges = [(i, (i + 1) %% %d) for i in range(%d)]\n", qubits, qubits)
+	fmt.Fprintf(&b, "observable = SparsePauliOp.from_sparse_list([('ZZ', list(e), 1.0) for e in edges], num_qubits=%d)\n", qubits)
+	fmt.Fprintf(&b, "qc = QAOAAnsatz(observable, reps=%d).decompose()\n", layers)
+	b.WriteString("qc.measure_all()\n")
+	return b.String()
+}
+
+// NewBellStateJob returns a builder preconfigured with the Bell state circuit
+func NewBellStateJob(name, namespace string) *JobBuilder {
+	return NewJob(name, namespace).
+		WithLabels(map[string]string{"example": "bell-state", CircuitFamilyLabel: "bell"}).
+		WithInlineCircuit(BellStateCircuit())
+}
+
+// NewGHZJob returns a builder preconfigured with an n-qubit GHZ circuit
+func NewGHZJob(name, namespace string, qubits int) (*JobBuilder, error) {
+	code, err := GHZCircuit(qubits)
+	if err != nil {
+		return nil, err
+	}
+	return NewJob(name, namespace).
+		WithLabels(map[string]string{"example": "ghz", CircuitFamilyLabel: "ghz"}).
+		WithInlineCircuit(code), nil
+}
+
+// NewQFTJob returns a builder preconfigured with an n-qubit QFT circuit
+func NewQFTJob(name, namespace string, qubits int) (*JobBuilder, error) {
+	code, err := QFTCircuit(qubits)
+	if err != nil {
+		return nil, err
+	}
+	return NewJob(name, namespace).
+		WithLabels(map[string]string{"example": "qft", CircuitFamilyLabel: "qft"}).
+		WithInlineCircuit(code), nil
+}
+
+// NewQAOAJob returns a builder preconfigured with a QAOA MaxCut circuit on
+// an n-qubit ring, optimized with COBYLA before it is sampled
+func NewQAOAJob(name, namespace string, qubits, layers int) *JobBuilder {
+	return NewJob(name, namespace).
+		WithLabels(map[string]string{"example": "qaoa", CircuitFamilyLabel: "qaoa"}).
+		WithInlineCircuit(QAOAMaxCutCircuit(qubits, layers)).
+		WithOptimizer("COBYLA", 100, 0)
+}
+
+// examples maps example names to their constructors with default sizes
+var examples = map[string]func(name, namespace string) (*JobBuilder, error){
+	"bell": func(name, namespace string) (*JobBuilder, error) {
+		return NewBellStateJob(name, namespace), nil
+	},
+	"ghz": func(name, namespace string) (*JobBuilder, error) {
+		return NewGHZJob(name, namespace, 3)
+	},
+	"qft": func(name, namespace string) (*JobBuilder, error) {
+		return NewQFTJob(name, namespace, 3)
+	},
+	"qaoa": func(name, namespace string) (*JobBuilder, error) {
+		return NewQAOAJob(name, namespace, 4, 1), nil
+	},
+}
+
+// ExampleNames lists the names accepted by NewExampleJob
+func ExampleNames() []string {
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewExampleJob returns a builder for a named example (e.g., "bell")
+func NewExampleJob(example, name, namespace string) (*JobBuilder, error) {
+	newJob, ok := examples[example]
+	if !ok {
+		return nil, fmt.Errorf("unknown example %q, must be one of: %s", example, strings.Join(ExampleNames(), ", "))
+	}
+	return newJob(name, namespace)
+}
@@ -43,6 +43,22 @@ type QiskitBackendStatus struct {
 	// For Kubernetes API conventions, see:
 	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
 
+	// Predicted queue wait, averaged over recently observed submissions
+	// +optional
+	PredictedQueueWait string `json:"predictedQueueWait,omitempty"`
+
+	// Number of observed submissions the prediction is based on
+	// +optional
+	QueueWaitSamples int `json:"queueWaitSamples,omitempty"`
+
+	// Queue wait of the most recent observed submission
+	// +optional
+	LastObservedQueueWait string `json:"lastObservedQueueWait,omitempty"`
+
+	// Last time the prediction changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
 	// conditions represent the current state of the QiskitBackend resource.
 	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
 	//
@@ -60,6 +76,9 @@ type QiskitBackendStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Predicted Wait",type=string,JSONPath=`.status.predictedQueueWait`
+// +kubebuilder:printcolumn:name="Samples",type=integer,JSONPath=`.status.queueWaitSamples`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // QiskitBackend is the Schema for the qiskitbackends API
 type QiskitBackend struct {
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QiskitBulkOperationSpec defines an action applied to every selected
+// QiskitJob of the operation's namespace
+type QiskitBulkOperationSpec struct {
+	// Action applied to each selected job. Cancel stops jobs that have not
+	// finished, Suspend holds them before their next attempt, Resume releases
+	// suspended jobs and Delete deletes them.
+	// +kubebuilder:validation:Enum=Cancel;Suspend;Resume;Delete
+	// +required
+	Action string `json:"action"`
+
+	// Labels of the jobs to act on. Only jobs that exist when the operation
+	// is created are selected. An empty selector selects no jobs.
+	// +required
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Only act on jobs in these phases; empty selects jobs in any phase
+	// +optional
+	Phases []string `json:"phases,omitempty"`
+
+	// Jobs acted on per second, so large operations do not flood the API
+	// server
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// +optional
+	JobsPerSecond int32 `json:"jobsPerSecond,omitempty"`
+
+	// Reason recorded on cancelled jobs
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// BulkJobFailure reports a job the operation could not act on
+type BulkJobFailure struct {
+	// Name of the job
+	Name string `json:"name"`
+
+	// Why the action failed
+	Message string `json:"message"`
+}
+
+// QiskitBulkOperationStatus reports the progress of the operation
+type QiskitBulkOperationStatus struct {
+	// Phase of the operation (Running, Completed)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Number of jobs the operation selected
+	// +optional
+	Matched int32 `json:"matched,omitempty"`
+
+	// Number of jobs the action was applied to
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// Number of selected jobs the action did not apply to, like finished
+	// jobs that cannot be cancelled or jobs that were already suspended
+	// +optional
+	Skipped int32 `json:"skipped,omitempty"`
+
+	// Number of jobs the action failed for
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// Jobs the action failed for, up to the first 50
+	// +optional
+	Failures []BulkJobFailure `json:"failures,omitempty"`
+
+	// When the operation started acting on jobs
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// When the operation finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Human-readable summary of the operation
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=qbo
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Matched",type=integer,JSONPath=`.status.matched`
+// +kubebuilder:printcolumn:name="Succeeded",type=integer,JSONPath=`.status.succeeded`
+// +kubebuilder:printcolumn:name="Skipped",type=integer,JSONPath=`.status.skipped`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QiskitBulkOperation is the Schema for the qiskitbulkoperations API. It
+// cancels, suspends, resumes or deletes every QiskitJob of its namespace
+// matching a label selector, at a limited rate, and reports what it did.
+// The spec cannot change once the operation is created.
+// +kubebuilder:validation:XValidation:rule="self.spec == oldSelf.spec",message="spec is immutable"
+type QiskitBulkOperation struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the action and the jobs it applies to
+	// +required
+	Spec QiskitBulkOperationSpec `json:"spec"`
+
+	// status reports the progress of the operation
+	// +optional
+	Status QiskitBulkOperationStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QiskitBulkOperationList contains a list of QiskitBulkOperation
+type QiskitBulkOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QiskitBulkOperation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QiskitBulkOperation{}, &QiskitBulkOperationList{})
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Calendar window types
+const (
+	// CalendarWindowPeak marks hours with higher backend prices
+	CalendarWindowPeak = "Peak"
+	// CalendarWindowBlackout marks hours during which no jobs are submitted
+	CalendarWindowBlackout = "Blackout"
+	// CalendarWindowAllowed marks the only hours jobs are submitted in. Jobs
+	// covered by Allowed windows wait in the Scheduled phase outside them.
+	CalendarWindowAllowed = "Allowed"
+)
+
+// QiskitCalendarSpec defines the pricing and availability windows of backends
+type QiskitCalendarSpec struct {
+	// Backend names or types the calendar applies to; shell-style globs such
+	// as "ibm_*" are allowed. Empty applies to every backend.
+	// +optional
+	Backends []string `json:"backends,omitempty"`
+
+	// Namespaces whose jobs the calendar applies to, e.g. to give teams in
+	// different time zones their own execution windows. Empty applies to
+	// jobs in every namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// IANA time zone the windows are expressed in (e.g., "Europe/Berlin")
+	// +kubebuilder:default=UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Recurring windows
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	Windows []CalendarWindow `json:"windows"`
+}
+
+// CalendarWindow is a recurring period with a pricing or availability effect
+type CalendarWindow struct {
+	// Name of the window, used in job conditions
+	// +required
+	Name string `json:"name"`
+
+	// Type of window: Peak pricing, Blackout, or Allowed execution hours
+	// +kubebuilder:validation:Enum=Peak;Blackout;Allowed
+	// +required
+	Type string `json:"type"`
+
+	// Days of the week the window starts on; empty means every day
+	// +optional
+	Days []CalendarDay `json:"days,omitempty"`
+
+	// Start time of day (HH:MM)
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +required
+	Start string `json:"start"`
+
+	// End time of day (HH:MM, "24:00" for midnight); an end before the start
+	// extends the window into the next day
+	// +kubebuilder:validation:Pattern=`^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$`
+	// +required
+	End string `json:"end"`
+
+	// Price multiplier of Peak windows (e.g., "1.5")
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	CostMultiplier string `json:"costMultiplier,omitempty"`
+}
+
+// CalendarDay is a day of the week
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type CalendarDay string
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=qcal
+// +kubebuilder:printcolumn:name="Time Zone",type=string,JSONPath=`.spec.timeZone`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QiskitCalendar is the Schema for the qiskitcalendars API.
+// Cluster administrators use calendars to declare peak pricing hours,
+// blackout periods and allowed execution hours; jobs are held back from
+// blackouts, outside allowed hours and, when their deadline allows, from
+// peak hours.
+type QiskitCalendar struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the calendar windows
+	// +required
+	Spec QiskitCalendarSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// QiskitCalendarList contains a list of QiskitCalendar
+type QiskitCalendarList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QiskitCalendar `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QiskitCalendar{}, &QiskitCalendarList{})
+}
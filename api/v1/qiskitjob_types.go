@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -24,15 +26,45 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // QiskitJobSpec defines the desired state of QiskitJob
+// +kubebuilder:validation:XValidation:rule="has(self.backend) || has(self.templateRef) || has(self.backendRef)",message="backend is required unless templateRef or backendRef is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.backendRef) || !has(self.templateRef)",message="backendRef and templateRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.overrides) || has(self.templateRef)",message="overrides require templateRef"
 type QiskitJobSpec struct {
-	// Backend configuration for quantum execution
-	// +required
-	Backend BackendSpec `json:"backend"`
+	// Template the job is instantiated from. The template supplies every
+	// setting except the circuit, session and deduplication policy; values the
+	// job sets for template-owned fields are replaced.
+	// +optional
+	TemplateRef *TemplateRef `json:"templateRef,omitempty"`
+
+	// Changes to template settings for this job only, limited to the
+	// template's allowedOverrides
+	// +optional
+	Overrides *JobOverrides `json:"overrides,omitempty"`
+
+	// Backend configuration for quantum execution. Required unless templateRef or backendRef is set.
+	// +optional
+	Backend BackendSpec `json:"backend,omitempty,omitzero"`
+
+	// Registered QuantumBackend to run on, by name or picked among those
+	// matching a selector. Its backend and credentials are copied into the
+	// job's spec when the job is first reconciled, so spec.backend may only
+	// be set by the operator.
+	// +optional
+	BackendRef *QuantumBackendRef `json:"backendRef,omitempty"`
 
 	// Circuit definition (Qiskit Python code)
 	// +required
 	Circuit CircuitSpec `json:"circuit"`
 
+	// Data the circuit reads, e.g. the classical dataset it encodes, put in
+	// the executor's workspace under /workspace/inputs/<name> before it runs.
+	// Their checksums are recorded in status.inputs.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Inputs []InputSpec `json:"inputs,omitempty"`
+
 	// Execution parameters (shots, optimization level, etc.)
 	// +optional
 	Execution ExecutionSpec `json:"execution,omitempty"`
@@ -50,22 +82,174 @@ type QiskitJobSpec struct {
 	Budget *BudgetSpec `json:"budget,omitempty"`
 
 	// Output configuration (where to store results)
+	// Deprecated: migrated to Outputs on write.
 	// +optional
 	Output *OutputSpec `json:"output,omitempty"`
 
+	// Outputs the results are stored in. Each output is written
+	// independently: one that fails does not keep the results from the
+	// others, and the state of each is reported in status.outputs.
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=atomic
+	// +optional
+	Outputs []OutputSpec `json:"outputs,omitempty"`
+
+	// Notifications sent when the job finishes for good, in addition to
+	// those the operator is configured to send for every job
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=atomic
+	// +optional
+	Notifications []NotificationSpec `json:"notifications,omitempty"`
+
+	// Shadow run of the circuit on a second backend, compared with the
+	// primary run to validate its results
+	// +optional
+	Shadow *ShadowSpec `json:"shadow,omitempty"`
+
+	// Verify mode: the circuit runs a second time with the same simulator
+	// seed, possibly on another Qiskit version or executor image, and the job
+	// fails unless both runs measure identical counts. Used to qualify new
+	// executor images and Qiskit upgrades before they are rolled out.
+	// +optional
+	Verify *VerifySpec `json:"verify,omitempty"`
+
+	// Artifacts published alongside the results
+	// +optional
+	Artifacts *ArtifactsSpec `json:"artifacts,omitempty"`
+
+	// Variational optimization of the circuit's parameters, run in the
+	// executor before the optimized circuit is sampled
+	// +optional
+	Optimizer *OptimizerSpec `json:"optimizer,omitempty"`
+
+	// Estimation of the expectation values of the circuit's observables,
+	// run in the executor instead of sampling the circuit
+	// +optional
+	Estimator *EstimatorSpec `json:"estimator,omitempty"`
+
+	// Parameter sweep: the circuit runs once per binding of its parameters,
+	// each in its own execution pod, and the counts of every binding are
+	// exported together
+	// +optional
+	Sweep *SweepSpec `json:"sweep,omitempty"`
+
+	// State tomography: the circuit runs once per measurement basis of the
+	// tomographed qubits, each in its own execution pod, and their state is
+	// reconstructed from the counts of every basis
+	// +optional
+	Tomography *TomographySpec `json:"tomography,omitempty"`
+
+	// Shot splitting: the shots are split across the job's backend and
+	// other equivalent backends, each running its share in its own execution
+	// pod at the same time, and the counts of every backend are merged
+	// +optional
+	Split *SplitSpec `json:"split,omitempty"`
+
 	// Credentials for backend authentication
 	// +optional
 	Credentials *CredentialsSpec `json:"credentials,omitempty"`
 
+	// Security restrictions on the execution pod beyond those the operator
+	// applies to every executor
+	// +optional
+	Security *SecuritySpec `json:"security,omitempty"`
+
 	// Backend selection preferences
 	// +optional
 	BackendSelection *BackendSelectionSpec `json:"backendSelection,omitempty"`
+
+	// Duplicate submission detection policy
+	// +optional
+	Deduplication *DeduplicationSpec `json:"deduplication,omitempty"`
+
+	// Placement constraints on where the job may execute
+	// +optional
+	Placement *PlacementSpec `json:"placement,omitempty"`
+
+	// Scheduling of the execution pod onto the cluster's nodes, e.g. to run
+	// large simulations on a high-memory or GPU node pool
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+
+	// How failed attempts are retried. Without it a failed job is retried 3
+	// times, 10s apart, whatever it failed of.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// TTLSecondsAfterFinished deletes the job, with its pods and results
+	// ConfigMaps, this many seconds after it completed or failed for good.
+	// Without it the operator's default applies, if any.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Suspend holds the job before its next attempt starts; an attempt that
+	// is already running finishes. Clearing it lets the job continue.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// TemplateRef references a QiskitJobTemplate
+type TemplateRef struct {
+	// Name of the QiskitJobTemplate
+	// +required
+	Name string `json:"name"`
+}
+
+// QuantumBackendRef references registered QuantumBackends
+// +kubebuilder:validation:XValidation:rule="has(self.name) != has(self.selector)",message="exactly one of name and selector is required"
+type QuantumBackendRef struct {
+	// Name of the QuantumBackend
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Selects QuantumBackends by label. Among the available ones the
+	// scheduler picks the first of spec.backendSelection.preferredBackends,
+	// else the one with the shortest queue, skipping excluded backends.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// JobOverrides are per-job changes to the settings of a QiskitJobTemplate.
+// Only fields the template lists in allowedOverrides may be set.
+type JobOverrides struct {
+	// +optional
+	Backend *BackendSpec `json:"backend,omitempty"`
+
+	// +optional
+	Execution *ExecutionSpec `json:"execution,omitempty"`
+
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// +optional
+	Budget *BudgetSpec `json:"budget,omitempty"`
+
+	// +optional
+	Output *OutputSpec `json:"output,omitempty"`
+
+	// +optional
+	Credentials *CredentialsSpec `json:"credentials,omitempty"`
+
+	// +optional
+	Security *SecuritySpec `json:"security,omitempty"`
+
+	// +optional
+	BackendSelection *BackendSelectionSpec `json:"backendSelection,omitempty"`
+
+	// +optional
+	Placement *PlacementSpec `json:"placement,omitempty"`
+
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
 }
 
 // BackendSpec defines the quantum backend configuration
 type BackendSpec struct {
-	// Type of backend (ibm_quantum, ibm_simulator, aws_braket, local_simulator)
-	// +kubebuilder:validation:Enum=ibm_quantum;ibm_simulator;aws_braket;local_simulator
+	// Type of backend (ibm_quantum, ibm_simulator, ibm_local_testing, aws_braket, local_simulator, generic_http).
+	// ibm_local_testing runs qiskit-ibm-runtime's local testing mode against
+	// the fake backend modelling Name (e.g., "ibm_brisbane" or "fake_brisbane").
+	// +kubebuilder:validation:Enum=ibm_quantum;ibm_simulator;ibm_local_testing;aws_braket;local_simulator;generic_http
 	// +required
 	Type string `json:"type"`
 
@@ -78,26 +262,170 @@ type BackendSpec struct {
 	Instance string `json:"instance,omitempty"`
 
 	// IBM Quantum Network hub (legacy authentication)
+	// Deprecated: migrated to Instance as "hub/group/project" on write.
 	// +optional
 	Hub string `json:"hub,omitempty"`
 
 	// IBM Quantum Network group (legacy authentication)
+	// Deprecated: migrated to Instance as "hub/group/project" on write.
 	// +optional
 	Group string `json:"group,omitempty"`
 
 	// IBM Quantum Network project (legacy authentication)
+	// Deprecated: migrated to Instance as "hub/group/project" on write.
 	// +optional
 	Project string `json:"project,omitempty"`
+
+	// Provider region (e.g., "us-east-1" for aws_braket)
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// AWS Braket device ARN (e.g., "arn:aws:braket:us-east-1::device/qpu/ionq/Aria-1")
+	// +optional
+	DeviceARN string `json:"deviceArn,omitempty"`
+
+	// Endpoints of an in-house control stack, required for generic_http backends
+	// +optional
+	HTTP *HTTPBackendSpec `json:"http,omitempty"`
+}
+
+// HTTP authentication schemes of generic_http backends
+const (
+	HTTPAuthNone   = "None"
+	HTTPAuthBearer = "Bearer"
+	HTTPAuthBasic  = "Basic"
+	HTTPAuthHeader = "Header"
+)
+
+// HTTPBackendSpec describes how to drive a QPU through a JSON-over-HTTP API.
+// URLs and bodies are Go templates rendered with the job's Name, Namespace,
+// UID, Shots, Circuit (the circuit code), Tags, MaxExecutionSeconds,
+// ResilienceLevel, ErrorMitigation, RequestID (the client request ID of the
+// submission) and, after submission, JobID (the provider's job ID). The json function quotes a value for use in a body.
+type HTTPBackendSpec struct {
+	// Endpoint the circuit is submitted to
+	// +required
+	Submit HTTPEndpoint `json:"submit"`
+
+	// Endpoint polled for the job's state
+	// +required
+	Status HTTPEndpoint `json:"status"`
+
+	// Endpoint returning the job's results; defaults to the status response
+	// +optional
+	Result *HTTPEndpoint `json:"result,omitempty"`
+
+	// Endpoint cancelling the job
+	// +optional
+	Cancel *HTTPEndpoint `json:"cancel,omitempty"`
+
+	// Endpoint finding the job submitted with a client request ID
+	// (RequestID, also sent as the Idempotency-Key header of the submission),
+	// e.g. by searching the provider's jobs; its response carries the job ID
+	// at mapping.jobId. A 404 response or no ID means nothing was submitted.
+	// Without it, a submission interrupted before its job ID was recorded is
+	// sent again with the same Idempotency-Key.
+	// +optional
+	Lookup *HTTPEndpoint `json:"lookup,omitempty"`
+
+	// Authentication scheme. Bearer and Header send the "api-key" of the job's
+	// credentials secret; Basic sends its "username" and "password".
+	// +kubebuilder:validation:Enum=None;Bearer;Basic;Header
+	// +kubebuilder:default=None
+	// +optional
+	Auth string `json:"auth,omitempty"`
+
+	// Header carrying the api-key for Header authentication
+	// +optional
+	AuthHeader string `json:"authHeader,omitempty"`
+
+	// Where the adapter finds values in the JSON responses
+	// +required
+	Mapping HTTPResponseMapping `json:"mapping"`
+
+	// Timeout of each request
+	// +kubebuilder:default="30s"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// HTTPEndpoint is a request of a generic_http backend
+type HTTPEndpoint struct {
+	// URL template (e.g., "https://qpu.lab.example/api/jobs/{{ .JobID }}")
+	// +required
+	URL string `json:"url"`
+
+	// HTTP method; POST for submit and GET otherwise when empty
+	// +kubebuilder:validation:Enum=GET;POST;PUT;DELETE
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Request body template, sent as application/json
+	// (e.g., `{"qasm": {{ json .Circuit }}, "shots": {{ .Shots }}}`)
+	// +optional
+	Body string `json:"body,omitempty"`
+}
+
+// HTTPResponseMapping locates values in JSON responses by dotted path
+// (e.g., "data.job.id"); numeric segments index into arrays
+type HTTPResponseMapping struct {
+	// Path of the provider job ID in the submit response
+	// +required
+	JobID string `json:"jobId"`
+
+	// Path of the job state in the status response
+	// +required
+	State string `json:"state"`
+
+	// States meaning the job completed successfully
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	CompletedStates []string `json:"completedStates"`
+
+	// States meaning the job failed or was cancelled
+	// +optional
+	FailedStates []string `json:"failedStates,omitempty"`
+
+	// Path of an error or progress message in the status response
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// States meaning the job waits in the provider's queue
+	// +optional
+	QueuedStates []string `json:"queuedStates,omitempty"`
+
+	// Path of the job's position in the provider's queue in the status
+	// response, 1 being next
+	// +optional
+	QueuePosition string `json:"queuePosition,omitempty"`
+
+	// Path of the RFC 3339 time the provider expects the job to start in the
+	// status response
+	// +optional
+	EstimatedStartTime string `json:"estimatedStartTime,omitempty"`
+
+	// Path of the measurement counts object (bitstring to count) in the result response
+	// +required
+	Counts string `json:"counts"`
 }
 
 // CircuitSpec defines the quantum circuit configuration
 type CircuitSpec struct {
-	// Source of the circuit code (inline, configmap, url, git)
-	// +kubebuilder:validation:Enum=inline;configmap;url;git
+	// Source of the circuit code (inline, configmap, url, git, bundle)
+	// +kubebuilder:validation:Enum=inline;configmap;url;git;bundle
 	// +required
 	Source string `json:"source"`
 
-	// Inline Qiskit Python code
+	// Language of the circuit code: Qiskit Python, or an OpenQASM 2 or 3
+	// program loaded into a QuantumCircuit by Qiskit's qasm2 or qasm3
+	// loader. OpenQASM is only valid for the inline, configmap and url
+	// sources.
+	// +kubebuilder:validation:Enum=python;qasm2;qasm3
+	// +kubebuilder:default=python
+	// +optional
+	Format string `json:"format,omitempty"`
+
+	// Inline circuit code, in the language of Format
 	// +optional
 	Code string `json:"code,omitempty"`
 
@@ -105,13 +433,68 @@ type CircuitSpec struct {
 	// +optional
 	ConfigMapRef *ConfigMapRef `json:"configMapRef,omitempty"`
 
-	// URL to fetch circuit code
+	// HTTP(S) URL to fetch circuit code from. The operator fetches it once
+	// per job, so every attempt runs the same code.
 	// +optional
 	URL string `json:"url,omitempty"`
 
+	// Hex-encoded SHA-256 digest the code fetched from URL must match,
+	// required for the url source
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{64}$`
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+
 	// Git repository reference
 	// +optional
 	GitRef *GitRef `json:"gitRef,omitempty"`
+
+	// Archive holding a multi-file Python project
+	// +optional
+	Bundle *BundleSpec `json:"bundle,omitempty"`
+
+	// What runs the job for git and bundle sources: a module (e.g.,
+	// "vqe.main") or a .py file relative to the project root, optionally
+	// followed by ":function" to call a function of it. Required for bundles.
+	// +optional
+	Entrypoint string `json:"entrypoint,omitempty"`
+
+	// Command-line arguments passed to the entrypoint in sys.argv, e.g. the
+	// parameters of a sweep
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	Args []string `json:"args,omitempty"`
+}
+
+// BundleSpec references a zip archive of a Python project that is unpacked
+// into the executor. Exactly one of ConfigMapRef, Image and URL must be set.
+type BundleSpec struct {
+	// ConfigMap whose binaryData key holds the archive
+	// +optional
+	ConfigMapRef *ConfigMapRef `json:"configMapRef,omitempty"`
+
+	// OCI image or artifact holding the archive, mounted as an image volume
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Path of the archive within the image
+	// +optional
+	// +kubebuilder:default=bundle.zip
+	Path string `json:"path,omitempty"`
+
+	// HTTP(S) URL to download the archive from
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Hex-encoded SHA-256 digest the archive must match
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{64}$`
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+
+	// Pip requirements file relative to the archive root. Every requirement
+	// must be on the operator's package allow-list or pinned by the operator.
+	// +optional
+	// +kubebuilder:default=requirements.txt
+	Requirements string `json:"requirements,omitempty"`
 }
 
 // ConfigMapRef references a ConfigMap
@@ -131,15 +514,79 @@ type GitRef struct {
 	// +required
 	Repository string `json:"repository"`
 
-	// Branch name
+	// Branch name; the repository's default branch when empty
 	// +optional
 	Branch string `json:"branch,omitempty"`
 
-	// Path to circuit file in repository
+	// Path to circuit file in repository, run from the repository root
+	// unless the circuit names an entrypoint
 	// +required
 	Path string `json:"path"`
 }
 
+// InputSpec is a data input of a job. Exactly one of ConfigMapRef,
+// SecretRef, PersistentVolumeClaim and URI must be set.
+type InputSpec struct {
+	// Name of the input, the file or directory it is found at under
+	// /workspace/inputs
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9_.]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Name string `json:"name"`
+
+	// ConfigMap mounted read-only: a directory of its keys, or the file of
+	// one key
+	// +optional
+	ConfigMapRef *InputObjectRef `json:"configMapRef,omitempty"`
+
+	// Secret mounted read-only: a directory of its keys, or the file of one
+	// key
+	// +optional
+	SecretRef *InputObjectRef `json:"secretRef,omitempty"`
+
+	// PersistentVolumeClaim mounted read-only, or a file or directory of it
+	// +optional
+	PersistentVolumeClaim *InputVolumeRef `json:"persistentVolumeClaim,omitempty"`
+
+	// https:// or s3://<bucket>/<key> URI of a file downloaded before the
+	// circuit runs
+	// +optional
+	URI string `json:"uri,omitempty"`
+
+	// Secret in the job's namespace with the credentials of an s3 URI, with
+	// the keys of s3 output Secrets
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Hex-encoded SHA-256 digest the input must match, as recorded in
+	// status.inputs
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{64}$`
+	// +optional
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// InputObjectRef references a ConfigMap or Secret, or one key of it
+type InputObjectRef struct {
+	// Name of the ConfigMap or Secret
+	// +required
+	Name string `json:"name"`
+
+	// Key to mount as a file; all keys are mounted as a directory if empty
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// InputVolumeRef references a PersistentVolumeClaim, or a path within it
+type InputVolumeRef struct {
+	// Name of the PersistentVolumeClaim
+	// +required
+	ClaimName string `json:"claimName"`
+
+	// File or directory within the claim to mount; the whole claim if empty
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 // ExecutionSpec defines execution parameters
 type ExecutionSpec struct {
 	// Number of measurements (shots)
@@ -175,292 +622,1699 @@ type ExecutionSpec struct {
 	// Disable automatic fallback to simulator
 	// +optional
 	DisableFallback bool `json:"disableFallback,omitempty"`
-}
 
-// SessionSpec defines IBM Quantum Runtime session configuration
-type SessionSpec struct {
-	// Session name
+	// Qiskit version to execute with (e.g., "1.0", "1.2.4"); defaults to the stable line
+	// +kubebuilder:validation:Pattern=`^v?[0-9]+\.[0-9]+(\.[0-9]+)?$`
 	// +optional
-	Name string `json:"name,omitempty"`
+	QiskitVersion string `json:"qiskitVersion,omitempty"`
 
-	// Maximum session time in seconds
+	// Latest time the job should be submitted. Until then the operator may
+	// hold the job back for a cheaper window of the backend's QiskitCalendars.
 	// +optional
-	MaxTime int `json:"maxTime,omitempty"`
+	Deadline *metav1.Time `json:"deadline,omitempty"`
 
-	// Session mode (dedicated, batch)
-	// +kubebuilder:validation:Enum=dedicated;batch
+	// Latest time the job should complete. Each attempt may only run for the
+	// time left, which the operator passes to providers as their execution
+	// time limit; no attempt starts once it has passed.
 	// +optional
-	Mode string `json:"mode,omitempty"`
-}
+	CompleteBy *metav1.Time `json:"completeBy,omitempty"`
 
-// ResourceRequirements defines pod resource requirements
-type ResourceRequirements struct {
-	// Resource requests
+	// Tags attached to the provider job (IBM Runtime job tags) in addition to
+	// the tags identifying this QiskitJob
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=set
 	// +optional
-	Requests map[string]string `json:"requests,omitempty"`
+	Tags []string `json:"tags,omitempty"`
 
-	// Resource limits
+	// Python packages installed into the executor in addition to Qiskit, as
+	// pip requirements (e.g., "qiskit-nature>=0.7"). Each must be on the
+	// operator's package allow-list.
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=set
 	// +optional
-	Limits map[string]string `json:"limits,omitempty"`
-}
+	ExtraPackages []string `json:"extraPackages,omitempty"`
 
-// BudgetSpec defines cost constraints
-type BudgetSpec struct {
-	// Maximum cost for this job (e.g., "$10.00")
+	// Scratch disk space for simulators that spill to disk, mounted at
+	// /scratch and used as TMPDIR
 	// +optional
-	MaxCost string `json:"maxCost,omitempty"`
+	Scratch *ScratchSpec `json:"scratch,omitempty"`
 
-	// Cost center identifier
+	// Device the local simulator runs Aer on. "gpu" requests a GPU for the
+	// executor, installs qiskit-aer-gpu and simulates on the GPU. On clusters
+	// without GPU nodes the job is simulated on the CPU instead, or fails if
+	// it disables fallback. Only valid for local_simulator jobs.
+	// +kubebuilder:validation:Enum=cpu;gpu
 	// +optional
-	CostCenter string `json:"costCenter,omitempty"`
+	Accelerator string `json:"accelerator,omitempty"`
 
-	// Billing account
+	// Executor image the job runs instead of the operator's, e.g. one with
+	// Qiskit and further packages pre-installed. It must follow the executor
+	// image contract: Python with pip on the path, and the job's Qiskit
+	// release installed or installable.
 	// +optional
-	BillingAccount string `json:"billingAccount,omitempty"`
-}
+	Image string `json:"image,omitempty"`
 
-// OutputSpec defines where to store results
-type OutputSpec struct {
-	// Output type (pvc, s3, gcs, azure_blob, configmap)
-	// +kubebuilder:validation:Enum=pvc;s3;gcs;azure_blob;configmap
-	// +required
-	Type string `json:"type"`
-
-	// Storage location (PVC name, bucket name, etc.)
-	// +required
-	Location string `json:"location"`
+	// Environment variables for the executor, so circuit code can read
+	// experiment configuration without embedding it in its source. Names
+	// the operator sets itself are reserved.
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
 
-	// Path within the storage location
+	// ConfigMaps and Secrets whose keys become environment variables of the
+	// executor. Variables in Env take precedence.
+	// +kubebuilder:validation:MaxItems=20
 	// +optional
-	Path string `json:"path,omitempty"`
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
 
-	// Result format (json, pickle, qpy, csv)
-	// +kubebuilder:validation:Enum=json;pickle;qpy;csv
+	// Run the executor in a sandbox namespace of its own, away from the
+	// workloads and Secrets of the job's namespace, for circuit code that is
+	// not trusted. Operators may sandbox every job regardless.
 	// +optional
-	// +kubebuilder:default=json
-	Format string `json:"format,omitempty"`
+	Sandbox bool `json:"sandbox,omitempty"`
 
-	// Retention period
+	// Reuse of results cached from earlier jobs running the same circuit
+	// with the same shots, backend and optimization level. "use" completes
+	// the job from a cached result when there is one and caches its own
+	// otherwise, "refresh" always executes and replaces the cached result,
+	// "ignore" neither reads nor writes the cache. Only circuits pinned to
+	// their content (inline, or url with sha256) are cached.
+	// +kubebuilder:validation:Enum=use;ignore;refresh
 	// +optional
-	Retention string `json:"retention,omitempty"`
-}
+	CachePolicy string `json:"cachePolicy,omitempty"`
 
-// CredentialsSpec defines authentication credentials
-type CredentialsSpec struct {
-	// Kubernetes Secret reference
+	// Qiskit primitive the executor drives: "sampler" measures the counts
+	// of the circuit, "estimator" the expectation values of its observables.
+	// Jobs that set spec.estimator run the estimator.
+	// +kubebuilder:validation:Enum=sampler;estimator
 	// +optional
-	SecretRef *SecretRef `json:"secretRef,omitempty"`
+	Primitive string `json:"primitive,omitempty"`
 
-	// HashiCorp Vault path
+	// Observables the estimator primitive estimates the expectation values
+	// of, instead of those the circuit code defines
+	// +kubebuilder:validation:MaxItems=1000
 	// +optional
-	VaultPath string `json:"vaultPath,omitempty"`
-}
+	Observables []Observable `json:"observables,omitempty"`
 
-// SecretRef references a Kubernetes Secret
-type SecretRef struct {
-	// Secret name
-	// +required
-	Name string `json:"name"`
+	// Controls of the transpiler the executor maps the circuit onto the
+	// backend with, next to the optimization level. Only valid for backends
+	// whose circuits run in an execution pod.
+	// +optional
+	Transpiler *TranspilerSpec `json:"transpiler,omitempty"`
 
-	// Secret namespace
+	// Error suppression and mitigation the backend applies to the job's
+	// circuits. Backends apply what they support; the mitigations actually
+	// applied are recorded in status.results.mitigations.
 	// +optional
-	Namespace string `json:"namespace,omitempty"`
+	ErrorMitigation *ErrorMitigationSpec `json:"errorMitigation,omitempty"`
 }
 
-// BackendSelectionSpec defines backend selection preferences
-type BackendSelectionSpec struct {
-	// Selection weights for scoring backends
+// ErrorMitigationSpec configures the error suppression and mitigation of a
+// job, in the terms of IBM Runtime's primitive options
+type ErrorMitigationSpec struct {
+	// IBM Quantum resilience level (0-2), overriding
+	// execution.resilienceLevel
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
 	// +optional
-	Weights *BackendWeights `json:"weights,omitempty"`
+	ResilienceLevel *int `json:"resilienceLevel,omitempty"`
 
-	// Preferred backends (ordered by preference)
+	// Dynamical decoupling sequence padding the idle periods of the qubits;
+	// none if empty
+	// +kubebuilder:validation:Enum=XX;XpXm;XY4
 	// +optional
-	PreferredBackends []string `json:"preferredBackends,omitempty"`
+	DynamicalDecoupling string `json:"dynamicalDecoupling,omitempty"`
 
-	// Excluded backends
+	// Pauli twirling of the circuit's gates and measurements
 	// +optional
-	ExcludedBackends []string `json:"excludedBackends,omitempty"`
+	Twirling *TwirlingSpec `json:"twirling,omitempty"`
 
-	// Allow fallback to simulator
+	// Mitigate readout errors of the measurements
+	// +optional
+	MeasurementMitigation bool `json:"measurementMitigation,omitempty"`
+}
+
+// TwirlingSpec selects what Pauli twirling randomizes
+type TwirlingSpec struct {
+	// Twirl the two-qubit gates
+	// +optional
+	Gates bool `json:"gates,omitempty"`
+
+	// Twirl the measurements
+	// +optional
+	Measure bool `json:"measure,omitempty"`
+}
+
+// TranspilerSpec tunes Qiskit's preset pass managers. Unset fields keep
+// Qiskit's choice for the optimization level.
+type TranspilerSpec struct {
+	// Method choosing the initial placement of the circuit's qubits
+	// +kubebuilder:validation:Enum=trivial;dense;sabre
+	// +optional
+	LayoutMethod string `json:"layoutMethod,omitempty"`
+
+	// Method inserting the swaps the backend's connectivity requires; "none"
+	// fails circuits that need any
+	// +kubebuilder:validation:Enum=basic;lookahead;stochastic;sabre;none
+	// +optional
+	RoutingMethod string `json:"routingMethod,omitempty"`
+
+	// Seed of the transpiler's randomized passes, so runs reproduce the
+	// same transpiled circuit
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Gates to decompose the circuit into instead of the backend's
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	BasisGates []string `json:"basisGates,omitempty"`
+
+	// Whether to route the circuit on the coupling map of the target
+	// backend, "backend", or as if every qubit were connected, "none"
+	// +kubebuilder:validation:Enum=backend;none
+	// +kubebuilder:default=backend
+	// +optional
+	CouplingMap string `json:"couplingMap,omitempty"`
+}
+
+// Observable is a weighted sum of Pauli strings
+type Observable struct {
+	// Name its expectation value is reported under; its index in the list
+	// if empty
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Terms of the sum
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1000
+	Terms []PauliTerm `json:"terms"`
+}
+
+// PauliTerm is a Pauli string with its coefficient
+type PauliTerm struct {
+	// Pauli operator on each qubit of the circuit, qubit 0 rightmost, e.g.
+	// "IZZ"
+	// +kubebuilder:validation:Pattern=`^[IXYZ]+$`
+	Pauli string `json:"pauli"`
+
+	// Coefficient of the term
+	// +kubebuilder:default=1
+	// +optional
+	Coefficient float64 `json:"coefficient,omitempty"`
+}
+
+// ScratchSpec sizes the execution pod's scratch space
+type ScratchSpec struct {
+	// Size of the scratch space. Unless StorageClassName is set it is an
+	// emptyDir on the node, and the pod requests that much ephemeral
+	// storage so it is scheduled where it fits instead of being evicted.
+	// +required
+	Size resource.Quantity `json:"size"`
+
+	// Provision the scratch space as a generic ephemeral volume of this
+	// StorageClass instead, for sizes beyond the nodes' ephemeral storage
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// SessionSpec defines IBM Quantum Runtime session configuration
+type SessionSpec struct {
+	// Session name
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Maximum session time in seconds
+	// +optional
+	MaxTime int `json:"maxTime,omitempty"`
+
+	// Session mode (dedicated, batch)
+	// +kubebuilder:validation:Enum=dedicated;batch
+	// +optional
+	Mode string `json:"mode,omitempty"`
+}
+
+// ArtifactsSpec selects artifacts published alongside a job's results
+type ArtifactsSpec struct {
+	// Publish the circuit as transpiled for the backend, as QPY and an SVG
+	// diagram, in the ConfigMap "<job name>-transpiled", and record its size
+	// in status.circuitMetadata.transpiled. Only valid for backends the
+	// operator transpiles for (ibm_local_testing).
+	// +optional
+	TranspiledCircuit bool `json:"transpiledCircuit,omitempty"`
+}
+
+// OptimizerSpec runs a variational loop in the executor: the parameters of
+// the circuit qc are optimized to minimize the expectation value of the
+// observable the circuit code defines, estimated within a single session of
+// the backend. Only valid for backends that run in an execution pod
+// (local_simulator, ibm_local_testing).
+type OptimizerSpec struct {
+	// Classical optimizer updating the parameters
+	// +kubebuilder:validation:Enum=COBYLA;SPSA
+	// +kubebuilder:default=COBYLA
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Maximum number of iterations. An iteration is one expectation value
+	// estimate for COBYLA and one parameter update for SPSA.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +kubebuilder:default=100
+	// +optional
+	MaxIterations int `json:"maxIterations,omitempty"`
+
+	// Convergence tolerance: COBYLA stops once its trust region shrinks
+	// below it, SPSA once an iteration changes the expectation value by less
+	// than it. Zero keeps COBYLA's default and runs every SPSA iteration.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Tolerance float64 `json:"tolerance,omitempty"`
+}
+
+// EstimatorSpec estimates the expectation value of each of the observables
+// the circuit code defines for the circuit qc, instead of sampling it. The
+// observables are split into batches small enough for a single request to
+// the backend, estimated by successive Estimator calls within a single
+// session, and their values merged in order into one result. Only valid for
+// backends that run in an execution pod (local_simulator, ibm_local_testing).
+type EstimatorSpec struct {
+	// Most observables estimated by a single Estimator call
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +kubebuilder:default=100
+	// +optional
+	MaxObservablesPerCall int `json:"maxObservablesPerCall,omitempty"`
+}
+
+// SweepSpec defines the parameter bindings of a sweep job. The bindings are
+// every set of parameters combined with every point of the ranges; a sweep
+// with only ranges scans their grid. Each binding is assigned to the
+// parameters of qc by name before it is sampled.
+type SweepSpec struct {
+	// Sets of parameter values, each bound in its own execution
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	Parameters []map[string]float64 `json:"parameters,omitempty"`
+
+	// Ranges of parameter values, scanned as a grid
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Ranges []SweepRange `json:"ranges,omitempty"`
+
+	// How many bindings execute at once
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=4
+	// +optional
+	Parallelism int `json:"parallelism,omitempty"`
+}
+
+// TomographySpec defines the state tomography of a job. The final
+// measurements of qc are replaced by measurements of the tomographed qubits
+// in every combination of the X, Y and Z bases, 3^n settings for n qubits,
+// and the density matrix of the qubits is reconstructed from their counts by
+// linear inversion.
+type TomographySpec struct {
+	// Qubits of qc whose state is reconstructed, in the order of the bits of
+	// the reconstructed state (the first is the least significant)
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=atomic
+	// +required
+	Qubits []int `json:"qubits"`
+
+	// How many measurement settings execute at once
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=4
+	// +optional
+	Parallelism int `json:"parallelism,omitempty"`
+}
+
+// SplitSpec splits the shots of a sampling job across its backend and other
+// equivalent backends, which run their shares at the same time to cut the
+// wall-clock time of large runs and spread the risk of a slow queue. Each
+// backend runs a share of the shots proportional to its weight, and their
+// counts are added up, so each weighs in the merged counts in proportion to
+// the shots it ran. Shares on local_simulator and ibm_local_testing run in an
+// execution pod, those on ibm_quantum and generic_http are submitted to their
+// provider with the job's credentials.
+type SplitSpec struct {
+	// Backends running a share of the shots alongside the job's backend
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=7
+	// +listType=atomic
+	// +required
+	Backends []SplitBackend `json:"backends"`
+
+	// Weight of the job's backend
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=1
+	// +optional
+	Weight int `json:"weight,omitempty"`
+
+	// Complete the job with the counts of the backends that completed when
+	// others failed, instead of failing the attempt
+	// +optional
+	AllowPartial bool `json:"allowPartial,omitempty"`
+}
+
+// SplitBackend is a backend running a share of the shots of a split job
+type SplitBackend struct {
+	// Backend running the share
+	// +required
+	Backend BackendSpec `json:"backend"`
+
+	// Weight of the backend
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=1
+	// +optional
+	Weight int `json:"weight,omitempty"`
+}
+
+// SweepRange is a parameter swept over evenly spaced values
+type SweepRange struct {
+	// Name of the parameter in the circuit
+	// +required
+	Name string `json:"name"`
+
+	// First value
+	// +required
+	Start float64 `json:"start"`
+
+	// Last value
+	// +required
+	Stop float64 `json:"stop"`
+
+	// Number of values from start to stop, both included
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +required
+	Steps int `json:"steps"`
+}
+
+// ShadowSpec defines a shadow run: the job's circuit executed on a second
+// backend alongside the primary run, usually a simulator, so that hardware
+// results can be continuously checked against a reference
+type ShadowSpec struct {
+	// Backend of the shadow run; local_simulator or ibm_local_testing
+	// +required
+	Backend BackendSpec `json:"backend"`
+}
+
+// VerifySpec defines the second run of a verify job, which must reproduce the
+// counts of the first exactly
+type VerifySpec struct {
+	// Seed of the simulator and transpiler in both runs
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=42
+	// +optional
+	Seed int64 `json:"seed,omitempty"`
+
+	// Qiskit version of the second run; defaults to that of the job
+	// +kubebuilder:validation:Pattern=`^v?[0-9]+\.[0-9]+(\.[0-9]+)?$`
+	// +optional
+	QiskitVersion string `json:"qiskitVersion,omitempty"`
+
+	// Executor image of the second run; defaults to the image its Qiskit
+	// version runs
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// ResourceRequirements defines pod resource requirements
+type ResourceRequirements struct {
+	// Resource requests
+	// +optional
+	Requests map[string]string `json:"requests,omitempty"`
+
+	// Resource limits
+	// +optional
+	Limits map[string]string `json:"limits,omitempty"`
+}
+
+// BudgetSpec defines cost constraints
+type BudgetSpec struct {
+	// Maximum cost for this job (e.g., "$10.00"). Jobs whose estimated cost
+	// exceeds it fail before they are submitted.
+	// +kubebuilder:validation:Pattern=`^\$?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MaxCost string `json:"maxCost,omitempty"`
+
+	// Cost center the job's spend is charged to in the QuantumCostReport.
+	// It is honored only if the job's namespace allows it, in its
+	// quantum.io/cost-center label or quantum.io/cost-centers annotation;
+	// otherwise the job is charged to the cost center of the label.
+	// +optional
+	CostCenter string `json:"costCenter,omitempty"`
+
+	// Billing account of the cost center the job's spend is billed to
+	// +optional
+	BillingAccount string `json:"billingAccount,omitempty"`
+}
+
+// OutputSpec defines where to store results
+type OutputSpec struct {
+	// Name of the output in status.outputs, by default its type. Outputs of
+	// the same type need names to tell them apart.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Output type (pvc, s3, gcs, azure_blob, oci, configmap, opensearch, elasticsearch)
+	// +kubebuilder:validation:Enum=pvc;s3;gcs;azure_blob;oci;configmap;opensearch;elasticsearch
+	// +required
+	Type string `json:"type"`
+
+	// Storage location (PVC name, bucket name, search index, etc.)
+	// +required
+	Location string `json:"location"`
+
+	// Path within the storage location. Results of s3 and pvc outputs are
+	// stored under <path>/<job name>/.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Result format (json, pickle, qpy, csv)
+	// +kubebuilder:validation:Enum=json;pickle;qpy;csv
+	// +optional
+	// +kubebuilder:default=json
+	Format string `json:"format,omitempty"`
+
+	// Compression applied to results before they are stored (none, gzip, zstd).
+	// Readers of the results decompress transparently.
+	// +kubebuilder:validation:Enum=none;gzip;zstd
+	// +optional
+	Compression string `json:"compression,omitempty"`
+
+	// Maximum number of distinct outcomes stored per object. Counts with more
+	// outcomes are split into shards stored alongside the results, for
+	// experiments whose counts exceed single-object size limits.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ShardSize int `json:"shardSize,omitempty"`
+
+	// Retention period. Objects of s3 and azure_blob outputs are tagged
+	// retention=<value> for lifecycle rules to expire them by (e.g., "30d").
+	// +optional
+	Retention string `json:"retention,omitempty"`
+
+	// Secret in the job's namespace holding the credentials of object store
+	// outputs: access-key-id, secret-access-key and optionally
+	// session-token, region and endpoint for s3, gcs and oci (which needs
+	// region and endpoint), account-name, account-key and optionally
+	// endpoint for azure_blob
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// NotificationSpec defines an endpoint told when a job finishes
+type NotificationSpec struct {
+	// Name of the notification in status.notifications, by default its
+	// type. Notifications of the same type need names to tell them apart.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Payload format: webhook posts the job's summary as JSON, slack posts a
+	// message to a Slack-compatible incoming webhook
+	// +kubebuilder:validation:Enum=webhook;slack
+	// +optional
+	// +kubebuilder:default=webhook
+	Type string `json:"type,omitempty"`
+
+	// URL the payload is posted to. Required unless the Secret holds it.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Secret in the job's namespace whose keys are sent as HTTP headers,
+	// e.g. Authorization, except url, which holds the URL for endpoints
+	// whose URL is itself the credential, like Slack webhooks
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Phases to notify about, all of Completed, Failed and Cancelled if
+	// empty. Failed jobs are notified about once no retries are left.
+	// +optional
+	Phases []string `json:"phases,omitempty"`
+}
+
+// CredentialsSpec defines authentication credentials
+// +kubebuilder:validation:XValidation:rule="!(has(self.volume) && has(self.secretsStore))",message="volume and secretsStore are mutually exclusive"
+type CredentialsSpec struct {
+	// Kubernetes Secret reference
+	// +optional
+	SecretRef *SecretRef `json:"secretRef,omitempty"`
+
+	// HashiCorp Vault path of a key/value secret the operator reads the
+	// backend credentials from instead of a Secret, with the same keys,
+	// e.g. secret/data/quantum/ibm. Needs the operator to be configured
+	// with a Vault server.
+	// +optional
+	VaultPath string `json:"vaultPath,omitempty"`
+
+	// Secrets to use instead of SecretRef when the job is routed to a
+	// given region, keyed by region (e.g., "eu-de", "eu-west-2")
+	// +optional
+	RegionalSecretRefs map[string]SecretRef `json:"regionalSecretRefs,omitempty"`
+
+	// Volume mounted read-only into the execution pod at
+	// /var/run/secrets/quantum/credentials, for credentials the operator
+	// itself never reads, such as a Secrets Store CSI driver volume
+	// +optional
+	Volume *CredentialsVolume `json:"volume,omitempty"`
+
+	// Credentials fetched by the Secrets Store CSI driver from Azure Key
+	// Vault, AWS Secrets Manager or GCP Secret Manager, mounted like Volume
+	// +optional
+	SecretsStore *SecretsStoreSpec `json:"secretsStore,omitempty"`
+
+	// Client certificate a generic_http backend is called with over mutual
+	// TLS, for on-premises control stacks that authenticate clients by
+	// certificate
+	// +optional
+	ClientCertificate *ClientCertificate `json:"clientCertificate,omitempty"`
+}
+
+// ClientCertificate is a client certificate and key for mutual TLS
+type ClientCertificate struct {
+	// Secret holding the PEM certificate chain in tls.crt and its key in
+	// tls.key, like a kubernetes.io/tls Secret, and optionally in ca.crt the
+	// CA certificates the server is verified against instead of the system
+	// roots
+	// +required
+	SecretRef SecretRef `json:"secretRef"`
+}
+
+// SecretsStoreSpec mounts credentials with the Secrets Store CSI driver
+type SecretsStoreSpec struct {
+	// SecretProviderClass in the job's namespace naming the provider and
+	// the secrets to fetch
+	// +required
+	SecretProviderClass string `json:"secretProviderClass"`
+
+	// Secret the provider authenticates with, for providers that do not
+	// use workload identity (e.g. an Azure service principal)
+	// +optional
+	NodePublishSecretRef *corev1.LocalObjectReference `json:"nodePublishSecretRef,omitempty"`
+}
+
+// SecuritySpec restricts what the job's executor may do, for circuit code
+// of tenants that are not trusted
+type SecuritySpec struct {
+	// Whether the executor may open network connections. Defaults to true,
+	// within the endpoints the operator allows executors to reach. When
+	// false the execution pod reaches nothing, not even cluster DNS: the
+	// job's circuit, inputs and packages must be available in the cluster,
+	// and results are not uploaded from the pod. Jobs of remote backends are
+	// submitted by the operator and unaffected.
+	// +optional
+	AllowNetworkEgress *bool `json:"allowNetworkEgress,omitempty"`
+}
+
+// CredentialsVolume is a volume providing credentials to the execution pod
+// +kubebuilder:validation:XValidation:rule="has(self.csi) != has(self.projected)",message="exactly one of csi or projected is required"
+type CredentialsVolume struct {
+	// CSI volume, e.g. of the Secrets Store CSI driver
+	// +optional
+	CSI *corev1.CSIVolumeSource `json:"csi,omitempty"`
+
+	// Projected volume, e.g. of service account tokens for workload identity
+	// +optional
+	Projected *corev1.ProjectedVolumeSource `json:"projected,omitempty"`
+}
+
+// SecretRef references a Kubernetes Secret
+type SecretRef struct {
+	// Secret name
+	// +required
+	Name string `json:"name"`
+
+	// Secret namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// BackendSelectionSpec defines backend selection preferences
+type BackendSelectionSpec struct {
+	// Selection weights for scoring backends
+	// +optional
+	Weights *BackendWeights `json:"weights,omitempty"`
+
+	// Preferred backends (ordered by preference)
+	// +optional
+	PreferredBackends []string `json:"preferredBackends,omitempty"`
+
+	// Excluded backends
+	// +optional
+	ExcludedBackends []string `json:"excludedBackends,omitempty"`
+
+	// Allow fallback to simulator
 	// +optional
 	AllowFallback bool `json:"allowFallback,omitempty"`
 
-	// Fallback to simulator on errors
+	// Fallback to simulator on errors
+	// +optional
+	FallbackToSimulator bool `json:"fallbackToSimulator,omitempty"`
+}
+
+// BackendWeights defines scoring weights for backend selection
+type BackendWeights struct {
+	// Cost weight (0.0-1.0)
+	// +optional
+	Cost float64 `json:"cost,omitempty"`
+
+	// Queue time weight (0.0-1.0)
+	// +optional
+	QueueTime float64 `json:"queueTime,omitempty"`
+
+	// Capability weight (0.0-1.0)
+	// +optional
+	Capability float64 `json:"capability,omitempty"`
+
+	// Availability weight (0.0-1.0)
+	// +optional
+	Availability float64 `json:"availability,omitempty"`
+}
+
+// DeduplicationSpec defines how identical submissions are detected and handled
+type DeduplicationSpec struct {
+	// Action taken when an identical job is found (warn, link, dedupe)
+	// +kubebuilder:validation:Enum=warn;link;dedupe
+	// +optional
+	// +kubebuilder:default=warn
+	Policy string `json:"policy,omitempty"`
+
+	// Window in which an earlier job with the same circuit hash, backend and
+	// shots is considered a duplicate (e.g., "10m")
+	// +optional
+	// +kubebuilder:default="10m"
+	Window string `json:"window,omitempty"`
+}
+
+// PlacementSpec constrains where a job may execute
+type PlacementSpec struct {
+	// Provider regions the job may be routed to. Jobs that cannot run in
+	// one of these regions are rejected rather than routed elsewhere.
+	// +optional
+	AllowedRegions []string `json:"allowedRegions,omitempty"`
+
+	// Spoke cluster the job is dispatched to. The operator in the spoke
+	// validates, schedules and runs a copy of the job, and its status is
+	// mirrored back; unset runs the job in this cluster.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// SchedulingSpec places the execution pod on nodes of the cluster. The
+// fields are passed through to the pod, on top of the node selectors and
+// tolerations the operator adds itself.
+type SchedulingSpec struct {
+	// Node labels the execution pod selects; they take precedence over the
+	// operator's executor and GPU node selectors
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Taints of the nodes the execution pod tolerates
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Node and pod affinity of the execution pod
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// RuntimeClass the execution pod runs with, e.g. "nvidia"
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// PriorityClass of the execution pod
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
+
+// RetryPolicy controls how many times and how soon a failed job is retried
+type RetryPolicy struct {
+	// Most retries of the job
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=3
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// Backoff between retries: fixed waits initialDelay before each retry,
+	// exponential doubles the wait after each retry up to maxDelay
+	// +kubebuilder:validation:Enum=fixed;exponential
+	// +kubebuilder:default=exponential
+	// +optional
+	Backoff string `json:"backoff,omitempty"`
+
+	// Wait before the first retry
+	// +kubebuilder:default="10s"
+	// +optional
+	InitialDelay *metav1.Duration `json:"initialDelay,omitempty"`
+
+	// Longest wait between retries
+	// +kubebuilder:default="5m"
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+
+	// Failures that are retried; others fail the job for good. All failures
+	// are retried if empty.
+	// +listType=set
+	// +optional
+	RetryOn []RetryableFailure `json:"retryOn,omitempty"`
+
+	// Archive the diagnostics of each failed attempt, its status, execution
+	// pod and the tail of its executor logs, before retrying it. Needs the
+	// operator's --archive-url; attempts whose diagnostics cannot be
+	// archived keep their failed execution.
+	// +optional
+	PreserveDiagnostics bool `json:"preserveDiagnostics,omitempty"`
+}
+
+// RetryableFailure is a class of failure a RetryPolicy may retry
+// +kubebuilder:validation:Enum=podFailure;backendUnavailable;validationTimeout
+type RetryableFailure string
+
+// Failure classes a RetryPolicy may retry
+const (
+	// RetryOnPodFailure retries attempts whose execution failed, in the
+	// execution pod or at the provider
+	RetryOnPodFailure RetryableFailure = "podFailure"
+	// RetryOnBackendUnavailable retries attempts that could not be scheduled
+	// onto a backend
+	RetryOnBackendUnavailable RetryableFailure = "backendUnavailable"
+	// RetryOnValidationTimeout retries attempts whose circuit could not be
+	// validated because the validation service stayed unavailable
+	RetryOnValidationTimeout RetryableFailure = "validationTimeout"
+)
+
+// QiskitJobPhase is a phase of the job lifecycle
+// +kubebuilder:validation:Enum=Pending;PendingApproval;Validating;Scheduling;Scheduled;Running;Retrying;Completed;Failed;Cancelled
+type QiskitJobPhase string
+
+// Phases of the job lifecycle
+const (
+	QiskitJobPending QiskitJobPhase = "Pending"
+	// QiskitJobPendingApproval holds jobs above the approval tier until
+	// they are approved
+	QiskitJobPendingApproval QiskitJobPhase = "PendingApproval"
+	QiskitJobValidating      QiskitJobPhase = "Validating"
+	QiskitJobScheduling      QiskitJobPhase = "Scheduling"
+	QiskitJobScheduled       QiskitJobPhase = "Scheduled"
+	QiskitJobRunning         QiskitJobPhase = "Running"
+	QiskitJobRetrying        QiskitJobPhase = "Retrying"
+	QiskitJobCompleted       QiskitJobPhase = "Completed"
+	QiskitJobFailed          QiskitJobPhase = "Failed"
+	QiskitJobCancelled       QiskitJobPhase = "Cancelled"
+)
+
+// Finished reports whether the phase is terminal: Completed, Failed or
+// Cancelled
+func (p QiskitJobPhase) Finished() bool {
+	return p == QiskitJobCompleted || p == QiskitJobFailed || p == QiskitJobCancelled
+}
+
+// RerunAnnotation asks the operator to run a finished job again. The spec of
+// a finished job can only be edited along with it; the operator removes it
+// once the job is back in Pending.
+const RerunAnnotation = "quantum.io/rerun"
+
+// QiskitJobStatus defines the observed state of QiskitJob.
+type QiskitJobStatus struct {
+	// Phase of the job lifecycle
+	// +optional
+	Phase QiskitJobPhase `json:"phase,omitempty"`
+
+	// Generation of the spec the job last ran, or is running. A finished
+	// job runs again, with its current spec, when it is annotated with
+	// RerunAnnotation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Version of the operator phase machine that last wrote this status.
+	// Statuses without it were written by operators predating versioning.
+	// +optional
+	PhaseMachineVersion int `json:"phaseMachineVersion,omitempty"`
+
+	// Human-readable message about the current state
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Job start time
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Job completion time
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Selected backend for execution
+	// +optional
+	SelectedBackend string `json:"selectedBackend,omitempty"`
+
+	// Provider region the job was routed to
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Spoke cluster the job was dispatched to
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// Sandbox namespace the job's executors run in
+	// +optional
+	SandboxNamespace string `json:"sandboxNamespace,omitempty"`
+
+	// Original backend if fallback was used
+	// +optional
+	OriginalBackend string `json:"originalBackend,omitempty"`
+
+	// Whether fallback to simulator was used
+	// +optional
+	FallbackUsed bool `json:"fallbackUsed,omitempty"`
+
+	// Backend information
+	// +optional
+	BackendInfo *BackendInfo `json:"backendInfo,omitempty"`
+
+	// Scores of the candidate backends of spec.backendSelection and the one
+	// the job runs on
+	// +optional
+	BackendSelection *BackendSelectionStatus `json:"backendSelection,omitempty"`
+
+	// Estimated cost for this job
+	// +optional
+	EstimatedCost string `json:"estimatedCost,omitempty"`
+
+	// Actual cost after execution, including the job's share of the cost
+	// of a dedicated session
+	// +optional
+	ActualCost string `json:"actualCost,omitempty"`
+
+	// Part of the actual cost of a finished job already added to the
+	// month-to-date spend of its namespace's QuantumQuotas, so that deleting
+	// the job leaves the spend counted
+	// +optional
+	QuotaCharge string `json:"quotaCharge,omitempty"`
+
+	// Cost center the job's spend is charged to, picked from those its
+	// namespace's labels allow when the job is scheduled
+	// +optional
+	CostCenter string `json:"costCenter,omitempty"`
+
+	// Part of the actual cost of a finished job already charged in the
+	// QuantumCostReport, so that deleting the job leaves the charge in place
+	// +optional
+	ReportedCost string `json:"reportedCost,omitempty"`
+
+	// Position in its namespace's queue of a job waiting for an execution
+	// slot, or in the provider's queue of a remote job, 1 being next
+	// +optional
+	QueuePosition *int `json:"queuePosition,omitempty"`
+
+	// Estimated start time, from the predicted queue wait of the backend,
+	// while the job waits for an execution slot, or as the provider of a
+	// remote job expects it
+	// +optional
+	EstimatedStartTime *metav1.Time `json:"estimatedStartTime,omitempty"`
+
+	// State of a remote job on its provider, Queued or Running while it runs,
+	// refreshed at every status poll
+	// +optional
+	ProviderPhase string `json:"providerPhase,omitempty"`
+
+	// When a job in the Scheduled phase may start, at the opening of the
+	// next allowed execution window of its calendars
+	// +optional
+	NextEligibleTime *metav1.Time `json:"nextEligibleTime,omitempty"`
+
+	// Convergence of the optimizer loop of the last attempt
+	// +optional
+	Optimization *OptimizationStatus `json:"optimization,omitempty"`
+
+	// How the estimator of the last attempt split the observables
+	// +optional
+	Estimation *EstimationStatus `json:"estimation,omitempty"`
+
+	// Commit of a git circuit source that the current attempt checked out
+	// +optional
+	CircuitCommit string `json:"circuitCommit,omitempty"`
+
+	// Data inputs of the current attempt as the executor found them
+	// +optional
+	Inputs []InputStatus `json:"inputs,omitempty"`
+
+	// IBM Quantum job ID
+	// +optional
+	JobID string `json:"jobId,omitempty"`
+
+	// Client request ID of a submission to the provider in progress,
+	// recorded before submitting so that a submission whose job ID was not
+	// recorded is looked up rather than submitted again
+	// +optional
+	SubmissionID string `json:"submissionId,omitempty"`
+
+	// Provider session the job ran in
+	// +optional
+	SessionID string `json:"sessionId,omitempty"`
+
+	// Cost the provider billed for the whole dedicated session, reported on
+	// the job that closed it. It is amortized into the ActualCost of every
+	// job that ran in the session.
+	// +optional
+	SessionCost string `json:"sessionCost,omitempty"`
+
+	// Results information
+	// +optional
+	Results *ResultsInfo `json:"results,omitempty"`
+
+	// Export of the results to each of spec.outputs, in order
+	// +optional
+	Outputs []OutputStatus `json:"outputs,omitempty"`
+
+	// Tail of the logs of the last execution that finished, kept after its
+	// pod is garbage-collected
+	// +optional
+	Logs *ExecutorLogs `json:"logs,omitempty"`
+
+	// Delivery of the notifications of the operator and of
+	// spec.notifications once the job finished
+	// +optional
+	Notifications []NotificationStatus `json:"notifications,omitempty"`
+
+	// Execution metrics
+	// +optional
+	Metrics *ExecutionMetrics `json:"metrics,omitempty"`
+
+	// Retry information
+	// +optional
+	RetryCount int `json:"retryCount,omitempty"`
+
+	// Time the next retry starts, after the backoff of the job's retry policy
+	// +optional
+	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty"`
+
+	// Where the diagnostics of failed attempts were archived before they
+	// were retried, oldest attempt first
+	// +optional
+	AttemptDiagnostics []string `json:"attemptDiagnostics,omitempty"`
+
+	// Time of the last heartbeat seen from the executor of the current attempt
+	// +optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
+	// Circuit metadata (from validation)
+	// +optional
+	CircuitMetadata *CircuitMetadata `json:"circuitMetadata,omitempty"`
+
+	// Qiskit release line resolved from the compatibility matrix
+	// +optional
+	QiskitVersion string `json:"qiskitVersion,omitempty"`
+
+	// Executor image the current attempt runs, which a QuantumRuntimeVersion
+	// may roll out gradually
+	// +optional
+	ExecutorImage string `json:"executorImage,omitempty"`
+
+	// Name of an earlier identical job this job duplicates
+	// +optional
+	DuplicateOf string `json:"duplicateOf,omitempty"`
+
+	// Link to the run the job was logged as in the experiment tracker
+	// +optional
+	TrackingURL string `json:"trackingUrl,omitempty"`
+
+	// Where the job came from: the jobs it reruns or depends on, and the
+	// experiment, template and session it ran under
+	// +optional
+	Lineage *JobLineage `json:"lineage,omitempty"`
+
+	// Shadow run of the current attempt
+	// +optional
+	Shadow *ShadowStatus `json:"shadow,omitempty"`
+
+	// Verification run of the current attempt of a verify job
+	// +optional
+	Verification *VerificationStatus `json:"verification,omitempty"`
+
+	// Progress of the bindings of a sweep job's current attempt
+	// +optional
+	Sweep *SweepStatus `json:"sweep,omitempty"`
+
+	// Progress of the measurement settings of a tomography job's current
+	// attempt
+	// +optional
+	Tomography *TomographyStatus `json:"tomography,omitempty"`
+
+	// Progress of the backends of a split job's current attempt
+	// +optional
+	Split *SplitStatus `json:"split,omitempty"`
+
+	// Approval of a job above the operator's approval tier
+	// +optional
+	Approval *ApprovalStatus `json:"approval,omitempty"`
+
+	// Conditions represent the current state of the QiskitJob resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// JobLineage records the edges of a job's provenance graph. Each is read
+// from the job's labels, annotations or spec, so it can also be selected on.
+type JobLineage struct {
+	// Job this job reruns, from its quantum.io/rerun-of label
+	// +optional
+	RerunOf string `json:"rerunOf,omitempty"`
+
+	// Jobs whose results this job depends on, from its quantum.io/depends-on
+	// annotation, e.g. the earlier steps of its workflow
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// Experiment the job belongs to, from its quantum.io/experiment label
+	// +optional
+	MemberOfExperiment string `json:"memberOfExperiment,omitempty"`
+
+	// QiskitJobTemplate the job was instantiated from, as
+	// <name>/<generation> once applied
+	// +optional
+	UsedTemplate string `json:"usedTemplate,omitempty"`
+
+	// QiskitSession the job ran in
+	// +optional
+	UsedSession string `json:"usedSession,omitempty"`
+}
+
+// ApprovalStatus records why a job needs approval before it runs, and the
+// decision on it
+type ApprovalStatus struct {
+	// Why the job needs approval, e.g. the tier it exceeds
+	Reason string `json:"reason"`
+
+	// When the job started waiting for approval
+	// +optional
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty"`
+
+	// Decision on the job, empty while it waits
+	// +kubebuilder:validation:Enum=Approved;Denied
+	// +optional
+	Decision string `json:"decision,omitempty"`
+
+	// Who decided: the user who annotated the job, or the approval webhook
+	// +optional
+	DecidedBy string `json:"decidedBy,omitempty"`
+
+	// When the decision was made
+	// +optional
+	DecidedAt *metav1.Time `json:"decidedAt,omitempty"`
+
+	// Why the job was approved or denied, if the approver said
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackendInfo contains information about the selected backend
+type BackendInfo struct {
+	// Backend name
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Backend version
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Number of qubits
+	// +optional
+	Qubits int `json:"qubits,omitempty"`
+
+	// Median error rate of the two-qubit gates
+	// +optional
+	GateError float64 `json:"gateError,omitempty"`
+
+	// Median readout error rate of the qubits
+	// +optional
+	ReadoutError float64 `json:"readoutError,omitempty"`
+
+	// When the backend was last calibrated, as of the attempt's scheduling
+	// +optional
+	CalibrationTimestamp *metav1.Time `json:"calibrationTimestamp,omitempty"`
+}
+
+// BackendSelectionStatus records how the job's backend was chosen
+type BackendSelectionStatus struct {
+	// Backend the job runs on, the candidate with the highest score unless
+	// the job targets another
+	Backend string `json:"backend"`
+
+	// Set when the backend was picked by the quantum.io/target-backend
+	// annotation rather than by score
+	// +optional
+	Targeted bool `json:"targeted,omitempty"`
+
+	// Scores of the candidates, best first
+	// +optional
+	Scores []BackendScore `json:"scores,omitempty"`
+}
+
+// BackendScore breaks down the score of a candidate backend. Each part is
+// between 0 and 1, higher being better, relative to the other candidates.
+type BackendScore struct {
+	// Candidate backend
+	Backend string `json:"backend"`
+
+	// Score of the estimated cost of the job on the backend
+	// +optional
+	Cost float64 `json:"cost"`
+
+	// Score of the predicted queue wait
+	// +optional
+	QueueTime float64 `json:"queueTime"`
+
+	// Score of the backend's two-qubit gate error
+	// +optional
+	Capability float64 `json:"capability"`
+
+	// Score of whether the backend accepts jobs
+	// +optional
+	Availability float64 `json:"availability"`
+
+	// Weighted total of the parts
+	// +optional
+	Total float64 `json:"total"`
+
+	// Why the backend cannot run the job, if it cannot
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ResultsInfo contains information about job results
+type ResultsInfo struct {
+	// Location of the results
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Number of shots executed
+	// +optional
+	Shots int `json:"shots,omitempty"`
+
+	// Total execution time
+	// +optional
+	ExecutionTime string `json:"executionTime,omitempty"`
+
+	// Quantum execution time (IBM specific)
+	// +optional
+	QuantumTime string `json:"quantumTime,omitempty"`
+
+	// Success rate (0.0-1.0)
+	// +optional
+	SuccessRate float64 `json:"successRate,omitempty"`
+
+	// Number of distinct outcomes measured
+	// +optional
+	Outcomes int `json:"outcomes,omitempty"`
+
+	// Outcome measured most often; ties go to the lowest bitstring
+	// +optional
+	MostLikelyOutcome string `json:"mostLikelyOutcome,omitempty"`
+
+	// Shannon entropy of the measured outcome distribution, in bits: 0 for a
+	// single outcome, n for n qubits measured uniformly
+	// +optional
+	Entropy float64 `json:"entropy,omitempty"`
+
+	// Normalized probabilities of the most likely outcomes, most likely
+	// first. Full distributions stay in the exported results.
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Probabilities []OutcomeProbability `json:"probabilities,omitempty"`
+
+	// Expectation values the estimator primitive measured, in the order of
+	// the observables. Left out for more than 1000 observables, whose values
+	// stay in the exported results.
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	ExpectationValues []ExpectationValue `json:"expectationValues,omitempty"`
+
+	// Digest of the exported results document, "sha256:<hex>" over the
+	// document in compact JSON with its counts merged
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// Signature of the digest by the operator's results signing key, base64
+	// encoded, so the results can later be proven to be what the operator
+	// produced
+	// +optional
+	Signature string `json:"signature,omitempty"`
+
+	// Key the signature was made with, by fingerprint
 	// +optional
-	FallbackToSimulator bool `json:"fallbackToSimulator,omitempty"`
+	SigningKey string `json:"signingKey,omitempty"`
+
+	// Error suppression and mitigation the backend reported it applied,
+	// e.g. "dynamical-decoupling:XY4" or "gate-twirling". Requested
+	// mitigations the backend does not support are left out.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Mitigations []string `json:"mitigations,omitempty"`
+
+	// Files the job produced: the results document in each output, the
+	// executor's logs and the files circuit code wrote next to the results
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	Artifacts []ResultArtifact `json:"artifacts,omitempty"`
 }
 
-// BackendWeights defines scoring weights for backend selection
-type BackendWeights struct {
-	// Cost weight (0.0-1.0)
+// Types of result artifacts
+const (
+	// ArtifactResults is the results document stored in an output
+	ArtifactResults = "results"
+	// ArtifactLogs is the tail of the executor's logs
+	ArtifactLogs = "logs"
+	// ArtifactFile is a file circuit code wrote next to the results
+	ArtifactFile = "file"
+)
+
+// ResultArtifact is a file a job produced
+type ResultArtifact struct {
+	// Name of the file, relative to the job's results
+	Name string `json:"name"`
+
+	// Type of the artifact: results, logs or file
+	// +kubebuilder:validation:Enum=results;logs;file
+	Type string `json:"type"`
+
+	// URI of the file
 	// +optional
-	Cost float64 `json:"cost,omitempty"`
+	Location string `json:"location,omitempty"`
 
-	// Queue time weight (0.0-1.0)
+	// Size of the file in bytes, if known
 	// +optional
-	QueueTime float64 `json:"queueTime,omitempty"`
+	Size int64 `json:"size,omitempty"`
+}
 
-	// Capability weight (0.0-1.0)
+// ExecutorLogs references the tail of an execution's logs the operator kept
+type ExecutorLogs struct {
+	// ConfigMap "<job name>-logs" holding the tail under executor.log
+	ConfigMap string `json:"configMap"`
+
+	// Execution the logs are of
 	// +optional
-	Capability float64 `json:"capability,omitempty"`
+	Execution string `json:"execution,omitempty"`
 
-	// Availability weight (0.0-1.0)
+	// Size of the tail in bytes
 	// +optional
-	Availability float64 `json:"availability,omitempty"`
+	Size int64 `json:"size,omitempty"`
+
+	// Whether earlier logs were cut off to fit the tail
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
 }
 
-// QiskitJobStatus defines the observed state of QiskitJob.
-type QiskitJobStatus struct {
-	// Phase of the job lifecycle
+// ExpectationValue is the estimated expectation value of one observable
+type ExpectationValue struct {
+	// Name of the observable, or its index if it has none
+	Observable string `json:"observable"`
+
+	// Expectation value
+	Value float64 `json:"value"`
+
+	// Standard error of the estimate
 	// +optional
-	Phase string `json:"phase,omitempty"`
+	StandardError float64 `json:"standardError,omitempty"`
+}
 
-	// Human-readable message about the current state
+// OutcomeProbability is the share of the measured shots of one outcome
+type OutcomeProbability struct {
+	// Measured bitstring
+	Outcome string `json:"outcome"`
+
+	// Share of the measured shots (0.0-1.0)
+	Probability float64 `json:"probability"`
+}
+
+// Export states of an output
+const (
+	// OutputExported means the operator wrote the results to the output
+	OutputExported = "Exported"
+	// OutputFailed means the results could not be written to the output
+	OutputFailed = "Failed"
+	// OutputDelegated means the executor writes the results to the output
+	// itself, as it does for pvc outputs
+	OutputDelegated = "Delegated"
+)
+
+// OutputStatus reports the export of the results to one output
+type OutputStatus struct {
+	// Name of the output
+	Name string `json:"name"`
+
+	// Type of the output
+	Type string `json:"type"`
+
+	// Location of the results in the output
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Export state: Exported, Failed or Delegated
+	// +kubebuilder:validation:Enum=Exported;Failed;Delegated
+	State string `json:"state"`
+
+	// Why the export failed
 	// +optional
 	Message string `json:"message,omitempty"`
+}
 
-	// Job start time
+// InputStatus reports a data input of the current attempt
+type InputStatus struct {
+	// Name of the input
+	Name string `json:"name"`
+
+	// Path of the input in the executor
+	Path string `json:"path"`
+
+	// Hex-encoded SHA-256 digest of the file, or of the sha256sum listing of
+	// the files of a directory sorted by path
+	SHA256 string `json:"sha256"`
+}
+
+// Delivery states of a notification
+const (
+	// NotificationSent means the endpoint accepted the notification
+	NotificationSent = "Sent"
+	// NotificationPending means delivery failed and is retried
+	NotificationPending = "Pending"
+	// NotificationFailed means delivery failed for good
+	NotificationFailed = "Failed"
+)
+
+// NotificationStatus reports the delivery of one notification
+type NotificationStatus struct {
+	// Name of the notification
+	Name string `json:"name"`
+
+	// Phase of the job the notification is about
+	Phase QiskitJobPhase `json:"phase"`
+
+	// Delivery state: Sent, Pending or Failed
+	// +kubebuilder:validation:Enum=Sent;Pending;Failed
+	State string `json:"state"`
+
+	// Delivery attempts made
 	// +optional
-	StartTime *metav1.Time `json:"startTime,omitempty"`
+	Attempts int32 `json:"attempts,omitempty"`
 
-	// Job completion time
+	// Time of the last delivery attempt
 	// +optional
-	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
 
-	// Selected backend for execution
+	// Why the last delivery attempt failed
 	// +optional
-	SelectedBackend string `json:"selectedBackend,omitempty"`
+	Message string `json:"message,omitempty"`
+}
 
-	// Original backend if fallback was used
+// ExecutionMetrics contains detailed execution metrics
+type ExecutionMetrics struct {
+	// Time from submission to start
 	// +optional
-	OriginalBackend string `json:"originalBackend,omitempty"`
+	SubmissionTime string `json:"submissionTime,omitempty"`
 
-	// Whether fallback to simulator was used
+	// Time spent in queue
 	// +optional
-	FallbackUsed bool `json:"fallbackUsed,omitempty"`
+	QueueTime string `json:"queueTime,omitempty"`
 
-	// Backend information
+	// Time spent executing
 	// +optional
-	BackendInfo *BackendInfo `json:"backendInfo,omitempty"`
+	ExecutionTime string `json:"executionTime,omitempty"`
 
-	// Estimated cost for this job
+	// Quantum time the provider billed for the job
 	// +optional
-	EstimatedCost string `json:"estimatedCost,omitempty"`
+	QuantumTime string `json:"quantumTime,omitempty"`
 
-	// Actual cost after execution
+	// Total time from submission to completion
 	// +optional
-	ActualCost string `json:"actualCost,omitempty"`
+	TotalTime string `json:"totalTime,omitempty"`
 
-	// Current position in backend queue
+	// Memory usage
 	// +optional
-	QueuePosition *int `json:"queuePosition,omitempty"`
+	MemoryUsage string `json:"memoryUsage,omitempty"`
 
-	// Estimated start time based on queue
+	// CPU usage
 	// +optional
-	EstimatedStartTime *metav1.Time `json:"estimatedStartTime,omitempty"`
+	CPUUsage string `json:"cpuUsage,omitempty"`
+}
 
-	// IBM Quantum job ID
+// ShadowStatus reports a job's shadow run and how far its results diverge
+// from the primary run
+type ShadowStatus struct {
+	// Backend the shadow run executed on
 	// +optional
-	JobID string `json:"jobId,omitempty"`
+	Backend string `json:"backend,omitempty"`
 
-	// Results information
+	// Execution pod of the shadow run
 	// +optional
-	Results *ResultsInfo `json:"results,omitempty"`
+	PodName string `json:"podName,omitempty"`
 
-	// Execution metrics
+	// Phase of the shadow run (Running, Completed, Failed)
 	// +optional
-	Metrics *ExecutionMetrics `json:"metrics,omitempty"`
+	Phase QiskitJobPhase `json:"phase,omitempty"`
 
-	// Retry information
+	// Total variation distance between the primary and shadow outcome
+	// distributions, from 0 (identical) to 1 (disjoint)
 	// +optional
-	RetryCount int `json:"retryCount,omitempty"`
+	TotalVariationDistance string `json:"totalVariationDistance,omitempty"`
 
-	// Next retry time
+	// Hellinger fidelity between the primary and shadow outcome
+	// distributions, from 0 (disjoint) to 1 (identical)
 	// +optional
-	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty"`
+	HellingerFidelity string `json:"hellingerFidelity,omitempty"`
 
-	// Circuit metadata (from validation)
+	// Why the shadow run could not be compared, if it could not
 	// +optional
-	CircuitMetadata *CircuitMetadata `json:"circuitMetadata,omitempty"`
+	Message string `json:"message,omitempty"`
+}
 
-	// Conditions represent the current state of the QiskitJob resource
-	// +listType=map
-	// +listMapKey=type
+// VerificationStatus reports the second run of a verify job
+type VerificationStatus struct {
+	// Execution pod of the verification run
 	// +optional
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	PodName string `json:"podName,omitempty"`
+
+	// Qiskit release line the verification run used
+	// +optional
+	QiskitVersion string `json:"qiskitVersion,omitempty"`
+
+	// Executor image the verification run ran
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Phase of the verification run (Running, Completed, Failed)
+	// +optional
+	Phase QiskitJobPhase `json:"phase,omitempty"`
+
+	// Outcomes whose counts differ between the runs, empty when they match
+	// +optional
+	Diff string `json:"diff,omitempty"`
+
+	// Why the verification run failed or could not be compared, if so
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
-// BackendInfo contains information about the selected backend
-type BackendInfo struct {
-	// Backend name
+// SweepStatus reports the bindings of the current attempt of a sweep job
+type SweepStatus struct {
+	// Attempt the bindings are executed for
+	Attempt int `json:"attempt"`
+
+	// Number of bindings
+	Total int `json:"total"`
+
+	// Bindings whose executions completed
 	// +optional
-	Name string `json:"name,omitempty"`
+	Completed int `json:"completed,omitempty"`
 
-	// Backend version
+	// Bindings whose executions are pending or running
 	// +optional
-	Version string `json:"version,omitempty"`
+	Running int `json:"running,omitempty"`
 
-	// Number of qubits
+	// Bindings whose executions failed
 	// +optional
-	Qubits int `json:"qubits,omitempty"`
+	Failed int `json:"failed,omitempty"`
 
-	// Average gate error rate
+	// Progress of each binding, in the order of the sweep
+	// +listType=map
+	// +listMapKey=index
 	// +optional
-	GateError float64 `json:"gateError,omitempty"`
+	Bindings []SweepBindingStatus `json:"bindings,omitempty"`
+}
 
-	// Average readout error rate
+// SweepBindingStatus reports the execution of one binding of a sweep
+type SweepBindingStatus struct {
+	// Index of the binding in the sweep, from 0
+	Index int `json:"index"`
+
+	// Phase of the binding (Pending, Running, Completed, Failed)
 	// +optional
-	ReadoutError float64 `json:"readoutError,omitempty"`
+	Phase QiskitJobPhase `json:"phase,omitempty"`
+
+	// Execution running the binding, once started
+	// +optional
+	Execution string `json:"execution,omitempty"`
+
+	// Why the binding failed, if it did
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
-// ResultsInfo contains information about job results
-type ResultsInfo struct {
-	// Location of the results
+// TomographyStatus reports the measurement settings of the current attempt
+// of a tomography job
+type TomographyStatus struct {
+	// Attempt the settings are executed for
+	Attempt int `json:"attempt"`
+
+	// Number of settings
+	Total int `json:"total"`
+
+	// Settings whose executions completed
 	// +optional
-	Location string `json:"location,omitempty"`
+	Completed int `json:"completed,omitempty"`
 
-	// Number of shots executed
+	// Settings whose executions are pending or running
 	// +optional
-	Shots int `json:"shots,omitempty"`
+	Running int `json:"running,omitempty"`
 
-	// Total execution time
+	// Settings whose executions failed
 	// +optional
-	ExecutionTime string `json:"executionTime,omitempty"`
+	Failed int `json:"failed,omitempty"`
 
-	// Quantum execution time (IBM specific)
+	// Purity of the reconstructed state, Tr(rho^2), once it is reconstructed
 	// +optional
-	QuantumTime string `json:"quantumTime,omitempty"`
+	Purity string `json:"purity,omitempty"`
 
-	// Success rate (0.0-1.0)
+	// Progress of each setting
+	// +listType=map
+	// +listMapKey=basis
 	// +optional
-	SuccessRate float64 `json:"successRate,omitempty"`
+	Settings []TomographySettingStatus `json:"settings,omitempty"`
 }
 
-// ExecutionMetrics contains detailed execution metrics
-type ExecutionMetrics struct {
-	// Time from submission to start
+// TomographySettingStatus reports the execution of one measurement setting
+// of a tomography job
+type TomographySettingStatus struct {
+	// Basis each tomographed qubit is measured in, e.g. "XZ": the last
+	// letter is that of the first qubit
+	Basis string `json:"basis"`
+
+	// Phase of the setting (Pending, Running, Completed, Failed)
 	// +optional
-	SubmissionTime string `json:"submissionTime,omitempty"`
+	Phase QiskitJobPhase `json:"phase,omitempty"`
 
-	// Time spent in queue
+	// Execution running the setting, once started
 	// +optional
-	QueueTime string `json:"queueTime,omitempty"`
+	Execution string `json:"execution,omitempty"`
 
-	// Time spent executing
+	// Why the setting failed, if it did
 	// +optional
-	ExecutionTime string `json:"executionTime,omitempty"`
+	Message string `json:"message,omitempty"`
+}
 
-	// Total time from submission to completion
+// SplitStatus reports the executions of the current attempt of a split job
+type SplitStatus struct {
+	// Attempt the shares are executed for
+	Attempt int `json:"attempt"`
+
+	// Number of backends
+	Total int `json:"total"`
+
+	// Backends whose executions completed
 	// +optional
-	TotalTime string `json:"totalTime,omitempty"`
+	Completed int `json:"completed,omitempty"`
 
-	// Memory usage
+	// Backends whose executions are pending or running
 	// +optional
-	MemoryUsage string `json:"memoryUsage,omitempty"`
+	Running int `json:"running,omitempty"`
 
-	// CPU usage
+	// Backends whose executions failed
 	// +optional
-	CPUUsage string `json:"cpuUsage,omitempty"`
+	Failed int `json:"failed,omitempty"`
+
+	// Shots merged into the job's counts, once they are merged
+	// +optional
+	Shots int `json:"shots,omitempty"`
+
+	// Progress of each backend's share, the job's backend first
+	// +listType=map
+	// +listMapKey=index
+	// +optional
+	Parts []SplitPartStatus `json:"parts,omitempty"`
+}
+
+// SplitPartStatus reports the execution of one backend's share of a split
+// job
+type SplitPartStatus struct {
+	// Index of the share, 0 for the job's backend and i+1 for
+	// spec.split.backends[i]
+	Index int `json:"index"`
+
+	// Backend running the share
+	Backend string `json:"backend"`
+
+	// Shots of the share
+	Shots int `json:"shots"`
+
+	// Phase of the share (Pending, Running, Completed, Failed)
+	// +optional
+	Phase QiskitJobPhase `json:"phase,omitempty"`
+
+	// Execution running the share, once started: its batch Job, or the
+	// provider's job ID on a remote backend
+	// +optional
+	Execution string `json:"execution,omitempty"`
+
+	// Why the share failed, if it did
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// OptimizationStatus records how the optimizer loop converged
+type OptimizationStatus struct {
+	// Optimizer that ran
+	Method string `json:"method"`
+
+	// Iterations run
+	Iterations int `json:"iterations"`
+
+	// Whether the optimizer met its tolerance before running out of iterations
+	Converged bool `json:"converged"`
+
+	// Lowest expectation value found
+	Value float64 `json:"value"`
+
+	// Parameter values at the lowest expectation value, by parameter name
+	// +optional
+	Parameters map[string]float64 `json:"parameters,omitempty"`
+
+	// Expectation value after every iteration, in order
+	// +optional
+	History []float64 `json:"history,omitempty"`
+}
+
+// EstimationStatus records how the estimator split a job's observables;
+// their expectation values are in the job's results
+type EstimationStatus struct {
+	// Observables estimated
+	Observables int `json:"observables"`
+
+	// Estimator calls the observables were split into
+	Calls int `json:"calls"`
 }
 
 // CircuitMetadata contains metadata about the circuit
@@ -484,14 +2338,120 @@ type CircuitMetadata struct {
 	// Gate types and counts
 	// +optional
 	GateTypes map[string]int `json:"gateTypes,omitempty"`
+
+	// The circuit as transpiled for the backend, when published
+	// +optional
+	Transpiled *TranspiledCircuitMetadata `json:"transpiled,omitempty"`
+
+	// How the bitstrings of the counts map onto the qubits the circuit ran
+	// on, for circuits the executor transpiles
+	// +optional
+	Layout *QubitLayout `json:"layout,omitempty"`
+
+	// Shape of the circuit after transpilation with the job's optimization
+	// level and transpiler options
+	// +optional
+	Transpilation *TranspilationMetadata `json:"transpilation,omitempty"`
+}
+
+// TranspilationMetadata is the shape of a transpiled circuit, so the effect
+// of optimization levels and transpiler options can be compared
+type TranspilationMetadata struct {
+	// What transpiled the circuit: "executor" for the circuit that ran, or
+	// "validation-service" for the prediction made before it did
+	Source string `json:"source"`
+
+	// Optimization level the circuit was transpiled at
+	OptimizationLevel int `json:"optimizationLevel"`
+
+	// Circuit depth
+	// +optional
+	Depth int `json:"depth,omitempty"`
+
+	// Number of gates acting on two qubits
+	// +optional
+	TwoQubitGates int `json:"twoQubitGates,omitempty"`
+
+	// Physical qubit each logical qubit is mapped to, indexed by logical
+	// qubit
+	// +optional
+	PhysicalQubits []int `json:"physicalQubits,omitempty"`
+}
+
+// QubitLayout is the final layout of a transpiled circuit and the order of
+// its classical registers in the bitstrings of its counts
+type QubitLayout struct {
+	// Physical qubit each logical qubit ended up on after routing, indexed
+	// by logical qubit
+	// +optional
+	PhysicalQubits []int `json:"physicalQubits,omitempty"`
+
+	// Measured classical registers in the order they appear in bitstrings,
+	// from left to right, separated by spaces. Within a register the
+	// rightmost character is bit 0.
+	// +optional
+	ClassicalRegisters []ClassicalRegister `json:"classicalRegisters,omitempty"`
+}
+
+// ClassicalRegister is a classical register of the counts' bitstrings
+type ClassicalRegister struct {
+	// Name of the register
+	Name string `json:"name"`
+
+	// Number of bits
+	Size int `json:"size"`
+
+	// Physical qubit each bit was measured from, indexed by bit; -1 for
+	// bits never measured
+	// +optional
+	MeasuredQubits []int `json:"measuredQubits,omitempty"`
+}
+
+// TranspiledCircuitMetadata describes the circuit that actually ran after
+// routing and optimization, relative to the logical circuit
+type TranspiledCircuitMetadata struct {
+	// Circuit depth
+	// +optional
+	Depth int `json:"depth,omitempty"`
+
+	// Total number of gates
+	// +optional
+	Gates int `json:"gates,omitempty"`
+
+	// Gate types and counts
+	// +optional
+	GateTypes map[string]int `json:"gateTypes,omitempty"`
+
+	// Change in depth from the logical circuit
+	// +optional
+	DepthDelta int `json:"depthDelta,omitempty"`
+
+	// Change in the number of gates from the logical circuit
+	// +optional
+	GatesDelta int `json:"gatesDelta,omitempty"`
+
+	// Change in the count of each gate type from the logical circuit; gate
+	// types the transpiler removed have negative deltas
+	// +optional
+	GateTypeDeltas map[string]int `json:"gateTypeDeltas,omitempty"`
+
+	// ConfigMap holding transpiled.qpy and transpiled.svg
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:resource:shortName=qjob;qj
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Backend",type=string,JSONPath=`.status.selectedBackend`
+// +kubebuilder:printcolumn:name="Queue",type=integer,JSONPath=`.status.queuePosition`
+// +kubebuilder:printcolumn:name="Provider Phase",type=string,JSONPath=`.status.providerPhase`,priority=1
+// +kubebuilder:printcolumn:name="Est. Start",type=date,JSONPath=`.status.estimatedStartTime`,priority=1
 // +kubebuilder:printcolumn:name="Cost",type=string,JSONPath=`.status.actualCost`
+// +kubebuilder:printcolumn:name="Shadow TVD",type=string,JSONPath=`.status.shadow.totalVariationDistance`,priority=1
+// +kubebuilder:printcolumn:name="Sweep",type=integer,JSONPath=`.status.sweep.completed`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // QiskitJob is the Schema for the qiskitjobs API
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QiskitJobTemplateSpec defines the settings shared by every job instantiated from the template
+type QiskitJobTemplateSpec struct {
+	// Backend configuration for quantum execution
+	// +required
+	Backend BackendSpec `json:"backend"`
+
+	// Execution parameters (shots, optimization level, etc.)
+	// +optional
+	Execution ExecutionSpec `json:"execution,omitempty"`
+
+	// Resource requirements for execution pods
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// Budget constraints and cost management
+	// +optional
+	Budget *BudgetSpec `json:"budget,omitempty"`
+
+	// Output configuration (where to store results)
+	// +optional
+	Output *OutputSpec `json:"output,omitempty"`
+
+	// Credentials for backend authentication, resolved in the job's namespace
+	// +optional
+	Credentials *CredentialsSpec `json:"credentials,omitempty"`
+
+	// Security restrictions on the execution pods of jobs, e.g. to cut the
+	// jobs of untrusted tenants off from the network
+	// +optional
+	Security *SecuritySpec `json:"security,omitempty"`
+
+	// Backend selection preferences
+	// +optional
+	BackendSelection *BackendSelectionSpec `json:"backendSelection,omitempty"`
+
+	// Placement constraints on where jobs may execute
+	// +optional
+	Placement *PlacementSpec `json:"placement,omitempty"`
+
+	// Scheduling of the execution pods of jobs onto the cluster's nodes
+	// +optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+
+	// Fields jobs may change through spec.overrides, as dotted paths relative
+	// to the job spec (e.g. "execution.shots" or "output"). A path also
+	// permits every field below it; anything not listed is locked.
+	// +optional
+	AllowedOverrides []string `json:"allowedOverrides,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=qjt
+// +kubebuilder:printcolumn:name="Backend",type=string,JSONPath=`.spec.backend.type`
+// +kubebuilder:printcolumn:name="Device",type=string,JSONPath=`.spec.backend.name`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QiskitJobTemplate is the Schema for the qiskitjobtemplates API.
+// Cluster administrators publish templates; QiskitJobs reference one by name
+// and supply only their circuit and any permitted overrides.
+type QiskitJobTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the job settings of the template
+	// +required
+	Spec QiskitJobTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// QiskitJobTemplateList contains a list of QiskitJobTemplate
+type QiskitJobTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QiskitJobTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QiskitJobTemplate{}, &QiskitJobTemplateList{})
+}
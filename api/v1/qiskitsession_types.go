@@ -20,38 +20,83 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
-// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
-
-// QiskitSessionSpec defines the desired state of QiskitSession
+// QiskitSessionSpec defines an IBM Quantum Runtime session that QiskitJobs
+// of its namespace share by naming it in spec.session.name
 type QiskitSessionSpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
-	// The following markers will use OpenAPI v3 schema to validate the value
-	// More info: https://book.kubebuilder.io/reference/markers/crd-validation.html
+	// IBM Quantum device the session runs jobs on. Its type must be
+	// ibm_quantum.
+	// +required
+	Backend BackendSpec `json:"backend"`
+
+	// Credentials the session is opened and closed with. Jobs running in the
+	// session must use the same Qiskit Runtime instance.
+	// +optional
+	Credentials *CredentialsSpec `json:"credentials,omitempty"`
+
+	// Session mode: dedicated reserves the device for the session's jobs,
+	// batch only groups them in the device's queue
+	// +kubebuilder:validation:Enum=dedicated;batch
+	// +kubebuilder:default=dedicated
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// How long the session stays open at most, counted from when it was
+	// first opened. The operator closes it once this has passed.
+	// +kubebuilder:default="8h"
+	// +optional
+	MaxTime *metav1.Duration `json:"maxTime,omitempty"`
 
-	// foo is an example field of QiskitSession. Edit qiskitsession_types.go to remove/update
+	// How often the session's state is checked with the provider
+	// +kubebuilder:default="1m"
 	// +optional
-	Foo *string `json:"foo,omitempty"`
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
 }
 
 // QiskitSessionStatus defines the observed state of QiskitSession.
 type QiskitSessionStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
-
-	// For Kubernetes API conventions, see:
-	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
-
-	// conditions represent the current state of the QiskitSession resource.
-	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
-	//
-	// Standard condition types include:
-	// - "Available": the resource is fully functional
-	// - "Progressing": the resource is being created or updated
-	// - "Degraded": the resource failed to reach or maintain its desired state
-	//
-	// The status of each condition is one of True, False, or Unknown.
+	// Phase of the session (Pending, Active, Idle, Expired, Failed)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Provider ID of the session currently open
+	// +optional
+	SessionID string `json:"sessionId,omitempty"`
+
+	// State of the session as the provider reports it (open, active,
+	// inactive, closed)
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Number of times the session was opened, including reopenings after the
+	// provider closed it early
+	// +optional
+	Opened int32 `json:"opened,omitempty"`
+
+	// When the session was first opened
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// When the session expires and is closed
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// When the operator started opening a session it has not recorded the
+	// provider ID of yet. Left over from an earlier pass, the provider may
+	// hold a session opened for this one.
+	// +optional
+	OpeningSince *metav1.Time `json:"openingSince,omitempty"`
+
+	// Time of the check with the provider that last changed the status.
+	// Checks that find nothing new are not written.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// Human-readable status of the session
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Conditions represent the current state of the QiskitSession resource.
+	// Ready is True while jobs can be submitted into the session.
 	// +listType=map
 	// +listMapKey=type
 	// +optional
@@ -60,8 +105,20 @@ type QiskitSessionStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-
-// QiskitSession is the Schema for the qiskitsessions API
+// +kubebuilder:resource:shortName=qs
+// +kubebuilder:printcolumn:name="Device",type=string,JSONPath=`.spec.backend.name`
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Session",type=string,JSONPath=`.status.sessionId`,priority=1
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.status.expiryTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QiskitSession is the Schema for the qiskitsessions API. It keeps an IBM
+// Quantum Runtime session open for the QiskitJobs that name it, so they run
+// one after another in a single session instead of each queueing for the
+// device on its own. The operator opens the session, reopens it if the
+// provider closes it early while jobs still wait for it, and closes it when
+// it expires or the QiskitSession is deleted.
 type QiskitSession struct {
 	metav1.TypeMeta `json:",inline"`
 
@@ -69,11 +126,11 @@ type QiskitSession struct {
 	// +optional
 	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
 
-	// spec defines the desired state of QiskitSession
+	// spec defines the session
 	// +required
 	Spec QiskitSessionSpec `json:"spec"`
 
-	// status defines the observed state of QiskitSession
+	// status defines the observed state of the session
 	// +optional
 	Status QiskitSessionStatus `json:"status,omitempty,omitzero"`
 }
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Phases of QiskitWorkflows and their steps
+const (
+	// WorkflowPending is a step waiting for the steps it depends on
+	WorkflowPending = "Pending"
+	// WorkflowRunning is a workflow or step whose jobs have not finished
+	WorkflowRunning = "Running"
+	// WorkflowSucceeded is a workflow or step whose jobs all completed
+	WorkflowSucceeded = "Succeeded"
+	// WorkflowFailed is a workflow with a failed step, or a step whose job
+	// failed or was cancelled
+	WorkflowFailed = "Failed"
+	// WorkflowSkipped is a step not run because a step it depends on failed
+	WorkflowSkipped = "Skipped"
+)
+
+// QiskitWorkflowSpec defines the steps of a workflow
+type QiskitWorkflowSpec struct {
+	// Steps of the workflow. A step's job is created once the steps it
+	// depends on have completed; steps that do not depend on each other run
+	// in parallel.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=50
+	// +listType=map
+	// +listMapKey=name
+	// +required
+	Steps []WorkflowStep `json:"steps"`
+}
+
+// WorkflowStep is a QiskitJob of a workflow and the steps it waits for
+type WorkflowStep struct {
+	// Name of the step, unique in the workflow. Its job is named
+	// <workflow>-<step>.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Name string `json:"name"`
+
+	// Steps that must complete before this one starts
+	// +listType=set
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// Steps among dependsOn whose results are passed to this step's
+	// executor: WORKFLOW_STEP_<STEP>_RESULTS holds the location of their
+	// results and, for uncompressed configmap outputs,
+	// WORKFLOW_STEP_<STEP>_DOCUMENT the results document itself
+	// +listType=set
+	// +optional
+	ResultsFrom []string `json:"resultsFrom,omitempty"`
+
+	// Template of the step's QiskitJob
+	// +required
+	Template QiskitJobTemplateSource `json:"template"`
+}
+
+// QiskitWorkflowStatus reports the progress of a workflow
+type QiskitWorkflowStatus struct {
+	// Phase of the workflow (Running, Succeeded, Failed)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Progress of each step, in the order of spec.steps
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Steps []WorkflowStepStatus `json:"steps,omitempty"`
+
+	// When the first steps were started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// When the last step finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Human-readable message, e.g. why the workflow is invalid or failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// WorkflowStepStatus reports the progress of a step
+type WorkflowStepStatus struct {
+	// Name of the step
+	// +required
+	Name string `json:"name"`
+
+	// Phase of the step (Pending, Running, Succeeded, Failed, Skipped)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Name of the step's QiskitJob, once created
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// Location of the step's results, once it has completed
+	// +optional
+	ResultsLocation string `json:"resultsLocation,omitempty"`
+
+	// When the step's job completed, failed or was cancelled
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Human-readable message, e.g. why the step failed or was skipped
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=qwf
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QiskitWorkflow is the Schema for the qiskitworkflows API. It runs
+// QiskitJobs in the order their dependencies give, such as the transpile,
+// estimate and evaluate steps of a variational algorithm, passing the
+// results of earlier steps to the later ones.
+type QiskitWorkflow struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the steps of the workflow
+	// +required
+	Spec QiskitWorkflowSpec `json:"spec"`
+
+	// status reports the progress of the workflow
+	// +optional
+	Status QiskitWorkflowStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QiskitWorkflowList contains a list of QiskitWorkflow
+type QiskitWorkflowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QiskitWorkflow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QiskitWorkflow{}, &QiskitWorkflowList{})
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumBackendSpec defines a backend registered for jobs of every namespace
+type QuantumBackendSpec struct {
+	// Backend jobs referencing this QuantumBackend run on
+	// +required
+	Backend BackendSpec `json:"backend"`
+
+	// Credentials jobs referencing this QuantumBackend use, and the operator
+	// probes the backend with. Secret references must name their namespace.
+	// +optional
+	Credentials *CredentialsSpec `json:"credentials,omitempty"`
+
+	// Namespaces whose jobs may reference this QuantumBackend, or "*" for
+	// every namespace. When empty, only jobs in the namespace of the
+	// credentials' Secrets may, or jobs of every namespace if it has no
+	// Secret credentials.
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+
+	// Limits jobs referencing this QuantumBackend must stay within
+	// +optional
+	Limits *QuantumBackendLimits `json:"limits,omitempty"`
+
+	// How often the backend's availability, queue and calibration are
+	// probed
+	// +kubebuilder:default="5m"
+	// +optional
+	ProbeInterval *metav1.Duration `json:"probeInterval,omitempty"`
+}
+
+// QuantumBackendLimits bound the jobs a registered backend accepts.
+// Concurrency limits of provider accounts are declared with
+// QuantumBackendPools.
+type QuantumBackendLimits struct {
+	// Maximum number of shots of a job
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxShots int32 `json:"maxShots,omitempty"`
+}
+
+// BackendCalibration summarizes the latest calibration of a device
+type BackendCalibration struct {
+	// When the device was last calibrated
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Median T1 relaxation time of the qubits
+	// +optional
+	MedianT1 string `json:"medianT1,omitempty"`
+
+	// Median T2 dephasing time of the qubits
+	// +optional
+	MedianT2 string `json:"medianT2,omitempty"`
+
+	// Median readout error of the qubits (0.0-1.0)
+	// +optional
+	MedianReadoutError float64 `json:"medianReadoutError,omitempty"`
+
+	// Median error of the two-qubit gates (0.0-1.0)
+	// +optional
+	MedianTwoQubitError float64 `json:"medianTwoQubitError,omitempty"`
+}
+
+// QuantumBackendStatus defines the observed state of QuantumBackend
+type QuantumBackendStatus struct {
+	// Number of qubits of the device
+	// +optional
+	Qubits int32 `json:"qubits,omitempty"`
+
+	// Number of jobs queued on the device, if the provider reports it
+	// +optional
+	QueueLength *int32 `json:"queueLength,omitempty"`
+
+	// Latest calibration of the device, if the provider reports it
+	// +optional
+	Calibration *BackendCalibration `json:"calibration,omitempty"`
+
+	// Time of the probe that last changed the status. Probes that find
+	// nothing new are not written.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// Generation of the spec last probed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the current state of the QuantumBackend resource.
+	// Available is True while the backend accepts jobs.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=qb
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.backend.type`
+// +kubebuilder:printcolumn:name="Device",type=string,JSONPath=`.spec.backend.name`
+// +kubebuilder:printcolumn:name="Available",type=string,JSONPath=`.status.conditions[?(@.type=="Available")].status`
+// +kubebuilder:printcolumn:name="Queue",type=integer,JSONPath=`.status.queueLength`
+// +kubebuilder:printcolumn:name="Qubits",type=integer,JSONPath=`.status.qubits`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumBackend is the Schema for the quantumbackends API. Cluster
+// administrators register the backends of the cluster once, with their
+// credentials and limits; jobs reference them by name or pick among them by
+// label instead of configuring the backend themselves. The operator probes
+// each backend's availability, queue and calibration into its status.
+type QuantumBackend struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the registered backend
+	// +required
+	Spec QuantumBackendSpec `json:"spec"`
+
+	// status defines the observed state of the backend
+	// +optional
+	Status QuantumBackendStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumBackendList contains a list of QuantumBackend
+type QuantumBackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumBackend `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumBackend{}, &QuantumBackendList{})
+}
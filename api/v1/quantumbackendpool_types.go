@@ -0,0 +1,155 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumBackendPoolSpec defines backends that share provider-side limits
+type QuantumBackendPoolSpec struct {
+	// Backend names, types or Braket device ARNs in the pool; shell-style
+	// globs such as "ibm_*" are allowed
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	Backends []string `json:"backends"`
+
+	// IBM Cloud instance the limits belong to. When set, only jobs submitted
+	// through this instance count against the limits.
+	// +optional
+	Instance string `json:"instance,omitempty"`
+
+	// Limits the provider enforces on the pool
+	// +required
+	Limits ProviderLimits `json:"limits"`
+}
+
+// ProviderLimits are limits a provider enforces on an account or device
+type ProviderLimits struct {
+	// Maximum number of jobs submitted to the provider at once, such as the
+	// concurrent job limit of an IBM Quantum Runtime instance or the
+	// concurrency quota of an AWS Braket device
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxConcurrentJobs int32 `json:"maxConcurrentJobs"`
+}
+
+// QuantumBackendPoolStatus reports the health of the pooled backends
+type QuantumBackendPoolStatus struct {
+	// Circuit breakers of the pooled backends that have failed. A breaker
+	// opens after consecutive submission or polling failures; while it is
+	// open jobs are not submitted to the backend.
+	// +listType=map
+	// +listMapKey=backend
+	// +optional
+	Breakers []BackendBreakerStatus `json:"breakers,omitempty"`
+
+	// Queue waits predicted for the pooled backends from the waits of their
+	// recent executions, since provider estimates are often missing or wrong
+	// +listType=map
+	// +listMapKey=backend
+	// +optional
+	QueueWaits []BackendQueueWait `json:"queueWaits,omitempty"`
+}
+
+// BackendBreakerStatus is the state of a backend's circuit breaker
+type BackendBreakerStatus struct {
+	// Backend the breaker guards
+	// +required
+	Backend string `json:"backend"`
+
+	// State of the breaker (Closed, Open, HalfOpen)
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Number of consecutive failed calls to the backend
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+
+	// When the breaker last changed state
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// When an open breaker next lets a call probe the backend
+	// +optional
+	RetryAt *metav1.Time `json:"retryAt,omitempty"`
+}
+
+// BackendQueueWait is the predicted queue wait of a backend
+type BackendQueueWait struct {
+	// Backend the prediction is for
+	// +required
+	Backend string `json:"backend"`
+
+	// Predicted queue wait, averaged over recently observed executions
+	// +optional
+	PredictedWait string `json:"predictedWait,omitempty"`
+
+	// Number of observed executions the prediction is based on
+	// +optional
+	Samples int32 `json:"samples,omitempty"`
+
+	// Queue wait of the most recent observed execution
+	// +optional
+	LastObservedWait string `json:"lastObservedWait,omitempty"`
+
+	// When the prediction last changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=qbp
+// +kubebuilder:printcolumn:name="Max Concurrent",type=integer,JSONPath=`.spec.limits.maxConcurrentJobs`
+// +kubebuilder:printcolumn:name="Instance",type=string,JSONPath=`.spec.instance`,priority=1
+// +kubebuilder:printcolumn:name="Hardware Paused",type=string,JSONPath=`.metadata.annotations.quantum\.io/pause-hardware`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumBackendPool is the Schema for the quantumbackendpools API.
+// Cluster administrators use pools to declare the limits of their provider
+// accounts; jobs wait in the scheduler for a free slot instead of being
+// rejected by the provider. The quantum.io/pause-hardware annotation on any
+// pool pauses hardware submissions across the cluster.
+type QuantumBackendPool struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the pooled backends and their limits
+	// +required
+	Spec QuantumBackendPoolSpec `json:"spec"`
+
+	// status reports the health of the pooled backends
+	// +optional
+	Status QuantumBackendPoolStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumBackendPoolList contains a list of QuantumBackendPool
+type QuantumBackendPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumBackendPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumBackendPool{}, &QuantumBackendPoolList{})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumCostReportSpec defines the desired state of QuantumCostReport
+type QuantumCostReportSpec struct {
+	// Monthly spend of cost centers above which they are reported as over
+	// their threshold
+	// +listType=map
+	// +listMapKey=costCenter
+	// +kubebuilder:validation:MaxItems=200
+	// +optional
+	Thresholds []CostCenterThreshold `json:"thresholds,omitempty"`
+}
+
+// CostCenterThreshold is the monthly spend a cost center is allowed before
+// it alerts
+type CostCenterThreshold struct {
+	// Cost center, as namespaces grant it in their quantum.io/cost-center label
+	// +kubebuilder:validation:MinLength=1
+	CostCenter string `json:"costCenter"`
+
+	// Monthly spend above which the cost center alerts (e.g., "$1000.00")
+	// +kubebuilder:validation:Pattern=`^\$?[0-9]+(\.[0-9]+)?$`
+	Amount string `json:"amount"`
+}
+
+// CostPeriod is the spend of jobs that finished in a billing period
+type CostPeriod struct {
+	// Billing period the spend refers to (YYYY-MM)
+	// +optional
+	BillingPeriod string `json:"billingPeriod,omitempty"`
+
+	// Total actual cost of the jobs that finished in the period, accumulated
+	// as each job is charged
+	// +optional
+	TotalSpend string `json:"totalSpend,omitempty"`
+
+	// Spend of jobs that name no cost center
+	// +optional
+	UnassignedSpend string `json:"unassignedSpend,omitempty"`
+
+	// Spend per namespace
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	Namespaces []NamespaceCost `json:"namespaces,omitempty"`
+
+	// Spend per cost center
+	// +listType=map
+	// +listMapKey=costCenter
+	// +optional
+	CostCenters []CostCenterCost `json:"costCenters,omitempty"`
+}
+
+// NamespaceCost is the spend of a namespace's jobs in a billing period
+type NamespaceCost struct {
+	// Namespace of the jobs
+	Namespace string `json:"namespace"`
+
+	// Total actual cost of the namespace's jobs
+	Spend string `json:"spend"`
+
+	// Number of the namespace's jobs that finished in the period
+	Jobs int `json:"jobs"`
+}
+
+// CostCenterCost is the spend charged to a cost center in a billing period
+type CostCenterCost struct {
+	// Cost center, as namespaces grant it in their quantum.io/cost-center label
+	CostCenter string `json:"costCenter"`
+
+	// Billing accounts the cost center's jobs named in spec.budget.billingAccount
+	// +optional
+	BillingAccounts []string `json:"billingAccounts,omitempty"`
+
+	// Total actual cost charged to the cost center
+	Spend string `json:"spend"`
+
+	// Number of the cost center's jobs that finished in the period
+	Jobs int `json:"jobs"`
+
+	// Threshold of the cost center from the spec, if it has one
+	// +optional
+	Threshold string `json:"threshold,omitempty"`
+
+	// True when the spend is above the threshold
+	// +optional
+	OverThreshold bool `json:"overThreshold,omitempty"`
+}
+
+// QuantumCostReportStatus defines the observed state of QuantumCostReport.
+type QuantumCostReportStatus struct {
+	// Spend of the current billing period
+	CostPeriod `json:",inline"`
+
+	// Spend of the billing period before, as it stood when the period closed
+	// +optional
+	Previous *CostPeriod `json:"previous,omitempty"`
+
+	// Last time the report was refreshed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Conditions represent the current state of the QuantumCostReport resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=qcr
+// +kubebuilder:printcolumn:name="Period",type=string,JSONPath=`.status.billingPeriod`
+// +kubebuilder:printcolumn:name="Spend",type=string,JSONPath=`.status.totalSpend`
+// +kubebuilder:printcolumn:name="Over Threshold",type=string,JSONPath=`.status.conditions[?(@.type=="ThresholdExceeded")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumCostReport is the Schema for the quantumcostreports API. The
+// operator maintains one instance accounting the spend of QiskitJobs per
+// namespace and cost center for chargeback.
+type QuantumCostReport struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of QuantumCostReport
+	// +optional
+	Spec QuantumCostReportSpec `json:"spec,omitempty,omitzero"`
+
+	// status defines the observed state of QuantumCostReport
+	// +optional
+	Status QuantumCostReportStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumCostReportList contains a list of QuantumCostReport
+type QuantumCostReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumCostReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumCostReport{}, &QuantumCostReportList{})
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumNamespaceProfileSpec defines the settings injected into the
+// namespace's QiskitJobs that leave them unset
+type QuantumNamespaceProfileSpec struct {
+	// Credentials of jobs created without spec.credentials. Their Secrets
+	// must be in the profile's namespace; jobs given a Secret of another
+	// namespace are rejected.
+	// +optional
+	Credentials *CredentialsSpec `json:"credentials,omitempty"`
+
+	// Outputs of jobs created without spec.outputs, in place of the
+	// ConfigMap the operator stores their results in otherwise. Each job
+	// stores its results under its own name, so configmap outputs, which
+	// hold a single job's results, cannot be shared this way.
+	// +kubebuilder:validation:XValidation:rule="self.all(o, o.type != 'configmap')",message="configmap outputs cannot be namespace defaults"
+	// +optional
+	Outputs []OutputSpec `json:"outputs,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=qnsprofile
+// +kubebuilder:printcolumn:name="Credentials",type=string,JSONPath=`.spec.credentials.secretRef.name`
+// +kubebuilder:printcolumn:name="Output",type=string,JSONPath=`.spec.outputs[0].type`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumNamespaceProfile is the Schema for the quantumnamespaceprofiles
+// API. Namespace administrators use profiles to say where the namespace's
+// credentials live and where results go; the mutating webhook injects them
+// into new QiskitJobs that do not set their own, so users never have to
+// know.
+type QuantumNamespaceProfile struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the settings injected into jobs
+	// +required
+	Spec QuantumNamespaceProfileSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumNamespaceProfileList contains a list of QuantumNamespaceProfile
+type QuantumNamespaceProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumNamespaceProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumNamespaceProfile{}, &QuantumNamespaceProfileList{})
+}
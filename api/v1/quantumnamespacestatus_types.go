@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumNamespaceStatusSpec defines the desired state of QuantumNamespaceStatus
+type QuantumNamespaceStatusSpec struct {
+	// Monthly spending limit used to compute quota utilization (e.g., "$500.00")
+	// +optional
+	MonthlyBudget string `json:"monthlyBudget,omitempty"`
+}
+
+// QuantumNamespaceStatusStatus defines the observed state of QuantumNamespaceStatus.
+type QuantumNamespaceStatusStatus struct {
+	// Number of QiskitJobs in the namespace by phase
+	// +optional
+	JobCounts map[string]int `json:"jobCounts,omitempty"`
+
+	// Total number of QiskitJobs in the namespace
+	// +optional
+	TotalJobs int `json:"totalJobs,omitempty"`
+
+	// Number of jobs that have not reached a terminal phase
+	// +optional
+	ActiveJobs int `json:"activeJobs,omitempty"`
+
+	// Billing period the spend figures refer to (YYYY-MM)
+	// +optional
+	BillingPeriod string `json:"billingPeriod,omitempty"`
+
+	// Total actual cost of jobs completed in the billing period
+	// +optional
+	MonthToDateSpend string `json:"monthToDateSpend,omitempty"`
+
+	// Fraction of the monthly budget spent (0.0-1.0+)
+	// +optional
+	QuotaUtilization float64 `json:"quotaUtilization,omitempty"`
+
+	// Fraction of finished jobs that failed (0.0-1.0)
+	// +optional
+	FailureRate float64 `json:"failureRate,omitempty"`
+
+	// Last time the summary changed
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Conditions represent the current state of the QuantumNamespaceStatus resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=qns
+// +kubebuilder:printcolumn:name="Jobs",type=integer,JSONPath=`.status.totalJobs`
+// +kubebuilder:printcolumn:name="Active",type=integer,JSONPath=`.status.activeJobs`
+// +kubebuilder:printcolumn:name="Spend",type=string,JSONPath=`.status.monthToDateSpend`
+// +kubebuilder:printcolumn:name="Failure Rate",type=number,JSONPath=`.status.failureRate`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumNamespaceStatus is the Schema for the quantumnamespacestatuses API.
+// The operator maintains one instance per namespace summarizing its QiskitJobs.
+type QuantumNamespaceStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of QuantumNamespaceStatus
+	// +optional
+	Spec QuantumNamespaceStatusSpec `json:"spec,omitempty,omitzero"`
+
+	// status defines the observed state of QuantumNamespaceStatus
+	// +optional
+	Status QuantumNamespaceStatusStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumNamespaceStatusList contains a list of QuantumNamespaceStatus
+type QuantumNamespaceStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumNamespaceStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumNamespaceStatus{}, &QuantumNamespaceStatusList{})
+}
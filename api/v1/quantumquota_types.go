@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumQuotaSpec defines the limits of a namespace's QiskitJobs
+type QuantumQuotaSpec struct {
+	// Maximum spend of the namespace's jobs in a calendar month (e.g.,
+	// "$500.00"). Hardware jobs whose estimated cost would take the spend of
+	// the month, counting jobs still running or admitted to run at their
+	// estimate, past it wait in Scheduling.
+	// +kubebuilder:validation:Pattern=`^\$?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MaxMonthlySpend string `json:"maxMonthlySpend,omitempty"`
+
+	// Maximum number of the namespace's jobs running on ibm_quantum or
+	// generic_http hardware at once. Further hardware jobs wait in
+	// Scheduling.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentHardwareJobs *int32 `json:"maxConcurrentHardwareJobs,omitempty"`
+
+	// Maximum number of the namespace's execution pods running at once,
+	// whatever their backend. Further jobs wait in Scheduling and are
+	// admitted in priority order.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentExecutors *int32 `json:"maxConcurrentExecutors,omitempty"`
+
+	// Maximum shots of a single job. Jobs asking for more fail.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxShots *int32 `json:"maxShots,omitempty"`
+}
+
+// QuantumQuotaStatus reports the usage the quota was last checked against
+type QuantumQuotaStatus struct {
+	// Billing period the spend figures refer to (YYYY-MM)
+	// +optional
+	BillingPeriod string `json:"billingPeriod,omitempty"`
+
+	// Actual cost of the namespace's jobs finished in the billing period,
+	// added as each job finishes so that it outlives the job
+	// +optional
+	MonthToDateSpend string `json:"monthToDateSpend,omitempty"`
+
+	// Estimated cost of the namespace's jobs running on hardware or
+	// admitted to run there
+	// +optional
+	CommittedSpend string `json:"committedSpend,omitempty"`
+
+	// Number of the namespace's jobs running on hardware or admitted to
+	// run there
+	// +optional
+	RunningHardwareJobs int32 `json:"runningHardwareJobs,omitempty"`
+
+	// Number of jobs waiting in Scheduling for the quota
+	// +optional
+	BlockedJobs int32 `json:"blockedJobs,omitempty"`
+
+	// Last time the usage was checked by the scheduler
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=qquota
+// +kubebuilder:printcolumn:name="Spend",type=string,JSONPath=`.status.monthToDateSpend`
+// +kubebuilder:printcolumn:name="Max Spend",type=string,JSONPath=`.spec.maxMonthlySpend`
+// +kubebuilder:printcolumn:name="Hardware Jobs",type=integer,JSONPath=`.status.runningHardwareJobs`
+// +kubebuilder:printcolumn:name="Blocked",type=integer,JSONPath=`.status.blockedJobs`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumQuota is the Schema for the quantumquotas API. Namespace
+// administrators use quotas to cap the spend, hardware concurrency and shots
+// of the namespace's QiskitJobs; the scheduler holds back or fails jobs that
+// would exceed them.
+type QuantumQuota struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the limits
+	// +required
+	Spec QuantumQuotaSpec `json:"spec"`
+
+	// status reports the usage the limits were last checked against
+	// +optional
+	Status QuantumQuotaStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumQuotaList contains a list of QuantumQuota
+type QuantumQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumQuota{}, &QuantumQuotaList{})
+}
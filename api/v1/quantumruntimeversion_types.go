@@ -0,0 +1,156 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumRuntimeVersionSpec defines the executor image of a Qiskit release line
+type QuantumRuntimeVersionSpec struct {
+	// Qiskit release line (major.minor) whose executor image is managed
+	// +required
+	// +kubebuilder:validation:Pattern=`^[0-9]+\.[0-9]+$`
+	QiskitVersion string `json:"qiskitVersion"`
+
+	// Executor image jobs of the line run. Defaults to the image of the
+	// operator's compatibility matrix.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Rolls out a new executor image to a share of new jobs first
+	// +optional
+	Canary *CanaryPolicy `json:"canary,omitempty"`
+}
+
+// CanaryPolicy runs a new executor image for a share of new jobs and rolls
+// it back when their failure rate regresses
+type CanaryPolicy struct {
+	// New executor image
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// Percentage of new jobs that run the canary image
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// Number of finished canary jobs before failure rates are compared
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=20
+	// +optional
+	MinJobs int32 `json:"minJobs,omitempty"`
+
+	// Largest increase of the canary failure rate over the stable failure
+	// rate that is tolerated, in percentage points
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=5
+	// +optional
+	MaxFailureRateIncrease *int32 `json:"maxFailureRateIncrease,omitempty"`
+}
+
+// RolloutTrack counts the finished jobs that ran one executor image
+type RolloutTrack struct {
+	// Executor image of the track
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Number of jobs that completed or failed for good
+	// +optional
+	Jobs int32 `json:"jobs,omitempty"`
+
+	// Number of those jobs that failed
+	// +optional
+	Failures int32 `json:"failures,omitempty"`
+
+	// Fraction of finished jobs that failed (0.0-1.0)
+	// +optional
+	FailureRate float64 `json:"failureRate,omitempty"`
+}
+
+// QuantumRuntimeVersionStatus defines the observed state of QuantumRuntimeVersion.
+type QuantumRuntimeVersionStatus struct {
+	// Jobs of the line that ran the stable image
+	// +optional
+	Stable RolloutTrack `json:"stable,omitempty,omitzero"`
+
+	// Jobs of the line that ran the canary image
+	// +optional
+	Canary RolloutTrack `json:"canary,omitempty,omitzero"`
+
+	// Canary image that was rolled back because its failure rate regressed.
+	// New jobs run the stable image until spec.canary.image changes.
+	// +optional
+	RolledBackImage string `json:"rolledBackImage,omitempty"`
+
+	// Last time the rollout was evaluated
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Conditions represent the current state of the QuantumRuntimeVersion resource
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=qrv
+// +kubebuilder:printcolumn:name="Qiskit",type=string,JSONPath=`.spec.qiskitVersion`
+// +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.spec.image`
+// +kubebuilder:printcolumn:name="Canary",type=string,JSONPath=`.spec.canary.image`
+// +kubebuilder:printcolumn:name="Weight",type=integer,JSONPath=`.spec.canary.weight`
+// +kubebuilder:printcolumn:name="Rolled Back",type=string,JSONPath=`.status.rolledBackImage`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumRuntimeVersion is the Schema for the quantumruntimeversions API.
+// Cluster administrators use it to change the executor image of a Qiskit
+// release line, optionally through a canary that the operator rolls back
+// automatically when jobs start failing more often.
+type QuantumRuntimeVersion struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the executor image of the release line
+	// +required
+	Spec QuantumRuntimeVersionSpec `json:"spec"`
+
+	// status defines the observed state of the rollout
+	// +optional
+	Status QuantumRuntimeVersionStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumRuntimeVersionList contains a list of QuantumRuntimeVersion
+type QuantumRuntimeVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumRuntimeVersion `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumRuntimeVersion{}, &QuantumRuntimeVersionList{})
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuantumWorkspaceSpec defines a time-boxed Jupyter notebook session
+type QuantumWorkspaceSpec struct {
+	// Backend the notebook targets by default. Its type and name are passed
+	// to the notebook as QISKIT_BACKEND_TYPE and QISKIT_BACKEND_NAME.
+	// +optional
+	Backend *BackendSpec `json:"backend,omitempty"`
+
+	// Credentials mounted read-only into the notebook, at the directory in
+	// QISKIT_CREDENTIALS_DIR. A secretRef must name a Secret of the
+	// workspace's namespace.
+	// +optional
+	Credentials *CredentialsSpec `json:"credentials,omitempty"`
+
+	// Qiskit release line the notebook runs, as for QiskitJobs (e.g. "1.2")
+	// +optional
+	QiskitVersion string `json:"qiskitVersion,omitempty"`
+
+	// Image the notebook runs instead of the executor image of its Qiskit
+	// version
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Compute resources of the notebook
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// Spending limit of the QiskitJobs submitted from the workspace
+	// +optional
+	Budget *WorkspaceBudgetSpec `json:"budget,omitempty"`
+
+	// How long the notebook runs at most, counted from when it started. The
+	// operator tears it down once this has passed.
+	// +kubebuilder:default="8h"
+	// +optional
+	MaxLifetime *metav1.Duration `json:"maxLifetime,omitempty"`
+
+	// Service account the notebook runs as. It needs RBAC to create
+	// QiskitJobs for the notebook to submit them.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// WorkspaceBudgetSpec limits what the jobs of a workspace may spend
+type WorkspaceBudgetSpec struct {
+	// Maximum total cost of the workspace's jobs (e.g., "$50.00"). Jobs whose
+	// estimated cost would take the workspace's spend past it fail before
+	// they are submitted.
+	// +kubebuilder:validation:Pattern=`^\$?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MaxCost string `json:"maxCost,omitempty"`
+}
+
+// QuantumWorkspaceStatus defines the observed state of QuantumWorkspace.
+type QuantumWorkspaceStatus struct {
+	// Phase of the workspace (Pending, Running, Expired, Failed)
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Name of the notebook pod
+	// +optional
+	PodName string `json:"podName,omitempty"`
+
+	// When the notebook pod was created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// When the workspace expires and its notebook is torn down
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	// Number of QiskitJobs submitted from the workspace
+	// +optional
+	Jobs int32 `json:"jobs,omitempty"`
+
+	// Actual cost of the workspace's finished jobs plus the estimated cost
+	// of those running
+	// +optional
+	Spend string `json:"spend,omitempty"`
+
+	// Human-readable status of the workspace
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Conditions represent the current state of the QuantumWorkspace
+	// resource. Ready is True while the notebook is running.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=qws
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Pod",type=string,JSONPath=`.status.podName`,priority=1
+// +kubebuilder:printcolumn:name="Jobs",type=integer,JSONPath=`.status.jobs`
+// +kubebuilder:printcolumn:name="Spend",type=string,JSONPath=`.status.spend`
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.status.expiryTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QuantumWorkspace is the Schema for the quantumworkspaces API. It runs a
+// JupyterLab pod for exploratory work, with the executor runtime of a Qiskit
+// version and the workspace's backend credentials, for a limited time. Jobs
+// the notebook submits carry the quantum.io/workspace label; the workspace
+// counts them and what they cost, and holds them to its budget. Once its
+// lifetime has passed, the operator deletes the notebook pod.
+type QuantumWorkspace struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the workspace
+	// +optional
+	Spec QuantumWorkspaceSpec `json:"spec"`
+
+	// status defines the observed state of the workspace
+	// +optional
+	Status QuantumWorkspaceStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QuantumWorkspaceList contains a list of QuantumWorkspace
+type QuantumWorkspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QuantumWorkspace `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QuantumWorkspace{}, &QuantumWorkspaceList{})
+}
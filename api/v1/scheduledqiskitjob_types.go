@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Concurrency policies of ScheduledQiskitJobs
+const (
+	// ConcurrencyAllow starts scheduled jobs while earlier ones still run
+	ConcurrencyAllow = "Allow"
+	// ConcurrencyForbid skips a scheduled run while an earlier job still runs
+	ConcurrencyForbid = "Forbid"
+	// ConcurrencyReplace cancels jobs that still run to start the scheduled one
+	ConcurrencyReplace = "Replace"
+)
+
+// ScheduledQiskitJobSpec defines when QiskitJobs are started and what they run
+type ScheduledQiskitJobSpec struct {
+	// Cron schedule jobs are started on, e.g. "0 2 * * *" for 02:00 every
+	// day, or a shorthand like "@hourly"
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Schedule string `json:"schedule"`
+
+	// IANA time zone the schedule is expressed in (e.g., "Europe/Berlin")
+	// +kubebuilder:default=UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Seconds after its scheduled time a run that was missed, e.g. while the
+	// operator was down, may still start. Runs missed by longer are
+	// skipped. Unset starts the most recent missed run however late.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// What to do when a run is due while jobs of earlier runs have not
+	// finished: Allow starts it anyway, Forbid skips it and Replace cancels
+	// the earlier jobs
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	// +kubebuilder:default=Allow
+	// +optional
+	ConcurrencyPolicy string `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend stops starting runs; jobs already started are left alone
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Template of the QiskitJobs started on schedule
+	// +required
+	JobTemplate QiskitJobTemplateSource `json:"jobTemplate"`
+
+	// Number of completed jobs to keep
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=3
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// Number of failed and cancelled jobs to keep
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+}
+
+// QiskitJobTemplateSource describes the QiskitJobs a ScheduledQiskitJob starts
+type QiskitJobTemplateSource struct {
+	// Labels and annotations of the jobs
+	// +optional
+	Metadata JobTemplateMetadata `json:"metadata,omitempty"`
+
+	// Spec of the jobs
+	// +required
+	Spec QiskitJobSpec `json:"spec"`
+}
+
+// JobTemplateMetadata is the metadata given to jobs started from a template
+type JobTemplateMetadata struct {
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ScheduledQiskitJobStatus reports the runs of a ScheduledQiskitJob
+type ScheduledQiskitJobStatus struct {
+	// Names of the started jobs that have not finished
+	// +listType=set
+	// +optional
+	Active []string `json:"active,omitempty"`
+
+	// When a run was last due
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// When a started job last completed
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// When the next run is due
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// Human-readable message, e.g. why the schedule is invalid or a run was
+	// skipped
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=sqj
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Suspend",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="Last Schedule",type=date,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="Next Schedule",type=date,JSONPath=`.status.nextScheduleTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ScheduledQiskitJob is the Schema for the scheduledqiskitjobs API. It
+// starts a QiskitJob from its template on a cron schedule, like a CronJob
+// does for Jobs, and keeps a limited history of the jobs it started.
+type ScheduledQiskitJob struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the schedule and the jobs it starts
+	// +required
+	Spec ScheduledQiskitJobSpec `json:"spec"`
+
+	// status reports the runs of the schedule
+	// +optional
+	Status ScheduledQiskitJobStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScheduledQiskitJobList contains a list of ScheduledQiskitJob
+type ScheduledQiskitJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScheduledQiskitJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScheduledQiskitJob{}, &ScheduledQiskitJobList{})
+}
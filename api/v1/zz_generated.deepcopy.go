@@ -21,13 +21,98 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalStatus) DeepCopyInto(out *ApprovalStatus) {
+	*out = *in
+	if in.RequestedAt != nil {
+		in, out := &in.RequestedAt, &out.RequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DecidedAt != nil {
+		in, out := &in.DecidedAt, &out.DecidedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalStatus.
+func (in *ApprovalStatus) DeepCopy() *ApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactsSpec) DeepCopyInto(out *ArtifactsSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactsSpec.
+func (in *ArtifactsSpec) DeepCopy() *ArtifactsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendBreakerStatus) DeepCopyInto(out *BackendBreakerStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RetryAt != nil {
+		in, out := &in.RetryAt, &out.RetryAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendBreakerStatus.
+func (in *BackendBreakerStatus) DeepCopy() *BackendBreakerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendBreakerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendCalibration) DeepCopyInto(out *BackendCalibration) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendCalibration.
+func (in *BackendCalibration) DeepCopy() *BackendCalibration {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendCalibration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendInfo) DeepCopyInto(out *BackendInfo) {
 	*out = *in
+	if in.CalibrationTimestamp != nil {
+		in, out := &in.CalibrationTimestamp, &out.CalibrationTimestamp
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendInfo.
@@ -40,6 +125,40 @@ func (in *BackendInfo) DeepCopy() *BackendInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendQueueWait) DeepCopyInto(out *BackendQueueWait) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendQueueWait.
+func (in *BackendQueueWait) DeepCopy() *BackendQueueWait {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendQueueWait)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendScore) DeepCopyInto(out *BackendScore) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendScore.
+func (in *BackendScore) DeepCopy() *BackendScore {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendSelectionSpec) DeepCopyInto(out *BackendSelectionSpec) {
 	*out = *in
@@ -70,9 +189,34 @@ func (in *BackendSelectionSpec) DeepCopy() *BackendSelectionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendSelectionStatus) DeepCopyInto(out *BackendSelectionStatus) {
+	*out = *in
+	if in.Scores != nil {
+		in, out := &in.Scores, &out.Scores
+		*out = make([]BackendScore, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSelectionStatus.
+func (in *BackendSelectionStatus) DeepCopy() *BackendSelectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendSelectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPBackendSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendSpec.
@@ -116,269 +260,3252 @@ func (in *BudgetSpec) DeepCopy() *BudgetSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CircuitMetadata) DeepCopyInto(out *CircuitMetadata) {
+func (in *CalendarWindow) DeepCopyInto(out *CalendarWindow) {
 	*out = *in
-	if in.GateTypes != nil {
-		in, out := &in.GateTypes, &out.GateTypes
-		*out = make(map[string]int, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]CalendarDay, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitMetadata.
-func (in *CircuitMetadata) DeepCopy() *CircuitMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarWindow.
+func (in *CalendarWindow) DeepCopy() *CalendarWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(CircuitMetadata)
+	out := new(CalendarWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CircuitSpec) DeepCopyInto(out *CircuitSpec) {
+func (in *BulkJobFailure) DeepCopyInto(out *BulkJobFailure) {
 	*out = *in
-	if in.ConfigMapRef != nil {
-		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(ConfigMapRef)
-		**out = **in
-	}
-	if in.GitRef != nil {
-		in, out := &in.GitRef, &out.GitRef
-		*out = new(GitRef)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitSpec.
-func (in *CircuitSpec) DeepCopy() *CircuitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkJobFailure.
+func (in *BulkJobFailure) DeepCopy() *BulkJobFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(CircuitSpec)
+	out := new(BulkJobFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMapRef) DeepCopyInto(out *ConfigMapRef) {
+func (in *BundleSpec) DeepCopyInto(out *BundleSpec) {
 	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapRef)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapRef.
-func (in *ConfigMapRef) DeepCopy() *ConfigMapRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleSpec.
+func (in *BundleSpec) DeepCopy() *BundleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMapRef)
+	out := new(BundleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CredentialsSpec) DeepCopyInto(out *CredentialsSpec) {
+func (in *CanaryPolicy) DeepCopyInto(out *CanaryPolicy) {
 	*out = *in
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(SecretRef)
+	if in.MaxFailureRateIncrease != nil {
+		in, out := &in.MaxFailureRateIncrease, &out.MaxFailureRateIncrease
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsSpec.
-func (in *CredentialsSpec) DeepCopy() *CredentialsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryPolicy.
+func (in *CanaryPolicy) DeepCopy() *CanaryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(CredentialsSpec)
+	out := new(CanaryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecutionMetrics) DeepCopyInto(out *ExecutionMetrics) {
+func (in *CircuitMetadata) DeepCopyInto(out *CircuitMetadata) {
 	*out = *in
+	if in.GateTypes != nil {
+		in, out := &in.GateTypes, &out.GateTypes
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Transpiled != nil {
+		in, out := &in.Transpiled, &out.Transpiled
+		*out = new(TranspiledCircuitMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(QubitLayout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transpilation != nil {
+		in, out := &in.Transpilation, &out.Transpilation
+		*out = new(TranspilationMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionMetrics.
-func (in *ExecutionMetrics) DeepCopy() *ExecutionMetrics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitMetadata.
+func (in *CircuitMetadata) DeepCopy() *CircuitMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecutionMetrics)
+	out := new(CircuitMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
+func (in *CircuitSpec) DeepCopyInto(out *CircuitSpec) {
 	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapRef)
+		**out = **in
+	}
+	if in.GitRef != nil {
+		in, out := &in.GitRef, &out.GitRef
+		*out = new(GitRef)
+		**out = **in
+	}
+	if in.Bundle != nil {
+		in, out := &in.Bundle, &out.Bundle
+		*out = new(BundleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSpec.
-func (in *ExecutionSpec) DeepCopy() *ExecutionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitSpec.
+func (in *CircuitSpec) DeepCopy() *CircuitSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecutionSpec)
+	out := new(CircuitSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitRef) DeepCopyInto(out *GitRef) {
+func (in *ClassicalRegister) DeepCopyInto(out *ClassicalRegister) {
 	*out = *in
+	if in.MeasuredQubits != nil {
+		in, out := &in.MeasuredQubits, &out.MeasuredQubits
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRef.
-func (in *GitRef) DeepCopy() *GitRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassicalRegister.
+func (in *ClassicalRegister) DeepCopy() *ClassicalRegister {
 	if in == nil {
 		return nil
 	}
-	out := new(GitRef)
+	out := new(ClassicalRegister)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
+func (in *ClientCertificate) DeepCopyInto(out *ClientCertificate) {
 	*out = *in
+	out.SecretRef = in.SecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
-func (in *OutputSpec) DeepCopy() *OutputSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificate.
+func (in *ClientCertificate) DeepCopy() *ClientCertificate {
 	if in == nil {
 		return nil
 	}
-	out := new(OutputSpec)
+	out := new(ClientCertificate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBackend) DeepCopyInto(out *QiskitBackend) {
+func (in *ConfigMapRef) DeepCopyInto(out *ConfigMapRef) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackend.
-func (in *QiskitBackend) DeepCopy() *QiskitBackend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapRef.
+func (in *ConfigMapRef) DeepCopy() *ConfigMapRef {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBackend)
+	out := new(ConfigMapRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitBackend) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBackendList) DeepCopyInto(out *QiskitBackendList) {
+func (in *CostCenterCost) DeepCopyInto(out *CostCenterCost) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]QiskitBackend, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BillingAccounts != nil {
+		in, out := &in.BillingAccounts, &out.BillingAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackendList.
-func (in *QiskitBackendList) DeepCopy() *QiskitBackendList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostCenterCost.
+func (in *CostCenterCost) DeepCopy() *CostCenterCost {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBackendList)
+	out := new(CostCenterCost)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitBackendList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBackendSpec) DeepCopyInto(out *QiskitBackendSpec) {
+func (in *CostCenterThreshold) DeepCopyInto(out *CostCenterThreshold) {
 	*out = *in
-	if in.Foo != nil {
-		in, out := &in.Foo, &out.Foo
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackendSpec.
-func (in *QiskitBackendSpec) DeepCopy() *QiskitBackendSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostCenterThreshold.
+func (in *CostCenterThreshold) DeepCopy() *CostCenterThreshold {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBackendSpec)
+	out := new(CostCenterThreshold)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBackendStatus) DeepCopyInto(out *QiskitBackendStatus) {
+func (in *CostPeriod) DeepCopyInto(out *CostPeriod) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceCost, len(*in))
+		copy(*out, *in)
+	}
+	if in.CostCenters != nil {
+		in, out := &in.CostCenters, &out.CostCenters
+		*out = make([]CostCenterCost, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackendStatus.
-func (in *QiskitBackendStatus) DeepCopy() *QiskitBackendStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostPeriod.
+func (in *CostPeriod) DeepCopy() *CostPeriod {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBackendStatus)
+	out := new(CostPeriod)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBudget) DeepCopyInto(out *QiskitBudget) {
+func (in *CredentialsSpec) DeepCopyInto(out *CredentialsSpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.RegionalSecretRefs != nil {
+		in, out := &in.RegionalSecretRefs, &out.RegionalSecretRefs
+		*out = make(map[string]SecretRef, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(CredentialsVolume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsStore != nil {
+		in, out := &in.SecretsStore, &out.SecretsStore
+		*out = new(SecretsStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(ClientCertificate)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsSpec.
+func (in *CredentialsSpec) DeepCopy() *CredentialsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsVolume) DeepCopyInto(out *CredentialsVolume) {
+	*out = *in
+	if in.CSI != nil {
+		in, out := &in.CSI, &out.CSI
+		*out = new(corev1.CSIVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Projected != nil {
+		in, out := &in.Projected, &out.Projected
+		*out = new(corev1.ProjectedVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsVolume.
+func (in *CredentialsVolume) DeepCopy() *CredentialsVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeduplicationSpec) DeepCopyInto(out *DeduplicationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeduplicationSpec.
+func (in *DeduplicationSpec) DeepCopy() *DeduplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeduplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorMitigationSpec) DeepCopyInto(out *ErrorMitigationSpec) {
+	*out = *in
+	if in.ResilienceLevel != nil {
+		in, out := &in.ResilienceLevel, &out.ResilienceLevel
+		*out = new(int)
+		**out = **in
+	}
+	if in.Twirling != nil {
+		in, out := &in.Twirling, &out.Twirling
+		*out = new(TwirlingSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorMitigationSpec.
+func (in *ErrorMitigationSpec) DeepCopy() *ErrorMitigationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorMitigationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EstimationStatus) DeepCopyInto(out *EstimationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EstimationStatus.
+func (in *EstimationStatus) DeepCopy() *EstimationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EstimationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EstimatorSpec) DeepCopyInto(out *EstimatorSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EstimatorSpec.
+func (in *EstimatorSpec) DeepCopy() *EstimatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EstimatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionMetrics) DeepCopyInto(out *ExecutionMetrics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionMetrics.
+func (in *ExecutionMetrics) DeepCopy() *ExecutionMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
+	*out = *in
+	if in.Deadline != nil {
+		in, out := &in.Deadline, &out.Deadline
+		*out = (*in).DeepCopy()
+	}
+	if in.CompleteBy != nil {
+		in, out := &in.CompleteBy, &out.CompleteBy
+		*out = (*in).DeepCopy()
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraPackages != nil {
+		in, out := &in.ExtraPackages, &out.ExtraPackages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scratch != nil {
+		in, out := &in.Scratch, &out.Scratch
+		*out = new(ScratchSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Observables != nil {
+		in, out := &in.Observables, &out.Observables
+		*out = make([]Observable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Transpiler != nil {
+		in, out := &in.Transpiler, &out.Transpiler
+		*out = new(TranspilerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ErrorMitigation != nil {
+		in, out := &in.ErrorMitigation, &out.ErrorMitigation
+		*out = new(ErrorMitigationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSpec.
+func (in *ExecutionSpec) DeepCopy() *ExecutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutorLogs) DeepCopyInto(out *ExecutorLogs) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutorLogs.
+func (in *ExecutorLogs) DeepCopy() *ExecutorLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutorLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpectationValue) DeepCopyInto(out *ExpectationValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpectationValue.
+func (in *ExpectationValue) DeepCopy() *ExpectationValue {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpectationValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRef) DeepCopyInto(out *GitRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRef.
+func (in *GitRef) DeepCopy() *GitRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBackendSpec) DeepCopyInto(out *HTTPBackendSpec) {
+	*out = *in
+	out.Submit = in.Submit
+	out.Status = in.Status
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(HTTPEndpoint)
+		**out = **in
+	}
+	if in.Cancel != nil {
+		in, out := &in.Cancel, &out.Cancel
+		*out = new(HTTPEndpoint)
+		**out = **in
+	}
+	if in.Lookup != nil {
+		in, out := &in.Lookup, &out.Lookup
+		*out = new(HTTPEndpoint)
+		**out = **in
+	}
+	in.Mapping.DeepCopyInto(&out.Mapping)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBackendSpec.
+func (in *HTTPBackendSpec) DeepCopy() *HTTPBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPEndpoint) DeepCopyInto(out *HTTPEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPEndpoint.
+func (in *HTTPEndpoint) DeepCopy() *HTTPEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPResponseMapping) DeepCopyInto(out *HTTPResponseMapping) {
+	*out = *in
+	if in.CompletedStates != nil {
+		in, out := &in.CompletedStates, &out.CompletedStates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedStates != nil {
+		in, out := &in.FailedStates, &out.FailedStates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueuedStates != nil {
+		in, out := &in.QueuedStates, &out.QueuedStates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPResponseMapping.
+func (in *HTTPResponseMapping) DeepCopy() *HTTPResponseMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPResponseMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputObjectRef) DeepCopyInto(out *InputObjectRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputObjectRef.
+func (in *InputObjectRef) DeepCopy() *InputObjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(InputObjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputSpec) DeepCopyInto(out *InputSpec) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(InputObjectRef)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(InputObjectRef)
+		**out = **in
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(InputVolumeRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSpec.
+func (in *InputSpec) DeepCopy() *InputSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InputSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputStatus) DeepCopyInto(out *InputStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputStatus.
+func (in *InputStatus) DeepCopy() *InputStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InputStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputVolumeRef) DeepCopyInto(out *InputVolumeRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputVolumeRef.
+func (in *InputVolumeRef) DeepCopy() *InputVolumeRef {
+	if in == nil {
+		return nil
+	}
+	out := new(InputVolumeRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobLineage) DeepCopyInto(out *JobLineage) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobLineage.
+func (in *JobLineage) DeepCopy() *JobLineage {
+	if in == nil {
+		return nil
+	}
+	out := new(JobLineage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobOverrides) DeepCopyInto(out *JobOverrides) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(BackendSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Execution != nil {
+		in, out := &in.Execution, &out.Execution
+		*out = new(ExecutionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(BudgetSpec)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(OutputSpec)
+		**out = **in
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CredentialsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendSelection != nil {
+		in, out := &in.BackendSelection, &out.BackendSelection
+		*out = new(BackendSelectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobOverrides.
+func (in *JobOverrides) DeepCopy() *JobOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(JobOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTemplateMetadata) DeepCopyInto(out *JobTemplateMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTemplateMetadata.
+func (in *JobTemplateMetadata) DeepCopy() *JobTemplateMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTemplateMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceCost) DeepCopyInto(out *NamespaceCost) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceCost.
+func (in *NamespaceCost) DeepCopy() *NamespaceCost {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceCost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSpec) DeepCopyInto(out *NotificationSpec) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSpec.
+func (in *NotificationSpec) DeepCopy() *NotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationStatus) DeepCopyInto(out *NotificationStatus) {
+	*out = *in
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationStatus.
+func (in *NotificationStatus) DeepCopy() *NotificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observable) DeepCopyInto(out *Observable) {
+	*out = *in
+	if in.Terms != nil {
+		in, out := &in.Terms, &out.Terms
+		*out = make([]PauliTerm, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observable.
+func (in *Observable) DeepCopy() *Observable {
+	if in == nil {
+		return nil
+	}
+	out := new(Observable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptimizationStatus) DeepCopyInto(out *OptimizationStatus) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]float64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptimizationStatus.
+func (in *OptimizationStatus) DeepCopy() *OptimizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OptimizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptimizerSpec) DeepCopyInto(out *OptimizerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptimizerSpec.
+func (in *OptimizerSpec) DeepCopy() *OptimizerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OptimizerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutcomeProbability) DeepCopyInto(out *OutcomeProbability) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutcomeProbability.
+func (in *OutcomeProbability) DeepCopy() *OutcomeProbability {
+	if in == nil {
+		return nil
+	}
+	out := new(OutcomeProbability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputSpec.
+func (in *OutputSpec) DeepCopy() *OutputSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputStatus) DeepCopyInto(out *OutputStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStatus.
+func (in *OutputStatus) DeepCopy() *OutputStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PauliTerm) DeepCopyInto(out *PauliTerm) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauliTerm.
+func (in *PauliTerm) DeepCopy() *PauliTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(PauliTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
+	*out = *in
+	if in.AllowedRegions != nil {
+		in, out := &in.AllowedRegions, &out.AllowedRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSpec.
+func (in *PlacementSpec) DeepCopy() *PlacementSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderLimits) DeepCopyInto(out *ProviderLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderLimits.
+func (in *ProviderLimits) DeepCopy() *ProviderLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBackend) DeepCopyInto(out *QiskitBackend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackend.
+func (in *QiskitBackend) DeepCopy() *QiskitBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitBackend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBackendList) DeepCopyInto(out *QiskitBackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackendList.
+func (in *QiskitBackendList) DeepCopy() *QiskitBackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitBackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBackendSpec) DeepCopyInto(out *QiskitBackendSpec) {
+	*out = *in
+	if in.Foo != nil {
+		in, out := &in.Foo, &out.Foo
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackendSpec.
+func (in *QiskitBackendSpec) DeepCopy() *QiskitBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBackendStatus) DeepCopyInto(out *QiskitBackendStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBackendStatus.
+func (in *QiskitBackendStatus) DeepCopy() *QiskitBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBudget) DeepCopyInto(out *QiskitBudget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudget.
+func (in *QiskitBudget) DeepCopy() *QiskitBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitBudget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBudgetList) DeepCopyInto(out *QiskitBudgetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitBudget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudgetList.
+func (in *QiskitBudgetList) DeepCopy() *QiskitBudgetList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBudgetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitBudgetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBudgetSpec) DeepCopyInto(out *QiskitBudgetSpec) {
+	*out = *in
+	if in.Foo != nil {
+		in, out := &in.Foo, &out.Foo
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudgetSpec.
+func (in *QiskitBudgetSpec) DeepCopy() *QiskitBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBudgetStatus) DeepCopyInto(out *QiskitBudgetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudgetStatus.
+func (in *QiskitBudgetStatus) DeepCopy() *QiskitBudgetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBudgetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBulkOperation) DeepCopyInto(out *QiskitBulkOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBulkOperation.
+func (in *QiskitBulkOperation) DeepCopy() *QiskitBulkOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBulkOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitBulkOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBulkOperationList) DeepCopyInto(out *QiskitBulkOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitBulkOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBulkOperationList.
+func (in *QiskitBulkOperationList) DeepCopy() *QiskitBulkOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBulkOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitBulkOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBulkOperationSpec) DeepCopyInto(out *QiskitBulkOperationSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBulkOperationSpec.
+func (in *QiskitBulkOperationSpec) DeepCopy() *QiskitBulkOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBulkOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitBulkOperationStatus) DeepCopyInto(out *QiskitBulkOperationStatus) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]BulkJobFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBulkOperationStatus.
+func (in *QiskitBulkOperationStatus) DeepCopy() *QiskitBulkOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitBulkOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitCalendar) DeepCopyInto(out *QiskitCalendar) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitCalendar.
+func (in *QiskitCalendar) DeepCopy() *QiskitCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitCalendar) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitCalendarList) DeepCopyInto(out *QiskitCalendarList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitCalendar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitCalendarList.
+func (in *QiskitCalendarList) DeepCopy() *QiskitCalendarList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitCalendarList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitCalendarList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitCalendarSpec) DeepCopyInto(out *QiskitCalendarSpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]CalendarWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitCalendarSpec.
+func (in *QiskitCalendarSpec) DeepCopy() *QiskitCalendarSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitCalendarSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJob) DeepCopyInto(out *QiskitJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJob.
+func (in *QiskitJob) DeepCopy() *QiskitJob {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJobList) DeepCopyInto(out *QiskitJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobList.
+func (in *QiskitJobList) DeepCopy() *QiskitJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJobSpec) DeepCopyInto(out *QiskitJobSpec) {
+	*out = *in
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(TemplateRef)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(JobOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Backend.DeepCopyInto(&out.Backend)
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(QuantumBackendRef)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Circuit.DeepCopyInto(&out.Circuit)
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]InputSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Execution.DeepCopyInto(&out.Execution)
+	if in.Session != nil {
+		in, out := &in.Session, &out.Session
+		*out = new(SessionSpec)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(BudgetSpec)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(OutputSpec)
+		**out = **in
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]OutputSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Shadow != nil {
+		in, out := &in.Shadow, &out.Shadow
+		*out = new(ShadowSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(VerifySpec)
+		**out = **in
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = new(ArtifactsSpec)
+		**out = **in
+	}
+	if in.Optimizer != nil {
+		in, out := &in.Optimizer, &out.Optimizer
+		*out = new(OptimizerSpec)
+		**out = **in
+	}
+	if in.Estimator != nil {
+		in, out := &in.Estimator, &out.Estimator
+		*out = new(EstimatorSpec)
+		**out = **in
+	}
+	if in.Sweep != nil {
+		in, out := &in.Sweep, &out.Sweep
+		*out = new(SweepSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tomography != nil {
+		in, out := &in.Tomography, &out.Tomography
+		*out = new(TomographySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Split != nil {
+		in, out := &in.Split, &out.Split
+		*out = new(SplitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CredentialsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendSelection != nil {
+		in, out := &in.BackendSelection, &out.BackendSelection
+		*out = new(BackendSelectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deduplication != nil {
+		in, out := &in.Deduplication, &out.Deduplication
+		*out = new(DeduplicationSpec)
+		**out = **in
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobSpec.
+func (in *QiskitJobSpec) DeepCopy() *QiskitJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJobStatus) DeepCopyInto(out *QiskitJobStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BackendInfo != nil {
+		in, out := &in.BackendInfo, &out.BackendInfo
+		*out = new(BackendInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendSelection != nil {
+		in, out := &in.BackendSelection, &out.BackendSelection
+		*out = new(BackendSelectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueuePosition != nil {
+		in, out := &in.QueuePosition, &out.QueuePosition
+		*out = new(int)
+		**out = **in
+	}
+	if in.EstimatedStartTime != nil {
+		in, out := &in.EstimatedStartTime, &out.EstimatedStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextEligibleTime != nil {
+		in, out := &in.NextEligibleTime, &out.NextEligibleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Optimization != nil {
+		in, out := &in.Optimization, &out.Optimization
+		*out = new(OptimizationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Estimation != nil {
+		in, out := &in.Estimation, &out.Estimation
+		*out = new(EstimationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]InputStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = new(ResultsInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]OutputStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = new(ExecutorLogs)
+		**out = **in
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(ExecutionMetrics)
+		**out = **in
+	}
+	if in.NextRetryAt != nil {
+		in, out := &in.NextRetryAt, &out.NextRetryAt
+		*out = (*in).DeepCopy()
+	}
+	if in.AttemptDiagnostics != nil {
+		in, out := &in.AttemptDiagnostics, &out.AttemptDiagnostics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CircuitMetadata != nil {
+		in, out := &in.CircuitMetadata, &out.CircuitMetadata
+		*out = new(CircuitMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lineage != nil {
+		in, out := &in.Lineage, &out.Lineage
+		*out = new(JobLineage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Shadow != nil {
+		in, out := &in.Shadow, &out.Shadow
+		*out = new(ShadowStatus)
+		**out = **in
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationStatus)
+		**out = **in
+	}
+	if in.Sweep != nil {
+		in, out := &in.Sweep, &out.Sweep
+		*out = new(SweepStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tomography != nil {
+		in, out := &in.Tomography, &out.Tomography
+		*out = new(TomographyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Split != nil {
+		in, out := &in.Split, &out.Split
+		*out = new(SplitStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobStatus.
+func (in *QiskitJobStatus) DeepCopy() *QiskitJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJobTemplate) DeepCopyInto(out *QiskitJobTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobTemplate.
+func (in *QiskitJobTemplate) DeepCopy() *QiskitJobTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJobTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitJobTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJobTemplateList) DeepCopyInto(out *QiskitJobTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitJobTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobTemplateList.
+func (in *QiskitJobTemplateList) DeepCopy() *QiskitJobTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJobTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitJobTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJobTemplateSpec) DeepCopyInto(out *QiskitJobTemplateSpec) {
+	*out = *in
+	in.Backend.DeepCopyInto(&out.Backend)
+	in.Execution.DeepCopyInto(&out.Execution)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(BudgetSpec)
+		**out = **in
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(OutputSpec)
+		**out = **in
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CredentialsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendSelection != nil {
+		in, out := &in.BackendSelection, &out.BackendSelection
+		*out = new(BackendSelectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedOverrides != nil {
+		in, out := &in.AllowedOverrides, &out.AllowedOverrides
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobTemplateSpec.
+func (in *QiskitJobTemplateSpec) DeepCopy() *QiskitJobTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJobTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitJobTemplateSource) DeepCopyInto(out *QiskitJobTemplateSource) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobTemplateSource.
+func (in *QiskitJobTemplateSource) DeepCopy() *QiskitJobTemplateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitJobTemplateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitSession) DeepCopyInto(out *QiskitSession) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSession.
+func (in *QiskitSession) DeepCopy() *QiskitSession {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitSession) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitSessionList) DeepCopyInto(out *QiskitSessionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSessionList.
+func (in *QiskitSessionList) DeepCopy() *QiskitSessionList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitSessionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitSessionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitSessionSpec) DeepCopyInto(out *QiskitSessionSpec) {
+	*out = *in
+	in.Backend.DeepCopyInto(&out.Backend)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CredentialsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxTime != nil {
+		in, out := &in.MaxTime, &out.MaxTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSessionSpec.
+func (in *QiskitSessionSpec) DeepCopy() *QiskitSessionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitSessionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitSessionStatus) DeepCopyInto(out *QiskitSessionStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.OpeningSince != nil {
+		in, out := &in.OpeningSince, &out.OpeningSince
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSessionStatus.
+func (in *QiskitSessionStatus) DeepCopy() *QiskitSessionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitSessionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitWorkflow) DeepCopyInto(out *QiskitWorkflow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitWorkflow.
+func (in *QiskitWorkflow) DeepCopy() *QiskitWorkflow {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitWorkflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitWorkflow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitWorkflowList) DeepCopyInto(out *QiskitWorkflowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QiskitWorkflow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitWorkflowList.
+func (in *QiskitWorkflowList) DeepCopy() *QiskitWorkflowList {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitWorkflowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QiskitWorkflowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitWorkflowSpec) DeepCopyInto(out *QiskitWorkflowSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]WorkflowStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitWorkflowSpec.
+func (in *QiskitWorkflowSpec) DeepCopy() *QiskitWorkflowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitWorkflowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QiskitWorkflowStatus) DeepCopyInto(out *QiskitWorkflowStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]WorkflowStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitWorkflowStatus.
+func (in *QiskitWorkflowStatus) DeepCopy() *QiskitWorkflowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QiskitWorkflowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackend) DeepCopyInto(out *QuantumBackend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackend.
+func (in *QuantumBackend) DeepCopy() *QuantumBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumBackend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendLimits) DeepCopyInto(out *QuantumBackendLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendLimits.
+func (in *QuantumBackendLimits) DeepCopy() *QuantumBackendLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendList) DeepCopyInto(out *QuantumBackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendList.
+func (in *QuantumBackendList) DeepCopy() *QuantumBackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumBackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendPool) DeepCopyInto(out *QuantumBackendPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendPool.
+func (in *QuantumBackendPool) DeepCopy() *QuantumBackendPool {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumBackendPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendPoolList) DeepCopyInto(out *QuantumBackendPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumBackendPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendPoolList.
+func (in *QuantumBackendPoolList) DeepCopy() *QuantumBackendPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumBackendPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendPoolSpec) DeepCopyInto(out *QuantumBackendPoolSpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Limits = in.Limits
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendPoolSpec.
+func (in *QuantumBackendPoolSpec) DeepCopy() *QuantumBackendPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendPoolStatus) DeepCopyInto(out *QuantumBackendPoolStatus) {
+	*out = *in
+	if in.Breakers != nil {
+		in, out := &in.Breakers, &out.Breakers
+		*out = make([]BackendBreakerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueueWaits != nil {
+		in, out := &in.QueueWaits, &out.QueueWaits
+		*out = make([]BackendQueueWait, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendPoolStatus.
+func (in *QuantumBackendPoolStatus) DeepCopy() *QuantumBackendPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendRef) DeepCopyInto(out *QuantumBackendRef) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendRef.
+func (in *QuantumBackendRef) DeepCopy() *QuantumBackendRef {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendSpec) DeepCopyInto(out *QuantumBackendSpec) {
+	*out = *in
+	in.Backend.DeepCopyInto(&out.Backend)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CredentialsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(QuantumBackendLimits)
+		**out = **in
+	}
+	if in.ProbeInterval != nil {
+		in, out := &in.ProbeInterval, &out.ProbeInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendSpec.
+func (in *QuantumBackendSpec) DeepCopy() *QuantumBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumBackendStatus) DeepCopyInto(out *QuantumBackendStatus) {
+	*out = *in
+	if in.QueueLength != nil {
+		in, out := &in.QueueLength, &out.QueueLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Calibration != nil {
+		in, out := &in.Calibration, &out.Calibration
+		*out = new(BackendCalibration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumBackendStatus.
+func (in *QuantumBackendStatus) DeepCopy() *QuantumBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumCostReport) DeepCopyInto(out *QuantumCostReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumCostReport.
+func (in *QuantumCostReport) DeepCopy() *QuantumCostReport {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumCostReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumCostReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumCostReportList) DeepCopyInto(out *QuantumCostReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumCostReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumCostReportList.
+func (in *QuantumCostReportList) DeepCopy() *QuantumCostReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumCostReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumCostReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumCostReportSpec) DeepCopyInto(out *QuantumCostReportSpec) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]CostCenterThreshold, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumCostReportSpec.
+func (in *QuantumCostReportSpec) DeepCopy() *QuantumCostReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumCostReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumCostReportStatus) DeepCopyInto(out *QuantumCostReportStatus) {
+	*out = *in
+	in.CostPeriod.DeepCopyInto(&out.CostPeriod)
+	if in.Previous != nil {
+		in, out := &in.Previous, &out.Previous
+		*out = new(CostPeriod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumCostReportStatus.
+func (in *QuantumCostReportStatus) DeepCopy() *QuantumCostReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumCostReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumNamespaceProfile) DeepCopyInto(out *QuantumNamespaceProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumNamespaceProfile.
+func (in *QuantumNamespaceProfile) DeepCopy() *QuantumNamespaceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumNamespaceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumNamespaceProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumNamespaceProfileList) DeepCopyInto(out *QuantumNamespaceProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumNamespaceProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumNamespaceProfileList.
+func (in *QuantumNamespaceProfileList) DeepCopy() *QuantumNamespaceProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumNamespaceProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumNamespaceProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumNamespaceProfileSpec) DeepCopyInto(out *QuantumNamespaceProfileSpec) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CredentialsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]OutputSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumNamespaceProfileSpec.
+func (in *QuantumNamespaceProfileSpec) DeepCopy() *QuantumNamespaceProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumNamespaceProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumNamespaceStatus) DeepCopyInto(out *QuantumNamespaceStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumNamespaceStatus.
+func (in *QuantumNamespaceStatus) DeepCopy() *QuantumNamespaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumNamespaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumNamespaceStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumNamespaceStatusList) DeepCopyInto(out *QuantumNamespaceStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumNamespaceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumNamespaceStatusList.
+func (in *QuantumNamespaceStatusList) DeepCopy() *QuantumNamespaceStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumNamespaceStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumNamespaceStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumNamespaceStatusSpec) DeepCopyInto(out *QuantumNamespaceStatusSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumNamespaceStatusSpec.
+func (in *QuantumNamespaceStatusSpec) DeepCopy() *QuantumNamespaceStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumNamespaceStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumNamespaceStatusStatus) DeepCopyInto(out *QuantumNamespaceStatusStatus) {
+	*out = *in
+	if in.JobCounts != nil {
+		in, out := &in.JobCounts, &out.JobCounts
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumNamespaceStatusStatus.
+func (in *QuantumNamespaceStatusStatus) DeepCopy() *QuantumNamespaceStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumNamespaceStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumQuota) DeepCopyInto(out *QuantumQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumQuota.
+func (in *QuantumQuota) DeepCopy() *QuantumQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumQuotaList) DeepCopyInto(out *QuantumQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumQuotaList.
+func (in *QuantumQuotaList) DeepCopy() *QuantumQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumQuotaSpec) DeepCopyInto(out *QuantumQuotaSpec) {
+	*out = *in
+	if in.MaxConcurrentHardwareJobs != nil {
+		in, out := &in.MaxConcurrentHardwareJobs, &out.MaxConcurrentHardwareJobs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentExecutors != nil {
+		in, out := &in.MaxConcurrentExecutors, &out.MaxConcurrentExecutors
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxShots != nil {
+		in, out := &in.MaxShots, &out.MaxShots
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumQuotaSpec.
+func (in *QuantumQuotaSpec) DeepCopy() *QuantumQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumQuotaStatus) DeepCopyInto(out *QuantumQuotaStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumQuotaStatus.
+func (in *QuantumQuotaStatus) DeepCopy() *QuantumQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumRuntimeVersion) DeepCopyInto(out *QuantumRuntimeVersion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumRuntimeVersion.
+func (in *QuantumRuntimeVersion) DeepCopy() *QuantumRuntimeVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumRuntimeVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumRuntimeVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumRuntimeVersionList) DeepCopyInto(out *QuantumRuntimeVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumRuntimeVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumRuntimeVersionList.
+func (in *QuantumRuntimeVersionList) DeepCopy() *QuantumRuntimeVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumRuntimeVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumRuntimeVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumRuntimeVersionSpec) DeepCopyInto(out *QuantumRuntimeVersionSpec) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumRuntimeVersionSpec.
+func (in *QuantumRuntimeVersionSpec) DeepCopy() *QuantumRuntimeVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumRuntimeVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumRuntimeVersionStatus) DeepCopyInto(out *QuantumRuntimeVersionStatus) {
+	*out = *in
+	out.Stable = in.Stable
+	out.Canary = in.Canary
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumRuntimeVersionStatus.
+func (in *QuantumRuntimeVersionStatus) DeepCopy() *QuantumRuntimeVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumRuntimeVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumWorkspace) DeepCopyInto(out *QuantumWorkspace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumWorkspace.
+func (in *QuantumWorkspace) DeepCopy() *QuantumWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumWorkspace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumWorkspaceList) DeepCopyInto(out *QuantumWorkspaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuantumWorkspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumWorkspaceList.
+func (in *QuantumWorkspaceList) DeepCopy() *QuantumWorkspaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumWorkspaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuantumWorkspaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumWorkspaceSpec) DeepCopyInto(out *QuantumWorkspaceSpec) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(BackendSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CredentialsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(WorkspaceBudgetSpec)
+		**out = **in
+	}
+	if in.MaxLifetime != nil {
+		in, out := &in.MaxLifetime, &out.MaxLifetime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumWorkspaceSpec.
+func (in *QuantumWorkspaceSpec) DeepCopy() *QuantumWorkspaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumWorkspaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuantumWorkspaceStatus) DeepCopyInto(out *QuantumWorkspaceStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiryTime != nil {
+		in, out := &in.ExpiryTime, &out.ExpiryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuantumWorkspaceStatus.
+func (in *QuantumWorkspaceStatus) DeepCopy() *QuantumWorkspaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuantumWorkspaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QubitLayout) DeepCopyInto(out *QubitLayout) {
+	*out = *in
+	if in.PhysicalQubits != nil {
+		in, out := &in.PhysicalQubits, &out.PhysicalQubits
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClassicalRegisters != nil {
+		in, out := &in.ClassicalRegisters, &out.ClassicalRegisters
+		*out = make([]ClassicalRegister, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QubitLayout.
+func (in *QubitLayout) DeepCopy() *QubitLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(QubitLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirements.
+func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultArtifact) DeepCopyInto(out *ResultArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultArtifact.
+func (in *ResultArtifact) DeepCopy() *ResultArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResultsInfo) DeepCopyInto(out *ResultsInfo) {
+	*out = *in
+	if in.Probabilities != nil {
+		in, out := &in.Probabilities, &out.Probabilities
+		*out = make([]OutcomeProbability, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpectationValues != nil {
+		in, out := &in.ExpectationValues, &out.ExpectationValues
+		*out = make([]ExpectationValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mitigations != nil {
+		in, out := &in.Mitigations, &out.Mitigations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ResultArtifact, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsInfo.
+func (in *ResultsInfo) DeepCopy() *ResultsInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ResultsInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InitialDelay != nil {
+		in, out := &in.InitialDelay, &out.InitialDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]RetryableFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTrack) DeepCopyInto(out *RolloutTrack) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudget.
-func (in *QiskitBudget) DeepCopy() *QiskitBudget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTrack.
+func (in *RolloutTrack) DeepCopy() *RolloutTrack {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBudget)
+	out := new(RolloutTrack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledQiskitJob) DeepCopyInto(out *ScheduledQiskitJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledQiskitJob.
+func (in *ScheduledQiskitJob) DeepCopy() *ScheduledQiskitJob {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledQiskitJob)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitBudget) DeepCopyObject() runtime.Object {
+func (in *ScheduledQiskitJob) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -386,31 +3513,31 @@ func (in *QiskitBudget) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBudgetList) DeepCopyInto(out *QiskitBudgetList) {
+func (in *ScheduledQiskitJobList) DeepCopyInto(out *ScheduledQiskitJobList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]QiskitBudget, len(*in))
+		*out = make([]ScheduledQiskitJob, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudgetList.
-func (in *QiskitBudgetList) DeepCopy() *QiskitBudgetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledQiskitJobList.
+func (in *ScheduledQiskitJobList) DeepCopy() *ScheduledQiskitJobList {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBudgetList)
+	out := new(ScheduledQiskitJobList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitBudgetList) DeepCopyObject() runtime.Object {
+func (in *ScheduledQiskitJobList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -418,388 +3545,630 @@ func (in *QiskitBudgetList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBudgetSpec) DeepCopyInto(out *QiskitBudgetSpec) {
+func (in *ScheduledQiskitJobSpec) DeepCopyInto(out *ScheduledQiskitJobSpec) {
 	*out = *in
-	if in.Foo != nil {
-		in, out := &in.Foo, &out.Foo
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.JobTemplate.DeepCopyInto(&out.JobTemplate)
+	if in.SuccessfulJobsHistoryLimit != nil {
+		in, out := &in.SuccessfulJobsHistoryLimit, &out.SuccessfulJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedJobsHistoryLimit != nil {
+		in, out := &in.FailedJobsHistoryLimit, &out.FailedJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledQiskitJobSpec.
+func (in *ScheduledQiskitJobSpec) DeepCopy() *ScheduledQiskitJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledQiskitJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledQiskitJobStatus) DeepCopyInto(out *ScheduledQiskitJobStatus) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledQiskitJobStatus.
+func (in *ScheduledQiskitJobStatus) DeepCopy() *ScheduledQiskitJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledQiskitJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingSpec) DeepCopyInto(out *SchedulingSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingSpec.
+func (in *SchedulingSpec) DeepCopy() *SchedulingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScratchSpec) DeepCopyInto(out *ScratchSpec) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudgetSpec.
-func (in *QiskitBudgetSpec) DeepCopy() *QiskitBudgetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScratchSpec.
+func (in *ScratchSpec) DeepCopy() *ScratchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScratchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRef) DeepCopyInto(out *SecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRef.
+func (in *SecretRef) DeepCopy() *SecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsStoreSpec) DeepCopyInto(out *SecretsStoreSpec) {
+	*out = *in
+	if in.NodePublishSecretRef != nil {
+		in, out := &in.NodePublishSecretRef, &out.NodePublishSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsStoreSpec.
+func (in *SecretsStoreSpec) DeepCopy() *SecretsStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
+	*out = *in
+	if in.AllowNetworkEgress != nil {
+		in, out := &in.AllowNetworkEgress, &out.AllowNetworkEgress
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
+func (in *SecuritySpec) DeepCopy() *SecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionSpec) DeepCopyInto(out *SessionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionSpec.
+func (in *SessionSpec) DeepCopy() *SessionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShadowSpec) DeepCopyInto(out *ShadowSpec) {
+	*out = *in
+	in.Backend.DeepCopyInto(&out.Backend)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShadowSpec.
+func (in *ShadowSpec) DeepCopy() *ShadowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShadowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShadowStatus) DeepCopyInto(out *ShadowStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShadowStatus.
+func (in *ShadowStatus) DeepCopy() *ShadowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShadowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBackend) DeepCopyInto(out *SplitBackend) {
+	*out = *in
+	in.Backend.DeepCopyInto(&out.Backend)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBackend.
+func (in *SplitBackend) DeepCopy() *SplitBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitPartStatus) DeepCopyInto(out *SplitPartStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitPartStatus.
+func (in *SplitPartStatus) DeepCopy() *SplitPartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitPartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitSpec) DeepCopyInto(out *SplitSpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]SplitBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitSpec.
+func (in *SplitSpec) DeepCopy() *SplitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitStatus) DeepCopyInto(out *SplitStatus) {
+	*out = *in
+	if in.Parts != nil {
+		in, out := &in.Parts, &out.Parts
+		*out = make([]SplitPartStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitStatus.
+func (in *SplitStatus) DeepCopy() *SplitStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SweepBindingStatus) DeepCopyInto(out *SweepBindingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SweepBindingStatus.
+func (in *SweepBindingStatus) DeepCopy() *SweepBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SweepBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SweepRange) DeepCopyInto(out *SweepRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SweepRange.
+func (in *SweepRange) DeepCopy() *SweepRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SweepRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SweepSpec) DeepCopyInto(out *SweepSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]map[string]float64, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make(map[string]float64, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+		}
+	}
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]SweepRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SweepSpec.
+func (in *SweepSpec) DeepCopy() *SweepSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBudgetSpec)
+	out := new(SweepSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitBudgetStatus) DeepCopyInto(out *QiskitBudgetStatus) {
+func (in *SweepStatus) DeepCopyInto(out *SweepStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]SweepBindingStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitBudgetStatus.
-func (in *QiskitBudgetStatus) DeepCopy() *QiskitBudgetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SweepStatus.
+func (in *SweepStatus) DeepCopy() *SweepStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitBudgetStatus)
+	out := new(SweepStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitJob) DeepCopyInto(out *QiskitJob) {
+func (in *TemplateRef) DeepCopyInto(out *TemplateRef) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJob.
-func (in *QiskitJob) DeepCopy() *QiskitJob {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateRef.
+func (in *TemplateRef) DeepCopy() *TemplateRef {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitJob)
+	out := new(TemplateRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitJob) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitJobList) DeepCopyInto(out *QiskitJobList) {
+func (in *TomographySettingStatus) DeepCopyInto(out *TomographySettingStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]QiskitJob, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobList.
-func (in *QiskitJobList) DeepCopy() *QiskitJobList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TomographySettingStatus.
+func (in *TomographySettingStatus) DeepCopy() *TomographySettingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitJobList)
+	out := new(TomographySettingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitJobList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitJobSpec) DeepCopyInto(out *QiskitJobSpec) {
+func (in *TomographySpec) DeepCopyInto(out *TomographySpec) {
 	*out = *in
-	out.Backend = in.Backend
-	in.Circuit.DeepCopyInto(&out.Circuit)
-	out.Execution = in.Execution
-	if in.Session != nil {
-		in, out := &in.Session, &out.Session
-		*out = new(SessionSpec)
-		**out = **in
-	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Budget != nil {
-		in, out := &in.Budget, &out.Budget
-		*out = new(BudgetSpec)
-		**out = **in
-	}
-	if in.Output != nil {
-		in, out := &in.Output, &out.Output
-		*out = new(OutputSpec)
-		**out = **in
-	}
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = new(CredentialsSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BackendSelection != nil {
-		in, out := &in.BackendSelection, &out.BackendSelection
-		*out = new(BackendSelectionSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Qubits != nil {
+		in, out := &in.Qubits, &out.Qubits
+		*out = make([]int, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobSpec.
-func (in *QiskitJobSpec) DeepCopy() *QiskitJobSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TomographySpec.
+func (in *TomographySpec) DeepCopy() *TomographySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitJobSpec)
+	out := new(TomographySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitJobStatus) DeepCopyInto(out *QiskitJobStatus) {
+func (in *TomographyStatus) DeepCopyInto(out *TomographyStatus) {
 	*out = *in
-	if in.StartTime != nil {
-		in, out := &in.StartTime, &out.StartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.CompletionTime != nil {
-		in, out := &in.CompletionTime, &out.CompletionTime
-		*out = (*in).DeepCopy()
-	}
-	if in.BackendInfo != nil {
-		in, out := &in.BackendInfo, &out.BackendInfo
-		*out = new(BackendInfo)
-		**out = **in
-	}
-	if in.QueuePosition != nil {
-		in, out := &in.QueuePosition, &out.QueuePosition
-		*out = new(int)
-		**out = **in
-	}
-	if in.EstimatedStartTime != nil {
-		in, out := &in.EstimatedStartTime, &out.EstimatedStartTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Results != nil {
-		in, out := &in.Results, &out.Results
-		*out = new(ResultsInfo)
-		**out = **in
-	}
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
-		*out = new(ExecutionMetrics)
-		**out = **in
-	}
-	if in.NextRetryAt != nil {
-		in, out := &in.NextRetryAt, &out.NextRetryAt
-		*out = (*in).DeepCopy()
-	}
-	if in.CircuitMetadata != nil {
-		in, out := &in.CircuitMetadata, &out.CircuitMetadata
-		*out = new(CircuitMetadata)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make([]TomographySettingStatus, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitJobStatus.
-func (in *QiskitJobStatus) DeepCopy() *QiskitJobStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TomographyStatus.
+func (in *TomographyStatus) DeepCopy() *TomographyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitJobStatus)
+	out := new(TomographyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitSession) DeepCopyInto(out *QiskitSession) {
+func (in *TranspilationMetadata) DeepCopyInto(out *TranspilationMetadata) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.PhysicalQubits != nil {
+		in, out := &in.PhysicalQubits, &out.PhysicalQubits
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSession.
-func (in *QiskitSession) DeepCopy() *QiskitSession {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TranspilationMetadata.
+func (in *TranspilationMetadata) DeepCopy() *TranspilationMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitSession)
+	out := new(TranspilationMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitSession) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitSessionList) DeepCopyInto(out *QiskitSessionList) {
+func (in *TranspiledCircuitMetadata) DeepCopyInto(out *TranspiledCircuitMetadata) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]QiskitSession, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.GateTypes != nil {
+		in, out := &in.GateTypes, &out.GateTypes
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GateTypeDeltas != nil {
+		in, out := &in.GateTypeDeltas, &out.GateTypeDeltas
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSessionList.
-func (in *QiskitSessionList) DeepCopy() *QiskitSessionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TranspiledCircuitMetadata.
+func (in *TranspiledCircuitMetadata) DeepCopy() *TranspiledCircuitMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitSessionList)
+	out := new(TranspiledCircuitMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QiskitSessionList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitSessionSpec) DeepCopyInto(out *QiskitSessionSpec) {
+func (in *TranspilerSpec) DeepCopyInto(out *TranspilerSpec) {
 	*out = *in
-	if in.Foo != nil {
-		in, out := &in.Foo, &out.Foo
-		*out = new(string)
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int64)
 		**out = **in
 	}
+	if in.BasisGates != nil {
+		in, out := &in.BasisGates, &out.BasisGates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSessionSpec.
-func (in *QiskitSessionSpec) DeepCopy() *QiskitSessionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TranspilerSpec.
+func (in *TranspilerSpec) DeepCopy() *TranspilerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitSessionSpec)
+	out := new(TranspilerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QiskitSessionStatus) DeepCopyInto(out *QiskitSessionStatus) {
+func (in *TwirlingSpec) DeepCopyInto(out *TwirlingSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QiskitSessionStatus.
-func (in *QiskitSessionStatus) DeepCopy() *QiskitSessionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwirlingSpec.
+func (in *TwirlingSpec) DeepCopy() *TwirlingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QiskitSessionStatus)
+	out := new(TwirlingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
+func (in *VerificationStatus) DeepCopyInto(out *VerificationStatus) {
 	*out = *in
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationStatus.
+func (in *VerificationStatus) DeepCopy() *VerificationStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(VerificationStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirements.
-func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerifySpec) DeepCopyInto(out *VerifySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerifySpec.
+func (in *VerifySpec) DeepCopy() *VerifySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRequirements)
+	out := new(VerifySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResultsInfo) DeepCopyInto(out *ResultsInfo) {
+func (in *WorkflowStep) DeepCopyInto(out *WorkflowStep) {
 	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResultsFrom != nil {
+		in, out := &in.ResultsFrom, &out.ResultsFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResultsInfo.
-func (in *ResultsInfo) DeepCopy() *ResultsInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStep.
+func (in *WorkflowStep) DeepCopy() *WorkflowStep {
 	if in == nil {
 		return nil
 	}
-	out := new(ResultsInfo)
+	out := new(WorkflowStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretRef) DeepCopyInto(out *SecretRef) {
+func (in *WorkflowStepStatus) DeepCopyInto(out *WorkflowStepStatus) {
 	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRef.
-func (in *SecretRef) DeepCopy() *SecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStepStatus.
+func (in *WorkflowStepStatus) DeepCopy() *WorkflowStepStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretRef)
+	out := new(WorkflowStepStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SessionSpec) DeepCopyInto(out *SessionSpec) {
+func (in *WorkspaceBudgetSpec) DeepCopyInto(out *WorkspaceBudgetSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionSpec.
-func (in *SessionSpec) DeepCopy() *SessionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceBudgetSpec.
+func (in *WorkspaceBudgetSpec) DeepCopy() *WorkspaceBudgetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SessionSpec)
+	out := new(WorkspaceBudgetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ConversionDataAnnotation holds, as JSON, the spec and status of the v1
+// QiskitJob a v1alpha1 one was converted from. Most v1 fields have no
+// v1alpha1 counterpart; they are restored from it when the job is converted
+// back, so reading and writing a job at v1alpha1 loses nothing.
+const ConversionDataAnnotation = "quantum.io/v1-conversion-data"
+
+// conversionData is what ConversionDataAnnotation holds
+type conversionData struct {
+	Spec   quantumv1.QiskitJobSpec   `json:"spec"`
+	Status quantumv1.QiskitJobStatus `json:"status,omitempty,omitzero"`
+}
+
+// ConvertTo converts the job to v1, on top of the v1 fields recorded when it
+// was converted from v1
+func (src *QiskitJob) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*quantumv1.QiskitJob)
+	if !ok {
+		return fmt.Errorf("expected a v1 QiskitJob but got %T", dstRaw)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	dst.Spec = quantumv1.QiskitJobSpec{}
+	dst.Status = quantumv1.QiskitJobStatus{}
+	if raw, ok := src.Annotations[ConversionDataAnnotation]; ok {
+		var data conversionData
+		if err := json.Unmarshal([]byte(raw), &data); err != nil {
+			return fmt.Errorf("invalid %s annotation of QiskitJob %s/%s: %w",
+				ConversionDataAnnotation, src.Namespace, src.Name, err)
+		}
+		dst.Spec, dst.Status = data.Spec, data.Status
+		delete(dst.Annotations, ConversionDataAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+
+	spec := &dst.Spec
+	spec.Backend.Type = src.Spec.Backend.Type
+	spec.Backend.Name = src.Spec.Backend.Name
+	spec.Backend.Hub = src.Spec.Backend.Hub
+	spec.Backend.Group = src.Spec.Backend.Group
+	spec.Backend.Project = src.Spec.Backend.Project
+
+	spec.Circuit.Source = src.Spec.Circuit.Source
+	spec.Circuit.Code = src.Spec.Circuit.Code
+	spec.Circuit.URL = src.Spec.Circuit.URL
+	spec.Circuit.ConfigMapRef = nil
+	if ref := src.Spec.Circuit.ConfigMapRef; ref != nil {
+		spec.Circuit.ConfigMapRef = &quantumv1.ConfigMapRef{Name: ref.Name, Key: ref.Key}
+	}
+
+	spec.Execution.Shots = src.Spec.Execution.Shots
+	spec.Execution.OptimizationLevel = src.Spec.Execution.OptimizationLevel
+	spec.Execution.ResilienceLevel = src.Spec.Execution.ResilienceLevel
+	spec.Execution.MaxExecutionTime = src.Spec.Execution.MaxExecutionTime
+	spec.Execution.Priority = src.Spec.Execution.Priority
+
+	// The single output stands for the first of the v1 outputs
+	switch output := src.Spec.Output; {
+	case output == nil:
+		spec.Output, spec.Outputs = nil, nil
+	case len(spec.Outputs) > 0:
+		spec.Outputs[0].Type = output.Type
+		spec.Outputs[0].Location = output.Location
+		spec.Outputs[0].Format = output.Format
+	default:
+		if spec.Output == nil {
+			spec.Output = &quantumv1.OutputSpec{}
+		}
+		spec.Output.Type = output.Type
+		spec.Output.Location = output.Location
+		spec.Output.Format = output.Format
+	}
+
+	status := &dst.Status
+	status.Phase = quantumv1.QiskitJobPhase(src.Status.Phase)
+	status.Message = src.Status.Message
+	status.StartTime = src.Status.StartTime.DeepCopy()
+	status.CompletionTime = src.Status.CompletionTime.DeepCopy()
+	status.SelectedBackend = src.Status.SelectedBackend
+	status.JobID = src.Status.JobID
+	status.RetryCount = src.Status.RetryCount
+	status.Conditions = src.DeepCopy().Status.Conditions
+	return nil
+}
+
+// ConvertFrom converts a v1 job to v1alpha1, recording the whole of its spec
+// and status in ConversionDataAnnotation
+func (dst *QiskitJob) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*quantumv1.QiskitJob)
+	if !ok {
+		return fmt.Errorf("expected a v1 QiskitJob but got %T", srcRaw)
+	}
+	data, err := json.Marshal(conversionData{Spec: src.Spec, Status: src.Status})
+	if err != nil {
+		return err
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[ConversionDataAnnotation] = string(data)
+
+	dst.Spec = QiskitJobSpec{
+		Backend: BackendSpec{
+			Type:    src.Spec.Backend.Type,
+			Name:    src.Spec.Backend.Name,
+			Hub:     src.Spec.Backend.Hub,
+			Group:   src.Spec.Backend.Group,
+			Project: src.Spec.Backend.Project,
+		},
+		Circuit: CircuitSpec{
+			Source: src.Spec.Circuit.Source,
+			Code:   src.Spec.Circuit.Code,
+			URL:    src.Spec.Circuit.URL,
+		},
+		Execution: ExecutionSpec{
+			Shots:             src.Spec.Execution.Shots,
+			OptimizationLevel: src.Spec.Execution.OptimizationLevel,
+			ResilienceLevel:   src.Spec.Execution.ResilienceLevel,
+			MaxExecutionTime:  src.Spec.Execution.MaxExecutionTime,
+			Priority:          src.Spec.Execution.Priority,
+		},
+	}
+	if ref := src.Spec.Circuit.ConfigMapRef; ref != nil {
+		dst.Spec.Circuit.ConfigMapRef = &ConfigMapRef{Name: ref.Name, Key: ref.Key}
+	}
+	output := src.Spec.Output
+	if len(src.Spec.Outputs) > 0 {
+		output = &src.Spec.Outputs[0]
+	}
+	if output != nil {
+		dst.Spec.Output = &OutputSpec{Type: output.Type, Location: output.Location, Format: output.Format}
+	}
+
+	dst.Status = QiskitJobStatus{
+		Phase:           string(src.Status.Phase),
+		Message:         src.Status.Message,
+		StartTime:       src.Status.StartTime.DeepCopy(),
+		CompletionTime:  src.Status.CompletionTime.DeepCopy(),
+		SelectedBackend: src.Status.SelectedBackend,
+		JobID:           src.Status.JobID,
+		RetryCount:      src.Status.RetryCount,
+		Conditions:      src.DeepCopy().Status.Conditions,
+	}
+	return nil
+}
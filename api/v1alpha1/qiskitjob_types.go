@@ -0,0 +1,213 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QiskitJobSpec defines the desired state of QiskitJob: a circuit, the
+// backend it runs on and the single output its results are written to
+type QiskitJobSpec struct {
+	// Backend configuration
+	// +required
+	Backend BackendSpec `json:"backend"`
+
+	// Circuit specification
+	// +required
+	Circuit CircuitSpec `json:"circuit"`
+
+	// Execution parameters
+	// +optional
+	Execution ExecutionSpec `json:"execution,omitempty"`
+
+	// Output configuration
+	// +optional
+	Output *OutputSpec `json:"output,omitempty"`
+}
+
+// BackendSpec defines the quantum backend configuration
+type BackendSpec struct {
+	// Backend type (e.g., "local_simulator", "ibm_quantum")
+	// +required
+	Type string `json:"type"`
+
+	// Backend name (e.g., "ibm_brisbane", "aer_simulator")
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// IBM Quantum Network hub
+	// +optional
+	Hub string `json:"hub,omitempty"`
+
+	// IBM Quantum Network group
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// IBM Quantum Network project
+	// +optional
+	Project string `json:"project,omitempty"`
+}
+
+// CircuitSpec defines the quantum circuit to execute
+type CircuitSpec struct {
+	// Source of the circuit code: inline, configmap or url
+	// +required
+	Source string `json:"source"`
+
+	// Inline circuit code
+	// +optional
+	Code string `json:"code,omitempty"`
+
+	// ConfigMap holding the circuit code
+	// +optional
+	ConfigMapRef *ConfigMapRef `json:"configMapRef,omitempty"`
+
+	// URL to fetch the circuit code from
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// ConfigMapRef references a key of a ConfigMap
+type ConfigMapRef struct {
+	// Name of the ConfigMap
+	// +required
+	Name string `json:"name"`
+
+	// Key in the ConfigMap
+	// +required
+	Key string `json:"key"`
+}
+
+// ExecutionSpec defines execution parameters
+type ExecutionSpec struct {
+	// Number of shots
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1024
+	// +optional
+	Shots int `json:"shots,omitempty"`
+
+	// Transpiler optimization level
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3
+	// +optional
+	OptimizationLevel int `json:"optimizationLevel,omitempty"`
+
+	// Error mitigation resilience level
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	ResilienceLevel int `json:"resilienceLevel,omitempty"`
+
+	// Maximum execution time (e.g., "10m")
+	// +optional
+	MaxExecutionTime string `json:"maxExecutionTime,omitempty"`
+
+	// Job priority
+	// +optional
+	Priority string `json:"priority,omitempty"`
+}
+
+// OutputSpec defines where results are written
+type OutputSpec struct {
+	// Output type (e.g., "configmap", "s3")
+	// +required
+	Type string `json:"type"`
+
+	// Output location: a claim, bucket or ConfigMap name
+	// +required
+	Location string `json:"location"`
+
+	// Output format
+	// +optional
+	Format string `json:"format,omitempty"`
+}
+
+// QiskitJobStatus defines the observed state of QiskitJob
+type QiskitJobStatus struct {
+	// Phase of the job lifecycle
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Human-readable message about the current state
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Job start time
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Job completion time
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Selected backend for execution
+	// +optional
+	SelectedBackend string `json:"selectedBackend,omitempty"`
+
+	// ID of the execution or provider job
+	// +optional
+	JobID string `json:"jobId,omitempty"`
+
+	// Number of retries attempted
+	// +optional
+	RetryCount int `json:"retryCount,omitempty"`
+
+	// Conditions represent the current state of the job
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:deprecatedversion:warning="quantum.quantum.io/v1alpha1 QiskitJob is deprecated; use quantum.quantum.io/v1"
+// +kubebuilder:resource:shortName=qjob;qj
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Backend",type=string,JSONPath=`.status.selectedBackend`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QiskitJob is the Schema for the qiskitjobs API
+type QiskitJob struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of QiskitJob
+	// +required
+	Spec QiskitJobSpec `json:"spec"`
+
+	// status defines the observed state of QiskitJob
+	// +optional
+	Status QiskitJobStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// QiskitJobList contains a list of QiskitJob
+type QiskitJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QiskitJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QiskitJob{}, &QiskitJobList{})
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(quantumv1.AddToScheme(scheme))
+}
+
+var actions = map[string]string{
+	"cancel":  controller.BulkActionCancel,
+	"suspend": controller.BulkActionSuspend,
+	"resume":  controller.BulkActionResume,
+	"delete":  controller.BulkActionDelete,
+}
+
+// bulk cancels, suspends, resumes or deletes the QiskitJobs of a namespace
+// matching a label selector. It creates a QiskitBulkOperation, which the
+// operator carries out at a limited rate, waits for it to finish and prints
+// its report. With --dry-run it only lists the jobs that would be acted on.
+func main() {
+	var namespace, selector, phases, reason, output string
+	var rate int
+	var dryRun bool
+	var timeout time.Duration
+	flag.StringVar(&namespace, "namespace", "default", "Namespace of the QiskitJobs.")
+	flag.StringVar(&selector, "selector", "", "Label selector of the QiskitJobs, e.g. quantum.io/experiment=foo. Required.")
+	flag.StringVar(&phases, "phases", "", "Comma-separated phases to restrict the action to. Empty acts on jobs in any phase.")
+	flag.StringVar(&reason, "reason", "", "Reason recorded on cancelled jobs.")
+	flag.IntVar(&rate, "rate", controller.DefaultBulkJobsPerSecond, "Jobs acted on per second.")
+	flag.BoolVar(&dryRun, "dry-run", false, "List the jobs that would be acted on without acting.")
+	flag.DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait for the operation to finish.")
+	flag.StringVar(&output, "output", "text", "Report format: text or json.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] cancel|suspend|resume|delete\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	action, ok := actions[strings.ToLower(flag.Arg(0))]
+	if flag.NArg() != 1 || !ok {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if output != "text" && output != "json" {
+		fmt.Fprintf(os.Stderr, "unknown --output %q, must be text or json\n", output)
+		os.Exit(2)
+	}
+	if selector == "" {
+		fmt.Fprintln(os.Stderr, "--selector is required")
+		os.Exit(2)
+	}
+	labelSelector, err := metav1.ParseToLabelSelector(selector)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid --selector: %v\n", err)
+		os.Exit(2)
+	}
+
+	op := &quantumv1.QiskitBulkOperation{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "bulk-" + strings.ToLower(action) + "-",
+			Namespace:    namespace,
+		},
+		Spec: quantumv1.QiskitBulkOperationSpec{
+			Action:        action,
+			Selector:      *labelSelector,
+			JobsPerSecond: int32(rate),
+			Reason:        reason,
+		},
+	}
+	if phases != "" {
+		op.Spec.Phases = strings.Split(phases, ",")
+	}
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create client: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	if dryRun {
+		err = listSelected(ctx, c, op)
+	} else {
+		err = run(ctx, c, op, timeout, output)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if op.Status.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// listSelected prints the jobs the operation would act on
+func listSelected(ctx context.Context, c client.Client, op *quantumv1.QiskitBulkOperation) error {
+	selector, err := metav1.LabelSelectorAsSelector(&op.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	var jobs quantumv1.QiskitJobList
+	if err := c.List(ctx, &jobs, client.InNamespace(op.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return err
+	}
+	selected := controller.SelectedJobs(op, jobs.Items)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tPHASE\tSUSPENDED")
+	for _, job := range selected {
+		fmt.Fprintf(tw, "%s\t%s\t%t\n", job.Name, job.Status.Phase, job.Spec.Suspend)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("Would %s %d QiskitJobs in %s\n", strings.ToLower(op.Spec.Action), len(selected), op.Namespace)
+	return nil
+}
+
+// run creates the operation and waits for the operator to finish it
+func run(ctx context.Context, c client.Client, op *quantumv1.QiskitBulkOperation, timeout time.Duration, output string) error {
+	if err := c.Create(ctx, op); err != nil {
+		return err
+	}
+	if output == "text" {
+		fmt.Printf("Created QiskitBulkOperation %s/%s, waiting for it to finish...\n", op.Namespace, op.Name)
+	}
+
+	err := wait.PollUntilContextTimeout(ctx, 2*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(op), op); err != nil {
+			return false, err
+		}
+		return op.Status.Phase == controller.BulkPhaseCompleted, nil
+	})
+	if err != nil {
+		return fmt.Errorf("QiskitBulkOperation %s/%s did not finish: %w; follow it with kubectl get qbo -n %s %s",
+			op.Namespace, op.Name, err, op.Namespace, op.Name)
+	}
+
+	if output == "json" {
+		return writeJSON(os.Stdout, &op.Status)
+	}
+	return writeText(os.Stdout, &op.Status)
+}
+
+func writeJSON(w io.Writer, status *quantumv1.QiskitBulkOperationStatus) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(status)
+}
+
+func writeText(w io.Writer, status *quantumv1.QiskitBulkOperationStatus) error {
+	if len(status.Failures) > 0 {
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "NAME\tERROR")
+		for _, failure := range status.Failures {
+			fmt.Fprintf(tw, "%s\t%s\n", failure.Name, failure.Message)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, status.Message)
+	return err
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(quantumv1.AddToScheme(scheme))
+}
+
+// debug asks the operator for a debug pod of a failed QiskitJob, waits for it
+// to start and prints how to exec into it. With --stop it removes the debug
+// pod again.
+func main() {
+	var namespace string
+	var stop bool
+	var timeout time.Duration
+	flag.StringVar(&namespace, "namespace", "default", "Namespace of the QiskitJob.")
+	flag.BoolVar(&stop, "stop", false, "Delete the debug pod instead of creating it.")
+	flag.DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for the debug pod to start.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] JOB\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create client: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := run(context.Background(), c, client.ObjectKey{Namespace: namespace, Name: flag.Arg(0)}, stop, timeout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, c client.Client, key client.ObjectKey, stop bool, timeout time.Duration) error {
+	var job quantumv1.QiskitJob
+	if err := c.Get(ctx, key, &job); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(job.DeepCopy())
+	if stop {
+		delete(job.Annotations, controller.DebugAnnotation)
+		if err := c.Patch(ctx, &job, patch); err != nil {
+			return err
+		}
+		fmt.Printf("Debug pod of %s/%s will be deleted\n", job.Namespace, job.Name)
+		return nil
+	}
+
+	if job.Status.Phase != controller.PhaseFailed {
+		return fmt.Errorf("QiskitJob %s/%s is %s, only failed jobs can be debugged", job.Namespace, job.Name, job.Status.Phase)
+	}
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[controller.DebugAnnotation] = "true"
+	if err := c.Patch(ctx, &job, patch); err != nil {
+		return err
+	}
+
+	podKey := client.ObjectKey{Namespace: controller.ExecutionNamespace(&job), Name: controller.DebugPodName(&job)}
+	fmt.Printf("Waiting for debug pod %s to start...\n", podKey.Name)
+	err := wait.PollUntilContextTimeout(ctx, 2*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		var pod corev1.Pod
+		if err := c.Get(ctx, podKey, &pod); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		switch pod.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodSucceeded, corev1.PodFailed:
+			return false, fmt.Errorf("debug pod %s already stopped; run with --stop, then try again", pod.Name)
+		}
+		return false, nil
+	})
+	if apierrors.IsForbidden(err) {
+		return fmt.Errorf("not allowed to read pods in %s: %w", podKey.Namespace, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf(`Debug pod is running. Inspect the environment with
+
+  kubectl exec -it -n %s %s -- sh
+
+and run the job by hand with
+
+  sh -c "$EXECUTOR_SCRIPT"
+
+When done, delete the debug pod with --stop.
+`, podKey.Namespace, podKey.Name)
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/quantum-operator/qiskit-operator/internal/summary"
+)
+
+// jobs lists QiskitJobs with their phase, backend, cost and duration. It
+// asks the operator for the summaries of all matching jobs in one call,
+// served from the operator's cache, instead of reading every full job from
+// the API server.
+func main() {
+	var server, tokenFile, namespace, selector, phases, output string
+	var insecure bool
+	var timeout time.Duration
+	flag.StringVar(&server, "server", "https://localhost:8443",
+		"URL of the operator's metrics server, e.g. through kubectl port-forward.")
+	flag.StringVar(&tokenFile, "token-file", "", "A file with a bearer token allowed to get "+summary.Path+".")
+	flag.BoolVar(&insecure, "insecure-skip-tls-verify", false,
+		"Skip verifying the server's certificate, for the self-signed certificate the operator generates.")
+	flag.StringVar(&namespace, "namespace", "", "Namespace of the QiskitJobs. Empty lists every namespace.")
+	flag.StringVar(&selector, "selector", "", "Label selector of the QiskitJobs, e.g. quantum.io/experiment=foo.")
+	flag.StringVar(&phases, "phases", "", "Comma-separated phases to restrict the listing to.")
+	flag.StringVar(&output, "output", "text", "Listing format: text or json.")
+	flag.DurationVar(&timeout, "timeout", time.Minute, "How long to wait for the operator.")
+	flag.Parse()
+	if output != "text" && output != "json" {
+		fmt.Fprintf(os.Stderr, "unknown --output %q, must be text or json\n", output)
+		os.Exit(2)
+	}
+
+	var token string
+	if tokenFile != "" {
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to read --token-file: %v\n", err)
+			os.Exit(1)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+
+	query := url.Values{}
+	if namespace != "" {
+		query.Set("namespace", namespace)
+	}
+	if selector != "" {
+		query.Set("labelSelector", selector)
+	}
+	if phases != "" {
+		query.Set("phase", phases)
+	}
+	endpoint := strings.TrimSuffix(server, "/") + summary.Path + "?" + query.Encode()
+
+	httpClient := &http.Client{Timeout: timeout}
+	if insecure {
+		httpClient.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}} //nolint:gosec
+	}
+	jobs, err := fetch(context.Background(), httpClient, endpoint, token)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(summary.List{Items: jobs})
+	} else {
+		err = summary.WriteTable(os.Stdout, jobs)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// fetch reads the job summaries from the operator
+func fetch(ctx context.Context, httpClient *http.Client, endpoint, token string) ([]summary.Job, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("operator answered %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	var list summary.List
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("unable to decode job summaries: %w", err)
+	}
+	return list.Items, nil
+}
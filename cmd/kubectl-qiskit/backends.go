@@ -0,0 +1,98 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"text/tabwriter"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// showBackends prints the backends of the QuantumBackendPools, or of the
+// named ones, with the queue wait the operator predicts for each from the
+// executions it observed and the state of its circuit breaker
+func showBackends(args []string) error {
+	flags, namespace := newFlagSet("backends", "[POOL...]")
+	names := parsePositional(flags, args)
+
+	cl, err := connect(*namespace)
+	if err != nil {
+		return err
+	}
+	var pools quantumv1.QuantumBackendPoolList
+	if err := cl.client.List(context.Background(), &pools); err != nil {
+		return err
+	}
+	shown := pools.Items[:0]
+	for _, pool := range pools.Items {
+		if len(names) == 0 || slices.Contains(names, pool.Name) {
+			shown = append(shown, pool)
+		}
+	}
+	if len(shown) == 0 {
+		return fmt.Errorf("no QuantumBackendPools found")
+	}
+	return printBackends(os.Stdout, shown)
+}
+
+// printBackends writes a row per backend with a queue wait prediction or a
+// circuit breaker in the pools' status, and a row per pool with neither
+func printBackends(w io.Writer, pools []quantumv1.QuantumBackendPool) error {
+	sort.Slice(pools, func(i, j int) bool { return pools[i].Name < pools[j].Name })
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "POOL\tBACKEND\tPREDICTED WAIT\tSAMPLES\tLAST OBSERVED\tBREAKER")
+	for _, pool := range pools {
+		waits := map[string]quantumv1.BackendQueueWait{}
+		breakers := map[string]string{}
+		var backends []string
+		for _, wait := range pool.Status.QueueWaits {
+			waits[wait.Backend] = wait
+			backends = append(backends, wait.Backend)
+		}
+		for _, b := range pool.Status.Breakers {
+			breakers[b.Backend] = b.State
+			if _, ok := waits[b.Backend]; !ok {
+				backends = append(backends, b.Backend)
+			}
+		}
+		if len(backends) == 0 {
+			fmt.Fprintf(tw, "%s\t-\t-\t-\t-\t-\n", pool.Name)
+			continue
+		}
+		sort.Strings(backends)
+		for _, backend := range backends {
+			wait, ok := waits[backend]
+			predicted, samples, last := "-", "-", "-"
+			if ok {
+				predicted, samples, last = wait.PredictedWait, fmt.Sprint(wait.Samples), wait.LastObservedWait
+			}
+			state := breakers[backend]
+			if state == "" {
+				state = "Closed"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", pool.Name, backend, predicted, samples, last, state)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+	"github.com/quantum-operator/qiskit-operator/pkg/clientutil"
+)
+
+// cancel annotates a job for cancellation; the operator then stops its
+// execution and cancels its provider job
+func cancel(args []string) error {
+	flags, namespace := newFlagSet("cancel", "JOB")
+	reason := flags.String("reason", "", "Reason recorded on the cancelled job.")
+	name := parseArgs(flags, args)
+
+	cl, err := connect(*namespace)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	var job quantumv1.QiskitJob
+	if err := cl.client.Get(ctx, client.ObjectKey{Namespace: cl.namespace, Name: name}, &job); err != nil {
+		return err
+	}
+	if clientutil.Finished(&job) {
+		return fmt.Errorf("QiskitJob %s/%s already finished as %s", job.Namespace, job.Name, job.Status.Phase)
+	}
+
+	patch := client.MergeFrom(job.DeepCopy())
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[controller.CancelAnnotation] = *reason
+	if err := cl.client.Patch(ctx, &job, patch); err != nil {
+		return err
+	}
+	fmt.Printf("QiskitJob %s/%s cancellation requested\n", job.Namespace, job.Name)
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/lineage"
+)
+
+// showLineage prints the provenance graph of a job's results: the jobs it
+// reruns, depends on or duplicates, and the experiment, template, session,
+// code and device behind each of them
+func showLineage(args []string) error {
+	flags, namespace := newFlagSet("lineage", "JOB")
+	output := flags.String("output", "text", "Format: text, a tree, json, or dot for Graphviz.")
+	name := parseArgs(flags, args)
+	if *output != "text" && *output != "json" && *output != "dot" {
+		return fmt.Errorf("unknown --output %q, must be text, json or dot", *output)
+	}
+
+	cl, err := connect(*namespace)
+	if err != nil {
+		return err
+	}
+	graph, err := lineage.Build(context.Background(), cl.client, cl.namespace, name)
+	if err != nil {
+		return err
+	}
+	switch *output {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(graph)
+	case "dot":
+		return lineage.WriteDOT(os.Stdout, graph)
+	}
+	return lineage.WriteText(os.Stdout, graph)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+)
+
+// logs prints the output of the execution pods of a job's attempt. Jobs
+// fanning out into several executions, such as sweeps, have every line
+// prefixed with the pod it came from.
+func logs(args []string) error {
+	flags, namespace := newFlagSet("logs", "JOB")
+	follow := flags.Bool("follow", false, "Stream the output until the executions finish, waiting for them to start.")
+	attemptFlag := flags.Int("attempt", 0, "Attempt to print the output of. Defaults to the current one.")
+	name := parseArgs(flags, args)
+
+	cl, err := connect(*namespace)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	var job quantumv1.QiskitJob
+	if err := cl.client.Get(ctx, client.ObjectKey{Namespace: cl.namespace, Name: name}, &job); err != nil {
+		return err
+	}
+	attempt := *attemptFlag
+	if attempt <= 0 {
+		attempt = job.Status.RetryCount + 1
+	}
+
+	var pods []corev1.Pod
+	err = wait.PollUntilContextCancel(ctx, 2*time.Second, true, func(ctx context.Context) (bool, error) {
+		if pods, err = executionPods(ctx, cl, &job, attempt); err != nil {
+			return false, err
+		}
+		if !*follow {
+			return true, nil
+		}
+		for _, pod := range pods {
+			if pod.Status.Phase != corev1.PodPending {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("QiskitJob %s/%s is %s and has no execution pods of attempt %d; jobs on remote backends run without one",
+			job.Namespace, job.Name, job.Status.Phase, attempt)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make([]error, len(pods))
+	for i := range pods {
+		prefix := ""
+		if len(pods) > 1 {
+			prefix = "[" + pods[i].Name + "] "
+		}
+		stream := func() {
+			errs[i] = streamLogs(ctx, cl, &pods[i], *follow, prefix, &mu)
+		}
+		// Without --follow the pods are printed one after the other
+		if !*follow {
+			stream()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stream()
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// executionPods lists the execution pods of an attempt of the job, oldest
+// first
+func executionPods(ctx context.Context, cl *cluster, job *quantumv1.QiskitJob, attempt int) ([]corev1.Pod, error) {
+	selector := labels.SelectorFromSet(labels.Set{
+		"quantum.io/job":        job.Name,
+		controller.AttemptLabel: strconv.Itoa(attempt),
+	})
+	list, err := cl.clientset.CoreV1().Pods(controller.ExecutionNamespace(job)).List(ctx,
+		metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	pods := list.Items
+	sort.Slice(pods, func(i, j int) bool {
+		if !pods[i].CreationTimestamp.Equal(&pods[j].CreationTimestamp) {
+			return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	return pods, nil
+}
+
+// streamLogs copies the output of the pod to stdout, prefixing every line
+func streamLogs(ctx context.Context, cl *cluster, pod *corev1.Pod, follow bool, prefix string, mu *sync.Mutex) error {
+	stream, err := cl.clientset.CoreV1().Pods(pod.Namespace).
+		GetLogs(pod.Name, &corev1.PodLogOptions{Follow: follow}).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("pod %s: %w", pod.Name, err)
+	}
+	defer func() { _ = stream.Close() }()
+	return copyLines(os.Stdout, stream, prefix, mu)
+}
+
+// copyLines copies r to w line by line, prefixing each, holding mu while
+// writing so lines of concurrent copies do not interleave
+func copyLines(w io.Writer, r io.Reader, prefix string, mu *sync.Mutex) error {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			mu.Lock()
+			_, writeErr := fmt.Fprint(w, prefix+line)
+			if writeErr == nil && line[len(line)-1] != '\n' {
+				_, writeErr = fmt.Fprintln(w)
+			}
+			mu.Unlock()
+			if writeErr != nil {
+				return writeErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(quantumv1.AddToScheme(scheme))
+}
+
+// commands are the subcommands of the plugin, each parsing its own flags
+var commands = map[string]func(args []string) error{
+	"submit":   submit,
+	"logs":     logs,
+	"results":  showResults,
+	"cancel":   cancel,
+	"lineage":  showLineage,
+	"backends": showBackends,
+}
+
+const usage = `Usage: kubectl qiskit COMMAND [flags]
+
+Commands:
+  submit FILE   Run a Qiskit Python or OpenQASM file as a QiskitJob
+  logs JOB      Print the executor output of a job
+  results JOB   Print the counts of a completed job as a histogram
+  cancel JOB    Cancel a job that has yet to finish
+  lineage JOB   Print the provenance graph of a job's results
+  backends      Print the predicted queue wait of the pooled backends
+
+Run 'kubectl qiskit COMMAND -h' for the flags of a command.
+`
+
+// kubectl-qiskit wraps the QiskitJob CRD for researchers who would rather not
+// write YAML. Installed on the PATH, kubectl runs it as "kubectl qiskit".
+// It talks to the cluster of the current kubeconfig context, in its
+// namespace unless --namespace says otherwise.
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-h" || os.Args[1] == "--help" || os.Args[1] == "help" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err := command(os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// newFlagSet returns the flag set of a command, with the namespace flag
+// every command takes
+func newFlagSet(name, args string) (*flag.FlagSet, *string) {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	namespace := flags.String("namespace", "", "Namespace of the QiskitJob. Defaults to that of the current context.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: kubectl qiskit %s [flags] %s\n", name, args)
+		flags.PrintDefaults()
+	}
+	return flags, namespace
+}
+
+// parseArgs parses the arguments of a command, which takes exactly one
+// positional argument, wherever it appears among the flags
+func parseArgs(flags *flag.FlagSet, args []string) string {
+	positional := parsePositional(flags, args)
+	if len(positional) != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	return positional[0]
+}
+
+// parsePositional parses the arguments of a command and returns its
+// positional arguments, wherever they appear among the flags
+func parsePositional(flags *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		_ = flags.Parse(args)
+		if flags.NArg() == 0 {
+			return positional
+		}
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
+
+// cluster holds the clients of the current kubeconfig context
+type cluster struct {
+	client    client.WithWatch
+	clientset kubernetes.Interface
+	namespace string
+}
+
+// connect creates the clients of the current kubeconfig context, in
+// namespace if it is set and else in that of the context
+func connect(namespace string) (*cluster, error) {
+	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
+	config, err := loader.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load kubeconfig: %w", err)
+	}
+	if namespace == "" {
+		if namespace, _, err = loader.Namespace(); err != nil {
+			return nil, fmt.Errorf("unable to load kubeconfig: %w", err)
+		}
+	}
+	c, err := client.NewWithWatch(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create clientset: %w", err)
+	}
+	return &cluster{client: c, clientset: clientset, namespace: namespace}, nil
+}
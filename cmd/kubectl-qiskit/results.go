@@ -0,0 +1,191 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// histogramWidth is the width of the bar of the most likely outcome
+const histogramWidth = 40
+
+// showResults prints the counts of a completed job as a histogram, read from
+// its configmap or s3 output, or the probabilities its status summarizes if
+// it has neither
+func showResults(args []string) error {
+	flags, namespace := newFlagSet("results", "JOB")
+	output := flags.String("output", "text", "Format: text, a histogram, or json, the results document.")
+	top := flags.Int("top", 16, "Most outcomes printed, the most likely first. 0 prints all of them.")
+	name := parseArgs(flags, args)
+	if *output != "text" && *output != "json" {
+		return fmt.Errorf("unknown --output %q, must be text or json", *output)
+	}
+
+	cl, err := connect(*namespace)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	var job quantumv1.QiskitJob
+	if err := cl.client.Get(ctx, client.ObjectKey{Namespace: cl.namespace, Name: name}, &job); err != nil {
+		return err
+	}
+	if job.Status.Phase != controller.PhaseCompleted {
+		return fmt.Errorf("QiskitJob %s/%s is %s; results are available once it completed",
+			job.Namespace, job.Name, job.Status.Phase)
+	}
+	doc, err := readDocument(ctx, cl.client, &job)
+	if err != nil {
+		return err
+	}
+	if *output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if doc != nil {
+			return encoder.Encode(doc)
+		}
+		return encoder.Encode(job.Status.Results)
+	}
+	return printResults(os.Stdout, &job, doc, *top)
+}
+
+// readDocument reads the results document of the job from its first output
+// the results can be read back from, nil if it has none
+func readDocument(ctx context.Context, c client.Client, job *quantumv1.QiskitJob) (*results.Document, error) {
+	for i := range job.Spec.Outputs {
+		output := &job.Spec.Outputs[i]
+		if driver := results.Driver(output.Type); driver != nil && !driver.Mounted {
+			return results.ReadOutput(ctx, c, job, output)
+		}
+	}
+	return nil, nil
+}
+
+// outcome is a bar of the histogram
+type outcome struct {
+	bitstring   string
+	count       int
+	probability float64
+}
+
+// printResults prints a summary line and the histogram of the job's counts,
+// its most likely outcomes' probabilities if the document is nil, or the
+// expectation values of an estimator job
+func printResults(w io.Writer, job *quantumv1.QiskitJob, doc *results.Document, top int) error {
+	info := job.Status.Results
+	if info == nil {
+		_, err := fmt.Fprintf(w, "QiskitJob %s/%s completed without results: %s\n", job.Namespace, job.Name, job.Status.Message)
+		return err
+	}
+	backend := job.Status.SelectedBackend
+	if backend == "" {
+		backend = job.Spec.Backend.Name
+	}
+	fmt.Fprintf(w, "QiskitJob %s/%s completed on %s", job.Namespace, job.Name, backend)
+	if info.Shots > 0 {
+		fmt.Fprintf(w, ", %d shots", info.Shots)
+	}
+	if info.Location != "" {
+		fmt.Fprintf(w, ", results in %s", info.Location)
+	}
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if len(info.ExpectationValues) > 0 {
+		fmt.Fprintln(tw, "OBSERVABLE\tVALUE\tSTANDARD ERROR")
+		for _, ev := range info.ExpectationValues {
+			fmt.Fprintf(tw, "%s\t%.6f\t%.6f\n", ev.Observable, ev.Value, ev.StandardError)
+		}
+		return tw.Flush()
+	}
+
+	var outcomes []outcome
+	distinct := info.Outcomes
+	if doc != nil && len(doc.Results.Counts) > 0 {
+		outcomes = countOutcomes(doc.Results.Counts)
+		distinct = len(outcomes)
+	} else {
+		for _, p := range info.Probabilities {
+			outcomes = append(outcomes, outcome{bitstring: p.Outcome, probability: p.Probability})
+		}
+	}
+	if len(outcomes) == 0 {
+		return tw.Flush()
+	}
+	if top > 0 && len(outcomes) > top {
+		outcomes = outcomes[:top]
+	}
+
+	counted := doc != nil && len(doc.Results.Counts) > 0
+	if counted {
+		fmt.Fprintln(tw, "OUTCOME\tCOUNT\tPROBABILITY")
+	} else {
+		fmt.Fprintln(tw, "OUTCOME\tPROBABILITY")
+	}
+	highest := outcomes[0].probability
+	for _, o := range outcomes {
+		bar := strings.Repeat("#", max(1, int(o.probability/highest*histogramWidth+0.5)))
+		if counted {
+			fmt.Fprintf(tw, "%s\t%d\t%.2f%%\t%s\n", o.bitstring, o.count, o.probability*100, bar)
+		} else {
+			fmt.Fprintf(tw, "%s\t%.2f%%\t%s\n", o.bitstring, o.probability*100, bar)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if rest := distinct - len(outcomes); rest > 0 {
+		_, err := fmt.Fprintf(w, "... and %d more outcomes\n", rest)
+		return err
+	}
+	return nil
+}
+
+// countOutcomes turns counts into the bars of a histogram, the most
+// frequent first
+func countOutcomes(counts map[string]int) []outcome {
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	outcomes := make([]outcome, 0, len(counts))
+	for bitstring, n := range counts {
+		if n > 0 {
+			outcomes = append(outcomes, outcome{bitstring: bitstring, count: n, probability: float64(n) / float64(total)})
+		}
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		if outcomes[i].count != outcomes[j].count {
+			return outcomes[i].count > outcomes[j].count
+		}
+		return outcomes[i].bitstring < outcomes[j].bitstring
+	})
+	return outcomes
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+	"github.com/quantum-operator/qiskit-operator/pkg/clientutil"
+	"github.com/quantum-operator/qiskit-operator/pkg/qasm"
+)
+
+// qasmVersionPattern matches the version declaration of an OpenQASM program
+var qasmVersionPattern = regexp.MustCompile(`(?m)^\s*OPENQASM\s+(\d)`)
+
+// invalidNameChars are replaced when deriving a job name from a file name
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// submit runs a circuit file as a QiskitJob: Qiskit Python defining qc, or
+// an OpenQASM 2 or 3 program for files ending in .qasm, or one of the
+// builder's example circuits. Its results are stored in a ConfigMap, where
+// the results command reads them.
+func submit(args []string) error {
+	flags, namespace := newFlagSet("submit", "FILE | --example NAME")
+	name := flags.String("name", "", "Name of the QiskitJob. Defaults to the file name without its extension, or the example's name.")
+	example := flags.String("example", "", "Run an example circuit instead of a file: "+strings.Join(builder.ExampleNames(), ", ")+".")
+	backendType := flags.String("backend-type", "local_simulator", "Type of the backend, e.g. local_simulator or ibm_quantum.")
+	backend := flags.String("backend", "", "Name of the backend, e.g. ibm_brisbane.")
+	shots := flags.Int("shots", 1024, "Number of shots.")
+	resultsName := flags.String("results", "", "ConfigMap the results are stored in. Defaults to <name>-results.")
+	dryRun := flags.Bool("dry-run", false, "Print the QiskitJob as YAML instead of creating it.")
+	wait := flags.Bool("wait", false, "Wait for the job to finish and print its results.")
+	timeout := flags.Duration("timeout", 30*time.Minute, "How long to wait for the job with --wait.")
+	positional := parsePositional(flags, args)
+	if len(positional) > 1 || (len(positional) == 1) == (*example != "") {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var b *builder.JobBuilder
+	format := "python"
+	if *example != "" {
+		if *name == "" {
+			*name = *example
+		}
+		var err error
+		if b, err = builder.NewExampleJob(*example, *name, *namespace); err != nil {
+			return err
+		}
+	} else {
+		file := positional[0]
+		code, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if format, err = circuitFormat(file, string(code)); err != nil {
+			return err
+		}
+		if *name == "" {
+			*name = jobName(file)
+		}
+		b = builder.NewJob(*name, *namespace).WithInlineCircuit(string(code))
+	}
+	if *resultsName == "" {
+		*resultsName = *name + "-results"
+	}
+
+	job := b.
+		WithBackend(*backendType, *backend).
+		WithShots(*shots).
+		WithOutput("configmap", *resultsName).
+		Build()
+	job.Spec.Circuit.Format = format
+	if *dryRun {
+		data, err := yaml.Marshal(job)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	cl, err := connect(*namespace)
+	if err != nil {
+		return err
+	}
+	job.Namespace = cl.namespace
+	ctx := context.Background()
+	if err := cl.client.Create(ctx, job); err != nil {
+		return err
+	}
+	fmt.Printf("QiskitJob %s/%s created\n", job.Namespace, job.Name)
+	if !*wait {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	finished, err := clientutil.WaitForPhase(ctx, cl.client, types.NamespacedName{Namespace: job.Namespace, Name: job.Name},
+		controller.PhaseCompleted)
+	if err != nil {
+		return err
+	}
+	doc, err := readDocument(ctx, cl.client, finished)
+	if err != nil {
+		return err
+	}
+	return printResults(os.Stdout, finished, doc, 16)
+}
+
+// circuitFormat returns the spec.circuit.format of a circuit file, checking
+// the structure of OpenQASM programs before they are submitted
+func circuitFormat(file, code string) (string, error) {
+	if !strings.EqualFold(filepath.Ext(file), ".qasm") {
+		return "python", nil
+	}
+	version := 2
+	if m := qasmVersionPattern.FindStringSubmatch(code); m != nil && m[1] == "3" {
+		version = 3
+	}
+	if _, err := qasm.Parse(code, version); err != nil {
+		return "", fmt.Errorf("%s: %w", file, err)
+	}
+	return fmt.Sprintf("qasm%d", version), nil
+}
+
+// jobName derives a job name from a file name: "Bell State.py" becomes
+// "bell-state"
+func jobName(file string) string {
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(base), "-"), "-")
+	if name == "" {
+		return "circuit"
+	}
+	return name
+}
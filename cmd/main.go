@@ -17,38 +17,82 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
+	"net"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	quantumv1alpha1 "github.com/quantum-operator/qiskit-operator/api/v1alpha1"
+	"github.com/quantum-operator/qiskit-operator/internal/callback"
+	"github.com/quantum-operator/qiskit-operator/internal/chaos"
 	"github.com/quantum-operator/qiskit-operator/internal/controller"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/internal/summary"
+	webhookv1 "github.com/quantum-operator/qiskit-operator/internal/webhook/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/approval"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/credentials"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
+	"github.com/quantum-operator/qiskit-operator/pkg/breaker"
+	"github.com/quantum-operator/qiskit-operator/pkg/dispatch"
+	"github.com/quantum-operator/qiskit-operator/pkg/lineage"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+	"github.com/quantum-operator/qiskit-operator/pkg/notify"
+	"github.com/quantum-operator/qiskit-operator/pkg/packages"
+	"github.com/quantum-operator/qiskit-operator/pkg/queue"
+	"github.com/quantum-operator/qiskit-operator/pkg/telemetry"
+	"github.com/quantum-operator/qiskit-operator/pkg/tracking"
+	"github.com/quantum-operator/qiskit-operator/pkg/validation"
+	"github.com/quantum-operator/qiskit-operator/pkg/watchdog"
+	"github.com/quantum-operator/qiskit-operator/pkg/work"
 	// +kubebuilder:scaffold:imports
 )
 
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
+
+	// version of the operator, set at build time with
+	// -ldflags "-X main.version=..."
+	version = "dev"
 )
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(quantumv1.AddToScheme(scheme))
+	utilruntime.Must(quantumv1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -58,9 +102,71 @@ func main() {
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
 	var enableLeaderElection bool
+	var leaderElectionNamespace string
+	var leaseDuration, renewDeadline, retryPeriod time.Duration
+	var releaseLeaseOnCancel bool
+	var maxConcurrentReconciles int
+	var rateLimits controller.RateLimits
+	var podPendingRequeueInterval, runningRequeueInterval, errorRequeueInterval time.Duration
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var faultInjection bool
+	var externalResultsProcessor bool
+	var failedPodRetention int
+	var decisionTraceSize int
+	var debugPodLifetime time.Duration
+	var executionTTL time.Duration
+	var gitImage, uploaderImage string
+	var executorImage, gpuExecutorImage string
+	var validationServiceURL string
+	var configFile string
+	var validationRetryTimeout time.Duration
+	var hangTimeout time.Duration
+	var logTailBytes int
+	var hangDumps bool
+	var callbackAddr, callbackURL, callbackCertPath, callbackKeyFile, callbackClientCAPath string
+	var executorNodeSelector, gpuNodeSelector string
+	var longRunThreshold time.Duration
+	var allowedPackages string
+	var packageIndex packages.Index
+	var trackingURI, trackingExperiment string
+	var notificationURL, notificationType, notificationSecret, notificationPhases string
+	var searchURL string
+	var resultsSigningKeyFile string
+	var resultsCacheTTL time.Duration
+	var spokeKubeconfigDir, manifestWorkClusters string
+	var skipFinalizers bool
+	var orphanSweepInterval time.Duration
+	var jobTTL, ttlSweepInterval time.Duration
+	var archiveURL string
+	var sessionSweepInterval time.Duration
+	var sessionSweepSecrets string
+	var secretPollInterval time.Duration
+	var secretPollQPS float64
+	var budgetSoftLimit float64
+	var approvalQubits int
+	var approvalCost float64
+	var approvalWebhookURL string
+	var approvalPollInterval time.Duration
+	var fallbackQueueWait time.Duration
+	var maxExecutorsPerNamespace int
+	var priorityClasses string
+	var breakerThreshold int
+	var breakerCooldown time.Duration
+	var providerPollQPS float64
+	var telemetryEndpoint string
+	var telemetryInterval time.Duration
+	var namespaceSelector string
+	var cacheTerminalJobs bool
+	var jobListPageSize int64
+	var secretAccess bool
+	var sandboxExecutors bool
+	var executorEgressCIDRs, executorEgressPorts string
+	var ibmOptions ibm.Options
+	var vaultOptions credentials.VaultOptions
+	var vaultCacheTTL time.Duration
+	var stallTimeout time.Duration
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -68,6 +174,35 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&leaderElectionNamespace, "leader-election-namespace", "",
+		"Namespace of the leader election lease. Empty uses the namespace the manager runs in.")
+	flag.DurationVar(&leaseDuration, "leader-election-lease-duration", 15*time.Second,
+		"How long replicas that are not the leader wait before taking over a lease that was not renewed.")
+	flag.DurationVar(&renewDeadline, "leader-election-renew-deadline", 10*time.Second,
+		"How long the leader keeps trying to renew its lease before it steps down.")
+	flag.DurationVar(&retryPeriod, "leader-election-retry-period", 2*time.Second,
+		"How often replicas try to acquire or renew the lease.")
+	flag.BoolVar(&releaseLeaseOnCancel, "leader-election-release-on-cancel", true,
+		"Release the lease when the manager stops, so another replica takes over at once "+
+			"instead of after the lease duration.")
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"How many QiskitJobs are reconciled at once. A job is never reconciled twice at the same time.")
+	flag.DurationVar(&rateLimits.BaseDelay, "rate-limit-base-delay", controller.DefaultRateLimitBaseDelay,
+		"First delay of a QiskitJob whose reconcile requeues it at once or fails; each time it does again "+
+			"the delay doubles.")
+	flag.DurationVar(&rateLimits.MaxDelay, "rate-limit-max-delay", controller.DefaultRateLimitMaxDelay,
+		"Longest delay of a QiskitJob whose reconciles keep requeueing it at once or failing.")
+	flag.Float64Var(&rateLimits.QPS, "rate-limit-qps", controller.DefaultRateLimitQPS,
+		"Most reconciles per second of QiskitJobs requeued at once or after a failure, over all jobs.")
+	flag.IntVar(&rateLimits.Burst, "rate-limit-burst", controller.DefaultRateLimitBurst,
+		"How many reconciles of QiskitJobs requeued at once or after a failure may run above --rate-limit-qps.")
+	flag.DurationVar(&podPendingRequeueInterval, "pod-pending-requeue-interval",
+		controller.DefaultPodPendingRequeueInterval,
+		"How often a QiskitJob checks on an execution pod that has not started yet.")
+	flag.DurationVar(&runningRequeueInterval, "running-requeue-interval", controller.DefaultRunningRequeueInterval,
+		"How often a running QiskitJob checks on its executions.")
+	flag.DurationVar(&errorRequeueInterval, "error-requeue-interval", controller.DefaultErrorRequeueInterval,
+		"How long a QiskitJob waits before it is reconciled again after a reconcile failed.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
@@ -79,6 +214,220 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&faultInjection, "fault-injection", false,
+		"Test mode: randomly fail job status updates, duplicate reconciles and delay pod events "+
+			"to verify that jobs still converge. Never enable in production.")
+	flag.BoolVar(&externalResultsProcessor, "external-results-processor", false,
+		"Hand result parsing and upload to the results-processor deployment instead of "+
+			"doing them in the reconciler.")
+	flag.IntVar(&failedPodRetention, "failed-pod-retention", controller.DefaultFailedPodRetention,
+		"Number of failed execution pods kept per QiskitJob so their logs can be inspected. "+
+			"Older failed pods are deleted; 0 keeps none.")
+	flag.DurationVar(&debugPodLifetime, "debug-pod-lifetime", controller.DefaultDebugPodLifetime,
+		"How long the debug pod of a failed QiskitJob annotated "+controller.DebugAnnotation+"=true "+
+			"stays up for exec sessions before it is stopped.")
+	flag.DurationVar(&executionTTL, "execution-ttl", controller.DefaultExecutionTTL,
+		"How long the batch Job of a finished execution is kept before it is deleted with its pods.")
+	flag.StringVar(&gitImage, "git-image", controller.DefaultGitImage,
+		"Image of the init container that clones git circuit sources into execution pods.")
+	flag.StringVar(&uploaderImage, "uploader-image", "",
+		"Image of the sidecar that uploads the results of execution pods to s3 and pvc outputs, e.g. this "+
+			"operator's image. The executor then never holds the outputs' credentials or claims. "+
+			"Empty leaves s3 uploads to the operator and pvc outputs to the executor.")
+	flag.StringVar(&executorImage, "executor-image", "",
+		"Pre-built image executors run on Qiskit lines without a QuantumRuntimeVersion, instead of installing "+
+			"Qiskit at start. "+controller.ExecutorImageLinePlaceholder+" is replaced with the job's release line, "+
+			"e.g. registry.example.com/qiskit-executor:"+controller.ExecutorImageLinePlaceholder+".")
+	flag.StringVar(&gpuExecutorImage, "gpu-executor-image", "",
+		"Pre-built image executors of jobs simulating on a GPU run, with qiskit-aer-gpu installed. "+
+			controller.ExecutorImageLinePlaceholder+" is replaced with the job's release line. "+
+			"Empty runs the usual image and installs qiskit-aer-gpu at start.")
+	flag.StringVar(&validationServiceURL, "validation-service-url", "",
+		"URL of the circuit validation service (e.g. http://validation-service:8000) that checks circuits "+
+			"before they are scheduled. Empty skips the check.")
+	flag.StringVar(&configFile, "config-file", "",
+		"YAML file, typically mounted from a ConfigMap, whose validationServiceURL, validationRetryTimeout, "+
+			"executorImage, gpuExecutorImage and httpPollInterval override the flags of the same names and are "+
+			"applied without a restart when the file changes.")
+	flag.DurationVar(&validationRetryTimeout, "validation-retry-timeout", controller.DefaultValidationRetryTimeout,
+		"How long circuit validation is retried while the validation service is unavailable before the job fails.")
+	flag.DurationVar(&hangTimeout, "hang-timeout", controller.DefaultHangTimeout,
+		"Fail an execution attempt as hung when its executor sends no heartbeat for this long, "+
+			"so the retry policy applies. 0 disables hang detection.")
+	flag.IntVar(&logTailBytes, "executor-log-tail-bytes", results.DefaultLogTailBytes,
+		"How much of the end of an executor's logs is kept in the job's <job>-logs ConfigMap once its "+
+			"execution finishes, so they outlive the pod. 0 keeps none.")
+	flag.BoolVar(&hangDumps, "hang-dumps", false,
+		"Install py-spy in execution pods and dump the executor's stack into the pod logs "+
+			"when a hung pod is terminated.")
+	flag.StringVar(&callbackAddr, "callback-bind-address", "0",
+		"The address executors post progress and results to over HTTPS, or 0 to leave them in pod logs only.")
+	flag.StringVar(&callbackURL, "callback-url", "",
+		"The URL executors reach the callback server at, such as https://qiskit-operator-callback.<namespace>.svc:9443.")
+	flag.StringVar(&callbackCertPath, "callback-cert-path", "",
+		"The directory with the callback server's tls.crt and tls.key, and the ca.crt executors verify it against.")
+	flag.StringVar(&callbackKeyFile, "callback-key-file", "",
+		"A file with the secret executor callback tokens are derived from, shared by all operator replicas.")
+	flag.StringVar(&callbackClientCAPath, "callback-client-ca-path", "",
+		"The directory with the tls.crt and tls.key of the CA executor client certificates are issued from, "+
+			"shared by all operator replicas.")
+	flag.StringVar(&executorNodeSelector, "executor-node-selector", "",
+		"Node labels (e.g. karpenter.sh/nodepool=simulators) added to the node selector of every execution pod, "+
+			"so node autoscalers provision executors from a dedicated node pool.")
+	flag.StringVar(&gpuNodeSelector, "gpu-node-selector", "",
+		"Node labels added to the node selector of execution pods that request nvidia.com/gpu.")
+	flag.DurationVar(&longRunThreshold, "long-run-threshold", controller.DefaultLongRunThreshold,
+		"Keep node autoscalers from disrupting execution pods of jobs whose maxExecutionTime is at least "+
+			"this long. Execution pods that request GPUs are always kept. 0 disables it.")
+	flag.StringVar(&allowedPackages, "allowed-packages", "",
+		"Comma-separated package names or glob patterns (e.g. qiskit-nature,qiskit-optimization) "+
+			"that QiskitJobs may install with spec.execution.extraPackages. Empty allows none.")
+	flag.StringVar(&packageIndex.URL, "package-index-url", "",
+		"Package index execution pods install from instead of PyPI, e.g. an internal mirror.")
+	flag.StringVar(&packageIndex.Proxy, "package-proxy", "",
+		"HTTP proxy execution pods install packages through.")
+	flag.Float64Var(&budgetSoftLimit, "budget-soft-limit", controller.DefaultBudgetSoftLimit,
+		"Share of a namespace's monthly budget (QuantumNamespaceStatus spec.monthlyBudget) from which its "+
+			"hardware jobs run on a simulator of their device, or are deferred if they disable fallback. 0 disables it.")
+	flag.IntVar(&approvalQubits, "approval-qubits", 0,
+		"QiskitJobs whose circuit uses more qubits than this wait in PendingApproval until an approver "+
+			"annotates them quantum.io/approval=approved. 0 disables the qubit tier.")
+	flag.Float64Var(&approvalCost, "approval-cost", 0,
+		"QiskitJobs estimated to cost more US dollars than this wait in PendingApproval until approved. "+
+			"0 disables the cost tier.")
+	flag.StringVar(&approvalWebhookURL, "approval-webhook-url", "",
+		"URL of an external approval service asked to approve or deny QiskitJobs waiting for approval. "+
+			"A bearer token is read from APPROVAL_WEBHOOK_TOKEN.")
+	flag.DurationVar(&approvalPollInterval, "approval-poll-interval", controller.DefaultApprovalPollInterval,
+		"How often the approval webhook is asked about a QiskitJob waiting for approval.")
+	flag.IntVar(&maxExecutorsPerNamespace, "max-executors-per-namespace", 0,
+		"Execution pods a namespace may run at once. Further QiskitJobs wait in Scheduling and are admitted "+
+			"in priority order, urgent first. QuantumQuotas can lower it with maxConcurrentExecutors. 0 disables it.")
+	flag.StringVar(&priorityClasses, "priority-classes", "",
+		"Priority classes of execution pods by job priority (e.g. urgent=quantum-urgent,low=quantum-batch), "+
+			"for jobs that set no spec.scheduling.priorityClassName.")
+	flag.DurationVar(&fallbackQueueWait, "fallback-queue-wait", 0,
+		"Predicted queue wait of an IBM Quantum device over which hardware jobs that set "+
+			"spec.backendSelection.allowFallback run on a simulator of the device instead. 0 disables it.")
+	flag.Float64Var(&providerPollQPS, "provider-poll-qps", controller.DefaultProviderPollQPS,
+		"Most status polls per second of each ibm_quantum or generic_http backend, across all of its jobs. "+
+			"0 disables the limit.")
+	flag.IntVar(&breakerThreshold, "backend-breaker-threshold", controller.DefaultBreakerThreshold,
+		"Consecutive failed submissions or polls of an ibm_quantum or generic_http backend after which its "+
+			"circuit breaker opens: jobs are not submitted to it, and IBM hardware jobs that allow fallback "+
+			"simulate their device instead. 0 disables circuit breakers.")
+	flag.DurationVar(&breakerCooldown, "backend-breaker-cooldown", controller.DefaultBreakerCooldown,
+		"How long a backend's circuit breaker stays open before a single call probes whether it recovered.")
+	flag.StringVar(&trackingURI, "tracking-uri", "",
+		"Log finished QiskitJobs to an experiment tracker: the URL of an MLflow tracking server, "+
+			"or wandb://<entity>/<project> for Weights & Biases. Credentials are read from "+
+			"MLFLOW_TRACKING_TOKEN or WANDB_API_KEY.")
+	flag.StringVar(&trackingExperiment, "tracking-experiment", tracking.DefaultExperiment,
+		"MLflow experiment finished QiskitJobs are logged to.")
+	flag.StringVar(&notificationURL, "notification-url", "",
+		"Tell this endpoint when any QiskitJob finishes, besides those of the job's spec.notifications.")
+	flag.StringVar(&notificationType, "notification-type", notify.TypeWebhook,
+		"Payload of --notification-url: webhook for the job's summary as JSON, or slack for a Slack-compatible message.")
+	flag.StringVar(&notificationSecret, "notification-secret", "",
+		"A namespace/name Secret whose keys are sent as headers with operator notifications, and whose url key "+
+			"replaces --notification-url.")
+	flag.StringVar(&notificationPhases, "notification-phases", "",
+		"Comma-separated phases operator notifications are sent for, all of Completed, Failed and Cancelled if empty.")
+	flag.StringVar(&searchURL, "search-url", "",
+		"OpenSearch or Elasticsearch endpoint result summaries of opensearch and elasticsearch "+
+			"outputs are indexed into. Credentials are read from SEARCH_API_KEY or SEARCH_USERNAME and SEARCH_PASSWORD.")
+	flag.StringVar(&resultsSigningKeyFile, "results-signing-key-file", "",
+		"A PEM-encoded Ed25519 private key the digests of exported configmap and s3 results are signed with. "+
+			"Results are not signed unless set.")
+	flag.DurationVar(&resultsCacheTTL, "results-cache-ttl", controller.DefaultResultsCacheTTL,
+		"How long results cached by jobs with spec.execution.cachePolicy use or refresh are reused by later "+
+			"identical jobs. 0 reuses them until their ConfigMap is deleted.")
+	flag.StringVar(&spokeKubeconfigDir, "spoke-kubeconfig-dir", "",
+		"Directory of kubeconfig files of spoke clusters QiskitJobs may be dispatched to with "+
+			"spec.placement.cluster, each named after its cluster.")
+	flag.StringVar(&manifestWorkClusters, "manifestwork-clusters", "",
+		"Comma-separated Open Cluster Management managed clusters QiskitJobs may be dispatched to "+
+			"with spec.placement.cluster through ManifestWorks.")
+	flag.BoolVar(&skipFinalizers, "skip-finalizers", false,
+		"Delete QiskitJobs without waiting for the operator to cancel and clean up their work, "+
+			"so wedged jobs never block namespace deletion. Orphaned execution pods are swept instead. "+
+			"Jobs can opt out individually with the quantum.io/skip-finalizer annotation.")
+	flag.DurationVar(&orphanSweepInterval, "orphan-sweep-interval", controller.DefaultOrphanSweepInterval,
+		"How often to delete execution pods and results ConfigMaps of QiskitJobs that no longer exist. "+
+			"0 disables the sweeper.")
+	flag.DurationVar(&jobTTL, "job-ttl-after-finished", 0,
+		"Delete QiskitJobs without spec.ttlSecondsAfterFinished this long after they completed or failed for good, "+
+			"with their pods and results ConfigMaps. Jobs of workflows and schedules are left to them. 0 keeps jobs.")
+	flag.DurationVar(&ttlSweepInterval, "ttl-sweep-interval", controller.DefaultTTLSweepInterval,
+		"How often to delete finished QiskitJobs whose TTL ran out. 0 disables TTLs.")
+	flag.StringVar(&archiveURL, "archive-url", "",
+		"Archive each QiskitJob and its results before its TTL deletes it: s3://<bucket>/<prefix>, with credentials "+
+			"read from ARCHIVE_ACCESS_KEY_ID and ARCHIVE_SECRET_ACCESS_KEY (and ARCHIVE_REGION, ARCHIVE_ENDPOINT), "+
+			"or file:///<dir> on a volume mounted into the operator. Jobs that cannot be archived are kept. "+
+			"Also receives the diagnostics of failed attempts whose retry policy preserves them.")
+	flag.DurationVar(&sessionSweepInterval, "session-sweep-interval", controller.DefaultSessionSweepInterval,
+		"How often to close IBM Quantum sessions opened by this cluster's jobs that no live QiskitJob "+
+			"or QiskitSession owns any more. 0 disables the sweeper. Needs Secret access.")
+	flag.StringVar(&sessionSweepSecrets, "session-sweep-secrets", "",
+		"Comma-separated namespace/name of additional credentials Secrets whose IBM Quantum instances "+
+			"are swept for leaked sessions, beyond those referenced by QiskitJobs.")
+	flag.StringVar(&telemetryEndpoint, "telemetry-endpoint", "",
+		"URL anonymous usage reports (job counts by backend type and phase, failure classes, operator "+
+			"version) are posted to. Empty, the default, reports nothing.")
+	flag.DurationVar(&telemetryInterval, "telemetry-interval", telemetry.DefaultInterval,
+		"How often usage is reported to --telemetry-endpoint.")
+	flag.IntVar(&decisionTraceSize, "decision-trace-size", 0,
+		"How many reconciles of each QiskitJob to trace: the phase handler run, the branches taken, backend "+
+			"scores and the requeue chosen. Traces are served on the metrics endpoint at "+controller.DecisionsPath+
+			" and kept in a <job>-decisions ConfigMap whenever the job changes phase. 0, the default, traces nothing.")
+	flag.DurationVar(&stallTimeout, "reconcile-stall-timeout", watchdog.DefaultTimeout,
+		"How long a controller may have a reconcile running, or requests queued without processing any, "+
+			"before the health check fails so that the pod is restarted. 0 disables the check.")
+	flag.DurationVar(&secretPollInterval, "secret-poll-interval", controller.DefaultSecretPollInterval,
+		"How often the credentials Secrets referenced by QiskitJobs are read to re-trigger jobs whose "+
+			"credentials changed. 0 disables polling; changes are then picked up on the next reconcile.")
+	flag.Float64Var(&secretPollQPS, "secret-poll-qps", controller.DefaultSecretPollQPS,
+		"Most Secrets read per second while polling.")
+	flag.StringVar(&namespaceSelector, "namespace-selector", "",
+		"Label selector of the namespaces whose QiskitJobs, pods and ConfigMaps the operator manages, "+
+			"e.g. quantum.io/managed=true. Empty manages all namespaces. Namespaces are selected at startup.")
+	flag.BoolVar(&cacheTerminalJobs, "cache-terminal-jobs", true,
+		"Keep QiskitJobs that finished for good in the informer cache. Disable for namespaces with tens of "+
+			"thousands of historical jobs: finished jobs are then labeled quantum.io/terminal and read from the "+
+			"API server a page at a time by the tasks that need them.")
+	flag.Int64Var(&jobListPageSize, "job-list-page-size", 0,
+		"How many QiskitJobs namespace summaries, rollouts, bulk operations and sweepers read from the API "+
+			"server at a time. 0 reads them from the cache, or 500 at a time for terminal jobs kept out of it.")
+	flag.BoolVar(&secretAccess, "secret-access", true,
+		"Read the credentials Secrets referenced by QiskitJobs. Disable to run without any Secret RBAC; "+
+			"credentials then reach execution pods through spec.credentials.volume only.")
+	flag.BoolVar(&sandboxExecutors, "sandbox-executors", false,
+		"Run the executors of every QiskitJob in a locked-down sandbox namespace of its own, not only those "+
+			"of jobs setting spec.execution.sandbox.")
+	flag.StringVar(&executorEgressCIDRs, "executor-egress-cidrs", "",
+		"Comma-separated CIDRs executors may connect to, such as those of the package index and of bundle and input hosts. "+
+			"When set, a NetworkPolicy in each job namespace confines executors to them and cluster DNS.")
+	flag.StringVar(&executorEgressPorts, "executor-egress-ports", "443",
+		"Comma-separated TCP ports executors may connect to on --executor-egress-cidrs.")
+	flag.StringVar(&ibmOptions.URL, "ibm-quantum-url", "",
+		"Qiskit Runtime API ibm_quantum jobs are submitted to, e.g. a private endpoint. "+
+			"Empty uses the IBM Cloud endpoint of the region each job is routed to.")
+	flag.StringVar(&ibmOptions.IAMURL, "ibm-iam-url", ibm.DefaultIAMURL,
+		"IBM Cloud IAM endpoint API keys of ibm_quantum jobs are exchanged for tokens at.")
+	flag.StringVar(&vaultOptions.Address, "vault-address", "",
+		"HashiCorp Vault server the credentials of QiskitJobs setting spec.credentials.vaultPath are read from, "+
+			"e.g. https://vault.example.com:8200. Empty disables Vault.")
+	flag.StringVar(&vaultOptions.Role, "vault-role", "qiskit-operator",
+		"Vault role the operator's service account logs in as through Vault's Kubernetes auth method.")
+	flag.StringVar(&vaultOptions.AuthMount, "vault-auth-mount", credentials.DefaultVaultAuthMount,
+		"Path Vault's Kubernetes auth method is mounted at.")
+	flag.StringVar(&vaultOptions.Namespace, "vault-namespace", "",
+		"Vault Enterprise namespace credentials are read from.")
+	flag.StringVar(&vaultOptions.PathPrefix, "vault-path-prefix", "",
+		"Prefix of the Vault paths jobs read, e.g. secret/data/quantum/{namespace}, where {namespace} is the "+
+			"job's namespace. Jobs then name paths below it only. Empty lets jobs read any path the role can.")
+	flag.DurationVar(&vaultCacheTTL, "vault-cache-ttl", 5*time.Minute,
+		"How long credentials read from Vault are kept in memory before they are read again.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -87,6 +436,52 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	packageAllowlist, err := packages.ParseAllowlist(allowedPackages)
+	if err != nil {
+		setupLog.Error(err, "invalid --allowed-packages")
+		os.Exit(1)
+	}
+	executorNodes, err := labels.ConvertSelectorToLabelsMap(executorNodeSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid --executor-node-selector")
+		os.Exit(1)
+	}
+	egressCIDRs, err := parseCIDRs(executorEgressCIDRs)
+	if err != nil {
+		setupLog.Error(err, "invalid --executor-egress-cidrs")
+		os.Exit(1)
+	}
+	egressPorts, err := parsePorts(executorEgressPorts)
+	if err != nil {
+		setupLog.Error(err, "invalid --executor-egress-ports")
+		os.Exit(1)
+	}
+	gpuNodes, err := labels.ConvertSelectorToLabelsMap(gpuNodeSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid --gpu-node-selector")
+		os.Exit(1)
+	}
+	jobPriorityClasses, err := labels.ConvertSelectorToLabelsMap(priorityClasses)
+	if err != nil {
+		setupLog.Error(err, "invalid --priority-classes")
+		os.Exit(1)
+	}
+	for priority := range jobPriorityClasses {
+		if !slices.Contains(controller.Priorities, priority) {
+			setupLog.Error(nil, "invalid --priority-classes", "priority", priority, "priorities", controller.Priorities)
+			os.Exit(1)
+		}
+	}
+	if maxConcurrentReconciles < 1 {
+		setupLog.Error(nil, "--max-concurrent-reconciles must be at least 1")
+		os.Exit(1)
+	}
+	if renewDeadline >= leaseDuration || retryPeriod >= renewDeadline {
+		setupLog.Error(nil, "leader election needs --leader-election-retry-period < "+
+			"--leader-election-renew-deadline < --leader-election-lease-duration")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -154,40 +549,282 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:                 scheme,
-		Metrics:                metricsServerOptions,
-		WebhookServer:          webhookServer,
-		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "3fd21f41.quantum.io",
-		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
-		// when the Manager ends. This requires the binary to immediately end when the
-		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
-		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
+	restConfig := ctrl.GetConfigOrDie()
+	var cacheOptions cache.Options
+	if namespaceSelector != "" {
+		cacheOptions.DefaultNamespaces, err = selectedNamespaces(restConfig, namespaceSelector)
+		if err != nil {
+			setupLog.Error(err, "unable to select namespaces", "selector", namespaceSelector)
+			os.Exit(1)
+		}
+		setupLog.Info("Managing selected namespaces", "selector", namespaceSelector,
+			"namespaces", len(cacheOptions.DefaultNamespaces))
+	}
+	if !cacheTerminalJobs {
+		// Jobs labeled terminal are read page by page when needed instead
+		cacheOptions.ByObject = map[client.Object]cache.ByObject{
+			&quantumv1.QiskitJob{}: {Label: controller.TerminalJobsSelector()},
+		}
+	}
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
+		Scheme:                  scheme,
+		Cache:                   cacheOptions,
+		Metrics:                 metricsServerOptions,
+		WebhookServer:           webhookServer,
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        "3fd21f41.quantum.io",
+		LeaderElectionNamespace: leaderElectionNamespace,
+		LeaseDuration:           &leaseDuration,
+		RenewDeadline:           &renewDeadline,
+		RetryPeriod:             &retryPeriod,
+		// Releasing the lease on shutdown is safe as the program ends as
+		// soon as the manager stops
+		LeaderElectionReleaseOnCancel: releaseLeaseOnCancel,
+		// Secrets are read one by one when jobs reference them rather than
+		// cached, which would list and watch every Secret in the cluster.
+		// Nodes are only listed to size scratch space, which needs no watch.
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}, &corev1.Node{}}},
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
-	if err := (&controller.QiskitJobReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+	// Queue wait observations from job executions feed the predictions
+	// published on QiskitBackend status
+	queuePredictor := queue.NewPredictor(queue.DefaultWindow)
+
+	clusterID, err := controller.ClusterID(context.Background(), mgr.GetAPIReader())
+	if err != nil {
+		setupLog.Error(err, "unable to identify the cluster, provider jobs are not tagged with it")
+	}
+
+	jobs := &controller.JobLister{
+		Cache:            mgr.GetClient(),
+		APIReader:        mgr.GetAPIReader(),
+		PageSize:         jobListPageSize,
+		UncachedTerminal: !cacheTerminalJobs,
+	}
+
+	var archive results.Archive
+	if archiveURL != "" {
+		if archive, err = results.NewArchive(archiveURL); err != nil {
+			setupLog.Error(err, "invalid --archive-url")
+			os.Exit(1)
+		}
+	}
+
+	jobReconciler := &controller.QiskitJobReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		ValidationServiceURL:     validationServiceURL,
+		ValidationRetryTimeout:   validationRetryTimeout,
+		QueuePredictor:           queuePredictor,
+		FailedPodRetention:       failedPodRetention,
+		DebugPodLifetime:         debugPodLifetime,
+		ExecutionTTL:             executionTTL,
+		GitImage:                 gitImage,
+		UploaderImage:            uploaderImage,
+		HangTimeout:              hangTimeout,
+		HangDumps:                hangDumps,
+		LogTailBytes:             logTailBytes,
+		Archive:                  archive,
+		ExecutorNodeSelector:     executorNodes,
+		GPUNodeSelector:          gpuNodes,
+		LongRunThreshold:         longRunThreshold,
+		ResultsCacheTTL:          resultsCacheTTL,
+		AllowedPackages:          packageAllowlist,
+		PackageIndex:             packageIndex,
+		ExecutorImage:            executorImage,
+		GPUExecutorImage:         gpuExecutorImage,
+		BudgetSoftLimit:          budgetSoftLimit,
+		FallbackQueueWait:        fallbackQueueWait,
+		ApprovalTier:             approval.Tier{Qubits: approvalQubits, Cost: approvalCost},
+		ApprovalPollInterval:     approvalPollInterval,
+		Recorder:                 mgr.GetEventRecorderFor("qiskitjob-controller"),
+		SkipFinalizers:           skipFinalizers,
+		MaxExecutorsPerNamespace: int32(maxExecutorsPerNamespace),
+		PriorityClasses:          jobPriorityClasses,
+		UncachedTerminalJobs:     !cacheTerminalJobs,
+		Jobs:                     jobs,
+		WithoutSecrets:           !secretAccess,
+		SandboxExecutors:         sandboxExecutors,
+		ExecutorEgress:           egressCIDRs,
+		ExecutorEgressPorts:      egressPorts,
+		IBM:                      ibmOptions,
+		ClusterID:                clusterID,
+		MaxConcurrentReconciles:  maxConcurrentReconciles,
+		RateLimits:               rateLimits,
+
+		PodPendingRequeueInterval: podPendingRequeueInterval,
+		RunningRequeueInterval:    runningRequeueInterval,
+		ErrorRequeueInterval:      errorRequeueInterval,
+	}
+	if decisionTraceSize > 0 {
+		jobReconciler.Decisions = &controller.DecisionTraces{
+			Size:   decisionTraceSize,
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		}
+	}
+	if vaultOptions.Address != "" {
+		jobReconciler.Vault = credentials.NewCache(credentials.NewVault(vaultOptions), vaultCacheTTL)
+	}
+	if configFile != "" {
+		reloader, err := controller.NewConfigReloader(configFile, controller.DefaultConfigPollInterval, controller.Tunables{
+			ValidationServiceURL:   validationServiceURL,
+			ValidationRetryTimeout: validationRetryTimeout,
+			ExecutorImage:          executorImage,
+			GPUExecutorImage:       gpuExecutorImage,
+
+			PodPendingRequeueInterval: podPendingRequeueInterval,
+			RunningRequeueInterval:    runningRequeueInterval,
+			ErrorRequeueInterval:      errorRequeueInterval,
+		})
+		if err != nil {
+			setupLog.Error(err, "invalid --config-file")
+			os.Exit(1)
+		}
+		if err := mgr.Add(reloader); err != nil {
+			setupLog.Error(err, "unable to set up config file reloading")
+			os.Exit(1)
+		}
+		jobReconciler.Config = reloader
+	}
+	if approvalWebhookURL != "" {
+		jobReconciler.ApprovalWebhook = &approval.Webhook{URL: approvalWebhookURL, Token: os.Getenv("APPROVAL_WEBHOOK_TOKEN")}
+	}
+	if breakerThreshold > 0 {
+		jobReconciler.Breakers = breaker.New(breakerThreshold, breakerCooldown)
+	}
+	if providerPollQPS > 0 {
+		jobReconciler.Polls = controller.NewProviderPolls(float32(providerPollQPS))
+	}
+	if secretPollInterval > 0 && secretAccess {
+		// Jobs are listed from the cache, Secrets read from the API server
+		jobReconciler.Secrets = controller.NewSecretWatcher(mgr.GetClient(), mgr.GetAPIReader(),
+			secretPollInterval, float32(secretPollQPS))
+		if err := mgr.Add(jobReconciler.Secrets); err != nil {
+			setupLog.Error(err, "unable to set up Secret polling")
+			os.Exit(1)
+		}
+	}
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create clientset")
+		os.Exit(1)
+	}
+	// Executors that called back are read from what they posted
+	logReader := callback.LogReader{Client: mgr.GetClient(), Logs: results.ClientsetLogReader{Clientset: clientset}}
+	jobReconciler.PodLogs = logReader
+	if hangTimeout > 0 {
+		jobReconciler.Logs = logReader
+	}
+	if callbackAddr != "0" {
+		server, endpoint, err := callbackServer(callbackAddr, callbackURL, callbackCertPath, callbackKeyFile, callbackClientCAPath)
+		if err != nil {
+			setupLog.Error(err, "invalid executor callback configuration")
+			os.Exit(1)
+		}
+		server.Client = mgr.GetClient()
+		server.Scheme = mgr.GetScheme()
+		server.TLSOpts = tlsOpts
+		if err := mgr.Add(server); err != nil {
+			setupLog.Error(err, "unable to set up the executor callback server")
+			os.Exit(1)
+		}
+		jobReconciler.Callback = endpoint
+	}
+	if trackingURI != "" {
+		tracker, err := tracking.FromURI(trackingURI, trackingExperiment)
+		if err != nil {
+			setupLog.Error(err, "invalid --tracking-uri")
+			os.Exit(1)
+		}
+		jobReconciler.Tracker = tracker
+	}
+	if notificationURL != "" || notificationSecret != "" {
+		notification := controller.Notification{NotificationSpec: quantumv1.NotificationSpec{
+			Name: "operator",
+			Type: notificationType,
+			URL:  notificationURL,
+		}}
+		if notificationPhases != "" {
+			notification.Phases = strings.Split(notificationPhases, ",")
+		}
+		if notificationSecret != "" {
+			refs, err := parseSecretRefs(notificationSecret)
+			if err != nil || len(refs) != 1 {
+				setupLog.Error(err, "invalid --notification-secret, must be one namespace/name")
+				os.Exit(1)
+			}
+			notification.Secret = &refs[0]
+			// Validated like spec.notifications, whose Secret may hold the URL
+			notification.SecretName = refs[0].Name
+		}
+		if notificationType != notify.TypeWebhook && notificationType != notify.TypeSlack {
+			setupLog.Error(nil, "invalid --notification-type, must be webhook or slack", "type", notificationType)
+			os.Exit(1)
+		}
+		if errs := validation.ValidateNotification(&notification.NotificationSpec, field.NewPath("notification")); len(errs) > 0 {
+			setupLog.Error(errs.ToAggregate(), "invalid operator notification")
+			os.Exit(1)
+		}
+		jobReconciler.Notifications = append(jobReconciler.Notifications, notification)
+	}
+	if searchURL != "" {
+		search, err := results.NewSearchIndexer(searchURL)
+		if err != nil {
+			setupLog.Error(err, "invalid --search-url")
+			os.Exit(1)
+		}
+		jobReconciler.Search = search
+	}
+	if resultsSigningKeyFile != "" {
+		signer, err := results.LoadSigningKey(resultsSigningKeyFile)
+		if err != nil {
+			setupLog.Error(err, "invalid --results-signing-key-file")
+			os.Exit(1)
+		}
+		setupLog.Info("Signing exported results", "key", signer.KeyID())
+		jobReconciler.ResultsSigner = signer
+	}
+	if spokeKubeconfigDir != "" || manifestWorkClusters != "" {
+		spokes := map[string]dispatch.Spoke{}
+		if spokeKubeconfigDir != "" {
+			if spokes, err = dispatch.LoadKubeconfigs(spokeKubeconfigDir, mgr.GetScheme()); err != nil {
+				setupLog.Error(err, "invalid --spoke-kubeconfig-dir")
+				os.Exit(1)
+			}
+		}
+		for _, cluster := range strings.Split(manifestWorkClusters, ",") {
+			if cluster = strings.TrimSpace(cluster); cluster != "" {
+				spokes[cluster] = &dispatch.ManifestWorkSpoke{Client: mgr.GetClient(), Cluster: cluster}
+			}
+		}
+		jobReconciler.Spokes = spokes
+	}
+	if externalResultsProcessor {
+		jobReconciler.ResultsQueue = work.NewQueue(mgr.GetClient(), mgr.GetScheme(), "qiskit-operator", 0)
+	}
+	if faultInjection {
+		setupLog.Info("Fault injection enabled, do not use in production")
+		injector := chaos.NewInjector(chaos.DefaultConfig())
+		jobReconciler.Client = injector.WrapClient(jobReconciler.Client)
+		jobReconciler.FaultInjector = injector
+	}
+	if err := jobReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "QiskitJob")
 		os.Exit(1)
 	}
 	if err := (&controller.QiskitBackendReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:         mgr.GetClient(),
+		Scheme:         mgr.GetScheme(),
+		QueuePredictor: queuePredictor,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "QiskitBackend")
 		os.Exit(1)
@@ -200,18 +837,208 @@ func main() {
 		os.Exit(1)
 	}
 	if err := (&controller.QiskitSessionReconciler{
+		Client:         mgr.GetClient(),
+		Scheme:         mgr.GetScheme(),
+		WithoutSecrets: !secretAccess,
+		IBM:            ibmOptions,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QiskitSession")
+		os.Exit(1)
+	}
+	if err := (&controller.QuantumNamespaceStatusReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
+		Jobs:   jobs,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "QiskitSession")
+		setupLog.Error(err, "unable to create controller", "controller", "QuantumNamespaceStatus")
+		os.Exit(1)
+	}
+	if err := (&controller.QuantumCostReportReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Jobs:     jobs,
+		Recorder: mgr.GetEventRecorderFor("quantumcostreport-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QuantumCostReport")
+		os.Exit(1)
+	}
+	if err := (&controller.QuantumBackendReconciler{
+		Client:         mgr.GetClient(),
+		Scheme:         mgr.GetScheme(),
+		WithoutSecrets: !secretAccess,
+		IBM:            ibmOptions,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QuantumBackend")
+		os.Exit(1)
+	}
+	if err := (&controller.QuantumRuntimeVersionReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Jobs:   jobs,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QuantumRuntimeVersion")
 		os.Exit(1)
 	}
+	if err := (&controller.QiskitBulkOperationReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+		Jobs:   jobs,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QiskitBulkOperation")
+		os.Exit(1)
+	}
+	if err := (&controller.ScheduledQiskitJobReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("scheduledqiskitjob-controller"),
+		Jobs:     jobs,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ScheduledQiskitJob")
+		os.Exit(1)
+	}
+	if err := (&controller.QiskitWorkflowReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("qiskitworkflow-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QiskitWorkflow")
+		os.Exit(1)
+	}
+	if err := (&controller.QuantumWorkspaceReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Recorder:      mgr.GetEventRecorderFor("quantumworkspace-controller"),
+		Jobs:          jobs,
+		PackageIndex:  packageIndex,
+		ExecutorImage: executorImage,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "QuantumWorkspace")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1.SetupQiskitJobWebhookWithManager(mgr, packageAllowlist); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "QiskitJob")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
+	// Resume jobs that were in flight when the previous operator version stopped
+	if err := mgr.Add(&controller.InFlightRecovery{Client: mgr.GetClient()}); err != nil {
+		setupLog.Error(err, "unable to set up in-flight job recovery")
+		os.Exit(1)
+	}
+
+	// Clean up after jobs deleted without their finalizer
+	if orphanSweepInterval > 0 {
+		if err := mgr.Add(&controller.OrphanSweeper{Client: mgr.GetClient(), Interval: orphanSweepInterval, Jobs: jobs}); err != nil {
+			setupLog.Error(err, "unable to set up orphan sweeper")
+			os.Exit(1)
+		}
+	}
+
+	// Delete finished jobs whose TTL ran out
+	if ttlSweepInterval > 0 {
+		sweeper := &controller.TTLSweeper{Client: mgr.GetClient(), Interval: ttlSweepInterval, DefaultTTL: jobTTL, Jobs: jobs,
+			Archive: archive}
+		if err := mgr.Add(sweeper); err != nil {
+			setupLog.Error(err, "unable to set up TTL sweeper")
+			os.Exit(1)
+		}
+	}
+
+	// Close IBM Quantum sessions leaked by crashed executors or operators
+	if sessionSweepInterval > 0 && secretAccess && clusterID != "" {
+		secrets, err := parseSecretRefs(sessionSweepSecrets)
+		if err != nil {
+			setupLog.Error(err, "invalid --session-sweep-secrets")
+			os.Exit(1)
+		}
+		if err := mgr.Add(&controller.SessionSweeper{
+			Client:    mgr.GetClient(),
+			ClusterID: clusterID,
+			Interval:  sessionSweepInterval,
+			Secrets:   secrets,
+			IBM:       ibmOptions,
+			Jobs:      jobs,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up session sweeper")
+			os.Exit(1)
+		}
+	}
+
+	// Report usage to the platform owners' endpoint, if they opted in
+	if telemetryEndpoint != "" && telemetryInterval > 0 {
+		if err := mgr.Add(&telemetry.Reporter{
+			Endpoint:  telemetryEndpoint,
+			Interval:  telemetryInterval,
+			Version:   version,
+			ClusterID: clusterID,
+			Usage: func(ctx context.Context) (*telemetry.Usage, error) {
+				return controller.TelemetryUsage(ctx, mgr.GetClient(), jobs)
+			},
+		}); err != nil {
+			setupLog.Error(err, "unable to set up telemetry reporter")
+			os.Exit(1)
+		}
+	}
+
+	// Export the executor demand of waiting jobs for node autoscaling
+	metrics.RegisterDemand(func(ctx context.Context) ([]metrics.Demand, error) {
+		return controller.PendingDemand(ctx, mgr.GetClient())
+	})
+	// Export the number of unfinished jobs per namespace and phase
+	metrics.RegisterActiveJobs(func(ctx context.Context) ([]metrics.ActiveJobs, error) {
+		return controller.ActiveJobs(ctx, mgr.GetClient())
+	})
+	// Export the month's spend per namespace and cost center for chargeback
+	metrics.RegisterSpend(func(ctx context.Context) (*metrics.Spend, error) {
+		return controller.CostReportSpend(ctx, mgr.GetClient())
+	})
+	// Export the live resource usage of running executors for dashboards
+	metrics.RegisterUsage(func(ctx context.Context) ([]metrics.Usage, error) {
+		return controller.ExecutorUsage(ctx, mgr.GetClient(), results.ClientsetLogReader{Clientset: clientset})
+	})
+	// Serve condensed job summaries for dashboards and cmd/jobs
+	if err := mgr.AddMetricsServerExtraHandler(summary.Path, &summary.Handler{Lister: jobs}); err != nil {
+		setupLog.Error(err, "unable to set up the job summary endpoint")
+		os.Exit(1)
+	}
+	// Serve the provenance graphs of jobs for audits, read from the API
+	// server as finished jobs leave the cache
+	if err := mgr.AddMetricsServerExtraHandler(lineage.Path, &lineage.Handler{Reader: mgr.GetAPIReader()}); err != nil {
+		setupLog.Error(err, "unable to set up the lineage endpoint")
+		os.Exit(1)
+	}
+	// Serve the decision traces of jobs for debugging
+	if jobReconciler.Decisions != nil {
+		if err := mgr.AddMetricsServerExtraHandler(controller.DecisionsPath, jobReconciler.Decisions); err != nil {
+			setupLog.Error(err, "unable to set up the decision trace endpoint")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
+	// Restart the pod when its controllers stop processing
+	if stallTimeout > 0 {
+		dog := watchdog.New(ctrlmetrics.Registry, stallTimeout)
+		dog.Recorder = mgr.GetEventRecorderFor("watchdog")
+		if name, namespace := os.Getenv("POD_NAME"), os.Getenv("POD_NAMESPACE"); name != "" && namespace != "" {
+			dog.Pod = &corev1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: namespace, Name: name}
+		}
+		if err := mgr.Add(dog); err != nil {
+			setupLog.Error(err, "unable to set up the reconciler watchdog")
+			os.Exit(1)
+		}
+		if err := mgr.AddHealthzCheck("reconcilers", dog.Check); err != nil {
+			setupLog.Error(err, "unable to set up reconciler health check")
+			os.Exit(1)
+		}
+	}
 	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
@@ -223,3 +1050,118 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// parseSecretRefs parses comma-separated namespace/name Secret references
+func parseSecretRefs(s string) ([]types.NamespacedName, error) {
+	var refs []types.NamespacedName
+	for _, ref := range strings.Split(s, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		namespace, name, ok := strings.Cut(ref, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("secret %q is not namespace/name", ref)
+		}
+		refs = append(refs, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+	return refs, nil
+}
+
+// parseCIDRs parses comma-separated CIDRs
+func parseCIDRs(s string) ([]string, error) {
+	var cidrs []string
+	for _, cidr := range strings.Split(s, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return cidrs, nil
+}
+
+// parsePorts parses comma-separated TCP ports
+func parsePorts(s string) ([]int32, error) {
+	var ports []int32
+	for _, port := range strings.Split(s, ",") {
+		port = strings.TrimSpace(port)
+		if port == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(port, 10, 32)
+		if err != nil || n < 1 || n > 65535 {
+			return nil, fmt.Errorf("port %q is not between 1 and 65535", port)
+		}
+		ports = append(ports, int32(n))
+	}
+	return ports, nil
+}
+
+// selectedNamespaces returns the namespaces matching the label selector, to
+// restrict the manager's cache to
+func selectedNamespaces(config *rest.Config, selector string) (map[string]cache.Config, error) {
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+	var namespaces corev1.NamespaceList
+	if err := c.List(context.Background(), &namespaces, client.MatchingLabelsSelector{Selector: labelSelector}); err != nil {
+		return nil, err
+	}
+	if len(namespaces.Items) == 0 {
+		return nil, fmt.Errorf("no namespaces match %q", selector)
+	}
+	selected := make(map[string]cache.Config, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		selected[ns.Name] = cache.Config{}
+	}
+	// Sandbox namespaces are created as jobs run; what runs in them is
+	// cached by its label instead
+	sandboxed, err := labels.NewRequirement(controller.SandboxLabel, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+	selected[cache.AllNamespaces] = cache.Config{LabelSelector: labels.NewSelector().Add(*sandboxed)}
+	return selected, nil
+}
+
+// callbackServer configures the executor callback server and the endpoint
+// execution pods are told to call back at. Executors authenticate with a
+// client certificate issued from the client CA as well as a token.
+func callbackServer(addr, url, certPath, keyFile, clientCAPath string) (*callback.Server, *callback.Endpoint, error) {
+	if url == "" || certPath == "" || keyFile == "" || clientCAPath == "" {
+		return nil, nil, fmt.Errorf("--callback-url, --callback-cert-path, --callback-key-file and " +
+			"--callback-client-ca-path are required")
+	}
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	if key = []byte(strings.TrimSpace(string(key))); len(key) < 32 {
+		return nil, nil, fmt.Errorf("%s must hold at least 32 bytes", keyFile)
+	}
+	ca, err := os.ReadFile(filepath.Join(certPath, "ca.crt"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+	issuer, err := callback.LoadIssuer(clientCAPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("--callback-client-ca-path: %w", err)
+	}
+	server := &callback.Server{
+		Key:       key,
+		ClientCAs: issuer.Pool(),
+		Addr:      addr,
+		CertFile:  filepath.Join(certPath, "tls.crt"),
+		KeyFile:   filepath.Join(certPath, "tls.key"),
+	}
+	return server, &callback.Endpoint{URL: strings.TrimSuffix(url, "/"), CA: string(ca), Key: key, Issuer: issuer}, nil
+}
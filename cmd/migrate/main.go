@@ -0,0 +1,130 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(quantumv1.AddToScheme(scheme))
+}
+
+// migrate rewrites the QiskitJobs stored in a cluster from the conventions of
+// older operators to those of this one, and reports what it changed. Run it
+// with --dry-run first, then without, when upgrading across a release that
+// retires old conventions.
+func main() {
+	var namespace, output string
+	var dryRun bool
+	flag.StringVar(&namespace, "namespace", "", "Only migrate QiskitJobs in this namespace. Empty migrates all.")
+	flag.BoolVar(&dryRun, "dry-run", false, "Report what would be migrated without writing anything.")
+	flag.StringVar(&output, "output", "text", "Report format: text or json.")
+	flag.Parse()
+
+	if output != "text" && output != "json" {
+		fmt.Fprintf(os.Stderr, "unknown --output %q, must be text or json\n", output)
+		os.Exit(2)
+	}
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create client: %v\n", err)
+		os.Exit(1)
+	}
+
+	migrator := &controller.Migrator{Client: c, Namespace: namespace, DryRun: dryRun}
+	report, err := migrator.Run(context.Background())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if output == "json" {
+		err = writeJSON(os.Stdout, report)
+	} else {
+		err = writeText(os.Stdout, report)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if report.Failed() > 0 {
+		os.Exit(1)
+	}
+}
+
+func writeJSON(w io.Writer, report *controller.MigrationReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func writeText(w io.Writer, report *controller.MigrationReport) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tNAME\tPHASE\tVERSION\tFIELDS\tERROR")
+	for _, job := range report.Jobs {
+		phase := job.ToPhase
+		if job.FromPhase != job.ToPhase {
+			phase = job.FromPhase + " -> " + job.ToPhase
+		}
+		version := fmt.Sprint(job.ToVersion)
+		if job.FromVersion != job.ToVersion {
+			version = fmt.Sprintf("%d -> %d", job.FromVersion, job.ToVersion)
+		}
+		fields := strings.Join(job.Fields, ",")
+		if fields == "" {
+			fields = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", job.Namespace, job.Name, phase, version, fields, job.Error)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	verb := "Migrated"
+	if report.DryRun {
+		verb = "Would migrate"
+	}
+	_, err := fmt.Fprintf(w, "%s %d of %d QiskitJobs, %d failed\n",
+		verb, len(report.Jobs)-report.Failed(), report.Scanned, report.Failed())
+	return err
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"os"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/callback"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/work"
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(quantumv1.AddToScheme(scheme))
+}
+
+// The results processor parses and exports QiskitJob results claimed from the
+// Lease-based work queue. Run it alongside a manager started with
+// --external-results-processor.
+func main() {
+	var pollInterval time.Duration
+	var leaseDuration time.Duration
+	var searchURL string
+	var signingKeyFile string
+	flag.DurationVar(&pollInterval, "poll-interval", 5*time.Second,
+		"How often to look for results to process when the queue is empty.")
+	flag.DurationVar(&leaseDuration, "lease-duration", work.DefaultLeaseDuration,
+		"How long a claimed task stays claimed without renewal before another processor may take it.")
+	flag.StringVar(&searchURL, "search-url", "",
+		"OpenSearch or Elasticsearch endpoint result summaries are indexed into. "+
+			"Credentials are read from SEARCH_API_KEY or SEARCH_USERNAME and SEARCH_PASSWORD.")
+	flag.StringVar(&signingKeyFile, "results-signing-key-file", "",
+		"A PEM-encoded Ed25519 private key the digests of exported configmap and s3 results are signed with. "+
+			"Use the manager's key.")
+	opts := zap.Options{
+		Development: true,
+	}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+
+	// Claims are held under the pod name so operators can see who is working on what
+	identity := os.Getenv("POD_NAME")
+	if identity == "" {
+		var err error
+		if identity, err = os.Hostname(); err != nil {
+			setupLog.Error(err, "unable to determine processor identity")
+			os.Exit(1)
+		}
+	}
+
+	cfg := ctrl.GetConfigOrDie()
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create client")
+		os.Exit(1)
+	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		setupLog.Error(err, "unable to create clientset")
+		os.Exit(1)
+	}
+
+	var search *results.SearchIndexer
+	if searchURL != "" {
+		if search, err = results.NewSearchIndexer(searchURL); err != nil {
+			setupLog.Error(err, "invalid --search-url")
+			os.Exit(1)
+		}
+	}
+	var signer results.Signer
+	if signingKeyFile != "" {
+		key, err := results.LoadSigningKey(signingKeyFile)
+		if err != nil {
+			setupLog.Error(err, "invalid --results-signing-key-file")
+			os.Exit(1)
+		}
+		signer = key
+	}
+
+	processor := &results.Processor{
+		Client:       c,
+		Scheme:       scheme,
+		Logs:         callback.LogReader{Client: c, Logs: results.ClientsetLogReader{Clientset: clientset}},
+		Queue:        work.NewQueue(c, scheme, identity, leaseDuration),
+		PollInterval: pollInterval,
+		Search:       search,
+		Signer:       signer,
+	}
+
+	setupLog.Info("starting results processor", "identity", identity)
+	ctx := ctrl.LoggerInto(ctrl.SetupSignalHandler(), ctrl.Log.WithName("results-processor"))
+	if err := processor.Run(ctx); err != nil {
+		setupLog.Error(err, "problem running results processor")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/support"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(quantumv1.AddToScheme(scheme))
+}
+
+// support-bundle gathers a QiskitJob, its events and conditions, the specs
+// and logs of its pods and the operator's logs about it into an archive to
+// attach to an issue. Installed as kubectl-quantum-support_bundle on the
+// path, it runs as "kubectl quantum support-bundle".
+func main() {
+	var namespace, output, operatorNamespace string
+	var margin time.Duration
+	flag.StringVar(&namespace, "namespace", "default", "Namespace of the QiskitJob.")
+	flag.StringVar(&output, "output", "", "Archive to write; <namespace>-<job>-support-bundle.tar.gz if empty.")
+	flag.StringVar(&operatorNamespace, "operator-namespace", support.DefaultOperatorNamespace,
+		"Namespace the operator runs in.")
+	flag.DurationVar(&margin, "margin", support.DefaultMargin,
+		"How far before the job was created and after it finished to gather operator logs.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] JOB\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	config := ctrl.GetConfigOrDie()
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create client: %v\n", err)
+		os.Exit(1)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create clientset: %v\n", err)
+		os.Exit(1)
+	}
+
+	gatherer := &support.Gatherer{
+		Client:            c,
+		Clientset:         clientset,
+		OperatorNamespace: operatorNamespace,
+		Margin:            margin,
+	}
+	if err := run(context.Background(), gatherer, client.ObjectKey{Namespace: namespace, Name: flag.Arg(0)}, output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, gatherer *support.Gatherer, key client.ObjectKey, output string) error {
+	bundle, err := gatherer.Gather(ctx, key)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		output = bundle.Name + ".tar.gz"
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	if err := bundle.Write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %s with %d files\n", output, len(bundle.Files))
+	for _, file := range bundle.Files {
+		if file.Name == "errors.txt" {
+			fmt.Println("Some of the job's records could not be gathered; see errors.txt in the archive")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// pollInterval is how often the uploader looks for the executor to be done
+const pollInterval = 500 * time.Millisecond
+
+// The uploader runs as a sidecar of execution pods started with
+// --uploader-image. It waits for the executor to hand its results over in
+// the directory they share, converts them to the format of each object store
+// and pvc output of the job and uploads them, then tells the executor how every
+// upload went. The executor never sees the outputs' credentials or claims.
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	dir := os.Getenv(results.ResultsDirEnv)
+	if dir == "" {
+		fail(fmt.Errorf("%s is not set", results.ResultsDirEnv))
+	}
+	// An uploader restarted after it uploaded keeps the statuses it wrote
+	uploaded := filepath.Join(dir, results.UploadedFile)
+	if _, err := os.Stat(uploaded); err != nil {
+		if err := run(ctx, dir, uploaded); err != nil {
+			fail(err)
+		}
+	}
+
+	// Sidecars that exit are restarted; wait for the pod to stop
+	<-ctx.Done()
+}
+
+// run waits for the executor to be done, uploads the results it reported
+// and writes the status of every upload
+func run(ctx context.Context, dir, uploaded string) error {
+	var targets []results.UploadTarget
+	if err := json.Unmarshal([]byte(os.Getenv(results.UploaderOutputsEnv)), &targets); err != nil {
+		return fmt.Errorf("invalid %s: %w", results.UploaderOutputsEnv, err)
+	}
+	var doc results.Document
+	if err := json.Unmarshal([]byte(os.Getenv(results.UploaderDocumentEnv)), &doc); err != nil {
+		return fmt.Errorf("invalid %s: %w", results.UploaderDocumentEnv, err)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, results.DoneFile)); err == nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+	reported, err := os.ReadFile(filepath.Join(dir, results.ReportedFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	filled, found := results.UploadedDocument(&doc, string(reported))
+	var qpy []byte
+	if transpiled, ok := results.ParseTranspiled(string(reported)); ok {
+		qpy = transpiled.QPY
+	}
+
+	statuses := make([]quantumv1.OutputStatus, 0, len(targets))
+	for i := range targets {
+		target := &targets[i]
+		status := quantumv1.OutputStatus{
+			Name:  results.OutputName(&target.OutputSpec),
+			Type:  target.Type,
+			State: quantumv1.OutputExported,
+		}
+		err := errors.New("the executor reported no results")
+		if found {
+			err = upload(ctx, target, filled, qpy)
+		}
+		if err != nil {
+			status.State = quantumv1.OutputFailed
+			status.Message = err.Error()
+		}
+		if status.Message != "" {
+			fmt.Printf("output %s: %s: %s\n", status.Name, status.State, status.Message)
+		} else {
+			fmt.Printf("output %s: %s\n", status.Name, status.State)
+		}
+		statuses = append(statuses, status)
+	}
+
+	data, err := json.Marshal(statuses)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(uploaded+".tmp", data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(uploaded+".tmp", uploaded)
+}
+
+// upload writes the results to one output, which retries failures that may
+// not happen again
+func upload(ctx context.Context, target *results.UploadTarget, doc *results.Document, qpy []byte) error {
+	store, err := results.OpenStore(ctx, target.Env(os.Getenv), &target.OutputSpec)
+	if err != nil {
+		return err
+	}
+	return results.StoreDocument(ctx, store, &target.OutputSpec, doc, qpy)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "uploader: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(quantumv1.AddToScheme(scheme))
+}
+
+// verify proves that results are what the operator produced: that a results
+// document has the digest recorded in its QiskitJob's status and that the
+// digest was signed with the operator's results signing key. The document is
+// read from the job's ConfigMap, or from a copy given with --file, such as
+// one downloaded from s3. Jobs that no longer exist are verified with the
+// digest and signature given on the command line.
+func main() {
+	var namespace, keyFile, file, digest, signature string
+	flag.StringVar(&namespace, "namespace", "default", "Namespace of the QiskitJob.")
+	flag.StringVar(&keyFile, "key", "", "PEM-encoded Ed25519 public key of the operator's results signing key. Required.")
+	flag.StringVar(&file, "file", "", "A copy of the results document, possibly compressed, in place of the job's ConfigMap.")
+	flag.StringVar(&digest, "digest", "", "Digest recorded for the results, in place of the job's status. Requires --file.")
+	flag.StringVar(&signature, "signature", "", "Signature recorded for the results, in place of the job's status. Requires --file.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <job>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	offline := digest != "" || signature != ""
+	if keyFile == "" || (offline && (file == "" || digest == "" || signature == "")) ||
+		(!offline && flag.NArg() != 1) {
+		flag.Usage()
+		os.Exit(2)
+	}
+	key, err := results.LoadVerificationKey(keyFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	var name string
+	var info *quantumv1.ResultsInfo
+	var doc *results.Document
+	if offline {
+		name = file
+		info = &quantumv1.ResultsInfo{Digest: digest, Signature: signature}
+	} else {
+		c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "unable to create client: %v\n", err)
+			os.Exit(1)
+		}
+		var job quantumv1.QiskitJob
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: flag.Arg(0)}, &job); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		name = namespace + "/" + job.Name
+		info = job.Status.Results
+		if file == "" {
+			if doc, err = jobDocument(ctx, c, &job); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+	}
+	if doc == nil {
+		if doc, err = readDocument(file); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	if err := results.Verify(doc, info, key); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+		os.Exit(1)
+	}
+	fmt.Printf("%s: results match digest %s signed by %s\n", name, info.Digest, results.KeyID(key))
+}
+
+// jobDocument reads the results document of a job from its first configmap
+// output
+func jobDocument(ctx context.Context, c client.Client, job *quantumv1.QiskitJob) (*results.Document, error) {
+	for _, output := range job.Spec.Outputs {
+		if output.Type == "configmap" {
+			return results.Read(ctx, c, job.Namespace, output.Location)
+		}
+	}
+	return nil, errors.New("only configmap results are read from the cluster; pass a copy of the results with --file")
+}
+
+// readDocument reads a results document from a file, decompressing it
+func readDocument(path string) (*results.Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = results.Decompress(data); err != nil {
+		return nil, err
+	}
+	return results.DecodeDocument(data)
+}
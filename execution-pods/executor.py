@@ -16,19 +16,37 @@ import time
 from datetime import datetime
 
 
+def read_circuit():
+    """Return the circuit code from $CIRCUIT_FILE if it exists, else $CIRCUIT_CODE"""
+    circuit_file = os.getenv('CIRCUIT_FILE', '/circuit/main.py')
+    if circuit_file and os.path.isfile(circuit_file):
+        with open(circuit_file) as f:
+            return f.read()
+    return os.getenv('CIRCUIT_CODE', '')
+
+
+def aer_version():
+    """Return the installed Qiskit Aer version"""
+    try:
+        import qiskit_aer
+        return qiskit_aer.__version__
+    except (ImportError, AttributeError):
+        return "unknown"
+
+
 def main():
     """Main execution function"""
     print("=" * 60)
     print("QiskitOperator Circuit Executor")
     print("=" * 60)
     
-    # Get circuit code from environment
-    circuit_code = os.getenv('CIRCUIT_CODE', '')
+    # Get circuit code from the circuit file, or else the environment
+    circuit_code = read_circuit()
     shots = int(os.getenv('SHOTS', '1024'))
     optimization_level = int(os.getenv('OPTIMIZATION_LEVEL', '1'))
     
     if not circuit_code:
-        print("ERROR: CIRCUIT_CODE environment variable is required")
+        print("ERROR: CIRCUIT_FILE or CIRCUIT_CODE is required")
         sys.exit(1)
     
     print(f"\nConfiguration:")
@@ -107,7 +125,7 @@ def main():
             "backend_info": {
                 "name": "aer_simulator",
                 "type": "simulator",
-                "version": "0.13.0"
+                "version": aer_version()
             },
             "execution": {
                 "start_time": datetime.utcnow().isoformat() + "Z",
@@ -135,8 +153,8 @@ def main():
         }
         
         # Write results to file
-        results_file = "/results/results.json"
-        os.makedirs("/results", exist_ok=True)
+        results_file = os.getenv('RESULTS_FILE', '/results/results.json')
+        os.makedirs(os.path.dirname(results_file) or ".", exist_ok=True)
         
         print(f"\nWriting results to {results_file}...")
         with open(results_file, 'w') as f:
@@ -0,0 +1,224 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package callback lets executors hand their progress and results to the
+// operator over mutual TLS instead of leaving them to be scraped from pod
+// logs. Each execution pod is given a client certificate and a token that
+// only authenticate its own attempt; what it posts is stored in a ConfigMap
+// owned by the job, which the
+// reconciler and the results processor read in preference to the pod logs.
+// Executors that cannot reach the operator still log everything, so pod logs
+// remain the fallback.
+package callback
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/heartbeat"
+)
+
+// Environment variables configuring the executor
+const (
+	// URLEnv carries the URL of the pod's callback resource
+	URLEnv = "CALLBACK_URL"
+	// TokenEnv carries the token authenticating the pod's attempt
+	TokenEnv = "CALLBACK_TOKEN"
+	// CAEnv carries the PEM certificates the operator's certificate is
+	// verified against, if not the system roots
+	CAEnv = "CALLBACK_CA"
+	// ClientCertEnv and ClientKeyEnv carry the PEM client certificate and
+	// key the pod presents to the operator
+	ClientCertEnv = "CALLBACK_CLIENT_CERT"
+	ClientKeyEnv  = "CALLBACK_CLIENT_KEY"
+)
+
+// IsEnv reports whether an environment variable is one Env sets
+func IsEnv(name string) bool {
+	switch name {
+	case URLEnv, TokenEnv, CAEnv, ClientCertEnv, ClientKeyEnv:
+		return true
+	}
+	return false
+}
+
+// Keys of the ConfigMap holding what an execution pod posted
+const (
+	// HeartbeatKey holds the pod's last heartbeat as a heartbeat line
+	HeartbeatKey = "heartbeat"
+	// OutputKey holds the executor's standard output, compressed
+	OutputKey = "output"
+)
+
+// Prologue is prepended to the executor code when callbacks are enabled.
+// It copies standard output as it is written, posts each heartbeat as
+// progress and the whole output on exit, presenting the pod's client
+// certificate. Failed posts are reported on standard error and otherwise
+// ignored, since the same lines are logged.
+const Prologue = `import atexit as _cb_atexit
+import os as _cb_os
+import ssl as _cb_ssl
+import sys as _cb_sys
+import tempfile as _cb_tempfile
+import threading as _cb_threading
+import urllib.request as _cb_request
+
+_cb_context = _cb_ssl.create_default_context(cadata=_cb_os.environ.get('` + CAEnv + `') or None)
+if _cb_os.environ.get('` + ClientCertEnv + `'):
+    try:
+        with _cb_tempfile.NamedTemporaryFile('w', suffix='.pem', delete=False) as _cb_pem:
+            _cb_pem.write(_cb_os.environ['` + ClientCertEnv + `'] + _cb_os.environ['` + ClientKeyEnv + `'])
+        try:
+            _cb_context.load_cert_chain(_cb_pem.name)
+        finally:
+            _cb_os.unlink(_cb_pem.name)
+    except Exception as error:
+        print('Callback client certificate not loaded: %s' % error, file=_cb_sys.stderr, flush=True)
+
+def _cb_post(kind, text):
+    request = _cb_request.Request(_cb_os.environ['` + URLEnv + `'] + '/' + kind, data=text.encode(), method='POST',
+        headers={'Authorization': 'Bearer ' + _cb_os.environ['` + TokenEnv + `'], 'Content-Type': 'text/plain; charset=utf-8'})
+    try:
+        _cb_request.urlopen(request, timeout=30, context=_cb_context).close()
+    except Exception as error:
+        print('Callback to the operator failed: %s' % error, file=_cb_sys.stderr, flush=True)
+
+class _CallbackStdout:
+    def __init__(self, stream):
+        self._stream = stream
+        self._output = []
+
+    def write(self, text):
+        self._output.append(text)
+        if text.startswith('` + heartbeat.Marker + ` '):
+            _cb_threading.Thread(target=_cb_post, args=('progress', text), daemon=True).start()
+        return self._stream.write(text)
+
+    def __getattr__(self, name):
+        return getattr(self._stream, name)
+
+    def output(self):
+        return ''.join(self._output)
+
+_cb_stdout = _CallbackStdout(_cb_sys.stdout)
+_cb_sys.stdout = _cb_stdout
+_cb_atexit.register(lambda: _cb_post('output', _cb_stdout.output()))
+
+`
+
+// Endpoint is where execution pods call back
+type Endpoint struct {
+	// URL executors reach the callback server at
+	URL string
+	// CA holds the PEM certificates executors verify the server against;
+	// empty uses the system roots
+	CA string
+	// Key signs the tokens of execution pods
+	Key []byte
+	// Issuer issues the client certificates of execution pods
+	Issuer *Issuer
+}
+
+// Env returns the environment telling an execution pod of the job how to
+// call back, with a client certificate of its own
+func (e *Endpoint) Env(job *quantumv1.QiskitJob, pod string) ([]corev1.EnvVar, error) {
+	env := []corev1.EnvVar{
+		{Name: URLEnv, Value: fmt.Sprintf("%s/v1/namespaces/%s/pods/%s", e.URL, job.Namespace, pod)},
+		{Name: TokenEnv, Value: Token(e.Key, job.Namespace, pod, job.UID)},
+	}
+	if e.CA != "" {
+		env = append(env, corev1.EnvVar{Name: CAEnv, Value: e.CA})
+	}
+	if e.Issuer != nil {
+		cert, key, err := e.Issuer.Issue(job.Namespace, pod)
+		if err != nil {
+			return nil, fmt.Errorf("issuing callback client certificate: %w", err)
+		}
+		env = append(env, corev1.EnvVar{Name: ClientCertEnv, Value: string(cert)},
+			corev1.EnvVar{Name: ClientKeyEnv, Value: string(key)})
+	}
+	return env, nil
+}
+
+// Token returns the token of an execution pod. It is bound to the job's UID
+// as well as the pod, so it does not carry over to a recreated job of the
+// same name.
+func Token(key []byte, namespace, pod string, job types.UID) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s/%s/%s", namespace, pod, job)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ConfigMapName names the ConfigMap holding what an execution pod posted
+func ConfigMapName(pod string) string {
+	return pod + "-callback"
+}
+
+// PodLogReader reads execution pod logs, in full or within a recent window
+type PodLogReader interface {
+	PodLogs(ctx context.Context, namespace, name string) (string, error)
+	RecentPodLogs(ctx context.Context, namespace, name string, since time.Duration) (string, error)
+}
+
+// LogReader reads what execution pods posted to the callback server,
+// falling back to their logs for pods that posted nothing
+type LogReader struct {
+	Client client.Reader
+	Logs   PodLogReader
+}
+
+// PodLogs returns the output the executor posted on exit, or its logs
+func (r LogReader) PodLogs(ctx context.Context, namespace, name string) (string, error) {
+	if cm, ok := r.posted(ctx, namespace, name); ok {
+		if data, ok := cm.BinaryData[OutputKey]; ok {
+			if output, err := results.Decompress(data); err == nil {
+				return string(output), nil
+			}
+		}
+	}
+	return r.Logs.PodLogs(ctx, namespace, name)
+}
+
+// RecentPodLogs returns the heartbeat the executor posted within the last
+// since, or what it logged in that time
+func (r LogReader) RecentPodLogs(ctx context.Context, namespace, name string, since time.Duration) (string, error) {
+	if cm, ok := r.posted(ctx, namespace, name); ok {
+		line := cm.Data[HeartbeatKey]
+		if beat, ok := heartbeat.Last(line); ok && time.Since(beat.Time) <= since {
+			return line, nil
+		}
+	}
+	return r.Logs.RecentPodLogs(ctx, namespace, name, since)
+}
+
+// posted returns the ConfigMap of what the pod posted, if it could be read
+func (r LogReader) posted(ctx context.Context, namespace, name string) (*corev1.ConfigMap, bool) {
+	var cm corev1.ConfigMap
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ConfigMapName(name)}, &cm); err != nil {
+		return nil, false
+	}
+	return &cm, true
+}
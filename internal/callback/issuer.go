@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package callback
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ClientCertValidity is how long the client certificate of an execution
+// pod is valid. Executions running longer lose their callbacks and are read
+// from their logs instead.
+const ClientCertValidity = 30 * 24 * time.Hour
+
+// Issuer issues the client certificates execution pods present to the
+// callback server, from a CA the server verifies them against
+type Issuer struct {
+	ca  *x509.Certificate
+	key crypto.Signer
+}
+
+// NewIssuer returns an issuer signing with the PEM certificate and key of a CA
+func NewIssuer(certPEM, keyPEM []byte) (*Issuer, error) {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	if !ca.IsCA {
+		return nil, fmt.Errorf("certificate of %s is not a CA", ca.Subject)
+	}
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key cannot sign")
+	}
+	return &Issuer{ca: ca, key: key}, nil
+}
+
+// LoadIssuer reads the tls.crt and tls.key of a CA from dir, as mounted from
+// a kubernetes.io/tls Secret
+func LoadIssuer(dir string) (*Issuer, error) {
+	certPEM, err := os.ReadFile(filepath.Join(dir, "tls.crt"))
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := os.ReadFile(filepath.Join(dir, "tls.key"))
+	if err != nil {
+		return nil, err
+	}
+	return NewIssuer(certPEM, keyPEM)
+}
+
+// Pool returns the CA as the pool client certificates are verified against
+func (i *Issuer) Pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(i.ca)
+	return pool
+}
+
+// ClientName is the common name of the client certificate of an execution
+// pod, which only authenticates callbacks for that execution
+func ClientName(namespace, pod string) string {
+	return namespace + "/" + pod
+}
+
+// Issue returns a new PEM client certificate and key for an execution pod
+func (i *Issuer) Issue(namespace, pod string) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: ClientName(namespace, pod)},
+		// Allow for clocks running behind the operator's
+		NotBefore:   now.Add(-5 * time.Minute),
+		NotAfter:    now.Add(ClientCertValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, i.ca, &key.PublicKey, i.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), nil
+}
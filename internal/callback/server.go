@@ -0,0 +1,302 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package callback
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/heartbeat"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+// MaxOutputBytes is the most output an executor may post
+const MaxOutputBytes = 32 << 20
+
+// maxConfigMapBytes is the most data a ConfigMap can hold
+const maxConfigMapBytes = 1 << 20
+
+// attemptLabel records which attempt of a job an execution pod ran; it is
+// set by the reconciler
+const attemptLabel = "quantum.io/attempt"
+
+// Server receives what execution pods post. It runs on every replica of the
+// operator, so it does not wait to be elected leader.
+type Server struct {
+	// Client reads executions and their jobs, and stores what they post
+	Client client.Client
+	Scheme *runtime.Scheme
+	// Key verifies the tokens of execution pods
+	Key []byte
+	// ClientCAs verify the client certificates of execution pods. Start
+	// requires them; handlers served without TLS only check tokens.
+	ClientCAs *x509.CertPool
+
+	// Addr is the address the server listens on
+	Addr string
+	// CertFile and KeyFile hold the server's certificate and key; they are
+	// reloaded when they change
+	CertFile string
+	KeyFile  string
+	TLSOpts  []func(*tls.Config)
+}
+
+// Start serves callbacks until the context is cancelled
+func (s *Server) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("callback")
+
+	if s.ClientCAs == nil {
+		return errors.New("the callback server needs the CA of executor client certificates")
+	}
+	watcher, err := certwatcher.New(s.CertFile, s.KeyFile)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := watcher.Start(ctx); err != nil {
+			logger.Error(err, "Certificate watcher stopped")
+		}
+	}()
+	tlsConfig := &tls.Config{
+		GetCertificate: watcher.GetCertificate,
+		ClientAuth:     tls.RequireAndVerifyClientCert,
+		ClientCAs:      s.ClientCAs,
+		MinVersion:     tls.VersionTLS12,
+	}
+	for _, opt := range s.TLSOpts {
+		opt(tlsConfig)
+	}
+
+	srv := &http.Server{
+		Addr:              s.Addr,
+		Handler:           s.Handler(),
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			logger.Error(err, "Failed to shut down callback server")
+		}
+	}()
+
+	logger.Info("Serving executor callbacks", "addr", s.Addr)
+	if err := srv.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Handler routes the callback API:
+//
+//	POST /v1/namespaces/{namespace}/pods/{pod}/progress  a heartbeat line
+//	POST /v1/namespaces/{namespace}/pods/{pod}/output    the executor's output
+//
+// where {pod} names the execution: the batch Job running the attempt, or the
+// execution pod itself for attempts started before executions ran as Jobs.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/namespaces/{namespace}/pods/{pod}/progress", s.handleProgress)
+	mux.HandleFunc("POST /v1/namespaces/{namespace}/pods/{pod}/output", s.handleOutput)
+	return mux
+}
+
+// handleProgress records the heartbeat an executor posted, stamped with the
+// time it was received
+func (s *Server) handleProgress(w http.ResponseWriter, req *http.Request) {
+	job, execution, ok := s.authorize(w, req)
+	if !ok {
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, 4096))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	beat, ok := heartbeat.Last(string(body))
+	if !ok {
+		http.Error(w, "body is not a heartbeat", http.StatusBadRequest)
+		return
+	}
+	line := fmt.Sprintf("%s %d %s", heartbeat.Marker, time.Now().Unix(), redact.String(beat.Progress))
+	s.store(req.Context(), w, job, execution, func(cm *corev1.ConfigMap) {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[HeartbeatKey] = line
+	})
+}
+
+// handleOutput records the output an executor posted on exit, compressed.
+// Credentials the executor did not mask, like those printed by subprocesses,
+// are masked before the output is stored.
+func (s *Server) handleOutput(w http.ResponseWriter, req *http.Request) {
+	job, execution, ok := s.authorize(w, req)
+	if !ok {
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, MaxOutputBytes))
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		http.Error(w, fmt.Sprintf("output exceeds %d bytes", MaxOutputBytes), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, err := results.Compress([]byte(redact.String(string(body))), results.CompressionZstd)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(OutputKey)+base64.StdEncoding.EncodedLen(len(data)) > maxConfigMapBytes {
+		http.Error(w, "output is too large for a ConfigMap even when compressed", http.StatusRequestEntityTooLarge)
+		return
+	}
+	s.store(req.Context(), w, job, execution, func(cm *corev1.ConfigMap) {
+		if cm.BinaryData == nil {
+			cm.BinaryData = map[string][]byte{}
+		}
+		cm.BinaryData[OutputKey] = data
+	})
+}
+
+// authorize checks the request's client certificate and token against the
+// execution it names and that the execution runs the current attempt of a
+// running job. Tokens of unknown executions are rejected like wrong tokens,
+// so they do not reveal which executions exist.
+func (s *Server) authorize(w http.ResponseWriter, req *http.Request) (*quantumv1.QiskitJob, client.Object, bool) {
+	ctx := req.Context()
+	namespace, name := req.PathValue("namespace"), req.PathValue("pod")
+
+	if s.ClientCAs != nil && !clientIs(req, namespace, name) {
+		http.Error(w, "client certificate is not that of the execution", http.StatusForbidden)
+		return nil, nil, false
+	}
+
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+		return nil, nil, false
+	}
+	execution, err := s.execution(ctx, namespace, name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return nil, nil, false
+	}
+	owner := metav1.GetControllerOf(execution)
+	if err != nil || owner == nil || owner.Kind != "QiskitJob" ||
+		!hmac.Equal([]byte(token), []byte(Token(s.Key, namespace, name, owner.UID))) {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return nil, nil, false
+	}
+
+	var job quantumv1.QiskitJob
+	err = s.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: owner.Name}, &job)
+	if apierrors.IsNotFound(err) || (err == nil && job.UID != owner.UID) {
+		http.Error(w, "job no longer exists", http.StatusGone)
+		return nil, nil, false
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return nil, nil, false
+	}
+	if job.Status.Phase != "Running" || execution.GetLabels()[attemptLabel] != strconv.Itoa(job.Status.RetryCount+1) {
+		http.Error(w, "execution does not run the job's current attempt", http.StatusConflict)
+		return nil, nil, false
+	}
+	return &job, execution, true
+}
+
+// clientIs reports whether the request was made with the verified client
+// certificate of the named execution
+func clientIs(req *http.Request, namespace, pod string) bool {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 {
+		return false
+	}
+	return req.TLS.VerifiedChains[0][0].Subject.CommonName == ClientName(namespace, pod)
+}
+
+// execution returns the batch Job of the named execution or, for attempts
+// started before executions ran as Jobs, its pod
+func (s *Server) execution(ctx context.Context, namespace, name string) (client.Object, error) {
+	key := client.ObjectKey{Namespace: namespace, Name: name}
+	var batchJob batchv1.Job
+	err := s.Client.Get(ctx, key, &batchJob)
+	if !apierrors.IsNotFound(err) {
+		return &batchJob, err
+	}
+	var pod corev1.Pod
+	return &pod, s.Client.Get(ctx, key, &pod)
+}
+
+// store applies mutate to the ConfigMap of what the execution posted,
+// creating it owned by the job, and writes the response
+func (s *Server) store(ctx context.Context, w http.ResponseWriter, job *quantumv1.QiskitJob, execution client.Object,
+	mutate func(*corev1.ConfigMap)) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName(execution.GetName()), Namespace: execution.GetNamespace()}}
+		_, err := controllerutil.CreateOrUpdate(ctx, s.Client, cm, func() error {
+			if cm.Labels == nil {
+				cm.Labels = map[string]string{}
+			}
+			cm.Labels[results.JobLabel] = job.Name
+			mutate(cm)
+			return controllerutil.SetControllerReference(job, cm, s.Scheme)
+		})
+		return err
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to store callback", "execution", execution.GetName())
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chaos injects faults into the QiskitJob controller to verify that
+// its phase machine is idempotent: status updates fail at random, requests
+// are reconciled twice and pod events arrive late. It is a test mode and must
+// never be enabled in production.
+package chaos
+
+import (
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// errInjected marks failures produced by the injector
+var errInjected = errors.New("injected by chaos test mode")
+
+// Config sets how often each fault is injected
+type Config struct {
+	// Fraction of status updates and patches that fail (0.0-1.0)
+	StatusUpdateFailureRate float64
+	// Fraction of reconciles that are immediately run a second time (0.0-1.0)
+	DuplicateReconcileRate float64
+	// Upper bound of the random delay added to pod events
+	InformerDelay time.Duration
+	// Seed for the fault sequence; 0 picks a random seed
+	Seed uint64
+}
+
+// DefaultConfig is the fault mix enabled by the --fault-injection flag
+func DefaultConfig() Config {
+	return Config{
+		StatusUpdateFailureRate: 0.2,
+		DuplicateReconcileRate:  0.3,
+		InformerDelay:           2 * time.Second,
+	}
+}
+
+// Injector decides when to inject faults. It is safe for concurrent use.
+type Injector struct {
+	config Config
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+// NewInjector returns an injector for the given configuration
+func NewInjector(config Config) *Injector {
+	seed := config.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	return &Injector{
+		config: config,
+		rnd:    rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// roll reports whether a fault with the given rate fires
+func (i *Injector) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.rnd.Float64() < rate
+}
+
+// delay returns a random delay up to the configured informer delay
+func (i *Injector) delay() time.Duration {
+	if i.config.InformerDelay <= 0 {
+		return 0
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return time.Duration(i.rnd.Int64N(int64(i.config.InformerDelay)))
+}
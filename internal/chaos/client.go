@@ -0,0 +1,76 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// WrapClient returns a client whose status updates and patches fail at the
+// configured rate. Failures are split between conflicts and server timeouts,
+// the two errors a reconciler most often sees from a busy API server.
+func (i *Injector) WrapClient(c client.Client) client.Client {
+	return &faultyClient{Client: c, injector: i}
+}
+
+type faultyClient struct {
+	client.Client
+	injector *Injector
+}
+
+// Status implements client.StatusClient
+func (c *faultyClient) Status() client.SubResourceWriter {
+	return &faultyStatusWriter{SubResourceWriter: c.Client.Status(), injector: c.injector}
+}
+
+type faultyStatusWriter struct {
+	client.SubResourceWriter
+	injector *Injector
+}
+
+// Update implements client.SubResourceWriter
+func (w *faultyStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if err := w.fault(ctx, obj); err != nil {
+		return err
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+// Patch implements client.SubResourceWriter
+func (w *faultyStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if err := w.fault(ctx, obj); err != nil {
+		return err
+	}
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
+
+func (w *faultyStatusWriter) fault(ctx context.Context, obj client.Object) error {
+	if !w.injector.roll(w.injector.config.StatusUpdateFailureRate) {
+		return nil
+	}
+	logf.FromContext(ctx).V(1).Info("Injecting status update failure", "object", client.ObjectKeyFromObject(obj))
+
+	if w.injector.roll(0.5) {
+		return apierrors.NewServerTimeout(schema.GroupResource{Resource: "status"}, "update", 1)
+	}
+	return apierrors.NewConflict(schema.GroupResource{Resource: "status"}, obj.GetName(), errInjected)
+}
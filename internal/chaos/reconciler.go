@@ -0,0 +1,89 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// WrapReconciler returns a reconciler that, at the configured rate, reconciles
+// a request a second time right after the first pass, as happens when an
+// event and a requeue race
+func (i *Injector) WrapReconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		result, err := r.Reconcile(ctx, req)
+		if err != nil || !i.roll(i.config.DuplicateReconcileRate) {
+			return result, err
+		}
+		logf.FromContext(ctx).V(1).Info("Injecting duplicate reconcile", "request", req.NamespacedName)
+		return r.Reconcile(ctx, req)
+	})
+}
+
+// DelayHandler returns an event handler that enqueues the requests produced
+// by h after a random delay, simulating a lagging informer
+func (i *Injector) DelayHandler(h handler.EventHandler) handler.EventHandler {
+	return &delayHandler{inner: h, injector: i}
+}
+
+var _ handler.EventHandler = &delayHandler{}
+
+type delayHandler struct {
+	inner    handler.EventHandler
+	injector *Injector
+}
+
+// Create implements handler.EventHandler
+func (d *delayHandler) Create(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	d.inner.Create(ctx, e, d.wrap(q))
+}
+
+// Update implements handler.EventHandler
+func (d *delayHandler) Update(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	d.inner.Update(ctx, e, d.wrap(q))
+}
+
+// Delete implements handler.EventHandler
+func (d *delayHandler) Delete(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	d.inner.Delete(ctx, e, d.wrap(q))
+}
+
+// Generic implements handler.EventHandler
+func (d *delayHandler) Generic(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	d.inner.Generic(ctx, e, d.wrap(q))
+}
+
+func (d *delayHandler) wrap(q workqueue.TypedRateLimitingInterface[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return &delayedQueue{TypedRateLimitingInterface: q, injector: d.injector}
+}
+
+// delayedQueue turns immediate adds into delayed ones
+type delayedQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	injector *Injector
+}
+
+// Add implements workqueue.TypedInterface
+func (q *delayedQueue) Add(item reconcile.Request) {
+	q.AddAfter(item, q.injector.delay())
+}
@@ -18,19 +18,30 @@ package controller
 
 import (
 	"context"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/queue"
 )
 
+// queuePredictionRefresh is how often backend queue predictions are checked
+// for changes to publish
+const queuePredictionRefresh = time.Minute
+
 // QiskitBackendReconciler reconciles a QiskitBackend object
 type QiskitBackendReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// QueuePredictor provides the queue wait predictions published in status.
+	// It is shared with the QiskitJob reconciler, which feeds it observations.
+	QueuePredictor *queue.Predictor
 }
 
 // +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitbackends,verbs=get;list;watch;create;update;patch;delete
@@ -39,19 +50,46 @@ type QiskitBackendReconciler struct {
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the QiskitBackend object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
 //
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
+// The backend's name is the backend key jobs are observed under, and its
+// status carries the current queue wait prediction for that backend. The
+// status is only written when the prediction changed.
 func (r *QiskitBackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+	logger := logf.FromContext(ctx)
+
+	var backend quantumv1.QiskitBackend
+	if err := r.Get(ctx, req.NamespacedName, &backend); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if r.QueuePredictor == nil {
+		return ctrl.Result{}, nil
+	}
+
+	prediction, ok := r.QueuePredictor.Predict(backend.Name)
+	if !ok {
+		return ctrl.Result{RequeueAfter: queuePredictionRefresh}, nil
+	}
+
+	published := queueWaitStatus(backend.Name, prediction)
+	if backend.Status.PredictedQueueWait == published.PredictedWait &&
+		backend.Status.QueueWaitSamples == prediction.Samples &&
+		backend.Status.LastObservedQueueWait == published.LastObservedWait {
+		return ctrl.Result{RequeueAfter: queuePredictionRefresh}, nil
+	}
 
-	// TODO(user): your logic here
+	now := metav1.Now()
+	backend.Status.PredictedQueueWait = published.PredictedWait
+	backend.Status.QueueWaitSamples = prediction.Samples
+	backend.Status.LastObservedQueueWait = published.LastObservedWait
+	backend.Status.LastUpdated = &now
+	if err := r.Status().Update(ctx, &backend); err != nil {
+		return ctrl.Result{}, err
+	}
 
-	return ctrl.Result{}, nil
+	logger.V(1).Info("Published queue wait prediction",
+		"backend", backend.Name, "wait", backend.Status.PredictedQueueWait, "samples", prediction.Samples)
+	return ctrl.Result{RequeueAfter: queuePredictionRefresh}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/queue"
 )
 
 var _ = Describe("QiskitBackend Controller", func() {
@@ -80,5 +82,39 @@ var _ = Describe("QiskitBackend Controller", func() {
 			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
+
+		It("should publish the predicted queue wait", func() {
+			predictor := queue.NewPredictor(queue.DefaultWindow)
+			predictor.Observe(resourceName, 30*time.Second)
+			predictor.Observe(resourceName, 90*time.Second)
+
+			controllerReconciler := &QiskitBackendReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				QueuePredictor: predictor,
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(queuePredictionRefresh))
+
+			backend := &quantumv1.QiskitBackend{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, backend)).To(Succeed())
+			Expect(backend.Status.PredictedQueueWait).To(Equal("1m0s"))
+			Expect(backend.Status.QueueWaitSamples).To(Equal(2))
+			Expect(backend.Status.LastObservedQueueWait).To(Equal("1m30s"))
+			Expect(backend.Status.LastUpdated).NotTo(BeNil())
+
+			By("leaving the status alone while the prediction is unchanged")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			unchanged := &quantumv1.QiskitBackend{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, unchanged)).To(Succeed())
+			Expect(unchanged.ResourceVersion).To(Equal(backend.ResourceVersion))
+		})
 	})
 })
@@ -0,0 +1,259 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/flowcontrol"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Actions of bulk operations
+const (
+	BulkActionCancel  = "Cancel"
+	BulkActionSuspend = "Suspend"
+	BulkActionResume  = "Resume"
+	BulkActionDelete  = "Delete"
+)
+
+// Phases of bulk operations
+const (
+	BulkPhaseRunning   = "Running"
+	BulkPhaseCompleted = "Completed"
+)
+
+// DefaultBulkJobsPerSecond is the rate bulk operations act on jobs at unless
+// they set their own
+const DefaultBulkJobsPerSecond = 10
+
+// maxBulkFailures caps the failures a bulk operation lists in its status
+const maxBulkFailures = 50
+
+// bulkProgressInterval is how many jobs a bulk operation acts on between
+// updates of its status
+const bulkProgressInterval = 50
+
+// QiskitBulkOperationReconciler applies the action of a QiskitBulkOperation to
+// the jobs it selects
+type QiskitBulkOperationReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Jobs lists the jobs an operation selects; nil lists them from the client
+	Jobs *JobLister
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitbulkoperations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitbulkoperations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch;update;patch;delete
+
+// Reconcile acts on every selected job in turn, no faster than the
+// operation's rate, and records the outcome. An operation runs once; if the
+// operator restarts halfway, the operation starts over and counts the jobs
+// it already acted on as skipped.
+func (r *QiskitBulkOperationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var op quantumv1.QiskitBulkOperation
+	if err := r.Get(ctx, req.NamespacedName, &op); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if op.Status.Phase == BulkPhaseCompleted || !op.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	now := metav1.Now()
+	op.Status = quantumv1.QiskitBulkOperationStatus{Phase: BulkPhaseRunning, StartTime: &now}
+	selector, err := metav1.LabelSelectorAsSelector(&op.Spec.Selector)
+	if err != nil {
+		return r.complete(ctx, &op, fmt.Sprintf("Invalid selector: %v", err))
+	}
+	// An empty selector would act on every job of the namespace
+	if selector.Empty() {
+		return r.complete(ctx, &op, "Selector selects no labels; refusing to act on every job")
+	}
+	var selected []*quantumv1.QiskitJob
+	err = eachJob(ctx, r.Client, r.Jobs, func(job *quantumv1.QiskitJob) error {
+		if selectsJob(&op, job) {
+			selected = append(selected, job.DeepCopy())
+		}
+		return nil
+	}, client.InNamespace(op.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	sortByName(selected)
+	op.Status.Matched = int32(len(selected))
+	op.Status.Message = fmt.Sprintf("Acting on %d jobs", len(selected))
+	if err := r.Status().Update(ctx, &op); err != nil {
+		return ctrl.Result{}, err
+	}
+	logger.Info("Starting bulk operation", "action", op.Spec.Action, "jobs", len(selected))
+
+	rate := op.Spec.JobsPerSecond
+	if rate <= 0 {
+		rate = DefaultBulkJobsPerSecond
+	}
+	limiter := flowcontrol.NewTokenBucketRateLimiter(float32(rate), 1)
+	defer limiter.Stop()
+
+	for i, job := range selected {
+		if err := limiter.Wait(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+		applied, err := r.apply(ctx, &op, job)
+		switch {
+		case err != nil:
+			op.Status.Failed++
+			if len(op.Status.Failures) < maxBulkFailures {
+				op.Status.Failures = append(op.Status.Failures, quantumv1.BulkJobFailure{Name: job.Name, Message: err.Error()})
+			}
+		case applied:
+			op.Status.Succeeded++
+		default:
+			op.Status.Skipped++
+		}
+		if (i+1)%bulkProgressInterval == 0 && i+1 < len(selected) {
+			op.Status.Message = fmt.Sprintf("Acted on %d of %d jobs", i+1, len(selected))
+			if err := r.Status().Update(ctx, &op); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	return r.complete(ctx, &op, bulkSummary(&op))
+}
+
+// complete records that the operation finished with the given message
+func (r *QiskitBulkOperationReconciler) complete(ctx context.Context, op *quantumv1.QiskitBulkOperation, message string) (ctrl.Result, error) {
+	now := metav1.Now()
+	op.Status.Phase = BulkPhaseCompleted
+	op.Status.CompletionTime = &now
+	op.Status.Message = message
+	log.FromContext(ctx).Info("Bulk operation completed", "message", message)
+	return ctrl.Result{}, r.Status().Update(ctx, op)
+}
+
+// SelectedJobs returns the jobs, among those matching the operation's
+// selector, that it acts on: jobs in one of its phases that existed when the
+// operation was created, ordered by name
+func SelectedJobs(op *quantumv1.QiskitBulkOperation, jobs []quantumv1.QiskitJob) []*quantumv1.QiskitJob {
+	var selected []*quantumv1.QiskitJob
+	for i := range jobs {
+		if selectsJob(op, &jobs[i]) {
+			selected = append(selected, &jobs[i])
+		}
+	}
+	sortByName(selected)
+	return selected
+}
+
+// selectsJob reports whether the operation acts on the job its selector matched
+func selectsJob(op *quantumv1.QiskitBulkOperation, job *quantumv1.QiskitJob) bool {
+	if !op.CreationTimestamp.IsZero() && op.CreationTimestamp.Before(&job.CreationTimestamp) {
+		return false
+	}
+	return len(op.Spec.Phases) == 0 || slices.Contains(op.Spec.Phases, string(job.Status.Phase))
+}
+
+// sortByName orders the jobs by name
+func sortByName(jobs []*quantumv1.QiskitJob) {
+	slices.SortFunc(jobs, func(a, b *quantumv1.QiskitJob) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// apply applies the operation's action to the job. It reports false when
+// the action does not apply, like cancelling a finished job.
+func (r *QiskitBulkOperationReconciler) apply(ctx context.Context, op *quantumv1.QiskitBulkOperation, job *quantumv1.QiskitJob) (bool, error) {
+	if !job.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	patch := client.MergeFrom(job.DeepCopy())
+	switch op.Spec.Action {
+	case BulkActionCancel:
+		if _, ok := job.Annotations[CancelAnnotation]; ok || !cancellable(job) {
+			return false, nil
+		}
+		reason := op.Spec.Reason
+		if reason == "" {
+			reason = "bulk operation " + op.Name
+		}
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		job.Annotations[CancelAnnotation] = reason
+	case BulkActionSuspend:
+		if job.Spec.Suspend || !cancellable(job) {
+			return false, nil
+		}
+		job.Spec.Suspend = true
+	case BulkActionResume:
+		if !job.Spec.Suspend || !cancellable(job) {
+			return false, nil
+		}
+		job.Spec.Suspend = false
+	case BulkActionDelete:
+		err := r.Delete(ctx, job)
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	default:
+		return false, fmt.Errorf("unknown action %q", op.Spec.Action)
+	}
+
+	err := r.Patch(ctx, job, patch)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// bulkSummary summarizes what the operation did
+func bulkSummary(op *quantumv1.QiskitBulkOperation) string {
+	verb := map[string]string{
+		BulkActionCancel:  "Cancelled",
+		BulkActionSuspend: "Suspended",
+		BulkActionResume:  "Resumed",
+		BulkActionDelete:  "Deleted",
+	}[op.Spec.Action]
+	return fmt.Sprintf("%s %d of %d jobs, %d skipped, %d failed",
+		verb, op.Status.Succeeded, op.Status.Matched, op.Status.Skipped, op.Status.Failed)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QiskitBulkOperationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Progress updates of the operation itself need no reconciliation
+		For(&quantumv1.QiskitBulkOperation{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("qiskitbulkoperation").
+		Complete(r)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("QiskitBulkOperation Controller", func() {
+	ctx := context.Background()
+	created := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	// experimentJob returns a job of the experiment in the phase, created
+	// before the operation
+	experimentJob := func(name, experiment string, phase quantumv1.QiskitJobPhase) *quantumv1.QiskitJob {
+		job := builder.NewBellStateJob(name, "default").Build()
+		job.Labels = map[string]string{"quantum.io/experiment": experiment}
+		job.CreationTimestamp = created
+		job.Status.Phase = phase
+		return job
+	}
+
+	newOperation := func(action string, phases ...string) *quantumv1.QiskitBulkOperation {
+		return &quantumv1.QiskitBulkOperation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bulk",
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(created.Add(time.Minute)),
+			},
+			Spec: quantumv1.QiskitBulkOperationSpec{
+				Action:        action,
+				Selector:      metav1.LabelSelector{MatchLabels: map[string]string{"quantum.io/experiment": "foo"}},
+				Phases:        phases,
+				JobsPerSecond: 100,
+			},
+		}
+	}
+
+	run := func(c client.Client, op *quantumv1.QiskitBulkOperation) *quantumv1.QiskitBulkOperation {
+		r := &QiskitBulkOperationReconciler{Client: c, Scheme: c.Scheme()}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(op)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(op), op)).To(Succeed())
+		return op
+	}
+
+	It("should cancel the selected jobs and report what it did", func() {
+		objects := []client.Object{
+			experimentJob("queued-1", "foo", PhasePending),
+			experimentJob("queued-2", "foo", PhaseScheduling),
+			experimentJob("done", "foo", PhaseCompleted),
+			experimentJob("other", "bar", PhasePending),
+		}
+		late := experimentJob("late", "foo", PhasePending)
+		late.CreationTimestamp = metav1.NewTime(created.Add(2 * time.Minute))
+		op := newOperation(BulkActionCancel)
+		op.Spec.Reason = "experiment stopped"
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(append(objects, late, op)...).
+			WithStatusSubresource(&quantumv1.QiskitBulkOperation{}, &quantumv1.QiskitJob{}).Build()
+
+		op = run(c, op)
+		Expect(op.Status.Phase).To(Equal(BulkPhaseCompleted))
+		Expect(op.Status.Matched).To(Equal(int32(3)), "jobs created after the operation are left alone")
+		Expect(op.Status.Succeeded).To(Equal(int32(2)))
+		Expect(op.Status.Skipped).To(Equal(int32(1)))
+		Expect(op.Status.Failed).To(BeZero())
+		Expect(op.Status.Message).To(Equal("Cancelled 2 of 3 jobs, 1 skipped, 0 failed"))
+
+		for name, cancelled := range map[string]bool{"queued-1": true, "queued-2": true, "done": false, "other": false, "late": false} {
+			job := &quantumv1.QiskitJob{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, job)).To(Succeed())
+			if cancelled {
+				Expect(job.Annotations).To(HaveKeyWithValue(CancelAnnotation, "experiment stopped"), name)
+			} else {
+				Expect(job.Annotations).NotTo(HaveKey(CancelAnnotation), name)
+			}
+		}
+
+		By("not running a completed operation again")
+		Expect(run(c, op).Status.Succeeded).To(Equal(int32(2)))
+	})
+
+	It("should suspend and resume only jobs in the given phases", func() {
+		var objects []client.Object
+		for i := 0; i < 3; i++ {
+			objects = append(objects, experimentJob(fmt.Sprintf("queued-%d", i), "foo", PhasePending))
+		}
+		objects = append(objects, experimentJob("running", "foo", PhaseRunning))
+		done := experimentJob("done", "foo", PhaseCompleted)
+		done.Spec.Suspend = true
+		objects = append(objects, done)
+		op := newOperation(BulkActionSuspend, string(PhasePending))
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(append(objects, op)...).
+			WithStatusSubresource(&quantumv1.QiskitBulkOperation{}, &quantumv1.QiskitJob{}).Build()
+
+		op = run(c, op)
+		Expect(op.Status.Matched).To(Equal(int32(3)))
+		Expect(op.Status.Succeeded).To(Equal(int32(3)))
+		running := &quantumv1.QiskitJob{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "running", Namespace: "default"}, running)).To(Succeed())
+		Expect(running.Spec.Suspend).To(BeFalse())
+
+		resume := newOperation(BulkActionResume)
+		resume.Name = "resume"
+		Expect(c.Create(ctx, resume)).To(Succeed())
+		resume = run(c, resume)
+		Expect(resume.Status.Succeeded).To(Equal(int32(3)))
+		Expect(resume.Status.Skipped).To(Equal(int32(2)), "finished jobs are not resumed")
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(done), done)).To(Succeed())
+		Expect(done.Spec.Suspend).To(BeTrue())
+	})
+
+	It("should refuse to act on every job", func() {
+		op := newOperation(BulkActionDelete)
+		op.Spec.Selector = metav1.LabelSelector{}
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithObjects(experimentJob("queued", "foo", PhasePending), op).
+			WithStatusSubresource(&quantumv1.QiskitBulkOperation{}).Build()
+
+		op = run(c, op)
+		Expect(op.Status.Phase).To(Equal(BulkPhaseCompleted))
+		Expect(op.Status.Matched).To(BeZero())
+		Expect(c.Get(ctx, types.NamespacedName{Name: "queued", Namespace: "default"}, &quantumv1.QiskitJob{})).To(Succeed())
+	})
+})
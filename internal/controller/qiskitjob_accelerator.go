@@ -0,0 +1,114 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ConditionGPUFallback is True when a job asking for a GPU is simulated on
+// the CPU because no node of the cluster has GPUs
+const ConditionGPUFallback = "GPUFallback"
+
+// aerDeviceEnv selects the device the simulator epilogue runs Aer on
+const aerDeviceEnv = "AER_DEVICE"
+
+// gpuFallback reports whether the job asked for a GPU but fell back to the CPU
+func gpuFallback(job *quantumv1.QiskitJob) bool {
+	return meta.IsStatusConditionTrue(job.Status.Conditions, ConditionGPUFallback)
+}
+
+// gpuAccelerated reports whether the job simulates on a GPU
+func gpuAccelerated(job *quantumv1.QiskitJob) bool {
+	return job.Spec.Execution.Accelerator == "gpu" && !gpuFallback(job)
+}
+
+// acceleratorEnv has Aer simulate on the GPU for jobs accelerated by one
+func acceleratorEnv(job *quantumv1.QiskitJob) []corev1.EnvVar {
+	if !gpuAccelerated(job) {
+		return nil
+	}
+	return []corev1.EnvVar{{Name: aerDeviceEnv, Value: "GPU"}}
+}
+
+// gpuUnavailable reports whether no node of the cluster can run a GPU
+// executor. Clusters whose autoscaler provisions GPU nodes on demand, told
+// by a --gpu-node-selector, are taken to have them, and a forbidden or empty
+// node list skips the check like that of scratch space.
+func (r *QiskitJobReconciler) gpuUnavailable(ctx context.Context) (bool, error) {
+	if len(r.GPUNodeSelector) > 0 {
+		return false, nil
+	}
+	var nodes corev1.NodeList
+	if err := r.List(ctx, &nodes); err != nil {
+		if apierrors.IsForbidden(err) {
+			log.FromContext(ctx).V(1).Info("Cannot list nodes, skipping GPU capability check")
+			return false, nil
+		}
+		return false, err
+	}
+	if len(nodes.Items) == 0 {
+		return false, nil
+	}
+	for _, node := range nodes.Items {
+		if gpus, ok := node.Status.Allocatable[GPUResource]; ok && !node.Spec.Unschedulable && gpus.Sign() > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// holdForGPU checks that a job asking for a GPU can get one before its
+// execution pod is created, which would otherwise stay pending for good. On
+// clusters without GPUs the job is simulated on the CPU instead, or fails
+// if it disables fallback. A job that fell back stays on the CPU for its
+// retries. It reports whether the job is held, in which case reconciliation
+// should stop with the returned result.
+func (r *QiskitJobReconciler) holdForGPU(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if !gpuAccelerated(job) {
+		return ctrl.Result{}, false, nil
+	}
+	unavailable, err := r.gpuUnavailable(ctx)
+	if err != nil || !unavailable {
+		return ctrl.Result{}, err != nil, err
+	}
+
+	if job.Spec.Execution.DisableFallback {
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed,
+			fmt.Sprintf("No node of the cluster has %s allocatable for GPU simulation, and fallback to the CPU is disabled", GPUResource))
+		return result, true, err
+	}
+	log.FromContext(ctx).Info("No GPU nodes, simulating on the CPU")
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionGPUFallback,
+		Status:             metav1.ConditionTrue,
+		Reason:             "NoGPUNodes",
+		Message:            fmt.Sprintf("No node of the cluster has %s allocatable; simulating on the CPU", GPUResource),
+		ObservedGeneration: job.Generation,
+	})
+	return ctrl.Result{}, false, nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// ConditionHeldForAdmission is True while the job waits in Scheduling for
+// one of the execution slots of its namespace
+const ConditionHeldForAdmission = "HeldForAdmission"
+
+// Priorities is every spec.execution.priority, in the order jobs are admitted
+var Priorities = []string{"urgent", "high", "normal", "low"}
+
+// priorityRank orders jobs for admission; higher ranks are admitted first
+func priorityRank(job *quantumv1.QiskitJob) int {
+	priority := job.Spec.Execution.Priority
+	if priority == "" {
+		priority = defaults.Priority
+	}
+	for i, p := range Priorities {
+		if p == priority {
+			return len(Priorities) - i
+		}
+	}
+	return 0
+}
+
+// admittedBefore reports whether other, also waiting for an execution slot,
+// is admitted before job: jobs of higher priority first, then in submission
+// order
+func admittedBefore(other, job *quantumv1.QiskitJob) bool {
+	if other.Status.Phase != PhaseScheduling ||
+		!meta.IsStatusConditionTrue(other.Status.Conditions, ConditionHeldForAdmission) {
+		return false
+	}
+	if a, b := priorityRank(other), priorityRank(job); a != b {
+		return a > b
+	}
+	if !other.CreationTimestamp.Equal(&job.CreationTimestamp) {
+		return other.CreationTimestamp.Before(&job.CreationTimestamp)
+	}
+	return other.Name < job.Name
+}
+
+// executorLimit returns how many execution pods of the job's namespace may
+// run at once: the smallest of the operator's limit and the
+// maxConcurrentExecutors of the namespace's QuantumQuotas, or 0 for no limit
+func (r *QiskitJobReconciler) executorLimit(ctx context.Context, job *quantumv1.QiskitJob) (int32, error) {
+	limit := r.MaxExecutorsPerNamespace
+	var quotas quantumv1.QuantumQuotaList
+	if err := r.List(ctx, &quotas, client.InNamespace(job.Namespace)); err != nil {
+		return 0, err
+	}
+	for i := range quotas.Items {
+		if quotaLimit := quotas.Items[i].Spec.MaxConcurrentExecutors; quotaLimit != nil && (limit == 0 || *quotaLimit < limit) {
+			limit = *quotaLimit
+		}
+	}
+	return limit, nil
+}
+
+// liveExecutions counts the unfinished execution Jobs of the namespace's
+// QiskitJobs by job name, including those running in sandboxes
+func (r *QiskitJobReconciler) liveExecutions(ctx context.Context, namespace string) (map[string]int32, error) {
+	var local, sandboxed batchv1.JobList
+	if err := r.List(ctx, &local, client.InNamespace(namespace),
+		client.MatchingLabels{"app": "qiskit-operator"}, client.HasLabels{"quantum.io/job"}); err != nil {
+		return nil, err
+	}
+	if err := r.List(ctx, &sandboxed,
+		client.MatchingLabels{SandboxLabel: namespace}, client.HasLabels{"quantum.io/job"}); err != nil {
+		return nil, err
+	}
+	live := map[string]int32{}
+	for _, executions := range [][]batchv1.Job{local.Items, sandboxed.Items} {
+		for i := range executions {
+			if jobCondition(&executions[i], batchv1.JobComplete) != nil || jobCondition(&executions[i], batchv1.JobFailed) != nil {
+				continue
+			}
+			live[executions[i].Labels["quantum.io/job"]]++
+		}
+	}
+	return live, nil
+}
+
+// holdForAdmission keeps the job in Scheduling while its namespace runs as
+// many execution Jobs as it may, counting jobs admitted but whose executions
+// are not seen yet as taking a slot and the jobs admitted before it as ahead
+// in line. Waiting jobs get their position in the namespace's queue and,
+// once jobs of the namespace have completed, an estimated start from how
+// long they took. It reports whether the job is held, in which case
+// reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) holdForAdmission(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	limit, err := r.executorLimit(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	if limit == 0 {
+		admit(job)
+		return ctrl.Result{}, false, nil
+	}
+
+	r.executorAdmissions.mu.Lock()
+	defer r.executorAdmissions.mu.Unlock()
+	now := time.Now()
+
+	var jobs quantumv1.QiskitJobList
+	if err := r.List(ctx, &jobs, client.InNamespace(job.Namespace)); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	live, err := r.liveExecutions(ctx, job.Namespace)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	var running, ahead int32
+	var took time.Duration
+	var completed int64
+	unseen := map[types.UID]bool{}
+	for i := range jobs.Items {
+		other := &jobs.Items[i]
+		if other.UID == job.UID {
+			continue
+		}
+		running += live[other.Name]
+		if live[other.Name] == 0 && (other.Status.Phase == PhaseScheduling || other.Status.Phase == PhaseRunning) {
+			unseen[other.UID] = true
+		}
+		switch {
+		case admittedBefore(other, job):
+			ahead++
+		case other.Status.Phase == PhaseCompleted && other.Status.StartTime != nil && other.Status.CompletionTime != nil:
+			took += other.Status.CompletionTime.Sub(other.Status.StartTime.Time)
+			completed++
+		}
+	}
+	running += int32(len(r.executorAdmissions.unseen(job.Namespace, unseen, now)))
+	if running+ahead < limit {
+		// Jobs driven over HTTP run no executions to be seen
+		if !remote(job) || job.Spec.Split != nil {
+			r.executorAdmissions.admit([]string{job.Namespace}, job, 0, now)
+		}
+		admit(job)
+		return ctrl.Result{}, false, nil
+	}
+
+	position := int(ahead) + 1
+	job.Status.QueuePosition = &position
+	job.Status.EstimatedStartTime = nil
+	if completed > 0 {
+		// A slot frees up for the job once enough of those running and
+		// ahead of it have finished, limit at a time
+		rounds := (running+ahead-limit)/limit + 1
+		start := metav1.NewTime(time.Now().Add(time.Duration(rounds) * (took / time.Duration(completed))))
+		job.Status.EstimatedStartTime = &start
+	}
+	message := fmt.Sprintf("Waiting for an execution slot: %d of %d executors of the namespace running or starting, %d jobs ahead",
+		running, limit, ahead)
+	log.FromContext(ctx).Info("Holding job for admission", "running", running, "ahead", ahead, "limit", limit,
+		"priority", job.Spec.Execution.Priority)
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionHeldForAdmission,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ExecutorLimit",
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+	job.Status.Message = message
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	requeueBecause(ctx, RequeueRateLimited)
+	return ctrl.Result{RequeueAfter: quotaRecheckInterval}, true, nil
+}
+
+// admit clears the queue position of a job that was waiting for an
+// execution slot. The status is written with the rest of the scheduling
+// decision.
+func admit(job *quantumv1.QiskitJob) {
+	if !meta.IsStatusConditionTrue(job.Status.Conditions, ConditionHeldForAdmission) {
+		return
+	}
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionHeldForAdmission,
+		Status:             metav1.ConditionFalse,
+		Reason:             "Admitted",
+		Message:            "Namespace has a free execution slot",
+		ObservedGeneration: job.Generation,
+	})
+	job.Status.QueuePosition = nil
+	job.Status.EstimatedStartTime = nil
+}
+
+// applyPriorityClass gives the execution pod the priority class the
+// operator maps the job's priority to, unless the job names one in
+// spec.scheduling.priorityClassName
+func (r *QiskitJobReconciler) applyPriorityClass(pod *corev1.Pod, job *quantumv1.QiskitJob) {
+	if pod.Spec.PriorityClassName != "" {
+		return
+	}
+	priority := job.Spec.Execution.Priority
+	if priority == "" {
+		priority = defaults.Priority
+	}
+	pod.Spec.PriorityClassName = r.PriorityClasses[priority]
+}
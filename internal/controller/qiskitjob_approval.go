@@ -0,0 +1,184 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/approval"
+)
+
+// ConditionApproved reports whether a job above the approval tier was
+// approved to run
+const ConditionApproved = "Approved"
+
+// DefaultApprovalPollInterval is how often the approval webhook is asked
+// about a waiting job by default
+const DefaultApprovalPollInterval = time.Minute
+
+// approvalWebhookApprover names the approval webhook as the approver of the
+// jobs it decides without naming someone
+const approvalWebhookApprover = "approval-webhook"
+
+// holdForApproval sends a job above the operator's approval tier to
+// PendingApproval until it is approved, and fails it once denied. Approved
+// jobs go on to run, also for their retries. It reports whether the job is
+// held, in which case reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) holdForApproval(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	qubits := 0
+	if job.Status.CircuitMetadata != nil {
+		qubits = job.Status.CircuitMetadata.Qubits
+	}
+	cost, _ := parseCost(job.Status.EstimatedCost)
+	reason, required := r.ApprovalTier.Exceeds(qubits, cost)
+	if !required {
+		return ctrl.Result{}, false, nil
+	}
+
+	if job.Status.Approval == nil {
+		job.Status.Approval = &quantumv1.ApprovalStatus{}
+	}
+	job.Status.Approval.Reason = reason
+	recordAnnotatedDecision(job)
+	switch job.Status.Approval.Decision {
+	case approval.Approved:
+		return ctrl.Result{}, false, nil
+	case approval.Denied:
+		result, err := r.denyJob(ctx, job)
+		return result, true, err
+	}
+
+	log.FromContext(ctx).Info("Job needs approval", "reason", reason)
+	now := metav1.Now()
+	job.Status.Approval.RequestedAt = &now
+	setJobCondition(job, ConditionApproved, metav1.ConditionFalse, "AwaitingApproval", reason)
+	result, err := r.updateJobPhase(ctx, job, PhasePendingApproval, "Awaiting approval: "+reason)
+	return result, true, err
+}
+
+// handlePendingApprovalJob waits for a decision on a job, from its
+// annotations or the approval webhook. Approved jobs are scheduled again.
+func (r *QiskitJobReconciler) handlePendingApprovalJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	if job.Status.Approval == nil {
+		job.Status.Approval = &quantumv1.ApprovalStatus{}
+	}
+	recordAnnotatedDecision(job)
+	if job.Status.Approval.Decision == "" && r.ApprovalWebhook != nil {
+		r.reviewApproval(ctx, job)
+	}
+
+	switch job.Status.Approval.Decision {
+	case approval.Approved:
+		message := "Approved"
+		if by := job.Status.Approval.DecidedBy; by != "" {
+			message = "Approved by " + by
+		}
+		setJobCondition(job, ConditionApproved, metav1.ConditionTrue, "Approved", message)
+		return r.updateJobPhase(ctx, job, PhaseScheduling, message)
+	case approval.Denied:
+		return r.denyJob(ctx, job)
+	}
+
+	// Annotating the job reconciles it, the webhook has to be asked again
+	if r.ApprovalWebhook != nil {
+		requeueBecause(ctx, RequeueHold)
+		return ctrl.Result{RequeueAfter: r.approvalPollInterval()}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// reviewApproval asks the approval webhook about the job and records its
+// decision. Failed requests leave the job waiting.
+func (r *QiskitJobReconciler) reviewApproval(ctx context.Context, job *quantumv1.QiskitJob) {
+	request := approval.Request{
+		Namespace:     job.Namespace,
+		Name:          job.Name,
+		UID:           string(job.UID),
+		Backend:       job.Status.SelectedBackend,
+		EstimatedCost: job.Status.EstimatedCost,
+		Reason:        job.Status.Approval.Reason,
+	}
+	if request.Backend == "" {
+		request.Backend = device(job)
+	}
+	if job.Status.CircuitMetadata != nil {
+		request.Qubits = job.Status.CircuitMetadata.Qubits
+	}
+	response, err := r.ApprovalWebhook.Review(ctx, request)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to ask the approval webhook")
+		return
+	}
+	if response.Decision == "" {
+		return
+	}
+	approver := response.Approver
+	if approver == "" {
+		approver = approvalWebhookApprover
+	}
+	recordDecision(job, response.Decision, approver, response.Message)
+}
+
+// denyJob fails a denied job for good
+func (r *QiskitJobReconciler) denyJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	message := "Denied"
+	if by := job.Status.Approval.DecidedBy; by != "" {
+		message = "Denied by " + by
+	}
+	if reason := job.Status.Approval.Message; reason != "" {
+		message += ": " + reason
+	}
+	setJobCondition(job, ConditionApproved, metav1.ConditionFalse, "Denied", message)
+	return r.updateJobPhase(ctx, job, PhaseFailed, message)
+}
+
+// recordAnnotatedDecision records the decision annotated on the job, if it
+// is a new one
+func recordAnnotatedDecision(job *quantumv1.QiskitJob) {
+	decision, decidedBy, message := approval.Decision(job.Annotations)
+	if decision != "" && decision != job.Status.Approval.Decision {
+		recordDecision(job, decision, decidedBy, message)
+	}
+}
+
+// recordDecision records a decision on the job in its approval status
+func recordDecision(job *quantumv1.QiskitJob, decision, decidedBy, message string) {
+	now := metav1.Now()
+	job.Status.Approval.Decision = decision
+	job.Status.Approval.DecidedBy = decidedBy
+	job.Status.Approval.DecidedAt = &now
+	job.Status.Approval.Message = message
+}
+
+// approvalDenied reports whether the job failed because it was denied,
+// which a retry would not change
+func approvalDenied(job *quantumv1.QiskitJob) bool {
+	return job.Status.Approval != nil && job.Status.Approval.Decision == approval.Denied
+}
+
+func (r *QiskitJobReconciler) approvalPollInterval() time.Duration {
+	if r.ApprovalPollInterval > 0 {
+		return r.ApprovalPollInterval
+	}
+	return DefaultApprovalPollInterval
+}
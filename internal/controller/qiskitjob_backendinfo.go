@@ -0,0 +1,65 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// recordBackendInfo records the selected backend in the job's status, with
+// the latest calibration of remote devices whose provider publishes it, so
+// results can be traced back to the device's state when they were taken.
+// The calibration is read once per attempt; a provider that cannot be read
+// leaves it out rather than holding the job.
+func (r *QiskitJobReconciler) recordBackendInfo(ctx context.Context, job *quantumv1.QiskitJob) {
+	if job.Status.BackendInfo != nil && job.Status.BackendInfo.Name == job.Status.SelectedBackend {
+		return
+	}
+	job.Status.BackendInfo = &quantumv1.BackendInfo{Name: job.Status.SelectedBackend}
+	if !remote(job) {
+		return
+	}
+
+	adapter, err := r.remoteBackend(ctx, job)
+	if err != nil {
+		log.FromContext(ctx).Info("Cannot read the backend calibration", "backend", job.Status.SelectedBackend, "reason", err.Error())
+		return
+	}
+	reader, ok := adapter.(backend.CalibrationReader)
+	if !ok {
+		return
+	}
+	calibration, err := reader.GetCalibration(ctx)
+	if err != nil {
+		log.FromContext(ctx).Info("Cannot read the backend calibration", "backend", job.Status.SelectedBackend, "reason", err.Error())
+		return
+	}
+	info := job.Status.BackendInfo
+	info.Version = calibration.Version
+	info.Qubits = calibration.Qubits
+	info.GateError = calibration.MedianTwoQubitError
+	info.ReadoutError = calibration.MedianReadoutError
+	if !calibration.LastUpdated.IsZero() {
+		info.CalibrationTimestamp = &metav1.Time{Time: calibration.LastUpdated}
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/validation"
+)
+
+// backendRefRetryInterval is how often a job whose selector matches no
+// available QuantumBackend looks again
+const backendRefRetryInterval = time.Minute
+
+// resolveBackendRef copies the QuantumBackend the pending job references
+// into its spec. It reports whether the job was changed, failed or is left
+// waiting for a backend, in which case reconciliation should stop with the
+// returned result.
+func (r *QiskitJobReconciler) resolveBackendRef(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if job.Status.Phase != PhasePending {
+		return ctrl.Result{}, false, nil
+	}
+
+	if job.Spec.BackendRef != nil && !backendref.Applied(job) {
+		if errs := validation.ValidateBackendRef(job.Spec.BackendRef, field.NewPath("spec", "backendRef")); len(errs) > 0 {
+			result, err := r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+			return result, true, err
+		}
+	}
+
+	applied, err := backendref.Resolve(ctx, r.Client, job)
+	var limitErr *backendref.LimitError
+	var notAllowedErr *backendref.NotAllowedError
+	switch {
+	case apierrors.IsNotFound(err):
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed,
+			fmt.Sprintf("QuantumBackend %q not found", job.Spec.BackendRef.Name))
+		return result, true, err
+	case errors.As(err, &limitErr):
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed, limitErr.Error())
+		return result, true, err
+	case errors.As(err, &notAllowedErr):
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed, notAllowedErr.Error())
+		return result, true, err
+	case errors.Is(err, backendref.ErrNoneAvailable):
+		if job.Status.Message != err.Error() {
+			if _, err := r.updateJobPhase(ctx, job, PhasePending, err.Error()); err != nil {
+				return ctrl.Result{}, true, err
+			}
+		}
+		requeueBecause(ctx, RequeueBackendQueue)
+		return ctrl.Result{RequeueAfter: backendRefRetryInterval}, true, nil
+	case err != nil:
+		return ctrl.Result{}, true, err
+	case !applied:
+		return ctrl.Result{}, false, nil
+	}
+
+	log.FromContext(ctx).Info("Resolved QuantumBackend", "backend", job.Annotations[backendref.AppliedAnnotation],
+		"type", job.Spec.Backend.Type, "device", job.Spec.Backend.Name)
+	if err := r.Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{Requeue: true}, true, nil
+}
+
+// crossNamespaceCredentials returns why the job may not use its credentials
+// if they name a Secret of another namespace without a QuantumBackend
+// sharing it with the job's namespace
+func (r *QiskitJobReconciler) crossNamespaceCredentials(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	err := backendref.CheckSecrets(ctx, r.Client, job)
+	var crossNamespace *backendref.CrossNamespaceError
+	if errors.As(err, &crossNamespace) {
+		return err.Error(), nil
+	}
+	return "", err
+}
+
+// secretKey returns the Secret a job's credentials reference, in the job's
+// namespace unless the reference names one. Secrets of other namespaces are
+// only returned for jobs whose credentials a QuantumBackend supplied; where
+// a Secret is read, backendref.SecretNamespace checks that the
+// QuantumBackend still shares it.
+func secretKey(job *quantumv1.QiskitJob, ref quantumv1.SecretRef) (types.NamespacedName, bool) {
+	if ref.Namespace == "" || ref.Namespace == job.Namespace {
+		return types.NamespacedName{Name: ref.Name, Namespace: job.Namespace}, true
+	}
+	if !backendref.Applied(job) {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, true
+}
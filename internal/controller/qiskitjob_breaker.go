@@ -0,0 +1,164 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/breaker"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+const (
+	// DefaultBreakerThreshold is the number of consecutive failed calls to a
+	// backend that opens its circuit breaker
+	DefaultBreakerThreshold = 5
+	// DefaultBreakerCooldown is how long an open circuit breaker keeps
+	// calls away from its backend before probing it
+	DefaultBreakerCooldown = 2 * time.Minute
+)
+
+// breakerGauge is the value of the circuit breaker state metric for a state
+var breakerGauge = map[breaker.State]float64{
+	breaker.Closed:   0,
+	breaker.HalfOpen: 1,
+	breaker.Open:     2,
+}
+
+// holdForBreaker keeps jobs from calling a backend whose circuit breaker is
+// open. IBM hardware jobs that have not been submitted yet and allow it
+// simulate their device instead; other jobs wait for the breaker to let a
+// probe through. It reports whether the job is held, in which case
+// reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) holdForBreaker(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	status, ok := r.allowBackendCall(job)
+	if ok {
+		return ctrl.Result{}, false, nil
+	}
+	key := queueBackendKey(job)
+
+	if job.Status.JobID == "" && job.Spec.Backend.Type == string(backend.IBMQuantum) && !job.Spec.Execution.DisableFallback {
+		message := fmt.Sprintf("Circuit breaker of %s is open after %d consecutive failures, simulating it instead",
+			key, status.ConsecutiveFailures)
+		r.fallBack(ctx, job, fallbackReasonBreaker, message)
+		result, err := r.updateJobPhase(ctx, job, PhaseScheduling, message)
+		return result, true, err
+	}
+
+	wait := time.Until(status.RetryAt)
+	if wait <= 0 {
+		// Another job is probing the backend
+		wait = r.tunables().HTTPPollInterval
+	}
+	log.FromContext(ctx).Info("Holding calls to backend with an open circuit breaker", "backend", key, "retryAfter", wait)
+	job.Status.Message = fmt.Sprintf("Circuit breaker of %s is open after %d consecutive failures; waiting %s to call it",
+		key, status.ConsecutiveFailures, wait.Round(time.Second))
+	requeueBecause(ctx, RequeueRateLimited)
+	return ctrl.Result{RequeueAfter: wait}, true, r.Status().Update(ctx, job)
+}
+
+// allowBackendCall reports whether the circuit breaker of the job's backend
+// lets a call through, returning the breaker's status to tell when to try
+// again if not
+func (r *QiskitJobReconciler) allowBackendCall(job *quantumv1.QiskitJob) (breaker.Status, bool) {
+	if r.Breakers == nil {
+		return breaker.Status{}, true
+	}
+	key := queueBackendKey(job)
+	status, ok := r.Breakers.Allow(key)
+	metrics.BackendBreakerState.WithLabelValues(key).Set(breakerGauge[status.State])
+	return status, ok
+}
+
+// recordBackendCall feeds the outcome of a call to the job's backend to its
+// circuit breaker. Rejections every attempt would run into, and throttling
+// by a provider that is up, say nothing about the backend's health.
+func (r *QiskitJobReconciler) recordBackendCall(ctx context.Context, job *quantumv1.QiskitJob, err error) {
+	if r.Breakers == nil || backend.Permanent(err) || backend.Transient(err) {
+		return
+	}
+	key := queueBackendKey(job)
+	var status breaker.Status
+	var changed bool
+	if err == nil {
+		status, changed = r.Breakers.Success(key)
+	} else {
+		status, changed = r.Breakers.Failure(key)
+	}
+	if !changed {
+		return
+	}
+
+	metrics.BackendBreakerState.WithLabelValues(key).Set(breakerGauge[status.State])
+	if status.State == breaker.Open {
+		metrics.BackendBreakerTrips.WithLabelValues(key).Inc()
+		r.event(job, corev1.EventTypeWarning, "CircuitBreakerOpened", fmt.Sprintf(
+			"Circuit breaker of %s opened after %d consecutive failures: %v", key, status.ConsecutiveFailures, err))
+	} else {
+		r.event(job, corev1.EventTypeNormal, "CircuitBreakerClosed", fmt.Sprintf("%s recovered, closing its circuit breaker", key))
+	}
+	if err := r.publishBreaker(ctx, job, status); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to publish circuit breaker state", "backend", key)
+	}
+}
+
+// publishBreaker records the state of a breaker in the status of the
+// QuantumBackendPools the job's backend belongs to
+func (r *QiskitJobReconciler) publishBreaker(ctx context.Context, job *quantumv1.QiskitJob, status breaker.Status) error {
+	var pools quantumv1.QuantumBackendPoolList
+	if err := r.List(ctx, &pools); err != nil {
+		return err
+	}
+	published := quantumv1.BackendBreakerStatus{
+		Backend:             status.Backend,
+		State:               string(status.State),
+		ConsecutiveFailures: int32(status.ConsecutiveFailures),
+		LastTransitionTime:  &metav1.Time{Time: time.Now()},
+	}
+	if status.State == breaker.Open {
+		published.RetryAt = &metav1.Time{Time: status.RetryAt}
+	}
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+		if !inPool(pool, job) {
+			continue
+		}
+		found := false
+		for j := range pool.Status.Breakers {
+			if pool.Status.Breakers[j].Backend == status.Backend {
+				pool.Status.Breakers[j] = published
+				found = true
+			}
+		}
+		if !found {
+			pool.Status.Breakers = append(pool.Status.Breakers, published)
+		}
+		if err := r.Status().Update(ctx, pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
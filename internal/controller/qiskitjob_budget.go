@@ -0,0 +1,400 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumquotas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumworkspaces,verbs=get;list;watch
+
+// ConditionQuotaExceeded is True while the job is held back, or after it
+// failed, for exceeding its own maxCost or a QuantumQuota of its namespace
+const ConditionQuotaExceeded = "QuotaExceeded"
+
+// Reasons of the QuotaExceeded condition. Jobs over their maxCost, their
+// workspace's budget, a quota's maxShots or a limit of their trial account
+// fail for good; the others wait in Scheduling.
+const (
+	quotaReasonMaxCost        = "MaxCostExceeded"
+	quotaReasonWorkspace      = "WorkspaceBudgetExceeded"
+	quotaReasonMaxShots       = "MaxShotsExceeded"
+	quotaReasonSpend          = "MonthlySpendExceeded"
+	quotaReasonConcurrency    = "HardwareConcurrencyExceeded"
+	quotaReasonTrialSimulator = "TrialSimulatorOnly"
+	quotaReasonTrialShots     = "TrialMaxShotsExceeded"
+	quotaReasonTrialDailyJobs = "TrialDailyJobsExceeded"
+	quotaReasonTrialInvalid   = "InvalidTrialLimits"
+)
+
+// quotaRejected reports whether the job failed for a limit every attempt
+// would exceed
+func quotaRejected(job *quantumv1.QiskitJob) bool {
+	condition := meta.FindStatusCondition(job.Status.Conditions, ConditionQuotaExceeded)
+	return condition != nil && condition.Status == metav1.ConditionTrue &&
+		(condition.Reason == quotaReasonMaxCost || condition.Reason == quotaReasonWorkspace ||
+			condition.Reason == quotaReasonMaxShots || trialRefused(job))
+}
+
+// quotaHeld reports whether the job waits in Scheduling for a QuantumQuota
+func quotaHeld(job *quantumv1.QiskitJob) bool {
+	condition := meta.FindStatusCondition(job.Status.Conditions, ConditionQuotaExceeded)
+	return job.Status.Phase == PhaseScheduling && condition != nil && condition.Status == metav1.ConditionTrue &&
+		(condition.Reason == quotaReasonSpend || condition.Reason == quotaReasonConcurrency)
+}
+
+// namespaceUsage is what the QuantumQuotas of a namespace limit, besides
+// the spend they accumulate themselves
+type namespaceUsage struct {
+	// period is the billing period, YYYY-MM
+	period string
+	// uncharged is the actual cost of jobs finished in the period that is
+	// not added to the quotas' spend yet
+	uncharged float64
+	// committed is the estimated cost of jobs running on hardware or
+	// admitted to run there
+	committed float64
+	// hardwareJobs is the number of jobs running on hardware or admitted to
+	// run there
+	hardwareJobs int32
+	// blocked is the number of jobs held for the quotas
+	blocked int32
+}
+
+// namespaceUsage tallies the usage of the job's namespace by its other jobs.
+// Jobs admitted past the quotas count as running on hardware until they are
+// seen running.
+func (r *QiskitJobReconciler) namespaceUsage(ctx context.Context, job *quantumv1.QiskitJob, now time.Time) (*namespaceUsage, error) {
+	usage := &namespaceUsage{period: now.Format("2006-01")}
+	var jobs []quantumv1.QiskitJob
+	err := eachJob(ctx, r.Client, r.Jobs, func(other *quantumv1.QiskitJob) error {
+		jobs = append(jobs, *other)
+		return nil
+	}, client.InNamespace(job.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	admitted := r.quotaAdmissions.pending(job.Namespace, jobs, now)
+	for i := range jobs {
+		other := &jobs[i]
+		if other.UID == job.UID {
+			continue
+		}
+		if completion := other.Status.CompletionTime; completion != nil && completion.Format("2006-01") == usage.period {
+			usage.uncharged += uncharged(other)
+		}
+		admission, ok := admitted[other.UID]
+		switch {
+		case other.Status.Phase == PhaseRunning && onHardware(other):
+			usage.hardwareJobs++
+			if cost, err := parseCost(other.Status.EstimatedCost); err == nil {
+				usage.committed += cost
+			}
+		case ok:
+			usage.hardwareJobs++
+			usage.committed += admission.estimate
+		case quotaHeld(other):
+			usage.blocked++
+		}
+	}
+	return usage, nil
+}
+
+// uncharged is the part of the actual cost of a job not yet added to the
+// spend of its namespace's QuantumQuotas. Costs that fail to parse are
+// ignored, as in the namespace summary.
+func uncharged(job *quantumv1.QiskitJob) float64 {
+	cost, err := parseCost(job.Status.ActualCost)
+	if err != nil {
+		return 0
+	}
+	charged, _ := parseCost(job.Status.QuotaCharge)
+	return cost - charged
+}
+
+// quotaSpend is the spend the quota accumulated in the billing period
+func quotaSpend(quota *quantumv1.QuantumQuota, period string) float64 {
+	if quota.Status.BillingPeriod != period {
+		return 0
+	}
+	spend, _ := parseCost(quota.Status.MonthToDateSpend)
+	return spend
+}
+
+// holdForQuantumQuotas checks the job, once its backend is picked and its
+// cost estimated, against the limits of its trial account, its own maxCost,
+// the budget of the QuantumWorkspace it was submitted from and the
+// QuantumQuotas of its namespace. Jobs over a trial limit, their maxCost,
+// their workspace's budget or a quota's maxShots fail for good;
+// hardware jobs that would exceed a quota's monthly spend or hardware
+// concurrency wait in Scheduling. The usage checked against is recorded in
+// the quotas' status. It reports whether the job is held or failed, in which
+// case reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) holdForQuantumQuotas(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	logger := log.FromContext(ctx)
+
+	if reason, message, err := r.overTrialLimits(ctx, job, time.Now()); err != nil {
+		return ctrl.Result{}, true, err
+	} else if reason != "" {
+		return r.rejectForQuota(ctx, job, reason, message)
+	}
+
+	estimate, _ := parseCost(job.Status.EstimatedCost)
+	if budget := job.Spec.Budget; budget != nil && budget.MaxCost != "" {
+		maxCost, err := parseCost(budget.MaxCost)
+		if err != nil {
+			result, err := r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Invalid spec.budget.maxCost: %v", err))
+			return result, true, err
+		}
+		if estimate > maxCost {
+			return r.rejectForQuota(ctx, job, quotaReasonMaxCost, fmt.Sprintf("Estimated cost %s on %s exceeds the job's maxCost of %s",
+				job.Status.EstimatedCost, job.Status.SelectedBackend, budget.MaxCost))
+		}
+	}
+
+	if message, err := r.overWorkspaceBudget(ctx, job, estimate); err != nil {
+		return ctrl.Result{}, true, err
+	} else if message != "" {
+		return r.rejectForQuota(ctx, job, quotaReasonWorkspace, message)
+	}
+
+	var quotas quantumv1.QuantumQuotaList
+	if err := r.List(ctx, &quotas, client.InNamespace(job.Namespace)); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	if len(quotas.Items) == 0 {
+		releaseFromQuota(job)
+		return ctrl.Result{}, false, nil
+	}
+	r.quotaAdmissions.mu.Lock()
+	defer r.quotaAdmissions.mu.Unlock()
+	now := time.Now()
+	usage, err := r.namespaceUsage(ctx, job, now)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	shots := defaults.Shots
+	if job.Spec.Execution.Shots > 0 {
+		shots = job.Spec.Execution.Shots
+	}
+	hardware := onHardware(job)
+	var reason, message string
+	requeue := quotaRecheckInterval
+	for i := range quotas.Items {
+		quota := &quotas.Items[i]
+		spec := &quota.Spec
+		maxSpend, err := parseCost(spec.MaxMonthlySpend)
+		spend := quotaSpend(quota, usage.period) + usage.uncharged
+		switch {
+		case spec.MaxShots != nil && shots > int(*spec.MaxShots):
+			reason = quotaReasonMaxShots
+			message = fmt.Sprintf("%d shots exceed the maximum of %d of QuantumQuota %s", shots, *spec.MaxShots, quota.Name)
+		case hardware && spec.MaxConcurrentHardwareJobs != nil && usage.hardwareJobs >= *spec.MaxConcurrentHardwareJobs:
+			reason = quotaReasonConcurrency
+			message = fmt.Sprintf("Waiting for QuantumQuota %s: %d of %d hardware jobs running",
+				quota.Name, usage.hardwareJobs, *spec.MaxConcurrentHardwareJobs)
+		case hardware && estimate > 0 && spec.MaxMonthlySpend != "" && err == nil && spend+usage.committed+estimate > maxSpend:
+			reason = quotaReasonSpend
+			message = fmt.Sprintf("Waiting for QuantumQuota %s: estimated cost %s would take the month's spend of %s, %s of it running, past %s",
+				quota.Name, job.Status.EstimatedCost, formatCost(spend+usage.committed), formatCost(usage.committed), spec.MaxMonthlySpend)
+			// Spend frees up as running jobs finish below their estimate, or next month
+			year, month, _ := now.Date()
+			nextMonth := time.Date(year, month+1, 1, 0, 0, 0, 0, now.Location())
+			requeue = min(budgetRecheckInterval, nextMonth.Sub(now))
+		default:
+			continue
+		}
+		break
+	}
+
+	held := reason != "" && reason != quotaReasonMaxShots
+	if held {
+		usage.blocked++
+	}
+	r.publishQuotaUsage(ctx, quotas.Items, usage, now)
+
+	switch {
+	case reason == quotaReasonMaxShots:
+		return r.rejectForQuota(ctx, job, reason, message)
+	case held:
+		logger.Info("Holding submission for namespace quota", "reason", reason)
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionQuotaExceeded,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: job.Generation,
+		})
+		job.Status.Message = message
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		requeueBecause(ctx, RequeueRateLimited)
+		return ctrl.Result{RequeueAfter: requeue}, true, nil
+	}
+	if hardware {
+		r.quotaAdmissions.admit([]string{job.Namespace}, job, estimate, now)
+	}
+	releaseFromQuota(job)
+	return ctrl.Result{}, false, nil
+}
+
+// overWorkspaceBudget explains how the job's estimate would take the spend
+// of the QuantumWorkspace it was submitted from past the workspace's
+// maxCost, if it does. Jobs of workspaces that are gone are not limited.
+func (r *QiskitJobReconciler) overWorkspaceBudget(ctx context.Context, job *quantumv1.QiskitJob, estimate float64) (string, error) {
+	name := job.Labels[WorkspaceLabel]
+	if name == "" || estimate <= 0 {
+		return "", nil
+	}
+	var workspace quantumv1.QuantumWorkspace
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: job.Namespace}, &workspace); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	budget := workspace.Spec.Budget
+	if budget == nil || budget.MaxCost == "" {
+		return "", nil
+	}
+	// The workspace's controller reports a maxCost that fails to parse
+	maxCost, err := parseCost(budget.MaxCost)
+	if err != nil {
+		return "", nil
+	}
+	_, spend, err := workspaceUsage(ctx, r.Client, r.Jobs, &workspace, job.UID)
+	if err != nil || spend+estimate <= maxCost {
+		return "", err
+	}
+	return fmt.Sprintf("Estimated cost %s would take the spend of QuantumWorkspace %s, %s, past its maxCost of %s",
+		job.Status.EstimatedCost, name, formatCost(spend), budget.MaxCost), nil
+}
+
+// rejectForQuota fails the job for good for a limit every attempt would exceed
+func (r *QiskitJobReconciler) rejectForQuota(ctx context.Context, job *quantumv1.QiskitJob, reason, message string) (ctrl.Result, bool, error) {
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionQuotaExceeded,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+	result, err := r.updateJobPhase(ctx, job, PhaseFailed, message)
+	return result, true, err
+}
+
+// releaseFromQuota clears the QuotaExceeded condition of a job that was
+// held. The status is written with the rest of the scheduling decision.
+func releaseFromQuota(job *quantumv1.QiskitJob) {
+	if meta.IsStatusConditionTrue(job.Status.Conditions, ConditionQuotaExceeded) {
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionQuotaExceeded,
+			Status:             metav1.ConditionFalse,
+			Reason:             "WithinQuota",
+			Message:            "Job is within its namespace's quotas",
+			ObservedGeneration: job.Generation,
+		})
+	}
+}
+
+// publishQuotaUsage records the usage the quotas were checked against in
+// their status, starting the spend of a quota over in a new billing period.
+// Quotas whose usage did not change are left alone.
+func (r *QiskitJobReconciler) publishQuotaUsage(ctx context.Context, quotas []quantumv1.QuantumQuota, usage *namespaceUsage, now time.Time) {
+	for i := range quotas {
+		quota := &quotas[i]
+		status := quantumv1.QuantumQuotaStatus{
+			BillingPeriod:       usage.period,
+			MonthToDateSpend:    formatCost(quotaSpend(quota, usage.period)),
+			CommittedSpend:      formatCost(usage.committed),
+			RunningHardwareJobs: usage.hardwareJobs,
+			BlockedJobs:         usage.blocked,
+			LastUpdated:         quota.Status.LastUpdated,
+		}
+		if status == quota.Status {
+			continue
+		}
+		status.LastUpdated = &metav1.Time{Time: now}
+		quota.Status = status
+		if err := r.Status().Update(ctx, quota); err != nil {
+			// Usage is recorded again the next time a job is scheduled
+			log.FromContext(ctx).Error(err, "Failed to record quota usage", "quota", quota.Name)
+		}
+	}
+}
+
+// chargeQuotas adds the actual cost of a finished job to the month-to-date
+// spend of its namespace's QuantumQuotas, so that the spend outlives the
+// job. Costs added to the job later, such as its share of a dedicated
+// session, are charged on a later pass. The charge is recorded on the job
+// after the quotas; a job whose record fails is charged again, which errs
+// on the side of the limit.
+func (r *QiskitJobReconciler) chargeQuotas(ctx context.Context, job *quantumv1.QiskitJob) error {
+	charge := uncharged(job)
+	completion := job.Status.CompletionTime
+	if charge == 0 || completion == nil {
+		return nil
+	}
+	period := completion.Format("2006-01")
+
+	var quotas quantumv1.QuantumQuotaList
+	if err := r.List(ctx, &quotas, client.InNamespace(job.Namespace)); err != nil {
+		return err
+	}
+	for i := range quotas.Items {
+		key := client.ObjectKeyFromObject(&quotas.Items[i])
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			var quota quantumv1.QuantumQuota
+			if err := r.Get(ctx, key, &quota); err != nil {
+				return err
+			}
+			switch {
+			case quota.Status.BillingPeriod > period:
+				// The quota moved on to a later period already
+				return nil
+			case quota.Status.BillingPeriod < period:
+				quota.Status.BillingPeriod = period
+				quota.Status.MonthToDateSpend = formatCost(charge)
+			default:
+				quota.Status.MonthToDateSpend = formatCost(quotaSpend(&quota, period) + charge)
+			}
+			quota.Status.LastUpdated = &metav1.Time{Time: time.Now()}
+			return r.Status().Update(ctx, &quota)
+		})
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	charged, _ := parseCost(job.Status.QuotaCharge)
+	job.Status.QuotaCharge = formatCost(charged + charge)
+	return r.Status().Update(ctx, job)
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+	"github.com/quantum-operator/qiskit-operator/pkg/packages"
+)
+
+// Locations of a bundle in the execution pod
+const (
+	bundleSourceDir        = "/bundle-source"
+	workspaceDir           = "/workspace"
+	bundleDir              = workspaceDir + "/bundle"
+	bundleRequirementsFile = workspaceDir + "/requirements.txt"
+)
+
+// bundleFetch reads or downloads the job's archive, unpacks it into the
+// workspace and writes the bundle's requirements to bundleRequirementsFile
+// once each is found on the allowed packages. It is mounted into the
+// execution pod next to the program.
+const bundleFetch = `
+import fnmatch, hashlib, io, os, re, sys, urllib.request, zipfile
+source = os.environ.get('BUNDLE_PATH')
+if source:
+    data = open(source, 'rb').read()
+else:
+    data = urllib.request.urlopen(os.environ['BUNDLE_URL'], timeout=300).read()
+digest = os.environ.get('BUNDLE_SHA256', '').lower()
+if digest and hashlib.sha256(data).hexdigest() != digest:
+    sys.exit('bundle does not match sha256 ' + digest)
+zipfile.ZipFile(io.BytesIO(data)).extractall('/workspace/bundle')
+allowed = [p for p in os.environ.get('BUNDLE_ALLOWED_PACKAGES', '').split(',') if p]
+requirements = []
+path = os.path.join('/workspace/bundle', os.environ['BUNDLE_REQUIREMENTS'])
+if os.path.exists(path):
+    for line in open(path):
+        line = line.split('#')[0].strip()
+        if not line:
+            continue
+        match = re.fullmatch('([A-Za-z0-9][A-Za-z0-9._-]*)([][A-Za-z0-9._,<>=!~*+ -]*)', line)
+        if not match:
+            sys.exit('unsupported requirement in bundle: ' + line)
+        name = re.sub('[-_.]+', '-', match.group(1).lower())
+        if not any(fnmatch.fnmatchcase(name, pattern) for pattern in allowed):
+            sys.exit('bundle requirement not on the package allow-list: ' + name)
+        requirements.append(line)
+open('/workspace/requirements.txt', 'w').write(''.join(r + chr(10) for r in requirements))
+`
+
+// entrypointRunner runs the job's entrypoint in place of inline circuit
+// code from the root of its bundle or git checkout, with its arguments in
+// sys.argv. A module or file runs as __main__
+// unless a function is named, in which case it is imported and the function
+// called. Modules can call report_progress too, and the circuit qc the
+// entrypoint defines or its function returns is kept for backend epilogues,
+// as is the observable an optimizer loop minimizes.
+const entrypointRunner = `
+import builtins as _builtins
+import json as _json
+import os as _os
+import runpy as _runpy
+import sys as _sys
+_builtins.report_progress = report_progress
+_os.chdir(_os.environ['PROJECT_DIR'])
+_sys.path.insert(0, _os.environ['PROJECT_DIR'])
+_target, _, _function = _os.environ['ENTRYPOINT'].partition(':')
+_sys.argv = [_target] + _json.loads(_os.environ.get('ENTRYPOINT_ARGS', '[]'))
+_run_name = '__entrypoint__' if _function else '__main__'
+if _target.endswith('.py'):
+    _globals = _runpy.run_path(_target, run_name=_run_name)
+else:
+    _globals = _runpy.run_module(_target, run_name=_run_name, alter_sys=True)
+qc = _globals.get('qc')
+observable = _globals.get('observable')
+if _function:
+    _result = _globals[_function]()
+    if _result is not None:
+        qc = _result
+`
+
+// isBundle reports whether the job runs a bundle rather than circuit code
+func isBundle(job *quantumv1.QiskitJob) bool {
+	return job.Spec.Circuit.Source == "bundle" && job.Spec.Circuit.Bundle != nil
+}
+
+// bundleConfigMapMissing reports whether the ConfigMap key holding the job's
+// bundle does not exist, which would leave the pod unable to start
+func (r *QiskitJobReconciler) bundleConfigMapMissing(ctx context.Context, job *quantumv1.QiskitJob) (bool, error) {
+	if !isBundle(job) || job.Spec.Circuit.Bundle.ConfigMapRef == nil {
+		return false, nil
+	}
+	ref := job.Spec.Circuit.Bundle.ConfigMapRef
+
+	var cm corev1.ConfigMap
+	err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: job.Namespace}, &cm)
+	if errors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	_, binary := cm.BinaryData[ref.Key]
+	_, text := cm.Data[ref.Key]
+	return !binary && !text, nil
+}
+
+// mountWorkspace gives the executor a writable workspace for the job's
+// project
+func mountWorkspace(pod *corev1.Pod) {
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         "workspace",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts,
+		corev1.VolumeMount{Name: "workspace", MountPath: workspaceDir})
+}
+
+// mountBundle gives the execution pod a writable workspace and the job's
+// archive, and tells the executor where to find it
+func (r *QiskitJobReconciler) mountBundle(pod *corev1.Pod, job *quantumv1.QiskitJob, rt *compat.Runtime) {
+	bundle := job.Spec.Circuit.Bundle
+	container := &pod.Spec.Containers[0]
+
+	mountWorkspace(pod)
+
+	var source *corev1.VolumeSource
+	archive := "bundle.zip"
+	switch {
+	case bundle.ConfigMapRef != nil:
+		source = &corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: bundle.ConfigMapRef.Name},
+			Items:                []corev1.KeyToPath{{Key: bundle.ConfigMapRef.Key, Path: archive}},
+		}}
+	case bundle.Image != "":
+		source = &corev1.VolumeSource{Image: &corev1.ImageVolumeSource{
+			Reference:  bundle.Image,
+			PullPolicy: corev1.PullIfNotPresent,
+		}}
+		if bundle.Path != "" {
+			archive = bundle.Path
+		}
+	default:
+		container.Env = append(container.Env, corev1.EnvVar{Name: "BUNDLE_URL", Value: bundle.URL})
+	}
+	if source != nil {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{Name: "bundle-source", VolumeSource: *source})
+		container.VolumeMounts = append(container.VolumeMounts,
+			corev1.VolumeMount{Name: "bundle-source", MountPath: bundleSourceDir, ReadOnly: true})
+		container.Env = append(container.Env,
+			corev1.EnvVar{Name: "BUNDLE_PATH", Value: path.Join(bundleSourceDir, archive)})
+	}
+
+	requirements := bundle.Requirements
+	if requirements == "" {
+		requirements = "requirements.txt"
+	}
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: "BUNDLE_REQUIREMENTS", Value: requirements},
+		corev1.EnvVar{Name: "BUNDLE_ALLOWED_PACKAGES", Value: strings.Join(bundlePackages(r.AllowedPackages, rt, job), ",")},
+	)
+	if bundle.SHA256 != "" {
+		container.Env = append(container.Env, corev1.EnvVar{Name: "BUNDLE_SHA256", Value: bundle.SHA256})
+	}
+}
+
+// entrypointEnv tells the entrypoint runner where the project is, what to
+// run and with which arguments. A git checkout runs the file at its path
+// unless an entrypoint is named.
+func entrypointEnv(circuit *quantumv1.CircuitSpec) ([]corev1.EnvVar, error) {
+	dir, entrypoint := bundleDir, circuit.Entrypoint
+	if circuit.Source == "git" {
+		dir = gitRepoDir
+		if entrypoint == "" {
+			entrypoint = circuit.GitRef.Path
+		}
+	}
+	args := circuit.Args
+	if args == nil {
+		args = []string{}
+	}
+	data, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	return []corev1.EnvVar{
+		{Name: "PROJECT_DIR", Value: dir},
+		{Name: "ENTRYPOINT", Value: entrypoint},
+		{Name: "ENTRYPOINT_ARGS", Value: string(data)},
+	}, nil
+}
+
+// bundlePackages returns the packages a bundle's requirements may name: the
+// allow-list and the packages the operator pins for the runtime, so bundles
+// can pin them too and conflicts fail the install
+func bundlePackages(allowed packages.Allowlist, rt *compat.Runtime, job *quantumv1.QiskitJob) []string {
+	names := append([]string(nil), allowed...)
+	for _, requirement := range rt.RequirementsFor(backendType(job)) {
+		if name, err := packages.Name(requirement); err == nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// bundleInstall returns the shell commands that fetch the job's bundle
+// before packages are installed, and the pip arguments installing its
+// requirements
+func bundleInstall(job *quantumv1.QiskitJob) (string, string) {
+	if !isBundle(job) {
+		return "", ""
+	}
+	return fmt.Sprintf("python3 %s && \\\n", bundleFetchFile), " -r " + bundleRequirementsFile
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// ConditionCacheHit reports whether a job with cachePolicy "use" was
+// completed from the results of an identical earlier execution
+const ConditionCacheHit = "CacheHit"
+
+// DefaultResultsCacheTTL is how long cached results are reused by default
+const DefaultResultsCacheTTL = 7 * 24 * time.Hour
+
+// checkCache completes a job with cachePolicy "use" from cached results of
+// an identical execution. It returns a non-nil result when the job was
+// completed; on a miss the job goes on to execute.
+func (r *QiskitJobReconciler) checkCache(ctx context.Context, job *quantumv1.QiskitJob) (*ctrl.Result, error) {
+	if job.Spec.Execution.CachePolicy != results.CachePolicyUse {
+		return nil, nil
+	}
+	if _, ok := results.CacheKey(job); !ok {
+		return nil, nil
+	}
+
+	entry, err := results.LookupCache(ctx, r.Client, job, r.ResultsCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionCacheHit,
+			Status:             metav1.ConditionFalse,
+			Reason:             "CacheMiss",
+			Message:            "No cached results of an identical execution",
+			ObservedGeneration: job.Generation,
+		})
+		return nil, nil
+	}
+	log.FromContext(ctx).Info("Reusing cached results", "job", entry.Job)
+
+	exportAllowed, err := r.outputExportAllowed(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.Now()
+	job.Status.CompletionTime = &now
+	if entry.Backend != "" {
+		job.Status.SelectedBackend = entry.Backend
+	}
+	job.Status.EstimatedCost = "$0.00"
+	job.Status.ActualCost = "$0.00"
+	executionTime, _ := time.ParseDuration(entry.ExecutionTime)
+	job.Status.Results = results.NewInfo(job, entry.Counts, executionTime)
+	job.Status.Outputs = nil
+
+	message := fmt.Sprintf("Completed from cache: results reused from job %s", entry.Job)
+	if !exportAllowed {
+		job.Status.Results.Location = ""
+		message += "; result export blocked by data residency policy"
+	} else if len(job.Spec.Outputs) > 0 {
+		if err := r.exportResults(ctx, job, results.NewDocument(job, entry.Counts), nil); err != nil {
+			return nil, err
+		}
+		if degraded, ok := degradedOutputsMessage(job); ok {
+			message = degraded
+		}
+	}
+
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionCacheHit,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ResultsReused",
+		Message:            fmt.Sprintf("Results of job %s cached at %s", entry.Job, entry.CachedAt.UTC().Format(time.RFC3339)),
+		ObservedGeneration: job.Generation,
+	})
+	result, err := r.updateJobPhase(ctx, job, PhaseCompleted, message)
+	return &result, err
+}
+
+// cacheResults stores the counts of a completed job for later jobs to
+// reuse. Results that cannot be cached only lose the reuse.
+func (r *QiskitJobReconciler) cacheResults(ctx context.Context, job *quantumv1.QiskitJob, counts map[string]int) {
+	if !results.UsesCache(job) {
+		return
+	}
+	if err := results.StoreCache(ctx, r.Client, job, counts, job.Status.Results); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to cache results")
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/calendar"
+)
+
+// ConditionDelayedForCost is True while submission is held back by a
+// blackout or peak pricing window of a QiskitCalendar
+const ConditionDelayedForCost = "DelayedForCost"
+
+// scheduledRecheckInterval bounds how long a Scheduled job sleeps before its
+// calendars are evaluated again, since calendar changes do not trigger
+// reconciliation
+const scheduledRecheckInterval = 5 * time.Minute
+
+// namespaceCalendars returns the calendars that apply to jobs in the job's namespace
+func (r *QiskitJobReconciler) namespaceCalendars(ctx context.Context, job *quantumv1.QiskitJob) ([]quantumv1.QiskitCalendar, error) {
+	var calendars quantumv1.QiskitCalendarList
+	if err := r.List(ctx, &calendars); err != nil {
+		return nil, err
+	}
+	var namespace corev1.Namespace
+	if err := r.Get(ctx, client.ObjectKey{Name: job.Namespace}, &namespace); err != nil {
+		return nil, err
+	}
+	matching, err := calendar.ForNamespace(calendars.Items, namespace.Labels)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Ignoring calendars with invalid namespace selectors")
+	}
+	return matching, nil
+}
+
+// holdForExecutionWindow moves a job that is outside the allowed execution
+// windows of its calendars to the Scheduled phase, recording when the next
+// one opens. It reports whether the job is held, in which case
+// reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) holdForExecutionWindow(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	calendars, err := r.namespaceCalendars(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	next, window, err := calendar.Eligible(calendars, []string{job.Spec.Backend.Type, device(job)}, time.Now())
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Ignoring invalid calendar windows")
+	}
+	if next.IsZero() {
+		return ctrl.Result{}, false, nil
+	}
+	job.Status.NextEligibleTime = &metav1.Time{Time: next}
+	result, err := r.updateJobPhase(ctx, job, PhaseScheduled,
+		fmt.Sprintf("Outside the allowed execution windows; eligible when window %q opens at %s",
+			window, next.UTC().Format(time.RFC3339)))
+	return result, true, err
+}
+
+// handleScheduledJob waits for the next allowed execution window, then hands
+// the job back to scheduling
+func (r *QiskitJobReconciler) handleScheduledJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	calendars, err := r.namespaceCalendars(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	next, window, err := calendar.Eligible(calendars, []string{job.Spec.Backend.Type, device(job)}, time.Now())
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Ignoring invalid calendar windows")
+	}
+	if next.IsZero() {
+		job.Status.NextEligibleTime = nil
+		return r.updateJobPhase(ctx, job, PhaseScheduling, "Execution window open, scheduling job")
+	}
+
+	if job.Status.NextEligibleTime == nil || !job.Status.NextEligibleTime.Time.Equal(next) {
+		job.Status.NextEligibleTime = &metav1.Time{Time: next}
+		job.Status.Message = fmt.Sprintf("Outside the allowed execution windows; eligible when window %q opens at %s",
+			window, next.UTC().Format(time.RFC3339))
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	requeueBecause(ctx, RequeueHold)
+	return ctrl.Result{RequeueAfter: min(time.Until(next), scheduledRecheckInterval)}, nil
+}
+
+// holdForCalendar delays submission while the job's backend is in a
+// blackout window, or in a peak pricing window that ends before the job's
+// deadline. It reports whether the job is held, in which case reconciliation
+// should stop with the returned result.
+func (r *QiskitJobReconciler) holdForCalendar(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	logger := log.FromContext(ctx)
+
+	calendars, err := r.namespaceCalendars(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	var deadline *time.Time
+	if job.Spec.Execution.Deadline != nil {
+		deadline = &job.Spec.Execution.Deadline.Time
+	}
+	backends := []string{job.Spec.Backend.Type, device(job)}
+	decision, err := calendar.Evaluate(calendars, backends, time.Now(), deadline)
+	if err != nil {
+		logger.Error(err, "Ignoring invalid calendar windows")
+	}
+
+	if !decision.Delayed() {
+		if meta.IsStatusConditionTrue(job.Status.Conditions, ConditionDelayedForCost) {
+			meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+				Type:               ConditionDelayedForCost,
+				Status:             metav1.ConditionFalse,
+				Reason:             "WindowOpen",
+				Message:            "Backend calendar allows submission",
+				ObservedGeneration: job.Generation,
+			})
+		}
+		return ctrl.Result{}, false, nil
+	}
+
+	message := fmt.Sprintf("Waiting for %s window %q to end at %s",
+		decision.Reason, decision.Window, decision.Until.UTC().Format(time.RFC3339))
+	logger.Info("Delaying submission for backend calendar", "reason", decision.Reason,
+		"window", decision.Window, "until", decision.Until)
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionDelayedForCost,
+		Status:             metav1.ConditionTrue,
+		Reason:             decision.Reason,
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+	job.Status.Message = message
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	requeueBecause(ctx, RequeueHold)
+	return ctrl.Result{RequeueAfter: time.Until(decision.Until)}, true, nil
+}
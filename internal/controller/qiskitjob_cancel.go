@@ -0,0 +1,67 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// CancelAnnotation asks the operator to cancel a job. Its value, if any, is
+// the reason recorded in the job's message.
+const CancelAnnotation = "quantum.io/cancel"
+
+// cancellable reports whether the job has yet to finish: it neither
+// completed, was cancelled, nor failed with no retries left
+func cancellable(job *quantumv1.QiskitJob) bool {
+	switch job.Status.Phase {
+	case PhaseCompleted, PhaseCancelled:
+		return false
+	case PhaseFailed:
+		return retriesLeft(job)
+	}
+	return true
+}
+
+// cancelRequested cancels a job annotated for cancellation: its execution and
+// pods are deleted, a provider job is cancelled and a dispatched copy is
+// withdrawn, as when the job is deleted. It reports whether the job was
+// cancelled, in which case reconciliation should stop with the returned
+// result.
+func (r *QiskitJobReconciler) cancelRequested(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	reason, ok := job.Annotations[CancelAnnotation]
+	if !ok || !cancellable(job) {
+		return ctrl.Result{}, false, nil
+	}
+	if err := r.cleanupJob(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	now := metav1.Now()
+	job.Status.CompletionTime = &now
+	job.Status.NextRetryAt = nil
+	message := "Job cancelled"
+	if reason != "" {
+		message += ": " + reason
+	}
+	result, err := r.updateJobPhase(ctx, job, PhaseCancelled, message)
+	return result, true, err
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Circuits fetched from a URL are kept in a ConfigMap of the job under
+// fetchedCircuitKey, annotated with the URL they were fetched from
+const (
+	fetchedCircuitKey    = "circuit.py"
+	fetchedURLAnnotation = "quantum.io/circuit-url"
+)
+
+// maxCircuitBytes bounds circuit code fetched from a URL, leaving room in
+// the ConfigMap it is stored in
+const maxCircuitBytes = 512 * 1024
+
+// circuitHTTPClient fetches url circuit sources. It connects only to public
+// addresses, however the URL or its redirects resolve, so that job authors
+// cannot reach the cluster's services or the cloud's metadata endpoint
+// through the operator. It ignores proxies, which would connect for it.
+var circuitHTTPClient = &http.Client{
+	Timeout: time.Minute,
+	Transport: &http.Transport{
+		DialContext:         (&net.Dialer{Timeout: 30 * time.Second, Control: dialPublicOnly}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which clouds use for
+// internal endpoints too
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// dialPublicOnly refuses connections to addresses that are not public
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !publicAddress(addr) {
+		return &circuitSourceError{fmt.Sprintf("Circuit url resolves to %s, which is not a public address", addr)}
+	}
+	return nil
+}
+
+// publicAddress reports whether addr is routable on the internet, rather
+// than loopback, link-local, private or otherwise internal
+func publicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// circuitSourceError explains why the job's circuit code cannot be read,
+// which fails the job rather than being retried
+type circuitSourceError struct {
+	message string
+}
+
+func (e *circuitSourceError) Error() string {
+	return e.message
+}
+
+// circuitCode returns the Python code of the job's circuit: the inline code,
+// the code held in the key of the referenced ConfigMap in the job's
+// namespace, or the code fetched from the job's URL
+func (r *QiskitJobReconciler) circuitCode(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	circuit := &job.Spec.Circuit
+	switch circuit.Source {
+	case "configmap":
+		return r.configMapCircuit(ctx, job)
+	case "url":
+		return r.urlCircuit(ctx, job)
+	default:
+		return circuit.Code, nil
+	}
+}
+
+// configMapCircuit returns the code held in the key of the job's ConfigMap
+func (r *QiskitJobReconciler) configMapCircuit(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	ref := job.Spec.Circuit.ConfigMapRef
+	if ref == nil {
+		return "", &circuitSourceError{"Circuit configMapRef is required for configmap source"}
+	}
+
+	var cm corev1.ConfigMap
+	err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: job.Namespace}, &cm)
+	if apierrors.IsNotFound(err) {
+		return "", &circuitSourceError{fmt.Sprintf("Circuit ConfigMap %s not found", ref.Name)}
+	}
+	if err != nil {
+		return "", err
+	}
+	if code, ok := cm.Data[ref.Key]; ok {
+		return code, nil
+	}
+	if code, ok := cm.BinaryData[ref.Key]; ok {
+		return string(code), nil
+	}
+	return "", &circuitSourceError{fmt.Sprintf("Key %s not found in circuit ConfigMap %s", ref.Key, ref.Name)}
+}
+
+// fetchedCircuitName names the ConfigMap holding the code fetched for the job
+func fetchedCircuitName(job *quantumv1.QiskitJob) string {
+	return job.Name + "-circuit"
+}
+
+// urlCircuit returns the code of the job's URL. It is fetched on first use,
+// checked against the job's sha256 and stored in a ConfigMap of the job that
+// later reads and retries use, so the code cannot change under the job.
+// Jobs denied network egress cannot fetch code either.
+func (r *QiskitJobReconciler) urlCircuit(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	circuit := &job.Spec.Circuit
+	if circuit.URL == "" {
+		return "", &circuitSourceError{"Circuit url is required for url source"}
+	}
+	if circuit.SHA256 == "" {
+		return "", &circuitSourceError{"Circuit sha256 is required for url source"}
+	}
+	if !allowsEgress(job) {
+		return "", &circuitSourceError{"Circuit url cannot be fetched for jobs denied network egress"}
+	}
+
+	stored := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: fetchedCircuitName(job), Namespace: job.Namespace}, stored)
+	found := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+	if code, ok := stored.Data[fetchedCircuitKey]; ok && stored.Annotations[fetchedURLAnnotation] == circuit.URL &&
+		matchesSHA256(code, circuit.SHA256) {
+		return code, nil
+	}
+
+	code, err := fetchCircuit(ctx, circuit.URL)
+	if err != nil {
+		return "", err
+	}
+	if !matchesSHA256(code, circuit.SHA256) {
+		return "", &circuitSourceError{fmt.Sprintf("Circuit fetched from %s does not match sha256 %s",
+			circuit.URL, circuit.SHA256)}
+	}
+
+	if !found {
+		stored = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      fetchedCircuitName(job),
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				"app":            "qiskit-operator",
+				"quantum.io/job": job.Name,
+			},
+		}}
+		if err := controllerutil.SetControllerReference(job, stored, r.Scheme); err != nil {
+			return "", err
+		}
+	}
+	if stored.Annotations == nil {
+		stored.Annotations = map[string]string{}
+	}
+	stored.Annotations[fetchedURLAnnotation] = circuit.URL
+	stored.Data = map[string]string{fetchedCircuitKey: code}
+	if found {
+		err = r.Update(ctx, stored)
+	} else {
+		err = r.Create(ctx, stored)
+	}
+	if err != nil {
+		return "", err
+	}
+	log.FromContext(ctx).Info("Fetched circuit", "url", circuit.URL, "configMap", stored.Name)
+	return code, nil
+}
+
+// fetchCircuit downloads circuit code. Server errors and failed connections
+// are retried; other responses fail the job.
+func fetchCircuit(ctx context.Context, rawURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", &circuitSourceError{fmt.Sprintf("Invalid circuit url %s: %v", rawURL, err)}
+	}
+	resp, err := circuitHTTPClient.Do(req)
+	var sourceErr *circuitSourceError
+	if errors.As(err, &sourceErr) {
+		return "", sourceErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("fetching circuit from %s: %w", rawURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return "", fmt.Errorf("fetching circuit from %s: %s", rawURL, resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", &circuitSourceError{fmt.Sprintf("Failed to fetch circuit from %s: %s", rawURL, resp.Status)}
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCircuitBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("fetching circuit from %s: %w", rawURL, err)
+	}
+	if len(data) > maxCircuitBytes {
+		return "", &circuitSourceError{fmt.Sprintf("Circuit at %s is larger than %d bytes", rawURL, maxCircuitBytes)}
+	}
+	if !utf8.Valid(data) {
+		return "", &circuitSourceError{fmt.Sprintf("Circuit at %s is not UTF-8 text", rawURL)}
+	}
+	return string(data), nil
+}
+
+// matchesSHA256 reports whether code has the hex-encoded digest
+func matchesSHA256(code, digest string) bool {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:]) == strings.ToLower(digest)
+}
+
+// circuitUnreadable returns why the job's circuit code cannot be read, or
+// nothing if it can
+func (r *QiskitJobReconciler) circuitUnreadable(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	_, err := r.circuitCode(ctx, job)
+	var sourceErr *circuitSourceError
+	if errors.As(err, &sourceErr) {
+		return sourceErr.Error(), nil
+	}
+	return "", err
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/callback"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+)
+
+// CodeLabel marks the ConfigMaps holding the programs of execution pods
+const CodeLabel = "quantum.io/code"
+
+// Files of the executor's program, mounted read-only into execution pods
+const (
+	codeDir              = "/circuit"
+	codeVolume           = "code"
+	programKey           = "main.py"
+	requirementsKey      = "requirements.txt"
+	bundleFetchKey       = "fetch.py"
+	qasmKey              = "circuit.qasm"
+	observablesKey       = "observables.json"
+	programFile          = codeDir + "/" + programKey
+	codeRequirementsFile = codeDir + "/" + requirementsKey
+	bundleFetchFile      = codeDir + "/" + bundleFetchKey
+	qasmFile             = codeDir + "/" + qasmKey
+	observablesFile      = codeDir + "/" + observablesKey
+)
+
+// executionProgram returns the files the execution pod runs: the Python
+// program with the job's circuit code, the pip requirements of the executor,
+// the bundle fetcher for bundles, the program of OpenQASM circuits and the
+// observables the estimator estimates. User content only ever reaches the
+// executor through these files, never through its shell.
+func (r *QiskitJobReconciler) executionProgram(job *quantumv1.QiskitJob, rt *compat.Runtime, circuitCode string) map[string]string {
+	code := executionCode(job, circuitCode, r.uploadsResults(job))
+	if r.Callback != nil {
+		code = callback.Prologue + code
+	}
+
+	requirements := rt.RequirementsFor(backendType(job))
+	if gpuAccelerated(job) {
+		requirements = compat.AerGPU(requirements)
+	}
+	requirements = append(requirements, job.Spec.Execution.ExtraPackages...)
+	if results.PublishesTranspiled(job) {
+		requirements = append(requirements, strings.Fields(transpiledRequirements)...)
+	}
+	if r.HangDumps {
+		requirements = append(requirements, "py-spy")
+	}
+	if qasmVersion(job) == 3 {
+		requirements = append(requirements, qasm3Requirements)
+	}
+
+	files := map[string]string{
+		programKey:      code,
+		requirementsKey: strings.Join(requirements, "\n") + "\n",
+	}
+	if isBundle(job) {
+		files[bundleFetchKey] = bundleFetch
+	}
+	if qasmVersion(job) > 0 {
+		files[qasmKey] = circuitCode
+	}
+	if observables, ok := observablesJSON(job); ok {
+		files[observablesKey] = observables
+	}
+	return files
+}
+
+// codeConfigMapName names the ConfigMap of a program after the job and a
+// digest of its files, so attempts, debug pods and verification runs of the
+// same program share it while shadow runs get their own
+func codeConfigMapName(job *quantumv1.QiskitJob, files map[string]string) string {
+	h := sha256.New()
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write([]byte(files[key]))
+		h.Write([]byte{0})
+	}
+	return job.Name + "-code-" + hex.EncodeToString(h.Sum(nil))[:10]
+}
+
+// ensureProgram stores the program in an immutable ConfigMap owned by the
+// job, unless it already is, and returns the ConfigMap's name
+func (r *QiskitJobReconciler) ensureProgram(ctx context.Context, job *quantumv1.QiskitJob, files map[string]string) (string, error) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      codeConfigMapName(job, files),
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				"app":            "qiskit-operator",
+				results.JobLabel: job.Name,
+				CodeLabel:        "true",
+			},
+		},
+		Data:      files,
+		Immutable: ptr(true),
+	}
+	if err := controllerutil.SetControllerReference(job, configMap, r.Scheme); err != nil {
+		return "", err
+	}
+	if err := r.Create(ctx, configMap); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", err
+	}
+	return configMap.Name, nil
+}
+
+// mountProgram mounts the program's ConfigMap read-only at codeDir
+func mountProgram(pod *corev1.Pod, configMap string) {
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: codeVolume,
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: configMap},
+		}},
+	})
+	container := &pod.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts,
+		corev1.VolumeMount{Name: codeVolume, MountPath: codeDir, ReadOnly: true})
+}
+
+// deletePrograms deletes the ConfigMaps holding the job's programs
+func (r *QiskitJobReconciler) deletePrograms(ctx context.Context, job *quantumv1.QiskitJob) error {
+	var configMaps corev1.ConfigMapList
+	if err := r.List(ctx, &configMaps, client.InNamespace(job.Namespace),
+		client.MatchingLabels{results.JobLabel: job.Name, CodeLabel: "true"}); err != nil {
+		return err
+	}
+	for i := range configMaps.Items {
+		if err := r.Delete(ctx, &configMaps.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Standard conditions of a job's lifecycle, maintained alongside its phase
+// so tools that only read conditions can follow it
+const (
+	// ConditionValidated reports whether the job's spec and circuit passed validation
+	ConditionValidated = "Validated"
+	// ConditionScheduled reports whether a backend was selected for the job
+	ConditionScheduled = "Scheduled"
+	// ConditionPodReady reports whether the execution pod of the current attempt is running
+	ConditionPodReady = "PodReady"
+	// ConditionCompleted reports whether the job completed
+	ConditionCompleted = "Completed"
+	// ConditionFailed reports whether the job failed
+	ConditionFailed = "Failed"
+	// ConditionFinished reports whether the job finished for good: it
+	// completed, was cancelled, or failed with no retries left
+	ConditionFinished = "Finished"
+)
+
+// Event reasons of job failures, by the phase the job failed in
+const (
+	ReasonValidationFailed = "ValidationFailed"
+	ReasonSchedulingFailed = "SchedulingFailed"
+	ReasonExecutionFailed  = "ExecutionFailed"
+	ReasonFailedCreatePod  = "FailedCreatePod"
+	ReasonRetrying         = "Retrying"
+)
+
+// setJobCondition sets a condition of the job for its current generation
+func setJobCondition(job *quantumv1.QiskitJob, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+}
+
+// failureReason names what a job moving from oldPhase to Failed failed at
+func failureReason(oldPhase quantumv1.QiskitJobPhase) string {
+	switch oldPhase {
+	case PhasePending, PhaseValidating:
+		return ReasonValidationFailed
+	case PhaseScheduling, PhaseScheduled, PhasePendingApproval:
+		return ReasonSchedulingFailed
+	}
+	return ReasonExecutionFailed
+}
+
+// setPhaseConditions updates the standard conditions of a job moving from
+// oldPhase to its current phase
+func setPhaseConditions(job *quantumv1.QiskitJob, oldPhase quantumv1.QiskitJobPhase, message string) {
+	phase := job.Status.Phase
+	switch phase {
+	case PhaseValidating:
+		setJobCondition(job, ConditionValidated, metav1.ConditionUnknown, "Validating", message)
+	case PhaseScheduling:
+		setJobCondition(job, ConditionScheduled, metav1.ConditionFalse, "Scheduling", message)
+	case PhaseScheduled:
+		setJobCondition(job, ConditionScheduled, metav1.ConditionTrue, "Scheduled", message)
+	case PhaseCompleted:
+		setJobCondition(job, ConditionCompleted, metav1.ConditionTrue, "Succeeded", message)
+		setJobCondition(job, ConditionFailed, metav1.ConditionFalse, "Succeeded", message)
+		setJobCondition(job, ConditionFinished, metav1.ConditionTrue, "Succeeded", message)
+	case PhaseFailed:
+		reason := failureReason(oldPhase)
+		setJobCondition(job, ConditionCompleted, metav1.ConditionFalse, reason, message)
+		setJobCondition(job, ConditionFailed, metav1.ConditionTrue, reason, message)
+	case PhaseCancelled:
+		setJobCondition(job, ConditionCompleted, metav1.ConditionFalse, "Cancelled", message)
+		setJobCondition(job, ConditionFinished, metav1.ConditionTrue, "Cancelled", message)
+	}
+
+	// Leaving a phase settles the condition it was working towards
+	if oldPhase == PhaseValidating && phase != PhaseFailed && phase != PhaseCancelled {
+		setJobCondition(job, ConditionValidated, metav1.ConditionTrue, "Validated", "Job specification and circuit are valid")
+	}
+	if (oldPhase == PhasePending || oldPhase == PhaseValidating) && phase == PhaseFailed {
+		setJobCondition(job, ConditionValidated, metav1.ConditionFalse, ReasonValidationFailed, message)
+	}
+	if (oldPhase == PhaseScheduling || oldPhase == PhaseScheduled) && phase == PhaseRunning {
+		setJobCondition(job, ConditionScheduled, metav1.ConditionTrue, "BackendSelected",
+			fmt.Sprintf("Scheduled on %s", job.Status.SelectedBackend))
+	}
+}
+
+// phaseEvent records an event for a job that moved from oldPhase to its
+// current phase: a warning naming what failed for failures, and the new
+// phase otherwise
+func (r *QiskitJobReconciler) phaseEvent(job *quantumv1.QiskitJob, oldPhase quantumv1.QiskitJobPhase, message string) {
+	if job.Status.Phase == PhaseFailed {
+		r.event(job, corev1.EventTypeWarning, failureReason(oldPhase), message)
+		return
+	}
+	r.event(job, corev1.EventTypeNormal, string(job.Status.Phase), message)
+}
+
+// setPodReady updates the PodReady condition from the phase of the
+// execution pod of the current attempt
+func setPodReady(job *quantumv1.QiskitJob, podPhase corev1.PodPhase) {
+	switch podPhase {
+	case corev1.PodPending:
+		setJobCondition(job, ConditionPodReady, metav1.ConditionFalse, "PodPending", "Execution pod is pending")
+	case corev1.PodRunning:
+		setJobCondition(job, ConditionPodReady, metav1.ConditionTrue, "PodRunning", "Execution pod is running")
+	case corev1.PodSucceeded:
+		setJobCondition(job, ConditionPodReady, metav1.ConditionFalse, "PodSucceeded", "Execution pod has completed")
+	case corev1.PodFailed:
+		setJobCondition(job, ConditionPodReady, metav1.ConditionFalse, "PodFailed", "Execution pod has failed")
+	default:
+		setJobCondition(job, ConditionPodReady, metav1.ConditionUnknown, "PodUnknown",
+			fmt.Sprintf("Execution pod is in phase %s", podPhase))
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultConfigPollInterval is how often the operator's config file is
+// checked for changes unless configured otherwise
+const DefaultConfigPollInterval = 10 * time.Second
+
+// Tunables are the settings of the job reconciler that can change while the
+// operator runs. Every reconcile reads them afresh.
+type Tunables struct {
+	// ValidationServiceURL is the circuit validation service; empty skips it
+	ValidationServiceURL string
+	// ValidationRetryTimeout is how long validation is retried while the
+	// validation service is unavailable
+	ValidationRetryTimeout time.Duration
+	// ExecutorImage is the image executors of lines without a
+	// QuantumRuntimeVersion run, see QiskitJobReconciler.ExecutorImage
+	ExecutorImage string
+	// GPUExecutorImage is the image executors of jobs simulating on a GPU run
+	GPUExecutorImage string
+	// HTTPPollInterval is how often the status of generic_http and
+	// ibm_quantum jobs is polled
+	HTTPPollInterval time.Duration
+	// MaxQueuedPollInterval is the longest a generic_http or ibm_quantum job
+	// queued at its provider waits between polls
+	MaxQueuedPollInterval time.Duration
+	// PodPendingRequeueInterval is how often a job checks on an execution
+	// pod that has not started yet
+	PodPendingRequeueInterval time.Duration
+	// RunningRequeueInterval is how often a job checks on its running
+	// executions
+	RunningRequeueInterval time.Duration
+	// ErrorRequeueInterval is how long a job waits after a reconcile failed
+	ErrorRequeueInterval time.Duration
+}
+
+// configFile is the operator's config file. Settings it leaves out keep the
+// value of their flag.
+type configFile struct {
+	ValidationServiceURL      *string          `json:"validationServiceURL,omitempty"`
+	ValidationRetryTimeout    *metav1.Duration `json:"validationRetryTimeout,omitempty"`
+	ExecutorImage             *string          `json:"executorImage,omitempty"`
+	GPUExecutorImage          *string          `json:"gpuExecutorImage,omitempty"`
+	HTTPPollInterval          *metav1.Duration `json:"httpPollInterval,omitempty"`
+	MaxQueuedPollInterval     *metav1.Duration `json:"maxQueuedPollInterval,omitempty"`
+	PodPendingRequeueInterval *metav1.Duration `json:"podPendingRequeueInterval,omitempty"`
+	RunningRequeueInterval    *metav1.Duration `json:"runningRequeueInterval,omitempty"`
+	ErrorRequeueInterval      *metav1.Duration `json:"errorRequeueInterval,omitempty"`
+}
+
+// ConfigReloader applies changes of the operator's config file to the job
+// reconciler without restarting the manager, so tuning a busy cluster does
+// not interrupt the reconciles in flight. It polls the file, which works for
+// ConfigMap volumes whose updates the kubelet swaps in behind a symlink. A
+// file that does not parse or validate is logged and the previous settings
+// stay in effect.
+type ConfigReloader struct {
+	// Path is the config file
+	Path string
+
+	// Interval is how often to check the file
+	Interval time.Duration
+
+	// flags are the settings the command line gave
+	flags Tunables
+
+	// contents are those of the file last applied
+	contents []byte
+
+	current atomic.Pointer[Tunables]
+}
+
+var _ manager.LeaderElectionRunnable = &ConfigReloader{}
+
+// NewConfigReloader returns a reloader of the config file at path over the
+// settings of the flags. The file is read once up front, so a broken file
+// stops the operator from starting instead of being ignored.
+func NewConfigReloader(path string, interval time.Duration, flags Tunables) (*ConfigReloader, error) {
+	c := &ConfigReloader{Path: path, Interval: interval, flags: flags}
+	c.current.Store(&flags)
+	if _, err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Tunables returns the settings in effect
+func (c *ConfigReloader) Tunables() Tunables {
+	return *c.current.Load()
+}
+
+// NeedLeaderElection makes every replica follow the file, so a newly
+// elected leader reconciles with the current settings at once
+func (c *ConfigReloader) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable
+func (c *ConfigReloader) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("config-reloader")
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		changed, err := c.reload()
+		if err != nil {
+			logger.Error(err, "Ignoring invalid config file, keeping the previous settings", "path", c.Path)
+			continue
+		}
+		if changed {
+			t := c.Tunables()
+			logger.Info("Applied changed config file", "path", c.Path,
+				"validationServiceURL", t.ValidationServiceURL, "validationRetryTimeout", t.ValidationRetryTimeout,
+				"executorImage", t.ExecutorImage, "gpuExecutorImage", t.GPUExecutorImage,
+				"httpPollInterval", t.HTTPPollInterval, "maxQueuedPollInterval", t.MaxQueuedPollInterval,
+				"podPendingRequeueInterval", t.PodPendingRequeueInterval,
+				"runningRequeueInterval", t.RunningRequeueInterval, "errorRequeueInterval", t.ErrorRequeueInterval)
+		}
+	}
+}
+
+// reload reads the config file and applies it if it changed, reporting
+// whether it did
+func (c *ConfigReloader) reload() (bool, error) {
+	contents, err := os.ReadFile(c.Path)
+	if err != nil {
+		return false, err
+	}
+	if c.contents != nil && bytes.Equal(contents, c.contents) {
+		return false, nil
+	}
+	var file configFile
+	if err := yaml.UnmarshalStrict(contents, &file); err != nil {
+		return false, fmt.Errorf("parsing %s: %w", c.Path, err)
+	}
+	t, err := file.apply(c.flags)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", c.Path, err)
+	}
+	c.contents = contents
+	c.current.Store(&t)
+	return true, nil
+}
+
+// apply returns the settings with those of the file in place of the flags'
+func (f *configFile) apply(t Tunables) (Tunables, error) {
+	if f.ValidationServiceURL != nil {
+		if *f.ValidationServiceURL != "" {
+			u, err := url.Parse(*f.ValidationServiceURL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return t, fmt.Errorf("validationServiceURL %q must be an http or https URL", *f.ValidationServiceURL)
+			}
+		}
+		t.ValidationServiceURL = *f.ValidationServiceURL
+	}
+	if f.ValidationRetryTimeout != nil {
+		if f.ValidationRetryTimeout.Duration <= 0 {
+			return t, fmt.Errorf("validationRetryTimeout must be positive")
+		}
+		t.ValidationRetryTimeout = f.ValidationRetryTimeout.Duration
+	}
+	if f.ExecutorImage != nil {
+		t.ExecutorImage = *f.ExecutorImage
+	}
+	if f.GPUExecutorImage != nil {
+		t.GPUExecutorImage = *f.GPUExecutorImage
+	}
+	if f.HTTPPollInterval != nil {
+		if f.HTTPPollInterval.Duration < time.Second {
+			return t, fmt.Errorf("httpPollInterval must be at least 1s")
+		}
+		t.HTTPPollInterval = f.HTTPPollInterval.Duration
+	}
+	for _, interval := range []struct {
+		name  string
+		value *metav1.Duration
+		into  *time.Duration
+	}{
+		{"maxQueuedPollInterval", f.MaxQueuedPollInterval, &t.MaxQueuedPollInterval},
+		{"podPendingRequeueInterval", f.PodPendingRequeueInterval, &t.PodPendingRequeueInterval},
+		{"runningRequeueInterval", f.RunningRequeueInterval, &t.RunningRequeueInterval},
+		{"errorRequeueInterval", f.ErrorRequeueInterval, &t.ErrorRequeueInterval},
+	} {
+		if interval.value == nil {
+			continue
+		}
+		if interval.value.Duration < time.Second {
+			return t, fmt.Errorf("%s must be at least 1s", interval.name)
+		}
+		*interval.into = interval.value.Duration
+	}
+	return t, nil
+}
+
+// tunables returns the settings in effect for the reconciler: those of its
+// config file if it has one, else its fields, with defaults for those unset
+func (r *QiskitJobReconciler) tunables() Tunables {
+	t := Tunables{
+		ValidationServiceURL:      r.ValidationServiceURL,
+		ValidationRetryTimeout:    r.ValidationRetryTimeout,
+		ExecutorImage:             r.ExecutorImage,
+		GPUExecutorImage:          r.GPUExecutorImage,
+		PodPendingRequeueInterval: r.PodPendingRequeueInterval,
+		RunningRequeueInterval:    r.RunningRequeueInterval,
+		ErrorRequeueInterval:      r.ErrorRequeueInterval,
+	}
+	if r.Config != nil {
+		t = r.Config.Tunables()
+	}
+	if t.ValidationRetryTimeout <= 0 {
+		t.ValidationRetryTimeout = DefaultValidationRetryTimeout
+	}
+	if t.HTTPPollInterval <= 0 {
+		t.HTTPPollInterval = DefaultHTTPPollInterval
+	}
+	if t.MaxQueuedPollInterval <= 0 {
+		t.MaxQueuedPollInterval = DefaultMaxQueuedPollInterval
+	}
+	if t.PodPendingRequeueInterval <= 0 {
+		t.PodPendingRequeueInterval = DefaultPodPendingRequeueInterval
+	}
+	if t.RunningRequeueInterval <= 0 {
+		t.RunningRequeueInterval = DefaultRunningRequeueInterval
+	}
+	if t.ErrorRequeueInterval <= 0 {
+		t.ErrorRequeueInterval = DefaultErrorRequeueInterval
+	}
+	return t
+}
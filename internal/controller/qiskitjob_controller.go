@@ -18,60 +18,372 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/callback"
+	"github.com/quantum-operator/qiskit-operator/internal/chaos"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/approval"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/credentials"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
+	"github.com/quantum-operator/qiskit-operator/pkg/breaker"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+	"github.com/quantum-operator/qiskit-operator/pkg/dispatch"
+	"github.com/quantum-operator/qiskit-operator/pkg/heartbeat"
+	"github.com/quantum-operator/qiskit-operator/pkg/lineage"
+	"github.com/quantum-operator/qiskit-operator/pkg/migration"
+	"github.com/quantum-operator/qiskit-operator/pkg/notify"
+	"github.com/quantum-operator/qiskit-operator/pkg/packages"
+	"github.com/quantum-operator/qiskit-operator/pkg/provenance"
+	"github.com/quantum-operator/qiskit-operator/pkg/queue"
+	"github.com/quantum-operator/qiskit-operator/pkg/region"
+	"github.com/quantum-operator/qiskit-operator/pkg/tracking"
+	"github.com/quantum-operator/qiskit-operator/pkg/validation"
+	"github.com/quantum-operator/qiskit-operator/pkg/work"
 )
 
 // Job phase constants
 const (
-	PhasePending    = "Pending"
-	PhaseValidating = "Validating"
-	PhaseScheduling = "Scheduling"
-	PhaseRunning    = "Running"
-	PhaseCompleted  = "Completed"
-	PhaseFailed     = "Failed"
-	PhaseCancelled  = "Cancelled"
-	PhaseRetrying   = "Retrying"
+	PhasePending    = quantumv1.QiskitJobPending
+	PhaseValidating = quantumv1.QiskitJobValidating
+	PhaseScheduling = quantumv1.QiskitJobScheduling
+	PhaseScheduled  = quantumv1.QiskitJobScheduled
+	PhaseRunning    = quantumv1.QiskitJobRunning
+	PhaseCompleted  = quantumv1.QiskitJobCompleted
+	PhaseFailed     = quantumv1.QiskitJobFailed
+	PhaseCancelled  = quantumv1.QiskitJobCancelled
+	PhaseRetrying   = quantumv1.QiskitJobRetrying
+
+	// PhasePendingApproval holds jobs above the approval tier until they
+	// are approved
+	PhasePendingApproval = quantumv1.QiskitJobPendingApproval
 )
 
 // Finalizer name
 const qiskitJobFinalizer = "quantum.io/finalizer"
 
+// maxRetries is how many times a failed job is retried unless its retry
+// policy says otherwise
+const maxRetries = 3
+
 // QiskitJobReconciler reconciles a QiskitJob object
 type QiskitJobReconciler struct {
 	client.Client
-	Scheme               *runtime.Scheme
+	Scheme *runtime.Scheme
+
+	// ValidationServiceURL is the circuit validation service circuits are
+	// checked by before they are scheduled; empty skips the check
 	ValidationServiceURL string
+
+	// ValidationRetryTimeout is how long validation is retried while the
+	// validation service is unavailable before the job fails
+	ValidationRetryTimeout time.Duration
+
+	// QueuePredictor is fed the observed queue wait of each execution and
+	// used to estimate job start times; nil disables prediction
+	QueuePredictor *queue.Predictor
+
+	// FaultInjector, when set, duplicates reconciles and delays pod events to
+	// test convergence. The client should be wrapped by the same injector.
+	FaultInjector *chaos.Injector
+
+	// ResultsQueue, when set, hands result parsing and upload to the separate
+	// results processor instead of doing them in the reconciler
+	ResultsQueue *work.Queue
+
+	// FailedPodRetention is how many failed execution pods are kept per job
+	// for debugging; older ones are deleted
+	FailedPodRetention int
+
+	// DebugPodLifetime is how long debug pods of failed jobs stay up
+	DebugPodLifetime time.Duration
+
+	// ExecutionTTL is how long finished execution Jobs are kept before they
+	// are deleted with their pods
+	ExecutionTTL time.Duration
+
+	// GitImage clones git circuit sources into execution pods
+	GitImage string
+
+	// UploaderImage runs the sidecar uploading the results of execution
+	// pods to s3 and pvc outputs; empty leaves them to the operator and the
+	// executor
+	UploaderImage string
+
+	// ClusterID, when set, tags provider jobs with the cluster they were
+	// submitted from, so the session sweeper can tell its own sessions apart
+	ClusterID string
+
+	// Logs reads the heartbeats of running executors; nil disables hang detection
+	Logs RecentLogReader
+
+	// PodLogs reads the results of finished executors when they are not
+	// handed to the results processor; nil leaves shadow runs uncompared
+	PodLogs results.LogReader
+
+	// LogTailBytes is how much of the end of an executor's logs is kept in
+	// the job's logs ConfigMap once its execution finished; zero keeps none
+	LogTailBytes int
+
+	// Archive receives the diagnostics of failed attempts whose retry policy
+	// preserves them; nil keeps the failed attempts instead
+	Archive results.Archive
+
+	// HangTimeout is how long an executor may go without a heartbeat before
+	// its attempt is failed as hung; zero disables hang detection
+	HangTimeout time.Duration
+
+	// HangDumps takes a py-spy dump of hung executors into their pod logs
+	HangDumps bool
+
+	// Callback, when set, has executors post their progress and output to
+	// the operator's callback server rather than only logging them
+	Callback *callback.Endpoint
+
+	// ExecutorNodeSelector is added to every execution pod, so node
+	// autoscalers provision executors from a dedicated node pool
+	ExecutorNodeSelector map[string]string
+
+	// GPUNodeSelector is added to execution pods that request GPUs
+	GPUNodeSelector map[string]string
+
+	// LongRunThreshold is the maximum execution time from which execution
+	// pods are kept from being disrupted by node autoscalers; zero only
+	// keeps GPU executors
+	LongRunThreshold time.Duration
+
+	// AllowedPackages lists the extra packages jobs may install. It is
+	// checked by the webhook as well, but webhooks can be disabled.
+	AllowedPackages packages.Allowlist
+
+	// PackageIndex configures where executors install packages from
+	PackageIndex packages.Index
+
+	// FallbackQueueWait is the predicted queue wait of a device over which
+	// hardware jobs that allow fallback simulate it instead; zero disables it
+	FallbackQueueWait time.Duration
+
+	// Recorder records events on jobs, like their fallback to simulation
+	Recorder record.EventRecorder
+
+	// Breakers, when set, stop submitting to and polling ibm_quantum and
+	// generic_http backends that keep failing
+	Breakers *breaker.Set
+
+	// Polls, when set, paces the status polls of ibm_quantum and
+	// generic_http jobs per backend
+	Polls *ProviderPolls
+
+	// BudgetSoftLimit is the share of a namespace's monthly budget from which
+	// its hardware jobs are simulated or deferred; zero disables it
+	BudgetSoftLimit float64
+
+	// ExecutorImage is the image executors of lines without a
+	// QuantumRuntimeVersion run instead of that of the compatibility matrix;
+	// "{line}" in it is replaced with the job's Qiskit release line
+	ExecutorImage string
+
+	// GPUExecutorImage is the image executors of jobs simulating on a GPU
+	// run, e.g. one with qiskit-aer-gpu and CUDA pre-installed; "{line}" in
+	// it is replaced with the job's Qiskit release line. Without it they run
+	// the usual image and install qiskit-aer-gpu at start.
+	GPUExecutorImage string
+
+	// Config, when set, supplies the validation service, executor images
+	// and polling interval from the operator's config file in place of the
+	// fields above, following the file as it changes
+	Config *ConfigReloader
+
+	// Tracker, when set, logs every finished job as a run of an external
+	// experiment tracker
+	Tracker tracking.Tracker
+
+	// Notifier posts the notifications of finished jobs; nil uses one with
+	// default settings
+	Notifier *notify.Notifier
+
+	// Notifications are sent for every job that finishes, besides those of
+	// its spec.notifications
+	Notifications []Notification
+
+	// Search indexes results of opensearch and elasticsearch outputs
+	Search *results.SearchIndexer
+
+	// ResultsSigner, when set, signs the digest of exported results
+	ResultsSigner results.Signer
+
+	// ResultsCacheTTL is how long cached results are reused by jobs with
+	// cachePolicy "use"; zero reuses them for as long as they exist
+	ResultsCacheTTL time.Duration
+
+	// Secrets, when set, re-triggers jobs whose credentials Secrets change
+	Secrets *SecretWatcher
+
+	// WithoutSecrets runs the operator without access to Secrets. Credentials
+	// then reach execution pods through spec.credentials.volume only: region
+	// credentials are not checked up front and generic_http and ibm_quantum
+	// backends cannot authenticate.
+	WithoutSecrets bool
+
+	// Vault, when set, fetches the credentials of jobs naming a
+	// spec.credentials.vaultPath
+	Vault credentials.Provider
+
+	// SandboxExecutors runs the executors of every job in a sandbox
+	// namespace of its own, not only those of jobs asking for it
+	SandboxExecutors bool
+
+	// ExecutorEgress lists the CIDRs executors may connect to, such as those
+	// of the package index and of bundle and input hosts. When set, a
+	// NetworkPolicy in each job namespace confines executors to them and
+	// cluster DNS; otherwise they may connect anywhere, and sandboxed ones
+	// to anywhere outside the cluster.
+	ExecutorEgress []string
+
+	// ExecutorEgressPorts are the TCP ports executors may connect to, 443
+	// when empty
+	ExecutorEgressPorts []int32
+
+	// IBM overrides where ibm_quantum jobs connect to. By default they use
+	// the IBM Cloud endpoints of the region they are routed to.
+	IBM ibm.Options
+
+	// ibmClients caches authenticated IBM Quantum adapters
+	ibmClients ibmClients
+
+	// poolAdmissions records the jobs admitted to backend pools that are
+	// not seen running yet
+	poolAdmissions admissions
+
+	// quotaAdmissions records the jobs admitted past the QuantumQuotas of
+	// their namespace that are not seen running yet
+	quotaAdmissions admissions
+
+	// executorAdmissions records the jobs admitted to an execution slot of
+	// their namespace whose executions are not seen yet
+	executorAdmissions admissions
+
+	// Spokes are the clusters jobs may be dispatched to, by name
+	Spokes map[string]dispatch.Spoke
+
+	// UncachedTerminalJobs labels jobs that finished for good with
+	// TerminalLabel, keeping them out of the cache of a manager set up to
+	// leave such jobs out
+	UncachedTerminalJobs bool
+
+	// Jobs lists the jobs of a namespace to amortize session costs and
+	// find duplicates; nil lists them from the client
+	Jobs *JobLister
+
+	// ApprovalTier is the size of job that runs without approval; larger
+	// jobs wait in PendingApproval until they are approved
+	ApprovalTier approval.Tier
+
+	// ApprovalWebhook, when set, is asked to approve jobs waiting for
+	// approval besides the approvers annotating them
+	ApprovalWebhook *approval.Webhook
+
+	// ApprovalPollInterval is how often the approval webhook is asked about
+	// a waiting job; zero uses DefaultApprovalPollInterval
+	ApprovalPollInterval time.Duration
+
+	// SkipFinalizers deletes jobs without the operator's cleanup, leaving it
+	// to garbage collection and the orphan sweeper, so wedged jobs never
+	// block namespace deletion
+	SkipFinalizers bool
+
+	// MaxExecutorsPerNamespace is how many execution pods a namespace may
+	// run at once; further jobs are admitted in priority order. Zero leaves
+	// it to the namespace's QuantumQuotas.
+	MaxExecutorsPerNamespace int32
+
+	// PriorityClasses maps job priorities to the priority class of their
+	// execution pods
+	PriorityClasses map[string]string
+
+	// Decisions, when set, traces what every reconcile of a job decided
+	Decisions *DecisionTraces
+
+	// MaxConcurrentReconciles is how many jobs are reconciled at once; a
+	// job is never reconciled twice at the same time. Zero reconciles one.
+	MaxConcurrentReconciles int
+
+	// RateLimits pace the reconciles that requeue a job at once or fail
+	RateLimits RateLimits
+
+	// PodPendingRequeueInterval is how often a job checks on an execution
+	// pod that has not started yet; zero uses
+	// DefaultPodPendingRequeueInterval
+	PodPendingRequeueInterval time.Duration
+
+	// RunningRequeueInterval is how often a job checks on its running
+	// executions; zero uses DefaultRunningRequeueInterval
+	RunningRequeueInterval time.Duration
+
+	// ErrorRequeueInterval is how long a job waits after a reconcile failed;
+	// zero uses DefaultErrorRequeueInterval
+	ErrorRequeueInterval time.Duration
 }
 
 // +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobtemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitcalendars,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumbackendpools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumbackendpools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumbackends,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=pods/log,verbs=get;list
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=list
+// +kubebuilder:rbac:groups=work.open-cluster-management.io,resources=manifestworks,verbs=get;create;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 //
 // The reconciler implements a phase-based state machine:
-// Pending → Validating → Scheduling → Running → Completed/Failed
+// Pending → Validating → Scheduling (⇄ Scheduled) → Running → Completed/Failed
 func (r *QiskitJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, reason := withRequeueReason(ctx)
+	var decision *Decision
+	if r.Decisions != nil {
+		ctx, decision = withDecision(ctx)
+	}
+	result, err := r.reconcileJob(ctx, req)
+	recordRequeue(*reason, result, err)
+	if decision != nil {
+		decision.finish(*reason, result, err)
+		r.Decisions.record(ctx, req.NamespacedName, decision)
+	}
+	return result, err
+}
+
+// reconcileJob runs the job's state machine, recording why it requeues
+func (r *QiskitJobReconciler) reconcileJob(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	// Fetch the QiskitJob instance
@@ -86,12 +398,17 @@ func (r *QiskitJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		logger.Error(err, "Failed to get QiskitJob")
 		return ctrl.Result{}, err
 	}
+	traceJob(ctx, &job)
 
 	// Handle deletion with finalizer
 	if job.ObjectMeta.DeletionTimestamp != nil {
+		traceStep(ctx, "Job is being deleted")
 		if controllerutil.ContainsFinalizer(&job, qiskitJobFinalizer) {
-			// Run cleanup logic
-			if err := r.cleanupJob(ctx, &job); err != nil {
+			// Run cleanup logic, unless the job opted out so it can be force deleted
+			if r.finalizerSkipped(&job) {
+				logger.Info("Deleting job without cleanup, leaving it to the orphan sweeper")
+				traceStep(ctx, "Skipped cleanup, leaving it to the orphan sweeper")
+			} else if err := r.cleanupJob(ctx, &job); err != nil {
 				logger.Error(err, "Failed to cleanup job")
 				return ctrl.Result{}, err
 			}
@@ -105,17 +422,71 @@ func (r *QiskitJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, nil
 	}
 
-	// Add finalizer if it doesn't exist
-	if !controllerutil.ContainsFinalizer(&job, qiskitJobFinalizer) {
+	// Add finalizer if it doesn't exist, or drop it if the job opted out
+	if r.finalizerSkipped(&job) {
+		if controllerutil.RemoveFinalizer(&job, qiskitJobFinalizer) {
+			if err := r.Update(ctx, &job); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	} else if !controllerutil.ContainsFinalizer(&job, qiskitJobFinalizer) {
+		traceStep(ctx, "Added finalizer")
 		controllerutil.AddFinalizer(&job, qiskitJobFinalizer)
 		if err := r.Update(ctx, &job); err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
+	// Migrate deprecated fields on jobs that bypassed the defaulting webhook
+	if migrated := migration.Migrate(&job); len(migrated) > 0 {
+		logger.Info("Migrating deprecated fields", "fields", migrated)
+		traceStep(ctx, "Migrated deprecated fields %v", migrated)
+		if err := r.Update(ctx, &job); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Instantiate the job template on jobs that bypassed the defaulting webhook
+	if result, done, err := r.instantiateTemplate(ctx, &job); done || err != nil {
+		if done {
+			traceStep(ctx, "Instantiated job template")
+		}
+		return result, err
+	}
+
+	// Label jobs that bypassed the defaulting webhook with their template and session
+	if lineage.ApplyLabels(&job) {
+		traceStep(ctx, "Labelled lineage")
+		if err := r.Update(ctx, &job); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Keep large inline code out of jobs that opted into offloading it
+	if result, done, err := r.offloadCode(ctx, &job); done || err != nil {
+		if done {
+			traceStep(ctx, "Offloaded inline code")
+		}
+		return result, err
+	}
+
+	// Bring statuses written by older operators up to the current phase machine
+	if upgradeStatus(&job) {
+		logger.Info("Upgraded job status", "phase", job.Status.Phase, "version", PhaseMachineVersion)
+		traceStep(ctx, "Upgraded status to phase machine version %d", PhaseMachineVersion)
+		if err := r.Status().Update(ctx, &job); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
 	// Initialize phase if empty
 	if job.Status.Phase == "" {
 		job.Status.Phase = PhasePending
+		job.Status.PhaseMachineVersion = PhaseMachineVersion
+		job.Status.ObservedGeneration = job.Generation
 		job.Status.Message = "Job created, awaiting validation"
 		now := metav1.Now()
 		job.Status.StartTime = &now
@@ -124,44 +495,110 @@ func (r *QiskitJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ctrl.Result{}, err
 		}
 		logger.Info("Job initialized", "phase", PhasePending)
+		traceStep(ctx, "Initialized job")
+		traceOutcome(ctx, &job, nil)
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Run finished jobs again once their spec is edited
+	if result, done, err := r.observeGeneration(ctx, &job); done || err != nil {
+		if done {
+			traceStep(ctx, "Spec changed after the job finished")
+		}
+		traceOutcome(ctx, &job, err)
+		return result, err
+	}
+
+	// Cancellation and suspension apply whatever phase the job is in
+	if result, done, err := r.cancelRequested(ctx, &job); done || err != nil {
+		if done {
+			traceStep(ctx, "Cancellation requested")
+		}
+		traceOutcome(ctx, &job, err)
+		return result, err
+	}
+	if result, held, err := r.holdSuspended(ctx, &job); held || err != nil {
+		if held {
+			traceStep(ctx, "Held while suspended")
+		}
+		return result, err
+	}
+
+	// Copy the registered backend the job references into its spec
+	if result, done, err := r.resolveBackendRef(ctx, &job); done || err != nil {
+		if done {
+			traceStep(ctx, "Resolved backend reference")
+		}
+		return result, err
+	}
+
 	// Phase-based reconciliation
 	logger.Info("Reconciling QiskitJob", 
 		"name", job.Name, 
 		"namespace", job.Namespace, 
 		"phase", job.Status.Phase)
 
+	// Lineage is recorded with whatever status the phase handler writes
+	job.Status.Lineage = lineage.Of(&job)
+
 	var result ctrl.Result
 	var err error
+	phase := job.Status.Phase
 
 	switch job.Status.Phase {
 	case PhasePending:
+		traceHandler(ctx, "handlePendingJob")
 		result, err = r.handlePendingJob(ctx, &job)
 	case PhaseValidating:
+		traceHandler(ctx, "handleValidatingJob")
 		result, err = r.handleValidatingJob(ctx, &job)
 	case PhaseScheduling:
+		traceHandler(ctx, "handleSchedulingJob")
 		result, err = r.handleSchedulingJob(ctx, &job)
+	case PhaseScheduled:
+		traceHandler(ctx, "handleScheduledJob")
+		result, err = r.handleScheduledJob(ctx, &job)
 	case PhaseRunning:
+		traceHandler(ctx, "handleRunningJob")
 		result, err = r.handleRunningJob(ctx, &job)
 	case PhaseCompleted:
+		traceHandler(ctx, "handleCompletedJob")
 		result, err = r.handleCompletedJob(ctx, &job)
 	case PhaseFailed:
+		traceHandler(ctx, "handleFailedJob")
 		result, err = r.handleFailedJob(ctx, &job)
 	case PhaseRetrying:
+		traceHandler(ctx, "handleRetryingJob")
 		result, err = r.handleRetryingJob(ctx, &job)
+	case PhasePendingApproval:
+		traceHandler(ctx, "handlePendingApprovalJob")
+		result, err = r.handlePendingApprovalJob(ctx, &job)
+	case PhaseCancelled:
+		// Terminal, nothing left to do but charge what ran and notify
+		traceHandler(ctx, "sendNotifications")
+		if err = r.chargeQuotas(ctx, &job); err == nil {
+			result, err = r.sendNotifications(ctx, &job)
+		}
 	default:
-		logger.Info("Unknown phase, resetting to Pending", "phase", job.Status.Phase)
-		job.Status.Phase = PhasePending
+		phase := resumePhase(&job)
+		logger.Info("Unknown phase, resuming", "phase", job.Status.Phase, "resumeAs", phase)
+		traceStep(ctx, "Unknown phase %s, resuming as %s", job.Status.Phase, phase)
+		job.Status.Phase = phase
 		err = r.Status().Update(ctx, &job)
 		result = ctrl.Result{Requeue: true}
 	}
+	traceOutcome(ctx, &job, err)
 
 	if err != nil {
 		logger.Error(err, "Error handling job phase", "phase", job.Status.Phase)
 		// Don't return error for retryable issues, just requeue
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		requeueBecause(ctx, requeueReasonOf(err))
+		return ctrl.Result{RequeueAfter: r.errorRequeue()}, nil
+	}
+
+	// Terminal jobs leave the cache once nothing is left to do for them
+	if result.IsZero() && phase.Finished() {
+		return r.uncacheTerminal(ctx, &job)
 	}
 
 	return result, nil
@@ -174,6 +611,15 @@ func (r *QiskitJobReconciler) handlePendingJob(ctx context.Context, job *quantum
 	logger := log.FromContext(ctx)
 	logger.Info("Handling pending job")
 
+	if err := r.recordCostCenter(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Jobs dispatched to a spoke cluster are validated and run there
+	if dispatched(job) {
+		return r.dispatchJob(ctx, job)
+	}
+
 	// Basic validation
 	if job.Spec.Backend.Type == "" {
 		return r.updateJobPhase(ctx, job, PhaseFailed, "Backend type is required")
@@ -187,6 +633,147 @@ func (r *QiskitJobReconciler) handlePendingJob(ctx context.Context, job *quantum
 		return r.updateJobPhase(ctx, job, PhaseFailed, "Circuit code is required for inline source")
 	}
 
+	if errs := validation.ValidateCircuit(&job.Spec.Circuit, field.NewPath("spec", "circuit")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateInlineCodeSize(job, field.NewPath("spec", "circuit", "code")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	reason, err := r.circuitUnreadable(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		return r.updateJobPhase(ctx, job, PhaseFailed, reason)
+	}
+	missing, err := r.bundleConfigMapMissing(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if missing {
+		ref := job.Spec.Circuit.Bundle.ConfigMapRef
+		return r.updateJobPhase(ctx, job, PhaseFailed,
+			fmt.Sprintf("Bundle archive %s not found in ConfigMap %s", ref.Key, ref.Name))
+	}
+
+	// Provider-specific backend fields
+	if errs := validation.ValidateBackend(&job.Spec.Backend, field.NewPath("spec", "backend")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateShadow(job.Spec.Shadow, field.NewPath("spec", "shadow")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateVerify(&job.Spec, field.NewPath("spec", "verify")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateArtifacts(job.Spec.Artifacts, &job.Spec.Backend, field.NewPath("spec", "artifacts")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateOptimizer(job.Spec.Optimizer, &job.Spec.Backend, field.NewPath("spec", "optimizer")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateSweep(&job.Spec, field.NewPath("spec", "sweep")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateTomography(&job.Spec, field.NewPath("spec", "tomography")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateSplit(&job.Spec, field.NewPath("spec", "split")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidatePrimitive(&job.Spec, field.NewPath("spec")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateTranspiler(&job.Spec, field.NewPath("spec", "execution", "transpiler")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateErrorMitigation(job.Spec.Execution.ErrorMitigation, field.NewPath("spec", "execution", "errorMitigation")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateScratch(job.Spec.Execution.Scratch, field.NewPath("spec", "execution", "scratch")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateAccelerator(&job.Spec, field.NewPath("spec", "execution", "accelerator")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateEnv(&job.Spec.Execution, field.NewPath("spec", "execution")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateResources(job.Spec.Resources, field.NewPath("spec", "resources")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateScheduling(job.Spec.Scheduling, field.NewPath("spec", "scheduling")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateSecurity(&job.Spec, field.NewPath("spec", "security")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateBudget(job.Spec.Budget, field.NewPath("spec", "budget")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateOutputs(job.Spec.Outputs, field.NewPath("spec", "outputs")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	if errs := validation.ValidateInputs(job.Spec.Inputs, field.NewPath("spec", "inputs")); len(errs) > 0 {
+		return r.updateJobPhase(ctx, job, PhaseFailed, errs.ToAggregate().Error())
+	}
+	reason, err = r.scratchUnschedulable(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		return r.updateJobPhase(ctx, job, PhaseFailed, reason)
+	}
+	reason, err = r.envFromInvalid(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		return r.updateJobPhase(ctx, job, PhaseFailed, reason)
+	}
+	reason, err = r.inputMissing(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		return r.updateJobPhase(ctx, job, PhaseFailed, reason)
+	}
+
+	reason, err = r.crossNamespaceCredentials(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		return r.updateJobPhase(ctx, job, PhaseFailed, reason)
+	}
+
+	// Route to a region that satisfies the placement constraints
+	jobRegion, err := region.Route(&job.Spec.Backend, job.Spec.Placement)
+	if err != nil {
+		return r.updateJobPhase(ctx, job, PhaseFailed, err.Error())
+	}
+	if ref := region.Credentials(job.Spec.Credentials, jobRegion); ref != nil && jobRegion != "" {
+		secret, missing, err := r.regionCredentialsMissing(ctx, job, ref)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if missing {
+			return r.updateJobPhase(ctx, job, PhaseFailed,
+				fmt.Sprintf("Credentials secret %s for region %s not found", secret, jobRegion))
+		}
+	}
+	job.Status.Region = jobRegion
+
+	// Check the requested Qiskit version against the compatibility matrix
+	rt, err := compat.Resolve(job.Spec.Execution.QiskitVersion)
+	if err != nil {
+		return r.updateJobPhase(ctx, job, PhaseFailed, err.Error())
+	}
+	if err := rt.CheckBackend(job.Spec.Backend.Type); err != nil {
+		return r.updateJobPhase(ctx, job, PhaseFailed, err.Error())
+	}
+	job.Status.QiskitVersion = rt.Line
+
 	// Move to validation phase
 	return r.updateJobPhase(ctx, job, PhaseValidating, "Job specification validated, starting circuit validation")
 }
@@ -196,26 +783,50 @@ func (r *QiskitJobReconciler) handleValidatingJob(ctx context.Context, job *quan
 	logger := log.FromContext(ctx)
 	logger.Info("Validating quantum circuit")
 
-	// TODO: Call validation service
-	// For now, we'll skip validation service and move to scheduling
-	// In production, this would call the Python validation service
-
-	// Mock circuit metadata for now
 	if job.Status.CircuitMetadata == nil {
-		job.Status.CircuitMetadata = &quantumv1.CircuitMetadata{
-			Hash:   "mock-hash",
-			Depth:  10,
-			Qubits: 2,
-			Gates:  15,
-			GateTypes: map[string]int{
-				"h":       2,
-				"cx":      5,
-				"measure": 2,
-			},
+		reason, retryAfter, err := r.validateCircuit(ctx, job)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if reason != "" {
+			return r.updateJobPhase(ctx, job, PhaseFailed, reason)
 		}
+		if retryAfter > 0 {
+			requeueBecause(ctx, RequeueBackoff)
+			return ctrl.Result{RequeueAfter: retryAfter}, nil
+		}
+	}
+
+	if err := r.AllowedPackages.Check(job.Spec.Execution.ExtraPackages); err != nil {
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Extra packages rejected: %v", err))
+	}
+
+	// Report non-blocking lint findings alongside hard validation
+	r.lintCircuit(ctx, job)
+
+	// Detect identical submissions before paying for another execution
+	note, result, err := r.checkDuplicate(ctx, job)
+	if err != nil {
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Duplicate detection failed: %v", err))
+	}
+	if result != nil {
+		return *result, nil
 	}
 
-	return r.updateJobPhase(ctx, job, PhaseScheduling, "Circuit validated successfully")
+	// Reuse the results of an identical earlier execution when the job allows it
+	result, err = r.checkCache(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if result != nil {
+		return *result, nil
+	}
+
+	message := "Circuit validated successfully"
+	if note != "" {
+		message = fmt.Sprintf("%s (%s)", message, note)
+	}
+	return r.updateJobPhase(ctx, job, PhaseScheduling, message)
 }
 
 // handleSchedulingJob selects the backend and prepares for execution
@@ -223,15 +834,94 @@ func (r *QiskitJobReconciler) handleSchedulingJob(ctx context.Context, job *quan
 	logger := log.FromContext(ctx)
 	logger.Info("Scheduling job for execution")
 
-	// For MVP, we only support local_simulator
-	if job.Spec.Backend.Type != "local_simulator" {
+	// IBM hardware, local_simulator, local testing mode and in-house generic_http backends are supported
+	if job.Spec.Backend.Type != "local_simulator" && job.Spec.Backend.Type != "generic_http" &&
+		job.Spec.Backend.Type != "ibm_local_testing" && job.Spec.Backend.Type != "ibm_quantum" {
 		return r.updateJobPhase(ctx, job, PhaseFailed, 
 			fmt.Sprintf("Backend type '%s' not yet supported, use 'local_simulator'", job.Spec.Backend.Type))
 	}
+	if completeByPassed(job) {
+		return r.updateJobPhase(ctx, job, PhaseFailed,
+			fmt.Sprintf("Job can no longer complete by %s", job.Spec.Execution.CompleteBy.UTC().Format(time.RFC3339)))
+	}
+
+	// Pick among the candidate backends first, so the holds below apply to the one picked
+	if message, err := r.selectBackend(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	} else if message != "" {
+		return r.updateJobPhase(ctx, job, PhaseFailed, message)
+	}
+
+	if result, held, err := r.holdForPause(ctx, job); held {
+		return result, err
+	}
+	if result, held, err := r.holdForExecutionWindow(ctx, job); held {
+		return result, err
+	}
+	if result, held, err := r.holdForBudget(ctx, job); held {
+		return result, err
+	}
+	if result, held, err := r.holdForBackendAvailability(ctx, job); held {
+		return result, err
+	}
+	if result, held, err := r.holdForGPU(ctx, job); held {
+		return result, err
+	}
+	if result, held, err := r.holdForNodeFit(ctx, job); held {
+		return result, err
+	}
+	// Jobs simulating their device need neither a cheap window nor a provider slot
+	if !job.Status.FallbackUsed {
+		if result, held, err := r.holdForCalendar(ctx, job); held {
+			return result, err
+		}
+		if result, held, err := r.holdForQuota(ctx, job); held {
+			return result, err
+		}
+		if result, held, err := r.holdForSession(ctx, job); held {
+			return result, err
+		}
+	}
+	// Jobs wait for a slot last, so those held for other reasons keep no one waiting
+	if result, held, err := r.holdForAdmission(ctx, job); held {
+		return result, err
+	}
 
 	// Set selected backend
-	job.Status.SelectedBackend = "local_simulator"
-	job.Status.EstimatedCost = "$0.00" // Local simulator is free
+	job.Status.EstimatedCost = "$0.00" // Simulators and on-premises hardware are free
+	switch backendType(job) {
+	case "ibm_local_testing":
+		// Local testing mode simulates the backend in the execution pod
+		job.Status.SelectedBackend = localTestingBackend(&job.Spec.Backend)
+	case "generic_http":
+		job.Status.SelectedBackend = job.Spec.Backend.Name
+		if job.Status.SelectedBackend == "" {
+			job.Status.SelectedBackend = "generic_http"
+		}
+	case "ibm_quantum":
+		job.Status.SelectedBackend = device(job)
+		job.Status.EstimatedCost = estimateIBMCost(ctx, job)
+	default:
+		job.Status.SelectedBackend = "local_simulator"
+	}
+	// Split jobs pay for every share on hardware, the job's own backend's too
+	if job.Spec.Split != nil {
+		job.Status.EstimatedCost = estimateSplitCost(ctx, job)
+	}
+	r.predictStartTime(job)
+	r.recordBackendInfo(ctx, job)
+	if r.sandboxed(job) {
+		job.Status.SandboxNamespace = sandboxNamespace(job)
+	}
+
+	// The job's own budget and its namespace's quotas are checked against the estimate
+	if result, held, err := r.holdForQuantumQuotas(ctx, job); held {
+		return result, err
+	}
+	// Jobs above the approval tier run once someone approves them
+	if result, held, err := r.holdForApproval(ctx, job); held {
+		return result, err
+	}
 
 	// Update status
 	if err := r.Status().Update(ctx, job); err != nil {
@@ -247,77 +937,311 @@ func (r *QiskitJobReconciler) handleRunningJob(ctx context.Context, job *quantum
 	logger := log.FromContext(ctx)
 	logger.Info("Handling running job")
 
-	// Check if execution pod exists
-	podName := fmt.Sprintf("qiskit-job-%s", job.Name)
-	var pod corev1.Pod
-	err := r.Get(ctx, types.NamespacedName{Name: podName, Namespace: job.Namespace}, &pod)
+	if dispatched(job) {
+		return r.handleDispatchedJob(ctx, job)
+	}
+
+	// Split jobs run a share per backend sharing their shots, remote or not
+	if job.Spec.Split != nil {
+		return r.handleSplitJob(ctx, job)
+	}
+
+	// IBM hardware and in-house control stacks are driven over HTTP instead of from a pod
+	if remote(job) {
+		return r.handleHTTPJob(ctx, job)
+	}
 
-	if err != nil && errors.IsNotFound(err) {
-		// Pod doesn't exist, create it
-		logger.Info("Creating execution pod")
-		pod, err := r.createExecutionPod(ctx, job)
+	// Sweeps run an execution per binding of the circuit's parameters
+	if job.Spec.Sweep != nil {
+		return r.handleSweepJob(ctx, job)
+	}
+
+	// Tomography runs an execution per measurement setting of its qubits
+	if job.Spec.Tomography != nil {
+		return r.handleTomographyJob(ctx, job)
+	}
+
+	// Check if the execution of the current attempt exists
+	name := currentExecutionName(job)
+	execution, err := r.currentExecution(ctx, job)
+	if err != nil {
+		logger.Error(err, "Failed to get execution")
+		return ctrl.Result{}, err
+	}
+
+	if execution == nil {
+		// An execution recorded in the status was deleted since; one that
+		// already succeeded took its results with it
+		recreating := job.Status.JobID == name
+		if recreating && executionSucceeded(job) {
+			return r.failDeletedExecution(ctx, job, name)
+		}
+
+		// Execution doesn't exist, start it
+		logger.Info("Creating execution job")
+		batchJob, err := r.executionJob(ctx, job)
 		if err != nil {
-			logger.Error(err, "Failed to create execution pod")
-			return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Failed to create pod: %v", err))
+			logger.Error(err, "Failed to create execution job")
+			message := fmt.Sprintf("Failed to create execution: %v", err)
+			r.event(job, corev1.EventTypeWarning, ReasonFailedCreatePod, message)
+			setJobCondition(job, ConditionPodReady, metav1.ConditionFalse, ReasonFailedCreatePod, message)
+			return r.updateJobPhase(ctx, job, PhaseFailed, message)
 		}
 
-		if err := r.Create(ctx, pod); err != nil {
-			logger.Error(err, "Failed to create pod in cluster")
+		if err := r.Create(ctx, batchJob); err != nil {
+			if errors.IsAlreadyExists(err) {
+				// The cache has not seen the execution created last time yet
+				requeueBecause(ctx, RequeueWaitingForPod)
+				return ctrl.Result{RequeueAfter: r.podPendingRequeue()}, nil
+			}
+			logger.Error(err, "Failed to create execution job in cluster")
+			r.event(job, corev1.EventTypeWarning, ReasonFailedCreatePod, fmt.Sprintf("Failed to create execution %s: %v", name, err))
 			return ctrl.Result{}, err
 		}
 
-		logger.Info("Execution pod created", "pod", podName)
-		job.Status.JobID = podName
+		logger.Info("Execution job created", "job", name)
+		traceStep(ctx, "Created execution %s", name)
+		job.Status.JobID = name
+		job.Status.Inputs = nil
+		startAttempt(job)
+		if recreating {
+			r.recordRecreatedExecution(ctx, job, name)
+		} else {
+			r.startShadow(ctx, job)
+			r.startVerification(ctx, job)
+		}
 		if err := r.Status().Update(ctx, job); err != nil {
 			return ctrl.Result{}, err
 		}
 
-		// Requeue to check pod status
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
-	} else if err != nil {
-		logger.Error(err, "Failed to get pod")
-		return ctrl.Result{}, err
+		// Requeue to check execution status
+		requeueBecause(ctx, RequeueWaitingForPod)
+		return ctrl.Result{RequeueAfter: r.podPendingRequeue()}, nil
 	}
 
-	// Pod exists, check its status
-	logger.Info("Checking pod status", "phase", pod.Status.Phase)
+	// Execution exists, check its status
+	logger.Info("Checking execution status", "phase", execution.phase)
+	traceStep(ctx, "Execution %s is %s", name, execution.phase)
+	setPodReady(job, execution.phase)
+
+	// The status update recording the execution may have failed after it was created
+	if job.Status.JobID != name {
+		job.Status.JobID = name
+	}
+	// and with it the runs alongside the attempt, which the attempt's
+	// results are not complete without
+	if execution.phase != corev1.PodFailed {
+		r.startShadow(ctx, job)
+		r.startVerification(ctx, job)
+	}
+	pod := execution.pod
+	if pod != nil {
+		if commit, ok := clonedCommit(pod); ok {
+			job.Status.CircuitCommit = commit
+		}
+		if inputs, ok := fetchedInputs(pod, job); ok {
+			job.Status.Inputs = inputs
+		}
+	}
 
-	switch pod.Status.Phase {
+	switch execution.phase {
 	case corev1.PodPending:
 		job.Status.Message = "Execution pod is pending"
 		r.Status().Update(ctx, job)
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		requeueBecause(ctx, RequeueWaitingForPod)
+		return ctrl.Result{RequeueAfter: r.podPendingRequeue()}, nil
 
 	case corev1.PodRunning:
 		job.Status.Message = "Quantum circuit is executing"
+		progress, hung := r.checkHeartbeat(ctx, job, pod)
+		if hung {
+			return r.handleHungExecution(ctx, job, pod)
+		}
+		if progress != "" && progress != "running" {
+			job.Status.Message += ": " + progress
+		}
+		r.observeQueueWait(ctx, job, pod)
 		r.Status().Update(ctx, job)
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		requeueBecause(ctx, RequeueWaitingForPod)
+		return ctrl.Result{RequeueAfter: r.runningRequeue()}, nil
 
 	case corev1.PodSucceeded:
-		logger.Info("Pod completed successfully")
-		return r.handlePodCompletion(ctx, job, &pod)
+		logger.Info("Execution completed successfully")
+		if pod != nil {
+			r.observeQueueWait(ctx, job, pod)
+		}
+		if result, waiting, err := r.awaitShadow(ctx, job); waiting {
+			return result, err
+		}
+		if result, waiting, err := r.awaitVerification(ctx, job); waiting {
+			return result, err
+		}
+		if message := r.compareVerification(ctx, job); message != "" {
+			return r.updateJobPhase(ctx, job, PhaseFailed, message)
+		}
+		return r.handlePodCompletion(ctx, job, pod)
 
 	case corev1.PodFailed:
-		logger.Info("Pod failed")
-		return r.updateJobPhase(ctx, job, PhaseFailed, "Execution pod failed")
+		logger.Info("Execution failed")
+		if err := r.pruneFailedPods(ctx, job); err != nil {
+			logger.Error(err, "Failed to prune old failed execution pods")
+		}
+		if r.LogTailBytes > 0 {
+			r.keepExecutorLogs(ctx, job, r.executionLogs(ctx, job))
+		}
+		if pod != nil {
+			if message, failed := cloneFailure(pod); failed {
+				return r.updateJobPhase(ctx, job, PhaseFailed, message)
+			}
+			if message, failed := inputFailure(pod); failed {
+				return r.updateJobPhase(ctx, job, PhaseFailed, message)
+			}
+		}
+		if execution.message != "" {
+			return r.updateJobPhase(ctx, job, PhaseFailed, execution.message)
+		}
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Execution pod %s failed", name))
 
 	default:
-		job.Status.Message = fmt.Sprintf("Unknown pod phase: %s", pod.Status.Phase)
+		job.Status.Message = fmt.Sprintf("Unknown pod phase: %s", execution.phase)
 		r.Status().Update(ctx, job)
-		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		requeueBecause(ctx, RequeueWaitingForPod)
+		return ctrl.Result{RequeueAfter: r.runningRequeue()}, nil
 	}
 }
 
-// handlePodCompletion processes completed pod and stores results
+// handlePodCompletion processes a completed execution and stores results.
+// The pod is nil when it is gone, which only leaves the executor's run time
+// unknown.
 func (r *QiskitJobReconciler) handlePodCompletion(ctx context.Context, job *quantumv1.QiskitJob, pod *corev1.Pod) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("Processing pod completion")
 
-	// Get pod logs (results)
-	// In production, we'd parse actual results from logs or mounted volume
-	// For MVP, we'll just mark as complete
+	// Results are only exported to sinks the namespace's residency policy allows
+	exportAllowed, err := r.outputExportAllowed(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Hand result parsing and upload to the results processor when one is deployed
+	processed := exportAllowed && r.ResultsQueue != nil
+	// The estimation is only that of this attempt
+	job.Status.Estimation = nil
+	if processed {
+		result, done, err := r.awaitResultsProcessor(ctx, job)
+		if !done || err != nil {
+			return result, err
+		}
+		if divergence, ok := results.ParseDivergence(job); ok {
+			recordDivergence(job, divergence)
+		}
+		if transpiled, ok := results.ParseTranspiledAnnotation(job); ok {
+			results.RecordTranspiled(job, transpiled)
+		}
+		if optimization, ok := results.ParseOptimizationAnnotation(job); ok {
+			results.RecordOptimization(job, optimization)
+		}
+		if estimation, ok := results.ParseEstimationAnnotation(job); ok {
+			results.RecordEstimation(job, estimation)
+		}
+		if layout, ok := results.ParseLayoutAnnotation(job); ok {
+			results.RecordLayout(job, layout)
+		}
+	}
+
+	recordCompletion(job)
 
-	// Update job status
+	// The results processor parses the logs itself; they are only read here
+	// when they are also kept
+	var logs string
+	if !processed || r.LogTailBytes > 0 {
+		logs = r.executionLogs(ctx, job)
+	}
+	r.keepExecutorLogs(ctx, job, logs)
+
+	// Parse the results the executor logged, unless the results processor did
+	var counts map[string]int
+	var shadow *results.ShadowResults
+	var estimation *results.Estimation
+	var uploads []quantumv1.OutputStatus
+	job.Status.Results = nil
+	job.Status.Outputs = nil
+	if processed {
+		if info, ok := results.ParseInfoAnnotation(job); ok {
+			job.Status.Results = info
+		}
+		if statuses, ok := results.ParseOutputsAnnotation(job); ok {
+			recordOutputs(job, statuses)
+		}
+	} else {
+		if parsed, ok := results.ParseCounts(logs); ok {
+			counts = parsed
+			executionTime, _ := results.ParseExecutionTime(logs)
+			job.Status.Results = results.NewInfo(job, counts, executionTime)
+		}
+		shadow = r.compareShadow(ctx, job, counts)
+		r.publishTranspiled(ctx, job, logs)
+		r.recordOptimization(ctx, job, logs)
+		estimation = r.recordEstimation(ctx, job, logs)
+		if estimation != nil && job.Status.Results == nil {
+			executionTime, _ := results.ParseExecutionTime(logs)
+			job.Status.Results = results.NewEstimationInfo(job, estimation, executionTime)
+		}
+		if layout, ok := results.ParseLayout(logs); ok {
+			results.RecordLayout(job, layout)
+		}
+		uploads, _ = results.ParseUploads(logs)
+		if mitigations, ok := results.ParseMitigations(logs); ok && job.Status.Results != nil {
+			job.Status.Results.Mitigations = mitigations
+		}
+	}
+	if info := job.Status.Results; info != nil {
+		if info.ExecutionTime == "" && pod != nil {
+			if runTime := executorRunTime(pod); runTime > 0 {
+				info.ExecutionTime = runTime.String()
+			}
+		}
+		if !exportAllowed {
+			info.Location = ""
+		}
+	}
+
+	if !exportAllowed {
+		// Files circuit code wrote to a blocked output are not indexed
+		indexArtifacts(job, "")
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			"Job completed; result export blocked by data residency policy")
+	}
+
+	// Export results if not already exported by the results processor
+	if !processed && len(job.Spec.Outputs) > 0 {
+		doc := results.NewDocument(job, counts)
+		doc.Shadow = shadow
+		doc.Estimation = estimation
+		if err := r.exportResults(ctx, job, doc, uploads); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if !processed {
+		r.cacheResults(ctx, job, counts)
+	}
+	indexArtifacts(job, logs)
+
+	switch {
+	case defaults.Estimates(&job.Spec) && job.Status.Estimation == nil:
+		return r.updateJobPhase(ctx, job, PhaseCompleted, "Job completed; no expectation values found in executor output")
+	case !defaults.Estimates(&job.Spec) && job.Status.Results == nil:
+		return r.updateJobPhase(ctx, job, PhaseCompleted, "Job completed; no measurement counts found in executor output")
+	}
+	if message, degraded := degradedOutputsMessage(job); degraded {
+		return r.updateJobPhase(ctx, job, PhaseCompleted, message)
+	}
+	return r.updateJobPhase(ctx, job, PhaseCompleted, "Job completed successfully")
+}
+
+// recordCompletion records when a job's execution finished and how long
+// the job took
+func recordCompletion(job *quantumv1.QiskitJob) {
 	now := metav1.Now()
 	job.Status.CompletionTime = &now
 	job.Status.ActualCost = "$0.00"
@@ -325,27 +1249,37 @@ func (r *QiskitJobReconciler) handlePodCompletion(ctx context.Context, job *quan
 	// Calculate execution time
 	if job.Status.StartTime != nil {
 		duration := now.Sub(job.Status.StartTime.Time)
+		var queueTime string
+		if job.Status.Metrics != nil {
+			queueTime = job.Status.Metrics.QueueTime
+		}
 		job.Status.Metrics = &quantumv1.ExecutionMetrics{
 			TotalTime:     duration.String(),
+			QueueTime:     queueTime,
 			ExecutionTime: duration.String(),
 		}
 	}
-
-	// Create results ConfigMap if specified
-	if job.Spec.Output != nil && job.Spec.Output.Type == "configmap" {
-		if err := r.createResultsConfigMap(ctx, job); err != nil {
-			logger.Error(err, "Failed to create results ConfigMap")
-		}
-	}
-
-	return r.updateJobPhase(ctx, job, PhaseCompleted, "Job completed successfully")
 }
 
 // handleCompletedJob manages completed jobs
 func (r *QiskitJobReconciler) handleCompletedJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
-	// Job is complete, no further action needed
-	// Could implement cleanup logic here
-	return ctrl.Result{}, nil
+	// Job is complete, no further action needed beyond keeping its results
+	// ConfigMaps as exported, costing, charging, notifying and logging it
+	if err := r.repairResults(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.amortizeSessionCost(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.chargeQuotas(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	result, err := r.sendNotifications(ctx, job)
+	if err != nil {
+		return result, err
+	}
+	tracked, err := r.logToTracker(ctx, job)
+	return sooner(result, tracked), err
 }
 
 // handleFailedJob manages failed jobs
@@ -353,40 +1287,99 @@ func (r *QiskitJobReconciler) handleFailedJob(ctx context.Context, job *quantumv
 	logger := log.FromContext(ctx)
 	
 	// Check if we should retry
-	maxRetries := 3
-	if job.Status.RetryCount < maxRetries {
+	if retriesLeft(job) {
 		logger.Info("Job failed, attempting retry", "retryCount", job.Status.RetryCount)
+		if err := r.clearFailedAttempt(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
 		job.Status.RetryCount++
 		job.Status.Phase = PhaseRetrying
-		now := metav1.Now()
-		retryTime := now.Add(10 * time.Second)
-		job.Status.NextRetryAt = &metav1.Time{Time: retryTime}
-		return ctrl.Result{RequeueAfter: 10 * time.Second}, r.Status().Update(ctx, job)
+		delay := retryDelay(job)
+		job.Status.NextRetryAt = &metav1.Time{Time: time.Now().Add(delay)}
+		message := fmt.Sprintf("Retrying failed job (attempt %d of %d)", job.Status.RetryCount, retryLimit(job))
+		traceStep(ctx, "%s in %s", message, delay)
+		setJobCondition(job, ConditionFailed, metav1.ConditionFalse, ReasonRetrying, message)
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.event(job, corev1.EventTypeWarning, ReasonRetrying, message)
+		recordPhaseMetrics(job, PhaseFailed)
+		requeueBecause(ctx, RequeueBackoff)
+		return ctrl.Result{RequeueAfter: delay}, nil
 	}
 
 	// Max retries exceeded, job stays failed
 	logger.Info("Max retries exceeded, job permanently failed")
-	return ctrl.Result{}, nil
+	traceStep(ctx, "No retries left")
+	if !meta.IsStatusConditionTrue(job.Status.Conditions, ConditionFinished) {
+		setJobCondition(job, ConditionFinished, metav1.ConditionTrue, "NoRetriesLeft", job.Status.Message)
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if err := r.syncDebugPod(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.amortizeSessionCost(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.chargeQuotas(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	result, err := r.sendNotifications(ctx, job)
+	if err != nil {
+		return result, err
+	}
+	tracked, err := r.logToTracker(ctx, job)
+	return sooner(result, tracked), err
+}
+
+// retriesLeft reports whether a failed job is retried. Dispatched jobs were
+// retried by their spoke, and seeded runs that measured different counts
+// and jobs the provider rejected for good would fail the same way again.
+func retriesLeft(job *quantumv1.QiskitJob) bool {
+	return job.Status.RetryCount < retryLimit(job) && retryableFailure(job) && !dispatched(job) &&
+		!verificationFailed(job) && !providerRejected(job) && !quotaRejected(job) && !approvalDenied(job) &&
+		!completeByPassed(job)
 }
 
 // handleRetryingJob manages job retries
 func (r *QiskitJobReconciler) handleRetryingJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
+	// Wait out the backoff, also across restarts and unrelated reconciles
+	if wait := retryDue(job); wait > 0 {
+		requeueBecause(ctx, RequeueBackoff)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
 	logger.Info("Retrying job", "retryCount", job.Status.RetryCount)
 
-	// Reset to pending to restart the flow
+	// Results processed for the previous attempt no longer apply
+	if clearResultsAnnotations(job) {
+		if err := r.Update(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Reset to pending to restart the flow; the next attempt records the
+	// backend's calibration afresh
+	job.Status.NextRetryAt = nil
+	job.Status.BackendInfo = nil
 	return r.updateJobPhase(ctx, job, PhasePending, fmt.Sprintf("Retrying job (attempt %d)", job.Status.RetryCount))
 }
 
 // Helper functions
 
 // updateJobPhase updates the job phase and message
-func (r *QiskitJobReconciler) updateJobPhase(ctx context.Context, job *quantumv1.QiskitJob, phase, message string) (ctrl.Result, error) {
+func (r *QiskitJobReconciler) updateJobPhase(ctx context.Context, job *quantumv1.QiskitJob, phase quantumv1.QiskitJobPhase, message string) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	
 	oldPhase := job.Status.Phase
 	job.Status.Phase = phase
 	job.Status.Message = message
+	if phase != oldPhase {
+		setPhaseConditions(job, oldPhase, message)
+		traceStep(ctx, "Moving from %s to %s: %s", oldPhase, phase, message)
+	}
 
 	if err := r.Status().Update(ctx, job); err != nil {
 		logger.Error(err, "Failed to update job status")
@@ -394,6 +1387,10 @@ func (r *QiskitJobReconciler) updateJobPhase(ctx context.Context, job *quantumv1
 	}
 
 	logger.Info("Job phase updated", "from", oldPhase, "to", phase, "message", message)
+	if phase != oldPhase {
+		r.phaseEvent(job, oldPhase, message)
+	}
+	recordPhaseMetrics(job, oldPhase)
 
 	// Requeue immediately to process next phase
 	return ctrl.Result{Requeue: true}, nil
@@ -404,16 +1401,40 @@ func (r *QiskitJobReconciler) cleanupJob(ctx context.Context, job *quantumv1.Qis
 	logger := log.FromContext(ctx)
 	logger.Info("Cleaning up job resources")
 
-	// Delete execution pod if it exists
-	podName := fmt.Sprintf("qiskit-job-%s", job.Name)
-	pod := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      podName,
-			Namespace: job.Namespace,
-		},
+	r.cancelHTTPJob(ctx, job)
+	if err := r.withdrawJob(ctx, job); err != nil {
+		return err
 	}
 
-	if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+	// Delete the executions of every attempt along with their pods
+	var executions batchv1.JobList
+	if err := r.List(ctx, &executions, client.InNamespace(job.Namespace),
+		client.MatchingLabels{"quantum.io/job": job.Name}); err != nil {
+		return err
+	}
+	for i := range executions.Items {
+		err := r.Delete(ctx, &executions.Items[i], client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if err := r.deletePrograms(ctx, job); err != nil {
+		return err
+	}
+
+	// Delete the remaining pods: retained failed ones, shadow and debug pods
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.InNamespace(job.Namespace),
+		client.MatchingLabels{"quantum.io/job": job.Name}); err != nil {
+		return err
+	}
+	for i := range pods.Items {
+		if err := r.Delete(ctx, &pods.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if err := r.deleteSandbox(ctx, job); err != nil {
 		return err
 	}
 
@@ -423,23 +1444,50 @@ func (r *QiskitJobReconciler) cleanupJob(ctx context.Context, job *quantumv1.Qis
 
 // createExecutionPod creates a pod to execute the quantum circuit
 func (r *QiskitJobReconciler) createExecutionPod(ctx context.Context, job *quantumv1.QiskitJob) (*corev1.Pod, error) {
-	podName := fmt.Sprintf("qiskit-job-%s", job.Name)
+	podName := executionName(job)
 
 	// Get execution parameters
-	shots := 1024
+	shots := defaults.Shots
 	if job.Spec.Execution.Shots > 0 {
 		shots = job.Spec.Execution.Shots
 	}
 
+	// Select the executor image and pinned packages for the requested Qiskit version
+	rt, err := compat.Resolve(job.Spec.Execution.QiskitVersion)
+	if err != nil {
+		return nil, err
+	}
+	image, err := r.executorImage(ctx, job, rt)
+	if err != nil {
+		return nil, err
+	}
+	job.Status.ExecutorImage = image
+
+	code, err := r.circuitCode(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	program, err := r.ensureProgram(ctx, job, r.executionProgram(job, rt, code))
+	if err != nil {
+		return nil, err
+	}
+
+	// Tag the provider job so it can be traced back to this QiskitJob
+	jobTags, err := json.Marshal(r.jobTags(job))
+	if err != nil {
+		return nil, err
+	}
+
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      podName,
 			Namespace: job.Namespace,
 			Labels: map[string]string{
-				"app":                       "qiskit-operator",
-				"qiskit-job":                job.Name,
-				"quantum.io/job":            job.Name,
-				"quantum.io/backend-type":   job.Spec.Backend.Type,
+				"app":                     "qiskit-operator",
+				"qiskit-job":              job.Name,
+				"quantum.io/job":          job.Name,
+				"quantum.io/backend-type": backendType(job),
+				AttemptLabel:              fmt.Sprintf("%d", attempt(job)),
 			},
 		},
 		Spec: corev1.PodSpec{
@@ -447,13 +1495,10 @@ func (r *QiskitJobReconciler) createExecutionPod(ctx context.Context, job *quant
 			Containers: []corev1.Container{
 				{
 					Name:  "executor",
-					Image: "python:3.11-slim", // TODO: Use custom image with Qiskit
+					Image: image,
 					Command: []string{
 						"sh", "-c",
-						fmt.Sprintf(`
-pip install --quiet qiskit==1.0.0 qiskit-aer==0.13.0 && \
-python3 -c "%s"
-`, r.escapeCode(job.Spec.Circuit.Code)),
+						r.executionScript(job),
 					},
 					Env: []corev1.EnvVar{
 						{
@@ -464,17 +1509,16 @@ python3 -c "%s"
 							Name:  "OPTIMIZATION_LEVEL",
 							Value: fmt.Sprintf("%d", job.Spec.Execution.OptimizationLevel),
 						},
-					},
-					Resources: corev1.ResourceRequirements{
-						Requests: corev1.ResourceList{
-							corev1.ResourceCPU:    mustParseQuantity("500m"),
-							corev1.ResourceMemory: mustParseQuantity("1Gi"),
+						{
+							Name:  "QISKIT_VERSION",
+							Value: rt.Line,
 						},
-						Limits: corev1.ResourceList{
-							corev1.ResourceCPU:    mustParseQuantity("2"),
-							corev1.ResourceMemory: mustParseQuantity("4Gi"),
+						{
+							Name:  "JOB_TAGS",
+							Value: string(jobTags),
 						},
 					},
+					Resources: executorResources(job),
 					SecurityContext: &corev1.SecurityContext{
 						RunAsNonRoot:             ptr(true),
 						RunAsUser:                ptr(int64(1000)),
@@ -488,78 +1532,111 @@ python3 -c "%s"
 		},
 	}
 
-	// Set owner reference
-	if err := controllerutil.SetControllerReference(job, pod, r.Scheme); err != nil {
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, corev1.EnvVar{
+		Name:  heartbeat.IntervalEnv,
+		Value: fmt.Sprintf("%d", int(heartbeat.DefaultInterval.Seconds())),
+	})
+	if r.HangDumps {
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env,
+			corev1.EnvVar{Name: heartbeat.HangDumpEnv, Value: "1"})
+	}
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, r.PackageIndex.Env()...)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, optimizerEnv(job)...)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, estimatorEnv(job)...)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, transpilerEnv(job)...)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, mitigationEnv(job)...)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, verifyEnv(job)...)
+	pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, acceleratorEnv(job)...)
+	if isBundle(job) || isGit(job) {
+		env, err := entrypointEnv(&job.Spec.Circuit)
+		if err != nil {
+			return nil, err
+		}
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, env...)
+	}
+	if isBundle(job) {
+		r.mountBundle(pod, job, rt)
+	}
+	if isGit(job) {
+		r.cloneRepository(pod, job)
+	}
+	mountProgram(pod, program)
+	if err := mountInputs(pod, job); err != nil {
 		return nil, err
 	}
 
-	return pod, nil
-}
+	if backendType(job) == "ibm_local_testing" {
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env,
+			corev1.EnvVar{Name: "BACKEND_NAME", Value: localTestingBackend(&job.Spec.Backend)})
+	}
+	mountCredentials(pod, job)
+	mountScratch(pod, job)
+	hardenExecutor(pod)
+	if r.uploadsResults(job) {
+		if err := r.addUploader(pod, job); err != nil {
+			return nil, err
+		}
+	} else if err := mountOutput(pod, job); err != nil {
+		return nil, err
+	}
+	injectEnv(pod, job)
+	// Sandboxes and confined executors cannot reach the callback server
+	if r.Callback != nil && job.Status.SandboxNamespace == "" && r.egressMode(job) == "" {
+		env, err := r.Callback.Env(job, podName)
+		if err != nil {
+			return nil, err
+		}
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, env...)
+	}
+	r.addProvisioningHints(pod, job)
+	applyScheduling(pod, job)
+	r.applyPriorityClass(pod, job)
 
-// createResultsConfigMap creates a ConfigMap with job results
-func (r *QiskitJobReconciler) createResultsConfigMap(ctx context.Context, job *quantumv1.QiskitJob) error {
-	logger := log.FromContext(ctx)
+	if metadata := provenance.SessionMetadata(job); metadata != nil {
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			return nil, err
+		}
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env,
+			corev1.EnvVar{Name: "SESSION_METADATA", Value: string(data)})
+	}
 
-	if job.Spec.Output == nil || job.Spec.Output.Location == "" {
-		return nil
-	}
-
-	// Create results data (mock for now)
-	resultsData := fmt.Sprintf(`{
-  "job_id": "%s",
-  "job_name": "%s",
-  "backend": "%s",
-  "shots": %d,
-  "results": {
-    "counts": {
-      "00": 512,
-      "11": 512
-    }
-  },
-  "status": "completed"
-}`, job.Status.JobID, job.Name, job.Status.SelectedBackend, job.Spec.Execution.Shots)
-
-	cm := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      job.Spec.Output.Location,
-			Namespace: job.Namespace,
-			Labels: map[string]string{
-				"app":            "qiskit-operator",
-				"quantum.io/job": job.Name,
-			},
-		},
-		Data: map[string]string{
-			"results.json": resultsData,
-		},
+	if job.Status.SandboxNamespace != "" {
+		// Owner references cannot cross namespaces; the sandbox goes with the job
+		if err := r.sandboxPod(ctx, job, pod); err != nil {
+			return nil, err
+		}
+		return pod, nil
+	}
+	if err := r.confineEgress(ctx, job, pod); err != nil {
+		return nil, err
 	}
 
 	// Set owner reference
-	if err := controllerutil.SetControllerReference(job, cm, r.Scheme); err != nil {
-		return err
+	if err := controllerutil.SetControllerReference(job, pod, r.Scheme); err != nil {
+		return nil, err
 	}
 
-	// Create or update ConfigMap
-	existing := &corev1.ConfigMap{}
-	err := r.Get(ctx, types.NamespacedName{Name: cm.Name, Namespace: cm.Namespace}, existing)
-	if err != nil && errors.IsNotFound(err) {
-		logger.Info("Creating results ConfigMap", "name", cm.Name)
-		return r.Create(ctx, cm)
-	} else if err != nil {
-		return err
-	}
+	return pod, nil
+}
 
-	// Update existing ConfigMap
-	existing.Data = cm.Data
-	logger.Info("Updating results ConfigMap", "name", cm.Name)
-	return r.Update(ctx, existing)
+// executionLogs returns the logs of the job's execution, or nothing when
+// they cannot be read
+func (r *QiskitJobReconciler) executionLogs(ctx context.Context, job *quantumv1.QiskitJob) string {
+	return r.namedExecutionLogs(ctx, job, job.Status.JobID)
 }
 
-// escapeCode escapes the circuit code for shell execution
-func (r *QiskitJobReconciler) escapeCode(code string) string {
-	// Basic escaping - in production, use proper shell escaping
-	// For now, just handle quotes
-	code = fmt.Sprintf("%s", code)
-	return code
+// namedExecutionLogs returns the logs of the named execution of the job, or
+// nothing when they cannot be read
+func (r *QiskitJobReconciler) namedExecutionLogs(ctx context.Context, job *quantumv1.QiskitJob, name string) string {
+	if r.PodLogs == nil {
+		return ""
+	}
+	logs, err := r.PodLogs.PodLogs(ctx, ExecutionNamespace(job), name)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read execution pod logs")
+	}
+	return logs
 }
 
 // Helper functions for pointer values
@@ -577,14 +1654,25 @@ func mustParseQuantity(s string) resource.Quantity {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *QiskitJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	// Set default validation service URL
-	if r.ValidationServiceURL == "" {
-		r.ValidationServiceURL = "http://validation-service:8000"
+	options := crcontroller.Options{
+		MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+		RateLimiter:             NewRateLimiter(r.RateLimits),
+	}
+	// One handler per kind, so fault injection delays every event once
+	jobs := handler.EnqueueRequestsFromMapFunc(controllingJob)
+	var reconciler reconcile.Reconciler = r
+	if r.FaultInjector != nil {
+		jobs = r.FaultInjector.DelayHandler(jobs)
+		reconciler = r.FaultInjector.WrapReconciler(r)
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&quantumv1.QiskitJob{}).
-		Owns(&corev1.Pod{}).
-		Named("qiskitjob").
-		Complete(r)
+		Watches(&batchv1.Job{}, jobs).
+		Watches(&corev1.Pod{}, jobs).
+		Watches(&corev1.ConfigMap{}, jobs)
+	if r.Secrets != nil {
+		b = b.WatchesRawSource(r.Secrets.Source())
+	}
+	return b.Named("qiskitjob").WithOptions(options).Complete(reconciler)
 }
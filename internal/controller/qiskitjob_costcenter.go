@@ -0,0 +1,88 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+const (
+	// CostCenterLabel on a namespace names the cost center its jobs are
+	// charged to
+	CostCenterLabel = "quantum.io/cost-center"
+	// CostCentersAnnotation on a namespace lists, comma-separated, further
+	// cost centers its jobs may pick in spec.budget.costCenter
+	CostCentersAnnotation = "quantum.io/cost-centers"
+)
+
+// allowedCostCenter returns the cost center the job is charged to: the one
+// it asks for if its namespace allows it, otherwise the namespace's own
+func allowedCostCenter(namespace *corev1.Namespace, job *quantumv1.QiskitJob) string {
+	own := namespace.Labels[CostCenterLabel]
+	requested := costCenterRequested(job)
+	if requested == "" || requested == own {
+		return own
+	}
+	for _, center := range strings.Split(namespace.Annotations[CostCentersAnnotation], ",") {
+		if strings.TrimSpace(center) == requested {
+			return requested
+		}
+	}
+	return own
+}
+
+// recordCostCenter records the cost center the job's spend is charged to in
+// its status, so that users cannot charge cost centers their namespace's
+// administrators did not grant them. The status is written with the phase.
+func (r *QiskitJobReconciler) recordCostCenter(ctx context.Context, job *quantumv1.QiskitJob) error {
+	var namespace corev1.Namespace
+	if err := r.Get(ctx, client.ObjectKey{Name: job.Namespace}, &namespace); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	center := allowedCostCenter(&namespace, job)
+	if requested := costCenterRequested(job); requested != "" && requested != center {
+		log.FromContext(ctx).Info("Namespace does not allow the cost center, charging its own",
+			"requested", requested, "costCenter", center)
+	}
+	job.Status.CostCenter = center
+	return nil
+}
+
+// costCenterRequested returns the cost center the job asks to be charged to
+func costCenterRequested(job *quantumv1.QiskitJob) string {
+	if job.Spec.Budget == nil {
+		return ""
+	}
+	return job.Spec.Budget.CostCenter
+}
+
+// billingAccount returns the billing account the job's spend is billed to,
+// if it named one for the cost center it is charged to
+func billingAccount(job *quantumv1.QiskitJob) string {
+	requested := costCenterRequested(job)
+	if job.Spec.Budget == nil || requested != "" && requested != job.Status.CostCenter {
+		return ""
+	}
+	return job.Spec.Budget.BillingAccount
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/credentials"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/region"
+)
+
+// credentialsDir is where the job's credentials volume is mounted
+const credentialsDir = "/var/run/secrets/quantum/credentials"
+
+// secretsStoreDriver is the name of the Secrets Store CSI driver
+const secretsStoreDriver = "secrets-store.csi.k8s.io"
+
+// mountCredentials mounts the job's credentials volume, if it has one, into
+// the execution pod and tells the executor where to find it. The operator
+// never reads these credentials itself.
+func mountCredentials(pod *corev1.Pod, job *quantumv1.QiskitJob) {
+	source := credentialsVolumeSource(job.Spec.Credentials)
+	if source == nil {
+		return
+	}
+	container := &pod.Spec.Containers[0]
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{Name: "credentials", VolumeSource: *source})
+	container.VolumeMounts = append(container.VolumeMounts,
+		corev1.VolumeMount{Name: "credentials", MountPath: credentialsDir, ReadOnly: true})
+	container.Env = append(container.Env, corev1.EnvVar{Name: "QISKIT_CREDENTIALS_DIR", Value: credentialsDir})
+}
+
+// credentialsVolumeSource returns the volume the credentials are mounted
+// from, or nil if they are not mounted
+func credentialsVolumeSource(creds *quantumv1.CredentialsSpec) *corev1.VolumeSource {
+	switch {
+	case creds == nil:
+		return nil
+	case creds.SecretsStore != nil:
+		return &corev1.VolumeSource{CSI: &corev1.CSIVolumeSource{
+			Driver:               secretsStoreDriver,
+			ReadOnly:             ptr(true),
+			VolumeAttributes:     map[string]string{"secretProviderClass": creds.SecretsStore.SecretProviderClass},
+			NodePublishSecretRef: creds.SecretsStore.NodePublishSecretRef.DeepCopy(),
+		}}
+	case creds.Volume != nil:
+		source := &corev1.VolumeSource{CSI: creds.Volume.CSI.DeepCopy(), Projected: creds.Volume.Projected.DeepCopy()}
+		if source.CSI != nil {
+			// Credentials are only ever read
+			source.CSI.ReadOnly = ptr(true)
+		}
+		return source
+	}
+	return nil
+}
+
+// backendCredentials returns the credentials the operator authenticates the
+// job's backend with in the region: those at the job's Vault path if it
+// names one, otherwise the data of its Secret for the region, or nil if the
+// job has neither
+func (r *QiskitJobReconciler) backendCredentials(ctx context.Context, job *quantumv1.QiskitJob, jobRegion string) (map[string][]byte, error) {
+	if creds := job.Spec.Credentials; creds != nil && creds.VaultPath != "" {
+		if r.Vault == nil {
+			return nil, errors.New("spec.credentials.vaultPath needs the operator to be started with --vault-address")
+		}
+		return r.Vault.Fetch(ctx, credentials.Ref{Namespace: job.Namespace, Path: creds.VaultPath})
+	}
+	ref := region.Credentials(job.Spec.Credentials, jobRegion)
+	if ref == nil {
+		return nil, nil
+	}
+	if r.WithoutSecrets {
+		return nil, errors.New("credentials Secrets need Secret access, which the operator runs without")
+	}
+	namespace, err := backendref.SecretNamespace(ctx, r.Client, job, *ref)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.Secrets{Reader: r.Client}.Fetch(ctx, credentials.Ref{Namespace: namespace, Name: ref.Name})
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// executionLimit returns how long an attempt of the job starting now may
+// run: its maxExecutionTime, shortened to the time left before completeBy.
+// Zero means unlimited.
+func executionLimit(job *quantumv1.QiskitJob) time.Duration {
+	var limit time.Duration
+	if maxTime, err := time.ParseDuration(job.Spec.Execution.MaxExecutionTime); err == nil && maxTime > 0 {
+		limit = maxTime
+	}
+	if completeBy := job.Spec.Execution.CompleteBy; completeBy != nil {
+		left := max(time.Until(completeBy.Time), time.Second)
+		if limit == 0 || left < limit {
+			limit = left
+		}
+	}
+	return limit
+}
+
+// completeByPassed reports whether the job can no longer complete in time,
+// which no retry would change
+func completeByPassed(job *quantumv1.QiskitJob) bool {
+	completeBy := job.Spec.Execution.CompleteBy
+	return completeBy != nil && !time.Now().Before(completeBy.Time)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// DebugAnnotation set to "true" on a failed job recreates its execution pod
+// as a debug pod that sleeps instead of running the circuit, so users can
+// exec in and inspect the environment. Removing it deletes the debug pod.
+const DebugAnnotation = "quantum.io/debug"
+
+// DebugLabel marks debug pods
+const DebugLabel = "quantum.io/debug"
+
+// DefaultDebugPodLifetime is how long a debug pod stays up unless configured
+// otherwise
+const DefaultDebugPodLifetime = time.Hour
+
+// debugScriptEnv holds the execution script in debug pods, so it can be run
+// by hand with `sh -c "$EXECUTOR_SCRIPT"`
+const debugScriptEnv = "EXECUTOR_SCRIPT"
+
+// DebugPodName names the debug pod of a job
+func DebugPodName(job *quantumv1.QiskitJob) string {
+	return fmt.Sprintf("qiskit-job-%s-debug", job.Name)
+}
+
+// syncDebugPod creates the job's debug pod while the job asks for one and
+// deletes it once the job no longer does
+func (r *QiskitJobReconciler) syncDebugPod(ctx context.Context, job *quantumv1.QiskitJob) error {
+	var pod corev1.Pod
+	err := r.Get(ctx, client.ObjectKey{Namespace: ExecutionNamespace(job), Name: DebugPodName(job)}, &pod)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	if job.Annotations[DebugAnnotation] != "true" {
+		if !exists {
+			return nil
+		}
+		log.FromContext(ctx).Info("Deleting debug pod", "pod", pod.Name)
+		return client.IgnoreNotFound(r.Delete(ctx, &pod))
+	}
+	if exists {
+		return nil
+	}
+
+	debugPod, err := r.debugPod(ctx, job)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Creating debug pod", "pod", debugPod.Name)
+	if err := r.Create(ctx, debugPod); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// debugPod builds the job's execution pod with the same image, mounts and
+// environment, but sleeping for the debug pod lifetime instead of running
+// the circuit
+func (r *QiskitJobReconciler) debugPod(ctx context.Context, job *quantumv1.QiskitJob) (*corev1.Pod, error) {
+	pod, err := r.createExecutionPod(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	lifetime := r.DebugPodLifetime
+	if lifetime <= 0 {
+		lifetime = DefaultDebugPodLifetime
+	}
+	seconds := int64(lifetime.Seconds())
+
+	pod.Name = DebugPodName(job)
+	delete(pod.Labels, AttemptLabel)
+	pod.Labels[DebugLabel] = "true"
+	pod.Spec.ActiveDeadlineSeconds = &seconds
+
+	container := &pod.Spec.Containers[0]
+	container.Env = append(container.Env, corev1.EnvVar{Name: debugScriptEnv, Value: container.Command[len(container.Command)-1]})
+	container.Command = []string{"sleep", fmt.Sprintf("%d", seconds)}
+	container.Args = nil
+	return pod, nil
+}
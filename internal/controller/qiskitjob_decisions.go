@@ -0,0 +1,337 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// DecisionsPath is where the operator serves the decision traces of jobs,
+// next to its metrics
+const DecisionsPath = "/jobs/decisions"
+
+// DecisionsLabel marks the ConfigMaps decision traces are persisted in,
+// named <job>-decisions
+const DecisionsLabel = "quantum.io/decisions"
+
+// DecisionsKey is the key of a decision trace ConfigMap holding the trace
+const DecisionsKey = "decisions.json"
+
+// maxDecisionSteps is the most steps recorded for one reconcile
+const maxDecisionSteps = 32
+
+// Decision is what one reconcile of a job did and why
+type Decision struct {
+	Time time.Time `json:"time"`
+	// Phase the job was reconciled in
+	Phase quantumv1.QiskitJobPhase `json:"phase,omitempty"`
+	// Handler is the phase handler the reconcile got to, if any
+	Handler string `json:"handler,omitempty"`
+	// Steps are the branches the reconcile took, in order
+	Steps []string `json:"steps,omitempty"`
+	// NextPhase is the phase the reconcile moved the job to
+	NextPhase quantumv1.QiskitJobPhase `json:"nextPhase,omitempty"`
+	// Requeue is why the job was requeued, a reason of
+	// qiskit_operator_job_requeues_total
+	Requeue      string `json:"requeue,omitempty"`
+	RequeueAfter string `json:"requeueAfter,omitempty"`
+	// Error the reconcile or its phase handler failed with
+	Error string `json:"error,omitempty"`
+
+	uid types.UID
+}
+
+// decisionKey holds the decision of the current reconcile in its context
+type decisionKey struct{}
+
+// withDecision returns a context the reconcile's handlers trace their
+// decisions in
+func withDecision(ctx context.Context) (context.Context, *Decision) {
+	decision := &Decision{Time: time.Now()}
+	return context.WithValue(ctx, decisionKey{}, decision), decision
+}
+
+// decisionOf returns the decision traced in the context, nil outside of a
+// traced reconcile
+func decisionOf(ctx context.Context) *Decision {
+	decision, _ := ctx.Value(decisionKey{}).(*Decision)
+	return decision
+}
+
+// traceStep records a branch the reconcile took. Outside of a traced
+// reconcile it does nothing.
+func traceStep(ctx context.Context, format string, args ...any) {
+	decision := decisionOf(ctx)
+	switch {
+	case decision == nil:
+	case len(decision.Steps) < maxDecisionSteps:
+		decision.Steps = append(decision.Steps, fmt.Sprintf(format, args...))
+	case len(decision.Steps) == maxDecisionSteps:
+		decision.Steps = append(decision.Steps, "...")
+	}
+}
+
+// traceJob records which job, in which phase, the reconcile is about
+func traceJob(ctx context.Context, job *quantumv1.QiskitJob) {
+	if decision := decisionOf(ctx); decision != nil {
+		decision.uid = job.UID
+		decision.Phase = job.Status.Phase
+	}
+}
+
+// traceHandler records the phase handler the reconcile runs
+func traceHandler(ctx context.Context, handler string) {
+	if decision := decisionOf(ctx); decision != nil {
+		decision.Handler = handler
+	}
+}
+
+// traceOutcome records the phase the reconcile left the job in, and the
+// error its phase handler failed with
+func traceOutcome(ctx context.Context, job *quantumv1.QiskitJob, err error) {
+	decision := decisionOf(ctx)
+	if decision == nil {
+		return
+	}
+	if err != nil {
+		decision.Error = err.Error()
+	} else if job.Status.Phase != decision.Phase {
+		decision.NextPhase = job.Status.Phase
+	}
+}
+
+// finish records how the reconcile ended
+func (d *Decision) finish(reason string, result ctrl.Result, err error) {
+	d.Requeue = requeueReason(reason, result, err)
+	if result.RequeueAfter > 0 {
+		d.RequeueAfter = result.RequeueAfter.String()
+	}
+	if err != nil {
+		d.Error = err.Error()
+	}
+}
+
+// DecisionTraces keeps the decisions of the last reconciles of every job in
+// memory, and persists them in a ConfigMap owned by the job whenever its
+// phase changes, so why a job ended up where it did can be reconstructed
+// after it finished or the operator restarted, without debug logs
+type DecisionTraces struct {
+	// Size is how many reconciles are kept per job
+	Size int
+
+	// Client reads and writes the ConfigMaps traces are persisted in
+	Client client.Client
+	Scheme *runtime.Scheme
+
+	mu   sync.Mutex
+	jobs map[types.NamespacedName]*decisionRing
+}
+
+// decisionRing holds the last decisions of a job, oldest first
+type decisionRing struct {
+	uid       types.UID
+	decisions []Decision
+	// loaded is set once the decisions persisted by an earlier run of the
+	// operator were merged in
+	loaded bool
+}
+
+// record keeps the decision of a reconcile of the job, persisting the trace
+// if it changed the job's phase. The traces of deleted jobs are dropped.
+func (t *DecisionTraces) record(ctx context.Context, key types.NamespacedName, decision *Decision) {
+	if decision.uid == "" {
+		if decision.Error == "" {
+			// The job is gone, and its ConfigMap with it
+			t.mu.Lock()
+			delete(t.jobs, key)
+			t.mu.Unlock()
+		}
+		return
+	}
+
+	t.mu.Lock()
+	if t.jobs == nil {
+		t.jobs = map[types.NamespacedName]*decisionRing{}
+	}
+	ring := t.jobs[key]
+	if ring == nil || ring.uid != decision.uid {
+		ring = &decisionRing{uid: decision.uid}
+		t.jobs[key] = ring
+	}
+	ring.decisions = t.trim(append(ring.decisions, *decision))
+	loaded := ring.loaded
+	t.mu.Unlock()
+	if decision.NextPhase == "" {
+		return
+	}
+
+	// Keep what an earlier run of the operator persisted
+	var persisted []Decision
+	if !loaded {
+		var err error
+		if persisted, err = t.persisted(ctx, key, decision.uid); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to read persisted decision trace")
+			return
+		}
+	}
+	t.mu.Lock()
+	if !ring.loaded {
+		ring.decisions = t.trim(append(persisted, ring.decisions...))
+		ring.loaded = true
+	}
+	decisions := slices.Clone(ring.decisions)
+	t.mu.Unlock()
+
+	if err := t.persist(ctx, key, decision.uid, decisions); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to persist decision trace")
+	}
+}
+
+// trim drops the oldest decisions beyond Size
+func (t *DecisionTraces) trim(decisions []Decision) []Decision {
+	if extra := len(decisions) - t.Size; extra > 0 {
+		return slices.Delete(decisions, 0, extra)
+	}
+	return decisions
+}
+
+// persist writes the trace to the ConfigMap of the job with the UID
+func (t *DecisionTraces) persist(ctx context.Context, key types.NamespacedName, uid types.UID, decisions []Decision) error {
+	var job quantumv1.QiskitJob
+	if err := t.Client.Get(ctx, key, &job); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if job.UID != uid {
+		return nil
+	}
+	data, err := json.Marshal(decisions)
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: decisionsName(key.Name), Namespace: key.Namespace}}
+	_, err = controllerutil.CreateOrUpdate(ctx, t.Client, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[results.JobLabel] = key.Name
+		cm.Labels[DecisionsLabel] = "true"
+		cm.Data = map[string]string{DecisionsKey: string(data)}
+		return controllerutil.SetControllerReference(&job, cm, t.Scheme)
+	})
+	return err
+}
+
+// persisted returns the decisions persisted for the job with the UID
+func (t *DecisionTraces) persisted(ctx context.Context, key types.NamespacedName, uid types.UID) ([]Decision, error) {
+	var cm corev1.ConfigMap
+	err := t.Client.Get(ctx, types.NamespacedName{Namespace: key.Namespace, Name: decisionsName(key.Name)}, &cm)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if owner := metav1.GetControllerOf(&cm); owner == nil || (uid != "" && owner.UID != uid) {
+		// Left over from an earlier job of the same name
+		return nil, nil
+	}
+	var decisions []Decision
+	if err := json.Unmarshal([]byte(cm.Data[DecisionsKey]), &decisions); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", cm.Name, err)
+	}
+	return decisions, nil
+}
+
+// Decisions returns the trace of the job's last reconciles, oldest first:
+// those in memory, after those persisted by an earlier run of the operator
+func (t *DecisionTraces) Decisions(ctx context.Context, key types.NamespacedName) ([]Decision, error) {
+	t.mu.Lock()
+	var uid types.UID
+	var decisions []Decision
+	loaded := false
+	if ring := t.jobs[key]; ring != nil {
+		uid, loaded = ring.uid, ring.loaded
+		decisions = slices.Clone(ring.decisions)
+	}
+	t.mu.Unlock()
+
+	if loaded && len(decisions) > 0 {
+		return decisions, nil
+	}
+	persisted, err := t.persisted(ctx, key, uid)
+	if err != nil {
+		return nil, err
+	}
+	return t.trim(append(persisted, decisions...)), nil
+}
+
+// decisionsName is the name of the ConfigMap the job's trace is persisted in
+func decisionsName(job string) string {
+	return job + "-decisions"
+}
+
+// ServeHTTP serves the decision trace of a job as JSON:
+//
+//	GET /jobs/decisions?namespace=<ns>&name=<job>
+func (t *DecisionTraces) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	query := req.URL.Query()
+	key := types.NamespacedName{Namespace: query.Get("namespace"), Name: query.Get("name")}
+	if key.Namespace == "" || key.Name == "" {
+		http.Error(w, "namespace and name are required", http.StatusBadRequest)
+		return
+	}
+	decisions, err := t.Decisions(req.Context(), key)
+	if err != nil {
+		log.FromContext(req.Context()).Error(err, "Failed to read decision trace", "job", key)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(decisions) == 0 {
+		http.Error(w, fmt.Sprintf("no decisions traced for %s", key), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"namespace": key.Namespace,
+		"name":      key.Name,
+		"decisions": decisions,
+	})
+}
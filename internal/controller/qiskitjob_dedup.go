@@ -0,0 +1,275 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// Deduplication policies
+const (
+	DedupPolicyWarn   = "warn"
+	DedupPolicyLink   = "link"
+	DedupPolicyDedupe = "dedupe"
+)
+
+// defaultDedupWindow is used when a job enables deduplication without a window
+const defaultDedupWindow = 10 * time.Minute
+
+// circuitHash returns a stable hash of the circuit. Circuits whose code the
+// operator reads (inline, configmap and url sources) are hashed by the code
+// they run, so a ConfigMap edited between two jobs tells them apart; other
+// sources are hashed by their definition.
+func circuitHash(circuit quantumv1.CircuitSpec, code string) string {
+	if code != "" {
+		circuit.Source, circuit.Code = "", code
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "source=%s\n", circuit.Source)
+	switch circuit.Source {
+	case "configmap":
+		if circuit.ConfigMapRef != nil {
+			fmt.Fprintf(h, "configmap=%s/%s\n", circuit.ConfigMapRef.Name, circuit.ConfigMapRef.Key)
+		}
+	case "url":
+		fmt.Fprintf(h, "url=%s\n", circuit.URL)
+		if circuit.SHA256 != "" {
+			fmt.Fprintf(h, "sha256=%s\n", circuit.SHA256)
+		}
+	case "git":
+		if circuit.GitRef != nil {
+			fmt.Fprintf(h, "git=%s@%s:%s\n", circuit.GitRef.Repository, circuit.GitRef.Branch, circuit.GitRef.Path)
+		}
+	case "bundle":
+		if b := circuit.Bundle; b != nil {
+			if b.ConfigMapRef != nil {
+				fmt.Fprintf(h, "configmap=%s/%s\n", b.ConfigMapRef.Name, b.ConfigMapRef.Key)
+			}
+			fmt.Fprintf(h, "image=%s:%s\nurl=%s\nsha256=%s\n", b.Image, b.Path, b.URL, b.SHA256)
+			fmt.Fprintf(h, "requirements=%s\n", b.Requirements)
+		}
+	default:
+		fmt.Fprintf(h, "code=%s\n", circuit.Code)
+	}
+	if circuit.Format != "" && circuit.Format != "python" {
+		fmt.Fprintf(h, "format=%s\n", circuit.Format)
+	}
+	if circuit.Entrypoint != "" || len(circuit.Args) > 0 {
+		fmt.Fprintf(h, "entrypoint=%s\nargs=%q\n", circuit.Entrypoint, circuit.Args)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// effectiveShots returns the number of shots a job will execute
+func effectiveShots(job *quantumv1.QiskitJob) int {
+	if job.Spec.Execution.Shots > 0 {
+		return job.Spec.Execution.Shots
+	}
+	return defaults.Shots
+}
+
+// circuitPinned reports whether the hash of the job's circuit pins the code
+// it runs. Git branches move and bundles are only pinned by their digest,
+// so jobs running them are never duplicates.
+func circuitPinned(job *quantumv1.QiskitJob) bool {
+	circuit := &job.Spec.Circuit
+	switch circuit.Source {
+	case "git":
+		return false
+	case "bundle":
+		b := circuit.Bundle
+		return b != nil && b.ConfigMapRef == nil && (b.SHA256 != "" || strings.Contains(b.Image, "@sha256:"))
+	}
+	return true
+}
+
+// executionSpec returns the parts of the job's spec that decide what it runs
+// and how, with defaults applied, so jobs differing only in where their
+// results go, when they are scheduled or how they are retried compare equal.
+// The circuit is compared by its hash.
+func executionSpec(job *quantumv1.QiskitJob) quantumv1.QiskitJobSpec {
+	spec := job.Spec.DeepCopy()
+	execution := spec.Execution
+	if execution.OptimizationLevel <= 0 {
+		execution.OptimizationLevel = defaults.OptimizationLevel
+	}
+	return quantumv1.QiskitJobSpec{
+		TemplateRef: spec.TemplateRef,
+		Overrides:   spec.Overrides,
+		Backend:     spec.Backend,
+		BackendRef:  spec.BackendRef,
+		Inputs:      spec.Inputs,
+		Execution: quantumv1.ExecutionSpec{
+			Shots:             effectiveShots(job),
+			OptimizationLevel: execution.OptimizationLevel,
+			ResilienceLevel:   execution.ResilienceLevel,
+			DisableFallback:   execution.DisableFallback,
+			QiskitVersion:     execution.QiskitVersion,
+			ExtraPackages:     execution.ExtraPackages,
+			Image:             execution.Image,
+			Env:               execution.Env,
+			EnvFrom:           execution.EnvFrom,
+			Primitive:         defaults.Primitive(&job.Spec),
+			Observables:       execution.Observables,
+			Transpiler:        execution.Transpiler,
+			ErrorMitigation:   execution.ErrorMitigation,
+		},
+		Shadow:           spec.Shadow,
+		Verify:           spec.Verify,
+		Optimizer:        spec.Optimizer,
+		Estimator:        spec.Estimator,
+		Sweep:            spec.Sweep,
+		Tomography:       spec.Tomography,
+		Split:            spec.Split,
+		Credentials:      spec.Credentials,
+		BackendSelection: spec.BackendSelection,
+	}
+}
+
+// isDuplicateOf reports whether job is an identical resubmission of other:
+// the same circuit code, run the same way
+func isDuplicateOf(job, other *quantumv1.QiskitJob, window time.Duration) bool {
+	if other.UID == job.UID || other.Status.CircuitMetadata == nil || job.Status.CircuitMetadata == nil {
+		return false
+	}
+	if !circuitPinned(job) || !circuitPinned(other) {
+		return false
+	}
+	if other.Status.Phase == PhaseFailed || other.Status.Phase == PhaseCancelled {
+		return false
+	}
+
+	// Only earlier jobs inside the window count; the original is never a duplicate of its copies
+	age := job.CreationTimestamp.Sub(other.CreationTimestamp.Time)
+	if age < 0 || age > window || (age == 0 && other.Name > job.Name) {
+		return false
+	}
+
+	return other.Status.CircuitMetadata.Hash == job.Status.CircuitMetadata.Hash &&
+		equality.Semantic.DeepEqual(executionSpec(other), executionSpec(job))
+}
+
+// findDuplicate returns the earliest identical job in the same namespace, if any
+func (r *QiskitJobReconciler) findDuplicate(ctx context.Context, job *quantumv1.QiskitJob) (*quantumv1.QiskitJob, error) {
+	window := defaultDedupWindow
+	if job.Spec.Deduplication.Window != "" {
+		d, err := time.ParseDuration(job.Spec.Deduplication.Window)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deduplication window %q: %w", job.Spec.Deduplication.Window, err)
+		}
+		window = d
+	}
+
+	var original *quantumv1.QiskitJob
+	err := eachJob(ctx, r.Client, r.Jobs, func(other *quantumv1.QiskitJob) error {
+		if isDuplicateOf(job, other, window) &&
+			(original == nil || other.CreationTimestamp.Before(&original.CreationTimestamp)) {
+			original = other.DeepCopy()
+		}
+		return nil
+	}, client.InNamespace(job.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	return original, nil
+}
+
+// checkDuplicate applies the job's deduplication policy. It returns a note to
+// append to the phase message, and a non-nil result when the reconcile should
+// stop here because the job was resolved from, or is waiting on, its original.
+func (r *QiskitJobReconciler) checkDuplicate(ctx context.Context, job *quantumv1.QiskitJob) (string, *ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if job.Spec.Deduplication == nil {
+		return "", nil, nil
+	}
+
+	original, err := r.findDuplicate(ctx, job)
+	if err != nil {
+		return "", nil, err
+	}
+	if original == nil {
+		return "", nil, nil
+	}
+
+	// Chains of duplicates always point at the root submission
+	originalName := original.Name
+	if original.Status.DuplicateOf != "" {
+		originalName = original.Status.DuplicateOf
+	}
+
+	policy := job.Spec.Deduplication.Policy
+	if policy == "" {
+		policy = DedupPolicyWarn
+	}
+	logger.Info("Duplicate submission detected", "original", originalName, "policy", policy)
+
+	switch policy {
+	case DedupPolicyLink:
+		job.Status.DuplicateOf = originalName
+		return fmt.Sprintf("duplicate of job %s", originalName), nil, nil
+
+	case DedupPolicyDedupe:
+		var root quantumv1.QiskitJob
+		if err := getJob(ctx, r.Client, r.Jobs, types.NamespacedName{Name: originalName, Namespace: job.Namespace}, &root); err != nil {
+			return "", nil, err
+		}
+		switch root.Status.Phase {
+		case PhaseCompleted:
+			now := metav1.Now()
+			job.Status.DuplicateOf = originalName
+			job.Status.CompletionTime = &now
+			job.Status.SelectedBackend = root.Status.SelectedBackend
+			job.Status.BackendInfo = root.Status.BackendInfo.DeepCopy()
+			job.Status.Results = root.Status.Results.DeepCopy()
+			job.Status.JobID = root.Status.JobID
+			job.Status.EstimatedCost = "$0.00"
+			job.Status.ActualCost = "$0.00"
+			result, err := r.updateJobPhase(ctx, job, PhaseCompleted,
+				fmt.Sprintf("Deduplicated: results reused from job %s", originalName))
+			return "", &result, err
+		case PhaseFailed, PhaseCancelled:
+			// The original produced no results, so this job executes normally
+			return fmt.Sprintf("original job %s did not complete, executing duplicate", originalName), nil, nil
+		default:
+			job.Status.DuplicateOf = originalName
+			job.Status.Message = fmt.Sprintf("Duplicate of job %s, waiting for its results", originalName)
+			if err := r.Status().Update(ctx, job); err != nil {
+				return "", nil, err
+			}
+			return "", &ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+
+	default:
+		return fmt.Sprintf("warning: identical to job %s submitted within the deduplication window", originalName), nil, nil
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// PendingDemand sums, per namespace and backend type, the executor requests
+// of jobs that run in an execution pod and are waiting for it: jobs that
+// have not started yet, unless a calendar or execution window holds them,
+// and jobs whose execution pod has not been scheduled to a node. Jobs of
+// remote backends never need a node and are left out.
+func PendingDemand(ctx context.Context, c client.Reader) ([]metrics.Demand, error) {
+	var jobs quantumv1.QiskitJobList
+	if err := c.List(ctx, &jobs); err != nil {
+		return nil, err
+	}
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.HasLabels{"quantum.io/job"}); err != nil {
+		return nil, err
+	}
+	// Pods that failed are being replaced by their Job and do not count
+	scheduled := map[attemptKey]bool{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		key, ok := podAttemptKey(pod)
+		if !ok || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		scheduled[key] = scheduled[key] || pod.Spec.NodeName != "" || pod.Status.Phase != corev1.PodPending
+	}
+
+	now := time.Now()
+	byKey := map[[2]string]*metrics.Demand{}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if remote(job) || dispatched(job) || !awaitingNode(job, scheduled, now) {
+			continue
+		}
+		key := [2]string{job.Namespace, job.Spec.Backend.Type}
+		d, ok := byKey[key]
+		if !ok {
+			d = &metrics.Demand{Namespace: job.Namespace, BackendType: job.Spec.Backend.Type}
+			byKey[key] = d
+		}
+		requests := executorResources(job).Requests
+		d.Jobs++
+		d.CPU.Add(requests[corev1.ResourceCPU])
+		d.Memory.Add(requests[corev1.ResourceMemory])
+		d.GPU.Add(requests[GPUResource])
+	}
+
+	demand := make([]metrics.Demand, 0, len(byKey))
+	for _, d := range byKey {
+		demand = append(demand, *d)
+	}
+	sort.Slice(demand, func(i, j int) bool {
+		if demand[i].Namespace != demand[j].Namespace {
+			return demand[i].Namespace < demand[j].Namespace
+		}
+		return demand[i].BackendType < demand[j].BackendType
+	})
+	return demand, nil
+}
+
+// awaitingNode reports whether the job will need a node for its execution
+// pod without anything but capacity holding it back
+func awaitingNode(job *quantumv1.QiskitJob, scheduled map[attemptKey]bool, now time.Time) bool {
+	switch job.Status.Phase {
+	case "", PhasePending, PhaseValidating, PhaseScheduling, PhaseRetrying:
+		return job.Status.NextEligibleTime == nil || !job.Status.NextEligibleTime.After(now)
+	case PhaseRunning:
+		return !scheduled[currentAttemptKey(job)]
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// ReasonDiagnosticsArchived is recorded when the diagnostics of a failed
+// attempt were archived before it was retried
+const ReasonDiagnosticsArchived = "DiagnosticsArchived"
+
+// diagnosticsLogBytes is how much of the end of a failed attempt's executor
+// logs its diagnostics keep
+const diagnosticsLogBytes = 256 * 1024
+
+// preservesDiagnostics reports whether the job's retry policy archives the
+// diagnostics of failed attempts
+func preservesDiagnostics(job *quantumv1.QiskitJob) bool {
+	return job.Spec.RetryPolicy != nil && job.Spec.RetryPolicy.PreserveDiagnostics
+}
+
+// clearFailedAttempt is called as the job's current attempt failed and is
+// about to be retried. It archives the attempt's diagnostics if the retry
+// policy asks for them, then deletes the execution of jobs with scratch
+// space, so the scratch volume and whatever the attempt left in it go before
+// the retry starts on fresh ones rather than when the execution's TTL runs
+// out. Attempts whose diagnostics could not be archived are kept.
+func (r *QiskitJobReconciler) clearFailedAttempt(ctx context.Context, job *quantumv1.QiskitJob) error {
+	logger := log.FromContext(ctx)
+	name := currentExecutionName(job)
+	failed, err := r.namedExecution(ctx, job, name)
+	if err != nil || failed == nil {
+		return err
+	}
+
+	if preservesDiagnostics(job) {
+		if r.Archive == nil {
+			logger.Info("Keeping failed attempt, no archive is configured for its diagnostics", "execution", name)
+			return nil
+		}
+		key := results.DiagnosticsKey(job, attempt(job))
+		if !slices.Contains(job.Status.AttemptDiagnostics, r.Archive.Location(key)) {
+			location, err := results.ArchiveDiagnostics(ctx, r.Archive, job, attempt(job), failed.pod,
+				r.namedExecutionLogs(ctx, job, name), diagnosticsLogBytes)
+			if err != nil {
+				logger.Error(err, "Failed to archive diagnostics, keeping failed attempt", "execution", name)
+				return nil
+			}
+			job.Status.AttemptDiagnostics = append(job.Status.AttemptDiagnostics, location)
+			r.event(job, corev1.EventTypeNormal, ReasonDiagnosticsArchived,
+				fmt.Sprintf("Archived diagnostics of attempt %d to %s", attempt(job), location))
+		}
+	}
+
+	if job.Spec.Execution.Scratch == nil {
+		return nil
+	}
+	logger.Info("Deleting failed attempt and its scratch space", "execution", name)
+	var execution client.Object = &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ExecutionNamespace(job)}}
+	if failed.pod != nil && failed.pod.Labels[batchv1.JobNameLabel] == "" {
+		// Attempts started before executions ran as Jobs ran in a bare pod
+		execution = failed.pod
+	}
+	err = r.Delete(ctx, execution, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	return client.IgnoreNotFound(err)
+}
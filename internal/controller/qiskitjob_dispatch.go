@@ -0,0 +1,155 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/dispatch"
+)
+
+// dispatchPollInterval is how often the status of a dispatched job's copy is
+// mirrored
+const dispatchPollInterval = 15 * time.Second
+
+// dispatched reports whether the job runs in a spoke cluster
+func dispatched(job *quantumv1.QiskitJob) bool {
+	return job.Spec.Placement != nil && job.Spec.Placement.Cluster != ""
+}
+
+// dispatchJob hands a pending job to its spoke cluster. The spoke validates,
+// schedules and runs it, so the hub skips those phases and waits in Running.
+func (r *QiskitJobReconciler) dispatchJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	cluster := job.Spec.Placement.Cluster
+	spoke, ok := r.Spokes[cluster]
+	if !ok {
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Cluster %s is not a configured spoke", cluster))
+	}
+	if err := spoke.Dispatch(ctx, job); err != nil {
+		return ctrl.Result{}, fmt.Errorf("dispatching to cluster %s: %w", cluster, err)
+	}
+	log.FromContext(ctx).Info("Dispatched job", "cluster", cluster)
+	job.Status.Cluster = cluster
+	job.Status.JobID = job.Name
+	return r.updateJobPhase(ctx, job, PhaseRunning, fmt.Sprintf("Dispatched to cluster %s", cluster))
+}
+
+// handleDispatchedJob mirrors the status of a dispatched job's copy until it
+// finishes. Copies are retried by their spoke, so the hub job finishes with
+// the copy's last attempt.
+func (r *QiskitJobReconciler) handleDispatchedJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	cluster := job.Status.Cluster
+	spoke, ok := r.Spokes[cluster]
+	if !ok {
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Cluster %s is no longer a configured spoke", cluster))
+	}
+	remote, err := spoke.Status(ctx, job)
+	if errors.Is(err, dispatch.ErrNotDispatched) {
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Job no longer exists in cluster %s", cluster))
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if remote == nil {
+		requeueBecause(ctx, RequeueBackendQueue)
+		return ctrl.Result{RequeueAfter: dispatchPollInterval}, nil
+	}
+
+	mirrorStatus(job, remote)
+	message := fmt.Sprintf("%s in cluster %s", remote.Phase, cluster)
+	if remote.Message != "" {
+		message += ": " + remote.Message
+	}
+	switch {
+	case remote.Phase == PhaseCompleted, remote.Phase == PhaseCancelled,
+		remote.Phase == PhaseFailed && (remote.RetryCount >= retryLimit(job) || verificationFailed(job) || providerRejected(job)):
+		if job.Status.CompletionTime == nil {
+			now := metav1.Now()
+			job.Status.CompletionTime = &now
+		}
+		return r.updateJobPhase(ctx, job, remote.Phase, message)
+	}
+	if job.Status.Message != message {
+		job.Status.Message = message
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	requeueBecause(ctx, RequeueBackendQueue)
+	return ctrl.Result{RequeueAfter: dispatchPollInterval}, nil
+}
+
+// mirrorStatus copies what the spoke reported about the job's copy onto the
+// hub job, keeping what it left out
+func mirrorStatus(job *quantumv1.QiskitJob, remote *quantumv1.QiskitJobStatus) {
+	if remote.SelectedBackend != "" {
+		job.Status.SelectedBackend = remote.SelectedBackend
+	}
+	if remote.Region != "" {
+		job.Status.Region = remote.Region
+	}
+	if remote.QiskitVersion != "" {
+		job.Status.QiskitVersion = remote.QiskitVersion
+	}
+	if remote.EstimatedCost != "" {
+		job.Status.EstimatedCost = remote.EstimatedCost
+	}
+	if remote.ActualCost != "" {
+		job.Status.ActualCost = remote.ActualCost
+	}
+	if remote.TrackingURL != "" {
+		job.Status.TrackingURL = remote.TrackingURL
+	}
+	if remote.CircuitMetadata != nil {
+		job.Status.CircuitMetadata = remote.CircuitMetadata
+	}
+	if remote.Results != nil {
+		job.Status.Results = remote.Results
+	}
+	if remote.Verification != nil {
+		job.Status.Verification = remote.Verification
+	}
+	if remote.CompletionTime != nil {
+		job.Status.CompletionTime = remote.CompletionTime
+	}
+	if rejected := meta.FindStatusCondition(remote.Conditions, ConditionProviderRejected); rejected != nil {
+		meta.SetStatusCondition(&job.Status.Conditions, *rejected)
+	}
+}
+
+// withdrawJob deletes a dispatched job's copy from its spoke. Copies in
+// spokes that are no longer configured are left behind.
+func (r *QiskitJobReconciler) withdrawJob(ctx context.Context, job *quantumv1.QiskitJob) error {
+	if job.Status.Cluster == "" {
+		return nil
+	}
+	spoke, ok := r.Spokes[job.Status.Cluster]
+	if !ok {
+		log.FromContext(ctx).Info("Leaving job copy behind in unconfigured spoke", "cluster", job.Status.Cluster)
+		return nil
+	}
+	return spoke.Withdraw(ctx, job)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// Reasons of the events recorded for resources of a job that were deleted
+// or modified behind the operator's back
+const (
+	// ReasonExecutionDeleted is recorded when the execution of the current
+	// attempt disappeared
+	ReasonExecutionDeleted = "ExecutionDeleted"
+	// ReasonResultsRestored is recorded when a results ConfigMap was put
+	// back the way it was exported
+	ReasonResultsRestored = "ResultsRestored"
+	// ReasonResultsLost is recorded when a results ConfigMap drifted and
+	// nothing was left to restore it from
+	ReasonResultsLost = "ResultsLost"
+)
+
+// executionSucceeded reports whether the execution of the current attempt
+// was last seen to have succeeded
+func executionSucceeded(job *quantumv1.QiskitJob) bool {
+	condition := meta.FindStatusCondition(job.Status.Conditions, ConditionPodReady)
+	return condition != nil && condition.Reason == "PodSucceeded"
+}
+
+// failDeletedExecution fails the attempt whose execution was deleted after
+// it succeeded: its results went with it, and running the circuit again is
+// left to the retry policy rather than done behind its back
+func (r *QiskitJobReconciler) failDeletedExecution(ctx context.Context, job *quantumv1.QiskitJob, name string) (ctrl.Result, error) {
+	message := fmt.Sprintf("Execution %s was deleted after it succeeded, before its results were collected", name)
+	log.FromContext(ctx).Info(message)
+	traceStep(ctx, "%s", message)
+	metrics.JobDrift.WithLabelValues("execution", "failed").Inc()
+	r.event(job, corev1.EventTypeWarning, ReasonExecutionDeleted, message)
+	return r.updateJobPhase(ctx, job, PhaseFailed, message)
+}
+
+// recordRecreatedExecution reports that the execution of the current attempt
+// was recreated after it was deleted while pending or running
+func (r *QiskitJobReconciler) recordRecreatedExecution(ctx context.Context, job *quantumv1.QiskitJob, name string) {
+	log.FromContext(ctx).Info("Recreated deleted execution", "job", name)
+	traceStep(ctx, "Recreated deleted execution %s", name)
+	metrics.JobDrift.WithLabelValues("execution", "recreated").Inc()
+	r.event(job, corev1.EventTypeWarning, ReasonExecutionDeleted,
+		fmt.Sprintf("Execution %s was deleted before it finished and has been recreated", name))
+}
+
+// repairResults checks that the results ConfigMaps of a completed job still
+// hold the results it recorded, and puts back those that were deleted or
+// modified from a copy of the recorded results: another output, or the
+// logs of the execution if it is still around. Outputs that cannot be
+// restored are marked failed, so they are only reported once.
+func (r *QiskitJobReconciler) repairResults(ctx context.Context, job *quantumv1.QiskitJob) error {
+	changed := false
+	for i := range job.Status.Outputs {
+		status := &job.Status.Outputs[i]
+		output := specOutput(job, status.Name)
+		if status.Type != "configmap" || status.State != quantumv1.OutputExported || output == nil || output.Location == "" {
+			continue
+		}
+		doc, err := results.Read(ctx, r.Client, job.Namespace, output.Location)
+		if err == nil && recordedResults(job, doc) {
+			continue
+		}
+		if apiError(err) && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		source, doc := r.recordedDocument(ctx, job, status.Name)
+		if doc == nil {
+			message := fmt.Sprintf("results ConfigMap %s was deleted or modified and no copy of the results is left to restore it from",
+				output.Location)
+			log.FromContext(ctx).Info(message)
+			traceStep(ctx, "Output %s failed: %s", status.Name, message)
+			metrics.JobDrift.WithLabelValues("configmap", "failed").Inc()
+			r.event(job, corev1.EventTypeWarning, ReasonResultsLost, "The "+message)
+			status.State = quantumv1.OutputFailed
+			status.Message = message
+			changed = true
+			continue
+		}
+		if err := results.ExportConfigMap(ctx, r.Client, r.Scheme, job, output, doc); err != nil {
+			return fmt.Errorf("failed to restore results ConfigMap %s: %w", output.Location, err)
+		}
+		traceStep(ctx, "Restored results ConfigMap %s from %s", output.Location, source)
+		metrics.JobDrift.WithLabelValues("configmap", "restored").Inc()
+		r.event(job, corev1.EventTypeNormal, ReasonResultsRestored,
+			fmt.Sprintf("Restored results ConfigMap %s from %s", output.Location, source))
+	}
+	if !changed {
+		return nil
+	}
+	recordOutputs(job, job.Status.Outputs)
+	return r.Status().Update(ctx, job)
+}
+
+// recordedDocument returns a copy of the results the job recorded and where
+// it was found, skipping the named output: the document of another output
+// it was exported to, or one rebuilt from the execution's logs
+func (r *QiskitJobReconciler) recordedDocument(ctx context.Context, job *quantumv1.QiskitJob, skip string) (string, *results.Document) {
+	for _, status := range job.Status.Outputs {
+		output := specOutput(job, status.Name)
+		if status.Name == skip || status.State != quantumv1.OutputExported || output == nil {
+			continue
+		}
+		driver := results.Driver(output.Type)
+		if driver == nil || driver.Mounted || (len(driver.SecretKeys) > 0 && r.WithoutSecrets) {
+			continue
+		}
+		doc, err := results.ReadOutput(ctx, r.Client, job, output)
+		if err == nil && recordedResults(job, doc) {
+			return "output " + status.Name, doc
+		}
+	}
+
+	// Sweeps, tomography and split jobs gather their results from several
+	// executions
+	if job.Spec.Sweep != nil || job.Spec.Tomography != nil || job.Spec.Split != nil {
+		return "", nil
+	}
+	logs := r.executionLogs(ctx, job)
+	counts, _ := results.ParseCounts(logs)
+	doc := results.NewDocument(job, counts)
+	if estimation, ok := results.ParseEstimation(logs); ok {
+		doc.Estimation = estimation
+	}
+	if (counts == nil && doc.Estimation == nil) || !recordedResults(job, doc) {
+		return "", nil
+	}
+	return "the logs of execution " + job.Status.JobID, doc
+}
+
+// recordedResults reports whether a results document holds the results the
+// job recorded: the document with the recorded digest if the results were
+// signed, otherwise one agreeing with the job's results summary
+func recordedResults(job *quantumv1.QiskitJob, doc *results.Document) bool {
+	if doc == nil || doc.JobName != job.Name || doc.JobID != job.Status.JobID {
+		return false
+	}
+	info := job.Status.Results
+	if info == nil {
+		return true
+	}
+	if info.Digest != "" {
+		digest, err := results.Digest(doc)
+		return err == nil && digest == info.Digest
+	}
+	summary := results.NewInfo(job, doc.Results.Counts, 0)
+	return summary.Shots == info.Shots && summary.Outcomes == info.Outcomes &&
+		summary.MostLikelyOutcome == info.MostLikelyOutcome
+}
+
+// specOutput returns the output of the job's spec reported under name in
+// its status, nil if there is none
+func specOutput(job *quantumv1.QiskitJob, name string) *quantumv1.OutputSpec {
+	for i := range job.Spec.Outputs {
+		if results.OutputName(&job.Spec.Outputs[i]) == name {
+			return &job.Spec.Outputs[i]
+		}
+	}
+	return nil
+}
+
+// apiError reports whether the error came from the API server, rather than
+// from decoding what it returned
+func apiError(err error) bool {
+	var status apierrors.APIStatus
+	return errors.As(err, &status)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/validation"
+)
+
+// injectEnv passes the job's own environment variables to the executor. They
+// are validated not to collide with the operator's.
+func injectEnv(pod *corev1.Pod, job *quantumv1.QiskitJob) {
+	container := &pod.Spec.Containers[0]
+	for _, env := range job.Spec.Execution.Env {
+		container.Env = append(container.Env, *env.DeepCopy())
+	}
+	for _, source := range job.Spec.Execution.EnvFrom {
+		container.EnvFrom = append(container.EnvFrom, *source.DeepCopy())
+	}
+}
+
+// envFromInvalid reports why the job's envFrom sources cannot be used, or ""
+// if they can. The webhook cannot see their keys, so a ConfigMap or Secret
+// key that becomes a reserved variable is caught here. Secrets are not read
+// when the operator has no access to them.
+func (r *QiskitJobReconciler) envFromInvalid(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	for _, source := range job.Spec.Execution.EnvFrom {
+		var (
+			obj      client.Object
+			kind     string
+			name     string
+			optional *bool
+		)
+		switch {
+		case source.ConfigMapRef != nil:
+			obj, kind, name, optional = &corev1.ConfigMap{}, "ConfigMap", source.ConfigMapRef.Name, source.ConfigMapRef.Optional
+		case source.SecretRef != nil && !r.WithoutSecrets:
+			obj, kind, name, optional = &corev1.Secret{}, "Secret", source.SecretRef.Name, source.SecretRef.Optional
+		default:
+			continue
+		}
+
+		err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: job.Namespace}, obj)
+		if errors.IsNotFound(err) {
+			if optional != nil && *optional {
+				continue
+			}
+			return fmt.Sprintf("%s %s for envFrom not found", kind, name), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, key := range envKeys(obj) {
+			if validation.ReservedEnv(source.Prefix + key) {
+				return fmt.Sprintf("%s %s for envFrom sets %s, which is reserved by the operator",
+					kind, name, source.Prefix+key), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// envKeys returns the sorted keys of a ConfigMap or Secret
+func envKeys(obj client.Object) []string {
+	var keys []string
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		for key := range o.Data {
+			keys = append(keys, key)
+		}
+		for key := range o.BinaryData {
+			keys = append(keys, key)
+		}
+	case *corev1.Secret:
+		for key := range o.Data {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
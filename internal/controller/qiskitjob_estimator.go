@@ -0,0 +1,167 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// DefaultEstimatorMaxObservables is the most observables a single Estimator
+// call estimates unless the job sets maxObservablesPerCall
+const DefaultEstimatorMaxObservables = 100
+
+// estimatorEpilogue estimates the expectation value of each of the
+// observables the job's spec lists, or else the circuit code defines for qc,
+// a list or a dict naming them.
+// The observables are split into batches of ESTIMATOR_MAX_OBSERVABLES, each
+// estimated by its own call, with the StatevectorEstimator, or for
+// ibm_local_testing with the runtime Estimator in a session on the fake
+// backend, where the transpiled circuit is left in _isa for the transpiled
+// circuit's publisher and the job's dynamical decoupling applied. Every call is reported as progress, and the merged
+// values on a single JSON log line.
+const estimatorEpilogue = transpilerOptions + errorSuppression + `
+
+# Estimator: estimate the expectation value of every observable for qc, in batches
+import json as _json
+import os as _os
+import sys as _sys
+import numpy as _np
+from qiskit.quantum_info import SparsePauliOp as _EstOperator
+if _os.path.exists('` + observablesFile + `'):
+    with open('` + observablesFile + `') as _est_file:
+        _est_observables = {_o['name']: _EstOperator.from_list([(_t['pauli'], _t['coefficient']) for _t in _o['terms']]) for _o in _json.load(_est_file)}
+else:
+    _est_observables = globals().get('observables')
+if _est_observables is None:
+    _sys.exit('the estimator needs spec.execution.observables or the circuit code to define observables, e.g. a list of SparsePauliOp')
+_est_names = None
+if isinstance(_est_observables, dict):
+    _est_names = [str(_name) for _name in _est_observables]
+    _est_observables = list(_est_observables.values())
+_est_observables = [_EstOperator(_o) for _o in _est_observables]
+if not _est_observables:
+    _sys.exit('the estimator needs at least one observable')
+_est_batch = int(_os.environ['ESTIMATOR_MAX_OBSERVABLES'])
+_est_calls = (len(_est_observables) + _est_batch - 1) // _est_batch
+_est_session = None
+if _os.environ.get('BACKEND_NAME'):
+    from qiskit_ibm_runtime import EstimatorV2 as _Estimator, Session as _EstSession
+    from qiskit_ibm_runtime.fake_provider import FakeProviderForBackendV2 as _EstFakeProvider
+    _est_backend = _EstFakeProvider().backend(_os.environ['BACKEND_NAME'])
+    _isa = _pass_manager_for(_est_backend).run(qc.remove_final_measurements(inplace=False))
+    _est_circuit = _suppress_errors(_isa, _est_backend)
+    _est_observables = [_o.apply_layout(_isa.layout) for _o in _est_observables]
+    _est_session = _EstSession(backend=_est_backend)
+    _est_estimator = _Estimator(mode=_est_session)
+else:
+    from qiskit.primitives import StatevectorEstimator as _Estimator
+    _est_circuit = qc.remove_final_measurements(inplace=False)
+    _est_estimator = _Estimator()
+_est_values, _est_stds = [], []
+try:
+    for _est_call in range(_est_calls):
+        _est_batch_observables = _est_observables[_est_call * _est_batch:(_est_call + 1) * _est_batch]
+        _est_data = _est_estimator.run([(_est_circuit, _est_batch_observables)]).result()[0].data
+        _est_values.extend(float(_v) for _v in _np.ravel(_est_data.evs))
+        _est_stds.extend(float(_v) for _v in _np.ravel(_est_data.stds))
+        report_progress('estimator call %d/%d' % (_est_call + 1, _est_calls))
+finally:
+    if _est_session is not None:
+        _est_session.close()
+_est_report = {'observables': len(_est_observables), 'calls': _est_calls, 'values': _est_values, 'stds': _est_stds}
+if _est_names is not None:
+    _est_report['names'] = _est_names
+print(_json.dumps({'estimation': _est_report}), flush=True)
+`
+
+// estimatorEnv configures the estimator of the job's executor, if it runs
+// one
+func estimatorEnv(job *quantumv1.QiskitJob) []corev1.EnvVar {
+	if !defaults.Estimates(&job.Spec) {
+		return nil
+	}
+	maxObservables := 0
+	if job.Spec.Estimator != nil {
+		maxObservables = job.Spec.Estimator.MaxObservablesPerCall
+	}
+	if maxObservables <= 0 {
+		maxObservables = DefaultEstimatorMaxObservables
+	}
+	return []corev1.EnvVar{{Name: "ESTIMATOR_MAX_OBSERVABLES", Value: strconv.Itoa(maxObservables)}}
+}
+
+// observablesJSON returns the observables of the job's spec for the
+// executor, named by index unless they have a name, or false if the spec
+// lists none
+func observablesJSON(job *quantumv1.QiskitJob) (string, bool) {
+	if !defaults.Estimates(&job.Spec) || len(job.Spec.Execution.Observables) == 0 {
+		return "", false
+	}
+	type term struct {
+		Pauli       string  `json:"pauli"`
+		Coefficient float64 `json:"coefficient"`
+	}
+	type observable struct {
+		Name  string `json:"name"`
+		Terms []term `json:"terms"`
+	}
+	observables := make([]observable, len(job.Spec.Execution.Observables))
+	for i, o := range job.Spec.Execution.Observables {
+		observables[i] = observable{Name: o.Name, Terms: make([]term, len(o.Terms))}
+		if o.Name == "" {
+			observables[i].Name = strconv.Itoa(i)
+		}
+		for j, t := range o.Terms {
+			observables[i].Terms[j] = term{Pauli: t.Pauli, Coefficient: t.Coefficient}
+			if t.Coefficient == 0 {
+				observables[i].Terms[j].Coefficient = 1
+			}
+		}
+	}
+	data, err := json.Marshal(observables)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// recordEstimation records how the estimator split the observables the
+// execution pod logged and returns their values for the job's results. A
+// missing report never fails the job.
+func (r *QiskitJobReconciler) recordEstimation(ctx context.Context, job *quantumv1.QiskitJob, logs string) *results.Estimation {
+	if !defaults.Estimates(&job.Spec) {
+		return nil
+	}
+	estimation, ok := results.ParseEstimation(logs)
+	if !ok {
+		log.FromContext(ctx).Info("No expectation values found in execution logs")
+		return nil
+	}
+	results.RecordEstimation(job, estimation)
+	log.FromContext(ctx).Info(fmt.Sprintf("Estimated %d observables in %d calls", estimation.Observables, estimation.Calls))
+	return estimation
+}
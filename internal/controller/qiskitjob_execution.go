@@ -0,0 +1,200 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/hints"
+)
+
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// DefaultExecutionTTL is how long finished execution Jobs, and with them
+// their pods, are kept unless configured otherwise
+const DefaultExecutionTTL = 24 * time.Hour
+
+// executionBackoffLimit is how often the pod of an attempt is recreated
+// after failing for reasons other than the executor exiting with an error,
+// like an init container that could not pull the circuit. Pods disrupted by
+// node failures, drains or preemption are recreated without counting.
+const executionBackoffLimit = 2
+
+// execution is the state of the job's current attempt
+type execution struct {
+	// pod runs the attempt; nil while the Job has not created one yet
+	pod *corev1.Pod
+
+	// phase of the attempt, in terms of the phases of its pod
+	phase corev1.PodPhase
+
+	// message explains why a failed attempt failed, if the Job tells
+	message string
+}
+
+// executionJob builds the batch Job running the job's current attempt in
+// the execution pod. The Job replaces pods lost with their node, and fails
+// as soon as the executor exits with an error, so failed circuits go
+// through the job's own retries rather than the Job's.
+func (r *QiskitJobReconciler) executionJob(ctx context.Context, job *quantumv1.QiskitJob) (*batchv1.Job, error) {
+	pod, err := r.createExecutionPod(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := r.ExecutionTTL
+	if ttl <= 0 {
+		ttl = DefaultExecutionTTL
+	}
+	execution := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			Labels:    maps.Clone(pod.Labels),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr(int32(executionBackoffLimit)),
+			TTLSecondsAfterFinished: ptr(int32(ttl.Seconds())),
+			PodFailurePolicy: &batchv1.PodFailurePolicy{
+				Rules: []batchv1.PodFailurePolicyRule{
+					{
+						Action: batchv1.PodFailurePolicyActionIgnore,
+						OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+							{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue},
+						},
+					},
+					{
+						Action: batchv1.PodFailurePolicyActionFailJob,
+						OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+							ContainerName: ptr("executor"),
+							Operator:      batchv1.PodFailurePolicyOnExitCodesOpNotIn,
+							Values:        []int32{0},
+						},
+					},
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      pod.Labels,
+					Annotations: pod.Annotations,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}
+	if limit := executionLimit(job); limit > 0 {
+		execution.Spec.ActiveDeadlineSeconds = ptr(int64(math.Ceil(limit.Seconds())))
+	}
+	// Batch schedulers like Kueue admit the execution through the job's queue
+	if queue := hints.Queue(job); queue != "" {
+		execution.Labels[hints.QueueLabel] = queue
+		execution.Labels[hints.KueueQueueLabel] = queue
+		execution.Spec.Template.Labels[hints.QueueLabel] = queue
+	}
+
+	if pod.Namespace != job.Namespace {
+		return execution, nil
+	}
+	if err := controllerutil.SetControllerReference(job, execution, r.Scheme); err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// currentExecution returns the state of the job's current attempt, nil if it
+// has not been started
+func (r *QiskitJobReconciler) currentExecution(ctx context.Context, job *quantumv1.QiskitJob) (*execution, error) {
+	return r.namedExecution(ctx, job, currentExecutionName(job))
+}
+
+// namedExecution returns the state of the named execution of the job, nil if
+// it has not been started
+func (r *QiskitJobReconciler) namedExecution(ctx context.Context, job *quantumv1.QiskitJob, name string) (*execution, error) {
+	key := client.ObjectKey{Namespace: ExecutionNamespace(job), Name: name}
+
+	var batchJob batchv1.Job
+	err := r.Get(ctx, key, &batchJob)
+	if apierrors.IsNotFound(err) {
+		// Attempts started before executions ran as Jobs finish in their bare pod
+		var pod corev1.Pod
+		if err := r.Get(ctx, key, &pod); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return &execution{pod: &pod, phase: pod.Status.Phase}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := r.newestPod(ctx, &batchJob)
+	if err != nil {
+		return nil, err
+	}
+	e := &execution{pod: pod, phase: corev1.PodPending}
+	switch {
+	case jobCondition(&batchJob, batchv1.JobComplete) != nil:
+		e.phase = corev1.PodSucceeded
+	case jobCondition(&batchJob, batchv1.JobFailed) != nil:
+		e.phase = corev1.PodFailed
+		e.message = fmt.Sprintf("Execution %s failed: %s", batchJob.Name, jobCondition(&batchJob, batchv1.JobFailed).Message)
+	case pod != nil && pod.Status.Phase != corev1.PodFailed:
+		// A failed pod of a running Job is being replaced
+		e.phase = pod.Status.Phase
+	}
+	return e, nil
+}
+
+// newestPod returns the most recently created pod of the Job, nil if it has
+// none
+func (r *QiskitJobReconciler) newestPod(ctx context.Context, batchJob *batchv1.Job) (*corev1.Pod, error) {
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.InNamespace(batchJob.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: batchJob.Name}); err != nil {
+		return nil, err
+	}
+	var newest *corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if newest == nil || newest.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			newest = pod
+		}
+	}
+	return newest, nil
+}
+
+// jobCondition returns the Job's condition of the given type if it is true
+func jobCondition(batchJob *batchv1.Job, conditionType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i := range batchJob.Status.Conditions {
+		condition := &batchJob.Status.Conditions[i]
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return condition
+		}
+	}
+	return nil
+}
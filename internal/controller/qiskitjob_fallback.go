@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+)
+
+// Reasons of the events recorded when a hardware job falls back to
+// simulating its device
+const (
+	fallbackReasonUnavailable = "BackendUnavailable"
+	fallbackReasonQueue       = "QueueTooLong"
+	fallbackReasonSubmission  = "SubmissionFailed"
+	fallbackReasonOffline     = "DeviceOffline"
+	fallbackReasonBudget      = "BudgetPressure"
+	fallbackReasonBreaker     = "CircuitBreakerOpen"
+)
+
+// fallBack moves a hardware job onto the simulator of its device: it runs
+// in local testing mode against the device's fake backend from now on, also
+// for its retries. The device is kept in status.originalBackend and an event
+// explains why.
+func (r *QiskitJobReconciler) fallBack(ctx context.Context, job *quantumv1.QiskitJob, reason, message string) {
+	log.FromContext(ctx).Info("Falling back to simulation", "backend", device(job), "reason", reason)
+	job.Status.FallbackUsed = true
+	job.Status.OriginalBackend = device(job)
+	r.event(job, corev1.EventTypeWarning, reason, message)
+}
+
+// event records an event on the job, if the reconciler has a recorder
+func (r *QiskitJobReconciler) event(job *quantumv1.QiskitJob, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(job, eventType, reason, message)
+	}
+}
+
+// holdForBackendAvailability moves hardware jobs whose device cannot take
+// them soon onto its simulator before they are submitted: those whose
+// QuantumBackend reports the device unavailable and, if they allow it with
+// spec.backendSelection.allowFallback, those whose predicted queue wait
+// exceeds the operator's threshold. Jobs that disable fallback wait for an
+// unavailable device instead, and queue for a busy one. It reports whether
+// the job is held, in which case reconciliation should stop with the
+// returned result.
+func (r *QiskitJobReconciler) holdForBackendAvailability(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if job.Spec.Backend.Type != string(backend.IBMQuantum) || job.Status.FallbackUsed {
+		return ctrl.Result{}, false, nil
+	}
+
+	unavailable, err := r.deviceUnavailable(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	if unavailable != "" {
+		if job.Spec.Execution.DisableFallback {
+			job.Status.Message = fmt.Sprintf("%s; waiting for it, as fallback to simulation is disabled", unavailable)
+			if err := r.Status().Update(ctx, job); err != nil {
+				return ctrl.Result{}, true, err
+			}
+			requeueBecause(ctx, RequeueBackendQueue)
+			return ctrl.Result{RequeueAfter: backendRefRetryInterval}, true, nil
+		}
+		r.fallBack(ctx, job, fallbackReasonUnavailable, fmt.Sprintf("%s; simulating it instead", unavailable))
+		return ctrl.Result{}, false, nil
+	}
+
+	if r.FallbackQueueWait <= 0 || r.QueuePredictor == nil || job.Spec.Execution.DisableFallback ||
+		job.Spec.BackendSelection == nil || !job.Spec.BackendSelection.AllowFallback {
+		return ctrl.Result{}, false, nil
+	}
+	if prediction, ok := r.QueuePredictor.Predict(queueBackendKey(job)); ok && prediction.Wait > r.FallbackQueueWait {
+		r.fallBack(ctx, job, fallbackReasonQueue, fmt.Sprintf("%s has a predicted queue wait of %s, over %s; simulating it instead",
+			device(job), prediction.Wait.Round(time.Second), r.FallbackQueueWait))
+	}
+	return ctrl.Result{}, false, nil
+}
+
+// deviceUnavailable explains why the job's device is unavailable if the
+// QuantumBackend registered for it reports so
+func (r *QiskitJobReconciler) deviceUnavailable(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	var registered quantumv1.QuantumBackendList
+	if err := r.List(ctx, &registered); err != nil {
+		return "", err
+	}
+	for i := range registered.Items {
+		qb := &registered.Items[i]
+		if qb.Spec.Backend.Type != job.Spec.Backend.Type || qb.Spec.Backend.Name != device(job) {
+			continue
+		}
+		condition := meta.FindStatusCondition(qb.Status.Conditions, backendref.ConditionAvailable)
+		if condition != nil && condition.Status == metav1.ConditionFalse {
+			return fmt.Sprintf("%s is unavailable: %s", device(job), condition.Message), nil
+		}
+	}
+	return "", nil
+}
+
+// fallsBackOnError reports whether a hardware job whose submission failed
+// simulates its device instead of failing, as
+// spec.backendSelection.fallbackToSimulator asks
+func fallsBackOnError(job *quantumv1.QiskitJob) bool {
+	return job.Spec.Backend.Type == string(backend.IBMQuantum) && !job.Spec.Execution.DisableFallback &&
+		job.Spec.BackendSelection != nil && job.Spec.BackendSelection.FallbackToSimulator
+}
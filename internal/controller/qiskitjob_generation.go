@@ -0,0 +1,119 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ReasonRerun is recorded when a finished job runs again because it was
+// annotated with RerunAnnotation
+const ReasonRerun = "Rerun"
+
+// observeGeneration records the generation of the spec the job runs in its
+// status. Jobs that have not finished pick up edits as they go. A finished
+// job annotated with RerunAnnotation is started over with its current spec:
+// the executions of its previous run are deleted, and once they are gone the
+// job goes back to Pending with a fresh status. It reports whether the job
+// is being started over, in which case reconciliation should stop with the
+// returned result.
+func (r *QiskitJobReconciler) observeGeneration(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if _, ok := job.Annotations[quantumv1.RerunAnnotation]; ok {
+		if finished(job) {
+			return r.rerun(ctx, job)
+		}
+		// Jobs that have not finished run anyway, so the request is dropped
+		delete(job.Annotations, quantumv1.RerunAnnotation)
+		if err := r.Update(ctx, job); err != nil {
+			return ctrl.Result{}, true, err
+		}
+	}
+	if job.Status.ObservedGeneration >= job.Generation {
+		return ctrl.Result{}, false, nil
+	}
+	job.Status.ObservedGeneration = job.Generation
+	return ctrl.Result{}, false, r.Status().Update(ctx, job)
+}
+
+// rerun starts a finished job over once the executions of its previous run
+// are gone
+func (r *QiskitJobReconciler) rerun(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	// Executions are named after attempts, which the new run numbers from
+	// one again
+	if err := r.cleanupJob(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	var executions batchv1.JobList
+	if err := r.List(ctx, &executions, client.InNamespace(job.Namespace),
+		client.MatchingLabels{"quantum.io/job": job.Name}); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	if len(executions.Items) > 0 {
+		traceStep(ctx, "Waiting for %d executions of the previous run to be deleted", len(executions.Items))
+		requeueBecause(ctx, RequeueWaitingForPod)
+		return ctrl.Result{RequeueAfter: r.podPendingRequeue()}, true, nil
+	}
+
+	// The results of the previous run no longer apply, and the job is
+	// cached again until it finishes anew
+	changed := clearResultsAnnotations(job)
+	if _, ok := job.Labels[TerminalLabel]; ok {
+		delete(job.Labels, TerminalLabel)
+		changed = true
+	}
+	if changed {
+		if err := r.Update(ctx, job); err != nil {
+			return ctrl.Result{}, true, err
+		}
+	}
+
+	oldPhase := job.Status.Phase
+	message := fmt.Sprintf("Rerun requested after the job finished as %s, running generation %d",
+		oldPhase, job.Generation)
+	now := metav1.Now()
+	job.Status = quantumv1.QiskitJobStatus{
+		Phase:               PhasePending,
+		PhaseMachineVersion: PhaseMachineVersion,
+		ObservedGeneration:  job.Generation,
+		Message:             message,
+		StartTime:           &now,
+	}
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	log.FromContext(ctx).Info("Running job again", "generation", job.Generation, "from", oldPhase)
+	r.event(job, corev1.EventTypeNormal, ReasonRerun, message)
+	recordPhaseMetrics(job, oldPhase)
+
+	// Left behind, the annotation is dropped on the next pass, as the job
+	// has not finished anymore
+	delete(job.Annotations, quantumv1.RerunAnnotation)
+	if err := r.Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{Requeue: true}, true, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+// DefaultGitImage clones git circuit sources unless configured otherwise
+const DefaultGitImage = "alpine/git:v2.47.2"
+
+// Keys of the job's credentials Secret that authenticate clones of private
+// repositories over HTTPS. The username defaults to "git", which hosts that
+// authenticate by token alone accept.
+const (
+	GitUsernameKey = "git-username"
+	GitTokenKey    = "git-token"
+)
+
+// Location of a git checkout in the execution pod, and the init container
+// cloning it
+const (
+	gitRepoDir         = workspaceDir + "/repo"
+	gitCloneContainer  = "git-clone"
+	gitTerminationPath = "/dev/termination-log"
+)
+
+// gitClone clones the branch into the workspace and reports the commit it
+// checked out as the termination message of the init container. The
+// credential helper hands the token to git so it never appears in the
+// repository URL or the pod spec.
+const gitClone = `set -e
+git -c credential.helper='!f() { if [ -n "$GIT_TOKEN" ]; then echo "username=${GIT_USERNAME:-git}"; echo "password=$GIT_TOKEN"; fi; }; f' \
+  clone --quiet --depth 1 --single-branch ${GIT_BRANCH:+--branch "$GIT_BRANCH"} -- "$GIT_REPOSITORY" ` + gitRepoDir + `
+git -C ` + gitRepoDir + ` rev-parse HEAD > ` + gitTerminationPath + `
+`
+
+// isGit reports whether the job runs a file of a git repository rather than
+// circuit code
+func isGit(job *quantumv1.QiskitJob) bool {
+	return job.Spec.Circuit.Source == "git" && job.Spec.Circuit.GitRef != nil
+}
+
+// cloneRepository adds an init container cloning the job's repository into
+// the executor's workspace. Credentials are read from the job's Secret when
+// it is in the job's namespace; without them only public repositories clone.
+func (r *QiskitJobReconciler) cloneRepository(pod *corev1.Pod, job *quantumv1.QiskitJob) {
+	ref := job.Spec.Circuit.GitRef
+	image := r.GitImage
+	if image == "" {
+		image = DefaultGitImage
+	}
+
+	mountWorkspace(pod)
+	container := corev1.Container{
+		Name:    gitCloneContainer,
+		Image:   image,
+		Command: []string{"sh", "-c", gitClone},
+		Env: []corev1.EnvVar{
+			{Name: "GIT_REPOSITORY", Value: ref.Repository},
+			{Name: "GIT_BRANCH", Value: ref.Branch},
+			{Name: "GIT_TERMINAL_PROMPT", Value: "0"},
+			{Name: "HOME", Value: "/tmp"},
+		},
+		VolumeMounts: []corev1.VolumeMount{{Name: "workspace", MountPath: workspaceDir}},
+		// A failed clone explains itself in the logs git leaves
+		TerminationMessagePath:   gitTerminationPath,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    mustParseQuantity("100m"),
+				corev1.ResourceMemory: mustParseQuantity("128Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    mustParseQuantity("1"),
+				corev1.ResourceMemory: mustParseQuantity("512Mi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			RunAsNonRoot:             ptr(true),
+			RunAsUser:                ptr(int64(1000)),
+			AllowPrivilegeEscalation: ptr(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	}
+	if secret := gitSecret(job); secret != "" {
+		for _, v := range [][2]string{{"GIT_USERNAME", GitUsernameKey}, {"GIT_TOKEN", GitTokenKey}} {
+			container.Env = append(container.Env, corev1.EnvVar{Name: v[0], ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+					Key:                  v[1],
+					Optional:             ptr(true),
+				},
+			}})
+		}
+	}
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, container)
+}
+
+// gitSecret returns the name of the job's credentials Secret if pods of the
+// job can read it
+func gitSecret(job *quantumv1.QiskitJob) string {
+	creds := job.Spec.Credentials
+	if creds == nil || creds.SecretRef == nil {
+		return ""
+	}
+	if ns := creds.SecretRef.Namespace; ns != "" && ns != job.Namespace {
+		return ""
+	}
+	return creds.SecretRef.Name
+}
+
+// clonedCommit returns the commit the pod's init container checked out
+func clonedCommit(pod *corev1.Pod) (string, bool) {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == gitCloneContainer && status.State.Terminated != nil && status.State.Terminated.ExitCode == 0 {
+			commit := strings.TrimSpace(status.State.Terminated.Message)
+			return commit, commit != ""
+		}
+	}
+	return "", false
+}
+
+// cloneFailure explains why the pod's init container could not clone the
+// job's repository, if that is why the pod failed. Git may print the
+// repository's URL with its credentials, which are masked.
+func cloneFailure(pod *corev1.Pod) (string, bool) {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == gitCloneContainer && status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+			message := strings.TrimSpace(status.State.Terminated.Message)
+			if message == "" {
+				message = fmt.Sprintf("exit code %d", status.State.Terminated.ExitCode)
+			}
+			return "Failed to clone circuit repository: " + redact.String(message), true
+		}
+	}
+	return "", false
+}
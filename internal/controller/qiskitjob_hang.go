@@ -0,0 +1,167 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/heartbeat"
+)
+
+// ConditionExecutorHung is True when an attempt was terminated because its
+// executor stopped sending heartbeats
+const ConditionExecutorHung = "ExecutorHung"
+
+// DefaultHangTimeout is how long a running executor may go without a
+// heartbeat unless configured otherwise
+const DefaultHangTimeout = 15 * time.Minute
+
+// heartbeatRefresh is how often the logs of a running pod are searched for
+// heartbeats
+const heartbeatRefresh = 2 * heartbeat.DefaultInterval
+
+// RecentLogReader reads what a pod logged within a recent window
+type RecentLogReader interface {
+	RecentPodLogs(ctx context.Context, namespace, name string, since time.Duration) (string, error)
+}
+
+// executionScript returns the shell script of the execution pod, which runs
+// the program mounted from the job's code ConfigMap and only ever refers to
+// it by path. It reports a heartbeat before installing packages, which can
+// take minutes. With hang dumps enabled the executor runs in the background,
+// so the shell can take a py-spy dump of it when the hung pod is terminated.
+func (r *QiskitJobReconciler) executionScript(job *quantumv1.QiskitJob) string {
+	fetch, bundleRequirements := bundleInstall(job)
+	install := pipInstall("-r " + codeRequirementsFile + bundleRequirements)
+	if !r.HangDumps {
+		return fmt.Sprintf(`
+echo "%s $(date +%%s) installing"
+%s%s && \
+python3 %s
+`, heartbeat.Marker, fetch, install, programFile)
+	}
+	return fmt.Sprintf(`
+echo "%s $(date +%%s) installing"
+%s%s && {
+python3 %s &
+pid=$!
+trap 'echo %s; py-spy dump --pid $pid; kill -KILL $pid' TERM
+wait $pid
+}
+`, heartbeat.Marker, fetch, install, programFile, heartbeat.DumpMarker)
+}
+
+// checkHeartbeat looks for heartbeats a running execution pod logged since
+// the last one seen, recording the newest in the job status. It reports
+// whether the executor has been silent for longer than HangTimeout, and the
+// progress the circuit last reported.
+func (r *QiskitJobReconciler) checkHeartbeat(ctx context.Context, job *quantumv1.QiskitJob, pod *corev1.Pod) (string, bool) {
+	if r.Logs == nil || r.HangTimeout <= 0 {
+		return "", false
+	}
+	last := runningSince(pod)
+	if last.IsZero() {
+		return "", false
+	}
+	if hb := job.Status.LastHeartbeatTime; hb != nil && hb.After(last) {
+		last = hb.Time
+	}
+	silence := time.Since(last)
+	if silence < heartbeatRefresh {
+		return "", false
+	}
+
+	logs, err := r.Logs.RecentPodLogs(ctx, pod.Namespace, podExecution(pod), silence)
+	if err != nil {
+		// Without logs there is no evidence of a hang; check again later
+		log.FromContext(ctx).Error(err, "Failed to read executor heartbeats", "pod", pod.Name)
+		return "", false
+	}
+	if beat, ok := heartbeat.Last(logs); ok {
+		job.Status.LastHeartbeatTime = &metav1.Time{Time: beat.Time}
+		return beat.Progress, false
+	}
+	return "", silence >= r.HangTimeout
+}
+
+// handleHungExecution terminates a hung execution pod and fails the attempt,
+// so the retry policy applies. The pod is terminated through its active
+// deadline rather than deleted, so it is retained with its logs and, with
+// hang dumps enabled, the executor's stack.
+func (r *QiskitJobReconciler) handleHungExecution(ctx context.Context, job *quantumv1.QiskitJob, pod *corev1.Pod) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	last := runningSince(pod)
+	if hb := job.Status.LastHeartbeatTime; hb != nil && hb.After(last) {
+		last = hb.Time
+	}
+	message := fmt.Sprintf("Executor hung: no heartbeat from pod %s for %s",
+		pod.Name, time.Since(last).Round(time.Second))
+	logger.Info("Terminating hung execution pod", "pod", pod.Name, "lastHeartbeat", last)
+
+	if pod.Spec.ActiveDeadlineSeconds == nil || *pod.Spec.ActiveDeadlineSeconds > 1 {
+		pod.Spec.ActiveDeadlineSeconds = ptr(int64(1))
+		if err := r.Update(ctx, pod); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if err := r.pruneFailedPods(ctx, job); err != nil {
+		logger.Error(err, "Failed to prune old failed execution pods")
+	}
+
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionExecutorHung,
+		Status:             metav1.ConditionTrue,
+		Reason:             "HeartbeatTimeout",
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+	return r.updateJobPhase(ctx, job, PhaseFailed, message)
+}
+
+// startAttempt resets the heartbeat state of the job for a new execution pod
+func startAttempt(job *quantumv1.QiskitJob) {
+	job.Status.LastHeartbeatTime = nil
+	if meta.IsStatusConditionTrue(job.Status.Conditions, ConditionExecutorHung) {
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionExecutorHung,
+			Status:             metav1.ConditionFalse,
+			Reason:             "Retried",
+			Message:            fmt.Sprintf("Attempt %d started", attempt(job)),
+			ObservedGeneration: job.Generation,
+		})
+	}
+}
+
+// runningSince returns when the executor container started running
+func runningSince(pod *corev1.Pod) time.Time {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == "executor" && status.State.Running != nil {
+			return status.State.Running.StartedAt.Time
+		}
+	}
+	return time.Time{}
+}
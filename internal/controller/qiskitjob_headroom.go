@@ -0,0 +1,156 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumnamespacestatuses,verbs=get;list;watch
+
+// ConditionBudgetPressure is True when the job was moved off hardware or
+// deferred because its namespace has spent most of its monthly budget
+const ConditionBudgetPressure = "BudgetPressure"
+
+// DefaultBudgetSoftLimit is the share of a namespace's monthly budget from
+// which hardware jobs fall back to simulation or are deferred
+const DefaultBudgetSoftLimit = 0.9
+
+// budgetRecheckInterval is how often deferred jobs look at the namespace's
+// spend again
+const budgetRecheckInterval = 15 * time.Minute
+
+// Reasons of the BudgetPressure condition
+const (
+	budgetReasonFallback = "SimulatorFallback"
+	budgetReasonDeferred = "Deferred"
+)
+
+// backendType returns the type of backend the job runs on: that of its
+// spec, or ibm_local_testing for hardware jobs that fell back to simulating
+// their device
+func backendType(job *quantumv1.QiskitJob) string {
+	if job.Status.FallbackUsed && job.Spec.Backend.Type == string(backend.IBMQuantum) {
+		return string(backend.IBMLocalTesting)
+	}
+	return job.Spec.Backend.Type
+}
+
+// budgetDeferred reports whether the job is held back for its namespace's spend
+func budgetDeferred(job *quantumv1.QiskitJob) bool {
+	condition := meta.FindStatusCondition(job.Status.Conditions, ConditionBudgetPressure)
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason == budgetReasonDeferred
+}
+
+// budgetUtilization returns the share of its monthly budget the job's
+// namespace has spent, as summarized in its QuantumNamespaceStatus, and the
+// budget. Namespaces without a budget report false.
+func (r *QiskitJobReconciler) budgetUtilization(ctx context.Context, job *quantumv1.QiskitJob) (float64, string, bool, error) {
+	var summary quantumv1.QuantumNamespaceStatus
+	err := r.Get(ctx, client.ObjectKey{Namespace: job.Namespace, Name: NamespaceStatusName}, &summary)
+	if apierrors.IsNotFound(err) {
+		return 0, "", false, nil
+	}
+	if err != nil {
+		return 0, "", false, err
+	}
+	if budget, err := parseCost(summary.Spec.MonthlyBudget); err != nil || budget <= 0 {
+		return 0, "", false, nil
+	}
+	return summary.Status.QuotaUtilization, summary.Spec.MonthlyBudget, true, nil
+}
+
+// holdForBudget softly enforces the namespace's monthly budget before the
+// hard cutoff: once the namespace has spent more than the soft limit, hardware
+// jobs are simulated on the fake backend of their device instead, or, if they
+// disable fallback, deferred until spend drops below the limit or their
+// deadline. Urgent jobs are submitted regardless. A job that fell back stays
+// on the simulator for its retries. It reports whether the job is held, in
+// which case reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) holdForBudget(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	logger := log.FromContext(ctx)
+
+	if r.BudgetSoftLimit <= 0 || job.Spec.Backend.Type != string(backend.IBMQuantum) || job.Status.FallbackUsed {
+		return ctrl.Result{}, false, nil
+	}
+	utilization, budget, ok, err := r.budgetUtilization(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	now := time.Now()
+	pastDeadline := job.Spec.Execution.Deadline != nil && !now.Before(job.Spec.Execution.Deadline.Time)
+	if !ok || utilization <= r.BudgetSoftLimit || job.Spec.Execution.Priority == "urgent" ||
+		(job.Spec.Execution.DisableFallback && pastDeadline) {
+		if meta.IsStatusConditionTrue(job.Status.Conditions, ConditionBudgetPressure) {
+			meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+				Type:               ConditionBudgetPressure,
+				Status:             metav1.ConditionFalse,
+				Reason:             "Submitted",
+				Message:            "Job submitted to hardware",
+				ObservedGeneration: job.Generation,
+			})
+		}
+		return ctrl.Result{}, false, nil
+	}
+
+	spent := fmt.Sprintf("Namespace has spent %.0f%% of its monthly budget of %s", utilization*100, budget)
+	if !job.Spec.Execution.DisableFallback {
+		r.fallBack(ctx, job, fallbackReasonBudget, fmt.Sprintf("%s; simulating %s instead", spent, device(job)))
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionBudgetPressure,
+			Status:             metav1.ConditionTrue,
+			Reason:             budgetReasonFallback,
+			Message:            fmt.Sprintf("%s; simulating %s instead of submitting to hardware", spent, device(job)),
+			ObservedGeneration: job.Generation,
+		})
+		return ctrl.Result{}, false, nil
+	}
+
+	message := fmt.Sprintf("%s; deferring hardware submission", spent)
+	requeue := budgetRecheckInterval
+	if deadline := job.Spec.Execution.Deadline; deadline != nil {
+		message = fmt.Sprintf("%s until %s", message, deadline.UTC().Format(time.RFC3339))
+		requeue = min(requeue, deadline.Sub(now))
+	}
+	logger.Info("Deferring submission for namespace budget", "utilization", utilization)
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionBudgetPressure,
+		Status:             metav1.ConditionTrue,
+		Reason:             budgetReasonDeferred,
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+	job.Status.Message = message
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	requeueBecause(ctx, RequeueHold)
+	return ctrl.Result{RequeueAfter: requeue}, true, nil
+}
@@ -0,0 +1,428 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/generichttp"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// DefaultHTTPPollInterval is how often a generic_http or ibm_quantum job's
+// status is polled unless the config file says otherwise
+const DefaultHTTPPollInterval = 10 * time.Second
+
+// submitRetryInterval is how long a job waits to submit again after the
+// provider's queue was full or it throttled the submission, unless the
+// provider says how long to wait
+const submitRetryInterval = time.Minute
+
+// ConditionProviderRejected is True when the provider rejected the job for a
+// reason every attempt would run into, like rejected credentials or a
+// circuit too large for the device. Such jobs are not retried.
+const ConditionProviderRejected = "ProviderRejected"
+
+// handleHTTPJob runs a generic_http or ibm_quantum job through the provider's
+// API instead of an execution pod: the circuit is submitted once, then the
+// job is polled until the provider reports a final state. Each submission is
+// recorded with its client request ID before it is sent, so a submission
+// whose job ID was lost is looked up instead of sent twice. A failed provider
+// job clears the job ID so a retry submits again. Calls stop while the
+// backend's circuit breaker is open, and submissions while hardware is
+// paused.
+func (r *QiskitJobReconciler) handleHTTPJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	adapter, err := r.remoteBackend(ctx, job)
+	var apiErr apierrors.APIStatus
+	switch {
+	case apierrors.IsNotFound(err):
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Credentials secret not found: %v", err))
+	case errors.As(err, &apiErr):
+		return ctrl.Result{}, err
+	case err != nil:
+		return r.failForProvider(ctx, job, err.Error(), err)
+	}
+	if result, held, err := r.holdForBreaker(ctx, job); held {
+		return result, err
+	}
+
+	if job.Status.JobID == "" {
+		// A pause that began after scheduling still stops the submission
+		if result, held, err := r.holdForPause(ctx, job); held {
+			return result, err
+		}
+		code, err := r.circuitCode(ctx, job)
+		var sourceErr *circuitSourceError
+		switch {
+		case errors.As(err, &sourceErr):
+			return r.updateJobPhase(ctx, job, PhaseFailed, sourceErr.Error())
+		case err != nil:
+			return ctrl.Result{}, err
+		}
+		shots := defaults.Shots
+		if job.Spec.Execution.Shots > 0 {
+			shots = job.Spec.Execution.Shots
+		}
+
+		// The submission is recorded before it is sent: a pass that fails to
+		// record the provider's job ID finds the job on the next pass rather
+		// than submitting it twice
+		requestID := submissionID(job)
+		var id *backend.JobID
+		if job.Status.SubmissionID == requestID {
+			id, err = findSubmitted(ctx, adapter, requestID)
+			r.recordBackendCall(ctx, job, err)
+			if err != nil {
+				logger.Error(err, "Failed to look up submitted job", "backend", adapter.Name(), "requestID", requestID)
+				requeueBecause(ctx, RequeueError)
+				return ctrl.Result{RequeueAfter: submitRetryInterval}, nil
+			}
+		} else {
+			job.Status.SubmissionID = requestID
+			if err := r.Status().Update(ctx, job); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if id == nil {
+			id, err = adapter.SubmitJob(ctx, &backend.QuantumJob{
+				ID:                string(job.UID),
+				CircuitCode:       code,
+				Shots:             shots,
+				OptimizationLevel: job.Spec.Execution.OptimizationLevel,
+				ResilienceLevel:   defaults.ResilienceLevel(&job.Spec.Execution),
+				MaxExecutionTime:  executionLimit(job),
+				Tags:              r.jobTags(job),
+				RequestID:         requestID,
+				SessionID:         job.Status.SessionID,
+				ErrorMitigation:   errorMitigationOf(job),
+			})
+			r.recordBackendCall(ctx, job, err)
+		} else {
+			logger.Info("Found job submitted on an earlier pass", "backend", adapter.Name(), "providerJobID", *id)
+		}
+		switch {
+		case backend.Transient(err):
+			wait := backend.RetryAfter(err)
+			if wait <= 0 {
+				wait = submitRetryInterval
+			}
+			logger.Info("Provider deferred submission", "backend", adapter.Name(), "reason", err.Error(), "retryAfter", wait)
+			job.Status.Message = fmt.Sprintf("Waiting %s to submit to %s: %v", wait, adapter.Name(), err)
+			requeueBecause(ctx, RequeueRateLimited)
+			return ctrl.Result{RequeueAfter: wait}, r.Status().Update(ctx, job)
+		case errors.Is(err, backend.ErrDeviceOffline) && job.Spec.Backend.Type == string(backend.IBMQuantum) &&
+			!job.Spec.Execution.DisableFallback:
+			// Simulate the device's fake backend instead, in an execution pod
+			message := fmt.Sprintf("%s is offline, simulating it instead: %v", adapter.Name(), err)
+			r.fallBack(ctx, job, fallbackReasonOffline, message)
+			return r.updateJobPhase(ctx, job, PhaseScheduling, message)
+		case err != nil && fallsBackOnError(job):
+			message := fmt.Sprintf("Failed to submit to %s, simulating it instead: %v", adapter.Name(), err)
+			r.fallBack(ctx, job, fallbackReasonSubmission, message)
+			return r.updateJobPhase(ctx, job, PhaseScheduling, message)
+		case err != nil:
+			logger.Error(err, "Failed to submit job", "backend", adapter.Name())
+			return r.failForProvider(ctx, job, fmt.Sprintf("Failed to submit to %s: %v", adapter.Name(), err), err)
+		}
+
+		logger.Info("Submitted job", "backend", adapter.Name(), "providerJobID", *id)
+		job.Status.JobID = string(*id)
+		job.Status.SubmissionID = ""
+		job.Status.ProviderPhase = ""
+		job.Status.QueuePosition = nil
+		r.startShadow(ctx, job)
+		job.Status.Message = fmt.Sprintf("Submitted to %s as %s", adapter.Name(), *id)
+		requeueBecause(ctx, RequeueBackendQueue)
+		return ctrl.Result{RequeueAfter: r.pollInterval(job)}, r.Status().Update(ctx, job)
+	}
+
+	// Jobs in flight together share the provider's API quota
+	if !r.Polls.TryAccept(adapter.Name()) {
+		requeueBecause(ctx, RequeueRateLimited)
+		return ctrl.Result{RequeueAfter: r.pollInterval(job)}, nil
+	}
+	status, err := adapter.GetJobStatus(ctx, backend.JobID(job.Status.JobID))
+	r.recordBackendCall(ctx, job, err)
+	if err != nil {
+		// The control stack may be briefly unreachable; keep polling
+		logger.Error(err, "Failed to poll job status", "providerJobID", job.Status.JobID)
+		requeueBecause(ctx, RequeueError)
+		return ctrl.Result{RequeueAfter: r.pollInterval(job)}, nil
+	}
+	syncProviderStatus(job, status)
+	// The shadow run may not have been recorded along with the submission
+	if status.Phase != "Failed" && status.Phase != "Cancelled" {
+		r.startShadow(ctx, job)
+	}
+
+	switch status.Phase {
+	case "Completed":
+		if result, waiting, err := r.awaitShadow(ctx, job); waiting {
+			return result, err
+		}
+		result, err := adapter.GetJobResult(ctx, status.ID)
+		r.recordBackendCall(ctx, job, err)
+		if err != nil {
+			logger.Error(err, "Failed to fetch job result", "providerJobID", job.Status.JobID)
+			requeueBecause(ctx, RequeueError)
+			return ctrl.Result{RequeueAfter: r.pollInterval(job)}, nil
+		}
+		return r.completeHTTPJob(ctx, job, adapter, result)
+
+	case "Failed":
+		message := fmt.Sprintf("Job %s failed on %s: %s", job.Status.JobID, adapter.Name(), status.Message)
+		job.Status.JobID = ""
+		return r.updateJobPhase(ctx, job, PhaseFailed, message)
+
+	case "Cancelled":
+		message := fmt.Sprintf("Job %s was cancelled on %s: %s", job.Status.JobID, adapter.Name(), status.Message)
+		job.Status.JobID = ""
+		return r.updateJobPhase(ctx, job, PhaseFailed, message)
+
+	default:
+		job.Status.Message = fmt.Sprintf("Job %s is %s on %s", job.Status.JobID, status.Message, adapter.Name())
+		requeueBecause(ctx, RequeueBackendQueue)
+		return ctrl.Result{RequeueAfter: r.pollInterval(job)}, r.Status().Update(ctx, job)
+	}
+}
+
+// submissionID returns the client request ID of the job's current attempt
+// at its provider
+func submissionID(job *quantumv1.QiskitJob) string {
+	return fmt.Sprintf("%s-%d", job.UID, job.Status.RetryCount)
+}
+
+// findSubmitted looks up the job a recorded submission created, nil if the
+// provider has none or cannot look jobs up. Providers honoring the request
+// ID as an idempotency key still ignore a second submission.
+func findSubmitted(ctx context.Context, adapter backend.Backend, requestID string) (*backend.JobID, error) {
+	finder, ok := adapter.(backend.Finder)
+	if !ok {
+		return nil, nil
+	}
+	return finder.FindJob(ctx, requestID)
+}
+
+// failForProvider fails the job for an error of its provider. Errors every
+// attempt would run into fail it for good.
+func (r *QiskitJobReconciler) failForProvider(ctx context.Context, job *quantumv1.QiskitJob, message string, err error) (ctrl.Result, error) {
+	if backend.Permanent(err) {
+		reason := "CircuitTooLarge"
+		if errors.Is(err, backend.ErrAuth) {
+			reason = "CredentialsRejected"
+		}
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionProviderRejected,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: job.Generation,
+		})
+	}
+	return r.updateJobPhase(ctx, job, PhaseFailed, message)
+}
+
+// providerRejected reports whether the provider rejected the job for good
+func providerRejected(job *quantumv1.QiskitJob) bool {
+	return meta.IsStatusConditionTrue(job.Status.Conditions, ConditionProviderRejected)
+}
+
+// completeHTTPJob records a finished provider job and exports its counts
+func (r *QiskitJobReconciler) completeHTTPJob(ctx context.Context, job *quantumv1.QiskitJob, adapter backend.Backend, result *backend.JobResult) (ctrl.Result, error) {
+	exportAllowed, err := r.outputExportAllowed(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := metav1.Now()
+	job.Status.CompletionTime = &now
+	job.Status.ActualCost = "$0.00"
+	var duration time.Duration
+	if job.Status.StartTime != nil {
+		duration = now.Sub(job.Status.StartTime.Time)
+		job.Status.Metrics = &quantumv1.ExecutionMetrics{
+			TotalTime:     duration.String(),
+			ExecutionTime: duration.String(),
+		}
+	}
+	job.Status.Results = results.NewInfo(job, result.Counts, duration)
+	job.Status.Results.Mitigations = providerMitigations(adapter, &backend.QuantumJob{
+		ResilienceLevel: defaults.ResilienceLevel(&job.Spec.Execution),
+		ErrorMitigation: errorMitigationOf(job),
+	})
+	if !exportAllowed {
+		job.Status.Results.Location = ""
+	}
+	if cost, err := adapter.GetActualCost(ctx, result.JobID); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to fetch job cost", "providerJobID", job.Status.JobID)
+	} else {
+		job.Status.ActualCost = formatCost(cost.Amount)
+		if cost.QuantumTime > 0 {
+			job.Status.Results.QuantumTime = cost.QuantumTime.String()
+			if job.Status.Metrics != nil {
+				job.Status.Metrics.QuantumTime = cost.QuantumTime.String()
+			}
+		}
+	}
+
+	shadow := r.compareShadow(ctx, job, result.Counts)
+
+	if !exportAllowed {
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			"Job completed; result export blocked by data residency policy")
+	}
+	doc := results.NewDocument(job, result.Counts)
+	doc.Shadow = shadow
+	if err := r.exportResults(ctx, job, doc, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.cacheResults(ctx, job, result.Counts)
+	if message, degraded := degradedOutputsMessage(job); degraded {
+		return r.updateJobPhase(ctx, job, PhaseCompleted, message)
+	}
+	return r.updateJobPhase(ctx, job, PhaseCompleted, "Job completed successfully")
+}
+
+// cancelHTTPJob cancels a job still running on a generic_http or ibm_quantum
+// backend, or the shares of a split job running on one. It is best effort:
+// backends without a cancel endpoint are left alone.
+func (r *QiskitJobReconciler) cancelHTTPJob(ctx context.Context, job *quantumv1.QiskitJob) {
+	if job.Spec.Split != nil {
+		r.cancelSplitShares(ctx, job)
+		return
+	}
+	if !remote(job) || job.Status.JobID == "" || job.Status.Phase != PhaseRunning {
+		return
+	}
+	adapter, err := r.remoteBackend(ctx, job)
+	if err == nil {
+		err = adapter.CancelJob(ctx, backend.JobID(job.Status.JobID))
+	}
+	if err != nil && !errors.Is(err, generichttp.ErrNotSupported) {
+		log.FromContext(ctx).Error(err, "Failed to cancel job", "providerJobID", job.Status.JobID)
+	}
+}
+
+// remote reports whether the job runs through a provider API rather than an
+// execution pod
+func remote(job *quantumv1.QiskitJob) bool {
+	switch backend.BackendType(backendType(job)) {
+	case backend.GenericHTTP, backend.IBMQuantum:
+		return true
+	}
+	return false
+}
+
+// remoteBackend returns an authenticated adapter for the job's provider API
+func (r *QiskitJobReconciler) remoteBackend(ctx context.Context, job *quantumv1.QiskitJob) (backend.Backend, error) {
+	if job.Spec.Backend.Type == string(backend.IBMQuantum) {
+		return r.ibmBackend(ctx, job)
+	}
+	return r.httpBackend(ctx, job)
+}
+
+// httpBackend returns an authenticated adapter for the job's generic_http backend
+func (r *QiskitJobReconciler) httpBackend(ctx context.Context, job *quantumv1.QiskitJob) (*generichttp.Backend, error) {
+	spec := job.Spec.Backend.HTTP
+	if spec == nil {
+		return nil, errors.New("generic_http backend has no endpoints")
+	}
+	config, err := r.clientTLS(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	adapter := generichttp.New(job.Spec.Backend.Name, spec, generichttp.Request{
+		Name:      job.Name,
+		Namespace: job.Namespace,
+		UID:       string(job.UID),
+	}, generichttp.Client(spec, config))
+
+	data, err := r.backendCredentials(ctx, job, "")
+	if err != nil {
+		return nil, err
+	}
+	var credentials *backend.Credentials
+	if data != nil {
+		credentials = &backend.Credentials{
+			APIKey: string(data["api-key"]),
+			Extra: map[string]string{
+				"username": string(data["username"]),
+				"password": string(data["password"]),
+			},
+		}
+	}
+	if err := adapter.Authenticate(ctx, credentials); err != nil {
+		return nil, err
+	}
+	return adapter, nil
+}
+
+// clientCAKey of a client certificate's Secret holds the CA certificates the
+// server is verified against
+const clientCAKey = "ca.crt"
+
+// clientTLS returns the TLS config presenting the client certificate of the
+// job's credentials, or nil if it has none
+func (r *QiskitJobReconciler) clientTLS(ctx context.Context, job *quantumv1.QiskitJob) (*tls.Config, error) {
+	creds := job.Spec.Credentials
+	if creds == nil || creds.ClientCertificate == nil {
+		return nil, nil
+	}
+	if r.WithoutSecrets {
+		return nil, errors.New("client certificates need Secret access, which the operator runs without")
+	}
+	ref := creds.ClientCertificate.SecretRef
+	namespace, err := backendref.SecretNamespace(ctx, r.Client, job, ref)
+	if err != nil {
+		return nil, err
+	}
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &secret); err != nil {
+		return nil, err
+	}
+	certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("client certificate secret %s: %w", ref.Name, err)
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12}
+	if ca, ok := secret.Data[clientCAKey]; ok {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("client certificate secret %s: no PEM certificate in %s", ref.Name, clientCAKey)
+		}
+	}
+	return config, nil
+}
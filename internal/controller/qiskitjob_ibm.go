@@ -0,0 +1,102 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// ibmClients keeps authenticated IBM Quantum adapters so jobs polled every
+// few seconds reuse their bearer token instead of exchanging the API key
+// each time. Adapters are keyed by endpoint, instance, device and API key,
+// so rotated credentials get a new one.
+type ibmClients struct {
+	mu       sync.Mutex
+	backends map[string]*ibm.Backend
+}
+
+// ibmBackend returns an authenticated adapter for the job's ibm_quantum
+// device, using the credentials of the region the job was routed to
+func (r *QiskitJobReconciler) ibmBackend(ctx context.Context, job *quantumv1.QiskitJob) (backend.Backend, error) {
+	data, err := r.backendCredentials(ctx, job, job.Status.Region)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("ibm_quantum backends require spec.credentials.secretRef or vaultPath with an api-key")
+	}
+
+	instance := job.Spec.Backend.Instance
+	if instance == "" {
+		instance = string(data["instance"])
+	}
+	if !strings.HasPrefix(instance, "crn:") {
+		return nil, errors.New("ibm_quantum backends require the IBM Cloud CRN of a Qiskit Runtime instance in spec.backend.instance")
+	}
+	if device(job) == "" {
+		return nil, errors.New("ibm_quantum backends require the device in spec.backend.name")
+	}
+
+	opts := r.IBM
+	if opts.URL == "" {
+		opts.URL = ibm.URL(job.Status.Region)
+	}
+	apiKey := string(data["api-key"])
+	sum := sha256.Sum256([]byte(apiKey))
+	key := strings.Join([]string{opts.URL, instance, device(job), hex.EncodeToString(sum[:])}, "|")
+
+	r.ibmClients.mu.Lock()
+	defer r.ibmClients.mu.Unlock()
+	if adapter, ok := r.ibmClients.backends[key]; ok {
+		return adapter, nil
+	}
+	adapter := ibm.New(device(job), instance, opts)
+	if err := adapter.Authenticate(ctx, &backend.Credentials{APIKey: apiKey, Instance: instance}); err != nil {
+		return nil, err
+	}
+	if r.ibmClients.backends == nil {
+		r.ibmClients.backends = map[string]*ibm.Backend{}
+	}
+	r.ibmClients.backends[key] = adapter
+	return adapter, nil
+}
+
+// estimateIBMCost prices the job's expected quantum time on IBM hardware
+func estimateIBMCost(ctx context.Context, job *quantumv1.QiskitJob) string {
+	return formatCost(ibmCost(ctx, job, device(job)))
+}
+
+// ibmCost prices the job's expected quantum time on the IBM device in dollars
+func ibmCost(ctx context.Context, job *quantumv1.QiskitJob, device string) float64 {
+	shots := defaults.Shots
+	if job.Spec.Execution.Shots > 0 {
+		shots = job.Spec.Execution.Shots
+	}
+	estimate, _ := ibm.New(device, job.Spec.Backend.Instance, ibm.Options{}).
+		EstimateCost(ctx, &backend.QuantumJob{Shots: shots})
+	return estimate.Amount
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+// Location of the data inputs in the execution pod, and the init container
+// fetching them
+const (
+	inputsDir       = workspaceDir + "/inputs"
+	inputsContainer = "fetch-inputs"
+)
+
+// inputsFetch downloads the job's URI inputs into the inputs directory,
+// checks every input against the digest it must match, and reports the
+// digest of each as "<sha256>  <name>" lines in the termination message of
+// the init container. A directory's digest is that of the sha256sum listing
+// of its files sorted by path, leaving out the ..data links of ConfigMap and
+// Secret volumes. s3 URIs are signed with Signature Version 4 from the
+// credentials of the INPUT_<index>_ variables.
+const inputsFetch = `
+import hashlib, hmac, json, os, shutil, sys, time, urllib.parse, urllib.request
+
+def file_digest(path):
+    h = hashlib.sha256()
+    with open(path, 'rb') as f:
+        for chunk in iter(lambda: f.read(1 << 20), b''):
+            h.update(chunk)
+    return h.hexdigest()
+
+def digest(path):
+    if not os.path.isdir(path):
+        return file_digest(path)
+    files = []
+    for root, dirs, names in os.walk(path):
+        dirs[:] = [d for d in dirs if not d.startswith('..')]
+        files += [os.path.relpath(os.path.join(root, n), path) for n in names if not n.startswith('..')]
+    listing = ''.join('%s  %s\n' % (file_digest(os.path.join(path, f)), f) for f in sorted(files))
+    return hashlib.sha256(listing.encode()).hexdigest()
+
+def s3_request(uri, env):
+    parts = urllib.parse.urlsplit(uri)
+    bucket, key = parts.netloc, urllib.parse.quote(parts.path.lstrip('/'), safe='/~')
+    region = os.environ.get(env + 'REGION') or 'us-east-1'
+    endpoint = os.environ.get(env + 'ENDPOINT', '').rstrip('/')
+    if endpoint:
+        url = '%s/%s/%s' % (endpoint, bucket, key)
+    elif '.' in bucket:
+        url = 'https://s3.%s.amazonaws.com/%s/%s' % (region, bucket, key)
+    else:
+        url = 'https://%s.s3.%s.amazonaws.com/%s' % (bucket, region, key)
+    target = urllib.parse.urlsplit(url)
+    stamp = time.strftime('%Y%m%dT%H%M%SZ', time.gmtime())
+    headers = {'host': target.netloc, 'x-amz-content-sha256': 'UNSIGNED-PAYLOAD', 'x-amz-date': stamp}
+    if os.environ.get(env + 'SESSION_TOKEN'):
+        headers['x-amz-security-token'] = os.environ[env + 'SESSION_TOKEN']
+    signed = ';'.join(sorted(headers))
+    canonical = '\n'.join(['GET', target.path, '', ''.join('%s:%s\n' % (h, headers[h]) for h in sorted(headers)),
+                           signed, 'UNSIGNED-PAYLOAD'])
+    scope = '%s/%s/s3/aws4_request' % (stamp[:8], region)
+    signing = ('AWS4' + os.environ[env + 'SECRET_ACCESS_KEY']).encode()
+    for part in (stamp[:8], region, 's3', 'aws4_request'):
+        signing = hmac.new(signing, part.encode(), hashlib.sha256).digest()
+    to_sign = '\n'.join(['AWS4-HMAC-SHA256', stamp, scope, hashlib.sha256(canonical.encode()).hexdigest()])
+    signature = hmac.new(signing, to_sign.encode(), hashlib.sha256).hexdigest()
+    headers['authorization'] = 'AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s' % (
+        os.environ[env + 'ACCESS_KEY_ID'], scope, signed, signature)
+    del headers['host']
+    return urllib.request.Request(url, headers=headers)
+
+lines = []
+for i, spec in enumerate(json.loads(os.environ['INPUTS'])):
+    name = spec['name']
+    path = os.path.join(os.environ['INPUTS_DIR'], name)
+    try:
+        uri = spec.get('uri')
+        if uri:
+            request = s3_request(uri, 'INPUT_%d_' % i) if uri.startswith('s3://') else urllib.request.Request(uri)
+            with urllib.request.urlopen(request, timeout=300) as response, open(path, 'wb') as f:
+                shutil.copyfileobj(response, f, 1 << 20)
+        actual = digest(path)
+    except Exception as e:
+        sys.exit('input %s: %s' % (name, e))
+    expected = spec.get('sha256', '').lower()
+    if expected and actual != expected:
+        sys.exit('input %s does not match sha256 %s' % (name, expected))
+    lines.append('%s  %s\n' % (actual, name))
+open('/dev/termination-log', 'w').write(''.join(lines))
+`
+
+// inputDigestPattern matches the digests the init container reports
+var inputDigestPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// inputFetch is what the init container is told about an input
+type inputFetch struct {
+	Name   string `json:"name"`
+	URI    string `json:"uri,omitempty"`
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// inputPath is where the executor finds the named input
+func inputPath(name string) string {
+	return path.Join(inputsDir, name)
+}
+
+// mountInputs puts the job's data inputs into the executor's workspace:
+// ConfigMaps, Secrets and claims are mounted read-only, and an init
+// container running the executor image downloads URIs next to them and
+// records the digest of every input before the circuit runs
+func mountInputs(pod *corev1.Pod, job *quantumv1.QiskitJob) error {
+	if len(job.Spec.Inputs) == 0 {
+		return nil
+	}
+	executor := &pod.Spec.Containers[0]
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         "inputs",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	mounts := []corev1.VolumeMount{{Name: "inputs", MountPath: inputsDir}}
+	var env []corev1.EnvVar
+	fetches := make([]inputFetch, 0, len(job.Spec.Inputs))
+	for i, input := range job.Spec.Inputs {
+		volume := corev1.Volume{Name: fmt.Sprintf("input-%d", i)}
+		mount := corev1.VolumeMount{Name: volume.Name, MountPath: inputPath(input.Name), ReadOnly: true}
+		switch {
+		case input.ConfigMapRef != nil:
+			source := &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: input.ConfigMapRef.Name},
+			}
+			if key := input.ConfigMapRef.Key; key != "" {
+				source.Items = []corev1.KeyToPath{{Key: key, Path: key}}
+				mount.SubPath = key
+			}
+			volume.ConfigMap = source
+		case input.SecretRef != nil:
+			source := &corev1.SecretVolumeSource{SecretName: input.SecretRef.Name}
+			if key := input.SecretRef.Key; key != "" {
+				source.Items = []corev1.KeyToPath{{Key: key, Path: key}}
+				mount.SubPath = key
+			}
+			volume.Secret = source
+		case input.PersistentVolumeClaim != nil:
+			volume.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: input.PersistentVolumeClaim.ClaimName,
+				ReadOnly:  true,
+			}
+			mount.SubPath = input.PersistentVolumeClaim.Path
+		}
+		if volume.ConfigMap != nil || volume.Secret != nil || volume.PersistentVolumeClaim != nil {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+			mounts = append(mounts, mount)
+		}
+		fetches = append(fetches, inputFetch{Name: input.Name, URI: input.URI, SHA256: input.SHA256})
+		if input.SecretName != "" {
+			env = append(env, secretKeysEnv(fmt.Sprintf("INPUT_%d", i), input.SecretName, results.S3SecretKeys)...)
+		}
+	}
+	data, err := json.Marshal(fetches)
+	if err != nil {
+		return err
+	}
+
+	executor.VolumeMounts = append(executor.VolumeMounts, mounts...)
+	executor.Env = append(executor.Env, corev1.EnvVar{Name: "INPUTS_DIR", Value: inputsDir})
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:    inputsContainer,
+		Image:   executor.Image,
+		Command: []string{"python3", "-c", inputsFetch},
+		Env: append([]corev1.EnvVar{
+			{Name: "INPUTS", Value: string(data)},
+			{Name: "INPUTS_DIR", Value: inputsDir},
+		}, env...),
+		VolumeMounts: append([]corev1.VolumeMount(nil), mounts...),
+		// A failed download explains itself in the logs it leaves
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    mustParseQuantity("100m"),
+				corev1.ResourceMemory: mustParseQuantity("128Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    mustParseQuantity("1"),
+				corev1.ResourceMemory: mustParseQuantity("512Mi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			RunAsNonRoot:             ptr(true),
+			RunAsUser:                ptr(int64(1000)),
+			AllowPrivilegeEscalation: ptr(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	})
+	return nil
+}
+
+// secretKeysEnv hands the keys of the Secret to a container as
+// <prefix>_ACCESS_KEY_ID and so on, so they never appear in the pod spec
+func secretKeysEnv(prefix, secret string, keys []results.SecretKey) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	for _, key := range keys {
+		env = append(env, corev1.EnvVar{
+			Name: prefix + "_" + key.Env,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+				Key:                  key.Key,
+				Optional:             ptr(key.Optional),
+			}},
+		})
+	}
+	return env
+}
+
+// inputMissing reports which ConfigMap, Secret or key a data input of the
+// job refers to does not exist, which would leave the pod unable to start.
+// Secrets are only checked when the operator can read them.
+func (r *QiskitJobReconciler) inputMissing(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	for _, input := range job.Spec.Inputs {
+		var (
+			obj  client.Object
+			kind string
+			ref  *quantumv1.InputObjectRef
+		)
+		switch {
+		case input.ConfigMapRef != nil:
+			obj, kind, ref = &corev1.ConfigMap{}, "ConfigMap", input.ConfigMapRef
+		case input.SecretRef != nil && !r.WithoutSecrets:
+			obj, kind, ref = &corev1.Secret{}, "Secret", input.SecretRef
+		case input.SecretName != "" && !r.WithoutSecrets:
+			obj, kind, ref = &corev1.Secret{}, "Secret", &quantumv1.InputObjectRef{Name: input.SecretName}
+		default:
+			continue
+		}
+
+		err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: job.Namespace}, obj)
+		if errors.IsNotFound(err) {
+			return fmt.Sprintf("%s %s for input %s not found", kind, ref.Name, input.Name), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if ref.Key != "" && !hasKey(obj, ref.Key) {
+			return fmt.Sprintf("%s %s for input %s has no key %s", kind, ref.Name, input.Name, ref.Key), nil
+		}
+	}
+	return "", nil
+}
+
+// hasKey reports whether a ConfigMap or Secret holds the key
+func hasKey(obj client.Object, key string) bool {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		_, text := o.Data[key]
+		_, binary := o.BinaryData[key]
+		return text || binary
+	case *corev1.Secret:
+		_, ok := o.Data[key]
+		return ok
+	}
+	return false
+}
+
+// fetchedInputs returns the inputs the pod's init container found, with
+// their digests
+func fetchedInputs(pod *corev1.Pod, job *quantumv1.QiskitJob) ([]quantumv1.InputStatus, bool) {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name != inputsContainer || status.State.Terminated == nil || status.State.Terminated.ExitCode != 0 {
+			continue
+		}
+		var inputs []quantumv1.InputStatus
+		for _, line := range strings.Split(strings.TrimSpace(status.State.Terminated.Message), "\n") {
+			digest, name, ok := strings.Cut(line, "  ")
+			if !ok || !inputDigestPattern.MatchString(digest) {
+				return nil, false
+			}
+			inputs = append(inputs, quantumv1.InputStatus{Name: name, Path: inputPath(name), SHA256: digest})
+		}
+		return inputs, len(inputs) == len(job.Spec.Inputs)
+	}
+	return nil, false
+}
+
+// inputFailure explains why the pod's init container could not fetch the
+// job's data inputs, if that is why the pod failed. Anything that looks
+// like a credential in the message is masked.
+func inputFailure(pod *corev1.Pod) (string, bool) {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == inputsContainer && status.State.Terminated != nil && status.State.Terminated.ExitCode != 0 {
+			message := strings.TrimSpace(status.State.Terminated.Message)
+			if message == "" {
+				message = fmt.Sprintf("exit code %d", status.State.Terminated.ExitCode)
+			}
+			return "Failed to fetch data inputs: " + redact.String(message), true
+		}
+	}
+	return "", false
+}
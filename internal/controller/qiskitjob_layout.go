@@ -0,0 +1,48 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+// layoutReporter defines _report_layout, with which backend epilogues report
+// how the bitstrings of their counts map onto the qubits the transpiled
+// circuit ran on: the physical qubit each logical qubit ended up on, and for
+// each measured register, in bitstring order, the physical qubit each bit
+// was measured from, along with the depth and two-qubit gates of the
+// transpiled circuit. A layout that cannot be read never fails the job.
+const layoutReporter = `
+
+# Report the qubit layout, so bitstrings can be read against the device
+def _report_layout(_transpiled, _registers):
+    import json as _layout_json
+    try:
+        _layout = getattr(_transpiled, 'layout', None)
+        _physical = _layout.final_index_layout() if _layout is not None else list(range(_transpiled.num_qubits))
+        _measured = {}
+        for _instruction in _transpiled.data:
+            if _instruction.operation.name == 'measure':
+                _measured[_instruction.clbits[0]] = _transpiled.find_bit(_instruction.qubits[0]).index
+        _transpiled_registers = {_register.name: _register for _register in _transpiled.cregs}
+        _two_qubit_gates = sum(1 for _instruction in _transpiled.data if len(_instruction.qubits) == 2 and _instruction.operation.name != 'barrier')
+        _report = {'physical_qubits': _physical, 'registers': [], 'depth': _transpiled.depth(), 'two_qubit_gates': _two_qubit_gates}
+        # Qiskit prints the last register first, and bit 0 of each rightmost
+        for _register in reversed(list(_registers)):
+            _bits = _transpiled_registers.get(_register.name, _register)
+            _report['registers'].append({'name': _register.name, 'size': _register.size,
+                'measured_qubits': [_measured.get(_bit, -1) for _bit in _bits]})
+        print(_layout_json.dumps({'qubit_layout': _report}), flush=True)
+    except Exception as _error:
+        print('Qubit layout not reported:', _error)
+`
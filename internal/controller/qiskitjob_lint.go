@@ -0,0 +1,81 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/lint"
+)
+
+// ConditionLintWarnings is True when circuit linting reported findings
+const ConditionLintWarnings = "LintWarnings"
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// lintCircuit runs the namespace's lint rule set against inline, ConfigMap or
+// URL circuit code and records the outcome in the LintWarnings condition.
+// Findings never fail the job.
+func (r *QiskitJobReconciler) lintCircuit(ctx context.Context, job *quantumv1.QiskitJob) {
+	logger := log.FromContext(ctx)
+
+	if source := job.Spec.Circuit.Source; source != "inline" && source != "configmap" && source != "url" {
+		return
+	}
+	// The rules read Python; OpenQASM programs are checked when validated
+	if qasmVersion(job) > 0 {
+		return
+	}
+	code, err := r.circuitCode(ctx, job)
+	if err != nil {
+		logger.Error(err, "Failed to read circuit code, not linting it")
+		return
+	}
+
+	ruleIDs, err := lint.RulesForNamespace(ctx, r.Client, job.Namespace)
+	if err != nil {
+		logger.Error(err, "Failed to load namespace lint rules, using all rules")
+		ruleIDs = lint.RuleIDs()
+	}
+
+	findings := lint.Run(code, ruleIDs)
+	condition := metav1.Condition{
+		Type:               ConditionLintWarnings,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NoFindings",
+		Message:            fmt.Sprintf("%d lint rules passed", len(ruleIDs)),
+		ObservedGeneration: job.Generation,
+	}
+	if len(findings) > 0 {
+		messages := make([]string, 0, len(findings))
+		for _, f := range findings {
+			messages = append(messages, f.String())
+		}
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "FindingsReported"
+		condition.Message = strings.Join(messages, "; ")
+		logger.Info("Circuit lint reported findings", "count", len(findings))
+	}
+	meta.SetStatusCondition(&job.Status.Conditions, condition)
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// TerminalLabel is set to the phase of jobs that finished for good once the
+// operator has nothing left to do for them, when terminal jobs are kept out
+// of the cache. Removing it brings a job back into the cache, e.g. to ask
+// for its debug pod.
+const TerminalLabel = "quantum.io/terminal"
+
+// DefaultJobListPageSize is how many jobs are read from the API server at a
+// time when terminal jobs are kept out of the cache and no page size is set
+const DefaultJobListPageSize = 500
+
+// TerminalJobsSelector selects the jobs the cache holds when terminal jobs
+// are kept out of it
+func TerminalJobsSelector() labels.Selector {
+	requirement, err := labels.NewRequirement(TerminalLabel, selection.DoesNotExist, nil)
+	if err != nil {
+		panic(err)
+	}
+	return labels.NewSelector().Add(*requirement)
+}
+
+// JobLister lists QiskitJobs for tasks that go through every job of a
+// namespace or the cluster, like namespace summaries and rollout
+// evaluation. By default jobs are read from the cache, which holds all of
+// them. With a page size, or with terminal jobs kept out of the cache, jobs
+// the cache does not serve are read from the API server a page at a time,
+// so namespaces with many historical jobs are never held in memory at once.
+type JobLister struct {
+	// Cache reads jobs from the manager's cache
+	Cache client.Reader
+
+	// APIReader reads jobs from the API server
+	APIReader client.Reader
+
+	// PageSize is how many jobs are read from the API server at a time. Set
+	// without UncachedTerminal, every job is read from the API server.
+	PageSize int64
+
+	// UncachedTerminal is set when the cache leaves out jobs labeled with
+	// TerminalLabel
+	UncachedTerminal bool
+}
+
+// eachJob calls fn for every job the lister lists, or c without a lister
+func eachJob(ctx context.Context, c client.Reader, lister *JobLister, fn func(*quantumv1.QiskitJob) error, opts ...client.ListOption) error {
+	if lister == nil {
+		lister = &JobLister{Cache: c}
+	}
+	return lister.Each(ctx, fn, opts...)
+}
+
+// Each calls fn for every job matching the options, stopping at the first
+// error. Jobs read from the API server are only valid during the call.
+func (l *JobLister) Each(ctx context.Context, fn func(*quantumv1.QiskitJob) error, opts ...client.ListOption) error {
+	if !l.UncachedTerminal {
+		if l.PageSize > 0 && l.APIReader != nil {
+			return l.pages(ctx, fn, nil, opts...)
+		}
+		var jobs quantumv1.QiskitJobList
+		if err := l.Cache.List(ctx, &jobs, opts...); err != nil {
+			return err
+		}
+		for i := range jobs.Items {
+			if err := fn(&jobs.Items[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Live jobs come from the cache and terminal ones from the API server. A
+	// job labeled since the cache last saw it is in both.
+	var live quantumv1.QiskitJobList
+	if err := l.Cache.List(ctx, &live, opts...); err != nil {
+		return err
+	}
+	seen := make(map[types.UID]bool, len(live.Items))
+	for i := range live.Items {
+		seen[live.Items[i].UID] = true
+		if err := fn(&live.Items[i]); err != nil {
+			return err
+		}
+	}
+	terminal, err := labels.NewRequirement(TerminalLabel, selection.Exists, nil)
+	if err != nil {
+		return err
+	}
+	return l.pages(ctx, func(job *quantumv1.QiskitJob) error {
+		if seen[job.UID] {
+			return nil
+		}
+		return fn(job)
+	}, terminal, opts...)
+}
+
+// pages reads the jobs matching the options and requirement from the API
+// server a page at a time
+func (l *JobLister) pages(ctx context.Context, fn func(*quantumv1.QiskitJob) error, requirement *labels.Requirement, opts ...client.ListOption) error {
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+	if requirement != nil {
+		selector := listOpts.LabelSelector
+		if selector == nil {
+			selector = labels.NewSelector()
+		}
+		listOpts.LabelSelector = selector.Add(*requirement)
+	}
+	listOpts.Limit = l.PageSize
+	if listOpts.Limit <= 0 {
+		listOpts.Limit = DefaultJobListPageSize
+	}
+	for {
+		var page quantumv1.QiskitJobList
+		if err := l.APIReader.List(ctx, &page, listOpts); err != nil {
+			return err
+		}
+		for i := range page.Items {
+			if err := fn(&page.Items[i]); err != nil {
+				return err
+			}
+		}
+		if page.Continue == "" {
+			return nil
+		}
+		listOpts.Continue = page.Continue
+	}
+}
+
+// getJob reads the job by that name, from the API server if it is a
+// terminal job the cache does not hold
+func getJob(ctx context.Context, c client.Reader, lister *JobLister, key types.NamespacedName, job *quantumv1.QiskitJob) error {
+	err := c.Get(ctx, key, job)
+	if err == nil || !errors.IsNotFound(err) || lister == nil || !lister.UncachedTerminal {
+		return err
+	}
+	return lister.APIReader.Get(ctx, key, job)
+}
+
+// jobUID returns the UID of the job by that name, looking terminal jobs the
+// cache does not hold up by their metadata only. It returns a NotFound
+// error if there is no such job.
+func jobUID(ctx context.Context, c client.Reader, lister *JobLister, key types.NamespacedName) (types.UID, error) {
+	var job quantumv1.QiskitJob
+	err := c.Get(ctx, key, &job)
+	if err == nil || !errors.IsNotFound(err) || lister == nil || !lister.UncachedTerminal {
+		return job.UID, err
+	}
+	metadata := &metav1.PartialObjectMetadata{}
+	metadata.SetGroupVersionKind(quantumv1.GroupVersion.WithKind("QiskitJob"))
+	if err := lister.APIReader.Get(ctx, key, metadata); err != nil {
+		return "", err
+	}
+	return metadata.UID, nil
+}
+
+// uncacheTerminal labels a job that finished for good with TerminalLabel,
+// which drops it from the cache when terminal jobs are kept out of it. The
+// finalizer goes with it, as the operator no longer sees the job's deletion;
+// garbage collection removes what the job owns. Jobs dispatched to spoke
+// clusters stay cached, so deleting them still withdraws their copy.
+func (r *QiskitJobReconciler) uncacheTerminal(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	if !r.UncachedTerminalJobs || job.Status.Cluster != "" {
+		return ctrl.Result{}, nil
+	}
+	if job.Status.Phase != PhaseCancelled {
+		if _, ok := finishedForGood(job); !ok {
+			return ctrl.Result{}, nil
+		}
+	}
+	if job.Labels[TerminalLabel] == string(job.Status.Phase) && !controllerutil.ContainsFinalizer(job, qiskitJobFinalizer) {
+		return ctrl.Result{}, nil
+	}
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[TerminalLabel] = string(job.Status.Phase)
+	controllerutil.RemoveFinalizer(job, qiskitJobFinalizer)
+	log.FromContext(ctx).Info("Dropping terminal job from the cache", "phase", job.Status.Phase)
+	return ctrl.Result{}, r.Update(ctx, job)
+}
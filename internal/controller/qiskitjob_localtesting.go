@@ -0,0 +1,107 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+	"github.com/quantum-operator/qiskit-operator/pkg/heartbeat"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+// localTestingEpilogue runs the circuit qc defined by the job's code in
+// qiskit-ibm-runtime's local testing mode: the V2 Sampler against Aer with
+// the noise model and coupling map of a fake IBM backend, with the job's
+// dynamical decoupling, and reports the qubit layout on the device after
+// the counts of the first register.
+const localTestingEpilogue = layoutReporter + transpilerOptions + errorSuppression + `
+
+# Local testing mode: transpile for and sample on a fake IBM backend
+import json as _json
+import os as _os
+from qiskit_ibm_runtime import SamplerV2 as _Sampler
+from qiskit_ibm_runtime.fake_provider import FakeProviderForBackendV2 as _FakeProvider
+_backend = _FakeProvider().backend(_os.environ['BACKEND_NAME'])
+_isa = _pass_manager_for(_backend).run(qc)
+_pub = _Sampler(mode=_backend).run([_suppress_errors(_isa, _backend)], shots=int(_os.environ['SHOTS'])).result()[0]
+_counts = getattr(_pub.data, qc.cregs[0].name).get_counts()
+print(_json.dumps({'backend': _backend.name, 'mode': 'local_testing', 'counts': _counts}))
+_report_layout(_isa, qc.cregs[:1])
+`
+
+// localTestingBackend maps the backend name of an ibm_local_testing job to
+// the fake backend modelling it: "ibm_brisbane" runs on "fake_brisbane"
+func localTestingBackend(spec *quantumv1.BackendSpec) string {
+	if strings.HasPrefix(spec.Name, "fake_") {
+		return spec.Name
+	}
+	return "fake_" + strings.TrimPrefix(spec.Name, "ibm_")
+}
+
+// executionCode returns the Python the execution pod runs for the job:
+// the redaction and heartbeat prologues, the circuit code, entrypoint runner or OpenQASM loader, the
+// binding of a sweep's parameters or the measurements of a tomography setting, the optimizer loop if the job runs one, which samples its optimum itself,
+// the estimator if the job runs one, which samples nothing, or else any
+// backend epilogue, followed by the transpiled circuit's publisher if the
+// job asks for it, and the writer of pvc outputs. With the uploader
+// sidecar, the results are handed over to it instead of written to pvc
+// outputs.
+func executionCode(job *quantumv1.QiskitJob, circuitCode string, uploader bool) string {
+	prologue := redact.Prologue + heartbeat.Prologue
+	switch {
+	case uploader:
+		prologue += uploaderPrologue
+	case pvcOutput(job) != nil:
+		prologue += pvcOutputPrologue
+	}
+	code := prologue + circuitCode
+	switch {
+	case isBundle(job) || isGit(job):
+		code = prologue + entrypointRunner
+	case qasmVersion(job) > 0:
+		code = prologue + qasmLoader(job)
+	}
+	if job.Spec.Sweep != nil {
+		code += sweepEpilogue
+	}
+	if job.Spec.Tomography != nil {
+		code += tomographyEpilogue
+	}
+	switch {
+	case job.Spec.Optimizer != nil:
+		code += optimizerEpilogue
+	case defaults.Estimates(&job.Spec):
+		code += estimatorEpilogue
+	case job.Spec.Backend.Type == "local_simulator":
+		code += simulatorEpilogue
+	}
+	if backendType(job) == "ibm_local_testing" {
+		if !defaults.Estimates(&job.Spec) {
+			code += localTestingEpilogue
+		}
+		if results.PublishesTranspiled(job) {
+			code += transpiledEpilogue
+		}
+	}
+	if pvcOutput(job) != nil && !uploader {
+		code += pvcOutputEpilogue
+	}
+	return code
+}
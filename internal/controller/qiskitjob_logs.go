@@ -0,0 +1,53 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+// keepExecutorLogs keeps the tail of the logs of the job's execution in its
+// logs ConfigMap and references it from the job's status. Logs that cannot
+// be kept are only logged: losing them is no reason to hold up the job.
+func (r *QiskitJobReconciler) keepExecutorLogs(ctx context.Context, job *quantumv1.QiskitJob, logs string) {
+	if r.LogTailBytes <= 0 || logs == "" {
+		return
+	}
+	kept, err := results.KeepLogs(ctx, r.Client, r.Scheme, job, job.Status.JobID, logs, r.LogTailBytes)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to keep executor logs")
+		return
+	}
+	job.Status.Logs = kept
+}
+
+// indexArtifacts lists the files the job produced in its results summary,
+// once its outputs recorded where its results went. File names are masked
+// like the logs they are listed in.
+func indexArtifacts(job *quantumv1.QiskitJob, logs string) {
+	if job.Status.Results == nil {
+		return
+	}
+	files, _ := results.ParseArtifacts(redact.String(logs))
+	job.Status.Results.Artifacts = results.Artifacts(job, files)
+}
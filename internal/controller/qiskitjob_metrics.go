@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// recordPhaseMetrics updates the lifecycle metrics of a job that has just
+// moved from oldPhase to its current phase
+func recordPhaseMetrics(job *quantumv1.QiskitJob, oldPhase quantumv1.QiskitJobPhase) {
+	phase := job.Status.Phase
+	if phase == oldPhase {
+		return
+	}
+	kind := backendType(job)
+	metrics.JobPhaseTransitions.WithLabelValues(string(phase), kind).Inc()
+
+	// Retried attempts validate again long after the job was submitted
+	if oldPhase == PhaseValidating && job.Status.RetryCount == 0 && job.Status.StartTime != nil {
+		metrics.JobValidationDuration.WithLabelValues(kind).Observe(time.Since(job.Status.StartTime.Time).Seconds())
+	}
+
+	switch phase {
+	case PhaseCompleted, PhaseFailed:
+		if job.Status.Metrics != nil {
+			if d, err := time.ParseDuration(job.Status.Metrics.ExecutionTime); err == nil {
+				metrics.JobExecutionDuration.WithLabelValues(kind, string(phase)).Observe(d.Seconds())
+			}
+		}
+	}
+	if phase == PhaseCompleted {
+		if cost, err := parseCost(job.Status.ActualCost); err == nil && cost > 0 {
+			metrics.JobCost.WithLabelValues(queueBackendKey(job), costCenter(job)).Add(cost)
+		}
+	}
+}
+
+// costCenter returns the cost center the job's spend is charged to, if any
+func costCenter(job *quantumv1.QiskitJob) string {
+	return job.Status.CostCenter
+}
+
+// ActiveJobs counts, per namespace and phase, the jobs that have not
+// reached a terminal phase
+func ActiveJobs(ctx context.Context, c client.Reader) ([]metrics.ActiveJobs, error) {
+	var jobs quantumv1.QiskitJobList
+	if err := c.List(ctx, &jobs); err != nil {
+		return nil, err
+	}
+
+	byKey := map[[2]string]*metrics.ActiveJobs{}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if finished(job) {
+			continue
+		}
+		phase := job.Status.Phase
+		if phase == "" {
+			phase = PhasePending
+		}
+		key := [2]string{job.Namespace, string(phase)}
+		a, ok := byKey[key]
+		if !ok {
+			a = &metrics.ActiveJobs{Namespace: job.Namespace, Phase: string(phase)}
+			byKey[key] = a
+		}
+		a.Jobs++
+	}
+
+	active := make([]metrics.ActiveJobs, 0, len(byKey))
+	for _, a := range byKey {
+		active = append(active, *a)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		if active[i].Namespace != active[j].Namespace {
+			return active[i].Namespace < active[j].Namespace
+		}
+		return active[i].Phase < active[j].Phase
+	})
+	return active, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/migration"
+)
+
+// JobMigration describes how migrating one stored job changed it
+type JobMigration struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Fields are the deprecated spec fields that were rewritten
+	Fields      []string                 `json:"fields,omitempty"`
+	FromPhase   quantumv1.QiskitJobPhase `json:"fromPhase,omitempty"`
+	ToPhase     quantumv1.QiskitJobPhase `json:"toPhase,omitempty"`
+	FromVersion int                      `json:"fromVersion"`
+	ToVersion   int                      `json:"toVersion"`
+	// Error is why the migrated job could not be written
+	Error string `json:"error,omitempty"`
+}
+
+// statusChanged reports whether the migration rewrote the job's status
+func (m *JobMigration) statusChanged() bool {
+	return m.FromPhase != m.ToPhase || m.FromVersion != m.ToVersion
+}
+
+// MigrationReport summarizes a bulk migration
+type MigrationReport struct {
+	DryRun bool `json:"dryRun"`
+	// Scanned is how many jobs were read
+	Scanned int `json:"scanned"`
+	// Jobs are the jobs that needed migrating
+	Jobs []JobMigration `json:"jobs"`
+}
+
+// Failed returns how many migrated jobs could not be written
+func (r *MigrationReport) Failed() int {
+	failed := 0
+	for _, job := range r.Jobs {
+		if job.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// Migrator rewrites stored QiskitJobs from the schema and phase conventions
+// of older operators to the current ones in bulk. The reconciler migrates
+// each job it reconciles the same way; the Migrator does it up front, so
+// conventions can be retired without waiting for every job to be reconciled.
+type Migrator struct {
+	client.Client
+
+	// Namespace limits migration to one namespace; empty migrates all
+	Namespace string
+
+	// DryRun reports what would change without writing anything
+	DryRun bool
+}
+
+// Run migrates every job that needs it. Jobs that cannot be written are
+// reported and do not stop the run.
+func (m *Migrator) Run(ctx context.Context) (*MigrationReport, error) {
+	var jobs quantumv1.QiskitJobList
+	if err := m.List(ctx, &jobs, client.InNamespace(m.Namespace)); err != nil {
+		return nil, fmt.Errorf("listing QiskitJobs: %w", err)
+	}
+
+	report := &MigrationReport{DryRun: m.DryRun, Scanned: len(jobs.Items), Jobs: []JobMigration{}}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.DeletionTimestamp != nil {
+			continue
+		}
+		change := upgradeJob(job)
+		if len(change.Fields) == 0 && !change.statusChanged() {
+			continue
+		}
+		if !m.DryRun {
+			if err := m.write(ctx, job, &change); err != nil {
+				change.Error = err.Error()
+			}
+		}
+		report.Jobs = append(report.Jobs, change)
+	}
+	return report, nil
+}
+
+// write stores the migrated spec and status of the job. Status is a
+// subresource, so it is written separately after the spec.
+func (m *Migrator) write(ctx context.Context, job *quantumv1.QiskitJob, change *JobMigration) error {
+	status := job.Status.DeepCopy()
+	if len(change.Fields) > 0 {
+		if err := m.Update(ctx, job); err != nil {
+			return err
+		}
+	}
+	if change.statusChanged() {
+		job.Status = *status
+		return m.Status().Update(ctx, job)
+	}
+	return nil
+}
+
+// upgradeJob rewrites a job stored by an older operator in current terms, in
+// place: deprecated spec fields, and a status phase the reconciler would
+// normalize or resume from
+func upgradeJob(job *quantumv1.QiskitJob) JobMigration {
+	change := JobMigration{
+		Namespace:   job.Namespace,
+		Name:        job.Name,
+		FromPhase:   job.Status.Phase,
+		FromVersion: job.Status.PhaseMachineVersion,
+	}
+	change.Fields = migration.Migrate(job)
+	upgradeStatus(job)
+	if _, ok := normalizePhase(job.Status.Phase); !ok && job.Status.Phase != "" {
+		job.Status.Phase = resumePhase(job)
+	}
+	change.ToPhase = job.Status.Phase
+	change.ToVersion = job.Status.PhaseMachineVersion
+	return change
+}
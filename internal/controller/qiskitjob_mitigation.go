@@ -0,0 +1,109 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// errorMitigationEnv holds the job's error mitigation for the executor, as
+// a backend.ErrorMitigation in JSON
+const errorMitigationEnv = "ERROR_MITIGATION"
+
+// errorSuppression defines _suppress_errors, with which the epilogues of
+// fake IBM backends pad the idle periods of the transpiled circuit with the
+// dynamical decoupling sequence of the job. Local testing mode ignores the
+// primitives' own mitigation options, so this is all the executor applies:
+// it reports the mitigations that changed the circuit on a JSON log line,
+// and returns the circuit to run.
+const errorSuppression = `
+
+# Error suppression: dynamical decoupling on the idle periods of the qubits
+def _suppress_errors(_isa, _backend):
+    import json as _em_json
+    import os as _em_os
+    import sys as _em_sys
+    if not _em_os.environ.get('` + errorMitigationEnv + `'):
+        return _isa
+    _sequence = _em_json.loads(_em_os.environ['` + errorMitigationEnv + `']).get('dynamicalDecoupling')
+    _applied = []
+    if _sequence:
+        from math import pi as _em_pi
+        from qiskit.circuit.library import RXGate as _EmRX, XGate as _EmX, YGate as _EmY
+        from qiskit.transpiler import PassManager as _EmPassManager
+        from qiskit.transpiler.passes import ALAPScheduleAnalysis as _EmSchedule, PadDynamicalDecoupling as _EmPad
+        _gates = {'XX': [_EmX(), _EmX()], 'XpXm': [_EmX(), _EmRX(-_em_pi)], 'XY4': [_EmX(), _EmY(), _EmX(), _EmY()]}[_sequence]
+        try:
+            _padded = _EmPassManager([_EmSchedule(target=_backend.target),
+                                      _EmPad(target=_backend.target, dd_sequence=_gates)]).run(_isa)
+        except Exception as _em_error:
+            print('dynamical decoupling not applied: %s' % _em_error, file=_em_sys.stderr)
+            _padded = _isa
+        # Qubits whose gates the backend lacks, or without idle periods, are
+        # left as they were
+        if _padded.count_ops() != _isa.count_ops():
+            _isa = _padded
+            _applied.append('` + backend.MitigationDynamicalDecoupling + `:' + _sequence)
+    print(_em_json.dumps({'mitigations': _applied}), flush=True)
+    return _isa
+`
+
+// errorMitigationOf returns the error suppression and mitigation the job
+// requests, beyond its resilience level
+func errorMitigationOf(job *quantumv1.QiskitJob) backend.ErrorMitigation {
+	spec := job.Spec.Execution.ErrorMitigation
+	if spec == nil {
+		return backend.ErrorMitigation{}
+	}
+	mitigation := backend.ErrorMitigation{
+		DynamicalDecoupling:   spec.DynamicalDecoupling,
+		MeasurementMitigation: spec.MeasurementMitigation,
+	}
+	if spec.Twirling != nil {
+		mitigation.GateTwirling = spec.Twirling.Gates
+		mitigation.MeasureTwirling = spec.Twirling.Measure
+	}
+	return mitigation
+}
+
+// mitigationEnv passes the job's error mitigation to the executor, if it
+// sets any
+func mitigationEnv(job *quantumv1.QiskitJob) []corev1.EnvVar {
+	if job.Spec.Execution.ErrorMitigation == nil {
+		return nil
+	}
+	data, err := json.Marshal(errorMitigationOf(job))
+	if err != nil {
+		return nil
+	}
+	return []corev1.EnvVar{{Name: errorMitigationEnv, Value: string(data)}}
+}
+
+// providerMitigations returns the mitigations the backend applied to the
+// job it ran, nil for backends that cannot tell
+func providerMitigations(adapter backend.Backend, job *backend.QuantumJob) []string {
+	mitigator, ok := adapter.(backend.Mitigator)
+	if !ok {
+		return nil
+	}
+	return mitigator.Mitigations(job)
+}
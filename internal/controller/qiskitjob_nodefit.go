@@ -0,0 +1,182 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// statevectorAmplitudeBytes is the size of an amplitude of a statevector,
+// a complex128
+const statevectorAmplitudeBytes = 16
+
+// NodeFitError reports that no schedulable node of the cluster has the
+// resources the execution pod of a job needs
+type NodeFitError struct {
+	// Needs are the resources the execution pod needs
+	Needs corev1.ResourceList
+	// Qubits of the statevector the memory need is raised to, if any
+	Qubits int
+	// Largest is the schedulable node with the most allocatable memory, nil
+	// if there is none
+	Largest *corev1.Node
+}
+
+func (e *NodeFitError) Error() string {
+	needs := formatResources(e.Needs, e.Needs)
+	if e.Qubits > 0 {
+		needs += fmt.Sprintf(" (memory for a %d-qubit statevector)", e.Qubits)
+	}
+	if e.Largest == nil {
+		return fmt.Sprintf("No schedulable node can run the execution pod, which needs %s", needs)
+	}
+	return fmt.Sprintf("No schedulable node can run the execution pod, which needs %s; the largest node, %s, has %s allocatable",
+		needs, e.Largest.Name, formatResources(e.Needs, e.Largest.Status.Allocatable))
+}
+
+// formatResources lists the quantities of the resources named in needs
+func formatResources(needs, quantities corev1.ResourceList) string {
+	names := make([]string, 0, len(needs))
+	for name := range needs {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		quantity := quantities[corev1.ResourceName(name)]
+		parts = append(parts, fmt.Sprintf("%s %s", name, quantity.String()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// statevectorQubits returns the qubits of the statevector the job's
+// execution pod simulates on the CPU, or 0 if it does not
+func statevectorQubits(job *quantumv1.QiskitJob) int {
+	if remote(job) || gpuAccelerated(job) || job.Status.CircuitMetadata == nil {
+		return 0
+	}
+	return job.Status.CircuitMetadata.Qubits
+}
+
+// podNeeds returns the resources the job's execution pod needs on a node:
+// what its executor requests, with memory raised to the size of the
+// statevector it simulates, and the qubits of that statevector if it raised
+// the memory
+func podNeeds(job *quantumv1.QiskitJob) (corev1.ResourceList, int) {
+	needs := executorResources(job).Requests
+	qubits := statevectorQubits(job)
+	if qubits <= 0 {
+		return needs, 0
+	}
+	size := int64(math.MaxInt64)
+	if qubits < 59 {
+		size = statevectorAmplitudeBytes << qubits
+	}
+	statevector := *resource.NewQuantity(size, resource.BinarySI)
+	if memory, ok := needs[corev1.ResourceMemory]; ok && memory.Cmp(statevector) >= 0 {
+		return needs, 0
+	}
+	needs[corev1.ResourceMemory] = statevector
+	return needs, qubits
+}
+
+// fits reports whether the node has allocatable every resource needed
+func fits(node *corev1.Node, needs corev1.ResourceList) bool {
+	for name, need := range needs {
+		allocatable, ok := node.Status.Allocatable[name]
+		if need.Sign() > 0 && (!ok || allocatable.Cmp(need) < 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeUnfit checks that a schedulable node can hold the job's execution
+// pod, and returns a NodeFitError if none can. The pod would otherwise stay
+// pending until its user gives up on it. Node pools an autoscaler
+// provisions on demand, told by --executor-node-selector or, for pods
+// requesting GPUs, --gpu-node-selector, are taken to fit. Only nodes
+// matching the job's spec.scheduling.nodeSelector are considered, and a
+// forbidden or empty node list skips the check like that of scratch space.
+func (r *QiskitJobReconciler) nodeUnfit(ctx context.Context, job *quantumv1.QiskitJob) error {
+	if remote(job) || dispatched(job) || len(r.ExecutorNodeSelector) > 0 ||
+		(requestsGPU(job) && len(r.GPUNodeSelector) > 0) {
+		return nil
+	}
+	var opts []client.ListOption
+	if scheduling := job.Spec.Scheduling; scheduling != nil && len(scheduling.NodeSelector) > 0 {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(scheduling.NodeSelector)})
+	}
+	var nodes corev1.NodeList
+	if err := r.List(ctx, &nodes, opts...); err != nil {
+		if apierrors.IsForbidden(err) {
+			logf.FromContext(ctx).V(1).Info("Cannot list nodes, skipping node fit check")
+			return nil
+		}
+		return err
+	}
+	if len(nodes.Items) == 0 {
+		return nil
+	}
+
+	needs, qubits := podNeeds(job)
+	var largest *corev1.Node
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if fits(node, needs) {
+			return nil
+		}
+		if largest == nil || node.Status.Allocatable.Memory().Cmp(*largest.Status.Allocatable.Memory()) > 0 {
+			largest = node
+		}
+	}
+	return &NodeFitError{Needs: needs, Qubits: qubits, Largest: largest}
+}
+
+// holdForNodeFit fails a job whose execution pod no node can hold. It
+// reports whether the job failed, in which case reconciliation should stop
+// with the returned result.
+func (r *QiskitJobReconciler) holdForNodeFit(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	err := r.nodeUnfit(ctx, job)
+	if err == nil {
+		return ctrl.Result{}, false, nil
+	}
+	var fitErr *NodeFitError
+	if !errors.As(err, &fitErr) {
+		return ctrl.Result{}, true, err
+	}
+	result, err := r.updateJobPhase(ctx, job, PhaseFailed, fitErr.Error())
+	return result, true, err
+}
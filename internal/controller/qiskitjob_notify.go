@@ -0,0 +1,234 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+	"github.com/quantum-operator/qiskit-operator/pkg/notify"
+)
+
+// Notification delivery retries: the first retry waits
+// notificationRetryDelay, each one after waits twice as long up to
+// maxNotificationRetryDelay, and a notification that failed
+// maxNotificationAttempts times is given up on
+const (
+	notificationRetryDelay    = 30 * time.Second
+	maxNotificationRetryDelay = 10 * time.Minute
+	maxNotificationAttempts   = 6
+)
+
+// notificationWindow is how long after a job finished it is still notified
+// about, so that jobs finished before notifications were configured or the
+// operator was upgraded are not notified about all at once
+const notificationWindow = 24 * time.Hour
+
+// ReasonNotificationFailed is the reason of the events recorded for
+// notifications given up on
+const ReasonNotificationFailed = "NotificationFailed"
+
+// Notification is sent for every job that finishes, as configured for the
+// operator
+type Notification struct {
+	quantumv1.NotificationSpec
+
+	// Secret holds the URL and headers of the notification like the Secret
+	// of spec.notifications[].secretName, in any namespace
+	Secret *types.NamespacedName
+}
+
+// notificationsOf returns the notifications sent for the job: those of the
+// operator, then those of its spec, with their Secrets
+func (r *QiskitJobReconciler) notificationsOf(job *quantumv1.QiskitJob) []Notification {
+	notifications := slices.Clone(r.Notifications)
+	for _, spec := range job.Spec.Notifications {
+		n := Notification{NotificationSpec: spec}
+		if spec.SecretName != "" {
+			n.Secret = &types.NamespacedName{Namespace: job.Namespace, Name: spec.SecretName}
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications
+}
+
+// notificationName is the name of the notification in status.notifications
+func notificationName(n *Notification) string {
+	if n.Name != "" {
+		return n.Name
+	}
+	if n.Type != "" {
+		return n.Type
+	}
+	return notify.TypeWebhook
+}
+
+// notifies reports whether the notification is sent for jobs ending in
+// phase
+func (n *Notification) notifies(phase quantumv1.QiskitJobPhase) bool {
+	if len(n.Phases) == 0 {
+		return phase.Finished()
+	}
+	return slices.Contains(n.Phases, string(phase))
+}
+
+// notificationDelay is how long to wait after the attempts that failed
+// before the next one
+func notificationDelay(attempts int32) time.Duration {
+	delay := notificationRetryDelay
+	for i := int32(1); i < attempts && delay < maxNotificationRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxNotificationRetryDelay)
+}
+
+// sendNotifications tells the job's notification endpoints that it
+// finished, once each, recording every delivery in status.notifications.
+// Failed deliveries are retried with backoff until they are given up on;
+// they never affect the job itself.
+func (r *QiskitJobReconciler) sendNotifications(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	phase := job.Status.Phase
+	now := time.Now()
+	var retryAfter time.Duration
+	changed := false
+	for _, n := range r.notificationsOf(job) {
+		if !n.notifies(phase) {
+			continue
+		}
+		name := notificationName(&n)
+		i := slices.IndexFunc(job.Status.Notifications, func(s quantumv1.NotificationStatus) bool { return s.Name == name })
+		if i < 0 {
+			if end := job.Status.CompletionTime; end != nil && now.Sub(end.Time) > notificationWindow {
+				continue
+			}
+			job.Status.Notifications = append(job.Status.Notifications, quantumv1.NotificationStatus{Name: name})
+			i = len(job.Status.Notifications) - 1
+		}
+		status := &job.Status.Notifications[i]
+		if status.Phase != phase {
+			// The job finished again, e.g. after being retried by hand
+			*status = quantumv1.NotificationStatus{Name: name, Phase: phase, State: quantumv1.NotificationPending}
+		}
+		if status.State == quantumv1.NotificationSent || status.State == quantumv1.NotificationFailed {
+			continue
+		}
+		if status.LastAttemptTime != nil {
+			if wait := status.LastAttemptTime.Add(notificationDelay(status.Attempts)).Sub(now); wait > 0 {
+				retryAfter = shorterWait(retryAfter, wait)
+				continue
+			}
+		}
+
+		err := r.sendNotification(ctx, job, &n)
+		status.Attempts++
+		status.LastAttemptTime = &metav1.Time{Time: now}
+		changed = true
+		switch {
+		case err == nil:
+			status.State = quantumv1.NotificationSent
+			status.Message = ""
+			metrics.JobNotifications.WithLabelValues(notificationType(&n), "sent").Inc()
+		case notify.Rejected(err) || status.Attempts >= maxNotificationAttempts:
+			status.State = quantumv1.NotificationFailed
+			status.Message = err.Error()
+			metrics.JobNotifications.WithLabelValues(notificationType(&n), "failed").Inc()
+			logger.Error(err, "Giving up on notification", "notification", name, "attempts", status.Attempts)
+			r.event(job, corev1.EventTypeWarning, ReasonNotificationFailed,
+				fmt.Sprintf("Notification %s failed after %d attempts: %v", name, status.Attempts, err))
+		default:
+			status.State = quantumv1.NotificationPending
+			status.Message = err.Error()
+			metrics.JobNotifications.WithLabelValues(notificationType(&n), "retried").Inc()
+			logger.Info("Notification failed, retrying", "notification", name, "attempts", status.Attempts, "error", err.Error())
+			retryAfter = shorterWait(retryAfter, notificationDelay(status.Attempts))
+		}
+	}
+
+	if changed {
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if retryAfter > 0 {
+		requeueBecause(ctx, RequeueBackoff)
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// sendNotification posts the notification of the finished job
+func (r *QiskitJobReconciler) sendNotification(ctx context.Context, job *quantumv1.QiskitJob, n *Notification) error {
+	target := notify.Target{Type: n.Type, URL: n.URL}
+	if n.Secret != nil {
+		if r.WithoutSecrets {
+			return &notify.RejectedError{Body: "notification Secrets need Secret access, which the operator runs without"}
+		}
+		var secret corev1.Secret
+		if err := r.Get(ctx, *n.Secret, &secret); err != nil {
+			return fmt.Errorf("notification Secret %s: %w", n.Secret.Name, err)
+		}
+		target.Headers = map[string]string{}
+		for key, value := range secret.Data {
+			if key == notify.URLKey {
+				target.URL = string(value)
+			} else {
+				target.Headers[key] = string(value)
+			}
+		}
+	}
+	if target.URL == "" {
+		return &notify.RejectedError{Body: "notification has no URL"}
+	}
+	notifier := r.Notifier
+	if notifier == nil {
+		notifier = &notify.Notifier{}
+	}
+	return notifier.Send(ctx, target, notify.PayloadFromJob(job))
+}
+
+// notificationType labels the notification's metrics
+func notificationType(n *Notification) string {
+	if n.Type == "" {
+		return notify.TypeWebhook
+	}
+	return n.Type
+}
+
+// shorterWait returns the shorter of two waits, ignoring unset ones
+func shorterWait(a, b time.Duration) time.Duration {
+	if a <= 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// sooner combines the results of two steps of a reconcile, requeueing as
+// soon as either asks to
+func sooner(a, b ctrl.Result) ctrl.Result {
+	return ctrl.Result{RequeueAfter: shorterWait(a.RequeueAfter, b.RequeueAfter)}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/offload"
+)
+
+// offloadCode moves the inline code of a pending job that opted into
+// offloading into a ConfigMap the job owns, and points the job at it. It
+// reports whether the job was changed, in which case reconciliation should
+// stop with the returned result. Code within the limit stays inline, as
+// does that of jobs dispatched to a spoke cluster, which has no copy of the
+// ConfigMap.
+func (r *QiskitJobReconciler) offloadCode(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if job.Status.Phase != "" && job.Status.Phase != PhasePending {
+		return ctrl.Result{}, false, nil
+	}
+	if !offload.Needed(job) || len(job.Spec.Circuit.Code) > offload.MaxOffloadedBytes || dispatched(job) {
+		return ctrl.Result{}, false, nil
+	}
+
+	cm := offload.ConfigMap(job)
+	if err := controllerutil.SetControllerReference(job, cm, r.Scheme); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	err := r.Create(ctx, cm)
+	if apierrors.IsAlreadyExists(err) {
+		// Left by an attempt whose job update failed; take over its code
+		var existing corev1.ConfigMap
+		if err := r.Get(ctx, types.NamespacedName{Name: cm.Name, Namespace: cm.Namespace}, &existing); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		existing.Data = cm.Data
+		err = r.Update(ctx, &existing)
+	}
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	size := len(job.Spec.Circuit.Code)
+	offload.Apply(job)
+	log.FromContext(ctx).Info("Offloaded inline code", "configMap", cm.Name, "bytes", size)
+	if err := r.Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{Requeue: true}, true, nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// Optimizer defaults for fields the job leaves empty
+const (
+	DefaultOptimizerMethod     = "COBYLA"
+	DefaultOptimizerIterations = 100
+)
+
+// optimizerEpilogue minimizes the expectation value of the observable the
+// circuit code defines over the parameters of qc, starting from its
+// initial_point if it defines one. Expectation values are estimated with
+// the StatevectorEstimator, or for ibm_local_testing with the runtime
+// Estimator in a session on the fake backend. Every iteration is reported
+// as progress, the convergence on a single JSON log line, and qc is left
+// bound to the best parameters for sampling: by the local testing epilogue,
+// or here for the local simulator.
+const optimizerEpilogue = transpilerOptions + `
+
+# Optimizer loop: minimize the expectation value of observable over the parameters of qc
+import json as _json
+import math as _math
+import os as _os
+import sys as _sys
+import numpy as _np
+if globals().get('observable') is None:
+    _sys.exit('the optimizer needs the circuit code to define observable, e.g. a SparsePauliOp')
+_opt_method = _os.environ['OPTIMIZER_METHOD']
+_opt_max_iterations = int(_os.environ['OPTIMIZER_MAX_ITERATIONS'])
+_opt_tolerance = float(_os.environ.get('OPTIMIZER_TOLERANCE', '0'))
+_opt_parameters = list(qc.parameters)
+if not _opt_parameters:
+    _sys.exit('the optimizer needs qc to have parameters')
+_opt_unmeasured = qc.remove_final_measurements(inplace=False)
+_opt_session = None
+if _os.environ.get('BACKEND_NAME'):
+    from qiskit_ibm_runtime import EstimatorV2 as _OptEstimator, Session as _OptSession
+    from qiskit_ibm_runtime.fake_provider import FakeProviderForBackendV2 as _OptFakeProvider
+    _opt_backend = _OptFakeProvider().backend(_os.environ['BACKEND_NAME'])
+    _opt_circuit = _pass_manager_for(_opt_backend).run(_opt_unmeasured)
+    _opt_observable = observable.apply_layout(_opt_circuit.layout)
+    _opt_session = _OptSession(backend=_opt_backend)
+    _opt_estimator = _OptEstimator(mode=_opt_session)
+else:
+    from qiskit.primitives import StatevectorEstimator as _OptEstimator
+    _opt_circuit, _opt_observable = _opt_unmeasured, observable
+    _opt_estimator = _OptEstimator()
+_opt_history = []
+_opt_best = [_math.inf, None]
+def _opt_evaluate(_x):
+    _value = float(_opt_estimator.run([(_opt_circuit, _opt_observable, [float(_v) for _v in _x])]).result()[0].data.evs)
+    if _value < _opt_best[0]:
+        _opt_best[0], _opt_best[1] = _value, [float(_v) for _v in _x]
+    return _value
+def _opt_record(_value):
+    _opt_history.append(_value)
+    report_progress('optimizer iteration %d/%d' % (len(_opt_history), _opt_max_iterations))
+_opt_rng = _np.random.default_rng()
+if globals().get('initial_point') is not None:
+    _opt_x = _np.array([float(_v) for _v in initial_point])
+else:
+    _opt_x = _opt_rng.uniform(0, 2 * _math.pi, len(_opt_parameters))
+_opt_converged = False
+if _opt_method == 'COBYLA':
+    from scipy.optimize import minimize as _opt_minimize
+    def _opt_objective(_x):
+        _value = _opt_evaluate(_x)
+        _opt_record(_value)
+        return _value
+    _opt_result = _opt_minimize(_opt_objective, _opt_x, method='COBYLA', tol=_opt_tolerance or None, options={'maxiter': _opt_max_iterations})
+    _opt_converged = bool(_opt_result.success)
+else:
+    _opt_previous = None
+    for _opt_k in range(_opt_max_iterations):
+        _opt_a = 0.2 / (_opt_k + 1) ** 0.602
+        _opt_c = 0.1 / (_opt_k + 1) ** 0.101
+        _opt_delta = _opt_rng.choice([-1.0, 1.0], len(_opt_x))
+        _opt_plus = _opt_evaluate(_opt_x + _opt_c * _opt_delta)
+        _opt_minus = _opt_evaluate(_opt_x - _opt_c * _opt_delta)
+        _opt_x = _opt_x - _opt_a * (_opt_plus - _opt_minus) / (2 * _opt_c) * _opt_delta
+        _opt_value = (_opt_plus + _opt_minus) / 2
+        _opt_record(_opt_value)
+        if _opt_previous is not None and abs(_opt_value - _opt_previous) < _opt_tolerance:
+            _opt_converged = True
+            break
+        _opt_previous = _opt_value
+if _opt_session is not None:
+    _opt_session.close()
+_opt_values = _opt_best[1]
+print(_json.dumps({'optimization': {'method': _opt_method, 'iterations': len(_opt_history), 'converged': _opt_converged, 'value': _opt_best[0], 'parameters': {_p.name: _v for _p, _v in zip(_opt_parameters, _opt_values)}, 'history': _opt_history}}), flush=True)
+qc = qc.assign_parameters(_opt_values)
+if not _os.environ.get('BACKEND_NAME'):
+    from qiskit.primitives import StatevectorSampler as _OptSampler
+    _opt_sampled = qc if qc.cregs else qc.measure_all(inplace=False)
+    _opt_pub = _OptSampler().run([_opt_sampled], shots=int(_os.environ['SHOTS'])).result()[0]
+    print(_json.dumps({'counts': getattr(_opt_pub.data, _opt_sampled.cregs[0].name).get_counts()}), flush=True)
+`
+
+// optimizerEnv configures the optimizer loop of the job's executor, if it
+// runs one
+func optimizerEnv(job *quantumv1.QiskitJob) []corev1.EnvVar {
+	optimizer := job.Spec.Optimizer
+	if optimizer == nil {
+		return nil
+	}
+	method := optimizer.Method
+	if method == "" {
+		method = DefaultOptimizerMethod
+	}
+	iterations := optimizer.MaxIterations
+	if iterations <= 0 {
+		iterations = DefaultOptimizerIterations
+	}
+	return []corev1.EnvVar{
+		{Name: "OPTIMIZER_METHOD", Value: method},
+		{Name: "OPTIMIZER_MAX_ITERATIONS", Value: strconv.Itoa(iterations)},
+		{Name: "OPTIMIZER_TOLERANCE", Value: strconv.FormatFloat(optimizer.Tolerance, 'g', -1, 64)},
+	}
+}
+
+// recordOptimization records the convergence of the optimizer loop the
+// execution pod logged. A missing report never fails the job.
+func (r *QiskitJobReconciler) recordOptimization(ctx context.Context, job *quantumv1.QiskitJob, logs string) {
+	if job.Spec.Optimizer == nil {
+		return
+	}
+	optimization, ok := results.ParseOptimization(logs)
+	if !ok {
+		log.FromContext(ctx).Info("No optimizer convergence found in execution logs")
+		return
+	}
+	results.RecordOptimization(job, optimization)
+	log.FromContext(ctx).Info(fmt.Sprintf("Optimizer %s reached %g after %d iterations",
+		optimization.Method, optimization.Value, optimization.Iterations), "converged", optimization.Converged)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// outputMountPath is where the claim of a pvc output is mounted
+const outputMountPath = "/output"
+
+// Environment variables telling the executor where and how to write the
+// results of a pvc output
+const (
+	outputDirEnv         = "OUTPUT_DIR"
+	outputFormatEnv      = "OUTPUT_FORMAT"
+	outputCompressionEnv = "OUTPUT_COMPRESSION"
+	// outputDocumentEnv holds the results document without its counts,
+	// which the executor fills in
+	outputDocumentEnv = "OUTPUT_DOCUMENT"
+)
+
+// pvcOutputPrologue creates the job's output directory, where circuit code
+// may write outputs of its own such as statevectors, and watches standard
+// output for the counts the executor reports.
+const pvcOutputPrologue = `import json as _out_json
+import os as _out_os
+import sys as _out_sys
+
+_out_dir = _out_os.environ['` + outputDirEnv + `']
+_out_os.makedirs(_out_dir, exist_ok=True)
+_out_reported = []
+
+class _OutputStdout:
+    def __init__(self, stream):
+        self._stream = stream
+
+    def write(self, text):
+        if text.startswith('{'):
+            try:
+                _out_reported.append(_out_json.loads(text))
+            except ValueError:
+                pass
+        return self._stream.write(text)
+
+    def __getattr__(self, name):
+        return getattr(self._stream, name)
+
+_out_sys.stdout = _OutputStdout(_out_sys.stdout)
+
+`
+
+// pvcOutputEpilogue writes the last counts the executor reported to the
+// job's output directory, in the output's format and compression, the way
+// the operator stores them in other sinks, and lists the other files there.
+const pvcOutputEpilogue = `
+
+# PVC output: write the reported counts under the job's output directory
+import gzip as _out_gzip
+import pickle as _out_pickle
+
+def _out_counts(reported):
+    if not isinstance(reported, dict):
+        return None
+    if isinstance(reported.get('counts'), dict):
+        return reported['counts']
+    if reported and all(isinstance(v, int) and k and set(k) <= set('01 ') for k, v in reported.items()):
+        return reported
+    return None
+
+_out_found = [(r, _out_counts(r)) for r in _out_reported if _out_counts(r) is not None]
+if _out_found:
+    _out_result, _out_result_counts = _out_found[-1]
+    _out_doc = _out_json.loads(_out_os.environ['` + outputDocumentEnv + `'])
+    _out_doc['backend'] = _out_doc.get('backend') or _out_result.get('backend', '')
+    _out_doc['results'] = {'counts': _out_result_counts}
+    _out_layouts = [r['qubit_layout'] for r in _out_reported if isinstance(r, dict) and 'qubit_layout' in r]
+    _out_doc.pop('layout', None)
+    if _out_layouts:
+        _out_doc['layout'] = _out_layouts[-1]
+    _out_format = _out_os.environ.get('` + outputFormatEnv + `', 'json')
+    if _out_format == 'csv':
+        _out_rows = ['outcome,count'] + ['%s,%d' % (k, v) for k, v in sorted(_out_result_counts.items(), key=lambda kv: (-kv[1], kv[0]))]
+        _out_name, _out_data = 'results.csv', ''.join(row + chr(10) for row in _out_rows).encode()
+    elif _out_format == 'pickle':
+        _out_name, _out_data = 'results.pkl', _out_pickle.dumps(_out_doc, protocol=2)
+    else:
+        _out_name, _out_data = 'results.json', _out_json.dumps(_out_doc).encode()
+    if _out_os.environ.get('` + outputCompressionEnv + `') == 'gzip':
+        _out_name, _out_data = _out_name + '.gz', _out_gzip.compress(_out_data)
+    _out_path = _out_os.path.join(_out_dir, _out_name)
+    with open(_out_path + '.tmp', 'wb') as _out_file:
+        _out_file.write(_out_data)
+    _out_os.replace(_out_path + '.tmp', _out_path)
+
+# List the files circuit code wrote next to the results, for the job's
+# artifact index
+_out_files = []
+for _out_root, _out_subdirs, _out_names in _out_os.walk(_out_dir):
+    for _out_file_name in _out_names:
+        _out_file_path = _out_os.path.join(_out_root, _out_file_name)
+        _out_rel = _out_os.path.relpath(_out_file_path, _out_dir)
+        if _out_rel.startswith('results.') or _out_rel.endswith(('.tmp', '.sha256')):
+            continue
+        _out_files.append({'name': _out_rel, 'size': _out_os.path.getsize(_out_file_path)})
+if _out_files:
+    print(_out_json.dumps({'artifacts': sorted(_out_files, key=lambda f: f['name'])[:100]}), flush=True)
+`
+
+// pvcOutput returns the output the executor writes the job's results to a
+// PersistentVolumeClaim for, or nil if it has none. Jobs have at most one.
+func pvcOutput(job *quantumv1.QiskitJob) *quantumv1.OutputSpec {
+	for i := range job.Spec.Outputs {
+		if output := &job.Spec.Outputs[i]; output.Type == "pvc" && output.Location != "" {
+			return output
+		}
+	}
+	return nil
+}
+
+// mountOutput mounts the claim of a pvc output into the execution pod and
+// tells the executor to write the results under the job's directory in it.
+// Volumes are made writable by the executor's group, which is only applied
+// when the volume root does not have it yet.
+func mountOutput(pod *corev1.Pod, job *quantumv1.QiskitJob) error {
+	output := pvcOutput(job)
+	if output == nil {
+		return nil
+	}
+	doc := results.NewDocument(job, nil)
+	doc.JobID = pod.Name
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: "output",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: output.Location},
+		},
+	})
+	if pod.Spec.SecurityContext == nil {
+		pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	pod.Spec.SecurityContext.FSGroup = ptr(int64(1000))
+	pod.Spec.SecurityContext.FSGroupChangePolicy = ptr(corev1.FSGroupChangeOnRootMismatch)
+
+	container := &pod.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: "output", MountPath: outputMountPath})
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: outputDirEnv, Value: path.Join(outputMountPath, results.JobPrefix(job, output))},
+		corev1.EnvVar{Name: outputFormatEnv, Value: output.Format},
+		corev1.EnvVar{Name: outputCompressionEnv, Value: output.Compression},
+		corev1.EnvVar{Name: outputDocumentEnv, Value: string(data)})
+	return nil
+}
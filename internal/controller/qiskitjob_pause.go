@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// PauseHardwareAnnotation on any QuantumBackendPool pauses the submission of
+// jobs to quantum hardware across the cluster, for incident response such as
+// a billing anomaly. Its value is the reason shown on the jobs held.
+// Simulators keep running, and jobs already at their provider keep being
+// polled.
+const PauseHardwareAnnotation = "quantum.io/pause-hardware"
+
+// ConditionHardwarePaused is True while a job waits to be submitted to
+// hardware because hardware submissions are paused
+const ConditionHardwarePaused = "HardwarePaused"
+
+// onHardware reports whether the job, or a share of it split off to another
+// backend, is submitted to quantum hardware rather than simulated
+func onHardware(job *quantumv1.QiskitJob) bool {
+	switch backendType(job) {
+	case string(backend.IBMQuantum), string(backend.GenericHTTP):
+		return true
+	}
+	if job.Spec.Split != nil {
+		for i, part := range splitParts(job) {
+			if splitRemote(job, i, part) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hardwarePause returns the pool pausing hardware submissions, nil if they
+// are not paused. Of several pools, the first by name is reported.
+func (r *QiskitJobReconciler) hardwarePause(ctx context.Context) (*quantumv1.QuantumBackendPool, error) {
+	var pools quantumv1.QuantumBackendPoolList
+	if err := r.List(ctx, &pools); err != nil {
+		return nil, err
+	}
+	var pausing *quantumv1.QuantumBackendPool
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+		if _, ok := pool.Annotations[PauseHardwareAnnotation]; ok && (pausing == nil || pool.Name < pausing.Name) {
+			pausing = pool
+		}
+	}
+	return pausing, nil
+}
+
+// holdForPause keeps hardware jobs from being submitted while hardware
+// submissions are paused, surfacing the reason on the job. It reports
+// whether the job is held, in which case reconciliation should stop with
+// the returned result.
+func (r *QiskitJobReconciler) holdForPause(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if !onHardware(job) {
+		return ctrl.Result{}, false, nil
+	}
+	pool, err := r.hardwarePause(ctx)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	if pool == nil {
+		if meta.IsStatusConditionTrue(job.Status.Conditions, ConditionHardwarePaused) {
+			meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+				Type:               ConditionHardwarePaused,
+				Status:             metav1.ConditionFalse,
+				Reason:             "Resumed",
+				Message:            "Hardware submissions resumed",
+				ObservedGeneration: job.Generation,
+			})
+		}
+		return ctrl.Result{}, false, nil
+	}
+
+	reason := pool.Annotations[PauseHardwareAnnotation]
+	if reason == "" {
+		reason = "no reason given"
+	}
+	message := fmt.Sprintf("Hardware submissions are paused by backend pool %q: %s", pool.Name, reason)
+	if !meta.IsStatusConditionTrue(job.Status.Conditions, ConditionHardwarePaused) {
+		log.FromContext(ctx).Info("Holding submission while hardware is paused", "pool", pool.Name, "reason", reason)
+	}
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionHardwarePaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Paused",
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+	job.Status.Message = message
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	requeueBecause(ctx, RequeueHold)
+	return ctrl.Result{RequeueAfter: quotaRecheckInterval}, true, nil
+}
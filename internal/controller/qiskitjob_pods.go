@@ -0,0 +1,137 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// AttemptLabel records which attempt of a job an execution pod ran
+const AttemptLabel = "quantum.io/attempt"
+
+// DefaultFailedPodRetention is how many failed execution pods are kept per
+// job unless configured otherwise
+const DefaultFailedPodRetention = 3
+
+// executionName names the execution of the job's current attempt, the batch
+// Job running it, so a retry does not reuse, and have to delete, the
+// execution of a failed attempt
+func executionName(job *quantumv1.QiskitJob) string {
+	return fmt.Sprintf("qiskit-job-%s-attempt-%d", job.Name, attempt(job))
+}
+
+// legacyExecutionName is the single pod name used before pods were named
+// per attempt; jobs started by older operators keep tracking it
+func legacyExecutionName(job *quantumv1.QiskitJob) string {
+	return fmt.Sprintf("qiskit-job-%s", job.Name)
+}
+
+// currentExecutionName returns the name of the execution running the job's
+// current attempt
+func currentExecutionName(job *quantumv1.QiskitJob) string {
+	if job.Status.JobID == legacyExecutionName(job) {
+		return job.Status.JobID
+	}
+	return executionName(job)
+}
+
+// podExecution returns the name of the execution a pod runs: its batch Job,
+// or the pod itself for shadow runs and attempts started before executions
+// ran as Jobs. Executors call back and results are read under this name.
+func podExecution(pod *corev1.Pod) string {
+	if name := pod.Labels[batchv1.JobNameLabel]; name != "" {
+		return name
+	}
+	return pod.Name
+}
+
+// attemptKey identifies an attempt of a job across the pods that ran it
+type attemptKey struct {
+	namespace, job string
+	attempt        int
+}
+
+// podAttemptKey returns the attempt an execution pod of the current attempt
+// of its job runs. Debug, shadow and verification pods run no attempt of
+// their own.
+func podAttemptKey(obj client.Object) (attemptKey, bool) {
+	labels := obj.GetLabels()
+	n, err := strconv.Atoi(labels[AttemptLabel])
+	if err != nil || labels["quantum.io/job"] == "" || labels[ShadowLabel] != "" || labels[VerifyLabel] != "" {
+		return attemptKey{}, false
+	}
+	return attemptKey{namespace: jobNamespace(obj), job: labels["quantum.io/job"], attempt: n}, true
+}
+
+// currentAttemptKey returns the key of the job's current attempt
+func currentAttemptKey(job *quantumv1.QiskitJob) attemptKey {
+	return attemptKey{namespace: job.Namespace, job: job.Name, attempt: attempt(job)}
+}
+
+// attempt numbers the job's attempts from 1
+func attempt(job *quantumv1.QiskitJob) int {
+	return job.Status.RetryCount + 1
+}
+
+// pruneFailedPods deletes the job's oldest failed execution pods, keeping the
+// most recent FailedPodRetention of them for `kubectl logs` during triage
+func (r *QiskitJobReconciler) pruneFailedPods(ctx context.Context, job *quantumv1.QiskitJob) error {
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.InNamespace(ExecutionNamespace(job)),
+		client.MatchingLabels{"quantum.io/job": job.Name}); err != nil {
+		return err
+	}
+
+	var failed []*corev1.Pod
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodFailed {
+			failed = append(failed, &pods.Items[i])
+		}
+	}
+	if len(failed) <= r.FailedPodRetention {
+		return nil
+	}
+
+	// Newest attempt first; pods without an attempt label predate it and go first
+	slices.SortFunc(failed, func(a, b *corev1.Pod) int {
+		return podAttempt(b) - podAttempt(a)
+	})
+	for _, pod := range failed[max(r.FailedPodRetention, 0):] {
+		log.FromContext(ctx).Info("Deleting old failed execution pod", "pod", pod.Name)
+		if err := r.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// podAttempt returns the attempt recorded on an execution pod, 0 if none
+func podAttempt(pod *corev1.Pod) int {
+	n, _ := strconv.Atoi(pod.Labels[AttemptLabel])
+	return n
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/flowcontrol"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// DefaultProviderPollQPS is how many status polls per second each remote
+// backend receives at most unless configured otherwise
+const DefaultProviderPollQPS = 5
+
+// DefaultMaxQueuedPollInterval is the longest a remote job queued at its
+// provider waits between polls unless the config file says otherwise
+const DefaultMaxQueuedPollInterval = 5 * time.Minute
+
+// pollJitter spreads the polls of jobs submitted together, as a fraction of
+// the poll interval added at random
+const pollJitter = 0.2
+
+// ProviderPolls paces the status polls of remote jobs per backend, so that
+// many jobs in flight stay within the provider's API quota. It is safe for
+// concurrent use.
+type ProviderPolls struct {
+	// QPS is the most polls per second of a backend
+	QPS float32
+
+	mu       sync.Mutex
+	limiters map[string]flowcontrol.RateLimiter
+}
+
+// NewProviderPolls returns polls paced at qps per backend
+func NewProviderPolls(qps float32) *ProviderPolls {
+	return &ProviderPolls{QPS: qps, limiters: map[string]flowcontrol.RateLimiter{}}
+}
+
+// TryAccept reports whether a job on the backend may poll now. Nil polls
+// are not paced.
+func (p *ProviderPolls) TryAccept(backend string) bool {
+	if p == nil {
+		return true
+	}
+	p.mu.Lock()
+	limiter, ok := p.limiters[backend]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(p.QPS, max(1, int(p.QPS)))
+		p.limiters[backend] = limiter
+	}
+	p.mu.Unlock()
+	return limiter.TryAccept()
+}
+
+// pollInterval is how long a remote job waits until its next status poll,
+// jittered so that jobs submitted together do not poll in bursts
+func (r *QiskitJobReconciler) pollInterval(job *quantumv1.QiskitJob) time.Duration {
+	t := r.tunables()
+	return wait.Jitter(stagePollInterval(job, t.HTTPPollInterval, t.MaxQueuedPollInterval, time.Now()), pollJitter)
+}
+
+// stagePollInterval is how often a remote job is polled at its stage. Jobs
+// the provider runs, or whose stage is unknown yet, are polled every base
+// interval. Queued jobs are polled sparsely, at most every ceiling: halfway
+// to the provider's estimated start, so polls tighten as it nears, or else
+// one base interval per job ahead of them in the queue.
+func stagePollInterval(job *quantumv1.QiskitJob, base, ceiling time.Duration, now time.Time) time.Duration {
+	if job.Status.ProviderPhase != "Queued" {
+		return base
+	}
+	interval := base
+	switch {
+	case job.Status.EstimatedStartTime != nil:
+		interval = job.Status.EstimatedStartTime.Sub(now) / 2
+	case job.Status.QueuePosition != nil:
+		interval = time.Duration(*job.Status.QueuePosition) * base
+	}
+	return min(max(interval, base), max(ceiling, base))
+}
+
+// syncProviderStatus copies the state, queue position and estimated start
+// the provider reports for the remote job into its status. A job the
+// provider started has neither; while queued, the provider's estimate
+// replaces the operator's own prediction when it has one.
+func syncProviderStatus(job *quantumv1.QiskitJob, status *backend.JobStatus) {
+	job.Status.ProviderPhase = status.Phase
+	if status.Phase != "Queued" {
+		job.Status.QueuePosition = nil
+		job.Status.EstimatedStartTime = nil
+		return
+	}
+	job.Status.QueuePosition = status.QueuePosition
+	if status.EstimatedStart != nil {
+		start := metav1.NewTime(*status.EstimatedStart)
+		job.Status.EstimatedStartTime = &start
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// GPUResource is the extended resource executors request GPUs as
+const GPUResource corev1.ResourceName = "nvidia.com/gpu"
+
+// DefaultLongRunThreshold is the maximum execution time from which execution
+// pods are kept from being disrupted by node autoscalers
+const DefaultLongRunThreshold = 15 * time.Minute
+
+// Annotations that keep node autoscalers from evicting an execution pod to
+// consolidate or scale down its node
+const (
+	// KarpenterDoNotDisruptAnnotation is honoured by Karpenter
+	KarpenterDoNotDisruptAnnotation = "karpenter.sh/do-not-disrupt"
+	// SafeToEvictAnnotation is honoured by the Cluster Autoscaler
+	SafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+)
+
+// executorResources returns the resources of the executor container: the
+// defaults, overridden by the job's spec.resources. A request above the
+// default limit raises the limit to it, and extended resources such as GPUs
+// are limited to what they request, as Kubernetes requires. Jobs simulating
+// on a GPU request one unless they request GPUs themselves, and jobs that
+// fell back to the CPU request none.
+func executorResources(job *quantumv1.QiskitJob) corev1.ResourceRequirements {
+	resources := corev1.ResourceRequirements{Requests: corev1.ResourceList{}, Limits: corev1.ResourceList{}}
+	for name, value := range defaults.ExecutorRequests {
+		resources.Requests[corev1.ResourceName(name)] = mustParseQuantity(value)
+	}
+	for name, value := range defaults.ExecutorLimits {
+		resources.Limits[corev1.ResourceName(name)] = mustParseQuantity(value)
+	}
+	// Quantities are validated before the pod is created; invalid ones are skipped
+	if job.Spec.Resources != nil {
+		for name, value := range job.Spec.Resources.Requests {
+			if q, err := resource.ParseQuantity(value); err == nil {
+				resources.Requests[corev1.ResourceName(name)] = q
+			}
+		}
+		for name, value := range job.Spec.Resources.Limits {
+			if q, err := resource.ParseQuantity(value); err == nil {
+				resources.Limits[corev1.ResourceName(name)] = q
+			}
+		}
+	}
+	if _, ok := resources.Requests[GPUResource]; gpuAccelerated(job) && !ok {
+		resources.Requests[GPUResource] = resource.MustParse("1")
+	}
+	if gpuFallback(job) {
+		delete(resources.Requests, GPUResource)
+		delete(resources.Limits, GPUResource)
+	}
+	for name, request := range resources.Requests {
+		limit, limited := resources.Limits[name]
+		if extendedResource(name) || (limited && limit.Cmp(request) < 0) {
+			resources.Limits[name] = request.DeepCopy()
+		}
+	}
+	return resources
+}
+
+// extendedResource reports whether the resource is an extended resource,
+// such as a GPU, rather than one native to Kubernetes
+func extendedResource(name corev1.ResourceName) bool {
+	return strings.Contains(string(name), "/") && !strings.Contains(string(name), "kubernetes.io/")
+}
+
+// requestsGPU reports whether the job's executor requests GPUs
+func requestsGPU(job *quantumv1.QiskitJob) bool {
+	gpus, ok := executorResources(job).Requests[GPUResource]
+	return ok && gpus.Sign() > 0
+}
+
+// addProvisioningHints tells node autoscalers such as Karpenter and the
+// Cluster Autoscaler what the execution pod needs, so the right instance
+// types are provisioned as soon as it is pending: the executor node pool's
+// selector, the GPU node pool's selector and a toleration of its taint for
+// pods that request GPUs, and, for long runs, annotations that keep the pod's
+// node from being consolidated or scaled down under it.
+func (r *QiskitJobReconciler) addProvisioningHints(pod *corev1.Pod, job *quantumv1.QiskitJob) {
+	gpu := requestsGPU(job)
+	addNodeSelector(pod, r.ExecutorNodeSelector)
+	if gpu {
+		addNodeSelector(pod, r.GPUNodeSelector)
+		pod.Spec.Tolerations = append(pod.Spec.Tolerations, corev1.Toleration{
+			Key:      string(GPUResource),
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		})
+	}
+
+	if !gpu && !r.longRun(job) {
+		return
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[KarpenterDoNotDisruptAnnotation] = "true"
+	pod.Annotations[SafeToEvictAnnotation] = "false"
+}
+
+// longRun reports whether the job may run for at least the long run
+// threshold, judged by its maximum execution time
+func (r *QiskitJobReconciler) longRun(job *quantumv1.QiskitJob) bool {
+	if r.LongRunThreshold <= 0 || job.Spec.Execution.MaxExecutionTime == "" {
+		return false
+	}
+	maxTime, err := time.ParseDuration(job.Spec.Execution.MaxExecutionTime)
+	return err == nil && maxTime >= r.LongRunThreshold
+}
+
+// applyScheduling passes the job's spec.scheduling through to the execution
+// pod. Its node selector is applied after the operator's, so it picks the
+// node pool when both set the same label.
+func applyScheduling(pod *corev1.Pod, job *quantumv1.QiskitJob) {
+	scheduling := job.Spec.Scheduling
+	if scheduling == nil {
+		return
+	}
+	addNodeSelector(pod, scheduling.NodeSelector)
+	for i := range scheduling.Tolerations {
+		pod.Spec.Tolerations = append(pod.Spec.Tolerations, *scheduling.Tolerations[i].DeepCopy())
+	}
+	if scheduling.Affinity != nil {
+		pod.Spec.Affinity = scheduling.Affinity.DeepCopy()
+	}
+	if scheduling.RuntimeClassName != nil {
+		pod.Spec.RuntimeClassName = ptr(*scheduling.RuntimeClassName)
+	}
+	pod.Spec.PriorityClassName = scheduling.PriorityClassName
+}
+
+func addNodeSelector(pod *corev1.Pod, selector map[string]string) {
+	if len(selector) == 0 {
+		return
+	}
+	if pod.Spec.NodeSelector == nil {
+		pod.Spec.NodeSelector = map[string]string{}
+	}
+	for key, value := range selector {
+		pod.Spec.NodeSelector[key] = value
+	}
+}
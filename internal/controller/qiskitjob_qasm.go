@@ -0,0 +1,60 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// qasm2Loader loads an OpenQASM 2 program into qc. Qiskit's legacy
+// instructions keep the gates of qelib1.inc the Qiskit gates they name.
+const qasm2Loader = `
+# OpenQASM 2 circuit, mounted next to this program
+from qiskit import qasm2 as _qasm2
+qc = _qasm2.load('` + qasmFile + `', custom_instructions=_qasm2.LEGACY_CUSTOM_INSTRUCTIONS)
+`
+
+// qasm3Loader loads an OpenQASM 3 program into qc. Its inputs become the
+// circuit's parameters, which sweeps and optimizers bind.
+const qasm3Loader = `
+# OpenQASM 3 circuit, mounted next to this program
+from qiskit import qasm3 as _qasm3
+qc = _qasm3.load('` + qasmFile + `')
+`
+
+// qasm3Requirements are what Qiskit's OpenQASM 3 loader needs besides Qiskit
+const qasm3Requirements = "qiskit-qasm3-import"
+
+// qasmVersion returns the OpenQASM version of the job's circuit, or 0 for
+// Python circuit code
+func qasmVersion(job *quantumv1.QiskitJob) int {
+	switch job.Spec.Circuit.Format {
+	case "qasm2":
+		return 2
+	case "qasm3":
+		return 3
+	}
+	return 0
+}
+
+// qasmLoader returns the Python loading the job's OpenQASM program
+func qasmLoader(job *quantumv1.QiskitJob) string {
+	if qasmVersion(job) == 2 {
+		return qasm2Loader
+	}
+	return qasm3Loader
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+	"github.com/quantum-operator/qiskit-operator/pkg/queue"
+)
+
+// queueBackendKey returns the key a job's queue waits are recorded under. It
+// matches the name of the QiskitBackend that publishes the prediction.
+func queueBackendKey(job *quantumv1.QiskitJob) string {
+	if name := device(job); name != "" {
+		return name
+	}
+	if job.Status.SelectedBackend != "" {
+		return job.Status.SelectedBackend
+	}
+	return job.Spec.Backend.Type
+}
+
+// podQueueWait returns how long the execution pod waited between creation and
+// the start of its container, or false if the container has not started yet
+func podQueueWait(pod *corev1.Pod) (time.Duration, bool) {
+	for _, cs := range pod.Status.ContainerStatuses {
+		var started metav1.Time
+		switch {
+		case cs.State.Running != nil:
+			started = cs.State.Running.StartedAt
+		case cs.State.Terminated != nil:
+			started = cs.State.Terminated.StartedAt
+		default:
+			continue
+		}
+		if started.IsZero() {
+			continue
+		}
+		return started.Sub(pod.CreationTimestamp.Time), true
+	}
+	return 0, false
+}
+
+// observeQueueWait records the job's queue wait in its metrics and feeds it to
+// the predictor, publishing the new prediction to the job's backend pools.
+// Each job is observed at most once.
+func (r *QiskitJobReconciler) observeQueueWait(ctx context.Context, job *quantumv1.QiskitJob, pod *corev1.Pod) {
+	if job.Status.Metrics != nil && job.Status.Metrics.QueueTime != "" {
+		return
+	}
+	wait, ok := podQueueWait(pod)
+	if !ok {
+		return
+	}
+
+	if job.Status.Metrics == nil {
+		job.Status.Metrics = &quantumv1.ExecutionMetrics{}
+	}
+	job.Status.Metrics.QueueTime = wait.Round(time.Second).String()
+	metrics.JobQueueDuration.WithLabelValues(backendType(job)).Observe(wait.Seconds())
+
+	if r.QueuePredictor == nil {
+		return
+	}
+	key := queueBackendKey(job)
+	r.QueuePredictor.Observe(key, wait)
+	if prediction, ok := r.QueuePredictor.Predict(key); ok {
+		if err := r.publishQueueWait(ctx, job, queueWaitStatus(key, prediction)); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to publish queue wait prediction", "backend", key)
+		}
+	}
+}
+
+// queueWaitStatus returns the published form of a backend's prediction
+func queueWaitStatus(backend string, prediction queue.Prediction) quantumv1.BackendQueueWait {
+	return quantumv1.BackendQueueWait{
+		Backend:          backend,
+		PredictedWait:    prediction.Wait.Round(time.Second).String(),
+		Samples:          int32(prediction.Samples),
+		LastObservedWait: prediction.Last.Round(time.Second).String(),
+	}
+}
+
+// publishQueueWait records a backend's predicted queue wait in the status of
+// the QuantumBackendPools the job's backend belongs to. Pools already
+// showing the prediction are left alone.
+func (r *QiskitJobReconciler) publishQueueWait(ctx context.Context, job *quantumv1.QiskitJob,
+	published quantumv1.BackendQueueWait) error {
+	var pools quantumv1.QuantumBackendPoolList
+	if err := r.List(ctx, &pools); err != nil {
+		return err
+	}
+	published.LastUpdated = &metav1.Time{Time: time.Now()}
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+		if !inPool(pool, job) {
+			continue
+		}
+		found := false
+		changed := true
+		for j := range pool.Status.QueueWaits {
+			current := &pool.Status.QueueWaits[j]
+			if current.Backend != published.Backend {
+				continue
+			}
+			found = true
+			changed = current.PredictedWait != published.PredictedWait || current.Samples != published.Samples ||
+				current.LastObservedWait != published.LastObservedWait
+			if changed {
+				*current = published
+			}
+		}
+		if !found {
+			pool.Status.QueueWaits = append(pool.Status.QueueWaits, published)
+		}
+		if !changed {
+			continue
+		}
+		if err := r.Status().Update(ctx, pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// predictStartTime sets the job's estimated start time from the predicted
+// queue wait of its backend, when a prediction is available
+func (r *QiskitJobReconciler) predictStartTime(job *quantumv1.QiskitJob) {
+	if r.QueuePredictor == nil {
+		return
+	}
+	prediction, ok := r.QueuePredictor.Predict(queueBackendKey(job))
+	if !ok {
+		return
+	}
+	start := metav1.NewTime(time.Now().Add(prediction.Wait))
+	job.Status.EstimatedStartTime = &start
+}
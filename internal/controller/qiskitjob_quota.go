@@ -0,0 +1,232 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ConditionHeldForQuota is True while submission is held back because a
+// QuantumBackendPool the job's backend belongs to is at its provider limits
+const ConditionHeldForQuota = "HeldForQuota"
+
+// quotaRecheckInterval is how often held jobs look for a free slot
+const quotaRecheckInterval = 30 * time.Second
+
+// quotaAdmissionWindow is how long a job admitted to a backend pool or
+// past its namespace's QuantumQuotas takes its share of the limits before it
+// is seen running, covering the time the cache takes to catch up with its
+// new phase
+const quotaAdmissionWindow = time.Minute
+
+// admission is a job admitted past a limit
+type admission struct {
+	// at is when the job was admitted
+	at time.Time
+	// estimate is the estimated cost of the job
+	estimate float64
+}
+
+// admissions records the jobs admitted past limits, by backend pool or
+// namespace, that are not seen running yet. It serializes the admission
+// checks of concurrent reconciles, so two jobs cannot take the last slot of
+// a limit together.
+type admissions struct {
+	mu       sync.Mutex
+	admitted map[string]map[types.UID]admission
+}
+
+// pending prunes the admissions under the key of jobs that moved on from
+// Scheduling or are gone, and of those admitted longer than the admission
+// window ago, and returns the remaining ones
+func (a *admissions) pending(key string, jobs []quantumv1.QiskitJob, now time.Time) map[types.UID]admission {
+	scheduling := map[types.UID]bool{}
+	for i := range jobs {
+		if jobs[i].Status.Phase == PhaseScheduling {
+			scheduling[jobs[i].UID] = true
+		}
+	}
+	return a.unseen(key, scheduling, now)
+}
+
+// unseen prunes the admissions under the key of jobs other than the unseen
+// ones, and of those admitted longer than the admission window ago, and
+// returns the remaining ones
+func (a *admissions) unseen(key string, unseen map[types.UID]bool, now time.Time) map[types.UID]admission {
+	admitted := a.admitted[key]
+	for uid, admission := range admitted {
+		if !unseen[uid] || now.Sub(admission.at) > quotaAdmissionWindow {
+			delete(admitted, uid)
+		}
+	}
+	return admitted
+}
+
+// admit records the job as admitted under the keys
+func (a *admissions) admit(keys []string, job *quantumv1.QiskitJob, estimate float64, now time.Time) {
+	if a.admitted == nil {
+		a.admitted = map[string]map[types.UID]admission{}
+	}
+	for _, key := range keys {
+		if a.admitted[key] == nil {
+			a.admitted[key] = map[types.UID]admission{}
+		}
+		a.admitted[key][job.UID] = admission{at: now, estimate: estimate}
+	}
+}
+
+// inPool reports whether the job is submitted to a backend of the pool
+func inPool(pool *quantumv1.QuantumBackendPool, job *quantumv1.QiskitJob) bool {
+	if pool.Spec.Instance != "" && pool.Spec.Instance != job.Spec.Backend.Instance {
+		return false
+	}
+	backends := []string{job.Spec.Backend.Type, device(job), job.Spec.Backend.DeviceARN}
+	for _, pattern := range pool.Spec.Backends {
+		for _, backend := range backends {
+			if ok, _ := path.Match(pattern, backend); ok && backend != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// submittedToPool reports whether the job, or a share of it split off to
+// a remote backend, is submitted to a backend of the pool
+func submittedToPool(pool *quantumv1.QuantumBackendPool, job *quantumv1.QiskitJob) bool {
+	if inPool(pool, job) {
+		return true
+	}
+	for _, share := range splitHardwareShares(job) {
+		if inPool(pool, share) {
+			return true
+		}
+	}
+	return false
+}
+
+// heldBefore reports whether other has been waiting for a slot since before
+// job, so that slots are handed out in submission order
+func heldBefore(other, job *quantumv1.QiskitJob) bool {
+	if other.Status.Phase != PhaseScheduling ||
+		!meta.IsStatusConditionTrue(other.Status.Conditions, ConditionHeldForQuota) ||
+		meta.IsStatusConditionTrue(other.Status.Conditions, ConditionDelayedForCost) || budgetDeferred(other) {
+		return false
+	}
+	if !other.CreationTimestamp.Equal(&job.CreationTimestamp) {
+		return other.CreationTimestamp.Before(&job.CreationTimestamp)
+	}
+	return other.Namespace+"/"+other.Name < job.Namespace+"/"+job.Name
+}
+
+// holdForQuota delays submission while a QuantumBackendPool the job's
+// backend, or that of a share of it on a remote backend, belongs to has as
+// many jobs at the provider as its limits allow,
+// counting jobs that have been waiting longer as ahead in line and jobs
+// admitted but not seen running yet as taking a slot. It reports whether the
+// job is held, in which case reconciliation should stop with the returned
+// result.
+func (r *QiskitJobReconciler) holdForQuota(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	logger := log.FromContext(ctx)
+	r.poolAdmissions.mu.Lock()
+	defer r.poolAdmissions.mu.Unlock()
+	now := time.Now()
+
+	var pools quantumv1.QuantumBackendPoolList
+	if err := r.List(ctx, &pools); err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	var jobs *quantumv1.QiskitJobList
+	var matched []string
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+		if !submittedToPool(pool, job) {
+			continue
+		}
+		// Provider limits span namespaces, so jobs of every namespace count
+		if jobs == nil {
+			jobs = &quantumv1.QiskitJobList{}
+			if err := r.List(ctx, jobs); err != nil {
+				return ctrl.Result{}, true, err
+			}
+		}
+
+		matched = append(matched, pool.Name)
+		admitted := r.poolAdmissions.pending(pool.Name, jobs.Items, now)
+		var running, waiting int32
+		for j := range jobs.Items {
+			other := &jobs.Items[j]
+			if other.UID == job.UID || !submittedToPool(pool, other) {
+				continue
+			}
+			_, ok := admitted[other.UID]
+			switch {
+			case other.Status.Phase == PhaseRunning, ok:
+				running++
+			case heldBefore(other, job):
+				waiting++
+			}
+		}
+		limit := pool.Spec.Limits.MaxConcurrentJobs
+		if running+waiting < limit {
+			continue
+		}
+
+		message := fmt.Sprintf("Waiting for a slot in backend pool %q: %d of %d concurrent jobs running or starting, %d waiting ahead",
+			pool.Name, running, limit, waiting)
+		logger.Info("Holding submission for provider limits", "pool", pool.Name,
+			"running", running, "waiting", waiting, "limit", limit)
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionHeldForQuota,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ConcurrencyLimit",
+			Message:            message,
+			ObservedGeneration: job.Generation,
+		})
+		job.Status.Message = message
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		requeueBecause(ctx, RequeueRateLimited)
+		return ctrl.Result{RequeueAfter: quotaRecheckInterval}, true, nil
+	}
+
+	r.poolAdmissions.admit(matched, job, 0, now)
+	if meta.IsStatusConditionTrue(job.Status.Conditions, ConditionHeldForQuota) {
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionHeldForQuota,
+			Status:             metav1.ConditionFalse,
+			Reason:             "SlotAvailable",
+			Message:            "Backend pool has a free slot",
+			ObservedGeneration: job.Generation,
+		})
+	}
+	return ctrl.Result{}, false, nil
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+)
+
+// regionCredentialsMissing reports whether the secret selected for the job's
+// region is missing, so the job fails up front instead of at submission to
+// the provider. Without Secret access the check is left to the provider.
+func (r *QiskitJobReconciler) regionCredentialsMissing(ctx context.Context, job *quantumv1.QiskitJob, ref *quantumv1.SecretRef) (string, bool, error) {
+	if r.WithoutSecrets {
+		return "", false, nil
+	}
+	namespace, err := backendref.SecretNamespace(ctx, r.Client, job, *ref)
+	if err != nil {
+		return "", false, err
+	}
+
+	var secret corev1.Secret
+	err = r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &secret)
+	if errors.IsNotFound(err) {
+		return namespace + "/" + ref.Name, true, nil
+	}
+	return "", false, err
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// Reasons a reconcile of a job requeued it, the reason label of
+// qiskit_operator_job_requeues_total
+const (
+	// RequeueWaitingForPod waits for an execution pod to start or finish
+	RequeueWaitingForPod = "waiting-for-pod"
+	// RequeueBackendQueue waits for a provider, a session or a spoke cluster
+	// to run the job
+	RequeueBackendQueue = "backend-queue"
+	// RequeueRateLimited waits for an execution slot, a quota or budget
+	// limit, a provider's API quota or an open circuit breaker
+	RequeueRateLimited = "rate-limited"
+	// RequeueHold waits for an approval, an execution window or the
+	// namespace's spend to drop
+	RequeueHold = "hold"
+	// RequeueBackoff waits out the backoff of a retry
+	RequeueBackoff = "backoff"
+	// RequeueConflictRetry retries after a write lost to a concurrent one
+	RequeueConflictRetry = "conflict-retry"
+	// RequeueError retries after any other error
+	RequeueError = "error"
+	// RequeueProgress continues at once after the reconcile changed the job
+	RequeueProgress = "progress"
+	// RequeueOther is any requeue not given a reason
+	RequeueOther = "other"
+)
+
+// Intervals jobs are requeued at while they wait, unless configured
+// otherwise
+const (
+	// DefaultPodPendingRequeueInterval is how often a job checks on an
+	// execution pod that has not started yet
+	DefaultPodPendingRequeueInterval = 5 * time.Second
+	// DefaultRunningRequeueInterval is how often a job checks on its running
+	// executions
+	DefaultRunningRequeueInterval = 5 * time.Second
+	// DefaultErrorRequeueInterval is how long a job waits after a reconcile
+	// failed
+	DefaultErrorRequeueInterval = 10 * time.Second
+)
+
+// Defaults of the rate limiter of the job controller's work queue
+const (
+	DefaultRateLimitBaseDelay = 5 * time.Millisecond
+	DefaultRateLimitMaxDelay  = 5 * time.Minute
+	DefaultRateLimitQPS       = 10
+	DefaultRateLimitBurst     = 100
+)
+
+// RateLimits configure the rate limiter of the job controller's work queue,
+// which paces the reconciles that requeue a job at once or fail: each job
+// backs off exponentially from BaseDelay up to MaxDelay while it keeps
+// doing so, and all of them together are held to QPS with bursts of Burst.
+// Zero values take the defaults.
+type RateLimits struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	QPS       float64
+	Burst     int
+}
+
+// NewRateLimiter returns the rate limiter of the job controller's work queue
+func NewRateLimiter(limits RateLimits) workqueue.TypedRateLimiter[reconcile.Request] {
+	if limits.BaseDelay <= 0 {
+		limits.BaseDelay = DefaultRateLimitBaseDelay
+	}
+	if limits.MaxDelay <= 0 {
+		limits.MaxDelay = DefaultRateLimitMaxDelay
+	}
+	if limits.QPS <= 0 {
+		limits.QPS = DefaultRateLimitQPS
+	}
+	if limits.Burst <= 0 {
+		limits.Burst = DefaultRateLimitBurst
+	}
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](limits.BaseDelay, limits.MaxDelay),
+		&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(limits.QPS), limits.Burst)},
+	)
+}
+
+// podPendingRequeue returns when to check again on an execution pod that
+// has not started, jittered like polls so that jobs started together spread
+// out
+func (r *QiskitJobReconciler) podPendingRequeue() time.Duration {
+	return wait.Jitter(r.tunables().PodPendingRequeueInterval, pollJitter)
+}
+
+// runningRequeue returns when to check again on running executions
+func (r *QiskitJobReconciler) runningRequeue() time.Duration {
+	return wait.Jitter(r.tunables().RunningRequeueInterval, pollJitter)
+}
+
+// errorRequeue returns when to reconcile a job again after its reconcile
+// failed
+func (r *QiskitJobReconciler) errorRequeue() time.Duration {
+	return wait.Jitter(r.tunables().ErrorRequeueInterval, pollJitter)
+}
+
+// requeueReasonKey holds the reason of the current reconcile's requeue in
+// its context
+type requeueReasonKey struct{}
+
+// withRequeueReason returns a context the reconcile's handlers record the
+// reason of its requeue in
+func withRequeueReason(ctx context.Context) (context.Context, *string) {
+	reason := new(string)
+	return context.WithValue(ctx, requeueReasonKey{}, reason), reason
+}
+
+// requeueBecause records why the reconcile requeues the job. The last reason
+// recorded wins; outside of Reconcile it does nothing.
+func requeueBecause(ctx context.Context, reason string) {
+	if holder, ok := ctx.Value(requeueReasonKey{}).(*string); ok {
+		*holder = reason
+	}
+}
+
+// requeueReasonOf classifies an error a reconcile is retried for
+func requeueReasonOf(err error) string {
+	if errors.IsConflict(err) {
+		return RequeueConflictRetry
+	}
+	return RequeueError
+}
+
+// recordRequeue counts the requeue of a reconcile, if it requeued, by the
+// reason recorded while it ran
+func recordRequeue(reason string, result ctrl.Result, err error) {
+	if reason = requeueReason(reason, result, err); reason != "" {
+		metrics.JobRequeues.WithLabelValues(reason).Inc()
+	}
+}
+
+// requeueReason returns why a reconcile requeued the job given the reason
+// recorded while it ran, or nothing if it did not requeue
+func requeueReason(reason string, result ctrl.Result, err error) string {
+	switch {
+	case err != nil:
+		return requeueReasonOf(err)
+	case result.IsZero():
+		return ""
+	case reason != "":
+		return reason
+	case result.RequeueAfter == 0:
+		return RequeueProgress
+	default:
+		return RequeueOther
+	}
+}
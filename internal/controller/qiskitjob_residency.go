@@ -0,0 +1,68 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/residency"
+)
+
+// ConditionResidencyViolation is True when result export was blocked by the
+// namespace's data residency policy
+const ConditionResidencyViolation = "ResidencyViolation"
+
+// outputExportAllowed re-checks the job's outputs against the namespace's
+// residency policy right before upload, since the policy may have changed
+// after admission. A violation is recorded in the ResidencyViolation
+// condition and blocks the export; errors loading the policy are returned so
+// the export is retried rather than performed unchecked.
+func (r *QiskitJobReconciler) outputExportAllowed(ctx context.Context, job *quantumv1.QiskitJob) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	if len(job.Spec.Outputs) == 0 {
+		return true, nil
+	}
+
+	policy, err := residency.PolicyForNamespace(ctx, r.Client, job.Namespace)
+	if err != nil {
+		return false, err
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionResidencyViolation,
+		Status:             metav1.ConditionFalse,
+		Reason:             "OutputAllowed",
+		Message:            "Output sinks satisfy the namespace data residency policy",
+		ObservedGeneration: job.Generation,
+	}
+	allowed := true
+	if err := policy.CheckAll(job.Spec.Outputs); err != nil {
+		logger.Info("Result export blocked by data residency policy", "reason", err.Error())
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ExportBlocked"
+		condition.Message = err.Error()
+		allowed = false
+	}
+	meta.SetStatusCondition(&job.Status.Conditions, condition)
+	return allowed, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/work"
+)
+
+// ConditionOutputsDegraded is True when the results could not be exported to
+// some of the job's outputs
+const ConditionOutputsDegraded = "OutputsDegraded"
+
+// awaitResultsProcessor enqueues the job's results for the results processor
+// and reports whether it has finished with them. Until it has, the returned
+// result requeues the job; the processor's annotation also triggers a
+// reconcile as soon as it is written.
+func (r *QiskitJobReconciler) awaitResultsProcessor(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	logger := log.FromContext(ctx)
+
+	if msg := job.Annotations[results.ErrorAnnotation]; msg != "" {
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Result processing failed: %s", msg))
+		return result, false, err
+	}
+	if job.Annotations[results.ProcessedAnnotation] != "" {
+		return ctrl.Result{}, true, nil
+	}
+
+	if err := r.ResultsQueue.Enqueue(ctx, work.TaskResultParsing, job); err != nil {
+		return ctrl.Result{}, false, err
+	}
+	logger.Info("Waiting for results processor")
+
+	job.Status.Message = "Execution finished, waiting for results processor"
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, false, err
+	}
+	return ctrl.Result{RequeueAfter: 30 * time.Second}, false, nil
+}
+
+// clearResultsAnnotations drops the results processor's outcome from an
+// earlier attempt. It reports whether the job changed.
+func clearResultsAnnotations(job *quantumv1.QiskitJob) bool {
+	changed := false
+	for _, key := range []string{results.ProcessedAnnotation, results.ErrorAnnotation, results.ShadowAnnotation,
+		results.TranspiledAnnotation, results.OptimizationAnnotation, results.EstimationAnnotation, results.InfoAnnotation,
+		results.LayoutAnnotation, results.OutputsAnnotation} {
+		if _, ok := job.Annotations[key]; ok {
+			delete(job.Annotations, key)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// executorRunTime returns how long the executor container ran, or zero if it
+// has not terminated
+func executorRunTime(pod *corev1.Pod) time.Duration {
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; status.Name == "executor" && terminated != nil &&
+			!terminated.StartedAt.IsZero() && terminated.FinishedAt.After(terminated.StartedAt.Time) {
+			return terminated.FinishedAt.Sub(terminated.StartedAt.Time)
+		}
+	}
+	return 0
+}
+
+// exportResults writes the job's results document to each of its outputs,
+// records how each export went, and signs the results when the operator has
+// a signing key. The results keep the location of the first output they
+// reached; a failed signature is returned, to retry the export.
+func (r *QiskitJobReconciler) exportResults(ctx context.Context, job *quantumv1.QiskitJob, doc *results.Document,
+	uploads []quantumv1.OutputStatus) error {
+	statuses, err := results.Export(ctx, r.Client, r.Scheme, r.Search, job, doc, uploads)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to export results")
+	}
+	recordOutputs(job, statuses)
+	if job.Status.Results != nil {
+		job.Status.Results.Location = results.ExportedLocation(statuses)
+	}
+	return results.Seal(ctx, r.ResultsSigner, job, doc, job.Status.Results, statuses)
+}
+
+// recordOutputs records how the export to each of the job's outputs went,
+// and sets the OutputsDegraded condition when some of them failed
+func recordOutputs(job *quantumv1.QiskitJob, statuses []quantumv1.OutputStatus) {
+	job.Status.Outputs = statuses
+	var failed []string
+	for _, status := range statuses {
+		if status.State == quantumv1.OutputFailed {
+			failed = append(failed, fmt.Sprintf("%s: %s", status.Name, status.Message))
+		}
+	}
+	if len(failed) == 0 {
+		meta.RemoveStatusCondition(&job.Status.Conditions, ConditionOutputsDegraded)
+		return
+	}
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionOutputsDegraded,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ExportFailed",
+		Message:            fmt.Sprintf("Results could not be exported to %d of %d outputs: %s", len(failed), len(statuses), strings.Join(failed, "; ")),
+		ObservedGeneration: job.Generation,
+	})
+}
+
+// degradedOutputsMessage describes a completed job whose results could not
+// be exported to some of its outputs, reporting false if they reached all
+func degradedOutputsMessage(job *quantumv1.QiskitJob) (string, bool) {
+	failed := 0
+	for _, status := range job.Status.Outputs {
+		if status.State == quantumv1.OutputFailed {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("Job completed; results could not be exported to %d of %d outputs", failed, len(job.Status.Outputs)), true
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Defaults of the retry policy fields, also the backoff of jobs without one
+const (
+	defaultRetryDelay    = 10 * time.Second
+	defaultMaxRetryDelay = 5 * time.Minute
+)
+
+// backoffFixed waits the initial delay before every retry
+const backoffFixed = "fixed"
+
+// retryLimit returns how many times the job is retried
+func retryLimit(job *quantumv1.QiskitJob) int {
+	if policy := job.Spec.RetryPolicy; policy != nil && policy.MaxRetries != nil {
+		return int(*policy.MaxRetries)
+	}
+	return maxRetries
+}
+
+// retryDelay returns how long the job waits before its retry numbered
+// job.Status.RetryCount
+func retryDelay(job *quantumv1.QiskitJob) time.Duration {
+	policy := job.Spec.RetryPolicy
+	if policy == nil {
+		return defaultRetryDelay
+	}
+	delay := defaultRetryDelay
+	if policy.InitialDelay != nil {
+		delay = policy.InitialDelay.Duration
+	}
+	if policy.Backoff == backoffFixed {
+		return delay
+	}
+
+	maxDelay := defaultMaxRetryDelay
+	if policy.MaxDelay != nil {
+		maxDelay = policy.MaxDelay.Duration
+	}
+	for i := 1; i < job.Status.RetryCount && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// retryClass names what the failed job failed of, as a retry policy
+// classifies failures, or returns "" for failures no class covers such as
+// invalid circuits
+func retryClass(job *quantumv1.QiskitJob) quantumv1.RetryableFailure {
+	failed := meta.FindStatusCondition(job.Status.Conditions, ConditionFailed)
+	if failed == nil {
+		return ""
+	}
+	switch failed.Reason {
+	case ReasonValidationFailed:
+		validated := meta.FindStatusCondition(job.Status.Conditions, ConditionCircuitValidated)
+		if validated != nil && validated.Status == metav1.ConditionFalse && validated.Reason == "ServiceUnavailable" {
+			return quantumv1.RetryOnValidationTimeout
+		}
+	case ReasonSchedulingFailed:
+		return quantumv1.RetryOnBackendUnavailable
+	case ReasonExecutionFailed:
+		return quantumv1.RetryOnPodFailure
+	}
+	return ""
+}
+
+// retryableFailure reports whether the job's retry policy retries the
+// failure it failed of
+func retryableFailure(job *quantumv1.QiskitJob) bool {
+	policy := job.Spec.RetryPolicy
+	if policy == nil || len(policy.RetryOn) == 0 {
+		return true
+	}
+	return slices.Contains(policy.RetryOn, retryClass(job))
+}
+
+// retryDue reports how long the retrying job still waits for its backoff to
+// run out, or zero once it is due
+func retryDue(job *quantumv1.QiskitJob) time.Duration {
+	if job.Status.NextRetryAt == nil {
+		return 0
+	}
+	return max(time.Until(job.Status.NextRetryAt.Time), 0)
+}
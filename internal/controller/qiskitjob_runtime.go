@@ -0,0 +1,102 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+)
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumruntimeversions,verbs=get;list;watch
+
+// ExecutorImageLinePlaceholder is replaced with the job's Qiskit release line
+// in the operator's --executor-image, so each line can run its own pre-built
+// image
+const ExecutorImageLinePlaceholder = "{line}"
+
+// canaryBucket places a job in one of 100 buckets. It depends only on the
+// job's UID, so every attempt of a job runs the same image while a canary
+// is rolled out.
+func canaryBucket(job *quantumv1.QiskitJob) int32 {
+	h := fnv.New32a()
+	h.Write([]byte(job.UID))
+	return int32(h.Sum32() % 100)
+}
+
+// executorImage returns the image the job's executor runs: the job's own
+// image if it sets one, else that of the QuantumRuntimeVersion of the job's
+// release line, or its canary image for the share of jobs the canary is
+// rolled out to. Lines without a runtime version run the operator's
+// --executor-image, and without one the image of the compatibility matrix.
+// When several runtime versions manage a line, the first by name applies.
+// Jobs simulating on a GPU run the operator's --gpu-executor-image if set.
+func (r *QiskitJobReconciler) executorImage(ctx context.Context, job *quantumv1.QiskitJob, rt *compat.Runtime) (string, error) {
+	if job.Spec.Execution.Image != "" {
+		return job.Spec.Execution.Image, nil
+	}
+	tunables := r.tunables()
+	if gpuAccelerated(job) && tunables.GPUExecutorImage != "" {
+		return strings.ReplaceAll(tunables.GPUExecutorImage, ExecutorImageLinePlaceholder, rt.Line), nil
+	}
+
+	var versions quantumv1.QuantumRuntimeVersionList
+	if err := r.List(ctx, &versions); err != nil {
+		return "", err
+	}
+	sort.Slice(versions.Items, func(i, j int) bool {
+		return versions.Items[i].Name < versions.Items[j].Name
+	})
+	for i := range versions.Items {
+		version := &versions.Items[i]
+		if version.Spec.QiskitVersion != rt.Line {
+			continue
+		}
+		if canary, ok := activeCanary(version); ok {
+			weight := canary.Weight
+			if weight <= 0 {
+				weight = defaultCanaryWeight
+			}
+			if canaryBucket(job) < weight {
+				return canary.Image, nil
+			}
+		}
+		if version.Spec.Image != "" {
+			return version.Spec.Image, nil
+		}
+		break
+	}
+	if tunables.ExecutorImage != "" {
+		return strings.ReplaceAll(tunables.ExecutorImage, ExecutorImageLinePlaceholder, rt.Line), nil
+	}
+	return rt.Image, nil
+}
+
+// pipInstall returns the shell command installing the requirements into the
+// executor. Requirements a pre-built executor image already satisfies are
+// installed without reaching a package index, so such images start at once
+// and work in air-gapped clusters; the index is only used when something is
+// missing.
+func pipInstall(requirements string) string {
+	return fmt.Sprintf("{ pip install --quiet --no-index %s 2>/dev/null || pip install --quiet %s; }",
+		requirements, requirements)
+}
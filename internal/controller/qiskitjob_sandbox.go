@@ -0,0 +1,311 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;create
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;create;update
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;create
+
+// SandboxLabel marks sandbox namespaces, and the executions and copies of
+// ConfigMaps and Secrets in them, with the namespace of the job they are for
+const SandboxLabel = "quantum.io/sandbox-of"
+
+// sandboxPrefix starts the names of sandbox namespaces, which end with the
+// UID of their job
+const sandboxPrefix = "qiskit-sandbox-"
+
+// sandboxServiceAccount runs sandboxed executors. It is granted nothing and
+// its token is not mounted.
+const sandboxServiceAccount = "qiskit-executor"
+
+// sandboxNetworkPolicy confines the network of sandboxed executors
+const sandboxNetworkPolicy = "qiskit-executor"
+
+// sandboxEgress is where sandboxed executors may connect to: anywhere but
+// the cluster's pods, services and nodes on the usual private ranges, and
+// cloud metadata endpoints
+var sandboxEgress = []networkingv1.IPBlock{
+	{CIDR: "0.0.0.0/0", Except: []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10", "169.254.0.0/16"}},
+	{CIDR: "::/0", Except: []string{"fc00::/7", "fe80::/10"}},
+}
+
+// sandboxed reports whether the job's executors are to run in a sandbox
+// namespace. Jobs of remote backends have no executor.
+func (r *QiskitJobReconciler) sandboxed(job *quantumv1.QiskitJob) bool {
+	return (r.SandboxExecutors || job.Spec.Execution.Sandbox) && !remote(job)
+}
+
+// sandboxNamespace names the job's sandbox namespace. It is named after the
+// job's UID, so a job recreated under the same name gets a new one.
+func sandboxNamespace(job *quantumv1.QiskitJob) string {
+	return sandboxPrefix + string(job.UID)
+}
+
+// ExecutionNamespace returns the namespace the job's executors run in: its
+// sandbox namespace if it is sandboxed, its own namespace otherwise
+func ExecutionNamespace(job *quantumv1.QiskitJob) string {
+	if job.Status.SandboxNamespace != "" {
+		return job.Status.SandboxNamespace
+	}
+	return job.Namespace
+}
+
+// jobNamespace returns the namespace of the job an execution pod or Job
+// runs for, which differs from its own in a sandbox
+func jobNamespace(obj client.Object) string {
+	if namespace := obj.GetLabels()[SandboxLabel]; namespace != "" {
+		return namespace
+	}
+	return obj.GetNamespace()
+}
+
+// sandboxedJob maps the executions and pods of sandboxes to their job,
+// which cannot own them across namespaces
+func sandboxedJob(_ context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+	if labels[SandboxLabel] == "" || labels["quantum.io/job"] == "" {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Namespace: labels[SandboxLabel], Name: labels["quantum.io/job"]},
+	}}
+}
+
+// controllingJob maps the executions, pods and ConfigMaps of jobs to the job
+// controlling them, or to the job of their sandbox
+func controllingJob(ctx context.Context, obj client.Object) []reconcile.Request {
+	if ref := metav1.GetControllerOf(obj); ref != nil && ref.Kind == "QiskitJob" {
+		if gv, err := schema.ParseGroupVersion(ref.APIVersion); err == nil && gv.Group == quantumv1.GroupVersion.Group {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: ref.Name}}}
+		}
+	}
+	return sandboxedJob(ctx, obj)
+}
+
+// sandboxLabels labels what the operator creates in the job's sandbox
+func sandboxLabels(job *quantumv1.QiskitJob) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/managed-by": "qiskit-operator",
+		"quantum.io/job":               job.Name,
+		SandboxLabel:                   job.Namespace,
+	}
+}
+
+// ensureSandbox creates the job's sandbox namespace unless it exists. Pods
+// in it must meet the restricted Pod Security Standard, run as a service
+// account without permissions and only reach cluster DNS and the endpoints
+// executors may connect to, by default those outside the cluster, like the
+// providers' APIs. Jobs denied network egress reach nothing. Nothing
+// reaches them.
+func (r *QiskitJobReconciler) ensureSandbox(ctx context.Context, job *quantumv1.QiskitJob) error {
+	namespace := job.Status.SandboxNamespace
+	labels := sandboxLabels(job)
+	labels["pod-security.kubernetes.io/enforce"] = "restricted"
+	labels["pod-security.kubernetes.io/enforce-version"] = "latest"
+
+	objects := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: labels}},
+		&corev1.ServiceAccount{
+			ObjectMeta:                   metav1.ObjectMeta{Name: sandboxServiceAccount, Namespace: namespace, Labels: sandboxLabels(job)},
+			AutomountServiceAccountToken: ptr(false),
+		},
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: sandboxNetworkPolicy, Namespace: namespace, Labels: sandboxLabels(job)},
+			Spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+				Egress:      r.egressRules(job),
+			},
+		},
+	}
+	for _, obj := range objects {
+		if err := r.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// sandboxPod moves the execution pod into the job's sandbox namespace,
+// restricts it to what the sandbox admits and copies the ConfigMaps and
+// Secrets it uses there. Claims and CSI volumes of the job's namespace
+// cannot follow it, so jobs using them cannot be sandboxed.
+func (r *QiskitJobReconciler) sandboxPod(ctx context.Context, job *quantumv1.QiskitJob, pod *corev1.Pod) error {
+	if err := r.ensureSandbox(ctx, job); err != nil {
+		return err
+	}
+	pod.Namespace = job.Status.SandboxNamespace
+	pod.Labels[SandboxLabel] = job.Namespace
+	pod.Spec.ServiceAccountName = sandboxServiceAccount
+	pod.Spec.AutomountServiceAccountToken = ptr(false)
+	if pod.Spec.SecurityContext == nil {
+		pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	security := pod.Spec.SecurityContext
+	security.RunAsNonRoot = ptr(true)
+	if security.RunAsUser == nil {
+		security.RunAsUser = ptr(int64(1000))
+	}
+	security.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+
+	configMaps, secrets := map[string]bool{}, map[string]bool{}
+	for _, volume := range pod.Spec.Volumes {
+		switch {
+		case volume.ConfigMap != nil:
+			configMaps[volume.ConfigMap.Name] = true
+		case volume.Secret != nil:
+			secrets[volume.Secret.SecretName] = true
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					configMaps[source.ConfigMap.Name] = true
+				}
+				if source.Secret != nil {
+					secrets[source.Secret.Name] = true
+				}
+			}
+		case volume.PersistentVolumeClaim != nil:
+			return fmt.Errorf("sandboxed executors cannot mount PersistentVolumeClaim %s of namespace %s",
+				volume.PersistentVolumeClaim.ClaimName, job.Namespace)
+		case volume.CSI != nil:
+			return fmt.Errorf("sandboxed executors cannot mount CSI volume %s of namespace %s", volume.Name, job.Namespace)
+		}
+	}
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			container := &containers[i]
+			restrictContainer(container)
+			for _, source := range container.EnvFrom {
+				if source.ConfigMapRef != nil {
+					configMaps[source.ConfigMapRef.Name] = true
+				}
+				if source.SecretRef != nil {
+					secrets[source.SecretRef.Name] = true
+				}
+			}
+			for _, env := range container.Env {
+				switch {
+				case env.ValueFrom == nil:
+				case env.ValueFrom.ConfigMapKeyRef != nil:
+					configMaps[env.ValueFrom.ConfigMapKeyRef.Name] = true
+				case env.ValueFrom.SecretKeyRef != nil:
+					secrets[env.ValueFrom.SecretKeyRef.Name] = true
+				}
+			}
+		}
+	}
+
+	if len(secrets) > 0 && r.WithoutSecrets {
+		return fmt.Errorf("sandboxed executors cannot use Secrets while the operator runs without access to them")
+	}
+	for name := range configMaps {
+		if err := r.copyToSandbox(ctx, job, &corev1.ConfigMap{}, name); err != nil {
+			return err
+		}
+	}
+	for name := range secrets {
+		if err := r.copyToSandbox(ctx, job, &corev1.Secret{}, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restrictContainer makes the container meet the restricted Pod Security
+// Standard
+func restrictContainer(container *corev1.Container) {
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	}
+	container.SecurityContext.AllowPrivilegeEscalation = ptr(false)
+	container.SecurityContext.Privileged = nil
+	container.SecurityContext.Capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+}
+
+// copyToSandbox copies a ConfigMap or Secret of the job's namespace into its
+// sandbox, keeping an earlier copy up to date. Missing ones are left
+// missing, so the pod fails on them as it would outside a sandbox.
+func (r *QiskitJobReconciler) copyToSandbox(ctx context.Context, job *quantumv1.QiskitJob, source client.Object, name string) error {
+	if err := r.Get(ctx, types.NamespacedName{Namespace: job.Namespace, Name: name}, source); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	meta := metav1.ObjectMeta{Name: name, Namespace: job.Status.SandboxNamespace}
+	switch source := source.(type) {
+	case *corev1.ConfigMap:
+		copied := &corev1.ConfigMap{ObjectMeta: meta}
+		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, copied, func() error {
+			copied.Labels = sandboxLabels(job)
+			copied.Data, copied.BinaryData = source.Data, source.BinaryData
+			return nil
+		})
+		return err
+	case *corev1.Secret:
+		copied := &corev1.Secret{ObjectMeta: meta}
+		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, copied, func() error {
+			copied.Labels = sandboxLabels(job)
+			copied.Type, copied.Data = source.Type, source.Data
+			return nil
+		})
+		return err
+	}
+	return nil
+}
+
+// deleteSandbox deletes the job's sandbox namespace and with it everything
+// its executors left there
+func (r *QiskitJobReconciler) deleteSandbox(ctx context.Context, job *quantumv1.QiskitJob) error {
+	if job.Status.SandboxNamespace == "" {
+		return nil
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: job.Status.SandboxNamespace}}
+	return client.IgnoreNotFound(r.Delete(ctx, namespace))
+}
+
+// orphanedSandbox reports whether the sandbox namespace belongs to a
+// QiskitJob that no longer exists
+func (s *OrphanSweeper) orphanedSandbox(ctx context.Context, namespace *corev1.Namespace) (bool, error) {
+	name := namespace.Labels["quantum.io/job"]
+	if name == "" || !strings.HasPrefix(namespace.Name, sandboxPrefix) {
+		return false, nil
+	}
+	uid, err := jobUID(ctx, s.Client, s.Jobs, types.NamespacedName{Name: name, Namespace: namespace.Labels[SandboxLabel]})
+	switch {
+	case apierrors.IsNotFound(err):
+		return true, nil
+	case err != nil:
+		return false, err
+	}
+	return string(uid) != strings.TrimPrefix(namespace.Name, sandboxPrefix), nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// scratchDir is where the execution pod's scratch space is mounted
+const scratchDir = "/scratch"
+
+// mountScratch gives the execution pod the job's scratch space. An emptyDir
+// is capped at the requested size and the pod requests that much ephemeral
+// storage, so the scheduler places it on a node with room to spill instead
+// of the kubelet evicting it under disk pressure.
+func mountScratch(pod *corev1.Pod, job *quantumv1.QiskitJob) {
+	scratch := job.Spec.Execution.Scratch
+	if scratch == nil {
+		return
+	}
+	container := &pod.Spec.Containers[0]
+	volume := corev1.Volume{Name: "scratch"}
+	if scratch.StorageClassName != nil {
+		volume.Ephemeral = &corev1.EphemeralVolumeSource{
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: scratch.StorageClassName,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: scratch.Size.DeepCopy()},
+					},
+				},
+			},
+		}
+	} else {
+		size := scratch.Size.DeepCopy()
+		volume.EmptyDir = &corev1.EmptyDirVolumeSource{SizeLimit: &size}
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = corev1.ResourceList{}
+		}
+		container.Resources.Requests[corev1.ResourceEphemeralStorage] = scratch.Size.DeepCopy()
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: "scratch", MountPath: scratchDir})
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: "SCRATCH_DIR", Value: scratchDir},
+		corev1.EnvVar{Name: "TMPDIR", Value: scratchDir})
+}
+
+// scratchUnschedulable reports why no node can hold the job's emptyDir
+// scratch space, or "" if one can. Nodes are read uncached, and a forbidden
+// list skips the check so profiles without node access still run jobs.
+func (r *QiskitJobReconciler) scratchUnschedulable(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	scratch := job.Spec.Execution.Scratch
+	if scratch == nil || scratch.StorageClassName != nil {
+		return "", nil
+	}
+	var nodes corev1.NodeList
+	if err := r.List(ctx, &nodes); err != nil {
+		if apierrors.IsForbidden(err) {
+			logf.FromContext(ctx).V(1).Info("Cannot list nodes, skipping scratch capacity check")
+			return "", nil
+		}
+		return "", err
+	}
+	if len(nodes.Items) == 0 {
+		return "", nil
+	}
+	var largest resource.Quantity
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if allocatable, ok := node.Status.Allocatable[corev1.ResourceEphemeralStorage]; ok && allocatable.Cmp(largest) > 0 {
+			largest = allocatable
+		}
+	}
+	if scratch.Size.Cmp(largest) <= 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("Scratch size %s exceeds the largest node allocatable ephemeral storage %s; set a storageClassName for larger scratch space",
+		scratch.Size.String(), largest.String()), nil
+}
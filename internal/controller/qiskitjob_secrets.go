@@ -0,0 +1,185 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+)
+
+// DefaultSecretPollInterval is how often referenced Secrets are checked for
+// changes unless configured otherwise
+const DefaultSecretPollInterval = time.Minute
+
+// DefaultSecretPollQPS is how many Secrets are read per second while polling
+// unless configured otherwise
+const DefaultSecretPollQPS = 5
+
+// SecretWatcher re-triggers QiskitJobs whose credentials Secrets changed. It
+// reads only the Secrets jobs reference, one GET each per poll and rate
+// limited, so the operator neither caches every Secret in the cluster nor
+// needs RBAC to list and watch them.
+type SecretWatcher struct {
+	// Client lists the QiskitJobs referencing Secrets, typically from the
+	// manager's cache
+	client.Client
+
+	// Secrets reads the referenced Secrets. It must read from the API
+	// server, such as the manager's API reader: a cached client would
+	// start an informer on every Secret in the cluster, or miss rotations
+	// where Secrets are not cached.
+	Secrets client.Reader
+
+	// Interval is how often to poll the referenced Secrets
+	Interval time.Duration
+
+	// RateLimiter paces the GETs of a poll
+	RateLimiter flowcontrol.RateLimiter
+
+	events chan event.GenericEvent
+
+	// versions are the resource versions last seen, "" for a missing Secret
+	versions map[types.NamespacedName]string
+}
+
+var _ manager.LeaderElectionRunnable = &SecretWatcher{}
+
+// NewSecretWatcher returns a watcher listing jobs with c and polling the
+// Secrets they reference with secrets, every interval at no more than qps
+// Secret reads per second
+func NewSecretWatcher(c client.Client, secrets client.Reader, interval time.Duration, qps float32) *SecretWatcher {
+	return &SecretWatcher{
+		Client:      c,
+		Secrets:     secrets,
+		Interval:    interval,
+		RateLimiter: flowcontrol.NewTokenBucketRateLimiter(qps, 1),
+		events:      make(chan event.GenericEvent, 1024),
+		versions:    map[types.NamespacedName]string{},
+	}
+}
+
+// Source enqueues the jobs the watcher re-triggers
+func (w *SecretWatcher) Source() source.Source {
+	return source.Channel(w.events, &handler.EnqueueRequestForObject{})
+}
+
+// NeedLeaderElection makes polling run only on the elected leader, where the
+// job controller runs
+func (w *SecretWatcher) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable
+func (w *SecretWatcher) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("secret-watcher")
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+			logger.Error(err, "Failed to poll referenced Secrets")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll reads every Secret referenced by an unfinished job and re-triggers
+// the jobs referencing those that changed since the last poll. The first
+// poll only records what it sees.
+func (w *SecretWatcher) poll(ctx context.Context) error {
+	var jobs quantumv1.QiskitJobList
+	if err := w.List(ctx, &jobs); err != nil {
+		return err
+	}
+	referencing := map[types.NamespacedName][]*quantumv1.QiskitJob{}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.Status.Phase == PhaseCompleted || job.Status.Phase == PhaseCancelled {
+			continue
+		}
+		for _, key := range referencedSecrets(job) {
+			referencing[key] = append(referencing[key], job)
+		}
+	}
+
+	logger := logf.FromContext(ctx)
+	versions := make(map[types.NamespacedName]string, len(referencing))
+	for key, referrers := range referencing {
+		if err := w.RateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		var secret corev1.Secret
+		err := w.Secrets.Get(ctx, key, &secret)
+		switch {
+		case errors.IsNotFound(err):
+			versions[key] = ""
+		case err != nil:
+			logger.Error(err, "Failed to read referenced Secret", "secret", key)
+			if version, ok := w.versions[key]; ok {
+				versions[key] = version
+			}
+			continue
+		default:
+			versions[key] = secret.ResourceVersion
+		}
+
+		previous, seen := w.versions[key]
+		if !seen || previous == versions[key] {
+			continue
+		}
+		logger.Info("Referenced Secret changed, re-triggering jobs", "secret", key, "jobs", len(referrers))
+		for _, job := range referrers {
+			select {
+			case w.events <- event.GenericEvent{Object: job}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	w.versions = versions
+	return nil
+}
+
+// referencedSecrets returns the credentials Secrets a job may use, in any
+// region, and that of its client certificate
+func referencedSecrets(job *quantumv1.QiskitJob) []types.NamespacedName {
+	refs := backendref.SecretRefs(job.Spec.Credentials)
+	keys := make([]types.NamespacedName, 0, len(refs))
+	for _, ref := range refs {
+		if key, ok := secretKey(job, ref); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
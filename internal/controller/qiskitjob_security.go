@@ -0,0 +1,175 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;create;patch
+
+// EgressLabel marks execution pods whose network the operator's
+// NetworkPolicies confine, with the egress they are allowed: "restricted"
+// pods reach cluster DNS and the endpoints executors may connect to,
+// "denied" pods nothing
+const EgressLabel = "quantum.io/executor-egress"
+
+// Egress modes of execution pods
+const (
+	egressRestricted = "restricted"
+	egressDenied     = "denied"
+)
+
+// egressPolicies name the NetworkPolicy confining the executors of each
+// egress mode in a job namespace
+var egressPolicies = map[string]string{
+	egressRestricted: "qiskit-executor-egress",
+	egressDenied:     "qiskit-executor-no-egress",
+}
+
+// executorTmpDir is the executor's writable temporary and home directory,
+// where pip installs packages its image lacks
+const executorTmpDir = "/tmp"
+
+// hardenExecutor runs the execution pod under the container runtime's
+// default seccomp profile and makes the executor's root filesystem
+// read-only. Circuit code writes to /tmp, the scratch space or its output
+// directory.
+func hardenExecutor(pod *corev1.Pod) {
+	if pod.Spec.SecurityContext == nil {
+		pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	pod.Spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+
+	container := &pod.Spec.Containers[0]
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	}
+	container.SecurityContext.ReadOnlyRootFilesystem = ptr(true)
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         "tmp",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: "tmp", MountPath: executorTmpDir})
+	container.Env = append(container.Env, corev1.EnvVar{Name: "HOME", Value: executorTmpDir})
+}
+
+// allowsEgress reports whether the job's executor may open network
+// connections at all
+func allowsEgress(job *quantumv1.QiskitJob) bool {
+	security := job.Spec.Security
+	return security == nil || security.AllowNetworkEgress == nil || *security.AllowNetworkEgress
+}
+
+// egressMode returns how the operator confines the network of the job's
+// executor outside a sandbox, "" if it does not
+func (r *QiskitJobReconciler) egressMode(job *quantumv1.QiskitJob) string {
+	switch {
+	case !allowsEgress(job):
+		return egressDenied
+	case len(r.ExecutorEgress) > 0:
+		return egressRestricted
+	}
+	return ""
+}
+
+// egressRules returns the connections the job's executor may open: cluster
+// DNS and the endpoints executors may connect to, which default to those
+// outside the cluster. Jobs denied egress may open none.
+func (r *QiskitJobReconciler) egressRules(job *quantumv1.QiskitJob) []networkingv1.NetworkPolicyEgressRule {
+	if !allowsEgress(job) {
+		return nil
+	}
+	var peers []networkingv1.NetworkPolicyPeer
+	for _, cidr := range r.ExecutorEgress {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+	if len(peers) == 0 {
+		for _, block := range sandboxEgress {
+			peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: block.DeepCopy()})
+		}
+	}
+	var ports []networkingv1.NetworkPolicyPort
+	for _, port := range r.ExecutorEgressPorts {
+		ports = append(ports, networkingv1.NetworkPolicyPort{Protocol: ptr(corev1.ProtocolTCP), Port: ptr(intstr.FromInt32(port))})
+	}
+	if len(ports) == 0 {
+		ports = []networkingv1.NetworkPolicyPort{{Protocol: ptr(corev1.ProtocolTCP), Port: ptr(intstr.FromInt32(443))}}
+	}
+
+	dns := intstr.FromInt32(53)
+	return []networkingv1.NetworkPolicyEgressRule{
+		{
+			To: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{corev1.LabelMetadataName: metav1.NamespaceSystem},
+			}}},
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: ptr(corev1.ProtocolUDP), Port: &dns},
+				{Protocol: ptr(corev1.ProtocolTCP), Port: &dns},
+			},
+		},
+		{To: peers, Ports: ports},
+	}
+}
+
+// confineEgress labels the execution pod with its egress mode and makes sure
+// the NetworkPolicy enforcing it exists in the pod's namespace, with the
+// endpoints currently configured. The policies select pods by label, so one
+// per mode serves every job of the namespace, and nothing reaches the pods
+// they select.
+func (r *QiskitJobReconciler) confineEgress(ctx context.Context, job *quantumv1.QiskitJob, pod *corev1.Pod) error {
+	mode := r.egressMode(job)
+	if mode == "" {
+		return nil
+	}
+	pod.Labels[EgressLabel] = mode
+
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      egressPolicies[mode],
+			Namespace: pod.Namespace,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "qiskit-operator"},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{EgressLabel: mode}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Egress:      r.egressRules(job),
+		},
+	}
+	err := r.Create(ctx, policy)
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	// Patch rather than read the policy, so NetworkPolicies need not be cached
+	patch, err := json.Marshal(map[string]interface{}{"spec": policy.Spec})
+	if err != nil {
+		return err
+	}
+	existing := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: policy.Name, Namespace: policy.Namespace}}
+	return r.Patch(ctx, existing, client.RawPatch(types.MergePatchType, patch))
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/hints"
+	"github.com/quantum-operator/qiskit-operator/pkg/scheduler"
+)
+
+// device returns the provider device the job runs on: the candidate its
+// backend selection scored best, else spec.backend.name
+func device(job *quantumv1.QiskitJob) string {
+	if selection := job.Status.BackendSelection; selection != nil && selection.Backend != "" {
+		return selection.Backend
+	}
+	return job.Spec.Backend.Name
+}
+
+// selectBackend scores the candidate backends of the job's backend
+// selection and records the best, and the breakdown of every score, in
+// status. The candidates are spec.backend and, for ibm_quantum jobs, the
+// preferred devices of the same instance, less the excluded ones. A
+// candidate named by the quantum.io/target-backend annotation is picked
+// over the best. Jobs whose backend a QuantumBackend supplied were already
+// placed by the same preferences. It returns why the job fails if no
+// candidate can run it.
+func (r *QiskitJobReconciler) selectBackend(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	selection := job.Spec.BackendSelection
+	target := hints.TargetBackend(job)
+	names := hints.Candidates(job)
+	if target != "" && !backendref.Applied(job) && !slices.Contains(names, target) {
+		return fmt.Sprintf("Backend %s of annotation %s is not a candidate backend of the job", target,
+			hints.TargetBackendAnnotation), nil
+	}
+	if selection == nil || backendref.Applied(job) {
+		job.Status.BackendSelection = nil
+		return "", nil
+	}
+
+	if len(names) == 0 {
+		own := job.Spec.Backend.Name
+		if own == "" {
+			own = job.Spec.Backend.Type
+		}
+		return fmt.Sprintf("Backend %s is in spec.backendSelection.excludedBackends and no preferred backend is left", own), nil
+	}
+
+	var registered quantumv1.QuantumBackendList
+	if err := r.List(ctx, &registered); err != nil {
+		return "", err
+	}
+	candidates := make([]scheduler.Candidate, 0, len(names))
+	for _, name := range names {
+		candidates = append(candidates, r.candidate(ctx, job, name, registered.Items))
+	}
+
+	qubits := 0
+	if job.Status.CircuitMetadata != nil {
+		qubits = job.Status.CircuitMetadata.Qubits
+	}
+	var weights scheduler.Weights
+	if w := selection.Weights; w != nil {
+		weights = scheduler.Weights{Cost: w.Cost, QueueTime: w.QueueTime, Capability: w.Capability, Availability: w.Availability}
+	}
+	scores := scheduler.Rank(candidates, qubits, weights, selection.PreferredBackends)
+
+	status := &quantumv1.BackendSelectionStatus{Backend: scores[0].Name}
+	for _, score := range scores {
+		status.Scores = append(status.Scores, quantumv1.BackendScore{
+			Backend:      score.Name,
+			Cost:         roundScore(score.Cost),
+			QueueTime:    roundScore(score.QueueTime),
+			Capability:   roundScore(score.Capability),
+			Availability: roundScore(score.Availability),
+			Total:        roundScore(score.Total),
+			Message:      score.Ineligible,
+		})
+	}
+	job.Status.BackendSelection = status
+	traceStep(ctx, "Scored candidate backends: %s", describeScores(status.Scores))
+
+	if target != "" {
+		i := slices.IndexFunc(scores, func(score scheduler.Score) bool { return score.Name == target })
+		if scores[i].Ineligible != "" {
+			return fmt.Sprintf("Target backend %s cannot run the circuit: %s", target, scores[i].Ineligible), nil
+		}
+		status.Backend = target
+		status.Targeted = true
+		log.FromContext(ctx).Info("Picked the target backend", "backend", target,
+			"score", status.Scores[i].Total, "best", scores[0].Name)
+		return "", nil
+	}
+	if scores[0].Ineligible != "" {
+		var reasons []string
+		for _, score := range scores {
+			reasons = append(reasons, fmt.Sprintf("%s %s", score.Name, score.Ineligible))
+		}
+		return "No candidate backend can run the circuit: " + strings.Join(reasons, "; "), nil
+	}
+	log.FromContext(ctx).Info("Scored candidate backends", "backend", status.Backend,
+		"score", status.Scores[0].Total, "candidates", len(scores))
+	return "", nil
+}
+
+// candidate gathers what is known about a candidate backend: the cost of
+// the job on it, the queue wait observed on it and what its QuantumBackend,
+// if one is registered for it, reports
+func (r *QiskitJobReconciler) candidate(ctx context.Context, job *quantumv1.QiskitJob, name string, registered []quantumv1.QuantumBackend) scheduler.Candidate {
+	candidate := scheduler.Candidate{Name: name}
+	switch job.Spec.Backend.Type {
+	case "ibm_quantum":
+		candidate.Cost = ibmCost(ctx, job, name)
+	case "local_simulator", "ibm_local_testing":
+		// Simulators run in the cluster and are available with it
+		available := true
+		candidate.Available = &available
+	}
+	if r.QueuePredictor != nil {
+		if prediction, ok := r.QueuePredictor.Predict(name); ok {
+			candidate.QueueWait = &prediction.Wait
+		}
+	}
+
+	for i := range registered {
+		qb := &registered[i]
+		if qb.Spec.Backend.Type != job.Spec.Backend.Type || qb.Spec.Backend.Name != name {
+			continue
+		}
+		candidate.Qubits = int(qb.Status.Qubits)
+		if calibration := qb.Status.Calibration; calibration != nil && calibration.MedianTwoQubitError > 0 {
+			candidate.TwoQubitError = &calibration.MedianTwoQubitError
+		}
+		if condition := meta.FindStatusCondition(qb.Status.Conditions, backendref.ConditionAvailable); condition != nil &&
+			condition.Status != metav1.ConditionUnknown {
+			available := condition.Status == metav1.ConditionTrue
+			candidate.Available = &available
+		}
+		break
+	}
+	return candidate
+}
+
+// roundScore rounds a score to two decimals for the status
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
+
+// describeScores summarizes the scores of the candidate backends, best
+// first, for the job's decision trace
+func describeScores(scores []quantumv1.BackendScore) string {
+	described := make([]string, 0, len(scores))
+	for _, score := range scores {
+		if score.Message != "" {
+			described = append(described, fmt.Sprintf("%s ineligible (%s)", score.Backend, score.Message))
+		} else {
+			described = append(described, fmt.Sprintf("%s %.2f", score.Backend, score.Total))
+		}
+	}
+	return strings.Join(described, ", ")
+}
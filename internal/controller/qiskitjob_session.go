@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/cost"
+)
+
+// sessionWaitInterval is how often a job waiting for its QiskitSession to
+// open checks on it
+const sessionWaitInterval = 15 * time.Second
+
+// ConditionSessionCostAmortized is True once the cost of the dedicated
+// session a job closed has been split across the jobs that ran in it
+const ConditionSessionCostAmortized = "SessionCostAmortized"
+
+// inSameSession reports whether other ran in the dedicated session job
+// closed. Sessions are matched by provider session ID when known, and by
+// name otherwise.
+func inSameSession(job, other *quantumv1.QiskitJob) bool {
+	if other.Spec.Session == nil || other.Spec.Session.Mode != "dedicated" ||
+		other.Spec.Session.Name != job.Spec.Session.Name {
+		return false
+	}
+	if job.Status.SessionID != "" && other.Status.SessionID != job.Status.SessionID {
+		return false
+	}
+	return other.Status.Phase == PhaseCompleted || other.Status.Phase == PhaseFailed
+}
+
+// quantumSeconds returns the quantum time billed for a job in seconds
+func quantumSeconds(job *quantumv1.QiskitJob) float64 {
+	if job.Status.Metrics == nil {
+		return 0
+	}
+	d, err := time.ParseDuration(job.Status.Metrics.QuantumTime)
+	if err != nil {
+		return 0
+	}
+	return d.Seconds()
+}
+
+// amortizeSessionCost splits the cost of the dedicated session a finished job
+// closed across every job that ran in the session, proportionally to their
+// quantum time, recording each share as the job's ActualCost. Without it the
+// closing job would carry the whole session's bill.
+func (r *QiskitJobReconciler) amortizeSessionCost(ctx context.Context, job *quantumv1.QiskitJob) error {
+	session := job.Spec.Session
+	if session == nil || session.Mode != "dedicated" || job.Status.SessionCost == "" ||
+		meta.IsStatusConditionTrue(job.Status.Conditions, ConditionSessionCostAmortized) {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	total, err := parseCost(job.Status.SessionCost)
+	if err != nil {
+		logger.Error(err, "Not amortizing session cost")
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionSessionCostAmortized,
+			Status:             metav1.ConditionFalse,
+			Reason:             "InvalidSessionCost",
+			Message:            err.Error(),
+			ObservedGeneration: job.Generation,
+		})
+		return r.Status().Update(ctx, job)
+	}
+
+	members := []*quantumv1.QiskitJob{job}
+	err = eachJob(ctx, r.Client, r.Jobs, func(other *quantumv1.QiskitJob) error {
+		if other.UID != job.UID && inSameSession(job, other) {
+			members = append(members, other.DeepCopy())
+		}
+		return nil
+	}, client.InNamespace(job.Namespace))
+	if err != nil {
+		return err
+	}
+
+	weights := make([]float64, len(members))
+	for i, member := range members {
+		weights[i] = quantumSeconds(member)
+	}
+	shares := cost.Amortize(total, weights)
+
+	// The closing job is updated last, so a failure part-way is retried
+	// from the start on the next reconcile
+	for i := 1; i < len(members); i++ {
+		members[i].Status.ActualCost = formatCost(shares[i])
+		if err := r.Status().Update(ctx, members[i]); err != nil {
+			return err
+		}
+	}
+	job.Status.ActualCost = formatCost(shares[0])
+	message := fmt.Sprintf("Session cost %s split across %d jobs by quantum time",
+		formatCost(total), len(members))
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionSessionCostAmortized,
+		Status:             metav1.ConditionTrue,
+		Reason:             "QuantumTime",
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+	logger.Info("Amortized session cost", "session", session.Name, "cost", formatCost(total), "jobs", len(members))
+	return r.Status().Update(ctx, job)
+}
+
+// holdForSession runs hardware jobs that name a QiskitSession of their
+// namespace in that session: the job waits until the session is open and
+// then records its provider session ID, which the job is submitted into.
+// Jobs naming no QiskitSession are left alone; their executor may open a
+// session of its own. It reports whether the job is held, in which case
+// reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) holdForSession(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if job.Spec.Session == nil || job.Spec.Session.Name == "" || backendType(job) != string(backend.IBMQuantum) {
+		return ctrl.Result{}, false, nil
+	}
+	var session quantumv1.QiskitSession
+	err := r.Get(ctx, client.ObjectKey{Namespace: job.Namespace, Name: job.Spec.Session.Name}, &session)
+	if apierrors.IsNotFound(err) {
+		return ctrl.Result{}, false, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	if session.Spec.Backend.Name != device(job) {
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Job runs on %s, but session %s runs jobs on %s",
+			device(job), session.Name, session.Spec.Backend.Name))
+		return result, true, err
+	}
+	switch session.Status.Phase {
+	case SessionPhaseExpired, SessionPhaseFailed:
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Session %s is %s: %s",
+			session.Name, strings.ToLower(session.Status.Phase), session.Status.Message))
+		return result, true, err
+	case SessionPhaseActive:
+		if session.Status.SessionID != "" {
+			job.Status.SessionID = session.Status.SessionID
+			return ctrl.Result{}, false, nil
+		}
+	}
+
+	job.Status.Message = fmt.Sprintf("Waiting for session %s to open", session.Name)
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	requeueBecause(ctx, RequeueBackendQueue)
+	return ctrl.Result{RequeueAfter: sessionWaitInterval}, true, nil
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/provenance"
+	"github.com/quantum-operator/qiskit-operator/pkg/region"
+)
+
+// DefaultSessionSweepInterval is how often leaked IBM Quantum sessions are
+// swept unless configured otherwise
+const DefaultSessionSweepInterval = 15 * time.Minute
+
+// sessionSweepJobLimit is how many of an instance's most recent operator
+// jobs are examined for sessions each sweep
+const sessionSweepJobLimit = 200
+
+// sessionSweepGracePeriod leaves sessions alone while they ran a job this
+// recently, so a retry or the next job of a session can still pick it up
+const sessionSweepGracePeriod = 10 * time.Minute
+
+// ClusterID identifies the cluster by the UID of its kube-system namespace,
+// which stays the same for the cluster's lifetime
+func ClusterID(ctx context.Context, reader client.Reader) (string, error) {
+	var namespace corev1.Namespace
+	if err := reader.Get(ctx, client.ObjectKey{Name: "kube-system"}, &namespace); err != nil {
+		return "", err
+	}
+	return string(namespace.UID), nil
+}
+
+// jobTags returns the provider job tags of a job, marked with the cluster
+// when its ID is known
+func (r *QiskitJobReconciler) jobTags(job *quantumv1.QiskitJob) []string {
+	tags := provenance.JobTags(job)
+	if r.ClusterID != "" {
+		tags = append(tags, provenance.ClusterTag(r.ClusterID))
+	}
+	return tags
+}
+
+// ibmAccount is an IBM Quantum Runtime instance and the API key to sweep it with
+type ibmAccount struct {
+	url      string
+	instance string
+	apiKey   string
+}
+
+// SessionSweeper periodically closes IBM Quantum sessions that jobs of this
+// cluster opened but no live QiskitJob or QiskitSession owns any more, such
+// as sessions left open when an executor or the operator crashed. Dedicated
+// sessions reserve a device, and bill for it, until they are closed or time
+// out.
+//
+// Sessions are found through the operator's provider job tags, in the IBM
+// Quantum instances the cluster's jobs have credentials for, plus Secrets.
+type SessionSweeper struct {
+	client.Client
+
+	// ClusterID restricts sweeping to sessions of jobs tagged with this
+	// cluster, so clusters sharing an instance leave each other's alone
+	ClusterID string
+
+	// Interval is how often to sweep
+	Interval time.Duration
+
+	// Jobs lists the jobs whose instances are swept; nil lists them from
+	// the client
+	Jobs *JobLister
+
+	// Secrets hold additional credentials to sweep, with the same api-key
+	// and instance keys as job credentials
+	Secrets []types.NamespacedName
+
+	// IBM overrides the IBM Quantum endpoints
+	IBM ibm.Options
+}
+
+var _ manager.LeaderElectionRunnable = &SessionSweeper{}
+
+// NeedLeaderElection makes sweeping run only on the elected leader
+func (s *SessionSweeper) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable
+func (s *SessionSweeper) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("session-sweeper")
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		closed, err := s.Sweep(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to sweep leaked sessions")
+		} else if closed > 0 {
+			logger.Info("Closed leaked sessions", "closed", closed)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep closes the leaked sessions of every known instance once and returns
+// how many it closed. An instance that cannot be swept does not stop the
+// others; the first error is returned.
+func (s *SessionSweeper) Sweep(ctx context.Context) (int, error) {
+	accounts, err := s.accounts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var firstErr error
+	closed := 0
+	for _, account := range accounts {
+		n, err := s.sweepAccount(ctx, account)
+		closed += n
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to sweep sessions", "instance", account.instance)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return closed, firstErr
+}
+
+// accounts returns the distinct IBM Quantum instances to sweep
+func (s *SessionSweeper) accounts(ctx context.Context) ([]ibmAccount, error) {
+	seen := map[string]bool{}
+	var accounts []ibmAccount
+	add := func(ref types.NamespacedName, instance, regionName string) {
+		var secret corev1.Secret
+		if err := s.Get(ctx, ref, &secret); err != nil {
+			if !apierrors.IsNotFound(err) {
+				logf.FromContext(ctx).Error(err, "Failed to read credentials", "secret", ref)
+			}
+			return
+		}
+		if instance == "" {
+			instance = string(secret.Data["instance"])
+		}
+		apiKey := string(secret.Data["api-key"])
+		if apiKey == "" || !strings.HasPrefix(instance, "crn:") {
+			return
+		}
+		account := ibmAccount{url: s.IBM.URL, instance: instance, apiKey: apiKey}
+		if account.url == "" {
+			account.url = ibm.URL(regionName)
+		}
+		sum := sha256.Sum256([]byte(apiKey))
+		key := strings.Join([]string{account.url, instance, hex.EncodeToString(sum[:])}, "|")
+		if !seen[key] {
+			seen[key] = true
+			accounts = append(accounts, account)
+		}
+	}
+
+	err := eachJob(ctx, s.Client, s.Jobs, func(job *quantumv1.QiskitJob) error {
+		if !strings.HasPrefix(job.Spec.Backend.Type, "ibm_") || job.Spec.Backend.Type == "ibm_local_testing" {
+			return nil
+		}
+		ref := region.Credentials(job.Spec.Credentials, job.Status.Region)
+		if ref == nil {
+			return nil
+		}
+		namespace, err := backendref.SecretNamespace(ctx, s.Client, job, *ref)
+		if err != nil {
+			// Jobs that may not use the Secret fail before they submit anything
+			return nil
+		}
+		add(types.NamespacedName{Namespace: namespace, Name: ref.Name}, job.Spec.Backend.Instance, job.Status.Region)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range s.Secrets {
+		add(ref, "", "")
+	}
+	return accounts, nil
+}
+
+// sweepAccount closes the leaked sessions of one instance
+func (s *SessionSweeper) sweepAccount(ctx context.Context, account ibmAccount) (int, error) {
+	opts := s.IBM
+	opts.URL = account.url
+	adapter := ibm.New("", account.instance, opts)
+	if err := adapter.Authenticate(ctx, &backend.Credentials{APIKey: account.apiKey}); err != nil {
+		return 0, err
+	}
+	jobs, err := adapter.ListJobs(ctx, []string{provenance.OperatorTag, provenance.ClusterTag(s.ClusterID)}, sessionSweepJobLimit)
+	if err != nil {
+		return 0, err
+	}
+
+	sessions := map[string][]ibm.Job{}
+	var order []string
+	for _, job := range jobs {
+		if job.SessionID == "" {
+			continue
+		}
+		if _, ok := sessions[job.SessionID]; !ok {
+			order = append(order, job.SessionID)
+		}
+		sessions[job.SessionID] = append(sessions[job.SessionID], job)
+	}
+
+	closed := 0
+	for _, id := range order {
+		members := sessions[id]
+		if time.Since(members[0].Created) < sessionSweepGracePeriod {
+			continue
+		}
+		owned, err := s.owned(ctx, members)
+		if err != nil {
+			return closed, err
+		}
+		if owned {
+			continue
+		}
+		session, err := adapter.GetSession(ctx, id)
+		if err != nil {
+			return closed, err
+		}
+		if session.Closed() {
+			continue
+		}
+		logf.FromContext(ctx).Info("Closing leaked session", "session", id, "mode", session.Mode,
+			"instance", account.instance, "lastJob", members[0].ID)
+		if err := adapter.CloseSession(ctx, id); err != nil {
+			return closed, err
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// owned reports whether the session the provider jobs ran in is still in use:
+// one of its QiskitJobs is unfinished, another unfinished job of the same
+// namespace names the same session, or a QiskitSession of that name exists
+func (s *SessionSweeper) owned(ctx context.Context, members []ibm.Job) (bool, error) {
+	for _, member := range members {
+		key, uid, ok := provenance.FromTags(member.Tags)
+		if !ok {
+			continue
+		}
+		var job quantumv1.QiskitJob
+		if err := getJob(ctx, s.Client, s.Jobs, key, &job); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if uid != "" && job.UID != uid {
+			continue
+		}
+		if !settled(&job) {
+			return true, nil
+		}
+		if job.Spec.Session == nil || job.Spec.Session.Name == "" {
+			continue
+		}
+
+		name := job.Spec.Session.Name
+		var session quantumv1.QiskitSession
+		err := s.Get(ctx, client.ObjectKey{Namespace: job.Namespace, Name: name}, &session)
+		if err == nil {
+			return true, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		var others quantumv1.QiskitJobList
+		if err := s.List(ctx, &others, client.InNamespace(job.Namespace)); err != nil {
+			return false, err
+		}
+		for i := range others.Items {
+			other := &others.Items[i]
+			if other.Spec.Session != nil && other.Spec.Session.Name == name && !settled(other) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// finished reports whether the job has reached a terminal phase
+func finished(job *quantumv1.QiskitJob) bool {
+	return job.Status.Phase.Finished()
+}
+
+// settled reports whether the job finished and does not run again: a failed
+// job with retries left still needs its session for the next attempt
+func settled(job *quantumv1.QiskitJob) bool {
+	return finished(job) && !(job.Status.Phase == PhaseFailed && retriesLeft(job))
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// ShadowLabel marks the execution pods of shadow runs
+const ShadowLabel = "quantum.io/shadow"
+
+// shadowPodName names the execution pod of the shadow run of the job's
+// current attempt
+func shadowPodName(job *quantumv1.QiskitJob) string {
+	return executionName(job) + "-shadow"
+}
+
+// startShadow creates the execution pod of the job's shadow run for the
+// current attempt and records it in the job's status, which the caller
+// persists. It is called on every pass of a running attempt and does
+// nothing once the status records the shadow run of the attempt. A shadow
+// run that cannot start is recorded as failed; it never affects the primary
+// run.
+func (r *QiskitJobReconciler) startShadow(ctx context.Context, job *quantumv1.QiskitJob) {
+	if job.Spec.Shadow == nil {
+		return
+	}
+	if shadow := job.Status.Shadow; shadow != nil && shadow.PodName == shadowPodName(job) {
+		return
+	}
+	logger := log.FromContext(ctx)
+
+	shadowJob := job.DeepCopy()
+	shadowJob.Spec.Backend = job.Spec.Shadow.Backend
+	// Shadow runs are free and stay out of the primary run's session
+	shadowJob.Spec.Session = nil
+	// Only the primary run writes to the job's outputs
+	shadowJob.Spec.Outputs = nil
+	status := &quantumv1.ShadowStatus{
+		Backend: job.Spec.Shadow.Backend.Type,
+		PodName: shadowPodName(job),
+		Phase:   PhaseRunning,
+	}
+	if job.Spec.Shadow.Backend.Type == "ibm_local_testing" {
+		status.Backend = localTestingBackend(&job.Spec.Shadow.Backend)
+	}
+	job.Status.Shadow = status
+
+	pod, err := r.createExecutionPod(ctx, shadowJob)
+	if err == nil {
+		pod.Name = status.PodName
+		pod.Labels[ShadowLabel] = "true"
+		err = r.Create(ctx, pod)
+	}
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to start shadow run")
+		status.Phase = PhaseFailed
+		status.Message = fmt.Sprintf("Failed to create shadow pod: %v", err)
+		return
+	}
+	logger.Info("Shadow run started", "pod", status.PodName, "backend", status.Backend)
+}
+
+// awaitShadow waits for the shadow run of a job whose primary run finished.
+// It reports whether the job is still waiting, in which case reconciliation
+// should stop with the returned result.
+func (r *QiskitJobReconciler) awaitShadow(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	shadow := job.Status.Shadow
+	if shadow == nil || shadow.Phase != PhaseRunning {
+		return ctrl.Result{}, false, nil
+	}
+
+	var pod corev1.Pod
+	err := r.Get(ctx, types.NamespacedName{Name: shadow.PodName, Namespace: ExecutionNamespace(job)}, &pod)
+	switch {
+	case apierrors.IsNotFound(err):
+		shadow.Phase = PhaseFailed
+		shadow.Message = fmt.Sprintf("Shadow pod %s not found", shadow.PodName)
+	case err != nil:
+		return ctrl.Result{}, true, err
+	case pod.Status.Phase == corev1.PodSucceeded:
+		shadow.Phase = PhaseCompleted
+	case pod.Status.Phase == corev1.PodFailed:
+		shadow.Phase = PhaseFailed
+		shadow.Message = fmt.Sprintf("Shadow pod %s failed", shadow.PodName)
+	default:
+		job.Status.Message = fmt.Sprintf("Primary run finished, waiting for shadow run on %s", shadow.Backend)
+		requeueBecause(ctx, RequeueWaitingForPod)
+		return ctrl.Result{RequeueAfter: r.runningRequeue()}, true, r.Status().Update(ctx, job)
+	}
+	return ctrl.Result{}, false, nil
+}
+
+// compareShadow reads the results of the job's completed shadow run and
+// records how far they diverge from the primary counts. It returns nil when
+// there is nothing to compare.
+func (r *QiskitJobReconciler) compareShadow(ctx context.Context, job *quantumv1.QiskitJob, primary map[string]int) *results.ShadowResults {
+	if job.Status.Shadow == nil || job.Status.Shadow.Phase != PhaseCompleted {
+		return nil
+	}
+	if r.PodLogs == nil {
+		job.Status.Shadow.Message = "Shadow results not compared: pod logs cannot be read"
+		return nil
+	}
+	shadow, err := results.ReadShadow(ctx, r.PodLogs, job, primary)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read shadow run results")
+		job.Status.Shadow.Message = fmt.Sprintf("Shadow results not compared: %v", err)
+		return nil
+	}
+	recordDivergence(job, shadow.Divergence)
+	return shadow
+}
+
+// recordDivergence publishes the divergence of the shadow run in the job's status
+func recordDivergence(job *quantumv1.QiskitJob, d results.Divergence) {
+	if job.Status.Shadow == nil {
+		return
+	}
+	job.Status.Shadow.TotalVariationDistance = fmt.Sprintf("%.4f", d.TotalVariationDistance)
+	job.Status.Shadow.HellingerFidelity = fmt.Sprintf("%.4f", d.HellingerFidelity)
+	job.Status.Shadow.Message = ""
+}
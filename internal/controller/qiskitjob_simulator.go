@@ -0,0 +1,50 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+// simulatorEpilogue samples the circuit qc defined by the job's code on Aer
+// and reports its counts, the shots run and how long sampling took. Circuits
+// without classical bits are measured on all qubits first. The circuit is
+// transpiled with the job's optimization level and transpiler options.
+// Setting SIMULATOR_SEED seeds transpilation, unless the job sets its own
+// seed, and sampling, so runs reproduce their counts. AER_DEVICE=GPU simulates on the GPU, failing clearly if Aer sees
+// none. The qubit layout is reported after the counts.
+const simulatorEpilogue = layoutReporter + transpilerOptions + `
+
+# Local simulator: sample the circuit on Aer and report its counts
+import json as _json
+import os as _os
+import time as _time
+from qiskit import QuantumCircuit as _QuantumCircuit
+if isinstance(globals().get('qc'), _QuantumCircuit):
+    from qiskit_aer import AerSimulator as _AerSimulator
+    _sim_device = _os.environ.get('AER_DEVICE', 'CPU')
+    _sim_devices = _AerSimulator().available_devices()
+    if _sim_device not in _sim_devices:
+        raise RuntimeError('Aer cannot simulate on %s in this executor, only on %s' % (_sim_device, ', '.join(_sim_devices)))
+    _sim_backend = _AerSimulator(device=_sim_device)
+    _sim_circuit = qc if qc.num_clbits else qc.measure_all(inplace=False)
+    _sim_shots = int(_os.environ['SHOTS'])
+    _sim_seed = int(_os.environ['SIMULATOR_SEED']) if _os.environ.get('SIMULATOR_SEED') else None
+    _sim_options = {} if _sim_seed is None else {'seed_simulator': _sim_seed}
+    _sim_start = _time.perf_counter()
+    _sim_isa = _pass_manager_for(_sim_backend, _sim_seed).run(_sim_circuit)
+    _sim_result = _sim_backend.run(_sim_isa, shots=_sim_shots, **_sim_options).result()
+    _sim_time = _time.perf_counter() - _sim_start
+    print(_json.dumps({'backend': _sim_backend.name, 'mode': 'local_simulator', 'counts': _sim_result.get_counts(), 'shots': _sim_shots, 'execution_time': _sim_time}), flush=True)
+    _report_layout(_sim_isa, _sim_circuit.cregs)
+`
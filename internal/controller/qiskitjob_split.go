@@ -0,0 +1,537 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/generichttp"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+	"github.com/quantum-operator/qiskit-operator/pkg/split"
+)
+
+// SplitPartLabel records which backend's share of a split job an execution
+// runs
+const SplitPartLabel = "quantum.io/split-part"
+
+// splitExecutionName names the execution running a backend's share of the
+// job's current attempt
+func splitExecutionName(job *quantumv1.QiskitJob, index int) string {
+	return fmt.Sprintf("%s-part-%d", executionName(job), index)
+}
+
+// splitBackendName names a backend of a split job in its status
+func splitBackendName(backend *quantumv1.BackendSpec) string {
+	if backend.Name == "" {
+		return backend.Type
+	}
+	return backend.Type + "/" + backend.Name
+}
+
+// splitParts returns the share of every backend of the split job
+func splitParts(job *quantumv1.QiskitJob) []split.Part {
+	shots := job.Spec.Execution.Shots
+	if shots == 0 {
+		shots = defaults.Shots
+	}
+	return split.Parts(&job.Spec, shots)
+}
+
+// splitShare returns the job as a backend's share of it runs: on the
+// share's backend with the share's shots. Only the job's own backend keeps
+// its session, the device picked for it and its fallback to simulation.
+func splitShare(job *quantumv1.QiskitJob, index int, part split.Part) *quantumv1.QiskitJob {
+	share := job.DeepCopy()
+	share.Spec.Backend = part.Backend
+	share.Spec.Execution.Shots = part.Shots
+	if index > 0 {
+		share.Status.SessionID = ""
+		share.Status.FallbackUsed = false
+		share.Status.BackendSelection = nil
+		share.Status.SelectedBackend = ""
+	}
+	return share
+}
+
+// splitHardwareShares returns the shares of the split job submitted to
+// quantum hardware through their provider's API
+func splitHardwareShares(job *quantumv1.QiskitJob) []*quantumv1.QiskitJob {
+	if job.Spec.Split == nil {
+		return nil
+	}
+	var shares []*quantumv1.QiskitJob
+	for i, part := range splitParts(job) {
+		if share := splitShare(job, i, part); remote(share) {
+			shares = append(shares, share)
+		}
+	}
+	return shares
+}
+
+// estimateSplitCost prices the shares of the split job on IBM hardware,
+// each for its own shots. Shares on other backends are free, as jobs on
+// them are.
+func estimateSplitCost(ctx context.Context, job *quantumv1.QiskitJob) string {
+	var cost float64
+	for _, share := range splitHardwareShares(job) {
+		if backendType(share) == string(backend.IBMQuantum) {
+			cost += ibmCost(ctx, share, device(share))
+		}
+	}
+	return formatCost(cost)
+}
+
+// splitExecutionJob builds the batch Job running a backend's share of the
+// job's current attempt: the job's execution, on the share's backend with
+// the share's shots
+func (r *QiskitJobReconciler) splitExecutionJob(ctx context.Context, job *quantumv1.QiskitJob,
+	index int, part split.Part) (*batchv1.Job, error) {
+	share := splitShare(job, index, part)
+	execution, err := r.executionJob(ctx, share)
+	if err != nil {
+		return nil, err
+	}
+	if index == 0 {
+		job.Status.ExecutorImage = share.Status.ExecutorImage
+	}
+
+	execution.Name = splitExecutionName(job, index)
+	execution.Labels[SplitPartLabel] = strconv.Itoa(index)
+	execution.Spec.Template.Labels[SplitPartLabel] = strconv.Itoa(index)
+	// Each share posts its output under its own execution's name
+	if err := r.renameCallback(job, execution); err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// submitSplitShare submits a backend's share of the job to the provider of
+// a remote backend, returning the provider's job ID. It returns an empty ID
+// when the provider deferred the submission or the backend's circuit breaker
+// is open, to submit again on a later pass. A share submitted on a pass that
+// failed to record its job ID is looked up by its client request ID rather
+// than submitted again.
+func (r *QiskitJobReconciler) submitSplitShare(ctx context.Context, job *quantumv1.QiskitJob,
+	index int, part split.Part) (string, error) {
+	share := splitShare(job, index, part)
+	adapter, err := r.remoteBackend(ctx, share)
+	if err != nil {
+		return "", err
+	}
+	code, err := r.circuitCode(ctx, job)
+	if err != nil {
+		return "", err
+	}
+	if status, ok := r.allowBackendCall(share); !ok {
+		log.FromContext(ctx).Info("Holding split share for an open circuit breaker", "part", index,
+			"backend", status.Backend, "retryAt", status.RetryAt)
+		return "", nil
+	}
+	requestID := fmt.Sprintf("%s-part-%d", submissionID(job), index)
+	id, err := findSubmitted(ctx, adapter, requestID)
+	if err == nil && id == nil {
+		id, err = adapter.SubmitJob(ctx, &backend.QuantumJob{
+			ID:                fmt.Sprintf("%s-part-%d", job.UID, index),
+			CircuitCode:       code,
+			Shots:             part.Shots,
+			OptimizationLevel: job.Spec.Execution.OptimizationLevel,
+			ResilienceLevel:   defaults.ResilienceLevel(&job.Spec.Execution),
+			MaxExecutionTime:  executionLimit(job),
+			Tags:              r.jobTags(job),
+			RequestID:         requestID,
+			SessionID:         share.Status.SessionID,
+			ErrorMitigation:   errorMitigationOf(job),
+		})
+	}
+	r.recordBackendCall(ctx, share, err)
+	switch {
+	case backend.Transient(err):
+		log.FromContext(ctx).Info("Provider deferred split share", "part", index, "backend", adapter.Name(), "reason", err.Error())
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("failed to submit to %s: %w", adapter.Name(), err)
+	}
+	return string(*id), nil
+}
+
+// pollSplitShare follows a backend's share of the job on the provider of a
+// remote backend, recording when it completed or failed. A provider that
+// cannot be reached, or whose circuit breaker is open, is polled again on
+// the next pass.
+func (r *QiskitJobReconciler) pollSplitShare(ctx context.Context, job *quantumv1.QiskitJob,
+	status *quantumv1.SplitPartStatus, part split.Part) error {
+	share := splitShare(job, status.Index, part)
+	adapter, err := r.remoteBackend(ctx, share)
+	if err != nil {
+		return err
+	}
+	if !r.Polls.TryAccept(adapter.Name()) {
+		return nil
+	}
+	if _, ok := r.allowBackendCall(share); !ok {
+		return nil
+	}
+	provider, err := adapter.GetJobStatus(ctx, backend.JobID(status.Execution))
+	r.recordBackendCall(ctx, share, err)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to poll split share", "part", status.Index, "providerJobID", status.Execution)
+		return nil
+	}
+	switch provider.Phase {
+	case "Completed":
+		status.Phase = PhaseCompleted
+	case "Failed", "Cancelled":
+		status.Phase = PhaseFailed
+		status.Message = fmt.Sprintf("Job %s %s on %s: %s", status.Execution, strings.ToLower(provider.Phase),
+			adapter.Name(), provider.Message)
+	}
+	return nil
+}
+
+// splitShareResult fetches the counts of a remote backend's completed share
+// of the job from its provider, how long the provider ran it and what it
+// cost. A cost the provider fails to report is logged and counted as free,
+// as for jobs driven over HTTP.
+func (r *QiskitJobReconciler) splitShareResult(ctx context.Context, job *quantumv1.QiskitJob,
+	status *quantumv1.SplitPartStatus, part split.Part) (map[string]int, time.Duration, float64, error) {
+	share := splitShare(job, status.Index, part)
+	adapter, err := r.remoteBackend(ctx, share)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if breakerStatus, ok := r.allowBackendCall(share); !ok {
+		return nil, 0, 0, fmt.Errorf("circuit breaker of %s is open until %s", breakerStatus.Backend,
+			breakerStatus.RetryAt.UTC().Format(time.RFC3339))
+	}
+	result, err := adapter.GetJobResult(ctx, backend.JobID(status.Execution))
+	r.recordBackendCall(ctx, share, err)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	var amount float64
+	if cost, err := adapter.GetActualCost(ctx, result.JobID); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to fetch split share cost", "part", status.Index, "providerJobID", status.Execution)
+	} else {
+		amount = cost.Amount
+	}
+	return result.Counts, result.ExecutionTime, amount, nil
+}
+
+// cancelSplitShares cancels the shares of a split job still running on
+// remote backends. It is best effort, like cancelHTTPJob.
+func (r *QiskitJobReconciler) cancelSplitShares(ctx context.Context, job *quantumv1.QiskitJob) {
+	status := job.Status.Split
+	if status == nil || job.Status.Phase != PhaseRunning {
+		return
+	}
+	parts := splitParts(job)
+	for _, part := range status.Parts {
+		if part.Phase != PhaseRunning || part.Index >= len(parts) || !splitRemote(job, part.Index, parts[part.Index]) {
+			continue
+		}
+		adapter, err := r.remoteBackend(ctx, splitShare(job, part.Index, parts[part.Index]))
+		if err == nil {
+			err = adapter.CancelJob(ctx, backend.JobID(part.Execution))
+		}
+		if err != nil && !errors.Is(err, generichttp.ErrNotSupported) {
+			log.FromContext(ctx).Error(err, "Failed to cancel split share", "part", part.Index, "providerJobID", part.Execution)
+		}
+	}
+}
+
+// splitRemote reports whether a backend's share of the job runs through its
+// provider's API rather than an execution pod
+func splitRemote(job *quantumv1.QiskitJob, index int, part split.Part) bool {
+	return remote(splitShare(job, index, part))
+}
+
+// handleSplitJob runs the shares of a split job's current attempt, all of
+// them at once, and completes the job once all of them have completed.
+// Shares on pod backends run in an execution each, those on remote backends
+// are submitted to their provider and polled, like generic_http and
+// ibm_quantum jobs, and not while hardware submissions are paused. After a
+// share fails the attempt fails once those running have finished, unless
+// spec.split.allowPartial completes it with the shares that did.
+func (r *QiskitJobReconciler) handleSplitJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	parts := splitParts(job)
+	status := job.Status.Split
+	if status == nil || status.Attempt != attempt(job) || status.Total != len(parts) {
+		status = &quantumv1.SplitStatus{Attempt: attempt(job), Total: len(parts)}
+		for i, part := range parts {
+			status.Parts = append(status.Parts, quantumv1.SplitPartStatus{
+				Index:   i,
+				Backend: splitBackendName(&part.Backend),
+				Shots:   part.Shots,
+				Phase:   PhasePending,
+			})
+		}
+		job.Status.Split = status
+		job.Status.JobID = executionName(job)
+		startAttempt(job)
+	}
+	allowPartial := job.Spec.Split.AllowPartial
+
+	// Follow the executions of the shares started so far
+	for i := range status.Parts {
+		part := &status.Parts[i]
+		if part.Phase != PhaseRunning {
+			continue
+		}
+		if splitRemote(job, part.Index, parts[part.Index]) {
+			if err := r.pollSplitShare(ctx, job, part, parts[part.Index]); err != nil {
+				if failed, err := failSplitShare(part, err); !failed {
+					return ctrl.Result{}, err
+				}
+			}
+			continue
+		}
+		execution, err := r.namedExecution(ctx, job, part.Execution)
+		if err != nil {
+			logger.Error(err, "Failed to get execution", "part", part.Index)
+			return ctrl.Result{}, err
+		}
+		switch {
+		case execution == nil:
+			// Not in the cache yet, or deleted; starting it again tells
+			part.Phase = PhasePending
+		case execution.phase == corev1.PodSucceeded:
+			part.Phase = PhaseCompleted
+		case execution.phase == corev1.PodFailed:
+			part.Phase = PhaseFailed
+			part.Message = execution.message
+			if part.Message == "" {
+				part.Message = fmt.Sprintf("Execution %s failed", part.Execution)
+			}
+		}
+	}
+	countSplitParts(status)
+
+	// A pause that began after scheduling still stops the submission of
+	// remote shares; those already submitted keep being polled
+	for _, part := range status.Parts {
+		if part.Phase == PhasePending && splitRemote(job, part.Index, parts[part.Index]) &&
+			(status.Failed == 0 || allowPartial) {
+			if result, held, err := r.holdForPause(ctx, job); held {
+				return result, err
+			}
+			break
+		}
+	}
+
+	// Start the pending shares, unless a failure already failed the attempt
+	for i := range status.Parts {
+		if status.Failed > 0 && !allowPartial {
+			break
+		}
+		part := &status.Parts[i]
+		if part.Phase != PhasePending {
+			continue
+		}
+		if splitRemote(job, part.Index, parts[part.Index]) {
+			id, err := r.submitSplitShare(ctx, job, part.Index, parts[part.Index])
+			switch {
+			case err != nil:
+				if failed, err := failSplitShare(part, err); !failed {
+					return ctrl.Result{}, err
+				}
+				status.Failed++
+			case id != "":
+				part.Phase = PhaseRunning
+				part.Execution = id
+				status.Running++
+			}
+			continue
+		}
+		batchJob, err := r.splitExecutionJob(ctx, job, part.Index, parts[part.Index])
+		if err != nil {
+			logger.Error(err, "Failed to create execution job", "part", part.Index)
+			return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Failed to create execution: %v", err))
+		}
+		if err := r.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create execution job in cluster", "part", part.Index)
+			return ctrl.Result{}, err
+		}
+		part.Phase = PhaseRunning
+		part.Execution = batchJob.Name
+		status.Running++
+	}
+
+	switch {
+	case status.Completed == status.Total:
+		return r.completeSplit(ctx, job)
+	case status.Running == 0 && status.Completed > 0 && allowPartial:
+		return r.completeSplit(ctx, job)
+	case status.Failed > 0 && status.Running == 0:
+		for _, part := range status.Parts {
+			if part.Phase == PhaseFailed {
+				return r.updateJobPhase(ctx, job, PhaseFailed,
+					fmt.Sprintf("Split share %d on %s failed: %s", part.Index, part.Backend, part.Message))
+			}
+		}
+	case status.Failed > 0 && !allowPartial:
+		job.Status.Message = fmt.Sprintf("Split share failed, waiting for %d running shares", status.Running)
+	default:
+		job.Status.Message = fmt.Sprintf("Split: %d/%d shares completed, %d running",
+			status.Completed, status.Total, status.Running)
+	}
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	requeueBecause(ctx, RequeueWaitingForPod)
+	return ctrl.Result{RequeueAfter: r.runningRequeue()}, nil
+}
+
+// failSplitShare fails a share for an error of its provider or its
+// circuit. Errors of the Kubernetes API are not the share's and are
+// returned instead, to retry.
+func failSplitShare(part *quantumv1.SplitPartStatus, err error) (bool, error) {
+	var apiErr apierrors.APIStatus
+	if errors.As(err, &apiErr) && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	part.Phase = PhaseFailed
+	part.Message = err.Error()
+	return true, nil
+}
+
+// countSplitParts tallies the shares of a split job by phase
+func countSplitParts(status *quantumv1.SplitStatus) {
+	status.Completed, status.Running, status.Failed = 0, 0, 0
+	for _, part := range status.Parts {
+		switch part.Phase {
+		case PhaseCompleted:
+			status.Completed++
+		case PhaseRunning:
+			status.Running++
+		case PhaseFailed:
+			status.Failed++
+		}
+	}
+}
+
+// completeSplit reads the counts of every completed share of a split job,
+// from the logs of its execution or from its provider, and exports them
+// together, with their totals as the job's counts: each backend weighs in by
+// the shots it ran. The shares ran at the same time, so the execution time
+// is that of the slowest, while the job's cost is that of all of them. Split jobs are processed here even where a results
+// processor is deployed.
+func (r *QiskitJobReconciler) completeSplit(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	status := job.Status.Split
+	log.FromContext(ctx).Info("Processing split completion", "shares", len(status.Parts))
+
+	// Results are only exported to sinks the namespace's residency policy allows
+	exportAllowed, err := r.outputExportAllowed(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	recordCompletion(job)
+
+	parts := splitParts(job)
+	var executionTime time.Duration
+	var cost float64
+	missing := 0
+	splitResults := make([]results.SplitResult, 0, len(status.Parts))
+	for _, part := range status.Parts {
+		result := results.SplitResult{Index: part.Index, Backend: part.Backend, Shots: part.Shots}
+		if part.Phase == PhaseCompleted && splitRemote(job, part.Index, parts[part.Index]) {
+			counts, t, shareCost, err := r.splitShareResult(ctx, job, &part, parts[part.Index])
+			if err != nil {
+				log.FromContext(ctx).Error(err, "Failed to fetch split share result", "part", part.Index)
+				return ctrl.Result{}, err
+			}
+			cost += shareCost
+			if counts != nil {
+				result.Counts = counts
+			} else {
+				missing++
+			}
+			executionTime = max(executionTime, t)
+		} else if part.Phase == PhaseCompleted {
+			logs := r.namedExecutionLogs(ctx, job, part.Execution)
+			if counts, ok := results.ParseCounts(logs); ok {
+				result.Counts = counts
+			} else {
+				missing++
+			}
+			if t, ok := results.ParseExecutionTime(logs); ok && t > executionTime {
+				executionTime = t
+			}
+		}
+		splitResults = append(splitResults, result)
+	}
+
+	// The job costs what its shares on hardware did
+	job.Status.ActualCost = formatCost(cost)
+	counts := results.SplitCounts(splitResults)
+	status.Shots = 0
+	for _, count := range counts {
+		status.Shots += count
+	}
+	job.Status.Results = nil
+	job.Status.Outputs = nil
+	if counts != nil {
+		job.Status.Results = results.NewInfo(job, counts, executionTime)
+	}
+	if !exportAllowed {
+		if job.Status.Results != nil {
+			job.Status.Results.Location = ""
+		}
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			"Job completed; result export blocked by data residency policy")
+	}
+
+	if len(job.Spec.Outputs) > 0 {
+		doc := results.NewDocument(job, counts)
+		doc.Split = splitResults
+		if err := r.exportResults(ctx, job, doc, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	switch {
+	case counts == nil:
+		return r.updateJobPhase(ctx, job, PhaseCompleted, "Job completed; no measurement counts found in executor output")
+	case status.Failed > 0:
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			fmt.Sprintf("Split completed with %d of %d shares; %d failed, %d shots merged",
+				status.Completed, status.Total, status.Failed, status.Shots))
+	case missing > 0:
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			fmt.Sprintf("Split completed; %d of %d shares reported no measurement counts", missing, status.Total))
+	}
+	if message, degraded := degradedOutputsMessage(job); degraded {
+		return r.updateJobPhase(ctx, job, PhaseCompleted, message)
+	}
+	return r.updateJobPhase(ctx, job, PhaseCompleted,
+		fmt.Sprintf("Split across %d backends completed successfully, %d shots merged", status.Total, status.Shots))
+}
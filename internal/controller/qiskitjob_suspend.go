@@ -0,0 +1,87 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/hints"
+)
+
+// ConditionSuspended is True while a suspended or held job is held before
+// its next attempt
+const ConditionSuspended = "Suspended"
+
+// suspendablePhase reports whether a job in the phase has no attempt running
+// and can be held
+func suspendablePhase(phase quantumv1.QiskitJobPhase) bool {
+	switch phase {
+	case PhasePending, PhaseValidating, PhaseScheduling, PhaseScheduled, PhaseRetrying, PhasePendingApproval:
+		return true
+	}
+	return false
+}
+
+// holdSuspended holds a suspended job until spec.suspend is cleared, and a
+// job annotated with quantum.io/hold until the annotation is removed. Jobs
+// running an attempt are held once it finished and the job is due to retry.
+// It reports whether the job is held, in which case reconciliation should
+// stop with the returned result; a held job is reconciled again when it
+// changes.
+func (r *QiskitJobReconciler) holdSuspended(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	reason, held := hints.Hold(job)
+	if (!job.Spec.Suspend && !held) || !suspendablePhase(job.Status.Phase) {
+		if meta.RemoveStatusCondition(&job.Status.Conditions, ConditionSuspended) {
+			log.FromContext(ctx).Info("Job resumed", "phase", job.Status.Phase)
+			return ctrl.Result{Requeue: true}, true, r.Status().Update(ctx, job)
+		}
+		return ctrl.Result{}, false, nil
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionSuspended,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Suspended",
+		Message:            "Job suspended in phase " + string(job.Status.Phase) + "; clear spec.suspend to continue",
+		ObservedGeneration: job.Generation,
+	}
+	if !job.Spec.Suspend {
+		message := "Job held in phase " + string(job.Status.Phase)
+		if reason != "" {
+			message += ": " + reason
+		}
+		condition.Reason = "Held"
+		condition.Message = message + "; remove the " + hints.HoldAnnotation + " annotation to continue"
+	}
+	if current := meta.FindStatusCondition(job.Status.Conditions, ConditionSuspended); current != nil &&
+		current.Status == metav1.ConditionTrue && current.Message == condition.Message {
+		return ctrl.Result{}, true, nil
+	}
+	log.FromContext(ctx).Info("Job suspended", "phase", job.Status.Phase, "reason", condition.Reason)
+	meta.SetStatusCondition(&job.Status.Conditions, condition)
+	job.Status.Message = "Job suspended"
+	if !job.Spec.Suspend {
+		job.Status.Message = "Job held"
+	}
+	return ctrl.Result{}, true, r.Status().Update(ctx, job)
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/callback"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/sweep"
+)
+
+// SweepIndexLabel records which binding of a sweep an execution runs
+const SweepIndexLabel = "quantum.io/sweep-index"
+
+// sweepEpilogue assigns the values of the execution's binding to the
+// parameters of qc by name, before it is sampled
+const sweepEpilogue = `
+
+# Parameter sweep: bind the parameters of qc to this execution's values
+import json as _json
+import os as _os
+import sys as _sys
+if globals().get('qc') is None:
+    _sys.exit('a parameter sweep needs the circuit code to define qc')
+_sweep_values = _json.loads(_os.environ['SWEEP_PARAMETERS'])
+_sweep_unknown = sorted(set(_sweep_values) - {_p.name for _p in qc.parameters})
+if _sweep_unknown:
+    _sys.exit('qc has no parameters named %s' % ', '.join(_sweep_unknown))
+qc = qc.assign_parameters({_p: _sweep_values[_p.name] for _p in qc.parameters if _p.name in _sweep_values})
+`
+
+// sweepExecutionName names the execution running a binding of the job's
+// current attempt
+func sweepExecutionName(job *quantumv1.QiskitJob, index int) string {
+	return fmt.Sprintf("%s-%d", executionName(job), index)
+}
+
+// sweepExecutionJob builds the batch Job running a binding of the job's
+// current attempt, which gets the binding's index and values in SWEEP_INDEX
+// and SWEEP_PARAMETERS
+func (r *QiskitJobReconciler) sweepExecutionJob(ctx context.Context, job *quantumv1.QiskitJob,
+	index int, binding sweep.Binding) (*batchv1.Job, error) {
+	execution, err := r.executionJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	values, err := json.Marshal(binding)
+	if err != nil {
+		return nil, err
+	}
+
+	execution.Name = sweepExecutionName(job, index)
+	execution.Labels[SweepIndexLabel] = strconv.Itoa(index)
+	execution.Spec.Template.Labels[SweepIndexLabel] = strconv.Itoa(index)
+	container := &execution.Spec.Template.Spec.Containers[0]
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: "SWEEP_INDEX", Value: strconv.Itoa(index)},
+		corev1.EnvVar{Name: "SWEEP_PARAMETERS", Value: string(values)})
+	// Each binding posts its output under its own execution's name
+	if err := r.renameCallback(job, execution); err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// renameCallback has the execution post its output under its own name,
+// for jobs that run several executions per attempt
+func (r *QiskitJobReconciler) renameCallback(job *quantumv1.QiskitJob, execution *batchv1.Job) error {
+	container := &execution.Spec.Template.Spec.Containers[0]
+	if r.Callback == nil || !slices.ContainsFunc(container.Env, func(e corev1.EnvVar) bool { return e.Name == callback.URLEnv }) {
+		return nil
+	}
+	callbackEnv, err := r.Callback.Env(job, execution.Name)
+	if err != nil {
+		return err
+	}
+	container.Env = append(slices.DeleteFunc(container.Env, func(e corev1.EnvVar) bool { return callback.IsEnv(e.Name) }),
+		callbackEnv...)
+	return nil
+}
+
+// handleSweepJob runs the bindings of a sweep job's current attempt, at most
+// spec.sweep.parallelism at a time, and completes the job once all of them
+// have completed. After a binding fails no more are started, and the attempt
+// fails once those running have finished.
+func (r *QiskitJobReconciler) handleSweepJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	bindings, err := sweep.Bindings(job.Spec.Sweep)
+	if err != nil {
+		return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Invalid sweep: %v", err))
+	}
+	status := job.Status.Sweep
+	if status == nil || status.Attempt != attempt(job) || status.Total != len(bindings) {
+		status = &quantumv1.SweepStatus{Attempt: attempt(job), Total: len(bindings)}
+		for i := range bindings {
+			status.Bindings = append(status.Bindings, quantumv1.SweepBindingStatus{Index: i, Phase: PhasePending})
+		}
+		job.Status.Sweep = status
+		job.Status.JobID = executionName(job)
+		startAttempt(job)
+	}
+
+	// Follow the executions of the bindings started so far
+	for i := range status.Bindings {
+		binding := &status.Bindings[i]
+		if binding.Phase != PhaseRunning {
+			continue
+		}
+		execution, err := r.namedExecution(ctx, job, binding.Execution)
+		if err != nil {
+			logger.Error(err, "Failed to get execution", "binding", binding.Index)
+			return ctrl.Result{}, err
+		}
+		switch {
+		case execution == nil:
+			// Not in the cache yet, or deleted; starting it again tells
+			binding.Phase = PhasePending
+		case execution.phase == corev1.PodSucceeded:
+			binding.Phase = PhaseCompleted
+		case execution.phase == corev1.PodFailed:
+			binding.Phase = PhaseFailed
+			binding.Message = execution.message
+			if binding.Message == "" {
+				binding.Message = fmt.Sprintf("Execution %s failed", binding.Execution)
+			}
+		}
+	}
+	countBindings(status)
+
+	// Start pending bindings up to the parallelism
+	for i := range status.Bindings {
+		if status.Failed > 0 || status.Running >= sweep.Parallelism(job.Spec.Sweep) {
+			break
+		}
+		binding := &status.Bindings[i]
+		if binding.Phase != PhasePending {
+			continue
+		}
+		batchJob, err := r.sweepExecutionJob(ctx, job, binding.Index, bindings[binding.Index])
+		if err != nil {
+			logger.Error(err, "Failed to create execution job", "binding", binding.Index)
+			return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Failed to create execution: %v", err))
+		}
+		if err := r.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create execution job in cluster", "binding", binding.Index)
+			return ctrl.Result{}, err
+		}
+		binding.Phase = PhaseRunning
+		binding.Execution = batchJob.Name
+		status.Running++
+	}
+
+	switch {
+	case status.Completed == status.Total:
+		return r.completeSweep(ctx, job, bindings)
+	case status.Failed > 0 && status.Running == 0:
+		for _, binding := range status.Bindings {
+			if binding.Phase == PhaseFailed {
+				return r.updateJobPhase(ctx, job, PhaseFailed,
+					fmt.Sprintf("Sweep binding %d failed: %s", binding.Index, binding.Message))
+			}
+		}
+	case status.Failed > 0:
+		job.Status.Message = fmt.Sprintf("Sweep binding failed, waiting for %d running bindings", status.Running)
+	default:
+		job.Status.Message = fmt.Sprintf("Sweep: %d/%d bindings completed, %d running",
+			status.Completed, status.Total, status.Running)
+	}
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	requeueBecause(ctx, RequeueWaitingForPod)
+	return ctrl.Result{RequeueAfter: r.runningRequeue()}, nil
+}
+
+// countBindings tallies the bindings of a sweep by phase
+func countBindings(status *quantumv1.SweepStatus) {
+	status.Completed, status.Running, status.Failed = 0, 0, 0
+	for _, binding := range status.Bindings {
+		switch binding.Phase {
+		case PhaseCompleted:
+			status.Completed++
+		case PhaseRunning:
+			status.Running++
+		case PhaseFailed:
+			status.Failed++
+		}
+	}
+}
+
+// completeSweep reads the counts of every binding of a completed sweep from
+// the logs of its execution and exports them together, with their totals as
+// the job's counts. Sweeps are processed here even where a results
+// processor is deployed.
+func (r *QiskitJobReconciler) completeSweep(ctx context.Context, job *quantumv1.QiskitJob,
+	bindings []sweep.Binding) (ctrl.Result, error) {
+	log.FromContext(ctx).Info("Processing sweep completion", "bindings", len(bindings))
+
+	// Results are only exported to sinks the namespace's residency policy allows
+	exportAllowed, err := r.outputExportAllowed(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	recordCompletion(job)
+
+	var executionTime time.Duration
+	missing := 0
+	sweepResults := make([]results.SweepResult, 0, len(bindings))
+	for _, binding := range job.Status.Sweep.Bindings {
+		result := results.SweepResult{Index: binding.Index, Parameters: bindings[binding.Index]}
+		logs := r.namedExecutionLogs(ctx, job, binding.Execution)
+		if counts, ok := results.ParseCounts(logs); ok {
+			result.Counts = counts
+		} else {
+			missing++
+		}
+		if t, ok := results.ParseExecutionTime(logs); ok {
+			executionTime += t
+		}
+		sweepResults = append(sweepResults, result)
+	}
+
+	counts := results.SweepCounts(sweepResults)
+	job.Status.Results = nil
+	job.Status.Outputs = nil
+	if counts != nil {
+		job.Status.Results = results.NewInfo(job, counts, executionTime)
+	}
+	if !exportAllowed {
+		if job.Status.Results != nil {
+			job.Status.Results.Location = ""
+		}
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			"Job completed; result export blocked by data residency policy")
+	}
+
+	if len(job.Spec.Outputs) > 0 {
+		doc := results.NewDocument(job, counts)
+		doc.Sweep = sweepResults
+		if err := r.exportResults(ctx, job, doc, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	switch {
+	case counts == nil:
+		return r.updateJobPhase(ctx, job, PhaseCompleted, "Job completed; no measurement counts found in executor output")
+	case missing > 0:
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			fmt.Sprintf("Sweep completed; %d of %d bindings reported no measurement counts", missing, len(bindings)))
+	}
+	if message, degraded := degradedOutputsMessage(job); degraded {
+		return r.updateJobPhase(ctx, job, PhaseCompleted, message)
+	}
+	return r.updateJobPhase(ctx, job, PhaseCompleted, fmt.Sprintf("Sweep of %d bindings completed successfully", len(bindings)))
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// SkipFinalizerAnnotation set to "true" on a job keeps the operator's
+// finalizer off it, and removes the finalizer without cleanup if the job is
+// already being deleted. The orphan sweeper cleans up after such jobs.
+const SkipFinalizerAnnotation = "quantum.io/skip-finalizer"
+
+// DefaultOrphanSweepInterval is how often orphaned job resources are swept
+// unless configured otherwise
+const DefaultOrphanSweepInterval = 10 * time.Minute
+
+// finalizerSkipped reports whether the job is deleted without the operator's
+// cleanup, because the operator or the job opted out of the finalizer
+func (r *QiskitJobReconciler) finalizerSkipped(job *quantumv1.QiskitJob) bool {
+	return r.SkipFinalizers || job.Annotations[SkipFinalizerAnnotation] == "true"
+}
+
+// OrphanSweeper periodically deletes the execution Jobs and pods, the
+// results ConfigMaps and the sandbox namespaces of QiskitJobs that no longer
+// exist. Garbage collection removes them through their owner references,
+// but not when they were created after the job was deleted, which jobs
+// deleted without the finalizer allow. Sandbox namespaces have no owner at
+// all.
+type OrphanSweeper struct {
+	client.Client
+
+	// Interval is how often to sweep
+	Interval time.Duration
+
+	// Jobs, when it keeps terminal jobs out of the cache, looks up the jobs
+	// the cache does not hold before their resources are swept
+	Jobs *JobLister
+}
+
+var _ manager.LeaderElectionRunnable = &OrphanSweeper{}
+
+// NeedLeaderElection makes sweeping run only on the elected leader
+func (s *OrphanSweeper) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable
+func (s *OrphanSweeper) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("orphan-sweeper")
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		swept, err := s.Sweep(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to sweep orphaned job resources")
+		} else if swept > 0 {
+			logger.Info("Swept orphaned job resources", "deleted", swept)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep deletes the resources of jobs that no longer exist once and returns
+// how many it deleted
+func (s *OrphanSweeper) Sweep(ctx context.Context) (int, error) {
+	var executions batchv1.JobList
+	if err := s.List(ctx, &executions, client.HasLabels{"quantum.io/job"}); err != nil {
+		return 0, err
+	}
+	var pods corev1.PodList
+	if err := s.List(ctx, &pods, client.HasLabels{"quantum.io/job"}); err != nil {
+		return 0, err
+	}
+	var configMaps corev1.ConfigMapList
+	if err := s.List(ctx, &configMaps, client.HasLabels{"quantum.io/job"}); err != nil {
+		return 0, err
+	}
+
+	objects := make([]client.Object, 0, len(executions.Items)+len(pods.Items)+len(configMaps.Items))
+	for i := range executions.Items {
+		objects = append(objects, &executions.Items[i])
+	}
+	for i := range pods.Items {
+		objects = append(objects, &pods.Items[i])
+	}
+	for i := range configMaps.Items {
+		objects = append(objects, &configMaps.Items[i])
+	}
+
+	swept := 0
+	for _, obj := range objects {
+		orphaned, err := s.orphaned(ctx, obj)
+		if err != nil {
+			return swept, err
+		}
+		if !orphaned {
+			continue
+		}
+		// Jobs would otherwise orphan their pods
+		err = s.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !errors.IsNotFound(err) {
+			return swept, err
+		}
+		swept++
+	}
+
+	var sandboxes corev1.NamespaceList
+	if err := s.List(ctx, &sandboxes, client.HasLabels{SandboxLabel}); err != nil {
+		return swept, err
+	}
+	for i := range sandboxes.Items {
+		orphaned, err := s.orphanedSandbox(ctx, &sandboxes.Items[i])
+		if err != nil {
+			return swept, err
+		}
+		if !orphaned {
+			continue
+		}
+		if err := s.Delete(ctx, &sandboxes.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return swept, err
+		}
+		swept++
+	}
+	return swept, nil
+}
+
+// orphaned reports whether the object is controlled by a QiskitJob that no
+// longer exists. A job recreated under the same name does not adopt it.
+// Objects without a QiskitJob controller are left alone.
+func (s *OrphanSweeper) orphaned(ctx context.Context, obj client.Object) (bool, error) {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != "QiskitJob" || owner.APIVersion != quantumv1.GroupVersion.String() {
+		return false, nil
+	}
+	uid, err := jobUID(ctx, s.Client, s.Jobs, types.NamespacedName{Name: owner.Name, Namespace: obj.GetNamespace()})
+	switch {
+	case errors.IsNotFound(err):
+		return true, nil
+	case err != nil:
+		return false, err
+	}
+	return uid != owner.UID, nil
+}
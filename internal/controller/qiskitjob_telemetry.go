@@ -0,0 +1,87 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/telemetry"
+)
+
+// Failure classes of jobs that failed for a reason other than those their
+// conditions record
+const (
+	failureClassCircuitInvalid = "CircuitInvalid"
+	failureClassOther          = "Other"
+)
+
+// TelemetryUsage counts the jobs of the cluster by backend type and phase,
+// and the failed ones by failure class, for usage reports. Only the
+// backend's type is counted, never its name or anything else of the job.
+func TelemetryUsage(ctx context.Context, c client.Reader, lister *JobLister) (*telemetry.Usage, error) {
+	usage := &telemetry.Usage{
+		Jobs:     map[string]map[string]int{},
+		Failures: map[string]int{},
+	}
+	namespaces := map[string]bool{}
+	err := eachJob(ctx, c, lister, func(job *quantumv1.QiskitJob) error {
+		namespaces[job.Namespace] = true
+		backendType := job.Spec.Backend.Type
+		if backendType == "" {
+			backendType = "unknown"
+		}
+		phase := job.Status.Phase
+		if phase == "" {
+			phase = PhasePending
+		}
+		if usage.Jobs[backendType] == nil {
+			usage.Jobs[backendType] = map[string]int{}
+		}
+		usage.Jobs[backendType][string(phase)]++
+		if phase == PhaseFailed {
+			usage.Failures[failureClass(job)]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	usage.Namespaces = len(namespaces)
+	return usage, nil
+}
+
+// failureClass classifies why a failed job failed by its conditions, as
+// their reasons come from a fixed set unlike its message
+func failureClass(job *quantumv1.QiskitJob) string {
+	conditions := job.Status.Conditions
+	if condition := meta.FindStatusCondition(conditions, ConditionProviderRejected); condition != nil &&
+		condition.Status == metav1.ConditionTrue {
+		return condition.Reason
+	}
+	if meta.IsStatusConditionTrue(conditions, ConditionExecutorHung) {
+		return ConditionExecutorHung
+	}
+	if meta.IsStatusConditionFalse(conditions, ConditionCircuitValidated) {
+		return failureClassCircuitInvalid
+	}
+	return failureClassOther
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/jobtemplate"
+)
+
+// instantiateTemplate applies the job's template when the defaulting webhook
+// did not. It reports whether the job was changed or failed, in which case
+// reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) instantiateTemplate(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	if job.Status.Phase != "" && job.Status.Phase != PhasePending {
+		return ctrl.Result{}, false, nil
+	}
+
+	applied, err := jobtemplate.Resolve(ctx, r.Client, job)
+	var overrideErr *jobtemplate.OverrideError
+	switch {
+	case apierrors.IsNotFound(err):
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed,
+			fmt.Sprintf("Job template %q not found", job.Spec.TemplateRef.Name))
+		return result, true, err
+	case errors.As(err, &overrideErr):
+		result, err := r.updateJobPhase(ctx, job, PhaseFailed, overrideErr.Error())
+		return result, true, err
+	case err != nil:
+		return ctrl.Result{}, true, err
+	case !applied:
+		return ctrl.Result{}, false, nil
+	}
+
+	log.FromContext(ctx).Info("Instantiated job template", "template", job.Annotations[jobtemplate.AppliedAnnotation])
+	if err := r.Update(ctx, job); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{Requeue: true}, true, nil
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/tomography"
+)
+
+// TomographyBasisLabel records which measurement setting of a tomography an
+// execution runs
+const TomographyBasisLabel = "quantum.io/tomography-basis"
+
+// tomographyEpilogue replaces the final measurements of qc by measurements
+// of the tomographed qubits in the execution's bases, into a register of
+// its own, before it is sampled
+const tomographyEpilogue = `
+
+# State tomography: measure the tomographed qubits of qc in this execution's bases
+import json as _json
+import os as _os
+import sys as _sys
+from qiskit import ClassicalRegister as _ClassicalRegister
+if globals().get('qc') is None:
+    _sys.exit('state tomography needs the circuit code to define qc')
+_tomo_qubits = _json.loads(_os.environ['TOMOGRAPHY_QUBITS'])
+_tomo_basis = _os.environ['TOMOGRAPHY_BASIS']
+if max(_tomo_qubits) >= qc.num_qubits:
+    _sys.exit('qc has %d qubits, cannot tomograph qubit %d' % (qc.num_qubits, max(_tomo_qubits)))
+qc = qc.remove_final_measurements(inplace=False)
+if qc.num_clbits:
+    _sys.exit('state tomography needs qc to measure only at its end')
+qc.add_register(_ClassicalRegister(len(_tomo_qubits), 'tomography'))
+for _i, _q in enumerate(_tomo_qubits):
+    _b = _tomo_basis[len(_tomo_qubits) - 1 - _i]
+    if _b == 'X':
+        qc.h(_q)
+    elif _b == 'Y':
+        qc.sdg(_q)
+        qc.h(_q)
+    qc.measure(_q, _i)
+`
+
+// tomographyExecutionName names the execution running a measurement setting
+// of the job's current attempt
+func tomographyExecutionName(job *quantumv1.QiskitJob, basis string) string {
+	return fmt.Sprintf("%s-%s", executionName(job), strings.ToLower(basis))
+}
+
+// tomographyExecutionJob builds the batch Job running a measurement setting
+// of the job's current attempt, which gets its bases and the tomographed
+// qubits in TOMOGRAPHY_BASIS and TOMOGRAPHY_QUBITS
+func (r *QiskitJobReconciler) tomographyExecutionJob(ctx context.Context, job *quantumv1.QiskitJob,
+	basis string) (*batchv1.Job, error) {
+	execution, err := r.executionJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	qubits, err := json.Marshal(job.Spec.Tomography.Qubits)
+	if err != nil {
+		return nil, err
+	}
+
+	execution.Name = tomographyExecutionName(job, basis)
+	execution.Labels[TomographyBasisLabel] = basis
+	execution.Spec.Template.Labels[TomographyBasisLabel] = basis
+	container := &execution.Spec.Template.Spec.Containers[0]
+	container.Env = append(container.Env,
+		corev1.EnvVar{Name: "TOMOGRAPHY_BASIS", Value: basis},
+		corev1.EnvVar{Name: "TOMOGRAPHY_QUBITS", Value: string(qubits)})
+	if err := r.renameCallback(job, execution); err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// handleTomographyJob runs the measurement settings of a tomography job's
+// current attempt, at most spec.tomography.parallelism at a time, and
+// completes the job once all of them have completed. After a setting fails
+// no more are started, and the attempt fails once those running have
+// finished.
+func (r *QiskitJobReconciler) handleTomographyJob(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	settings := tomography.Settings(len(job.Spec.Tomography.Qubits))
+	status := job.Status.Tomography
+	if status == nil || status.Attempt != attempt(job) || status.Total != len(settings) {
+		status = &quantumv1.TomographyStatus{Attempt: attempt(job), Total: len(settings)}
+		for _, basis := range settings {
+			status.Settings = append(status.Settings, quantumv1.TomographySettingStatus{Basis: basis, Phase: PhasePending})
+		}
+		job.Status.Tomography = status
+		job.Status.JobID = executionName(job)
+		startAttempt(job)
+	}
+
+	// Follow the executions of the settings started so far
+	for i := range status.Settings {
+		setting := &status.Settings[i]
+		if setting.Phase != PhaseRunning {
+			continue
+		}
+		execution, err := r.namedExecution(ctx, job, setting.Execution)
+		if err != nil {
+			logger.Error(err, "Failed to get execution", "basis", setting.Basis)
+			return ctrl.Result{}, err
+		}
+		switch {
+		case execution == nil:
+			// Not in the cache yet, or deleted; starting it again tells
+			setting.Phase = PhasePending
+		case execution.phase == corev1.PodSucceeded:
+			setting.Phase = PhaseCompleted
+		case execution.phase == corev1.PodFailed:
+			setting.Phase = PhaseFailed
+			setting.Message = execution.message
+			if setting.Message == "" {
+				setting.Message = fmt.Sprintf("Execution %s failed", setting.Execution)
+			}
+		}
+	}
+	countSettings(status)
+
+	// Start pending settings up to the parallelism
+	for i := range status.Settings {
+		if status.Failed > 0 || status.Running >= tomography.Parallelism(job.Spec.Tomography) {
+			break
+		}
+		setting := &status.Settings[i]
+		if setting.Phase != PhasePending {
+			continue
+		}
+		batchJob, err := r.tomographyExecutionJob(ctx, job, setting.Basis)
+		if err != nil {
+			logger.Error(err, "Failed to create execution job", "basis", setting.Basis)
+			return r.updateJobPhase(ctx, job, PhaseFailed, fmt.Sprintf("Failed to create execution: %v", err))
+		}
+		if err := r.Create(ctx, batchJob); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create execution job in cluster", "basis", setting.Basis)
+			return ctrl.Result{}, err
+		}
+		setting.Phase = PhaseRunning
+		setting.Execution = batchJob.Name
+		status.Running++
+	}
+
+	switch {
+	case status.Completed == status.Total:
+		return r.completeTomography(ctx, job)
+	case status.Failed > 0 && status.Running == 0:
+		for _, setting := range status.Settings {
+			if setting.Phase == PhaseFailed {
+				return r.updateJobPhase(ctx, job, PhaseFailed,
+					fmt.Sprintf("Tomography setting %s failed: %s", setting.Basis, setting.Message))
+			}
+		}
+	case status.Failed > 0:
+		job.Status.Message = fmt.Sprintf("Tomography setting failed, waiting for %d running settings", status.Running)
+	default:
+		job.Status.Message = fmt.Sprintf("Tomography: %d/%d settings completed, %d running",
+			status.Completed, status.Total, status.Running)
+	}
+	if err := r.Status().Update(ctx, job); err != nil {
+		return ctrl.Result{}, err
+	}
+	requeueBecause(ctx, RequeueWaitingForPod)
+	return ctrl.Result{RequeueAfter: r.runningRequeue()}, nil
+}
+
+// countSettings tallies the settings of a tomography by phase
+func countSettings(status *quantumv1.TomographyStatus) {
+	status.Completed, status.Running, status.Failed = 0, 0, 0
+	for _, setting := range status.Settings {
+		switch setting.Phase {
+		case PhaseCompleted:
+			status.Completed++
+		case PhaseRunning:
+			status.Running++
+		case PhaseFailed:
+			status.Failed++
+		}
+	}
+}
+
+// completeTomography reads the counts of every setting of a completed
+// tomography from the logs of its execution, reconstructs the state of the
+// tomographed qubits from them and exports both, with the counts of the
+// setting measuring every qubit in the Z basis as the job's counts.
+// Tomography is processed here even where a results processor is deployed.
+func (r *QiskitJobReconciler) completeTomography(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	status := job.Status.Tomography
+	log.FromContext(ctx).Info("Processing tomography completion", "settings", len(status.Settings))
+
+	// Results are only exported to sinks the namespace's residency policy allows
+	exportAllowed, err := r.outputExportAllowed(ctx, job)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	recordCompletion(job)
+
+	var executionTime time.Duration
+	var counts map[string]int
+	computational := strings.Repeat("Z", len(job.Spec.Tomography.Qubits))
+	result := &results.TomographyResult{Qubits: job.Spec.Tomography.Qubits}
+	for _, setting := range status.Settings {
+		measured := results.TomographySetting{Basis: setting.Basis}
+		logs := r.namedExecutionLogs(ctx, job, setting.Execution)
+		if c, ok := results.ParseCounts(logs); ok {
+			measured.Counts = c
+		}
+		if t, ok := results.ParseExecutionTime(logs); ok {
+			executionTime += t
+		}
+		if setting.Basis == computational {
+			counts = measured.Counts
+		}
+		result.Settings = append(result.Settings, measured)
+	}
+	reconstructErr := result.Reconstruct()
+	status.Purity = ""
+	if result.State != nil {
+		status.Purity = fmt.Sprintf("%.4f", result.State.Purity)
+	}
+
+	job.Status.Results = nil
+	job.Status.Outputs = nil
+	if counts != nil {
+		job.Status.Results = results.NewInfo(job, counts, executionTime)
+	}
+	if !exportAllowed {
+		if job.Status.Results != nil {
+			job.Status.Results.Location = ""
+		}
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			"Job completed; result export blocked by data residency policy")
+	}
+
+	if len(job.Spec.Outputs) > 0 {
+		doc := results.NewDocument(job, counts)
+		doc.Tomography = result
+		if err := r.exportResults(ctx, job, doc, nil); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if reconstructErr != nil {
+		return r.updateJobPhase(ctx, job, PhaseCompleted,
+			fmt.Sprintf("Tomography completed; state not reconstructed: %v", reconstructErr))
+	}
+	if message, degraded := degradedOutputsMessage(job); degraded {
+		return r.updateJobPhase(ctx, job, PhaseCompleted, message)
+	}
+	return r.updateJobPhase(ctx, job, PhaseCompleted,
+		fmt.Sprintf("Tomography of %d qubits completed successfully, purity %s", len(result.Qubits), status.Purity))
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/tracking"
+)
+
+// trackingRetryInterval is how long to wait before logging a job again when
+// the experiment tracker could not be reached
+const trackingRetryInterval = 5 * time.Minute
+
+// logToTracker logs a finished job to the experiment tracker once, recording
+// the link to its run. Tracker outages never affect the job itself.
+func (r *QiskitJobReconciler) logToTracker(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, error) {
+	if r.Tracker == nil || job.Status.TrackingURL != "" {
+		return ctrl.Result{}, nil
+	}
+
+	link, err := r.Tracker.LogRun(ctx, tracking.RunFromJob(job))
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to log job to the experiment tracker")
+		requeueBecause(ctx, RequeueBackoff)
+		return ctrl.Result{RequeueAfter: trackingRetryInterval}, nil
+	}
+	job.Status.TrackingURL = link
+	return ctrl.Result{}, r.Status().Update(ctx, job)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// transpiledEpilogue reports the transpiled circuit _isa of a backend
+// epilogue, and the sizes of it and the logical circuit qc, on a single JSON
+// log line after the counts.
+const transpiledEpilogue = `
+
+# Publish the transpiled circuit for review
+def _circuit_size(_circuit):
+    return {'depth': _circuit.depth(), 'gates': _circuit.size(), 'gate_types': dict(_circuit.count_ops())}
+def _publish_transpiled(_logical, _transpiled):
+    import base64 as _base64
+    import io as _io
+    from qiskit import qpy as _qpy
+    _qpy_buffer = _io.BytesIO()
+    _qpy.dump(_transpiled, _qpy_buffer)
+    _report = {'logical': _circuit_size(_logical), 'transpiled': _circuit_size(_transpiled), 'qpy': _base64.b64encode(_qpy_buffer.getvalue()).decode()}
+    try:
+        _svg_buffer = _io.BytesIO()
+        _transpiled.draw('mpl', idle_wires=False).savefig(_svg_buffer, format='svg')
+        _report['svg'] = _svg_buffer.getvalue().decode()
+    except Exception as _error:
+        print('Transpiled circuit diagram not drawn:', _error)
+    print(_json.dumps({'transpiled_circuit': _report}), flush=True)
+_publish_transpiled(qc, _isa)
+`
+
+// transpiledRequirements are the packages the transpiled circuit's diagram
+// is drawn with
+const transpiledRequirements = " matplotlib pylatexenc"
+
+// publishTranspiled publishes the transpiled circuit the execution pod logged
+// and records its sizes in the job's circuit metadata. A circuit that cannot
+// be published never fails the job.
+func (r *QiskitJobReconciler) publishTranspiled(ctx context.Context, job *quantumv1.QiskitJob, logs string) {
+	if !results.PublishesTranspiled(job) {
+		return
+	}
+	logger := log.FromContext(ctx)
+
+	transpiled, ok := results.ParseTranspiled(logs)
+	if !ok {
+		logger.Info("No transpiled circuit found in execution logs")
+		return
+	}
+	if err := results.PublishTranspiled(ctx, r.Client, r.Scheme, job, transpiled); err != nil {
+		logger.Error(err, "Failed to publish transpiled circuit")
+	} else {
+		logger.Info(fmt.Sprintf("Transpiled circuit published in ConfigMap %s", transpiled.ConfigMap))
+	}
+	results.RecordTranspiled(job, transpiled)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/validationservice"
+)
+
+// transpilerOptions defines _pass_manager_for, with which backend epilogues
+// build the pass manager mapping a circuit onto their backend: Qiskit's
+// preset pass manager for OPTIMIZATION_LEVEL, tuned by the job's transpiler
+// options in TRANSPILER_OPTIONS. The seed an epilogue passes applies unless
+// the job sets its own. Without the backend's coupling map, the circuit is
+// only decomposed into the backend's gates.
+const transpilerOptions = `
+
+# Transpile with the optimization level and transpiler options of the job
+def _pass_manager_for(_backend, _seed=None):
+    import json as _tr_json
+    import os as _tr_os
+    from qiskit.transpiler.preset_passmanagers import generate_preset_pass_manager as _tr_pass_manager
+    _options = _tr_json.loads(_tr_os.environ.get('TRANSPILER_OPTIONS') or '{}')
+    _kwargs = {'optimization_level': int(_tr_os.environ.get('OPTIMIZATION_LEVEL', '1'))}
+    if _seed is not None:
+        _kwargs['seed_transpiler'] = _seed
+    for _option in ('layout_method', 'routing_method', 'seed_transpiler', 'basis_gates'):
+        if _option in _options:
+            _kwargs[_option] = _options[_option]
+    if _options.get('coupling_map') == 'none':
+        _kwargs.setdefault('basis_gates', list(_backend.operation_names))
+        return _tr_pass_manager(**_kwargs)
+    return _tr_pass_manager(backend=_backend, **_kwargs)
+`
+
+// transpilerOptionsOf returns the job's transpiler options as the executor
+// and the validation service read them, or nil if it sets none
+func transpilerOptionsOf(job *quantumv1.QiskitJob) *validationservice.TranspilerOptions {
+	spec := job.Spec.Execution.Transpiler
+	if spec == nil {
+		return nil
+	}
+	return &validationservice.TranspilerOptions{
+		LayoutMethod:  spec.LayoutMethod,
+		RoutingMethod: spec.RoutingMethod,
+		Seed:          spec.Seed,
+		BasisGates:    spec.BasisGates,
+		CouplingMap:   spec.CouplingMap,
+	}
+}
+
+// transpilerEnv passes the job's transpiler options to the executor, if it
+// sets any
+func transpilerEnv(job *quantumv1.QiskitJob) []corev1.EnvVar {
+	options := transpilerOptionsOf(job)
+	if options == nil {
+		return nil
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		return nil
+	}
+	return []corev1.EnvVar{{Name: "TRANSPILER_OPTIONS", Value: string(data)}}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/region"
+)
+
+// Annotations of a credentials Secret that make it a trial account, which
+// only runs jobs on simulators, optionally with fewer shots and jobs a day
+const (
+	TrialAnnotation          = "quantum.io/trial"
+	TrialMaxShotsAnnotation  = "quantum.io/trial-max-shots"
+	TrialDailyJobsAnnotation = "quantum.io/trial-daily-jobs"
+)
+
+// trialAccount is the credentials Secret of a trial account and its limits;
+// limits of zero are unset
+type trialAccount struct {
+	secret    types.NamespacedName
+	maxShots  int
+	dailyJobs int
+}
+
+// credentialsSecret returns the secret the job authenticates with in its
+// region, defaulting to the job's namespace
+func credentialsSecret(job *quantumv1.QiskitJob) (types.NamespacedName, bool) {
+	ref := region.Credentials(job.Spec.Credentials, job.Status.Region)
+	if ref == nil || ref.Name == "" {
+		return types.NamespacedName{}, false
+	}
+	return secretKey(job, *ref)
+}
+
+// trialAccount returns the trial account the job runs under, or nil if its
+// credentials are not one, and what is wrong with the account's limits.
+// Without Secret access no job is.
+func (r *QiskitJobReconciler) trialAccount(ctx context.Context, job *quantumv1.QiskitJob) (*trialAccount, string, error) {
+	key, ok := credentialsSecret(job)
+	if !ok || r.WithoutSecrets {
+		return nil, "", nil
+	}
+	if _, err := backendref.SecretNamespace(ctx, r.Client, job,
+		quantumv1.SecretRef{Name: key.Name, Namespace: key.Namespace}); err != nil {
+		return nil, "", err
+	}
+	var secret corev1.Secret
+	if err := r.Get(ctx, key, &secret); err != nil {
+		return nil, "", client.IgnoreNotFound(err)
+	}
+	if secret.Annotations[TrialAnnotation] != "true" {
+		return nil, "", nil
+	}
+
+	account := &trialAccount{secret: key}
+	for annotation, limit := range map[string]*int{
+		TrialMaxShotsAnnotation:  &account.maxShots,
+		TrialDailyJobsAnnotation: &account.dailyJobs,
+	} {
+		value, ok := secret.Annotations[annotation]
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return nil, fmt.Sprintf("Annotation %s of trial credentials %s must be a positive integer, not %q",
+				annotation, key, value), nil
+		}
+		*limit = n
+	}
+	return account, "", nil
+}
+
+// simulated reports whether the job runs on a simulator rather than hardware
+func simulated(job *quantumv1.QiskitJob) bool {
+	switch backend.BackendType(backendType(job)) {
+	case backend.LocalSimulator, backend.IBMLocalTesting, backend.IBMSimulator:
+		return true
+	}
+	return false
+}
+
+// trialRefused reports whether the trial guardrails failed the job
+func trialRefused(job *quantumv1.QiskitJob) bool {
+	condition := meta.FindStatusCondition(job.Status.Conditions, ConditionQuotaExceeded)
+	if condition == nil {
+		return false
+	}
+	switch condition.Reason {
+	case quotaReasonTrialSimulator, quotaReasonTrialShots, quotaReasonTrialDailyJobs, quotaReasonTrialInvalid:
+		return true
+	}
+	return false
+}
+
+// overTrialLimits explains which limit of its trial account the job
+// exceeds, if its credentials are one. Trial accounts run jobs on simulators
+// only, with at most the account's shots and, counting the jobs created with
+// the same Secret since midnight UTC, jobs a day.
+func (r *QiskitJobReconciler) overTrialLimits(ctx context.Context, job *quantumv1.QiskitJob, now time.Time) (string, string, error) {
+	account, invalid, err := r.trialAccount(ctx, job)
+	switch {
+	case err != nil:
+		return "", "", err
+	case invalid != "":
+		return quotaReasonTrialInvalid, invalid, nil
+	case account == nil:
+		return "", "", nil
+	}
+	if !simulated(job) {
+		return quotaReasonTrialSimulator, fmt.Sprintf("Trial credentials %s only run jobs on simulators, not %s",
+			account.secret, backendType(job)), nil
+	}
+	if shots := effectiveShots(job); account.maxShots > 0 && shots > account.maxShots {
+		return quotaReasonTrialShots, fmt.Sprintf("%d shots exceed the maximum of %d of trial credentials %s",
+			shots, account.maxShots, account.secret), nil
+	}
+	if account.dailyJobs == 0 {
+		return "", "", nil
+	}
+
+	year, month, day := now.UTC().Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	jobs := 0
+	err = eachJob(ctx, r.Client, r.Jobs, func(other *quantumv1.QiskitJob) error {
+		if other.UID == job.UID || other.CreationTimestamp.Time.Before(midnight) || trialRefused(other) {
+			return nil
+		}
+		if key, ok := credentialsSecret(other); ok && key == account.secret {
+			jobs++
+		}
+		return nil
+	})
+	if err != nil || jobs < account.dailyJobs {
+		return "", "", err
+	}
+	return quotaReasonTrialDailyJobs, fmt.Sprintf("Trial credentials %s already have their %d jobs for today; submit again after midnight UTC",
+		account.secret, account.dailyJobs), nil
+}
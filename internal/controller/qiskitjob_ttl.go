@@ -0,0 +1,184 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// DefaultTTLSweepInterval is how often finished jobs are checked for an
+// expired TTL unless configured otherwise
+const DefaultTTLSweepInterval = time.Minute
+
+// finishedAt returns when a job that finished for good finished, or nil if
+// it has not
+func finishedAt(job *quantumv1.QiskitJob) *metav1.Time {
+	if _, ok := finishedForGood(job); !ok {
+		return nil
+	}
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime
+	}
+	for _, conditionType := range []string{ConditionCompleted, ConditionFailed} {
+		if condition := meta.FindStatusCondition(job.Status.Conditions, conditionType); condition != nil &&
+			condition.Status == metav1.ConditionTrue {
+			return &condition.LastTransitionTime
+		}
+	}
+	return nil
+}
+
+// TTLSweeper deletes QiskitJobs that completed or failed for good once
+// their spec.ttlSecondsAfterFinished, or the operator's default, ran out.
+// Garbage collection then removes their pods and results ConfigMaps. With
+// an archive, each job and its results are archived before it is deleted,
+// and a job that could not be archived is kept until the next sweep.
+type TTLSweeper struct {
+	client.Client
+
+	// Interval is how often to sweep
+	Interval time.Duration
+
+	// DefaultTTL applies to jobs without spec.ttlSecondsAfterFinished that
+	// no workflow or schedule controls; those keep the history they manage.
+	// Zero keeps such jobs.
+	DefaultTTL time.Duration
+
+	// Archive, if set, receives every job before it is deleted
+	Archive results.Archive
+
+	// Jobs, when it keeps terminal jobs out of the cache, lists the jobs
+	// the cache does not hold
+	Jobs *JobLister
+}
+
+var _ manager.LeaderElectionRunnable = &TTLSweeper{}
+
+// NeedLeaderElection makes sweeping run only on the elected leader
+func (s *TTLSweeper) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable
+func (s *TTLSweeper) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("ttl-sweeper")
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		deleted, err := s.Sweep(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to delete finished jobs")
+		} else if deleted > 0 {
+			logger.Info("Deleted finished jobs", "deleted", deleted)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ttl returns how long the job is kept after it finished, and false if it
+// is kept for good
+func (s *TTLSweeper) ttl(job *quantumv1.QiskitJob) (time.Duration, bool) {
+	if seconds := job.Spec.TTLSecondsAfterFinished; seconds != nil {
+		return time.Duration(*seconds) * time.Second, true
+	}
+	if s.DefaultTTL <= 0 || metav1.GetControllerOf(job) != nil {
+		return 0, false
+	}
+	return s.DefaultTTL, true
+}
+
+// chargesPending reports whether the job's actual cost is still to be
+// charged to its namespace's QuantumQuotas or the QuantumCostReport. The
+// report only charges jobs of its current and previous billing periods, so
+// older jobs are not waited for.
+func chargesPending(job *quantumv1.QiskitJob, now time.Time) bool {
+	completion := job.Status.CompletionTime
+	if completion == nil {
+		return false
+	}
+	if uncharged(job) > 0 {
+		return true
+	}
+	if _, err := parseCost(job.Status.ActualCost); err != nil || job.Status.ActualCost == job.Status.ReportedCost {
+		return false
+	}
+	year, month, _ := now.Date()
+	previous := time.Date(year, month-1, 1, 0, 0, 0, 0, now.Location()).Format("2006-01")
+	return completion.Format("2006-01") >= previous
+}
+
+// Sweep deletes the finished jobs whose TTL ran out once and returns how
+// many it deleted. Jobs whose spend is not charged yet are kept until a
+// later sweep, so that deleting them does not lose it.
+func (s *TTLSweeper) Sweep(ctx context.Context) (int, error) {
+	var expired []*quantumv1.QiskitJob
+	now := time.Now()
+	err := eachJob(ctx, s.Client, s.Jobs, func(job *quantumv1.QiskitJob) error {
+		finished := finishedAt(job)
+		if finished == nil || !job.DeletionTimestamp.IsZero() || chargesPending(job, now) {
+			return nil
+		}
+		if ttl, ok := s.ttl(job); ok && !now.Before(finished.Add(ttl)) {
+			expired = append(expired, job.DeepCopy())
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	logger := logf.FromContext(ctx)
+	deleted := 0
+	for _, job := range expired {
+		if s.Archive != nil {
+			location, err := results.ArchiveJob(ctx, s.Client, s.Archive, job)
+			if err != nil {
+				return deleted, fmt.Errorf("archiving %s/%s: %w", job.Namespace, job.Name, err)
+			}
+			logger.V(1).Info("Archived finished job", "job", client.ObjectKeyFromObject(job), "location", location)
+		}
+		// A job changed since it was listed, e.g. to ask for its debug pod,
+		// is looked at again on the next sweep
+		err := s.Delete(ctx, job,
+			client.Preconditions{UID: &job.UID, ResourceVersion: &job.ResourceVersion},
+			client.PropagationPolicy(metav1.DeletePropagationBackground))
+		switch {
+		case errors.IsNotFound(err) || errors.IsConflict(err):
+			continue
+		case err != nil:
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
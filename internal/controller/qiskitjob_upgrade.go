@@ -0,0 +1,182 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// PhaseMachineVersion is the version of the phase machine implemented by this
+// operator. Bump it whenever phases are added, renamed or change meaning, and
+// teach normalizePhase to read what the previous version wrote.
+const PhaseMachineVersion = 3
+
+// legacyPhases maps phase values written by older operators, or by hand, to
+// current phases. Keys are lower case.
+var legacyPhases = map[string]quantumv1.QiskitJobPhase{
+	"pending":    PhasePending,
+	"validating": PhaseValidating,
+	"scheduling": PhaseScheduling,
+	"queued":     PhaseScheduling,
+	"scheduled":  PhaseScheduled,
+	"running":    PhaseRunning,
+	"submitted":  PhaseRunning,
+	"completed":  PhaseCompleted,
+	"succeeded":  PhaseCompleted,
+	"failed":     PhaseFailed,
+	"error":      PhaseFailed,
+	"cancelled":  PhaseCancelled,
+	"canceled":   PhaseCancelled,
+	"retrying":   PhaseRetrying,
+
+	"pendingapproval": PhasePendingApproval,
+}
+
+// normalizePhase maps a stored phase onto the current phase machine
+func normalizePhase(phase quantumv1.QiskitJobPhase) (quantumv1.QiskitJobPhase, bool) {
+	p, ok := legacyPhases[strings.ToLower(strings.TrimSpace(string(phase)))]
+	return p, ok
+}
+
+// upgradeStatus rewrites a status written by an older operator in current
+// terms and stamps the current phase machine version. It reports whether the
+// status changed. Phases it cannot interpret are left for resumePhase.
+func upgradeStatus(job *quantumv1.QiskitJob) bool {
+	if job.Status.Phase == "" || job.Status.PhaseMachineVersion == PhaseMachineVersion {
+		return false
+	}
+	changed := false
+	if phase, ok := normalizePhase(job.Status.Phase); ok && phase != job.Status.Phase {
+		job.Status.Phase = phase
+		changed = true
+	}
+	if job.Status.PhaseMachineVersion < PhaseMachineVersion {
+		job.Status.PhaseMachineVersion = PhaseMachineVersion
+		changed = true
+	}
+	return changed
+}
+
+// resumePhase picks the phase to continue from when the stored phase is not
+// understood. A job that already started execution is resumed in Running,
+// where its pod or remote job is checked, instead of being executed again.
+func resumePhase(job *quantumv1.QiskitJob) quantumv1.QiskitJobPhase {
+	if job.Status.JobID != "" {
+		return PhaseRunning
+	}
+	return PhasePending
+}
+
+// InFlightRecovery runs once when the operator becomes leader. It brings the
+// status of every job in flight up to the current phase machine and checks
+// Running jobs against their execution pods, so an upgrade resumes work that
+// was in progress rather than restarting it.
+type InFlightRecovery struct {
+	client.Client
+}
+
+var _ manager.LeaderElectionRunnable = &InFlightRecovery{}
+
+// NeedLeaderElection makes recovery run only on the elected leader
+func (r *InFlightRecovery) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable
+func (r *InFlightRecovery) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("inflight-recovery")
+
+	var jobs quantumv1.QiskitJobList
+	if err := r.List(ctx, &jobs); err != nil {
+		return fmt.Errorf("listing QiskitJobs for in-flight recovery: %w", err)
+	}
+
+	resumed := 0
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.Status.Phase == "" || job.DeletionTimestamp != nil {
+			continue
+		}
+
+		changed := upgradeStatus(job)
+		if job.Status.Phase == PhaseRunning {
+			note, err := r.checkRunningJob(ctx, job)
+			if err != nil {
+				logger.Error(err, "Failed to check running job", "job", client.ObjectKeyFromObject(job))
+				continue
+			}
+			if note != "" {
+				job.Status.Message = note
+				changed = true
+			}
+			resumed++
+		}
+
+		if !changed {
+			continue
+		}
+		// Conflicts mean the job reconciler got there first, which also upgrades the status
+		if err := r.Status().Update(ctx, job); err != nil && !errors.IsConflict(err) {
+			logger.Error(err, "Failed to update job status", "job", client.ObjectKeyFromObject(job))
+		}
+	}
+
+	logger.Info("In-flight recovery complete", "jobs", len(jobs.Items), "resumed", resumed)
+	return nil
+}
+
+// checkRunningJob verifies what a Running job was executing on. It returns a
+// status message to record, or "" if the job can simply carry on.
+func (r *InFlightRecovery) checkRunningJob(ctx context.Context, job *quantumv1.QiskitJob) (string, error) {
+	if dispatched(job) {
+		// The spoke keeps running the copy while the hub operator restarts
+		return "", nil
+	}
+	name := currentExecutionName(job)
+	if job.Status.JobID != "" && job.Status.JobID != name {
+		// Remote provider job; the provider keeps running it while the operator restarts
+		return fmt.Sprintf("Resumed tracking of remote job %s after operator restart", job.Status.JobID), nil
+	}
+
+	var execution batchv1.Job
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: ExecutionNamespace(job)}, &execution)
+	if errors.IsNotFound(err) {
+		// Attempts started before executions ran as Jobs run in a bare pod
+		var pod corev1.Pod
+		err = r.Get(ctx, types.NamespacedName{Name: name, Namespace: ExecutionNamespace(job)}, &pod)
+	}
+	switch {
+	case errors.IsNotFound(err):
+		return "Execution lost during operator restart, recreating", nil
+	case err != nil:
+		return "", err
+	default:
+		return "", nil
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+)
+
+// uploaderContainer is the name of the sidecar uploading the results
+const uploaderContainer = "uploader"
+
+// resultsDir is where the executor hands its results over to the uploader
+const resultsDir = "/results"
+
+// uploaderTimeout is how long the executor waits for the uploader to report
+// on its uploads before giving up on them
+const uploaderTimeout = 10 * time.Minute
+
+// Environment variables telling the executor how to hand its results over
+const (
+	// uploaderTimeoutEnv holds uploaderTimeout in seconds
+	uploaderTimeoutEnv = "UPLOADER_TIMEOUT"
+	// uploadedOutputsEnv holds the names and types of the outputs the
+	// uploader writes to, reported failed if it does not report on them
+	uploadedOutputsEnv = "UPLOADED_OUTPUTS"
+)
+
+// uploaderPrologue copies every line the executor prints that looks like
+// JSON to the results directory, and once the program is done, however it
+// ended, hands them over to the uploader and relays how the uploads went to
+// the logs, where the operator reads them with the rest of the results.
+const uploaderPrologue = `import atexit as _up_atexit
+import json as _up_json
+import os as _up_os
+import sys as _up_sys
+import time as _up_time
+
+_up_dir = _up_os.environ['` + results.ResultsDirEnv + `']
+_up_reported = open(_up_os.path.join(_up_dir, '` + results.ReportedFile + `'), 'a')
+
+class _UploaderStdout:
+    def __init__(self, stream):
+        self._stream = stream
+
+    def write(self, text):
+        if text.startswith('{') and not _up_reported.closed:
+            _up_reported.write(text.rstrip(chr(10)) + chr(10))
+            _up_reported.flush()
+        return self._stream.write(text)
+
+    def __getattr__(self, name):
+        return getattr(self._stream, name)
+
+_up_stdout = _up_sys.stdout
+_up_sys.stdout = _UploaderStdout(_up_stdout)
+
+def _up_handover():
+    _up_reported.close()
+    open(_up_os.path.join(_up_dir, '` + results.DoneFile + `'), 'w').close()
+    path = _up_os.path.join(_up_dir, '` + results.UploadedFile + `')
+    timeout = int(_up_os.environ.get('` + uploaderTimeoutEnv + `', '600'))
+    deadline = _up_time.time() + timeout
+    while not _up_os.path.exists(path) and _up_time.time() < deadline:
+        _up_time.sleep(0.5)
+    try:
+        with open(path) as f:
+            statuses = _up_json.load(f)
+    except (OSError, ValueError):
+        statuses = [dict(output, state='Failed', message='the uploader did not report within %ds' % timeout)
+                    for output in _up_json.loads(_up_os.environ.get('` + uploadedOutputsEnv + `', '[]'))]
+    _up_stdout.write(_up_json.dumps({'uploads': statuses}) + chr(10))
+    _up_stdout.flush()
+
+_up_atexit.register(_up_handover)
+
+`
+
+// uploadsResults reports whether the execution pod of the job gets the
+// uploader sidecar: it is configured, and the job has outputs it uploads
+// to. Sweeps, tomography and split jobs gather their results from several
+// executions, which the operator exports itself.
+func (r *QiskitJobReconciler) uploadsResults(job *quantumv1.QiskitJob) bool {
+	if r.UploaderImage == "" || job.Spec.Sweep != nil || job.Spec.Tomography != nil || job.Spec.Split != nil {
+		return false
+	}
+	for i := range job.Spec.Outputs {
+		if results.Uploads(&job.Spec.Outputs[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// addUploader adds the uploader sidecar to the execution pod. The executor
+// hands its results over in an emptyDir they share; the uploader converts
+// them to the format of each object store and pvc output and uploads them. Only the
+// uploader gets the outputs' credentials and claims, so the executor image
+// needs no storage SDK and circuit code never sees them.
+func (r *QiskitJobReconciler) addUploader(pod *corev1.Pod, job *quantumv1.QiskitJob) error {
+	doc := results.NewDocument(job, nil)
+	doc.JobID = pod.Name
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	mounts := []corev1.VolumeMount{{Name: "results", MountPath: resultsDir}}
+	var env []corev1.EnvVar
+	var targets []results.UploadTarget
+	var outputs []quantumv1.OutputStatus
+	for i := range job.Spec.Outputs {
+		output := &job.Spec.Outputs[i]
+		if !results.Uploads(output) {
+			continue
+		}
+		target := results.UploadTarget{OutputSpec: *output, Prefix: results.JobPrefix(job, output)}
+		target.SecretName = ""
+		if keys := results.Driver(output.Type).SecretKeys; len(keys) > 0 {
+			target.CredentialsEnv = fmt.Sprintf("OUTPUT_%d", i)
+			env = append(env, secretKeysEnv(target.CredentialsEnv, output.SecretName, keys)...)
+		}
+		if output.Type == "pvc" {
+			volume := fmt.Sprintf("output-%d", i)
+			target.Dir = fmt.Sprintf("%s/%d", outputMountPath, i)
+			pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+				Name: volume,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: output.Location},
+				},
+			})
+			mounts = append(mounts, corev1.VolumeMount{Name: volume, MountPath: target.Dir})
+			if pod.Spec.SecurityContext == nil {
+				pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+			}
+			pod.Spec.SecurityContext.FSGroup = ptr(int64(1000))
+			pod.Spec.SecurityContext.FSGroupChangePolicy = ptr(corev1.FSGroupChangeOnRootMismatch)
+		}
+		targets = append(targets, target)
+		outputs = append(outputs, quantumv1.OutputStatus{Name: results.OutputName(output), Type: output.Type})
+	}
+	uploads, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+	names, err := json.Marshal(outputs)
+	if err != nil {
+		return err
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         "results",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	executor := &pod.Spec.Containers[0]
+	executor.VolumeMounts = append(executor.VolumeMounts, mounts[0])
+	executor.Env = append(executor.Env,
+		corev1.EnvVar{Name: results.ResultsDirEnv, Value: resultsDir},
+		corev1.EnvVar{Name: uploaderTimeoutEnv, Value: fmt.Sprintf("%d", int(uploaderTimeout.Seconds()))},
+		corev1.EnvVar{Name: uploadedOutputsEnv, Value: string(names)})
+
+	// A native sidecar runs alongside the executor and stops with it
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:          uploaderContainer,
+		Image:         r.UploaderImage,
+		Command:       []string{"/uploader"},
+		RestartPolicy: ptr(corev1.ContainerRestartPolicyAlways),
+		Env: append([]corev1.EnvVar{
+			{Name: results.ResultsDirEnv, Value: resultsDir},
+			{Name: results.UploaderOutputsEnv, Value: string(uploads)},
+			{Name: results.UploaderDocumentEnv, Value: string(document)},
+		}, env...),
+		VolumeMounts: mounts,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    mustParseQuantity("50m"),
+				corev1.ResourceMemory: mustParseQuantity("64Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    mustParseQuantity("500m"),
+				corev1.ResourceMemory: mustParseQuantity("256Mi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			RunAsNonRoot:             ptr(true),
+			RunAsUser:                ptr(int64(1000)),
+			AllowPrivilegeEscalation: ptr(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	})
+	return nil
+}
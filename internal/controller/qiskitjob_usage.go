@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/heartbeat"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get;list
+
+// podMetricsListGVK is the kind of pod metrics lists served by the resource
+// metrics API
+var podMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+
+// ExecutorUsage reports what the execution pods of running jobs are using:
+// CPU and memory from the resource metrics API, when metrics-server or
+// another provider of it is installed, and GPU utilization from the samples
+// executors with GPUs log alongside their heartbeats. Jobs of remote
+// backends and dispatched jobs have no execution pod here and are left out.
+func ExecutorUsage(ctx context.Context, c client.Reader, logs RecentLogReader) ([]metrics.Usage, error) {
+	var jobs quantumv1.QiskitJobList
+	if err := c.List(ctx, &jobs); err != nil {
+		return nil, err
+	}
+	running := map[attemptKey]*quantumv1.QiskitJob{}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.Status.Phase == PhaseRunning && !remote(job) && !dispatched(job) {
+			running[currentAttemptKey(job)] = job
+		}
+	}
+	if len(running) == 0 {
+		return nil, nil
+	}
+
+	usage := make([]metrics.Usage, 0, len(running))
+	byAttempt := map[attemptKey]*metrics.Usage{}
+	for key, job := range running {
+		backend := job.Status.SelectedBackend
+		if backend == "" {
+			backend = job.Spec.Backend.Type
+		}
+		usage = append(usage, metrics.Usage{Namespace: job.Namespace, Job: job.Name, Backend: backend})
+		byAttempt[key] = &usage[len(usage)-1]
+	}
+
+	podMetrics := &unstructured.UnstructuredList{}
+	podMetrics.SetGroupVersionKind(podMetricsListGVK)
+	// Without the resource metrics API, usage is still reported for what executors log
+	if err := c.List(ctx, podMetrics, client.HasLabels{"quantum.io/job"}); err != nil && !meta.IsNoMatchError(err) {
+		log.FromContext(ctx).Error(err, "Failed to read executor CPU and memory usage")
+	}
+	for i := range podMetrics.Items {
+		item := &podMetrics.Items[i]
+		key, ok := podAttemptKey(item)
+		if !ok {
+			continue
+		}
+		u, ok := byAttempt[key]
+		if !ok {
+			continue
+		}
+		containers, _, _ := unstructured.NestedSlice(item.Object, "containers")
+		cpu, memory := resource.Quantity{}, resource.Quantity{}
+		for _, container := range containers {
+			values, _ := container.(map[string]interface{})
+			cpu.Add(usageQuantity(values, "cpu"))
+			memory.Add(usageQuantity(values, "memory"))
+		}
+		u.CPU, u.Memory = &cpu, &memory
+	}
+
+	if logs != nil {
+		for key, job := range running {
+			if gpus := executorResources(job).Requests[GPUResource]; gpus.IsZero() {
+				continue
+			}
+			recent, err := logs.RecentPodLogs(ctx, ExecutionNamespace(job), currentExecutionName(job), heartbeatRefresh)
+			if err != nil {
+				continue
+			}
+			if sample, ok := heartbeat.LastUsage(recent); ok {
+				byAttempt[key].GPUUtilization = &sample.GPUUtilization
+			}
+		}
+	}
+
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Namespace != usage[j].Namespace {
+			return usage[i].Namespace < usage[j].Namespace
+		}
+		return usage[i].Job < usage[j].Job
+	})
+	return usage, nil
+}
+
+// usageQuantity returns a resource of a container's usage in pod metrics
+func usageQuantity(container map[string]interface{}, name string) resource.Quantity {
+	value, _, _ := unstructured.NestedString(container, "usage", name)
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}
+	}
+	return quantity
+}
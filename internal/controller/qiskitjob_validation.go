@@ -0,0 +1,163 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/lint"
+	"github.com/quantum-operator/qiskit-operator/pkg/qasm"
+	"github.com/quantum-operator/qiskit-operator/pkg/validationservice"
+)
+
+// ConditionCircuitValidated reports the validation service's verdict on the
+// job's circuit
+const ConditionCircuitValidated = "CircuitValidated"
+
+// DefaultValidationRetryTimeout is how long validation is retried while the
+// validation service is unavailable unless configured otherwise
+const DefaultValidationRetryTimeout = 5 * time.Minute
+
+// Bounds of the wait between validation attempts, which doubles while the
+// service stays unavailable
+const (
+	minValidationRetry = 5 * time.Second
+	maxValidationRetry = time.Minute
+)
+
+// validateCircuit records the shape of the job's circuit in its status. With
+// a validation service configured, circuits whose code the operator reads
+// are checked by the service, and a reason to fail the job is returned for
+// invalid ones. OpenQASM programs are checked structurally by the operator
+// first, which also records their shape without a service. While the service is unavailable it returns how long to wait
+// before trying again, until the retry timeout runs out. The hash is always
+// the operator's own, so duplicate detection matches jobs however they were
+// validated.
+func (r *QiskitJobReconciler) validateCircuit(ctx context.Context, job *quantumv1.QiskitJob) (string, time.Duration, error) {
+	code, err := r.circuitCode(ctx, job)
+	if err != nil {
+		return "", 0, err
+	}
+	metadata := &quantumv1.CircuitMetadata{Hash: circuitHash(job.Spec.Circuit, code)}
+	if version := qasmVersion(job); version > 0 {
+		program, err := qasm.Parse(code, version)
+		if err != nil {
+			setValidatedCondition(job, metav1.ConditionFalse, "Invalid", err.Error())
+			return "Circuit validation failed: " + err.Error(), 0, nil
+		}
+		metadata.Depth = program.Depth
+		metadata.Qubits = program.Qubits
+		metadata.Gates = program.Gates
+		metadata.GateTypes = program.GateTypes
+	} else if n, ok := lint.DeclaredQubits(code); ok {
+		metadata.Qubits = n
+	}
+	serviceURL := r.tunables().ValidationServiceURL
+	if serviceURL == "" || code == "" {
+		job.Status.CircuitMetadata = metadata
+		return "", 0, nil
+	}
+
+	response, err := validationservice.New(serviceURL, nil).Validate(ctx, validationservice.Request{
+		Code:              code,
+		Format:            job.Spec.Circuit.Format,
+		BackendName:       job.Spec.Backend.Name,
+		OptimizationLevel: job.Spec.Execution.OptimizationLevel,
+		Transpiler:        transpilerOptionsOf(job),
+	})
+	var unavailable *validationservice.UnavailableError
+	if errors.As(err, &unavailable) {
+		return r.awaitValidationService(ctx, job, err)
+	}
+	if err != nil {
+		setValidatedCondition(job, metav1.ConditionFalse, "Rejected", err.Error())
+		return fmt.Sprintf("Circuit validation failed: %v", err), 0, nil
+	}
+	if !response.Valid {
+		message := strings.Join(response.Errors, "; ")
+		if message == "" {
+			message = "the validation service gave no reason"
+		}
+		setValidatedCondition(job, metav1.ConditionFalse, "Invalid", message)
+		return "Circuit validation failed: " + message, 0, nil
+	}
+
+	metadata.Depth = response.Depth
+	metadata.Qubits = response.Qubits
+	metadata.Gates = response.Gates
+	metadata.GateTypes = response.GateTypes
+	if transpiled := response.Transpiled; transpiled != nil {
+		metadata.Transpilation = &quantumv1.TranspilationMetadata{
+			Source:            results.TranspilationByValidationService,
+			OptimizationLevel: job.Spec.Execution.OptimizationLevel,
+			Depth:             transpiled.Depth,
+			TwoQubitGates:     transpiled.TwoQubitGates,
+			PhysicalQubits:    transpiled.PhysicalQubits,
+		}
+	}
+	job.Status.CircuitMetadata = metadata
+	message := "Circuit validated by the validation service"
+	if len(response.Warnings) > 0 {
+		message = strings.Join(response.Warnings, "; ")
+	}
+	setValidatedCondition(job, metav1.ConditionTrue, "Validated", message)
+	return "", 0, nil
+}
+
+// awaitValidationService keeps the job validating while the validation
+// service is unavailable, waiting longer the longer it has been down, and
+// returns a reason to fail the job once the retry timeout runs out
+func (r *QiskitJobReconciler) awaitValidationService(ctx context.Context, job *quantumv1.QiskitJob, err error) (string, time.Duration, error) {
+	tunables := r.tunables()
+	timeout := tunables.ValidationRetryTimeout
+	setValidatedCondition(job, metav1.ConditionUnknown, "ServiceUnavailable", err.Error())
+	since := meta.FindStatusCondition(job.Status.Conditions, ConditionCircuitValidated).LastTransitionTime
+	down := time.Since(since.Time)
+	if down >= timeout {
+		setValidatedCondition(job, metav1.ConditionFalse, "ServiceUnavailable", err.Error())
+		return fmt.Sprintf("Circuit validation failed: the validation service at %s was unavailable for %s: %v",
+			tunables.ValidationServiceURL, timeout, err), 0, nil
+	}
+
+	log.FromContext(ctx).Info("Validation service unavailable, retrying", "error", err.Error(), "for", down)
+	job.Status.Message = fmt.Sprintf("Waiting for the validation service: %v", err)
+	if err := r.Status().Update(ctx, job); err != nil {
+		return "", 0, err
+	}
+	return "", min(max(down, minValidationRetry), maxValidationRetry), nil
+}
+
+// setValidatedCondition records the validation service's verdict
+func setValidatedCondition(job *quantumv1.QiskitJob, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionCircuitValidated,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: job.Generation,
+	})
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/callback"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+)
+
+// VerifyLabel marks the execution pods of verification runs
+const VerifyLabel = "quantum.io/verify"
+
+// ConditionVerified is True once both runs of a verify job measured identical
+// counts and False when they differ
+const ConditionVerified = "Verified"
+
+// DefaultVerifySeed seeds verify jobs admitted without the API server's defaults
+const DefaultVerifySeed = 42
+
+// verificationPodName names the execution pod of the verification run of the
+// job's current attempt
+func verificationPodName(job *quantumv1.QiskitJob) string {
+	return executionName(job) + "-verify"
+}
+
+// verifyEnv seeds the simulator and transpiler of both runs of a verify job
+func verifyEnv(job *quantumv1.QiskitJob) []corev1.EnvVar {
+	verify := job.Spec.Verify
+	if verify == nil {
+		return nil
+	}
+	seed := verify.Seed
+	if seed <= 0 {
+		seed = DefaultVerifySeed
+	}
+	return []corev1.EnvVar{{Name: "SIMULATOR_SEED", Value: strconv.FormatInt(seed, 10)}}
+}
+
+// verificationFailed reports whether the runs of the job's last attempt
+// measured different counts. Seeded runs reproduce their counts, so such a
+// job is not retried.
+func verificationFailed(job *quantumv1.QiskitJob) bool {
+	return job.Status.Verification != nil && job.Status.Verification.Diff != ""
+}
+
+// startVerification creates the execution pod of the verification run for
+// the current attempt and records it in the job's status, which the caller
+// persists. It is called on every pass of a running attempt and does
+// nothing once the status records the verification run of the attempt. A
+// verification run that cannot start is recorded as failed, which fails the
+// attempt once the primary run finished.
+func (r *QiskitJobReconciler) startVerification(ctx context.Context, job *quantumv1.QiskitJob) {
+	verify := job.Spec.Verify
+	if verify == nil {
+		return
+	}
+	if verification := job.Status.Verification; verification != nil && verification.PodName == verificationPodName(job) {
+		return
+	}
+	logger := log.FromContext(ctx)
+
+	verifyJob := job.DeepCopy()
+	// Only the primary run writes to the job's outputs
+	verifyJob.Spec.Outputs = nil
+	if verify.QiskitVersion != "" {
+		verifyJob.Spec.Execution.QiskitVersion = verify.QiskitVersion
+	}
+	status := &quantumv1.VerificationStatus{
+		PodName: verificationPodName(job),
+		Phase:   PhaseRunning,
+	}
+	job.Status.Verification = status
+
+	pod, err := r.createExecutionPod(ctx, verifyJob)
+	if err == nil {
+		pod.Name = status.PodName
+		pod.Labels[VerifyLabel] = "true"
+		if verify.Image != "" {
+			pod.Spec.Containers[0].Image = verify.Image
+		}
+		status.Image = pod.Spec.Containers[0].Image
+		if rt, err := compat.Resolve(verifyJob.Spec.Execution.QiskitVersion); err == nil {
+			status.QiskitVersion = rt.Line
+		}
+		// The verification run posts its output under its own name, not
+		// over that of the primary run
+		if r.Callback != nil {
+			var callbackEnv []corev1.EnvVar
+			if callbackEnv, err = r.Callback.Env(job, pod.Name); err == nil {
+				env := slices.DeleteFunc(pod.Spec.Containers[0].Env, func(e corev1.EnvVar) bool { return callback.IsEnv(e.Name) })
+				pod.Spec.Containers[0].Env = append(env, callbackEnv...)
+			}
+		}
+		if err == nil {
+			err = r.Create(ctx, pod)
+		}
+	}
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to start verification run")
+		status.Phase = PhaseFailed
+		status.Message = fmt.Sprintf("Failed to create verification pod: %v", err)
+		return
+	}
+	logger.Info("Verification run started", "pod", status.PodName, "image", status.Image)
+}
+
+// awaitVerification waits for the verification run of a job whose primary
+// run finished. It reports whether the job is still waiting, in which case
+// reconciliation should stop with the returned result.
+func (r *QiskitJobReconciler) awaitVerification(ctx context.Context, job *quantumv1.QiskitJob) (ctrl.Result, bool, error) {
+	verification := job.Status.Verification
+	if verification == nil || verification.Phase != PhaseRunning {
+		return ctrl.Result{}, false, nil
+	}
+
+	var pod corev1.Pod
+	err := r.Get(ctx, types.NamespacedName{Name: verification.PodName, Namespace: ExecutionNamespace(job)}, &pod)
+	switch {
+	case apierrors.IsNotFound(err):
+		verification.Phase = PhaseFailed
+		verification.Message = fmt.Sprintf("Verification pod %s not found", verification.PodName)
+	case err != nil:
+		return ctrl.Result{}, true, err
+	case pod.Status.Phase == corev1.PodSucceeded:
+		verification.Phase = PhaseCompleted
+	case pod.Status.Phase == corev1.PodFailed:
+		verification.Phase = PhaseFailed
+		verification.Message = fmt.Sprintf("Verification pod %s failed", verification.PodName)
+	default:
+		job.Status.Message = "Primary run finished, waiting for verification run"
+		requeueBecause(ctx, RequeueWaitingForPod)
+		return ctrl.Result{RequeueAfter: r.runningRequeue()}, true, r.Status().Update(ctx, job)
+	}
+	return ctrl.Result{}, false, nil
+}
+
+// compareVerification compares the counts of the job's finished primary and
+// verification runs. It returns why the attempt fails, or an empty string
+// when both runs measured the same counts or the job verifies nothing.
+func (r *QiskitJobReconciler) compareVerification(ctx context.Context, job *quantumv1.QiskitJob) string {
+	verification := job.Status.Verification
+	if job.Spec.Verify == nil || verification == nil {
+		return ""
+	}
+	if verification.Phase != PhaseCompleted {
+		return "Verification run failed: " + verification.Message
+	}
+	if r.PodLogs == nil {
+		verification.Message = "Runs not compared: pod logs cannot be read"
+		return "Verification failed: " + verification.Message
+	}
+
+	primary, ok := results.ParseCounts(r.executionLogs(ctx, job))
+	if !ok {
+		verification.Message = "Runs not compared: no measurement counts found in the logs of the primary run"
+		return "Verification failed: " + verification.Message
+	}
+	logs, err := r.PodLogs.PodLogs(ctx, ExecutionNamespace(job), verification.PodName)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read verification pod logs")
+	}
+	second, ok := results.ParseCounts(logs)
+	if !ok {
+		verification.Message = fmt.Sprintf("Runs not compared: no measurement counts found in the logs of verification pod %s",
+			verification.PodName)
+		return "Verification failed: " + verification.Message
+	}
+
+	verification.Diff = diffCounts(primary, second)
+	if verification.Diff != "" {
+		verification.Message = ""
+		meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+			Type:               ConditionVerified,
+			Status:             metav1.ConditionFalse,
+			Reason:             "CountsDiffer",
+			Message:            "Counts differ between runs: " + verification.Diff,
+			ObservedGeneration: job.Generation,
+		})
+		return "Verification failed, counts differ between runs: " + verification.Diff
+	}
+	meta.SetStatusCondition(&job.Status.Conditions, metav1.Condition{
+		Type:               ConditionVerified,
+		Status:             metav1.ConditionTrue,
+		Reason:             "CountsMatch",
+		Message:            fmt.Sprintf("Both runs measured identical counts on %s", verification.Image),
+		ObservedGeneration: job.Generation,
+	})
+	return ""
+}
+
+// diffCounts lists the outcomes whose counts differ between the primary and
+// the second run, as "outcome: primary != second", empty when none do
+func diffCounts(primary, second map[string]int) string {
+	var outcomes []string
+	for outcome := range primary {
+		outcomes = append(outcomes, outcome)
+	}
+	for outcome := range second {
+		if _, ok := primary[outcome]; !ok {
+			outcomes = append(outcomes, outcome)
+		}
+	}
+	slices.Sort(outcomes)
+
+	var diff []string
+	for _, outcome := range outcomes {
+		if primary[outcome] != second[outcome] {
+			diff = append(diff, fmt.Sprintf("%s: %d != %d", outcome, primary[outcome], second[outcome]))
+		}
+	}
+	return strings.Join(diff, ", ")
+}
@@ -18,46 +18,391 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
 )
 
-// QiskitSessionReconciler reconciles a QiskitSession object
+// Phases of sessions
+const (
+	SessionPhasePending = "Pending"
+	SessionPhaseActive  = "Active"
+	SessionPhaseIdle    = "Idle"
+	SessionPhaseExpired = "Expired"
+	SessionPhaseFailed  = "Failed"
+)
+
+// ConditionSessionReady is True while jobs can be submitted into a
+// QiskitSession
+const ConditionSessionReady = "Ready"
+
+// qiskitSessionFinalizer closes the provider session of a deleted
+// QiskitSession
+const qiskitSessionFinalizer = "quantum.io/session-finalizer"
+
+// Defaults of sessions the API server did not default
+const (
+	defaultSessionMaxTime       = 8 * time.Hour
+	defaultSessionCheckInterval = time.Minute
+)
+
+// QiskitSessionReconciler keeps the IBM Quantum Runtime session of a
+// QiskitSession open for the jobs that name it, and closes it when it expires
+// or the QiskitSession is deleted
 type QiskitSessionReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// WithoutSecrets is set when the operator runs without Secret access, so
+	// sessions cannot be opened
+	WithoutSecrets bool
+
+	// IBM overrides the endpoints sessions are opened at
+	IBM ibm.Options
 }
 
 // +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitsessions,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitsessions/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitsessions/finalizers,verbs=update
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
 
-// Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the QiskitSession object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.22.1/pkg/reconcile
+// Reconcile opens the session, checks on it every check interval and closes
+// it once it expires. A session the provider closed early, like after its
+// interactive timeout, is reopened as soon as an unfinished job names it, in
+// the time left until it expires. The status is only written when a check
+// changed it.
 func (r *QiskitSessionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+	var session quantumv1.QiskitSession
+	if err := r.Get(ctx, req.NamespacedName, &session); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if session.DeletionTimestamp != nil {
+		if controllerutil.ContainsFinalizer(&session, qiskitSessionFinalizer) {
+			if retry := r.closeSession(ctx, &session); retry {
+				return ctrl.Result{RequeueAfter: sessionCheckInterval(&session)}, nil
+			}
+			controllerutil.RemoveFinalizer(&session, qiskitSessionFinalizer)
+			if err := r.Update(ctx, &session); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	switch session.Status.Phase {
+	case SessionPhaseExpired, SessionPhaseFailed:
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(&session, qiskitSessionFinalizer) {
+		controllerutil.AddFinalizer(&session, qiskitSessionFinalizer)
+		if err := r.Update(ctx, &session); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	written := session.Status.DeepCopy()
+	result, err := r.checkSession(ctx, &session, written)
+	if err != nil || !sessionStatusChanged(written, &session.Status) {
+		return result, err
+	}
+	if err := r.Status().Update(ctx, &session); err != nil {
+		return ctrl.Result{}, err
+	}
+	return result, nil
+}
+
+// checkSession brings the session's status up to date with the provider,
+// opening and closing the provider session as needed. Status written on the
+// way is recorded in written.
+func (r *QiskitSessionReconciler) checkSession(ctx context.Context, session *quantumv1.QiskitSession, written *quantumv1.QiskitSessionStatus) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+
+	if message := validateSession(session); message != "" {
+		return setSessionPhase(session, SessionPhaseFailed, "InvalidSpec", message), nil
+	}
+
+	now := time.Now()
+	if expiry := session.Status.ExpiryTime; expiry != nil && !now.Before(expiry.Time) {
+		if retry := r.closeSession(ctx, session); retry {
+			return ctrl.Result{RequeueAfter: sessionCheckInterval(session)}, nil
+		}
+		logger.Info("Session expired", "session", session.Name)
+		session.Status.SessionID = ""
+		return setSessionPhase(session, SessionPhaseExpired, "Expired",
+			fmt.Sprintf("Session expired after %s", sessionMaxTime(session))), nil
+	}
+
+	adapter, err := r.ibmBackend(ctx, session)
+	var apiErr apierrors.APIStatus
+	switch {
+	case apierrors.IsNotFound(err):
+		return setSessionPhase(session, session.Status.Phase, "CredentialsNotFound",
+			fmt.Sprintf("Credentials secret not found: %v", err)), nil
+	case errors.As(err, &apiErr):
+		return ctrl.Result{}, err
+	case backend.Permanent(err):
+		return setSessionPhase(session, SessionPhaseFailed, "CredentialsRejected", err.Error()), nil
+	case err != nil:
+		return setSessionPhase(session, session.Status.Phase, "ProviderUnreachable", err.Error()), nil
+	}
+
+	session.Status.LastCheckTime = &metav1.Time{Time: now}
+	if id := session.Status.SessionID; id != "" {
+		provider, err := adapter.GetSession(ctx, id)
+		if err != nil {
+			logger.Error(err, "Failed to check session", "sessionID", id)
+			return setSessionPhase(session, session.Status.Phase, "ProviderUnreachable",
+				fmt.Sprintf("Failed to check session %s: %v", id, err)), nil
+		}
+		session.Status.State = provider.State
+		if !provider.Closed() {
+			return setSessionPhase(session, SessionPhaseActive, "Open",
+				fmt.Sprintf("Session %s is %s on %s", id, provider.State, session.Spec.Backend.Name)), nil
+		}
+		logger.Info("Provider closed the session early", "sessionID", id)
+		session.Status.SessionID = ""
+	}
+
+	if session.Status.Opened > 0 {
+		waiting, err := r.waitingJobs(ctx, session)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if waiting == 0 {
+			return setSessionPhase(session, SessionPhaseIdle, "ClosedByProvider",
+				"The provider closed the session; it is reopened when a job names it"), nil
+		}
+	}
+
+	remaining := sessionMaxTime(session)
+	if session.Status.ExpiryTime != nil {
+		remaining = session.Status.ExpiryTime.Sub(now)
+	}
+	// The attempt is recorded first, so that a session the provider opened
+	// but the operator failed to record is known of
+	if since := session.Status.OpeningSince; since != nil {
+		logger.Info("A session opened on an earlier pass may not have been recorded, the provider closes it at its maximum time",
+			"backend", session.Spec.Backend.Name, "since", since.Time)
+	} else {
+		session.Status.OpeningSince = &metav1.Time{Time: now}
+		if err := r.Status().Update(ctx, session); err != nil {
+			return ctrl.Result{}, err
+		}
+		session.Status.DeepCopyInto(written)
+	}
+	opened, err := adapter.OpenSession(ctx, sessionMode(session), remaining)
+	switch {
+	case backend.Permanent(err):
+		return setSessionPhase(session, SessionPhaseFailed, "OpenRejected",
+			fmt.Sprintf("Failed to open a session on %s: %v", session.Spec.Backend.Name, err)), nil
+	case err != nil:
+		logger.Error(err, "Failed to open session", "backend", session.Spec.Backend.Name)
+		return setSessionPhase(session, SessionPhasePending, "OpenFailed",
+			fmt.Sprintf("Failed to open a session on %s: %v", session.Spec.Backend.Name, err)), nil
+	}
 
-	// TODO(user): your logic here
+	logger.Info("Opened session", "backend", session.Spec.Backend.Name, "sessionID", opened.ID)
+	session.Status.SessionID = opened.ID
+	session.Status.State = opened.State
+	session.Status.OpeningSince = nil
+	session.Status.Opened++
+	if session.Status.StartTime == nil {
+		session.Status.StartTime = &metav1.Time{Time: now}
+		session.Status.ExpiryTime = &metav1.Time{Time: now.Add(remaining)}
+	}
+	return setSessionPhase(session, SessionPhaseActive, "Opened",
+		fmt.Sprintf("Opened session %s on %s", opened.ID, session.Spec.Backend.Name)), nil
+}
+
+// setSessionPhase sets the session's phase and Ready condition, and requeues
+// it for its next check, or for its expiry if that comes first
+func setSessionPhase(session *quantumv1.QiskitSession, phase, reason, message string) ctrl.Result {
+	if phase == "" {
+		phase = SessionPhasePending
+	}
+	session.Status.Phase = phase
+	session.Status.Message = message
+	status := metav1.ConditionFalse
+	if phase == SessionPhaseActive {
+		status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&session.Status.Conditions, metav1.Condition{
+		Type:               ConditionSessionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: session.Generation,
+	})
+
+	switch phase {
+	case SessionPhaseExpired, SessionPhaseFailed:
+		return ctrl.Result{}
+	}
+	after := sessionCheckInterval(session)
+	if expiry := session.Status.ExpiryTime; expiry != nil {
+		if left := time.Until(expiry.Time); left < after {
+			after = max(left, time.Second)
+		}
+	}
+	return ctrl.Result{RequeueAfter: after}
+}
+
+// sessionStatusChanged reports whether the status differs from the one
+// written, other than in the time of the last check
+func sessionStatusChanged(written, status *quantumv1.QiskitSessionStatus) bool {
+	checked := status.DeepCopy()
+	checked.LastCheckTime = written.LastCheckTime
+	return !equality.Semantic.DeepEqual(written, checked)
+}
+
+// closeSession closes the session's provider session, if one is open. It
+// reports whether closing should be retried; sessions whose credentials are
+// gone or rejected are left to the provider, which closes them at their
+// maximum time.
+func (r *QiskitSessionReconciler) closeSession(ctx context.Context, session *quantumv1.QiskitSession) bool {
+	id := session.Status.SessionID
+	if id == "" {
+		return false
+	}
+	logger := logf.FromContext(ctx)
+	adapter, err := r.ibmBackend(ctx, session)
+	if err == nil {
+		err = adapter.CloseSession(ctx, id)
+	}
+	switch {
+	case err == nil:
+		logger.Info("Closed session", "sessionID", id)
+		return false
+	case apierrors.IsNotFound(err) || backend.Permanent(err) || validateSession(session) != "":
+		logger.Error(err, "Cannot close session, leaving it to time out", "sessionID", id)
+		return false
+	default:
+		logger.Error(err, "Failed to close session", "sessionID", id)
+		return true
+	}
+}
+
+// waitingJobs counts the jobs of the namespace that name the session and
+// have not settled
+func (r *QiskitSessionReconciler) waitingJobs(ctx context.Context, session *quantumv1.QiskitSession) (int, error) {
+	var jobs quantumv1.QiskitJobList
+	if err := r.List(ctx, &jobs, client.InNamespace(session.Namespace)); err != nil {
+		return 0, err
+	}
+	waiting := 0
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if job.Spec.Session != nil && job.Spec.Session.Name == session.Name && !settled(job) {
+			waiting++
+		}
+	}
+	return waiting, nil
+}
+
+// validateSession explains what makes the session impossible to open, if
+// anything
+func validateSession(session *quantumv1.QiskitSession) string {
+	spec := session.Spec
+	switch {
+	case spec.Backend.Type != string(backend.IBMQuantum):
+		return fmt.Sprintf("Sessions are only supported on ibm_quantum backends, not %s", spec.Backend.Type)
+	case spec.Backend.Name == "":
+		return "ibm_quantum sessions require the device in spec.backend.name"
+	case spec.Credentials == nil || spec.Credentials.SecretRef == nil:
+		return "ibm_quantum sessions require spec.credentials.secretRef with an api-key"
+	case spec.Credentials.SecretRef.Namespace != "" && spec.Credentials.SecretRef.Namespace != session.Namespace:
+		return "spec.credentials.secretRef must name a Secret in the session's namespace"
+	}
+	return ""
+}
+
+// ibmBackend returns an adapter for the session's device authenticated with
+// its credentials
+func (r *QiskitSessionReconciler) ibmBackend(ctx context.Context, session *quantumv1.QiskitSession) (*ibm.Backend, error) {
+	spec := session.Spec
+	if message := validateSession(session); message != "" {
+		return nil, errors.New(message)
+	}
+	if r.WithoutSecrets {
+		return nil, errors.New("ibm_quantum credentials need Secret access, which the operator runs without")
+	}
+	ref := spec.Credentials.SecretRef
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: session.Namespace}, &secret); err != nil {
+		return nil, err
+	}
+
+	instance := spec.Backend.Instance
+	if instance == "" {
+		instance = string(secret.Data["instance"])
+	}
+	if !strings.HasPrefix(instance, "crn:") {
+		return nil, errors.New("ibm_quantum sessions require the IBM Cloud CRN of a Qiskit Runtime instance in spec.backend.instance")
+	}
+
+	opts := r.IBM
+	if opts.URL == "" {
+		opts.URL = ibm.URL(spec.Backend.Region)
+	}
+	adapter := ibm.New(spec.Backend.Name, instance, opts)
+	if err := adapter.Authenticate(ctx, &backend.Credentials{APIKey: string(secret.Data["api-key"]), Instance: instance}); err != nil {
+		return nil, err
+	}
+	return adapter, nil
+}
+
+// sessionMode returns the session's mode, dedicated unless set
+func sessionMode(session *quantumv1.QiskitSession) string {
+	if session.Spec.Mode == "" {
+		return "dedicated"
+	}
+	return session.Spec.Mode
+}
+
+// sessionMaxTime returns how long the session stays open at most
+func sessionMaxTime(session *quantumv1.QiskitSession) time.Duration {
+	if session.Spec.MaxTime != nil && session.Spec.MaxTime.Duration > 0 {
+		return session.Spec.MaxTime.Duration
+	}
+	return defaultSessionMaxTime
+}
 
-	return ctrl.Result{}, nil
+// sessionCheckInterval returns how often the session is checked with the provider
+func sessionCheckInterval(session *quantumv1.QiskitSession) time.Duration {
+	if session.Spec.CheckInterval != nil && session.Spec.CheckInterval.Duration > 0 {
+		return session.Spec.CheckInterval.Duration
+	}
+	return defaultSessionCheckInterval
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *QiskitSessionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&quantumv1.QiskitSession{}).
+		// Sessions are checked on their interval; status updates of their own
+		// need no reconciliation
+		For(&quantumv1.QiskitSession{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Named("qiskitsession").
 		Complete(r)
 }
@@ -18,67 +18,273 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
 )
 
 var _ = Describe("QiskitSession Controller", func() {
-	Context("When reconciling a resource", func() {
-		const resourceName = "test-resource"
+	var (
+		ctx      context.Context
+		server   *httptest.Server
+		opened   []map[string]any
+		closed   []string
+		state    string
+		ibmOpts  ibm.Options
+		instance = "crn:v1:bluemix:public:quantum-computing:us-east:a/abc:def::"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		opened = nil
+		closed = nil
+		state = "active"
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /identity/token", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"access_token": "token", "expires_in": 3600}`))
+		})
+		mux.HandleFunc("POST /api/v1/sessions", func(w http.ResponseWriter, r *http.Request) {
+			var request map[string]any
+			Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+			opened = append(opened, request)
+			state = "open"
+			_, _ = w.Write([]byte(`{"id": "s` + string(rune('0'+len(opened))) + `", "state": "open"}`))
+		})
+		mux.HandleFunc("GET /api/v1/sessions/{id}", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"id": "` + r.PathValue("id") + `", "state": "` + state + `"}`))
+		})
+		mux.HandleFunc("DELETE /api/v1/sessions/{id}/close", func(w http.ResponseWriter, r *http.Request) {
+			closed = append(closed, r.PathValue("id"))
+			state = "closed"
+			w.WriteHeader(http.StatusNoContent)
+		})
+		server = httptest.NewServer(mux)
+		ibmOpts = ibm.Options{URL: server.URL + "/api", IAMURL: server.URL + "/identity/token"}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
 
-		ctx := context.Background()
+	newSession := func(name string) *quantumv1.QiskitSession {
+		return &quantumv1.QiskitSession{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: quantumv1.QiskitSessionSpec{
+				Backend:     quantumv1.BackendSpec{Type: "ibm_quantum", Name: "ibm_torino", Instance: instance},
+				Credentials: &quantumv1.CredentialsSpec{SecretRef: &quantumv1.SecretRef{Name: "ibm"}},
+				Mode:        "dedicated",
+				MaxTime:     &metav1.Duration{Duration: 2 * time.Hour},
+			},
+		}
+	}
+	secret := func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ibm", Namespace: "default"},
+			Data:       map[string][]byte{"api-key": []byte("secret")},
+		}
+	}
 
-		typeNamespacedName := types.NamespacedName{
-			Name:      resourceName,
-			Namespace: "default", // TODO(user):Modify as needed
+	reconcile := func(c client.Client, name string) (*quantumv1.QiskitSession, ctrl.Result) {
+		r := &QiskitSessionReconciler{Client: c, Scheme: c.Scheme(), IBM: ibmOpts}
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}})
+		Expect(err).NotTo(HaveOccurred())
+		var session quantumv1.QiskitSession
+		if err := c.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, &session); apierrors.IsNotFound(err) {
+			return nil, result
 		}
-		qiskitsession := &quantumv1.QiskitSession{}
-
-		BeforeEach(func() {
-			By("creating the custom resource for the Kind QiskitSession")
-			err := k8sClient.Get(ctx, typeNamespacedName, qiskitsession)
-			if err != nil && errors.IsNotFound(err) {
-				resource := &quantumv1.QiskitSession{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      resourceName,
-						Namespace: "default",
-					},
-					// TODO(user): Specify other spec details if needed.
-				}
-				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		return &session, result
+	}
+
+	It("should open the session, reopen it for waiting jobs and close it when it expires", func() {
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(newSession("vqe"), secret()).
+			WithStatusSubresource(&quantumv1.QiskitSession{}, &quantumv1.QiskitJob{}).Build()
+
+		By("opening the session on the device")
+		session, result := reconcile(c, "vqe")
+		Expect(session.Status.Phase).To(Equal(SessionPhaseActive))
+		Expect(session.Status.SessionID).To(Equal("s1"))
+		Expect(session.Finalizers).To(ContainElement(qiskitSessionFinalizer))
+		Expect(meta.IsStatusConditionTrue(session.Status.Conditions, ConditionSessionReady)).To(BeTrue())
+		Expect(session.Status.ExpiryTime.Sub(session.Status.StartTime.Time)).To(Equal(2 * time.Hour))
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		Expect(opened).To(HaveLen(1))
+		Expect(opened[0]).To(HaveKeyWithValue("backend", "ibm_torino"))
+		Expect(opened[0]).To(HaveKeyWithValue("mode", "dedicated"))
+		Expect(opened[0]).To(HaveKeyWithValue("max_ttl", BeNumerically("==", 7200)))
+
+		By("keeping an open session as it is")
+		session, _ = reconcile(c, "vqe")
+		Expect(session.Status.SessionID).To(Equal("s1"))
+		Expect(opened).To(HaveLen(1))
+
+		By("going idle when the provider closes it early and no job waits")
+		state = "closed"
+		session, _ = reconcile(c, "vqe")
+		Expect(session.Status.Phase).To(Equal(SessionPhaseIdle))
+		Expect(session.Status.SessionID).To(BeEmpty())
+		Expect(meta.IsStatusConditionTrue(session.Status.Conditions, ConditionSessionReady)).To(BeFalse())
+		Expect(opened).To(HaveLen(1))
+
+		By("reopening it in the time left once a job names it")
+		job := builder.NewBellStateJob("vqe-step", "default").
+			WithBackend("ibm_quantum", "ibm_torino").
+			WithSession("vqe", "dedicated", 0).
+			Build()
+		job.Status.Phase = PhaseScheduling
+		Expect(c.Create(ctx, job)).To(Succeed())
+		session, _ = reconcile(c, "vqe")
+		Expect(session.Status.Phase).To(Equal(SessionPhaseActive))
+		Expect(session.Status.SessionID).To(Equal("s2"))
+		Expect(session.Status.Opened).To(Equal(int32(2)))
+		Expect(opened[1]["max_ttl"]).To(BeNumerically("<=", 7200))
+
+		By("closing it once it expires")
+		session.Status.ExpiryTime = &metav1.Time{Time: time.Now().Add(-time.Second)}
+		Expect(c.Status().Update(ctx, session)).To(Succeed())
+		session, result = reconcile(c, "vqe")
+		Expect(session.Status.Phase).To(Equal(SessionPhaseExpired))
+		Expect(closed).To(Equal([]string{"s2"}))
+		Expect(result.RequeueAfter).To(BeZero())
+	})
+
+	It("should only write the status when a check changed it", func() {
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(newSession("quiet"), secret()).
+			WithStatusSubresource(&quantumv1.QiskitSession{}).Build()
+		reconcile(c, "quiet")
+		session, _ := reconcile(c, "quiet")
+		Expect(session.Status.SessionID).To(Equal("s1"))
+		Expect(session.Status.OpeningSince).To(BeNil())
+		version := session.ResourceVersion
+
+		session, result := reconcile(c, "quiet")
+		Expect(session.ResourceVersion).To(Equal(version))
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+		state = "active"
+		session, _ = reconcile(c, "quiet")
+		Expect(session.ResourceVersion).NotTo(Equal(version))
+		Expect(session.Status.State).To(Equal("active"))
+	})
+
+	It("should record opening a session before asking the provider", func() {
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(newSession("lost"), secret()).
+			WithStatusSubresource(&quantumv1.QiskitSession{}).Build()
+		server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/identity/token" {
+				_, _ = w.Write([]byte(`{"access_token": "token", "expires_in": 3600}`))
+				return
 			}
+			w.WriteHeader(http.StatusBadGateway)
 		})
 
-		AfterEach(func() {
-			// TODO(user): Cleanup logic after each test, like removing the resource instance.
-			resource := &quantumv1.QiskitSession{}
-			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+		session, _ := reconcile(c, "lost")
+		Expect(session.Status.Phase).To(Equal(SessionPhasePending))
+		Expect(session.Status.OpeningSince).NotTo(BeNil())
+		since := session.Status.OpeningSince.Time
+
+		session, _ = reconcile(c, "lost")
+		Expect(session.Status.OpeningSince.Time).To(BeTemporally("==", since), "the first attempt is kept")
+	})
+
+	It("should close the session when it is deleted", func() {
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(newSession("batch"), secret()).
+			WithStatusSubresource(&quantumv1.QiskitSession{}).Build()
+		session, _ := reconcile(c, "batch")
+		Expect(session.Status.SessionID).To(Equal("s1"))
+
+		Expect(c.Delete(ctx, session)).To(Succeed())
+		session, _ = reconcile(c, "batch")
+		Expect(session).To(BeNil())
+		Expect(closed).To(Equal([]string{"s1"}))
+	})
+
+	It("should fail sessions that cannot be opened", func() {
+		invalid := newSession("simulated")
+		invalid.Spec.Backend.Type = "local_simulator"
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(invalid).
+			WithStatusSubresource(&quantumv1.QiskitSession{}).Build()
+
+		session, result := reconcile(c, "simulated")
+		Expect(session.Status.Phase).To(Equal(SessionPhaseFailed))
+		Expect(session.Status.Message).To(ContainSubstring("only supported on ibm_quantum"))
+		Expect(result.RequeueAfter).To(BeZero())
+		Expect(opened).To(BeEmpty())
+
+		By("deleting them without a provider session to close")
+		Expect(c.Delete(ctx, session)).To(Succeed())
+		session, _ = reconcile(c, "simulated")
+		Expect(session).To(BeNil())
+		Expect(closed).To(BeEmpty())
+	})
+
+	Context("when jobs name a QiskitSession", func() {
+		It("should hold jobs until the session is open and run them in it", func() {
+			session := newSession("shared")
+			job := builder.NewBellStateJob("shared-step", "default").
+				WithBackend("ibm_quantum", "ibm_torino").
+				WithSession("shared", "dedicated", 0).
+				Build()
+			c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(session, job).
+				WithStatusSubresource(&quantumv1.QiskitSession{}, &quantumv1.QiskitJob{}).Build()
+			r := &QiskitJobReconciler{Client: c, Scheme: c.Scheme()}
+
+			By("waiting while the session opens")
+			result, held, err := r.holdForSession(ctx, job)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(held).To(BeTrue())
+			Expect(result.RequeueAfter).To(Equal(sessionWaitInterval))
+			Expect(job.Status.Message).To(ContainSubstring("Waiting for session shared"))
 
-			By("Cleanup the specific resource instance QiskitSession")
-			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
-		})
-		It("should successfully reconcile the resource", func() {
-			By("Reconciling the created resource")
-			controllerReconciler := &QiskitSessionReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
-			}
+			By("taking the session's provider ID once it is active")
+			session.Status.Phase = SessionPhaseActive
+			session.Status.SessionID = "s9"
+			Expect(c.Status().Update(ctx, session)).To(Succeed())
+			_, held, err = r.holdForSession(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(held).To(BeFalse())
+			Expect(job.Status.SessionID).To(Equal("s9"))
+
+			By("failing jobs for another device")
+			job.Spec.Backend.Name = "ibm_fez"
+			_, held, err = r.holdForSession(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(held).To(BeTrue())
+			Expect(job.Status.Phase).To(Equal(PhaseFailed))
+			Expect(job.Status.Message).To(ContainSubstring("session shared runs jobs on ibm_torino"))
+
+			By("failing jobs of an expired session")
+			job.Spec.Backend.Name = "ibm_torino"
+			session.Status.Phase = SessionPhaseExpired
+			session.Status.Message = "Session expired after 2h0m0s"
+			Expect(c.Status().Update(ctx, session)).To(Succeed())
+			_, held, err = r.holdForSession(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(held).To(BeTrue())
+			Expect(job.Status.Message).To(ContainSubstring("Session shared is expired"))
 
-			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: typeNamespacedName,
-			})
+			By("leaving jobs alone whose session name has no QiskitSession")
+			job.Spec.Session.Name = "executor-managed"
+			job.Status.SessionID = ""
+			_, held, err = r.holdForSession(ctx, job)
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+			Expect(held).To(BeFalse())
+			Expect(job.Status.SessionID).To(BeEmpty())
 		})
 	})
 })
@@ -0,0 +1,377 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/lineage"
+)
+
+// Labels of the jobs of workflow steps
+const (
+	// WorkflowLabel names the QiskitWorkflow a job is a step of
+	WorkflowLabel = "quantum.io/workflow"
+	// WorkflowStepLabel names the step of the workflow a job runs
+	WorkflowStepLabel = "quantum.io/workflow-step"
+)
+
+// QiskitWorkflowReconciler runs the steps of QiskitWorkflows as QiskitJobs,
+// each once the steps it depends on have completed
+type QiskitWorkflowReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Recorder records events on workflows, like started steps
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitworkflows,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitworkflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch;create
+
+// Reconcile brings the workflow's steps up to date with their jobs, starts
+// the steps whose dependencies have completed and skips those with a
+// dependency that failed. The workflow finishes once no step can make
+// progress; it is left alone after that.
+func (r *QiskitWorkflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var workflow quantumv1.QiskitWorkflow
+	if err := r.Get(ctx, req.NamespacedName, &workflow); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !workflow.DeletionTimestamp.IsZero() || workflow.Status.CompletionTime != nil {
+		return ctrl.Result{}, nil
+	}
+
+	status := &workflow.Status
+	now := metav1.Now()
+	if status.StartTime == nil {
+		status.StartTime = &now
+	}
+	order, err := workflowOrder(workflow.Spec.Steps)
+	if err != nil {
+		status.Phase = quantumv1.WorkflowFailed
+		status.Message = fmt.Sprintf("Invalid workflow: %v", err)
+		status.CompletionTime = &now
+		return ctrl.Result{}, r.Status().Update(ctx, &workflow)
+	}
+
+	steps := map[string]*quantumv1.WorkflowStepStatus{}
+	for _, previous := range status.Steps {
+		steps[previous.Name] = previous.DeepCopy()
+	}
+	for _, i := range order {
+		step := &workflow.Spec.Steps[i]
+		if steps[step.Name] == nil {
+			steps[step.Name] = &quantumv1.WorkflowStepStatus{Name: step.Name, Phase: quantumv1.WorkflowPending}
+		}
+		if err := r.syncStep(ctx, &workflow, step, steps); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	status.Steps = make([]quantumv1.WorkflowStepStatus, 0, len(workflow.Spec.Steps))
+	var running, failed []string
+	for _, step := range workflow.Spec.Steps {
+		s := steps[step.Name]
+		status.Steps = append(status.Steps, *s)
+		switch s.Phase {
+		case quantumv1.WorkflowPending, quantumv1.WorkflowRunning:
+			running = append(running, s.Name)
+		case quantumv1.WorkflowFailed:
+			failed = append(failed, s.Name)
+		}
+	}
+	switch {
+	case len(running) > 0:
+		status.Phase = quantumv1.WorkflowRunning
+		status.Message = fmt.Sprintf("Waiting for steps %s", strings.Join(running, ", "))
+	case len(failed) > 0:
+		status.Phase = quantumv1.WorkflowFailed
+		status.Message = fmt.Sprintf("Steps %s failed", strings.Join(failed, ", "))
+		status.CompletionTime = &now
+		r.event(&workflow, corev1.EventTypeWarning, "WorkflowFailed", status.Message)
+	default:
+		status.Phase = quantumv1.WorkflowSucceeded
+		status.Message = "All steps completed"
+		status.CompletionTime = &now
+		r.event(&workflow, corev1.EventTypeNormal, "WorkflowSucceeded", status.Message)
+	}
+	return ctrl.Result{}, r.Status().Update(ctx, &workflow)
+}
+
+// workflowOrder validates the dependencies of the steps and returns their
+// indexes in an order that puts every step after those it depends on
+func workflowOrder(steps []quantumv1.WorkflowStep) ([]int, error) {
+	index := map[string]int{}
+	for i, step := range steps {
+		if _, ok := index[step.Name]; ok {
+			return nil, fmt.Errorf("step %s is defined twice", step.Name)
+		}
+		index[step.Name] = i
+	}
+	for _, step := range steps {
+		for _, dep := range step.DependsOn {
+			if _, ok := index[dep]; !ok {
+				return nil, fmt.Errorf("step %s depends on unknown step %s", step.Name, dep)
+			}
+		}
+		for _, source := range step.ResultsFrom {
+			if !slices.Contains(step.DependsOn, source) {
+				return nil, fmt.Errorf("step %s takes the results of %s, which it does not depend on", step.Name, source)
+			}
+		}
+	}
+
+	// Depth-first, so a step is ordered once all of its dependencies are
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(steps))
+	order := make([]int, 0, len(steps))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visiting:
+			return fmt.Errorf("step %s depends on itself", steps[i].Name)
+		case visited:
+			return nil
+		}
+		state[i] = visiting
+		for _, dep := range steps[i].DependsOn {
+			if err := visit(index[dep]); err != nil {
+				return err
+			}
+		}
+		state[i] = visited
+		order = append(order, i)
+		return nil
+	}
+	for i := range steps {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// syncStep updates the status of a step that has not finished from its job,
+// or creates the job once all steps it depends on have completed. A job
+// deleted before it finished is created again. The statuses of the steps it
+// depends on are already up to date.
+func (r *QiskitWorkflowReconciler) syncStep(ctx context.Context, workflow *quantumv1.QiskitWorkflow,
+	step *quantumv1.WorkflowStep, steps map[string]*quantumv1.WorkflowStepStatus) error {
+	s := steps[step.Name]
+	switch s.Phase {
+	case quantumv1.WorkflowSucceeded, quantumv1.WorkflowFailed, quantumv1.WorkflowSkipped:
+		return nil
+	}
+
+	var job quantumv1.QiskitJob
+	name := workflowJobName(workflow, step)
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: workflow.Namespace}, &job)
+	switch {
+	case err == nil:
+		finishStep(workflow, s, &job)
+		return nil
+	case !apierrors.IsNotFound(err):
+		return err
+	}
+
+	for _, dep := range step.DependsOn {
+		if phase := steps[dep].Phase; phase == quantumv1.WorkflowFailed || phase == quantumv1.WorkflowSkipped {
+			s.Phase = quantumv1.WorkflowSkipped
+			s.Message = fmt.Sprintf("Step %s did not succeed", dep)
+			return nil
+		}
+	}
+	for _, dep := range step.DependsOn {
+		if steps[dep].Phase != quantumv1.WorkflowSucceeded {
+			s.Phase = quantumv1.WorkflowPending
+			s.Message = fmt.Sprintf("Waiting for step %s", dep)
+			return nil
+		}
+	}
+
+	created, err := r.stepJob(ctx, workflow, step, steps)
+	if err != nil {
+		return err
+	}
+	// The job may be missing from the cache right after it was created
+	if err := r.Create(ctx, created); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	log.FromContext(ctx).Info("Started workflow step", "step", step.Name, "job", created.Name)
+	s.Phase = quantumv1.WorkflowRunning
+	s.JobName = created.Name
+	s.Message = ""
+	r.event(workflow, corev1.EventTypeNormal, "StepStarted", fmt.Sprintf("Started step %s as %s", step.Name, created.Name))
+	return nil
+}
+
+// finishStep updates the status of a step from its job
+func finishStep(workflow *quantumv1.QiskitWorkflow, s *quantumv1.WorkflowStepStatus, job *quantumv1.QiskitJob) {
+	s.JobName = job.Name
+	switch {
+	case !metav1.IsControlledBy(job, workflow):
+		s.Phase = quantumv1.WorkflowFailed
+		s.Message = fmt.Sprintf("QiskitJob %s exists and does not belong to the workflow", job.Name)
+	case job.Status.Phase == PhaseCompleted:
+		s.Phase = quantumv1.WorkflowSucceeded
+		s.Message = ""
+		if job.Status.Results != nil {
+			s.ResultsLocation = job.Status.Results.Location
+		}
+	case !cancellable(job):
+		s.Phase = quantumv1.WorkflowFailed
+		s.Message = fmt.Sprintf("QiskitJob %s is %s: %s", job.Name, job.Status.Phase, job.Status.Message)
+	default:
+		s.Phase = quantumv1.WorkflowRunning
+		s.Message = ""
+		return
+	}
+	s.CompletionTime = job.Status.CompletionTime.DeepCopy()
+	if s.CompletionTime == nil {
+		now := metav1.Now()
+		s.CompletionTime = &now
+	}
+}
+
+// workflowJobName returns the name of the job of a step
+func workflowJobName(workflow *quantumv1.QiskitWorkflow, step *quantumv1.WorkflowStep) string {
+	return workflow.Name + "-" + step.Name
+}
+
+// workflowEnvName returns the variable passing the results of a step to the
+// steps that take them
+func workflowEnvName(step, suffix string) string {
+	return "WORKFLOW_STEP_" + strings.ToUpper(strings.ReplaceAll(step, "-", "_")) + "_" + suffix
+}
+
+// stepJob builds the job of a step from its template, passing it the
+// results of the steps in resultsFrom
+func (r *QiskitWorkflowReconciler) stepJob(ctx context.Context, workflow *quantumv1.QiskitWorkflow,
+	step *quantumv1.WorkflowStep, steps map[string]*quantumv1.WorkflowStepStatus) (*quantumv1.QiskitJob, error) {
+	template := &step.Template
+	job := &quantumv1.QiskitJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        workflowJobName(workflow, step),
+			Namespace:   workflow.Namespace,
+			Labels:      maps.Clone(template.Metadata.Labels),
+			Annotations: maps.Clone(template.Metadata.Annotations),
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[WorkflowLabel] = workflow.Name
+	job.Labels[WorkflowStepLabel] = step.Name
+	var upstream []string
+	for _, dependency := range step.DependsOn {
+		if status := steps[dependency]; status != nil && status.JobName != "" {
+			upstream = append(upstream, status.JobName)
+		}
+	}
+	if len(upstream) > 0 {
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		job.Annotations[lineage.DependsOnAnnotation] = strings.Join(upstream, ",")
+	}
+
+	var env []corev1.EnvVar
+	for _, source := range step.ResultsFrom {
+		if location := steps[source].ResultsLocation; location != "" {
+			env = append(env, corev1.EnvVar{Name: workflowEnvName(source, "RESULTS"), Value: location})
+		}
+		// Uncompressed documents in a single ConfigMap can be read as is
+		var sourceJob quantumv1.QiskitJob
+		if err := r.Get(ctx, types.NamespacedName{Name: steps[source].JobName, Namespace: workflow.Namespace}, &sourceJob); err != nil {
+			return nil, err
+		}
+		output := readableOutput(&sourceJob)
+		if output == nil {
+			continue
+		}
+		env = append(env, corev1.EnvVar{
+			Name: workflowEnvName(source, "DOCUMENT"),
+			ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: output.Location},
+				Key:                  results.ResultsKey,
+			}},
+		})
+	}
+	// Passed results replace variables of the same name from the template
+	job.Spec.Execution.Env = slices.DeleteFunc(job.Spec.Execution.Env, func(existing corev1.EnvVar) bool {
+		return slices.ContainsFunc(env, func(passed corev1.EnvVar) bool { return passed.Name == existing.Name })
+	})
+	job.Spec.Execution.Env = append(job.Spec.Execution.Env, env...)
+
+	if err := controllerutil.SetControllerReference(workflow, job, r.Scheme); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// readableOutput returns the first output of a job holding its results
+// document in a single uncompressed ConfigMap, or nil if it has none
+func readableOutput(job *quantumv1.QiskitJob) *quantumv1.OutputSpec {
+	for i := range job.Spec.Outputs {
+		output := &job.Spec.Outputs[i]
+		if output.Type == "configmap" && output.Location != "" &&
+			(output.Compression == "" || output.Compression == "none") && output.ShardSize == 0 {
+			return output
+		}
+	}
+	return nil
+}
+
+// event records an event on the workflow, if the reconciler has a recorder
+func (r *QiskitWorkflowReconciler) event(workflow *quantumv1.QiskitWorkflow, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(workflow, eventType, reason, message)
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QiskitWorkflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&quantumv1.QiskitWorkflow{}).
+		Owns(&quantumv1.QiskitJob{}).
+		Named("qiskitworkflow").
+		Complete(r)
+}
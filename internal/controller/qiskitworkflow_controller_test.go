@@ -0,0 +1,176 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/pkg/lineage"
+)
+
+var _ = Describe("QiskitWorkflow Controller", func() {
+	ctx := context.Background()
+
+	step := func(name string, dependsOn ...string) quantumv1.WorkflowStep {
+		job := builder.NewBellStateJob(name, "default").Build()
+		return quantumv1.WorkflowStep{
+			Name:      name,
+			DependsOn: dependsOn,
+			Template:  quantumv1.QiskitJobTemplateSource{Spec: job.Spec},
+		}
+	}
+
+	newWorkflow := func(steps ...quantumv1.WorkflowStep) *quantumv1.QiskitWorkflow {
+		return &quantumv1.QiskitWorkflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "vqe", Namespace: "default", UID: types.UID("vqe-uid")},
+			Spec:       quantumv1.QiskitWorkflowSpec{Steps: steps},
+		}
+	}
+
+	newClient := func(objects ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(objects...).
+			WithStatusSubresource(&quantumv1.QiskitWorkflow{}, &quantumv1.QiskitJob{}).Build()
+	}
+
+	run := func(c client.Client, workflow *quantumv1.QiskitWorkflow) *quantumv1.QiskitWorkflow {
+		r := &QiskitWorkflowReconciler{Client: c, Scheme: c.Scheme()}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(workflow)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(workflow), workflow)).To(Succeed())
+		return workflow
+	}
+
+	stepJob := func(c client.Client, name string) (*quantumv1.QiskitJob, error) {
+		job := &quantumv1.QiskitJob{}
+		err := c.Get(ctx, types.NamespacedName{Name: "vqe-" + name, Namespace: "default"}, job)
+		return job, err
+	}
+
+	finish := func(c client.Client, name string, phase quantumv1.QiskitJobPhase) {
+		job, err := stepJob(c, name)
+		Expect(err).NotTo(HaveOccurred())
+		job.Status.Phase = phase
+		if phase == PhaseCompleted {
+			job.Status.Results = &quantumv1.ResultsInfo{Location: "configmap://default/" + name + "-results"}
+		}
+		Expect(c.Status().Update(ctx, job)).To(Succeed())
+	}
+
+	phases := func(workflow *quantumv1.QiskitWorkflow) map[string]string {
+		result := map[string]string{}
+		for _, s := range workflow.Status.Steps {
+			result[s.Name] = s.Phase
+		}
+		return result
+	}
+
+	It("should start steps once their dependencies complete and pass their results", func() {
+		sample := step("sample")
+		sample.Template.Spec.Outputs = []quantumv1.OutputSpec{{Type: "configmap", Location: "sample-results"}}
+		estimate := step("estimate", "sample")
+		estimate.ResultsFrom = []string{"sample"}
+		estimate.Template.Metadata.Labels = map[string]string{"quantum.io/experiment": "vqe"}
+		workflow := newWorkflow(estimate, sample, step("baseline"))
+		c := newClient(workflow)
+
+		workflow = run(c, workflow)
+		Expect(workflow.Status.Phase).To(Equal(quantumv1.WorkflowRunning))
+		Expect(phases(workflow)).To(Equal(map[string]string{
+			"estimate": quantumv1.WorkflowPending,
+			"sample":   quantumv1.WorkflowRunning,
+			"baseline": quantumv1.WorkflowRunning,
+		}))
+		Expect(workflow.Status.StartTime).NotTo(BeNil())
+		_, err := stepJob(c, "estimate")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		job, err := stepJob(c, "sample")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(job.Labels).To(HaveKeyWithValue(WorkflowStepLabel, "sample"))
+		Expect(job.Annotations).NotTo(HaveKey(lineage.DependsOnAnnotation))
+		Expect(metav1.IsControlledBy(job, workflow)).To(BeTrue())
+
+		finish(c, "sample", PhaseCompleted)
+		workflow = run(c, workflow)
+		Expect(phases(workflow)).To(HaveKeyWithValue("sample", quantumv1.WorkflowSucceeded))
+		Expect(phases(workflow)).To(HaveKeyWithValue("estimate", quantumv1.WorkflowRunning))
+		Expect(workflow.Status.Steps[1].ResultsLocation).To(Equal("configmap://default/sample-results"))
+
+		job, err = stepJob(c, "estimate")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(job.Labels).To(HaveKeyWithValue("quantum.io/experiment", "vqe"))
+		Expect(job.Annotations).To(HaveKeyWithValue(lineage.DependsOnAnnotation, "vqe-sample"))
+		Expect(job.Spec.Execution.Env).To(ContainElement(corev1.EnvVar{
+			Name: "WORKFLOW_STEP_SAMPLE_RESULTS", Value: "configmap://default/sample-results",
+		}))
+		Expect(job.Spec.Execution.Env).To(ContainElement(HaveField("ValueFrom.ConfigMapKeyRef", And(
+			HaveField("Name", "sample-results"), HaveField("Key", "results.json"),
+		))))
+
+		finish(c, "estimate", PhaseCompleted)
+		finish(c, "baseline", PhaseCompleted)
+		workflow = run(c, workflow)
+		Expect(workflow.Status.Phase).To(Equal(quantumv1.WorkflowSucceeded))
+		Expect(workflow.Status.CompletionTime).NotTo(BeNil())
+	})
+
+	It("should skip the dependents of a failed step and run the others", func() {
+		workflow := newWorkflow(step("sample"), step("estimate", "sample"), step("report", "estimate"), step("baseline"))
+		c := newClient(workflow)
+
+		workflow = run(c, workflow)
+		finish(c, "sample", PhaseCancelled)
+		workflow = run(c, workflow)
+		Expect(workflow.Status.Phase).To(Equal(quantumv1.WorkflowRunning))
+		Expect(phases(workflow)).To(Equal(map[string]string{
+			"sample":   quantumv1.WorkflowFailed,
+			"estimate": quantumv1.WorkflowSkipped,
+			"report":   quantumv1.WorkflowSkipped,
+			"baseline": quantumv1.WorkflowRunning,
+		}))
+
+		finish(c, "baseline", PhaseCompleted)
+		workflow = run(c, workflow)
+		Expect(workflow.Status.Phase).To(Equal(quantumv1.WorkflowFailed))
+		Expect(workflow.Status.Message).To(ContainSubstring("sample"))
+		_, err := stepJob(c, "estimate")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail workflows whose dependencies form a cycle", func() {
+		workflow := newWorkflow(step("sample", "estimate"), step("estimate", "sample"))
+		c := newClient(workflow)
+
+		workflow = run(c, workflow)
+		Expect(workflow.Status.Phase).To(Equal(quantumv1.WorkflowFailed))
+		Expect(workflow.Status.Message).To(ContainSubstring("depends on itself"))
+		var jobs quantumv1.QiskitJobList
+		Expect(c.List(ctx, &jobs)).To(Succeed())
+		Expect(jobs.Items).To(BeEmpty())
+	})
+})
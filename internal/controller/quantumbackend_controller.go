@@ -0,0 +1,212 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+)
+
+// defaultProbeInterval is how often QuantumBackends are probed when the API
+// server did not default spec.probeInterval
+const defaultProbeInterval = 5 * time.Minute
+
+// QuantumBackendReconciler probes the availability, queue and calibration
+// of registered backends into their status
+type QuantumBackendReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// WithoutSecrets is set when the operator runs without Secret access, so
+	// ibm_quantum backends cannot be probed
+	WithoutSecrets bool
+
+	// IBM overrides the endpoints IBM Quantum backends are probed at
+	IBM ibm.Options
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumbackends,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumbackends/status,verbs=get;update;patch
+
+// Reconcile probes the backend and requeues after its probe interval. The
+// status is only written when the probe changed it.
+func (r *QuantumBackendReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+
+	var registered quantumv1.QuantumBackend
+	if err := r.Get(ctx, req.NamespacedName, &registered); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	interval := defaultProbeInterval
+	if registered.Spec.ProbeInterval != nil && registered.Spec.ProbeInterval.Duration > 0 {
+		interval = registered.Spec.ProbeInterval.Duration
+	}
+
+	written := registered.Status.DeepCopy()
+	condition := r.probe(ctx, &registered)
+	if condition.Status == metav1.ConditionFalse {
+		logger.Info("Backend unavailable", "backend", registered.Name, "reason", condition.Reason, "message", condition.Message)
+	}
+	condition.ObservedGeneration = registered.Generation
+	meta.SetStatusCondition(&registered.Status.Conditions, condition)
+	registered.Status.ObservedGeneration = registered.Generation
+	probed := registered.Status.DeepCopy()
+	probed.LastProbeTime = written.LastProbeTime
+	if equality.Semantic.DeepEqual(written, probed) {
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	now := metav1.Now()
+	registered.Status.LastProbeTime = &now
+	if err := r.Status().Update(ctx, &registered); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// probe refreshes the backend's status from its provider and returns its
+// Available condition: True while it accepts jobs, False while it is offline
+// or cannot be probed, and Unknown for backend types the operator does not
+// probe
+func (r *QuantumBackendReconciler) probe(ctx context.Context, registered *quantumv1.QuantumBackend) metav1.Condition {
+	switch registered.Spec.Backend.Type {
+	case "local_simulator", "ibm_local_testing":
+		// Simulators run in the cluster and are available with it
+		registered.Status.QueueLength = nil
+		return metav1.Condition{Type: backendref.ConditionAvailable, Status: metav1.ConditionTrue,
+			Reason: "InCluster", Message: "Simulated in execution pods of the cluster"}
+	case "ibm_quantum":
+	default:
+		return metav1.Condition{Type: backendref.ConditionAvailable, Status: metav1.ConditionUnknown,
+			Reason: "ProbeNotSupported", Message: fmt.Sprintf("%s backends are not probed", registered.Spec.Backend.Type)}
+	}
+
+	adapter, err := r.ibmBackend(ctx, registered)
+	if err != nil {
+		return metav1.Condition{Type: backendref.ConditionAvailable, Status: metav1.ConditionFalse,
+			Reason: "ProbeFailed", Message: err.Error()}
+	}
+	available, err := adapter.IsAvailable(ctx)
+	if err != nil {
+		return metav1.Condition{Type: backendref.ConditionAvailable, Status: metav1.ConditionFalse,
+			Reason: "ProbeFailed", Message: err.Error()}
+	}
+	if queue, err := adapter.GetQueueStatus(ctx); err == nil {
+		registered.Status.QueueLength = ptr(int32(queue.QueueLength))
+	}
+	if capabilities, err := adapter.GetCapabilities(ctx); err == nil {
+		registered.Status.Qubits = int32(capabilities.MaxQubits)
+	}
+	if calibration, err := adapter.GetCalibration(ctx); err == nil {
+		registered.Status.Calibration = backendCalibration(calibration)
+	}
+	if !available {
+		return metav1.Condition{Type: backendref.ConditionAvailable, Status: metav1.ConditionFalse,
+			Reason: "DeviceOffline", Message: fmt.Sprintf("%s is not accepting jobs", registered.Spec.Backend.Name)}
+	}
+	return metav1.Condition{Type: backendref.ConditionAvailable, Status: metav1.ConditionTrue,
+		Reason: "DeviceOnline", Message: fmt.Sprintf("%s is accepting jobs", registered.Spec.Backend.Name)}
+}
+
+// ibmBackend returns an adapter for the registered device authenticated
+// with its credentials, whose Secret must name its namespace
+func (r *QuantumBackendReconciler) ibmBackend(ctx context.Context, registered *quantumv1.QuantumBackend) (*ibm.Backend, error) {
+	spec := registered.Spec
+	if spec.Credentials == nil || spec.Credentials.SecretRef == nil {
+		return nil, errors.New("ibm_quantum backends require spec.credentials.secretRef with an api-key")
+	}
+	ref := spec.Credentials.SecretRef
+	if ref.Namespace == "" {
+		return nil, errors.New("spec.credentials.secretRef of a QuantumBackend must name its namespace")
+	}
+	if r.WithoutSecrets {
+		return nil, errors.New("ibm_quantum credentials need Secret access, which the operator runs without")
+	}
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, &secret); err != nil {
+		return nil, err
+	}
+
+	instance := spec.Backend.Instance
+	if instance == "" {
+		instance = string(secret.Data["instance"])
+	}
+	if !strings.HasPrefix(instance, "crn:") {
+		return nil, errors.New("ibm_quantum backends require the IBM Cloud CRN of a Qiskit Runtime instance in spec.backend.instance")
+	}
+	if spec.Backend.Name == "" {
+		return nil, errors.New("ibm_quantum backends require the device in spec.backend.name")
+	}
+
+	opts := r.IBM
+	if opts.URL == "" {
+		opts.URL = ibm.URL(spec.Backend.Region)
+	}
+	adapter := ibm.New(spec.Backend.Name, instance, opts)
+	if err := adapter.Authenticate(ctx, &backend.Credentials{APIKey: string(secret.Data["api-key"]), Instance: instance}); err != nil {
+		return nil, err
+	}
+	return adapter, nil
+}
+
+// backendCalibration converts a provider's calibration summary to the API's
+func backendCalibration(calibration *backend.Calibration) *quantumv1.BackendCalibration {
+	result := &quantumv1.BackendCalibration{
+		MedianReadoutError:  calibration.MedianReadoutError,
+		MedianTwoQubitError: calibration.MedianTwoQubitError,
+	}
+	if !calibration.LastUpdated.IsZero() {
+		result.LastUpdated = &metav1.Time{Time: calibration.LastUpdated}
+	}
+	if calibration.MedianT1 > 0 {
+		result.MedianT1 = calibration.MedianT1.String()
+	}
+	if calibration.MedianT2 > 0 {
+		result.MedianT2 = calibration.MedianT2.String()
+	}
+	return result
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QuantumBackendReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Backends are probed on their interval; status updates of their own
+		// need no reconciliation
+		For(&quantumv1.QuantumBackend{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("quantumbackend").
+		Complete(r)
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/ibm"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/hints"
+	"github.com/quantum-operator/qiskit-operator/pkg/queue"
+)
+
+var _ = Describe("QuantumBackend Controller", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	registered := func(name, backendType string, labels map[string]string) *quantumv1.QuantumBackend {
+		return &quantumv1.QuantumBackend{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Spec: quantumv1.QuantumBackendSpec{
+				Backend: quantumv1.BackendSpec{Type: backendType, Name: name},
+			},
+		}
+	}
+
+	// available marks the backend available with the given queue
+	available := func(qb *quantumv1.QuantumBackend, queue int32) *quantumv1.QuantumBackend {
+		qb.Status.QueueLength = ptr(queue)
+		meta.SetStatusCondition(&qb.Status.Conditions, metav1.Condition{
+			Type: backendref.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "DeviceOnline"})
+		return qb
+	}
+
+	probe := func(c client.Client, r *QuantumBackendReconciler, name string) *quantumv1.QuantumBackend {
+		r.Client = c
+		r.Scheme = c.Scheme()
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		var qb quantumv1.QuantumBackend
+		Expect(c.Get(ctx, types.NamespacedName{Name: name}, &qb)).To(Succeed())
+		Expect(qb.Status.LastProbeTime).NotTo(BeNil())
+		return &qb
+	}
+
+	It("should probe an IBM Quantum device's availability, queue and calibration", func() {
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /identity/token", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"access_token": "token-1", "expires_in": 3600}`))
+		})
+		mux.HandleFunc("GET /api/v1/backends/ibm_torino/status", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"state": true, "status": "active", "length_queue": 12}`))
+		})
+		mux.HandleFunc("GET /api/v1/backends/ibm_torino/configuration", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"n_qubits": 133, "max_shots": 100000}`))
+		})
+		mux.HandleFunc("GET /api/v1/backends/ibm_torino/properties", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"last_update_date": "2025-06-01T08:00:00Z", "qubits": [` +
+				`[{"name": "T1", "unit": "us", "value": 150}, {"name": "readout_error", "value": 0.02}]], ` +
+				`"gates": [{"gate": "cz", "qubits": [0, 1], "parameters": [{"name": "gate_error", "value": 0.005}]}]}`))
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		qb := registered("ibm_torino", "ibm_quantum", nil)
+		qb.Spec.Backend.Instance = "crn:v1:bluemix:public:quantum-computing:us-east:a/abc:def::"
+		qb.Spec.Credentials = &quantumv1.CredentialsSpec{
+			SecretRef: &quantumv1.SecretRef{Name: "ibm", Namespace: "quantum-system"}}
+		qb.Spec.ProbeInterval = &metav1.Duration{Duration: time.Minute}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ibm", Namespace: "quantum-system"},
+			Data:       map[string][]byte{"api-key": []byte("secret")},
+		}
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(qb, secret).
+			WithStatusSubresource(&quantumv1.QuantumBackend{}).Build()
+		r := &QuantumBackendReconciler{IBM: ibm.Options{URL: server.URL + "/api", IAMURL: server.URL + "/identity/token"}}
+
+		probed := probe(c, r, "ibm_torino")
+		Expect(meta.IsStatusConditionTrue(probed.Status.Conditions, backendref.ConditionAvailable)).To(BeTrue())
+		Expect(*probed.Status.QueueLength).To(Equal(int32(12)))
+		Expect(probed.Status.Qubits).To(Equal(int32(133)))
+		Expect(probed.Status.Calibration).NotTo(BeNil())
+		Expect(probed.Status.Calibration.MedianT1).To(Equal("150µs"))
+		Expect(probed.Status.Calibration.MedianReadoutError).To(BeNumerically("~", 0.02, 1e-9))
+		Expect(probed.Status.Calibration.MedianTwoQubitError).To(BeNumerically("~", 0.005, 1e-9))
+
+		By("reporting a probe that cannot authenticate")
+		r.WithoutSecrets = true
+		probed = probe(c, r, "ibm_torino")
+		condition := meta.FindStatusCondition(probed.Status.Conditions, backendref.ConditionAvailable)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("ProbeFailed"))
+	})
+
+	It("should report simulators available and not probe other providers", func() {
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithObjects(registered("aer", "local_simulator", nil), registered("aria", "aws_braket", nil)).
+			WithStatusSubresource(&quantumv1.QuantumBackend{}).Build()
+		r := &QuantumBackendReconciler{}
+
+		Expect(meta.IsStatusConditionTrue(probe(c, r, "aer").Status.Conditions, backendref.ConditionAvailable)).To(BeTrue())
+		condition := meta.FindStatusCondition(probe(c, r, "aria").Status.Conditions, backendref.ConditionAvailable)
+		Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal("ProbeNotSupported"))
+
+		By("not writing probes that found nothing new")
+		aer := probe(c, r, "aer")
+		Expect(probe(c, r, "aer").ResourceVersion).To(Equal(aer.ResourceVersion))
+	})
+
+	Context("when jobs reference registered backends", func() {
+		production := map[string]string{"tier": "production"}
+
+		// resolve creates the job and reconciles it until its backend
+		// reference is resolved, or the job is failed or held
+		resolve := func(job *quantumv1.QiskitJob, objects ...client.Object) (*quantumv1.QiskitJob, ctrl.Result) {
+			job.Status.Phase = PhasePending
+			c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(append(objects, job)...).
+				WithStatusSubresource(&quantumv1.QiskitJob{}, &quantumv1.QuantumBackend{}).Build()
+			r := &QiskitJobReconciler{Client: c, Scheme: c.Scheme()}
+			var current quantumv1.QiskitJob
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(job), &current)).To(Succeed())
+			result, done, err := r.resolveBackendRef(ctx, &current)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(job), &current)).To(Succeed())
+			return &current, result
+		}
+
+		It("should copy the named backend and its credentials into the job", func() {
+			qb := registered("ibm_torino", "ibm_quantum", nil)
+			qb.Spec.Credentials = &quantumv1.CredentialsSpec{
+				SecretRef: &quantumv1.SecretRef{Name: "ibm", Namespace: "quantum-system"}}
+			qb.Spec.AllowedNamespaces = []string{"default"}
+			job, _ := resolve(builder.NewBellStateJob("named", "default").WithBackendRef("ibm_torino").Build(), qb)
+
+			Expect(job.Status.Phase).To(Equal(PhasePending))
+			Expect(job.Annotations).To(HaveKeyWithValue(backendref.AppliedAnnotation, "ibm_torino"))
+			Expect(job.Spec.Backend.Type).To(Equal("ibm_quantum"))
+			Expect(job.Spec.Backend.Name).To(Equal("ibm_torino"))
+			Expect(job.Spec.Credentials.SecretRef.Namespace).To(Equal("quantum-system"))
+		})
+
+		It("should pick the available backend with the shortest queue unless one is preferred", func() {
+			objects := []client.Object{
+				available(registered("ibm_torino", "ibm_quantum", production), 30),
+				available(registered("ibm_fez", "ibm_quantum", production), 4),
+				registered("ibm_marrakesh", "ibm_quantum", production),
+				available(registered("ibm_kyiv", "ibm_quantum", nil), 0),
+			}
+			job, _ := resolve(builder.NewBellStateJob("shortest", "default").WithBackendSelector(production).Build(), objects...)
+			Expect(job.Spec.Backend.Name).To(Equal("ibm_fez"))
+
+			preferring := builder.NewBellStateJob("preferring", "default").WithBackendSelector(production).Build()
+			preferring.Spec.BackendSelection = &quantumv1.BackendSelectionSpec{
+				PreferredBackends: []string{"ibm_marrakesh", "ibm_torino"}}
+			job, _ = resolve(preferring, objects...)
+			Expect(job.Spec.Backend.Name).To(Equal("ibm_torino"), "unavailable backends are skipped")
+
+			excluding := builder.NewBellStateJob("excluding", "default").WithBackendSelector(production).Build()
+			excluding.Spec.BackendSelection = &quantumv1.BackendSelectionSpec{ExcludedBackends: []string{"ibm_fez"}}
+			job, _ = resolve(excluding, objects...)
+			Expect(job.Spec.Backend.Name).To(Equal("ibm_torino"))
+		})
+
+		It("should hold jobs while no selected backend is available", func() {
+			job, result := resolve(builder.NewBellStateJob("waiting", "default").WithBackendSelector(production).Build(),
+				registered("ibm_marrakesh", "ibm_quantum", production))
+			Expect(result.RequeueAfter).To(Equal(backendRefRetryInterval))
+			Expect(job.Status.Phase).To(Equal(PhasePending))
+			Expect(job.Status.Message).To(Equal(backendref.ErrNoneAvailable.Error()))
+			Expect(backendref.Applied(job)).To(BeFalse())
+		})
+
+		It("should fail jobs referencing missing backends or exceeding their limits", func() {
+			job, _ := resolve(builder.NewBellStateJob("missing", "default").WithBackendRef("ibm_nowhere").Build())
+			Expect(job.Status.Phase).To(Equal(PhaseFailed))
+			Expect(job.Status.Message).To(ContainSubstring(`QuantumBackend "ibm_nowhere" not found`))
+
+			qb := registered("ibm_torino", "ibm_quantum", nil)
+			qb.Spec.Limits = &quantumv1.QuantumBackendLimits{MaxShots: 1000}
+			job, _ = resolve(builder.NewBellStateJob("greedy", "default").WithBackendRef("ibm_torino").WithShots(4000).Build(), qb)
+			Expect(job.Status.Phase).To(Equal(PhaseFailed))
+			Expect(job.Status.Message).To(ContainSubstring("accepts at most 1000 shots"))
+		})
+	})
+	Context("when jobs select among candidate backends", func() {
+		// selecting returns an ibm_quantum job on ibm_torino for a circuit on
+		// the given number of qubits
+		selecting := func(name string, qubits int, selection *quantumv1.BackendSelectionSpec) *quantumv1.QiskitJob {
+			job := builder.NewBellStateJob(name, "default").WithBackend("ibm_quantum", "ibm_torino").Build()
+			job.Spec.BackendSelection = selection
+			job.Status.CircuitMetadata = &quantumv1.CircuitMetadata{Qubits: qubits}
+			return job
+		}
+		calibrated := func(qb *quantumv1.QuantumBackend, qubits int32, twoQubitError float64) *quantumv1.QuantumBackend {
+			qb.Status.Qubits = qubits
+			qb.Status.Calibration = &quantumv1.BackendCalibration{MedianTwoQubitError: twoQubitError}
+			return qb
+		}
+
+		It("should run on the best scoring candidate and record every score", func() {
+			c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(
+				calibrated(available(registered("ibm_torino", "ibm_quantum", nil), 30), 133, 0.004),
+				calibrated(available(registered("ibm_fez", "ibm_quantum", nil), 2), 156, 0.008),
+				available(registered("ibm_kyiv", "ibm_quantum", nil), 0),
+			).Build()
+			predictor := queue.NewPredictor(queue.DefaultWindow)
+			predictor.Observe("ibm_torino", 40*time.Minute)
+			predictor.Observe("ibm_fez", 5*time.Minute)
+			r := &QiskitJobReconciler{Client: c, Scheme: c.Scheme(), QueuePredictor: predictor}
+
+			job := selecting("fastest", 2, &quantumv1.BackendSelectionSpec{
+				Weights:           &quantumv1.BackendWeights{QueueTime: 0.8, Capability: 0.2},
+				PreferredBackends: []string{"ibm_fez", "ibm_kyiv"},
+				ExcludedBackends:  []string{"ibm_kyiv"},
+			})
+			message, err := r.selectBackend(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(message).To(BeEmpty())
+			Expect(device(job)).To(Equal("ibm_fez"))
+			scores := job.Status.BackendSelection.Scores
+			Expect(scores).To(HaveLen(2), "excluded backends are not scored")
+			Expect(scores[0].Backend).To(Equal("ibm_fez"))
+			Expect(scores[0].QueueTime).To(Equal(1.0))
+			Expect(scores[0].Capability).To(Equal(0.0))
+			Expect(scores[0].Total).To(Equal(0.8))
+			Expect(scores[1].Backend).To(Equal("ibm_torino"))
+			Expect(scores[1].Total).To(Equal(0.2))
+
+			By("running on the candidate an external scheduler targets")
+			job.Annotations = map[string]string{hints.TargetBackendAnnotation: "ibm_torino"}
+			message, err = r.selectBackend(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(message).To(BeEmpty())
+			Expect(device(job)).To(Equal("ibm_torino"))
+			Expect(job.Status.BackendSelection.Targeted).To(BeTrue())
+			Expect(job.Status.BackendSelection.Scores[0].Backend).To(Equal("ibm_fez"), "scores stay ranked")
+
+			job.Annotations[hints.TargetBackendAnnotation] = "ibm_kyiv"
+			message, err = r.selectBackend(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(message).To(ContainSubstring("is not a candidate backend"))
+
+			By("leaving out candidates with too few qubits")
+			job = selecting("wide", 140, &quantumv1.BackendSelectionSpec{
+				Weights:           &quantumv1.BackendWeights{Capability: 1},
+				PreferredBackends: []string{"ibm_fez"},
+			})
+			message, err = r.selectBackend(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(message).To(BeEmpty())
+			Expect(device(job)).To(Equal("ibm_fez"))
+			Expect(job.Status.BackendSelection.Scores[1].Message).To(Equal("has 133 qubits, the circuit needs 140"))
+
+			By("failing jobs no candidate can run")
+			job = selecting("too-wide", 200, &quantumv1.BackendSelectionSpec{PreferredBackends: []string{"ibm_fez"}})
+			message, err = r.selectBackend(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(message).To(HavePrefix("No candidate backend can run the circuit"))
+
+			job = selecting("excluded", 2, &quantumv1.BackendSelectionSpec{ExcludedBackends: []string{"ibm_torino"}})
+			message, err = r.selectBackend(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(message).To(ContainSubstring("excludedBackends"))
+		})
+
+		It("should keep spec.backend without a backend selection", func() {
+			r := &QiskitJobReconciler{Client: fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).Build()}
+			job := selecting("plain", 2, nil)
+			job.Status.BackendSelection = &quantumv1.BackendSelectionStatus{Backend: "ibm_fez"}
+			message, err := r.selectBackend(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(message).To(BeEmpty())
+			Expect(job.Status.BackendSelection).To(BeNil())
+			Expect(device(job)).To(Equal("ibm_torino"))
+		})
+	})
+})
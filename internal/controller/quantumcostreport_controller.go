@@ -0,0 +1,414 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// CostReportName is the name of the cluster's QuantumCostReport
+const CostReportName = "quantum-costs"
+
+// ConditionThresholdExceeded is True while a cost center has spent more
+// than its threshold in the billing period
+const ConditionThresholdExceeded = "ThresholdExceeded"
+
+// QuantumCostReportReconciler maintains the cluster's QuantumCostReport,
+// accounting the spend of QiskitJobs per namespace and cost center
+type QuantumCostReportReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Jobs lists the jobs of the cluster; nil lists them from the client
+	Jobs *JobLister
+
+	// Recorder records events on the report, like cost centers going over
+	// their threshold
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumcostreports,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumcostreports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumcostreports/finalizers,verbs=update
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile charges the QiskitJobs of the cluster that finished since it
+// last ran to the spend of the billing period they finished in, with their
+// actual cost, and records the charge on each job. The spend accumulates in
+// the report, so jobs deleted afterwards stay charged. When a new month
+// starts, the totals of the month before move to status.previous, where
+// jobs of that month that finish late are still charged.
+func (r *QuantumCostReportReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	if req.Name != CostReportName {
+		// Only the operator's own report is maintained
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	var report quantumv1.QuantumCostReport
+	err := r.Get(ctx, req.NamespacedName, &report)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	missing := apierrors.IsNotFound(err)
+
+	status := &report.Status
+	period := now.Format("2006-01")
+	wasOver := map[string]bool{}
+	if status.BillingPeriod == period {
+		for _, c := range status.CostCenters {
+			wasOver[c.CostCenter] = c.OverThreshold
+		}
+	} else if status.BillingPeriod != "" {
+		logger.Info("Closing billing period", "period", status.BillingPeriod, "spend", status.TotalSpend)
+		status.Previous = status.CostPeriod.DeepCopy()
+		status.CostPeriod = quantumv1.CostPeriod{}
+	}
+	status.BillingPeriod = period
+	tally := tallyOf(&status.CostPeriod)
+	var previous *costTally
+	if status.Previous != nil {
+		previous = tallyOf(status.Previous)
+	}
+
+	var charged []*quantumv1.QiskitJob
+	err = eachJob(ctx, r.Client, r.Jobs, func(job *quantumv1.QiskitJob) error {
+		if tally.add(job) || previous != nil && previous.add(job) {
+			charged = append(charged, job.DeepCopy())
+		}
+		return nil
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if missing {
+		if len(charged) == 0 {
+			// Nothing spent yet
+			return ctrl.Result{RequeueAfter: untilNextPeriod(now)}, nil
+		}
+		report.ObjectMeta = metav1.ObjectMeta{
+			Name:   CostReportName,
+			Labels: map[string]string{"app": "qiskit-operator"},
+		}
+		logger.Info("Creating cost report")
+		created := report.DeepCopy()
+		if err := r.Create(ctx, created); err != nil {
+			return ctrl.Result{}, err
+		}
+		report.ObjectMeta = created.ObjectMeta
+	}
+
+	status.CostPeriod = tally.summarize(report.Spec.Thresholds)
+	if previous != nil {
+		summary := previous.summarize(report.Spec.Thresholds)
+		status.Previous = &summary
+	}
+
+	var over []string
+	for _, c := range status.CostCenters {
+		if !c.OverThreshold {
+			continue
+		}
+		over = append(over, c.CostCenter)
+		if !wasOver[c.CostCenter] && r.Recorder != nil {
+			r.Recorder.Event(&report, corev1.EventTypeWarning, "CostThresholdExceeded",
+				fmt.Sprintf("Cost center %s spent %s in %s, over its threshold of %s", c.CostCenter, c.Spend, tally.period, c.Threshold))
+		}
+	}
+	condition := metav1.Condition{
+		Type:               ConditionThresholdExceeded,
+		Status:             metav1.ConditionFalse,
+		Reason:             "WithinThresholds",
+		Message:            fmt.Sprintf("No cost center is over its threshold in %s", tally.period),
+		ObservedGeneration: report.Generation,
+	}
+	if len(over) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "OverThreshold"
+		condition.Message = fmt.Sprintf("Over their threshold in %s: %s", tally.period, strings.Join(over, ", "))
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	updated := metav1.NewTime(now)
+	status.LastUpdated = &updated
+
+	if err := r.Status().Update(ctx, &report); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The charges are recorded on the jobs after the report; a job whose
+	// record fails is charged again, so chargeback errs on billing it twice
+	// rather than not at all. Every job is tried, so that one failure does
+	// not charge the jobs after it again.
+	var errs []error
+	for _, job := range charged {
+		if err := r.recordCharge(ctx, job); err != nil {
+			logger.Error(err, "Failed to record the charge on the job", "job", client.ObjectKeyFromObject(job))
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return ctrl.Result{}, errors.Join(errs...)
+	}
+	// Close the month even when no job changes as it ends
+	return ctrl.Result{RequeueAfter: untilNextPeriod(now)}, nil
+}
+
+// recordCharge records on the job the actual cost it was charged with,
+// retrying on conflicts with the job controller's own status writes
+func (r *QuantumCostReportReconciler) recordCharge(ctx context.Context, charged *quantumv1.QiskitJob) error {
+	key := client.ObjectKeyFromObject(charged)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var job quantumv1.QiskitJob
+		if err := r.Get(ctx, key, &job); err != nil {
+			return err
+		}
+		job.Status.ReportedCost = charged.Status.ActualCost
+		return r.Status().Update(ctx, &job)
+	})
+	return client.IgnoreNotFound(err)
+}
+
+// untilNextPeriod is how long until the billing period after now's starts
+func untilNextPeriod(now time.Time) time.Duration {
+	year, month, _ := now.Date()
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, now.Location()).Sub(now) + time.Second
+}
+
+// costTally accumulates the spend of a billing period one job at a time
+type costTally struct {
+	period            string
+	total, unassigned float64
+	namespaces        map[string]*costEntry
+	costCenters       map[string]*costEntry
+}
+
+// costEntry is the spend of a namespace or cost center
+type costEntry struct {
+	spend    float64
+	jobs     int
+	accounts map[string]bool
+}
+
+// tallyOf resumes the tally of the spend a report recorded for the period.
+// Amounts that fail to parse count as nothing.
+func tallyOf(period *quantumv1.CostPeriod) *costTally {
+	t := &costTally{
+		period:      period.BillingPeriod,
+		namespaces:  map[string]*costEntry{},
+		costCenters: map[string]*costEntry{},
+	}
+	t.total, _ = parseCost(period.TotalSpend)
+	t.unassigned, _ = parseCost(period.UnassignedSpend)
+	for _, ns := range period.Namespaces {
+		e := t.entry(t.namespaces, ns.Namespace)
+		e.spend, _ = parseCost(ns.Spend)
+		e.jobs = ns.Jobs
+	}
+	for _, c := range period.CostCenters {
+		e := t.entry(t.costCenters, c.CostCenter)
+		e.spend, _ = parseCost(c.Spend)
+		e.jobs = c.Jobs
+		for _, account := range c.BillingAccounts {
+			e.accounts[account] = true
+		}
+	}
+	return t
+}
+
+// add charges the part of the job's actual cost not charged yet if it
+// finished in the period, reporting whether it did
+func (t *costTally) add(job *quantumv1.QiskitJob) bool {
+	completion := job.Status.CompletionTime
+	if completion == nil || completion.Format("2006-01") != t.period || job.Status.ActualCost == job.Status.ReportedCost {
+		return false
+	}
+	// Costs that fail to parse are ignored rather than blocking the report
+	cost, err := parseCost(job.Status.ActualCost)
+	if err != nil {
+		return false
+	}
+	reported, _ := parseCost(job.Status.ReportedCost)
+	cost -= reported
+	// Jobs count once, the first time they are charged
+	first := job.Status.ReportedCost == ""
+	t.total += cost
+	t.entry(t.namespaces, job.Namespace).charge(cost, first, "")
+	if center := costCenter(job); center != "" {
+		t.entry(t.costCenters, center).charge(cost, first, billingAccount(job))
+	} else {
+		t.unassigned += cost
+	}
+	return true
+}
+
+// entry returns the entry of key, adding it if needed
+func (t *costTally) entry(entries map[string]*costEntry, key string) *costEntry {
+	e, ok := entries[key]
+	if !ok {
+		e = &costEntry{accounts: map[string]bool{}}
+		entries[key] = e
+	}
+	return e
+}
+
+// charge adds a job's cost, counting the job if it is charged for the first
+// time, billed to account if it names one
+func (e *costEntry) charge(cost float64, first bool, account string) {
+	e.spend += cost
+	if first {
+		e.jobs++
+	}
+	if account != "" {
+		e.accounts[account] = true
+	}
+}
+
+// summarize returns the spend of the period, flagging the cost centers over
+// their thresholds
+func (t *costTally) summarize(thresholds []quantumv1.CostCenterThreshold) quantumv1.CostPeriod {
+	period := quantumv1.CostPeriod{
+		BillingPeriod:   t.period,
+		TotalSpend:      formatCost(t.total),
+		UnassignedSpend: formatCost(t.unassigned),
+	}
+	for _, ns := range sortedKeys(t.namespaces) {
+		e := t.namespaces[ns]
+		period.Namespaces = append(period.Namespaces, quantumv1.NamespaceCost{
+			Namespace: ns, Spend: formatCost(e.spend), Jobs: e.jobs,
+		})
+	}
+	limits := map[string]string{}
+	for _, threshold := range thresholds {
+		limits[threshold.CostCenter] = threshold.Amount
+		// Cost centers with a threshold are reported before they spend
+		t.entry(t.costCenters, threshold.CostCenter)
+	}
+	for _, center := range sortedKeys(t.costCenters) {
+		e := t.costCenters[center]
+		c := quantumv1.CostCenterCost{CostCenter: center, Spend: formatCost(e.spend), Jobs: e.jobs}
+		for account := range e.accounts {
+			c.BillingAccounts = append(c.BillingAccounts, account)
+		}
+		sort.Strings(c.BillingAccounts)
+		if amount, ok := limits[center]; ok {
+			if limit, err := parseCost(amount); err == nil {
+				c.Threshold = formatCost(limit)
+				c.OverThreshold = e.spend > limit
+			}
+		}
+		period.CostCenters = append(period.CostCenters, c)
+	}
+	return period
+}
+
+// sortedKeys returns the keys of entries in order
+func sortedKeys(entries map[string]*costEntry) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// CostReportSpend reads the spend of the current billing period from the
+// cluster's QuantumCostReport for the metrics endpoint, nil before there is
+// one
+func CostReportSpend(ctx context.Context, c client.Reader) (*metrics.Spend, error) {
+	var report quantumv1.QuantumCostReport
+	if err := c.Get(ctx, types.NamespacedName{Name: CostReportName}, &report); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	spend := &metrics.Spend{
+		Namespaces:  map[string]float64{},
+		CostCenters: map[string]float64{},
+		Thresholds:  map[string]float64{},
+	}
+	if report.Status.BillingPeriod != time.Now().Format("2006-01") {
+		// The month closed and the report has yet to catch up
+		return spend, nil
+	}
+	for _, ns := range report.Status.Namespaces {
+		if cost, err := parseCost(ns.Spend); err == nil {
+			spend.Namespaces[ns.Namespace] = cost
+		}
+	}
+	for _, center := range report.Status.CostCenters {
+		if cost, err := parseCost(center.Spend); err == nil {
+			spend.CostCenters[center.CostCenter] = cost
+		}
+		if center.Threshold == "" {
+			continue
+		}
+		if limit, err := parseCost(center.Threshold); err == nil {
+			spend.Thresholds[center.CostCenter] = limit
+		}
+	}
+	return spend, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QuantumCostReportReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	report := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: CostReportName}}}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&quantumv1.QuantumCostReport{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&quantumv1.QiskitJob{}, handler.EnqueueRequestsFromMapFunc(
+			func(context.Context, client.Object) []reconcile.Request { return report }),
+			builder.WithPredicates(predicate.Funcs{UpdateFunc: costChanged})).
+		Named("quantumcostreport").
+		Complete(r)
+}
+
+// costChanged reports whether an update of a job changed what it spent
+func costChanged(e event.UpdateEvent) bool {
+	before, ok := e.ObjectOld.(*quantumv1.QiskitJob)
+	after, ok2 := e.ObjectNew.(*quantumv1.QiskitJob)
+	if !ok || !ok2 {
+		return true
+	}
+	return before.Generation != after.Generation || before.Status.ActualCost != after.Status.ActualCost ||
+		!before.Status.CompletionTime.Equal(after.Status.CompletionTime)
+}
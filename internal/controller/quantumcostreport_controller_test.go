@@ -0,0 +1,246 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("QuantumCostReport Controller", func() {
+	ctx := context.Background()
+	now := time.Now()
+	lastMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -1, 0)
+
+	// finishedJob returns a job of the namespace that finished at the time
+	// costing cost, charged to the cost center and account if set
+	finishedJob := func(name, namespace string, finished time.Time, cost, center, account string) *quantumv1.QiskitJob {
+		job := builder.NewBellStateJob(name, namespace).Build()
+		if center != "" {
+			job.Spec.Budget = &quantumv1.BudgetSpec{CostCenter: center, BillingAccount: account}
+			job.Status.CostCenter = center
+		}
+		completion := metav1.NewTime(finished)
+		job.Status.Phase = PhaseCompleted
+		job.Status.CompletionTime = &completion
+		job.Status.ActualCost = cost
+		return job
+	}
+
+	It("should account the month's spend per namespace and cost center and alert over thresholds", func() {
+		running := builder.NewBellStateJob("running", "team-b").Build()
+		// Charged when last month closed
+		old := finishedJob("old", "team-b", lastMonth, "$100.00", "chemistry", "")
+		old.Status.ReportedCost = "$100.00"
+		report := &quantumv1.QuantumCostReport{
+			ObjectMeta: metav1.ObjectMeta{Name: CostReportName},
+			Spec: quantumv1.QuantumCostReportSpec{Thresholds: []quantumv1.CostCenterThreshold{
+				{CostCenter: "physics", Amount: "$50"},
+				{CostCenter: "chemistry", Amount: "10.00"},
+			}},
+			Status: quantumv1.QuantumCostReportStatus{CostPeriod: quantumv1.CostPeriod{
+				BillingPeriod: lastMonth.Format("2006-01"),
+				TotalSpend:    "$99.00",
+			}},
+		}
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithObjects(
+				finishedJob("bell-1", "team-a", now, "$30.00", "physics", "acct-1"),
+				finishedJob("bell-2", "team-a", now, "$25.00", "physics", "acct-2"),
+				finishedJob("bell-3", "team-b", now, "$5.00", "", ""),
+				old, running, report).
+			WithStatusSubresource(&quantumv1.QuantumCostReport{}, &quantumv1.QiskitJob{}).Build()
+		recorder := record.NewFakeRecorder(10)
+		r := &QuantumCostReportReconciler{Client: c, Scheme: c.Scheme(), Recorder: recorder}
+		key := types.NamespacedName{Name: CostReportName}
+
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("<=", 31*24*time.Hour), "the month is closed as it ends")
+		Expect(c.Get(ctx, key, report)).To(Succeed())
+
+		status := report.Status
+		Expect(status.BillingPeriod).To(Equal(now.Format("2006-01")))
+		Expect(status.TotalSpend).To(Equal("$60.00"))
+		Expect(status.UnassignedSpend).To(Equal("$5.00"))
+		Expect(status.Namespaces).To(Equal([]quantumv1.NamespaceCost{
+			{Namespace: "team-a", Spend: "$55.00", Jobs: 2},
+			{Namespace: "team-b", Spend: "$5.00", Jobs: 1},
+		}))
+		Expect(status.CostCenters).To(Equal([]quantumv1.CostCenterCost{
+			{CostCenter: "chemistry", Spend: "$0.00", Threshold: "$10.00"},
+			{CostCenter: "physics", BillingAccounts: []string{"acct-1", "acct-2"}, Spend: "$55.00", Jobs: 2,
+				Threshold: "$50.00", OverThreshold: true},
+		}))
+		Expect(status.Previous).NotTo(BeNil())
+		Expect(status.Previous.BillingPeriod).To(Equal(lastMonth.Format("2006-01")))
+		Expect(status.Previous.TotalSpend).To(Equal("$99.00"))
+		condition := meta.FindStatusCondition(status.Conditions, ConditionThresholdExceeded)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("physics"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("CostThresholdExceeded Cost center physics spent $55.00")))
+
+		By("alerting only once per cost center and month")
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).NotTo(Receive())
+		Expect(c.Get(ctx, key, report)).To(Succeed())
+		Expect(report.Status.Previous.TotalSpend).To(Equal("$99.00"))
+
+		By("exporting the totals as metrics")
+		spend, err := CostReportSpend(ctx, c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spend.Namespaces).To(Equal(map[string]float64{"team-a": 55, "team-b": 5}))
+		Expect(spend.CostCenters).To(Equal(map[string]float64{"chemistry": 0, "physics": 55}))
+		Expect(spend.Thresholds).To(Equal(map[string]float64{"chemistry": 10, "physics": 50}))
+
+		By("keeping the charges of jobs deleted since")
+		charged := &quantumv1.QiskitJob{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "bell-1", Namespace: "team-a"}, charged)).To(Succeed())
+		Expect(charged.Status.ReportedCost).To(Equal("$30.00"))
+		Expect(c.Delete(ctx, charged)).To(Succeed())
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, report)).To(Succeed())
+		Expect(report.Status.TotalSpend).To(Equal("$60.00"))
+		Expect(report.Status.Namespaces[0]).To(Equal(quantumv1.NamespaceCost{Namespace: "team-a", Spend: "$55.00", Jobs: 2}))
+
+		By("charging only what a job's cost grew by since")
+		charged = &quantumv1.QiskitJob{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "bell-3", Namespace: "team-b"}, charged)).To(Succeed())
+		charged.Status.ActualCost = "$7.00"
+		Expect(c.Status().Update(ctx, charged)).To(Succeed())
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, report)).To(Succeed())
+		Expect(report.Status.TotalSpend).To(Equal("$62.00"))
+		Expect(report.Status.Namespaces[1]).To(Equal(quantumv1.NamespaceCost{Namespace: "team-b", Spend: "$7.00", Jobs: 1}))
+	})
+
+	It("should create the report once jobs spent and ignore other reports", func() {
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&quantumv1.QuantumCostReport{}, &quantumv1.QiskitJob{}).Build()
+		r := &QuantumCostReportReconciler{Client: c, Scheme: c.Scheme()}
+		key := types.NamespacedName{Name: CostReportName}
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, &quantumv1.QuantumCostReport{})).NotTo(Succeed())
+		spend, err := CostReportSpend(ctx, c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spend).To(BeNil())
+
+		Expect(c.Create(ctx, finishedJob("bell", "default", now, "$1.50", "", ""))).To(Succeed())
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "other"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, &quantumv1.QuantumCostReport{})).NotTo(Succeed())
+
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		report := &quantumv1.QuantumCostReport{}
+		Expect(c.Get(ctx, key, report)).To(Succeed())
+		Expect(report.Status.TotalSpend).To(Equal("$1.50"))
+		Expect(report.Status.Previous).To(BeNil())
+		Expect(meta.IsStatusConditionFalse(report.Status.Conditions, ConditionThresholdExceeded)).To(BeTrue())
+	})
+
+	It("should record the charge on every job despite conflicts and failures", func() {
+		conflicted := false
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithObjects(
+				finishedJob("bell-1", "team-a", now, "$30.00", "", ""),
+				finishedJob("bell-2", "team-a", now, "$25.00", "", ""),
+				finishedJob("bell-3", "team-a", now, "$5.00", "", "")).
+			WithStatusSubresource(&quantumv1.QuantumCostReport{}, &quantumv1.QiskitJob{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					switch obj.GetName() {
+					case "bell-1":
+						// The job controller wrote the job's status first
+						if !conflicted {
+							conflicted = true
+							return apierrors.NewConflict(schema.GroupResource{Resource: "qiskitjobs"}, "bell-1", fmt.Errorf("stale"))
+						}
+					case "bell-2":
+						return fmt.Errorf("unavailable")
+					}
+					return c.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+			}).Build()
+		r := &QuantumCostReportReconciler{Client: c, Scheme: c.Scheme()}
+		key := types.NamespacedName{Name: CostReportName}
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).To(MatchError(ContainSubstring("unavailable")))
+		Expect(conflicted).To(BeTrue())
+		report := &quantumv1.QuantumCostReport{}
+		Expect(c.Get(ctx, key, report)).To(Succeed())
+		Expect(report.Status.TotalSpend).To(Equal("$60.00"))
+
+		job := &quantumv1.QiskitJob{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "bell-1", Namespace: "team-a"}, job)).To(Succeed())
+		Expect(job.Status.ReportedCost).To(Equal("$30.00"))
+		Expect(c.Get(ctx, types.NamespacedName{Name: "bell-2", Namespace: "team-a"}, job)).To(Succeed())
+		Expect(job.Status.ReportedCost).To(BeEmpty())
+		Expect(c.Get(ctx, types.NamespacedName{Name: "bell-3", Namespace: "team-a"}, job)).To(Succeed())
+		Expect(job.Status.ReportedCost).To(Equal("$5.00"), "the jobs after a failure are still recorded")
+	})
+
+	It("should charge jobs only to the cost centers their namespace allows", func() {
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "team-c",
+			Labels:      map[string]string{CostCenterLabel: "physics"},
+			Annotations: map[string]string{CostCentersAnnotation: "chemistry, biology"},
+		}}
+		job := func(center string) *quantumv1.QiskitJob {
+			job := builder.NewBellStateJob("bell", "team-c").Build()
+			if center != "" {
+				job.Spec.Budget = &quantumv1.BudgetSpec{CostCenter: center, BillingAccount: "acct"}
+			}
+			return job
+		}
+		Expect(allowedCostCenter(namespace, job(""))).To(Equal("physics"))
+		Expect(allowedCostCenter(namespace, job("biology"))).To(Equal("biology"))
+		Expect(allowedCostCenter(namespace, job("finance"))).To(Equal("physics"))
+
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(namespace).Build()
+		r := &QiskitJobReconciler{Client: c, Scheme: c.Scheme()}
+		spoofed := job("finance")
+		Expect(r.recordCostCenter(ctx, spoofed)).To(Succeed())
+		Expect(costCenter(spoofed)).To(Equal("physics"))
+		Expect(billingAccount(spoofed)).To(BeEmpty(), "the account named for another cost center is not billed")
+	})
+})
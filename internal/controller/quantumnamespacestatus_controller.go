@@ -0,0 +1,187 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// NamespaceStatusName is the name of the per-namespace QuantumNamespaceStatus object
+const NamespaceStatusName = "quantum-status"
+
+// QuantumNamespaceStatusReconciler maintains a QuantumNamespaceStatus summary
+// for every namespace containing QiskitJobs
+type QuantumNamespaceStatusReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Jobs lists the jobs of a namespace; nil lists them from the client
+	Jobs *JobLister
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumnamespacestatuses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumnamespacestatuses/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumnamespacestatuses/finalizers,verbs=update
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch
+
+// Reconcile recomputes the namespace summary from the QiskitJobs of the
+// namespace. It runs whenever a job in the namespace changes, so
+// dashboards can read a single object instead of listing every job. The
+// summary is only written when it changed.
+func (r *QuantumNamespaceStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Jobs are tallied one at a time, so terminal jobs read from the API
+	// server are never all held at once
+	now := time.Now()
+	tally := newJobTally(now)
+	if err := eachJob(ctx, r.Client, r.Jobs, tally.add, client.InNamespace(req.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var summary quantumv1.QuantumNamespaceStatus
+	err := r.Get(ctx, req.NamespacedName, &summary)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if errors.IsNotFound(err) {
+		if tally.total == 0 {
+			// Nothing to summarize yet
+			return ctrl.Result{}, nil
+		}
+		summary = quantumv1.QuantumNamespaceStatus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      req.Name,
+				Namespace: req.Namespace,
+				Labels: map[string]string{
+					"app": "qiskit-operator",
+				},
+			},
+		}
+		logger.Info("Creating namespace status summary", "namespace", req.Namespace)
+		if err := r.Create(ctx, &summary); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	written := summary.Status.DeepCopy()
+	tally.summarize(&summary, now)
+	summarized := summary.Status.DeepCopy()
+	summarized.LastUpdated = written.LastUpdated
+	if equality.Semantic.DeepEqual(written, summarized) {
+		return ctrl.Result{}, nil
+	}
+	if err := r.Status().Update(ctx, &summary); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// jobTally accumulates the summary of a namespace's jobs one job at a time
+type jobTally struct {
+	period            string
+	counts            map[string]int
+	total, active     int
+	completed, failed int
+	spend             float64
+}
+
+// newJobTally starts a tally for the billing period of now
+func newJobTally(now time.Time) *jobTally {
+	return &jobTally{period: now.Format("2006-01"), counts: map[string]int{}}
+}
+
+// add counts the job
+func (t *jobTally) add(job *quantumv1.QiskitJob) error {
+	phase := job.Status.Phase
+	if phase == "" {
+		phase = PhasePending
+	}
+	t.total++
+	t.counts[string(phase)]++
+
+	switch phase {
+	case PhaseCompleted:
+		t.completed++
+	case PhaseFailed:
+		t.failed++
+	case PhaseCancelled:
+	default:
+		t.active++
+	}
+
+	completion := job.Status.CompletionTime
+	if completion != nil && completion.Format("2006-01") == t.period {
+		// Costs that fail to parse are ignored rather than blocking the summary
+		if cost, err := parseCost(job.Status.ActualCost); err == nil {
+			t.spend += cost
+		}
+	}
+	return nil
+}
+
+// summarize fills the summary status from the tally
+func (t *jobTally) summarize(summary *quantumv1.QuantumNamespaceStatus, now time.Time) {
+	status := &summary.Status
+	status.JobCounts = t.counts
+	status.TotalJobs = t.total
+	status.ActiveJobs = t.active
+	status.BillingPeriod = t.period
+
+	status.MonthToDateSpend = formatCost(t.spend)
+	status.FailureRate = 0
+	if t.completed+t.failed > 0 {
+		status.FailureRate = float64(t.failed) / float64(t.completed+t.failed)
+	}
+	status.QuotaUtilization = 0
+	if budget, err := parseCost(summary.Spec.MonthlyBudget); err == nil && budget > 0 {
+		status.QuotaUtilization = t.spend / budget
+	}
+	updated := metav1.NewTime(now)
+	status.LastUpdated = &updated
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QuantumNamespaceStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Only budget changes affect the summary; its own status updates do not
+		For(&quantumv1.QuantumNamespaceStatus{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&quantumv1.QiskitJob{}, handler.EnqueueRequestsFromMapFunc(
+			func(ctx context.Context, obj client.Object) []reconcile.Request {
+				return []reconcile.Request{{
+					NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: NamespaceStatusName},
+				}}
+			})).
+		Named("quantumnamespacestatus").
+		Complete(r)
+}
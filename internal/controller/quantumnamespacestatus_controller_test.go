@@ -0,0 +1,100 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("QuantumNamespaceStatus Controller", func() {
+	Context("When reconciling a namespace with jobs", func() {
+		const jobName = "summary-test-job"
+
+		ctx := context.Background()
+
+		summaryName := types.NamespacedName{
+			Name:      NamespaceStatusName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating a QiskitJob in the namespace")
+			job := &quantumv1.QiskitJob{}
+			err := k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, job)
+			if err != nil && errors.IsNotFound(err) {
+				Expect(k8sClient.Create(ctx, builder.NewBellStateJob(jobName, "default").Build())).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			By("Cleanup the job and the namespace summary")
+			job := &quantumv1.QiskitJob{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, job)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, job)).To(Succeed())
+
+			summary := &quantumv1.QuantumNamespaceStatus{}
+			if err := k8sClient.Get(ctx, summaryName, summary); err == nil {
+				Expect(k8sClient.Delete(ctx, summary)).To(Succeed())
+			}
+		})
+		It("should create and populate the namespace summary", func() {
+			By("Reconciling the namespace summary")
+			controllerReconciler := &QuantumNamespaceStatusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: summaryName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			summary := &quantumv1.QuantumNamespaceStatus{}
+			Expect(k8sClient.Get(ctx, summaryName, summary)).To(Succeed())
+			Expect(summary.Status.TotalJobs).To(BeNumerically(">=", 1))
+			Expect(summary.Status.MonthToDateSpend).To(Equal("$0.00"))
+		})
+
+		It("should only write the summary when it changed", func() {
+			controllerReconciler := &QuantumNamespaceStatusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			summary := &quantumv1.QuantumNamespaceStatus{}
+			for range 2 {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: summaryName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(k8sClient.Get(ctx, summaryName, summary)).To(Succeed())
+			version := summary.ResourceVersion
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: summaryName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, summaryName, summary)).To(Succeed())
+			Expect(summary.ResourceVersion).To(Equal(version))
+		})
+	})
+})
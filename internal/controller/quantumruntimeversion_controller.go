@@ -0,0 +1,291 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+)
+
+// ConditionCanaryHealthy is True once enough canary jobs finished without
+// their failure rate regressing, Unknown while too few have, and False after
+// the canary was rolled back
+const ConditionCanaryHealthy = "CanaryHealthy"
+
+// Canary policy defaults, applied when the API server did not default them
+const (
+	defaultCanaryWeight                 = 10
+	defaultCanaryMinJobs                = 20
+	defaultCanaryMaxFailureRateIncrease = 5
+)
+
+// QuantumRuntimeVersionReconciler compares the failure rates of the canary
+// and stable executor images of a Qiskit release line and rolls the canary
+// back when it regresses
+type QuantumRuntimeVersionReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Jobs lists the jobs of every namespace; nil lists them from the client
+	Jobs *JobLister
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumruntimeversions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumruntimeversions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch
+
+// Reconcile counts the finished jobs of the release line by the image they
+// ran. It runs whenever a job of the line finishes.
+func (r *QuantumRuntimeVersionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var version quantumv1.QuantumRuntimeVersion
+	if err := r.Get(ctx, req.NamespacedName, &version); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Images are rolled out to jobs of every namespace
+	counts := newRolloutCounts(&version)
+	if err := eachJob(ctx, r.Client, r.Jobs, counts.add); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if evaluateRollout(&version, counts, time.Now()) {
+		logger.Info("Rolled back canary executor image", "image", version.Status.RolledBackImage,
+			"canaryFailureRate", version.Status.Canary.FailureRate,
+			"stableFailureRate", version.Status.Stable.FailureRate)
+	}
+
+	if err := r.Status().Update(ctx, &version); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// stableImage returns the image jobs of the line run outside the canary
+func stableImage(version *quantumv1.QuantumRuntimeVersion) string {
+	if version.Spec.Image != "" {
+		return version.Spec.Image
+	}
+	if rt, err := compat.Resolve(version.Spec.QiskitVersion); err == nil {
+		return rt.Image
+	}
+	return ""
+}
+
+// activeCanary returns the canary policy new jobs are subject to, if any. A
+// canary that was rolled back, or that was promoted to the stable image, is
+// not active.
+func activeCanary(version *quantumv1.QuantumRuntimeVersion) (*quantumv1.CanaryPolicy, bool) {
+	canary := version.Spec.Canary
+	if canary == nil || canary.Image == "" || canary.Image == stableImage(version) ||
+		canary.Image == version.Status.RolledBackImage {
+		return nil, false
+	}
+	return canary, true
+}
+
+// finishedForGood reports whether a job completed or failed with no retries left
+func finishedForGood(job *quantumv1.QiskitJob) (failed, ok bool) {
+	switch {
+	case job.Status.Phase == PhaseCompleted:
+		return false, true
+	case job.Status.Phase == PhaseFailed && (job.Status.RetryCount >= retryLimit(job) || !retryableFailure(job) ||
+		verificationFailed(job) || providerRejected(job)):
+		return true, true
+	}
+	return false, false
+}
+
+// rolloutCounter counts the finished jobs of a track
+type rolloutCounter struct {
+	jobs, failures int32
+}
+
+// count adds a finished job to the track
+func (t *rolloutCounter) count(failed bool) {
+	t.jobs++
+	if failed {
+		t.failures++
+	}
+}
+
+// track summarizes the counter for the status
+func (t rolloutCounter) track(image string) quantumv1.RolloutTrack {
+	track := quantumv1.RolloutTrack{Image: image, Jobs: t.jobs, Failures: t.failures}
+	if t.jobs > 0 {
+		track.FailureRate = float64(t.failures) / float64(t.jobs)
+	}
+	return track
+}
+
+// rolloutCounts counts the finished jobs of a release line by the image
+// their last attempt ran. Jobs dispatched to spoke clusters ran the spoke's
+// images and are not counted.
+type rolloutCounts struct {
+	line, stable, canary   string
+	stableJobs, canaryJobs rolloutCounter
+}
+
+// newRolloutCounts starts counting the jobs of the version's release line
+func newRolloutCounts(version *quantumv1.QuantumRuntimeVersion) *rolloutCounts {
+	counts := &rolloutCounts{line: version.Spec.QiskitVersion, stable: stableImage(version)}
+	if version.Spec.Canary != nil {
+		counts.canary = version.Spec.Canary.Image
+	}
+	return counts
+}
+
+// add counts the job if it finished for good
+func (c *rolloutCounts) add(job *quantumv1.QiskitJob) error {
+	if job.Status.QiskitVersion != c.line || dispatched(job) {
+		return nil
+	}
+	failed, ok := finishedForGood(job)
+	if !ok {
+		return nil
+	}
+	switch {
+	case job.Status.ExecutorImage == c.stable:
+		c.stableJobs.count(failed)
+	case c.canary != "" && job.Status.ExecutorImage == c.canary:
+		c.canaryJobs.count(failed)
+	}
+	return nil
+}
+
+// evaluateRollout fills the rollout status from the counted jobs and rolls
+// the canary back when its failure rate exceeds that of the stable image by
+// more than the policy tolerates. It reports whether the canary was rolled
+// back.
+func evaluateRollout(version *quantumv1.QuantumRuntimeVersion, counts *rolloutCounts, now time.Time) bool {
+	status := &version.Status
+	stable := stableImage(version)
+	canary := version.Spec.Canary
+
+	// A new canary image starts over
+	if status.RolledBackImage != "" && (canary == nil || canary.Image != status.RolledBackImage) {
+		status.RolledBackImage = ""
+	}
+
+	status.Stable = counts.stableJobs.track(stable)
+	status.Canary = quantumv1.RolloutTrack{}
+	updated := metav1.NewTime(now)
+	status.LastUpdated = &updated
+
+	if canary == nil || canary.Image == "" || canary.Image == stable {
+		meta.RemoveStatusCondition(&status.Conditions, ConditionCanaryHealthy)
+		return false
+	}
+	status.Canary = counts.canaryJobs.track(canary.Image)
+
+	if status.RolledBackImage != "" {
+		return false
+	}
+
+	minJobs := canary.MinJobs
+	if minJobs <= 0 {
+		minJobs = defaultCanaryMinJobs
+	}
+	if status.Canary.Jobs < minJobs {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               ConditionCanaryHealthy,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "Progressing",
+			Message:            fmt.Sprintf("%d of %d canary jobs finished", status.Canary.Jobs, minJobs),
+			ObservedGeneration: version.Generation,
+		})
+		return false
+	}
+
+	tolerance := int32(defaultCanaryMaxFailureRateIncrease)
+	if canary.MaxFailureRateIncrease != nil {
+		tolerance = *canary.MaxFailureRateIncrease
+	}
+	increase := status.Canary.FailureRate - status.Stable.FailureRate
+	if increase*100 > float64(tolerance) {
+		status.RolledBackImage = canary.Image
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:   ConditionCanaryHealthy,
+			Status: metav1.ConditionFalse,
+			Reason: "RolledBack",
+			Message: fmt.Sprintf("Canary image %s failed %.1f%% of %d jobs against %.1f%% for %s; new jobs run the stable image",
+				canary.Image, status.Canary.FailureRate*100, status.Canary.Jobs, status.Stable.FailureRate*100, stable),
+			ObservedGeneration: version.Generation,
+		})
+		return true
+	}
+
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:   ConditionCanaryHealthy,
+		Status: metav1.ConditionTrue,
+		Reason: "Healthy",
+		Message: fmt.Sprintf("Canary image failed %.1f%% of %d jobs against %.1f%% for the stable image",
+			status.Canary.FailureRate*100, status.Canary.Jobs, status.Stable.FailureRate*100),
+		ObservedGeneration: version.Generation,
+	})
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QuantumRuntimeVersionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates of the version itself need no evaluation
+		For(&quantumv1.QuantumRuntimeVersion{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&quantumv1.QiskitJob{}, handler.EnqueueRequestsFromMapFunc(r.versionsOfJob)).
+		Named("quantumruntimeversion").
+		Complete(r)
+}
+
+// versionsOfJob maps a finished job to the runtime versions of its release line
+func (r *QuantumRuntimeVersionReconciler) versionsOfJob(ctx context.Context, obj client.Object) []reconcile.Request {
+	job, ok := obj.(*quantumv1.QiskitJob)
+	if !ok || job.Status.ExecutorImage == "" {
+		return nil
+	}
+	if _, ok := finishedForGood(job); !ok {
+		return nil
+	}
+	var versions quantumv1.QuantumRuntimeVersionList
+	if err := r.List(ctx, &versions); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list runtime versions")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, version := range versions.Items {
+		if version.Spec.QiskitVersion == job.Status.QiskitVersion {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: version.Name}})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+)
+
+var _ = Describe("QuantumRuntimeVersion Controller", func() {
+	newVersion := func() *quantumv1.QuantumRuntimeVersion {
+		return &quantumv1.QuantumRuntimeVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "qiskit-1-2"},
+			Spec: quantumv1.QuantumRuntimeVersionSpec{
+				QiskitVersion: "1.2",
+				Image:         "registry.example.com/executor:1",
+				Canary: &quantumv1.CanaryPolicy{
+					Image:   "registry.example.com/executor:2",
+					Weight:  50,
+					MinJobs: 4,
+				},
+			},
+		}
+	}
+
+	// finishedJobs returns n jobs of the 1.2 line that ran the image, the
+	// first failures of them failed for good
+	finishedJobs := func(image string, n, failures int) []quantumv1.QiskitJob {
+		var jobs []quantumv1.QiskitJob
+		for i := 0; i < n; i++ {
+			job := builder.NewBellStateJob(fmt.Sprintf("job-%s-%d", image, i), "default").Build()
+			job.Status.QiskitVersion = "1.2"
+			job.Status.ExecutorImage = image
+			job.Status.Phase = PhaseCompleted
+			if i < failures {
+				job.Status.Phase = PhaseFailed
+				job.Status.RetryCount = maxRetries
+			}
+			jobs = append(jobs, *job)
+		}
+		return jobs
+	}
+
+	// countRollout counts the jobs towards the version's rollout
+	countRollout := func(version *quantumv1.QuantumRuntimeVersion, jobs []quantumv1.QiskitJob) *rolloutCounts {
+		counts := newRolloutCounts(version)
+		for i := range jobs {
+			Expect(counts.add(&jobs[i])).To(Succeed())
+		}
+		return counts
+	}
+
+	It("should wait for enough canary jobs before comparing failure rates", func() {
+		version := newVersion()
+		jobs := finishedJobs("registry.example.com/executor:2", 3, 3)
+		// A failed job that will still be retried does not count
+		retrying := finishedJobs("registry.example.com/executor:2", 1, 1)[0]
+		retrying.Name = "retrying"
+		retrying.Status.RetryCount = 1
+		jobs = append(jobs, retrying)
+
+		Expect(evaluateRollout(version, countRollout(version, jobs), time.Now())).To(BeFalse())
+		Expect(version.Status.Canary.Jobs).To(Equal(int32(3)))
+		Expect(version.Status.RolledBackImage).To(BeEmpty())
+		condition := meta.FindStatusCondition(version.Status.Conditions, ConditionCanaryHealthy)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+	})
+
+	It("should keep a canary that fails no more often than the stable image", func() {
+		version := newVersion()
+		jobs := append(finishedJobs("registry.example.com/executor:1", 10, 1),
+			finishedJobs("registry.example.com/executor:2", 10, 1)...)
+
+		Expect(evaluateRollout(version, countRollout(version, jobs), time.Now())).To(BeFalse())
+		Expect(version.Status.Stable.FailureRate).To(BeNumerically("~", 0.1))
+		Expect(version.Status.Canary.FailureRate).To(BeNumerically("~", 0.1))
+		Expect(meta.IsStatusConditionTrue(version.Status.Conditions, ConditionCanaryHealthy)).To(BeTrue())
+		_, active := activeCanary(version)
+		Expect(active).To(BeTrue())
+	})
+
+	It("should roll back a regressing canary until its image changes", func() {
+		version := newVersion()
+		jobs := append(finishedJobs("registry.example.com/executor:1", 10, 1),
+			finishedJobs("registry.example.com/executor:2", 4, 2)...)
+
+		Expect(evaluateRollout(version, countRollout(version, jobs), time.Now())).To(BeTrue())
+		Expect(version.Status.RolledBackImage).To(Equal("registry.example.com/executor:2"))
+		condition := meta.FindStatusCondition(version.Status.Conditions, ConditionCanaryHealthy)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("RolledBack"))
+		_, active := activeCanary(version)
+		Expect(active).To(BeFalse())
+
+		By("staying rolled back while more canary jobs finish")
+		Expect(evaluateRollout(version, countRollout(version, jobs), time.Now())).To(BeFalse())
+		Expect(version.Status.RolledBackImage).To(Equal("registry.example.com/executor:2"))
+
+		By("starting over with a new canary image")
+		version.Spec.Canary.Image = "registry.example.com/executor:3"
+		Expect(evaluateRollout(version, countRollout(version, jobs), time.Now())).To(BeFalse())
+		Expect(version.Status.RolledBackImage).To(BeEmpty())
+		_, active = activeCanary(version)
+		Expect(active).To(BeTrue())
+	})
+
+	It("should send the canary's share of new jobs to the canary image", func() {
+		ctx := context.Background()
+		version := newVersion()
+		other := &quantumv1.QuantumRuntimeVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "qiskit-1-0"},
+			Spec:       quantumv1.QuantumRuntimeVersionSpec{QiskitVersion: "1.0", Image: "registry.example.com/legacy:1"},
+		}
+		r := &QiskitJobReconciler{
+			Client: fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(version, other).
+				WithStatusSubresource(&quantumv1.QuantumRuntimeVersion{}).Build(),
+		}
+		rt, err := compat.Resolve("1.2")
+		Expect(err).NotTo(HaveOccurred())
+
+		images := map[string]int{}
+		for i := 0; i < 200; i++ {
+			job := builder.NewBellStateJob("canary", "default").Build()
+			job.UID = types.UID(fmt.Sprintf("uid-%d", i))
+			image, err := r.executorImage(ctx, job, rt)
+			Expect(err).NotTo(HaveOccurred())
+			again, err := r.executorImage(ctx, job, rt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again).To(Equal(image), "retries run the same image")
+			images[image]++
+		}
+		Expect(images).To(HaveLen(2))
+		Expect(images["registry.example.com/executor:2"]).To(BeNumerically("~", 100, 30))
+
+		By("running the stable image once the canary is rolled back")
+		version.Status.RolledBackImage = "registry.example.com/executor:2"
+		Expect(r.Status().Update(ctx, version)).To(Succeed())
+		job := builder.NewBellStateJob("canary", "default").Build()
+		for i := 0; i < 20; i++ {
+			job.UID = types.UID(fmt.Sprintf("uid-%d", i))
+			Expect(r.executorImage(ctx, job, rt)).To(Equal("registry.example.com/executor:1"))
+		}
+
+		By("running the matrix image on lines without a runtime version")
+		preview, err := compat.Resolve("1.3")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.executorImage(ctx, job, preview)).To(Equal(preview.Image))
+	})
+})
@@ -0,0 +1,449 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+	"github.com/quantum-operator/qiskit-operator/pkg/packages"
+)
+
+// Phases of workspaces
+const (
+	WorkspacePhasePending = "Pending"
+	WorkspacePhaseRunning = "Running"
+	WorkspacePhaseExpired = "Expired"
+	WorkspacePhaseFailed  = "Failed"
+)
+
+// ConditionWorkspaceReady is True while the notebook of a QuantumWorkspace
+// is running
+const ConditionWorkspaceReady = "Ready"
+
+// WorkspaceLabel names the QuantumWorkspace a job was submitted from
+const WorkspaceLabel = "quantum.io/workspace"
+
+// Notebook settings
+const (
+	defaultWorkspaceMaxLifetime = 8 * time.Hour
+	workspaceHome               = "/home/workspace"
+	workspacePort               = 8888
+)
+
+// QuantumWorkspaceReconciler runs the notebook pod of a QuantumWorkspace
+// until its lifetime has passed, and accounts for the jobs submitted from it
+type QuantumWorkspaceReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Recorder records events on workspaces, like their teardown
+	Recorder record.EventRecorder
+
+	// Jobs, when it keeps terminal jobs out of the cache, lists the jobs
+	// the cache does not hold
+	Jobs *JobLister
+
+	// PackageIndex configures where notebooks install packages from
+	PackageIndex packages.Index
+
+	// ExecutorImage is the operator's --executor-image, run by notebooks
+	// whose release line has no QuantumRuntimeVersion
+	ExecutorImage string
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumworkspaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumworkspaces/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumworkspaces/finalizers,verbs=update
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
+
+// Reconcile creates the workspace's notebook pod, records the usage of the
+// jobs submitted from it and deletes the pod once the workspace expires.
+// Usage is still recorded after that, for jobs that outlive the notebook.
+func (r *QuantumWorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logf.FromContext(ctx)
+
+	var workspace quantumv1.QuantumWorkspace
+	if err := r.Get(ctx, req.NamespacedName, &workspace); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if workspace.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	jobs, spend, err := workspaceUsage(ctx, r.Client, r.Jobs, &workspace, "")
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	usageChanged := jobs != workspace.Status.Jobs || formatCost(spend) != workspace.Status.Spend
+	workspace.Status.Jobs = jobs
+	workspace.Status.Spend = formatCost(spend)
+
+	switch workspace.Status.Phase {
+	case WorkspacePhaseExpired, WorkspacePhaseFailed:
+		if !usageChanged {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, r.Status().Update(ctx, &workspace)
+	}
+
+	rt, err := compat.Resolve(workspace.Spec.QiskitVersion)
+	if err != nil {
+		return r.setWorkspacePhase(ctx, &workspace, WorkspacePhaseFailed, "InvalidSpec", err.Error())
+	}
+	if message := validateWorkspace(&workspace); message != "" {
+		return r.setWorkspacePhase(ctx, &workspace, WorkspacePhaseFailed, "InvalidSpec", message)
+	}
+
+	now := time.Now()
+	if expiry := workspace.Status.ExpiryTime; expiry != nil && !now.Before(expiry.Time) {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: workspace.Status.PodName, Namespace: workspace.Namespace}}
+		if err := r.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		logger.Info("Workspace expired", "workspace", workspace.Name)
+		message := fmt.Sprintf("Notebook torn down after %s; its jobs cost %s", workspaceMaxLifetime(&workspace), workspace.Status.Spend)
+		r.event(&workspace, corev1.EventTypeNormal, "Expired", message)
+		return r.setWorkspacePhase(ctx, &workspace, WorkspacePhaseExpired, "Expired", message)
+	}
+
+	var pod corev1.Pod
+	err = r.Get(ctx, types.NamespacedName{Name: workspacePodName(&workspace), Namespace: workspace.Namespace}, &pod)
+	switch {
+	case apierrors.IsNotFound(err):
+		image, err := r.workspaceImage(ctx, &workspace, rt)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		created := r.notebookPod(&workspace, rt, image)
+		if err := controllerutil.SetControllerReference(&workspace, created, r.Scheme); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.Create(ctx, created); err != nil {
+			return ctrl.Result{}, err
+		}
+		logger.Info("Created notebook pod", "pod", created.Name, "image", image)
+		workspace.Status.PodName = created.Name
+		if workspace.Status.StartTime == nil {
+			workspace.Status.StartTime = &metav1.Time{Time: now}
+			workspace.Status.ExpiryTime = &metav1.Time{Time: now.Add(workspaceMaxLifetime(&workspace))}
+		}
+		return r.setWorkspacePhase(ctx, &workspace, WorkspacePhasePending, "PodCreated",
+			fmt.Sprintf("Starting notebook pod %s", created.Name))
+	case err != nil:
+		return ctrl.Result{}, err
+	}
+
+	switch {
+	case pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded:
+		message := fmt.Sprintf("Notebook pod %s exited", pod.Name)
+		if pod.Status.Message != "" {
+			message += ": " + pod.Status.Message
+		}
+		r.event(&workspace, corev1.EventTypeWarning, "NotebookExited", message)
+		return r.setWorkspacePhase(ctx, &workspace, WorkspacePhaseFailed, "NotebookExited", message)
+	case podReady(&pod):
+		return r.setWorkspacePhase(ctx, &workspace, WorkspacePhaseRunning, "NotebookReady",
+			fmt.Sprintf("Notebook is serving on port %d of pod %s", workspacePort, pod.Name))
+	}
+	return r.setWorkspacePhase(ctx, &workspace, WorkspacePhasePending, "NotebookStarting",
+		fmt.Sprintf("Waiting for notebook pod %s to become ready", pod.Name))
+}
+
+// setWorkspacePhase records the workspace's phase and Ready condition, and
+// requeues it for its expiry
+func (r *QuantumWorkspaceReconciler) setWorkspacePhase(ctx context.Context, workspace *quantumv1.QuantumWorkspace, phase, reason, message string) (ctrl.Result, error) {
+	workspace.Status.Phase = phase
+	workspace.Status.Message = message
+	status := metav1.ConditionFalse
+	if phase == WorkspacePhaseRunning {
+		status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&workspace.Status.Conditions, metav1.Condition{
+		Type:               ConditionWorkspaceReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: workspace.Generation,
+	})
+	if err := r.Status().Update(ctx, workspace); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	switch phase {
+	case WorkspacePhaseExpired, WorkspacePhaseFailed:
+		return ctrl.Result{}, nil
+	}
+	if expiry := workspace.Status.ExpiryTime; expiry != nil {
+		return ctrl.Result{RequeueAfter: max(time.Until(expiry.Time), time.Second)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// validateWorkspace explains what makes the workspace impossible to run, if
+// anything
+func validateWorkspace(workspace *quantumv1.QuantumWorkspace) string {
+	spec := workspace.Spec
+	if creds := spec.Credentials; creds != nil && creds.SecretRef != nil {
+		if ns := creds.SecretRef.Namespace; ns != "" && ns != workspace.Namespace {
+			return fmt.Sprintf("spec.credentials.secretRef must name a Secret of namespace %s to be mounted, not of %s",
+				workspace.Namespace, ns)
+		}
+	}
+	if budget := spec.Budget; budget != nil && budget.MaxCost != "" {
+		if _, err := parseCost(budget.MaxCost); err != nil {
+			return fmt.Sprintf("Invalid spec.budget.maxCost: %v", err)
+		}
+	}
+	if spec.Resources != nil {
+		for name, value := range spec.Resources.Requests {
+			if _, err := resource.ParseQuantity(value); err != nil {
+				return fmt.Sprintf("Invalid spec.resources.requests.%s: %v", name, err)
+			}
+		}
+		for name, value := range spec.Resources.Limits {
+			if _, err := resource.ParseQuantity(value); err != nil {
+				return fmt.Sprintf("Invalid spec.resources.limits.%s: %v", name, err)
+			}
+		}
+	}
+	return ""
+}
+
+// workspaceImage returns the image the notebook runs: the workspace's own
+// image if it sets one, else the stable image of the QuantumRuntimeVersion
+// of its release line, the operator's --executor-image, or the image of the
+// compatibility matrix, as for jobs. Canaries are left to jobs.
+func (r *QuantumWorkspaceReconciler) workspaceImage(ctx context.Context, workspace *quantumv1.QuantumWorkspace, rt *compat.Runtime) (string, error) {
+	if workspace.Spec.Image != "" {
+		return workspace.Spec.Image, nil
+	}
+	var versions quantumv1.QuantumRuntimeVersionList
+	if err := r.List(ctx, &versions); err != nil {
+		return "", err
+	}
+	sort.Slice(versions.Items, func(i, j int) bool {
+		return versions.Items[i].Name < versions.Items[j].Name
+	})
+	for i := range versions.Items {
+		version := &versions.Items[i]
+		if version.Spec.QiskitVersion == rt.Line && version.Spec.Image != "" {
+			return version.Spec.Image, nil
+		}
+	}
+	if r.ExecutorImage != "" {
+		return strings.ReplaceAll(r.ExecutorImage, ExecutorImageLinePlaceholder, rt.Line), nil
+	}
+	return rt.Image, nil
+}
+
+// notebookPod returns the pod serving JupyterLab for the workspace. It
+// installs the executor's requirements for the workspace's backend and
+// JupyterLab, and prints the access token to its log as Jupyter does.
+func (r *QuantumWorkspaceReconciler) notebookPod(workspace *quantumv1.QuantumWorkspace, rt *compat.Runtime, image string) *corev1.Pod {
+	backendType := ""
+	if workspace.Spec.Backend != nil {
+		backendType = workspace.Spec.Backend.Type
+	}
+	var requirements []string
+	for _, req := range append(rt.RequirementsFor(backendType), "jupyterlab") {
+		requirements = append(requirements, "'"+req+"'")
+	}
+	script := pipInstall(strings.Join(requirements, " ")) + " && " +
+		fmt.Sprintf("exec python -m jupyterlab --ip=0.0.0.0 --port=%d --no-browser --ServerApp.root_dir=%s",
+			workspacePort, workspaceHome)
+
+	container := corev1.Container{
+		Name:    "notebook",
+		Image:   image,
+		Command: []string{"sh", "-c", script},
+		Ports:   []corev1.ContainerPort{{Name: "http", ContainerPort: workspacePort}},
+		Env: []corev1.EnvVar{
+			{Name: "HOME", Value: workspaceHome},
+			{Name: "QISKIT_VERSION", Value: rt.Line},
+			{Name: "QUANTUM_WORKSPACE", Value: workspace.Name},
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(workspacePort)},
+			},
+			PeriodSeconds: 5,
+		},
+		VolumeMounts: []corev1.VolumeMount{{Name: "home", MountPath: workspaceHome}},
+		SecurityContext: &corev1.SecurityContext{
+			RunAsNonRoot:             ptr(true),
+			RunAsUser:                ptr(int64(1000)),
+			AllowPrivilegeEscalation: ptr(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	}
+	if b := workspace.Spec.Backend; b != nil {
+		container.Env = append(container.Env, corev1.EnvVar{Name: "QISKIT_BACKEND_TYPE", Value: b.Type})
+		if b.Name != "" {
+			container.Env = append(container.Env, corev1.EnvVar{Name: "QISKIT_BACKEND_NAME", Value: b.Name})
+		}
+		if b.Instance != "" {
+			container.Env = append(container.Env, corev1.EnvVar{Name: "QISKIT_BACKEND_INSTANCE", Value: b.Instance})
+		}
+		if b.Region != "" {
+			container.Env = append(container.Env, corev1.EnvVar{Name: "QISKIT_BACKEND_REGION", Value: b.Region})
+		}
+	}
+	if budget := workspace.Spec.Budget; budget != nil && budget.MaxCost != "" {
+		container.Env = append(container.Env, corev1.EnvVar{Name: "QUANTUM_WORKSPACE_MAX_COST", Value: budget.MaxCost})
+	}
+	container.Env = append(container.Env, r.PackageIndex.Env()...)
+	if spec := workspace.Spec.Resources; spec != nil {
+		container.Resources = corev1.ResourceRequirements{Requests: corev1.ResourceList{}, Limits: corev1.ResourceList{}}
+		for name, value := range spec.Requests {
+			container.Resources.Requests[corev1.ResourceName(name)] = resource.MustParse(value)
+		}
+		for name, value := range spec.Limits {
+			container.Resources.Limits[corev1.ResourceName(name)] = resource.MustParse(value)
+		}
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workspacePodName(workspace),
+			Namespace: workspace.Namespace,
+			Labels: map[string]string{
+				"app":          "qiskit-operator",
+				WorkspaceLabel: workspace.Name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:      corev1.RestartPolicyNever,
+			ServiceAccountName: workspace.Spec.ServiceAccountName,
+			Containers:         []corev1.Container{container},
+			Volumes: []corev1.Volume{{
+				Name:         "home",
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}},
+		},
+	}
+
+	source := credentialsVolumeSource(workspace.Spec.Credentials)
+	if creds := workspace.Spec.Credentials; source == nil && creds != nil && creds.SecretRef != nil {
+		source = &corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: creds.SecretRef.Name}}
+	}
+	if source != nil {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{Name: "credentials", VolumeSource: *source})
+		c := &pod.Spec.Containers[0]
+		c.VolumeMounts = append(c.VolumeMounts,
+			corev1.VolumeMount{Name: "credentials", MountPath: credentialsDir, ReadOnly: true})
+		c.Env = append(c.Env, corev1.EnvVar{Name: "QISKIT_CREDENTIALS_DIR", Value: credentialsDir})
+	}
+	return pod
+}
+
+// workspaceUsage counts the jobs submitted from the workspace and what they
+// cost: the actual cost of those that finished and the estimated cost of
+// those running. The job with the skipped UID is left out.
+func workspaceUsage(ctx context.Context, c client.Reader, lister *JobLister, workspace *quantumv1.QuantumWorkspace, skip types.UID) (int32, float64, error) {
+	var jobs int32
+	var spend float64
+	err := eachJob(ctx, c, lister, func(job *quantumv1.QiskitJob) error {
+		if job.UID == skip && skip != "" {
+			return nil
+		}
+		jobs++
+		// Costs that fail to parse are ignored, as in the namespace summary
+		if cost, err := parseCost(job.Status.ActualCost); err == nil && job.Status.ActualCost != "" {
+			spend += cost
+		} else if job.Status.Phase == PhaseRunning {
+			if cost, err := parseCost(job.Status.EstimatedCost); err == nil {
+				spend += cost
+			}
+		}
+		return nil
+	}, client.InNamespace(workspace.Namespace), client.MatchingLabels{WorkspaceLabel: workspace.Name})
+	return jobs, spend, err
+}
+
+// podReady reports whether the pod's Ready condition is True
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// workspacePodName returns the name of the workspace's notebook pod
+func workspacePodName(workspace *quantumv1.QuantumWorkspace) string {
+	return "quantum-workspace-" + workspace.Name
+}
+
+// workspaceMaxLifetime returns how long the workspace's notebook runs at most
+func workspaceMaxLifetime(workspace *quantumv1.QuantumWorkspace) time.Duration {
+	if workspace.Spec.MaxLifetime != nil && workspace.Spec.MaxLifetime.Duration > 0 {
+		return workspace.Spec.MaxLifetime.Duration
+	}
+	return defaultWorkspaceMaxLifetime
+}
+
+// event records an event on the workspace, if the reconciler has a recorder
+func (r *QuantumWorkspaceReconciler) event(workspace *quantumv1.QuantumWorkspace, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(workspace, eventType, reason, message)
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *QuantumWorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&quantumv1.QuantumWorkspace{}).
+		Owns(&corev1.Pod{}).
+		Watches(&quantumv1.QiskitJob{}, handler.EnqueueRequestsFromMapFunc(
+			func(ctx context.Context, obj client.Object) []reconcile.Request {
+				name := obj.GetLabels()[WorkspaceLabel]
+				if name == "" {
+					return nil
+				}
+				return []reconcile.Request{{
+					NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: name},
+				}}
+			})).
+		Named("quantumworkspace").
+		Complete(r)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("QuantumWorkspace Controller", func() {
+	ctx := context.Background()
+
+	newWorkspace := func() *quantumv1.QuantumWorkspace {
+		return &quantumv1.QuantumWorkspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "explore", Namespace: "default", UID: types.UID("explore-uid")},
+			Spec: quantumv1.QuantumWorkspaceSpec{
+				Backend:       &quantumv1.BackendSpec{Type: "ibm_quantum", Name: "ibm_torino"},
+				Credentials:   &quantumv1.CredentialsSpec{SecretRef: &quantumv1.SecretRef{Name: "ibm-credentials"}},
+				QiskitVersion: "1.2",
+				Budget:        &quantumv1.WorkspaceBudgetSpec{MaxCost: "$10.00"},
+				MaxLifetime:   &metav1.Duration{Duration: time.Hour},
+			},
+		}
+	}
+
+	newClient := func(objects ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(objects...).
+			WithStatusSubresource(&quantumv1.QuantumWorkspace{}, &quantumv1.QiskitJob{}).Build()
+	}
+
+	run := func(c client.Client, workspace *quantumv1.QuantumWorkspace) *quantumv1.QuantumWorkspace {
+		r := &QuantumWorkspaceReconciler{Client: c, Scheme: c.Scheme()}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(workspace)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(workspace), workspace)).To(Succeed())
+		return workspace
+	}
+
+	workspaceJob := func(name string, phase quantumv1.QiskitJobPhase, estimated, actual string) *quantumv1.QiskitJob {
+		job := builder.NewBellStateJob(name, "default").Build()
+		job.UID = types.UID(name + "-uid")
+		job.Labels = map[string]string{WorkspaceLabel: "explore"}
+		job.Status.Phase = phase
+		job.Status.EstimatedCost = estimated
+		job.Status.ActualCost = actual
+		return job
+	}
+
+	It("should run a notebook with the workspace's runtime and credentials until it expires", func() {
+		workspace := newWorkspace()
+		c := newClient(workspace)
+
+		workspace = run(c, workspace)
+		Expect(workspace.Status.Phase).To(Equal(WorkspacePhasePending))
+		Expect(workspace.Status.PodName).To(Equal("quantum-workspace-explore"))
+		Expect(workspace.Status.ExpiryTime.Sub(workspace.Status.StartTime.Time)).To(Equal(time.Hour))
+
+		pod := &corev1.Pod{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "quantum-workspace-explore", Namespace: "default"}, pod)).To(Succeed())
+		Expect(metav1.IsControlledBy(pod, workspace)).To(BeTrue())
+		container := pod.Spec.Containers[0]
+		Expect(container.Command[2]).To(ContainSubstring("'jupyterlab'"))
+		Expect(container.Command[2]).To(ContainSubstring("'qiskit==1.2."))
+		Expect(container.Env).To(ContainElements(
+			corev1.EnvVar{Name: "QISKIT_VERSION", Value: "1.2"},
+			corev1.EnvVar{Name: "QISKIT_BACKEND_NAME", Value: "ibm_torino"},
+			corev1.EnvVar{Name: "QUANTUM_WORKSPACE", Value: "explore"},
+			corev1.EnvVar{Name: "QISKIT_CREDENTIALS_DIR", Value: credentialsDir},
+		))
+		Expect(pod.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.Secret.SecretName", "ibm-credentials")))
+
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		Expect(c.Status().Update(ctx, pod)).To(Succeed())
+		workspace = run(c, workspace)
+		Expect(workspace.Status.Phase).To(Equal(WorkspacePhaseRunning))
+		Expect(meta.IsStatusConditionTrue(workspace.Status.Conditions, ConditionWorkspaceReady)).To(BeTrue())
+
+		workspace.Status.ExpiryTime = &metav1.Time{Time: time.Now().Add(-time.Second)}
+		Expect(c.Status().Update(ctx, workspace)).To(Succeed())
+		workspace = run(c, workspace)
+		Expect(workspace.Status.Phase).To(Equal(WorkspacePhaseExpired))
+		err := c.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should account for the jobs submitted from the workspace and hold them to its budget", func() {
+		workspace := newWorkspace()
+		c := newClient(workspace,
+			workspaceJob("done", PhaseCompleted, "$3.00", "$2.50"),
+			workspaceJob("running", PhaseRunning, "$4.00", ""),
+			builder.NewBellStateJob("unrelated", "default").Build())
+
+		workspace = run(c, workspace)
+		Expect(workspace.Status.Jobs).To(Equal(int32(2)))
+		Expect(workspace.Status.Spend).To(Equal("$6.50"))
+
+		r := &QiskitJobReconciler{Client: c, Scheme: c.Scheme()}
+		over := workspaceJob("over", PhaseScheduling, "$4.00", "")
+		Expect(c.Create(ctx, over)).To(Succeed())
+		_, stop, err := r.holdForQuantumQuotas(ctx, over)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stop).To(BeTrue())
+		Expect(over.Status.Phase).To(Equal(PhaseFailed))
+		Expect(quotaRejected(over)).To(BeTrue())
+		Expect(over.Status.Message).To(ContainSubstring("QuantumWorkspace explore, $6.50, past its maxCost of $10.00"))
+
+		within := workspaceJob("within", PhaseScheduling, "$3.00", "")
+		Expect(c.Create(ctx, within)).To(Succeed())
+		_, stop, err = r.holdForQuantumQuotas(ctx, within)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stop).To(BeFalse())
+	})
+
+	It("should fail workspaces whose credentials cannot be mounted", func() {
+		workspace := newWorkspace()
+		workspace.Spec.Credentials.SecretRef.Namespace = "other"
+		c := newClient(workspace)
+
+		workspace = run(c, workspace)
+		Expect(workspace.Status.Phase).To(Equal(WorkspacePhaseFailed))
+		Expect(workspace.Status.Message).To(ContainSubstring("must name a Secret of namespace default"))
+		Expect(workspace.Status.PodName).To(BeEmpty())
+	})
+})
@@ -0,0 +1,314 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/cron"
+)
+
+// ScheduledJobLabel names the ScheduledQiskitJob that started a job
+const ScheduledJobLabel = "quantum.io/scheduled-job"
+
+// ScheduledTimeAnnotation records when the run that started a job was due
+const ScheduledTimeAnnotation = "quantum.io/scheduled-time"
+
+// Defaults of ScheduledQiskitJobs the API server did not default
+const (
+	defaultSuccessfulJobsHistory = 3
+	defaultFailedJobsHistory     = 1
+)
+
+// ScheduledQiskitJobReconciler starts the QiskitJobs of ScheduledQiskitJobs
+// when they are due and prunes their history
+type ScheduledQiskitJobReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Recorder records events on schedules, like skipped runs
+	Recorder record.EventRecorder
+
+	// Jobs lists the jobs a schedule started; nil lists them from the client
+	Jobs *JobLister
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=scheduledqiskitjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=scheduledqiskitjobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=qiskitjobs,verbs=get;list;watch;create;patch;delete
+
+// Reconcile starts the most recent run of the schedule that is due and has
+// not started yet, unless it was missed by more than the starting deadline
+// or its concurrency policy forbids it, and requeues for the next run. Only
+// one missed run is ever started, however many were missed.
+func (r *ScheduledQiskitJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	var scheduled quantumv1.ScheduledQiskitJob
+	if err := r.Get(ctx, req.NamespacedName, &scheduled); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !scheduled.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	active, err := r.syncHistory(ctx, &scheduled)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	status := &scheduled.Status
+	status.Active = make([]string, 0, len(active))
+	for _, job := range active {
+		status.Active = append(status.Active, job.Name)
+	}
+
+	schedule, loc, err := parseSchedule(&scheduled.Spec)
+	if err != nil {
+		status.NextScheduleTime = nil
+		status.Message = fmt.Sprintf("Invalid schedule: %v", err)
+		return ctrl.Result{}, r.Status().Update(ctx, &scheduled)
+	}
+	if scheduled.Spec.Suspend {
+		status.NextScheduleTime = nil
+		status.Message = "Suspended"
+		return ctrl.Result{}, r.Status().Update(ctx, &scheduled)
+	}
+
+	now := time.Now()
+	due, next := dueRun(&scheduled, schedule, now.In(loc))
+	if !due.IsZero() {
+		status.LastScheduleTime = &metav1.Time{Time: due}
+		if scheduled.Spec.ConcurrencyPolicy == quantumv1.ConcurrencyForbid && len(active) > 0 {
+			status.Message = fmt.Sprintf("Skipped the run due at %s: %d jobs still running",
+				due.Format(time.RFC3339), len(active))
+			r.event(&scheduled, corev1.EventTypeNormal, "RunSkipped", status.Message)
+		} else {
+			if scheduled.Spec.ConcurrencyPolicy == quantumv1.ConcurrencyReplace {
+				for _, job := range active {
+					if err := r.cancel(ctx, &scheduled, job); err != nil {
+						return ctrl.Result{}, err
+					}
+				}
+			}
+			job, err := r.jobForRun(&scheduled, due)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			// The run was started already if the status update recording it failed
+			if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+				return ctrl.Result{}, err
+			}
+			logger.Info("Started scheduled job", "job", job.Name, "due", due)
+			if !slices.Contains(status.Active, job.Name) {
+				status.Active = append(status.Active, job.Name)
+			}
+			status.Message = fmt.Sprintf("Started %s", job.Name)
+			r.event(&scheduled, corev1.EventTypeNormal, "RunStarted", status.Message)
+		}
+	}
+
+	if next.IsZero() {
+		status.NextScheduleTime = nil
+		status.Message = "The schedule matches no time within five years"
+		return ctrl.Result{}, r.Status().Update(ctx, &scheduled)
+	}
+	status.NextScheduleTime = &metav1.Time{Time: next}
+	if err := r.Status().Update(ctx, &scheduled); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+}
+
+// parseSchedule parses the schedule and time zone of the spec
+func parseSchedule(spec *quantumv1.ScheduledQiskitJobSpec) (*cron.Schedule, *time.Location, error) {
+	schedule, err := cron.Parse(spec.Schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	zone := spec.TimeZone
+	if zone == "" {
+		zone = "UTC"
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown time zone %q", zone)
+	}
+	return schedule, loc, nil
+}
+
+// dueRun returns the most recent run of the schedule due by now that has not
+// started yet, zero if there is none or it was missed by more than the
+// starting deadline, and the next run after now
+func dueRun(scheduled *quantumv1.ScheduledQiskitJob, schedule *cron.Schedule, now time.Time) (time.Time, time.Time) {
+	earliest := scheduled.CreationTimestamp.Time
+	if scheduled.Status.LastScheduleTime != nil {
+		earliest = scheduled.Status.LastScheduleTime.Time
+	}
+	if earliest.IsZero() {
+		earliest = now
+	}
+	if deadline := scheduled.Spec.StartingDeadlineSeconds; deadline != nil {
+		if cutoff := now.Add(-time.Duration(*deadline) * time.Second); cutoff.After(earliest) {
+			// Runs are due on whole minutes; one due at the cutoff is in time
+			earliest = cutoff.Add(-time.Nanosecond)
+		}
+	}
+
+	var due time.Time
+	t := schedule.Next(earliest.In(now.Location()))
+	for !t.IsZero() && !t.After(now) {
+		due = t
+		t = schedule.Next(t)
+	}
+	return due, t
+}
+
+// syncHistory records when the schedule's jobs last completed, deletes the
+// oldest finished ones beyond the history limits and returns those that
+// have not finished
+func (r *ScheduledQiskitJobReconciler) syncHistory(ctx context.Context, scheduled *quantumv1.ScheduledQiskitJob) ([]*quantumv1.QiskitJob, error) {
+	var active, succeeded, failed []*quantumv1.QiskitJob
+	err := eachJob(ctx, r.Client, r.Jobs, func(job *quantumv1.QiskitJob) error {
+		if !metav1.IsControlledBy(job, scheduled) || !job.DeletionTimestamp.IsZero() {
+			return nil
+		}
+		job = job.DeepCopy()
+		switch {
+		case cancellable(job):
+			active = append(active, job)
+		case job.Status.Phase == PhaseCompleted:
+			succeeded = append(succeeded, job)
+		default:
+			failed = append(failed, job)
+		}
+		return nil
+	}, client.InNamespace(scheduled.Namespace), client.MatchingLabels{ScheduledJobLabel: scheduled.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range succeeded {
+		completed := job.Status.CompletionTime
+		if completed != nil && (scheduled.Status.LastSuccessfulTime == nil || scheduled.Status.LastSuccessfulTime.Before(completed)) {
+			scheduled.Status.LastSuccessfulTime = completed.DeepCopy()
+		}
+	}
+	if err := r.prune(ctx, succeeded, historyLimit(scheduled.Spec.SuccessfulJobsHistoryLimit, defaultSuccessfulJobsHistory)); err != nil {
+		return nil, err
+	}
+	if err := r.prune(ctx, failed, historyLimit(scheduled.Spec.FailedJobsHistoryLimit, defaultFailedJobsHistory)); err != nil {
+		return nil, err
+	}
+	return active, nil
+}
+
+// historyLimit returns the limit, or the default if it is unset
+func historyLimit(limit *int32, fallback int) int {
+	if limit == nil {
+		return fallback
+	}
+	return int(*limit)
+}
+
+// prune deletes all but the newest limit jobs
+func (r *ScheduledQiskitJobReconciler) prune(ctx context.Context, jobs []*quantumv1.QiskitJob, limit int) error {
+	if len(jobs) <= limit {
+		return nil
+	}
+	slices.SortFunc(jobs, func(a, b *quantumv1.QiskitJob) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	for _, job := range jobs[limit:] {
+		log.FromContext(ctx).Info("Deleting old scheduled job", "job", job.Name)
+		if err := r.Delete(ctx, job); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancel cancels a job of an earlier run that is replaced
+func (r *ScheduledQiskitJobReconciler) cancel(ctx context.Context, scheduled *quantumv1.ScheduledQiskitJob, job *quantumv1.QiskitJob) error {
+	if _, ok := job.Annotations[CancelAnnotation]; ok {
+		return nil
+	}
+	patch := client.MergeFrom(job.DeepCopy())
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[CancelAnnotation] = fmt.Sprintf("replaced by the next run of %s", scheduled.Name)
+	return client.IgnoreNotFound(r.Patch(ctx, job, patch))
+}
+
+// jobForRun builds the job of the run due at the given time. It is named
+// after the schedule and the minute the run was due, so a run is started
+// only once.
+func (r *ScheduledQiskitJobReconciler) jobForRun(scheduled *quantumv1.ScheduledQiskitJob, due time.Time) (*quantumv1.QiskitJob, error) {
+	template := &scheduled.Spec.JobTemplate
+	job := &quantumv1.QiskitJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%d", scheduled.Name, due.Unix()/60),
+			Namespace:   scheduled.Namespace,
+			Labels:      maps.Clone(template.Metadata.Labels),
+			Annotations: maps.Clone(template.Metadata.Annotations),
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[ScheduledJobLabel] = scheduled.Name
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[ScheduledTimeAnnotation] = due.UTC().Format(time.RFC3339)
+	if err := controllerutil.SetControllerReference(scheduled, job, r.Scheme); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// event records an event on the schedule, if the reconciler has a recorder
+func (r *ScheduledQiskitJobReconciler) event(scheduled *quantumv1.ScheduledQiskitJob, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(scheduled, eventType, reason, message)
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScheduledQiskitJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&quantumv1.ScheduledQiskitJob{}).
+		Owns(&quantumv1.QiskitJob{}).
+		Named("scheduledqiskitjob").
+		Complete(r)
+}
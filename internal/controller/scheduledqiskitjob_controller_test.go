@@ -0,0 +1,203 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("ScheduledQiskitJob Controller", func() {
+	ctx := context.Background()
+
+	// newSchedule returns a schedule last run ten minutes ago
+	newSchedule := func(schedule, policy string) *quantumv1.ScheduledQiskitJob {
+		template := builder.NewBellStateJob("template", "default").Build()
+		return &quantumv1.ScheduledQiskitJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "nightly",
+				Namespace:         "default",
+				UID:               types.UID("nightly-uid"),
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			Spec: quantumv1.ScheduledQiskitJobSpec{
+				Schedule:          schedule,
+				ConcurrencyPolicy: policy,
+				JobTemplate: quantumv1.QiskitJobTemplateSource{
+					Metadata: quantumv1.JobTemplateMetadata{Labels: map[string]string{"quantum.io/experiment": "calibration"}},
+					Spec:     template.Spec,
+				},
+			},
+			Status: quantumv1.ScheduledQiskitJobStatus{
+				LastScheduleTime: &metav1.Time{Time: time.Now().Add(-10 * time.Minute).Truncate(time.Minute)},
+			},
+		}
+	}
+
+	// startedJob returns a job the schedule started at the given age, in
+	// the phase
+	startedJob := func(scheduled *quantumv1.ScheduledQiskitJob, name string, phase quantumv1.QiskitJobPhase, age time.Duration) *quantumv1.QiskitJob {
+		job := builder.NewBellStateJob(name, "default").Build()
+		job.Labels = map[string]string{ScheduledJobLabel: scheduled.Name}
+		job.CreationTimestamp = metav1.NewTime(time.Now().Add(-age).Truncate(time.Second))
+		job.Status.Phase = phase
+		Expect(controllerutil.SetControllerReference(scheduled, job, k8sClient.Scheme())).To(Succeed())
+		return job
+	}
+
+	newClient := func(objects ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(objects...).
+			WithStatusSubresource(&quantumv1.ScheduledQiskitJob{}, &quantumv1.QiskitJob{}).Build()
+	}
+
+	run := func(c client.Client, scheduled *quantumv1.ScheduledQiskitJob) (reconcile.Result, *quantumv1.ScheduledQiskitJob) {
+		r := &ScheduledQiskitJobReconciler{Client: c, Scheme: c.Scheme()}
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(scheduled)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(scheduled), scheduled)).To(Succeed())
+		return result, scheduled
+	}
+
+	scheduledJobs := func(c client.Client) []quantumv1.QiskitJob {
+		var jobs quantumv1.QiskitJobList
+		Expect(c.List(ctx, &jobs, client.MatchingLabels{ScheduledJobLabel: "nightly"})).To(Succeed())
+		return jobs.Items
+	}
+
+	It("should start the most recent missed run once and requeue for the next", func() {
+		scheduled := newSchedule("* * * * *", "")
+		c := newClient(scheduled)
+
+		result, scheduled := run(c, scheduled)
+		due := time.Now().Truncate(time.Minute)
+		jobs := scheduledJobs(c)
+		Expect(jobs).To(HaveLen(1))
+		job := jobs[0]
+		Expect(job.Name).To(Equal(fmt.Sprintf("nightly-%d", due.Unix()/60)))
+		Expect(job.Labels).To(HaveKeyWithValue("quantum.io/experiment", "calibration"))
+		Expect(job.Annotations).To(HaveKeyWithValue(ScheduledTimeAnnotation, due.UTC().Format(time.RFC3339)))
+		Expect(metav1.IsControlledBy(&job, scheduled)).To(BeTrue())
+		Expect(job.Spec.Backend.Type).To(Equal("local_simulator"))
+
+		Expect(scheduled.Status.Active).To(ConsistOf(job.Name))
+		Expect(scheduled.Status.LastScheduleTime.Time).To(BeTemporally("==", due))
+		Expect(scheduled.Status.NextScheduleTime.Time).To(BeTemporally("==", due.Add(time.Minute)))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(result.RequeueAfter).To(BeNumerically("<=", time.Minute))
+
+		// Reconciling again within the minute starts nothing new
+		_, _ = run(c, scheduled)
+		Expect(scheduledJobs(c)).To(HaveLen(1))
+	})
+
+	It("should skip runs missed by more than the starting deadline", func() {
+		scheduled := newSchedule("0 0 1 1 *", "")
+		scheduled.Status.LastScheduleTime = &metav1.Time{Time: time.Now().AddDate(-2, 0, 0)}
+		scheduled.Spec.StartingDeadlineSeconds = ptr[int64](60)
+		c := newClient(scheduled)
+
+		_, scheduled = run(c, scheduled)
+		Expect(scheduledJobs(c)).To(BeEmpty())
+		Expect(scheduled.Status.NextScheduleTime).NotTo(BeNil())
+		Expect(scheduled.Status.NextScheduleTime.Month()).To(Equal(time.January))
+	})
+
+	It("should skip a run while earlier jobs run if concurrency is forbidden", func() {
+		scheduled := newSchedule("* * * * *", quantumv1.ConcurrencyForbid)
+		running := startedJob(scheduled, "nightly-running", PhaseRunning, 10*time.Minute)
+		c := newClient(scheduled, running)
+
+		_, scheduled = run(c, scheduled)
+		Expect(scheduledJobs(c)).To(HaveLen(1))
+		Expect(scheduled.Status.Active).To(ConsistOf("nightly-running"))
+		Expect(scheduled.Status.Message).To(ContainSubstring("Skipped"))
+		Expect(scheduled.Status.LastScheduleTime.Time).To(BeTemporally("==", time.Now().Truncate(time.Minute)))
+	})
+
+	It("should cancel earlier jobs that still run if concurrency is replaced", func() {
+		scheduled := newSchedule("* * * * *", quantumv1.ConcurrencyReplace)
+		running := startedJob(scheduled, "nightly-running", PhaseRunning, 10*time.Minute)
+		c := newClient(scheduled, running)
+
+		_, _ = run(c, scheduled)
+		Expect(scheduledJobs(c)).To(HaveLen(2))
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(running), running)).To(Succeed())
+		Expect(running.Annotations).To(HaveKey(CancelAnnotation))
+	})
+
+	It("should delete finished jobs beyond the history limits", func() {
+		scheduled := newSchedule("0 0 1 1 *", "")
+		scheduled.Spec.SuccessfulJobsHistoryLimit = ptr[int32](1)
+		scheduled.Spec.FailedJobsHistoryLimit = ptr[int32](0)
+		completed := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		newest := startedJob(scheduled, "nightly-3", PhaseCompleted, 2*time.Hour)
+		newest.Status.CompletionTime = &completed
+		objects := []client.Object{
+			scheduled,
+			startedJob(scheduled, "nightly-1", PhaseCompleted, 4*time.Hour),
+			startedJob(scheduled, "nightly-2", PhaseCancelled, 3*time.Hour),
+			newest,
+			startedJob(scheduled, "nightly-4", PhaseRunning, time.Hour),
+		}
+		c := newClient(objects...)
+
+		_, scheduled = run(c, scheduled)
+		names := []string{}
+		for _, job := range scheduledJobs(c) {
+			names = append(names, job.Name)
+		}
+		Expect(names).To(ConsistOf("nightly-3", "nightly-4"))
+		Expect(scheduled.Status.Active).To(ConsistOf("nightly-4"))
+		Expect(scheduled.Status.LastSuccessfulTime.Time).To(BeTemporally("==", completed.Time))
+	})
+
+	It("should start no runs while suspended or if the schedule is invalid", func() {
+		suspended := newSchedule("* * * * *", "")
+		suspended.Spec.Suspend = true
+		c := newClient(suspended)
+		_, suspended = run(c, suspended)
+		Expect(scheduledJobs(c)).To(BeEmpty())
+		Expect(suspended.Status.Message).To(Equal("Suspended"))
+		Expect(suspended.Status.NextScheduleTime).To(BeNil())
+
+		invalid := newSchedule("61 * * * *", "")
+		c = newClient(invalid)
+		result, invalid := run(c, invalid)
+		Expect(scheduledJobs(c)).To(BeEmpty())
+		Expect(invalid.Status.Message).To(ContainSubstring("Invalid schedule"))
+		Expect(result.RequeueAfter).To(BeZero())
+
+		zone := newSchedule("* * * * *", "")
+		zone.Spec.TimeZone = "Mars/Olympus_Mons"
+		c = newClient(zone)
+		_, zone = run(c, zone)
+		Expect(zone.Status.Message).To(ContainSubstring("unknown time zone"))
+	})
+})
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+// Archive keeps the records of jobs deleted once their TTL ran out
+type Archive interface {
+	// Store writes data under key, replacing what is stored there
+	Store(ctx context.Context, key string, data []byte) error
+	// Location returns the URI of what is stored under key
+	Location(key string) string
+}
+
+// ArchiveRecord is what is archived of a job: the job as it was last seen,
+// and the results its configmap outputs held
+type ArchiveRecord struct {
+	ArchivedAt metav1.Time          `json:"archivedAt"`
+	Job        *quantumv1.QiskitJob `json:"job"`
+	Results    *Document            `json:"results,omitempty"`
+}
+
+// DiagnosticsRecord is what is archived of a failed attempt before it is
+// retried: the job's status when it failed, the pod that ran it and the tail
+// of its executor logs
+type DiagnosticsRecord struct {
+	ArchivedAt metav1.Time                `json:"archivedAt"`
+	Namespace  string                     `json:"namespace"`
+	Job        string                     `json:"job"`
+	Attempt    int                        `json:"attempt"`
+	Status     *quantumv1.QiskitJobStatus `json:"status"`
+	Pod        *corev1.Pod                `json:"pod,omitempty"`
+	Logs       string                     `json:"logs,omitempty"`
+	Truncated  bool                       `json:"truncated,omitempty"`
+}
+
+// NewArchive returns the archive at rawURL: s3://<bucket>/<prefix> with the
+// credentials in the ARCHIVE_ACCESS_KEY_ID and ARCHIVE_SECRET_ACCESS_KEY
+// environment variables, and optionally ARCHIVE_SESSION_TOKEN,
+// ARCHIVE_REGION and ARCHIVE_ENDPOINT, or file:///<directory> for a volume
+// such as a PersistentVolumeClaim mounted into the operator
+func NewArchive(rawURL string) (Archive, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("archive URL %q is invalid: %w", rawURL, err)
+	}
+	switch u.Scheme {
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf("archive URL %q has no bucket", rawURL)
+		}
+		creds := &S3Credentials{
+			AccessKeyID:     os.Getenv("ARCHIVE_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("ARCHIVE_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("ARCHIVE_SESSION_TOKEN"),
+			Region:          os.Getenv("ARCHIVE_REGION"),
+			Endpoint:        strings.TrimSuffix(os.Getenv("ARCHIVE_ENDPOINT"), "/"),
+		}
+		if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+			return nil, fmt.Errorf("s3 archives need ARCHIVE_ACCESS_KEY_ID and ARCHIVE_SECRET_ACCESS_KEY")
+		}
+		if creds.Region == "" {
+			creds.Region = "us-east-1"
+		}
+		if creds.Endpoint != "" {
+			if e, err := url.Parse(creds.Endpoint); err != nil || (e.Scheme != "http" && e.Scheme != "https") || e.Host == "" {
+				return nil, fmt.Errorf("ARCHIVE_ENDPOINT %q must be an http or https URL", creds.Endpoint)
+			}
+		}
+		prefix := strings.Trim(u.Path, "/")
+		if prefix != "" {
+			prefix += "/"
+		}
+		return &S3Archive{Credentials: creds, Bucket: u.Host, Prefix: prefix}, nil
+	case "file":
+		if u.Host != "" || !path.IsAbs(u.Path) {
+			return nil, fmt.Errorf("archive URL %q must name an absolute directory, e.g. file:///archive", rawURL)
+		}
+		return &DirArchive{Dir: u.Path}, nil
+	}
+	return nil, fmt.Errorf("archive URL %q must be an s3:// or file:// URL", rawURL)
+}
+
+// S3Archive stores records as objects of a bucket
+type S3Archive struct {
+	Credentials *S3Credentials
+	Bucket      string
+	// Prefix of the keys records are stored under, ending in a slash
+	Prefix string
+}
+
+// Store implements Archive
+func (a *S3Archive) Store(ctx context.Context, key string, data []byte) error {
+	return a.Credentials.PutObject(ctx, a.Bucket, a.Prefix+key, data, "application/json", "")
+}
+
+// Location implements Archive
+func (a *S3Archive) Location(key string) string {
+	return "s3://" + a.Bucket + "/" + a.Prefix + key
+}
+
+// DirArchive stores records as files of a directory
+type DirArchive struct {
+	Dir string
+}
+
+// Store implements Archive. Records are written whole or not at all.
+func (a *DirArchive) Store(_ context.Context, key string, data []byte) error {
+	name := filepath.Join(a.Dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(name+".tmp", data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+// Location implements Archive
+func (a *DirArchive) Location(key string) string {
+	return "file://" + path.Join(filepath.ToSlash(a.Dir), key)
+}
+
+// ArchiveKey returns the key a job's record is archived under. The UID
+// keeps jobs recreated under the same name apart.
+func ArchiveKey(job *quantumv1.QiskitJob) string {
+	return path.Join(job.Namespace, job.Name+"-"+string(job.UID)+".json")
+}
+
+// DiagnosticsKey returns the key the diagnostics of an attempt of a job are
+// archived under, next to the job's own record
+func DiagnosticsKey(job *quantumv1.QiskitJob, attempt int) string {
+	return path.Join(job.Namespace, job.Name+"-"+string(job.UID), fmt.Sprintf("attempt-%d.json", attempt))
+}
+
+// ArchiveDiagnostics stores the diagnostics of a failed attempt of the job,
+// keeping at most maxLogs bytes of its logs, and returns where. The pod is
+// nil if it is gone. Credentials in the logs and in the literal env values
+// of the pod are masked.
+func ArchiveDiagnostics(ctx context.Context, archive Archive, job *quantumv1.QiskitJob, attempt int,
+	pod *corev1.Pod, logs string, maxLogs int) (string, error) {
+	record := DiagnosticsRecord{
+		ArchivedAt: metav1.Now(),
+		Namespace:  job.Namespace,
+		Job:        job.Name,
+		Attempt:    attempt,
+		Status:     job.Status.DeepCopy(),
+	}
+	record.Logs, record.Truncated = Tail(redact.String(logs), maxLogs)
+	if pod != nil {
+		record.Pod = pod.DeepCopy()
+		record.Pod.APIVersion = "v1"
+		record.Pod.Kind = "Pod"
+		record.Pod.ManagedFields = nil
+		for i := range record.Pod.Spec.InitContainers {
+			redact.Env(record.Pod.Spec.InitContainers[i].Env)
+		}
+		for i := range record.Pod.Spec.Containers {
+			redact.Env(record.Pod.Spec.Containers[i].Env)
+		}
+	}
+
+	data, err := json.Marshal(&record)
+	if err != nil {
+		return "", err
+	}
+	key := DiagnosticsKey(job, attempt)
+	if err := archive.Store(ctx, key, data); err != nil {
+		return "", err
+	}
+	return archive.Location(key), nil
+}
+
+// ArchiveJob stores the job and the results of its configmap outputs in the
+// archive, and returns where. Outputs whose ConfigMap is gone are skipped.
+func ArchiveJob(ctx context.Context, c client.Reader, archive Archive, job *quantumv1.QiskitJob) (string, error) {
+	record := ArchiveRecord{ArchivedAt: metav1.Now(), Job: job.DeepCopy()}
+	record.Job.APIVersion = quantumv1.GroupVersion.String()
+	record.Job.Kind = "QiskitJob"
+	record.Job.ManagedFields = nil
+	for _, output := range job.Spec.Outputs {
+		if output.Type != "configmap" || output.Location == "" {
+			continue
+		}
+		doc, err := Read(ctx, c, job.Namespace, output.Location)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("reading results %s: %w", output.Location, err)
+		}
+		record.Results = doc
+		break
+	}
+
+	data, err := json.Marshal(&record)
+	if err != nil {
+		return "", err
+	}
+	key := ArchiveKey(job)
+	if err := archive.Store(ctx, key, data); err != nil {
+		return "", err
+	}
+	return archive.Location(key), nil
+}
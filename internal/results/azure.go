@@ -0,0 +1,304 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Keys of the Secret named by spec.outputs[].secretName of azure_blob outputs
+const (
+	AzureAccountNameKey = "account-name"
+	AzureAccountKeyKey  = "account-key"
+	// AzureEndpointKey holds the URL of the Blob service, such as Azurite's,
+	// https://<account>.blob.core.windows.net by default
+	AzureEndpointKey = "endpoint"
+)
+
+// azureVersion is the version of the Blob service REST API requests use
+const azureVersion = "2021-08-06"
+
+// azureSecretKeys are the keys of the Secret of azure_blob outputs
+var azureSecretKeys = []SecretKey{
+	{Key: AzureAccountNameKey, Env: "ACCOUNT_NAME"},
+	{Key: AzureAccountKeyKey, Env: "ACCOUNT_KEY"},
+	{Key: AzureEndpointKey, Env: "ENDPOINT", Optional: true},
+}
+
+// azureDriver stores results in the Blob Storage container named by the
+// output's location, under JobPrefix, authenticated with the account's
+// shared key
+var azureDriver = &StoreDriver{
+	Open: func(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error) {
+		secret, err := env.secret(ctx, output, azureSecretKeys)
+		if err != nil {
+			return nil, err
+		}
+		return azureStoreFromSecret(secret, output.Location, env.Prefix)
+	},
+	SecretKeys: azureSecretKeys,
+	Scheme:     "azure",
+	Uploaded:   true,
+}
+
+// azureStore keeps objects as block blobs of a container, under the job's
+// prefix. Blobs carry their checksum as metadata, and their labels and
+// retention as index tags that blob queries and lifecycle rules filter on.
+type azureStore struct {
+	account   string
+	key       []byte
+	endpoint  string
+	container string
+	prefix    string
+}
+
+// azureStoreFromSecret returns the store of a container with the
+// credentials in the Secret
+func azureStoreFromSecret(secret *corev1.Secret, container, prefix string) (*azureStore, error) {
+	if err := requireKeys(secret, azureSecretKeys); err != nil {
+		return nil, err
+	}
+	store := &azureStore{
+		account:   string(secret.Data[AzureAccountNameKey]),
+		endpoint:  strings.TrimSuffix(string(secret.Data[AzureEndpointKey]), "/"),
+		container: container,
+		prefix:    prefix,
+	}
+	key, err := base64.StdEncoding.DecodeString(string(secret.Data[AzureAccountKeyKey]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: Secret %s has an invalid %s", ErrRejected, secret.Name, AzureAccountKeyKey)
+	}
+	store.key = key
+	if store.endpoint == "" {
+		store.endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", store.account)
+	}
+	if u, err := url.Parse(store.endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: Secret %s has an invalid %s %q", ErrRejected, secret.Name, AzureEndpointKey, store.endpoint)
+	}
+	return store, nil
+}
+
+// blobURL addresses a blob of the container; an empty key addresses the
+// container itself
+func (s *azureStore) blobURL(key string) string {
+	if key == "" {
+		return s.endpoint + "/" + s.container
+	}
+	return s.endpoint + "/" + s.container + escapeS3Path(key)
+}
+
+// Put implements ResultStore
+func (s *azureStore) Put(ctx context.Context, object *Object) error {
+	key := s.prefix + object.Key
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.blobURL(key), bytes.NewReader(object.Data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	req.Header.Set("Content-Type", object.ContentType)
+	req.Header.Set("Content-MD5", contentMD5(object.Data))
+	if object.Checksum != "" {
+		req.Header.Set("x-ms-meta-"+azureMetadataName(ChecksumAnnotation), object.Checksum)
+	}
+	if tags := objectTags(object); tags != "" {
+		req.Header.Set("x-ms-tags", tags)
+	}
+	resp, err := s.do(req, len(object.Data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return storeError(resp, fmt.Sprintf("uploading azure://%s/%s", s.container, key))
+}
+
+// Get implements ResultStore
+func (s *azureStore) Get(ctx context.Context, key string) (*Object, error) {
+	key = s.prefix + key
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.blobURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := storeError(resp, fmt.Sprintf("downloading azure://%s/%s", s.container, key)); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Object{
+		Key:         strings.TrimPrefix(key, s.prefix),
+		Data:        data,
+		ContentType: resp.Header.Get("Content-Type"),
+		Checksum:    resp.Header.Get("x-ms-meta-" + azureMetadataName(ChecksumAnnotation)),
+	}, nil
+}
+
+// List implements ResultStore with List Blobs
+func (s *azureStore) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	marker := ""
+	for {
+		query := url.Values{"restype": {"container"}, "comp": {"list"}, "prefix": {s.prefix + prefix}}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.blobURL(""), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.URL.RawQuery = query.Encode()
+		resp, err := s.do(req, 0)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Blobs []struct {
+				Name string `xml:"Name"`
+				Size int64  `xml:"Properties>Content-Length"`
+			} `xml:"Blobs>Blob"`
+			NextMarker string `xml:"NextMarker"`
+		}
+		err = storeError(resp, fmt.Sprintf("listing azure://%s/%s", s.container, s.prefix+prefix))
+		if err == nil {
+			err = xml.NewDecoder(resp.Body).Decode(&result)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range result.Blobs {
+			objects = append(objects, ObjectInfo{Key: strings.TrimPrefix(blob.Name, s.prefix), Size: blob.Size})
+		}
+		if result.NextMarker == "" {
+			return objects, nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+// Delete implements ResultStore
+func (s *azureStore) Delete(ctx context.Context, key string) error {
+	key = s.prefix + key
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.blobURL(key), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, 0)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return storeError(resp, fmt.Sprintf("deleting azure://%s/%s", s.container, key))
+}
+
+// do signs and sends a request to the Blob service
+func (s *azureStore) do(req *http.Request, length int) (*http.Response, error) {
+	s.Sign(req, length, time.Now())
+	return s3HTTPClient.Do(req)
+}
+
+// Sign adds the Shared Key authorization of the account to req, signing all
+// x-ms- headers it already has and its query
+func (s *azureStore) Sign(req *http.Request, length int, now time.Time) {
+	req.Header.Set("x-ms-date", now.UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureVersion)
+
+	var names []string
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			names = append(names, lower)
+		}
+	}
+	sort.Strings(names)
+	var canonical strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canonical, "%s:%s\n", name, strings.TrimSpace(strings.Join(req.Header.Values(name), ",")))
+	}
+	canonical.WriteString("/" + s.account + req.URL.EscapedPath())
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for name := range query {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	for _, name := range params {
+		values := query[name]
+		sort.Strings(values)
+		fmt.Fprintf(&canonical, "\n%s:%s", strings.ToLower(name), strings.Join(values, ","))
+	}
+
+	contentLength := ""
+	if length > 0 {
+		contentLength = strconv.Itoa(length)
+	}
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, superseded by x-ms-date
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonical.String(),
+	}, "\n")
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(stringToSign))
+	req.Header.Set("Authorization", "SharedKey "+s.account+":"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+}
+
+// azureMetadataName turns a label key into the name of blob metadata, which
+// must be a C# identifier
+func azureMetadataName(key string) string {
+	name := strings.ToLower(invalidAzureMetadataChars.ReplaceAllString(key, "_"))
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+var invalidAzureMetadataChars = regexp.MustCompile(`[^A-Za-z0-9_]+`)
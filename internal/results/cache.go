@@ -0,0 +1,223 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// Cache policies of spec.execution.cachePolicy
+const (
+	CachePolicyUse     = "use"
+	CachePolicyIgnore  = "ignore"
+	CachePolicyRefresh = "refresh"
+)
+
+const (
+	// CacheLabel marks the ConfigMaps of the results cache
+	CacheLabel = "quantum.io/results-cache"
+	// CacheEntryKey is the ConfigMap key of a cache entry, before any compression extension
+	CacheEntryKey = "entry.json"
+)
+
+// CacheEntry is the counts of a completed execution, kept for later jobs
+// running the same circuit the same way. Entries live in a ConfigMap per key
+// in the namespace of the job that produced them, and outlive that job.
+type CacheEntry struct {
+	Key string `json:"key"`
+	// Job is the name of the job that produced the counts
+	Job     string         `json:"job"`
+	Backend string         `json:"backend,omitempty"`
+	Counts  map[string]int `json:"counts"`
+	// ExecutionTime is how long the producing execution ran, if known
+	ExecutionTime string      `json:"executionTime,omitempty"`
+	CachedAt      metav1.Time `json:"cachedAt"`
+}
+
+// CacheName returns the name of the ConfigMap holding the cache entry for key
+func CacheName(key string) string {
+	return "qiskit-results-cache-" + key
+}
+
+// CacheKey returns the key the results of a job are cached under: a hash of
+// its circuit hash, shots, backend, optimization level, transpiler options
+// and error mitigation. It reports false
+// for jobs whose results cannot be reused, because their circuit is not
+// pinned to its content (configmap, git and unpinned url sources may change
+// under the same definition), their results are more than plain counts
+// (sweeps, optimizer loops, shadow and verification runs), or they read
+// environment variables from other objects.
+func CacheKey(job *quantumv1.QiskitJob) (string, bool) {
+	metadata := job.Status.CircuitMetadata
+	if metadata == nil || metadata.Hash == "" {
+		return "", false
+	}
+	circuit := job.Spec.Circuit
+	switch {
+	case circuit.Source == "url" && circuit.SHA256 != "":
+	case circuit.Source == "inline" || circuit.Source == "":
+	default:
+		return "", false
+	}
+	if job.Spec.Sweep != nil || job.Spec.Tomography != nil || job.Spec.Split != nil || job.Spec.Optimizer != nil || defaults.Estimates(&job.Spec) || job.Spec.Shadow != nil ||
+		job.Spec.Verify != nil || len(job.Spec.Execution.EnvFrom) > 0 {
+		return "", false
+	}
+
+	shots := job.Spec.Execution.Shots
+	if shots <= 0 {
+		shots = defaults.Shots
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "circuit=%s\nshots=%d\nbackend=%s/%s\noptimization=%d\n",
+		metadata.Hash, shots, job.Spec.Backend.Type, job.Spec.Backend.Name, optimizationLevel(job))
+	if transpiler := job.Spec.Execution.Transpiler; transpiler != nil {
+		data, err := json.Marshal(transpiler)
+		if err != nil {
+			return "", false
+		}
+		fmt.Fprintf(h, "transpiler=%s\n", data)
+	}
+	if mitigation := job.Spec.Execution.ErrorMitigation; mitigation != nil {
+		data, err := json.Marshal(mitigation)
+		if err != nil {
+			return "", false
+		}
+		fmt.Fprintf(h, "mitigation=%s\n", data)
+	}
+	for _, env := range job.Spec.Execution.Env {
+		if env.ValueFrom != nil {
+			return "", false
+		}
+		fmt.Fprintf(h, "env=%s=%s\n", env.Name, env.Value)
+	}
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// optimizationLevel returns the optimization level the job transpiles at
+func optimizationLevel(job *quantumv1.QiskitJob) int {
+	if level := job.Spec.Execution.OptimizationLevel; level > 0 {
+		return level
+	}
+	return defaults.OptimizationLevel
+}
+
+// LookupCache returns the cached counts for the job, or nil if there are
+// none younger than ttl. Entries that cannot be read count as missing.
+func LookupCache(ctx context.Context, c client.Reader, job *quantumv1.QiskitJob, ttl time.Duration) (*CacheEntry, error) {
+	key, ok := CacheKey(job)
+	if !ok {
+		return nil, nil
+	}
+
+	var cm corev1.ConfigMap
+	if err := c.Get(ctx, types.NamespacedName{Name: CacheName(key), Namespace: job.Namespace}, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	data, err := readPayload(&cm, CacheEntryKey)
+	if err != nil {
+		return nil, nil
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key || entry.Counts == nil {
+		return nil, nil
+	}
+	if ttl > 0 && time.Since(entry.CachedAt.Time) > ttl {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// StoreCache caches the counts of a completed job, replacing any entry for
+// the same key. Jobs that are not cacheable are skipped; counts too large for
+// a ConfigMap fail with ErrTooLarge.
+func StoreCache(ctx context.Context, c client.Client, job *quantumv1.QiskitJob, counts map[string]int, info *quantumv1.ResultsInfo) error {
+	key, ok := CacheKey(job)
+	if !ok || counts == nil {
+		return nil
+	}
+
+	entry := CacheEntry{
+		Key:      key,
+		Job:      job.Name,
+		Backend:  job.Status.SelectedBackend,
+		Counts:   counts,
+		CachedAt: metav1.Now(),
+	}
+	if info != nil {
+		entry.ExecutionTime = info.ExecutionTime
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{}
+	err = c.Get(ctx, types.NamespacedName{Name: CacheName(key), Namespace: job.Namespace}, cm)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+	if !exists {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      CacheName(key),
+				Namespace: job.Namespace,
+			},
+		}
+	}
+	if cm.Labels == nil {
+		cm.Labels = map[string]string{}
+	}
+	cm.Labels["app"] = "qiskit-operator"
+	cm.Labels[CacheLabel] = "true"
+	if err := setPayload(cm, CacheEntryKey, data, CompressionGzip); err != nil {
+		return err
+	}
+	if size := configMapSize(cm); size > maxConfigMapBytes {
+		return fmt.Errorf("%w: cache entry is %d bytes", ErrTooLarge, size)
+	}
+
+	if exists {
+		return c.Update(ctx, cm)
+	}
+	return c.Create(ctx, cm)
+}
+
+// UsesCache reports whether a job stores its results in the cache once it
+// completes
+func UsesCache(job *quantumv1.QiskitJob) bool {
+	policy := job.Spec.Execution.CachePolicy
+	return policy == CachePolicyUse || policy == CachePolicyRefresh
+}
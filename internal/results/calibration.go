@@ -0,0 +1,54 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Calibration is the state of the device a job ran on, as its provider
+// published it when the job was scheduled
+type Calibration struct {
+	BackendVersion string  `json:"backend_version,omitempty"`
+	Qubits         int     `json:"qubits,omitempty"`
+	GateError      float64 `json:"gate_error,omitempty"`
+	ReadoutError   float64 `json:"readout_error,omitempty"`
+	// Timestamp is when the device was last calibrated
+	Timestamp *time.Time `json:"calibration_timestamp,omitempty"`
+}
+
+// recordedCalibration returns the calibration recorded in the job's backend
+// info, nil for backends without one
+func recordedCalibration(job *quantumv1.QiskitJob) *Calibration {
+	info := job.Status.BackendInfo
+	if info == nil || (info.CalibrationTimestamp == nil && info.Version == "") {
+		return nil
+	}
+	calibration := &Calibration{
+		BackendVersion: info.Version,
+		Qubits:         info.Qubits,
+		GateError:      info.GateError,
+		ReadoutError:   info.ReadoutError,
+	}
+	if info.CalibrationTimestamp != nil {
+		timestamp := info.CalibrationTimestamp.UTC()
+		calibration.Timestamp = &timestamp
+	}
+	return calibration
+}
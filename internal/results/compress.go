@@ -0,0 +1,164 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Compression algorithms accepted in spec.outputs[].compression
+const (
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// ResultsKey is the ConfigMap key of uncompressed results; compressed results
+// are stored in binaryData under ResultsKey plus the algorithm's extension
+const ResultsKey = "results.json"
+
+// extensions maps compression algorithms to their file extensions
+var extensions = map[string]string{
+	CompressionGzip: ".gz",
+	CompressionZstd: ".zst",
+}
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Compress encodes data with the given algorithm; "" and "none" return data unchanged
+func Compress(data []byte, algorithm string) ([]byte, error) {
+	switch algorithm {
+	case "", CompressionNone:
+		return data, nil
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+		if err != nil {
+			return nil, err
+		}
+		defer enc.Close()
+		return enc.EncodeAll(data, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", algorithm)
+	}
+}
+
+// Decompress decodes gzip or zstd data, detected by its magic bytes. Anything
+// else is returned unchanged, so callers need not know how results were stored.
+func Decompress(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = r.Close() }()
+		return io.ReadAll(r)
+	case bytes.HasPrefix(data, zstdMagic):
+		dec, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		return dec.DecodeAll(data, nil)
+	default:
+		return data, nil
+	}
+}
+
+// setPayload stores data under key in the ConfigMap, compressed with
+// algorithm, and drops anything previously stored under key with another
+// encoding
+func setPayload(cm *corev1.ConfigMap, key string, data []byte, algorithm string) error {
+	encoded, err := Compress(data, algorithm)
+	if err != nil {
+		return err
+	}
+
+	delete(cm.Data, key)
+	for _, ext := range extensions {
+		delete(cm.BinaryData, key+ext)
+	}
+	if ext, ok := extensions[algorithm]; ok {
+		if cm.BinaryData == nil {
+			cm.BinaryData = map[string][]byte{}
+		}
+		cm.BinaryData[key+ext] = encoded
+		return nil
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[key] = string(encoded)
+	return nil
+}
+
+// readPayload returns the decompressed data stored under key in the ConfigMap
+func readPayload(cm *corev1.ConfigMap, key string) ([]byte, error) {
+	var raw []byte
+	if data, ok := cm.Data[key]; ok {
+		raw = []byte(data)
+	} else {
+		for _, ext := range extensions {
+			if data, ok := cm.BinaryData[key+ext]; ok {
+				raw = data
+				break
+			}
+		}
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("configmap %s/%s has no %s", cm.Namespace, cm.Name, key)
+	}
+
+	data, err := Decompress(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// ReadConfigMap returns the results document stored in a results ConfigMap,
+// decompressing it if needed. Sharded counts are not loaded; use Read for
+// those.
+func ReadConfigMap(cm *corev1.ConfigMap) (*Document, error) {
+	data, err := readPayload(cm, ResultsKey)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeDocument(data)
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// configMapDriver stores results in the ConfigMap named by the output's
+// location, owned by the job, and the shards of their counts in
+// <location>-shard-<n>
+var configMapDriver = &StoreDriver{
+	Open: func(_ context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error) {
+		if env.Client == nil || env.Job == nil {
+			return nil, fmt.Errorf("%w: configmap outputs are written by the operator", ErrRejected)
+		}
+		return &configMapStore{reader: env.Client, client: env.Client, scheme: env.Scheme, owner: env.Job,
+			namespace: env.Job.Namespace, name: output.Location}, nil
+	},
+	IndentedJSON: true,
+}
+
+// configMapStore keeps each object in a ConfigMap of its own: the results
+// document in the named ConfigMap, under its key, and shards in the shard
+// ConfigMaps, under ShardKey. Compressed payloads go in binaryData.
+type configMapStore struct {
+	reader client.Reader
+	// client writes the ConfigMaps; stores opened to read have none
+	client    client.Client
+	scheme    *runtime.Scheme
+	owner     *quantumv1.QiskitJob
+	namespace string
+	name      string
+}
+
+// locate returns the ConfigMap an object is stored in and its key there
+func (s *configMapStore) locate(key string) (string, string) {
+	if index, ok := shardIndex(key); ok {
+		return ShardName(s.name, index), ShardKey + strings.TrimPrefix(key, fmt.Sprintf("shard-%d.json", index))
+	}
+	return s.name, key
+}
+
+// Put implements ResultStore. The ConfigMap holds only the object.
+func (s *configMapStore) Put(ctx context.Context, object *Object) error {
+	if s.client == nil {
+		return errors.New("results ConfigMaps opened for reading cannot be written")
+	}
+	name, key := s.locate(object.Key)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   s.namespace,
+			Labels:      object.Labels,
+			Annotations: map[string]string{ChecksumAnnotation: object.Checksum},
+		},
+	}
+	if compressed(key) {
+		cm.BinaryData = map[string][]byte{key: object.Data}
+	} else {
+		cm.Data = map[string]string{key: string(object.Data)}
+	}
+	if size := configMapSize(cm); size > maxConfigMapBytes {
+		return fmt.Errorf("%w: %s is %d bytes; set the output's compression or shardSize",
+			ErrTooLarge, name, size)
+	}
+	return applyConfigMap(ctx, s.client, s.scheme, s.owner, cm)
+}
+
+// Get implements ResultStore
+func (s *configMapStore) Get(ctx context.Context, key string) (*Object, error) {
+	name, dataKey := s.locate(key)
+	cm := &corev1.ConfigMap{}
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: name}, cm); err != nil {
+		return nil, err
+	}
+	object := &Object{Key: key, Labels: cm.Labels, Checksum: cm.Annotations[ChecksumAnnotation]}
+	if data, ok := cm.Data[dataKey]; ok {
+		object.Data = []byte(data)
+	} else if data, ok := cm.BinaryData[dataKey]; ok {
+		object.Data = data
+	} else {
+		return nil, fmt.Errorf("configmap %s/%s has no %s", s.namespace, name, dataKey)
+	}
+	return object, nil
+}
+
+// List implements ResultStore. The results ConfigMap not existing is an
+// error, as there is nothing to list the shards of.
+func (s *configMapStore) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	cm := &corev1.ConfigMap{}
+	err := s.reader.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: s.name}, cm)
+	if err != nil && (prefix == "" || !apierrors.IsNotFound(err)) {
+		return nil, err
+	}
+	var objects []ObjectInfo
+	if err == nil {
+		objects = payloads(cm, func(key string) string { return key })
+	}
+
+	var list corev1.ConfigMapList
+	if err := s.reader.List(ctx, &list, client.InNamespace(s.namespace), client.HasLabels{ShardLabel}); err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		index, err := strconv.Atoi(strings.TrimPrefix(list.Items[i].Name, s.name+"-shard-"))
+		if err != nil || list.Items[i].Name != ShardName(s.name, index) {
+			continue
+		}
+		objects = append(objects, payloads(&list.Items[i], func(key string) string {
+			return fmt.Sprintf("shard-%d.json", index) + strings.TrimPrefix(key, ShardKey)
+		})...)
+	}
+
+	filtered := objects[:0]
+	for _, object := range objects {
+		if strings.HasPrefix(object.Key, prefix) {
+			filtered = append(filtered, object)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool { return filtered[i].Key < filtered[j].Key })
+	return filtered, nil
+}
+
+// payloads describes the payloads of a ConfigMap under the keys objects
+// returns for them
+func payloads(cm *corev1.ConfigMap, objects func(string) string) []ObjectInfo {
+	var infos []ObjectInfo
+	for key, value := range cm.Data {
+		infos = append(infos, ObjectInfo{Key: objects(key), Size: int64(len(value))})
+	}
+	for key, value := range cm.BinaryData {
+		infos = append(infos, ObjectInfo{Key: objects(key), Size: int64(len(value))})
+	}
+	return infos
+}
+
+// Delete implements ResultStore by deleting the ConfigMap holding the object
+func (s *configMapStore) Delete(ctx context.Context, key string) error {
+	if s.client == nil {
+		return errors.New("results ConfigMaps opened for reading cannot be deleted")
+	}
+	name, _ := s.locate(key)
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: s.namespace}}
+	return client.IgnoreNotFound(s.client.Delete(ctx, cm))
+}
+
+// compressed reports whether a key names a compressed payload
+func compressed(key string) bool {
+	for _, ext := range extensions {
+		if strings.HasSuffix(key, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportConfigMap writes the results document to the ConfigMap named by the
+// output's location, creating or updating it. If the output's shardSize
+// splits the counts, each shard gets its own ConfigMap and the named one only
+// holds the document without counts.
+func ExportConfigMap(ctx context.Context, c client.Client, scheme *runtime.Scheme, job *quantumv1.QiskitJob,
+	output *quantumv1.OutputSpec, doc *Document) error {
+	if output == nil || output.Location == "" {
+		return nil
+	}
+	return ExportStore(ctx, OperatorEnv(c, scheme, job), output, doc)
+}
+
+// Read returns the results stored in the named ConfigMap, decompressing them
+// and merging sharded counts back together
+func Read(ctx context.Context, c client.Reader, namespace, name string) (*Document, error) {
+	return LoadDocument(ctx, &configMapStore{reader: c, namespace: namespace, name: name})
+}
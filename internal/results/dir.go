@@ -0,0 +1,122 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// checksumExt is the extension of the file holding the checksum of a file
+// of a directory store, in the format of sha256sum
+const checksumExt = ".sha256"
+
+// dirDriver stores results in the claim of pvc outputs, under JobPrefix.
+// Only the uploader sidecar mounts the claim.
+var dirDriver = &StoreDriver{
+	Open: func(_ context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error) {
+		if env.Dir == "" {
+			return nil, fmt.Errorf("%w: the claim of pvc output %s is not mounted", ErrRejected, OutputName(output))
+		}
+		return &dirStore{dir: filepath.Join(env.Dir, filepath.FromSlash(env.Prefix))}, nil
+	},
+	Uploaded: true,
+	Mounted:  true,
+}
+
+// dirStore keeps objects as files of a directory, each with its checksum in
+// a .sha256 file next to it that sha256sum -c checks. Files are replaced
+// atomically, so readers of the volume never see them half written.
+type dirStore struct {
+	dir string
+}
+
+// Put implements ResultStore
+func (s *dirStore) Put(_ context.Context, object *Object) error {
+	if err := os.MkdirAll(s.dir, 0o775); err != nil {
+		return err
+	}
+	name := filepath.Join(s.dir, filepath.FromSlash(object.Key))
+	if err := writeFile(name, object.Data); err != nil {
+		return err
+	}
+	if object.Checksum == "" {
+		return nil
+	}
+	sum := strings.TrimPrefix(object.Checksum, "sha256:")
+	return writeFile(name+checksumExt, []byte(sum+"  "+filepath.Base(name)+"\n"))
+}
+
+// Get implements ResultStore
+func (s *dirStore) Get(_ context.Context, key string) (*Object, error) {
+	name := filepath.Join(s.dir, filepath.FromSlash(key))
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	object := &Object{Key: key, Data: data}
+	if sum, err := os.ReadFile(name + checksumExt); err == nil {
+		if fields := strings.Fields(string(sum)); len(fields) > 0 {
+			object.Checksum = "sha256:" + fields[0]
+		}
+	}
+	return object, nil
+}
+
+// List implements ResultStore
+func (s *dirStore) List(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var objects []ObjectInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) ||
+			strings.HasSuffix(name, checksumExt) || strings.HasSuffix(name, ".tmp") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, ObjectInfo{Key: name, Size: info.Size()})
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+// Delete implements ResultStore
+func (s *dirStore) Delete(_ context.Context, key string) error {
+	name := filepath.Join(s.dir, filepath.FromSlash(key))
+	for _, file := range []string{name, name + checksumExt} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// maxStatusProbabilities is how many of the most likely outcomes the job
+// status lists the probabilities of
+const maxStatusProbabilities = 16
+
+// Distribution summarizes the outcome distribution of a set of counts
+type Distribution struct {
+	// Outcomes is the number of distinct outcomes measured
+	Outcomes int
+	// MostLikely is the outcome measured most often, the lowest bitstring
+	// among equally likely ones
+	MostLikely string
+	// Entropy is the Shannon entropy of the distribution in bits
+	Entropy float64
+	// Probabilities are the normalized probabilities of the outcomes, most
+	// likely first
+	Probabilities []quantumv1.OutcomeProbability
+}
+
+// Distribute normalizes counts by their total into probabilities and
+// computes the entropy of the distribution. Counts without shots have an
+// empty distribution.
+func Distribute(counts map[string]int) Distribution {
+	total := countTotal(counts)
+	if total == 0 {
+		return Distribution{}
+	}
+
+	dist := Distribution{Probabilities: make([]quantumv1.OutcomeProbability, 0, len(counts))}
+	for outcome, n := range counts {
+		if n <= 0 {
+			continue
+		}
+		p := float64(n) / total
+		dist.Entropy -= p * math.Log2(p)
+		dist.Probabilities = append(dist.Probabilities, quantumv1.OutcomeProbability{Outcome: outcome, Probability: p})
+	}
+	slices.SortFunc(dist.Probabilities, func(a, b quantumv1.OutcomeProbability) int {
+		if c := cmp.Compare(b.Probability, a.Probability); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Outcome, b.Outcome)
+	})
+	dist.Outcomes = len(dist.Probabilities)
+	dist.MostLikely = dist.Probabilities[0].Outcome
+	// A single outcome computes as -0
+	dist.Entropy = math.Abs(dist.Entropy)
+	return dist
+}
+
+// recordDistribution adds the summary of the counts' distribution to a job
+// status results summary, keeping the probabilities of the most likely
+// outcomes only and rounding to four decimals to keep the status small
+func recordDistribution(info *quantumv1.ResultsInfo, counts map[string]int) {
+	dist := Distribute(counts)
+	if dist.Outcomes == 0 {
+		return
+	}
+	info.Outcomes = dist.Outcomes
+	info.MostLikelyOutcome = dist.MostLikely
+	info.Entropy = roundProbability(dist.Entropy)
+	top := dist.Probabilities[:min(len(dist.Probabilities), maxStatusProbabilities)]
+	info.Probabilities = make([]quantumv1.OutcomeProbability, len(top))
+	for i, p := range top {
+		info.Probabilities[i] = quantumv1.OutcomeProbability{Outcome: p.Outcome, Probability: roundProbability(p.Probability)}
+	}
+}
+
+// roundProbability rounds to four decimals
+func roundProbability(p float64) float64 {
+	return math.Round(p*10000) / 10000
+}
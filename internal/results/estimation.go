@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// EstimationAnnotation holds how the estimator the results processor read
+// for a job split its observables, as an Estimation in JSON without values
+const EstimationAnnotation = "quantum.io/estimation"
+
+// MaxRecordedObservables is the most observables whose expectation values
+// are recorded in a job's status; the values of more are only exported
+const MaxRecordedObservables = 1000
+
+// estimationPrefix starts the log line the executor reports its estimator on
+const estimationPrefix = `{"estimation":`
+
+// Estimation is what the executor reports about its estimator: the
+// expectation values of every batch of observables, merged in order
+type Estimation struct {
+	Observables int       `json:"observables"`
+	Calls       int       `json:"calls"`
+	Names       []string  `json:"names,omitempty"`
+	Values      []float64 `json:"values,omitempty"`
+	Stds        []float64 `json:"stds,omitempty"`
+}
+
+// ParseEstimation extracts the estimation the executor reported from
+// execution pod logs; the last report wins
+func ParseEstimation(logs string) (*Estimation, bool) {
+	var found *Estimation
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	// Every value takes a couple dozen bytes, so reports can be large
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, estimationPrefix) {
+			continue
+		}
+		var wrapped struct {
+			Estimation *Estimation `json:"estimation"`
+		}
+		if err := json.Unmarshal([]byte(line), &wrapped); err == nil && wrapped.Estimation != nil {
+			found = wrapped.Estimation
+		}
+	}
+	return found, found != nil
+}
+
+// Summary returns how the estimation split the observables, without their
+// names and values
+func (e *Estimation) Summary() *Estimation {
+	return &Estimation{Observables: e.Observables, Calls: e.Calls}
+}
+
+// ExpectationValues returns the expectation value of each observable, named
+// by the executor or by index, or nil for more than MaxRecordedObservables
+func ExpectationValues(e *Estimation) []quantumv1.ExpectationValue {
+	if len(e.Values) == 0 || len(e.Values) > MaxRecordedObservables {
+		return nil
+	}
+	values := make([]quantumv1.ExpectationValue, len(e.Values))
+	for i, value := range e.Values {
+		values[i] = quantumv1.ExpectationValue{Observable: strconv.Itoa(i), Value: value}
+		if i < len(e.Names) {
+			values[i].Observable = e.Names[i]
+		}
+		if i < len(e.Stds) {
+			values[i].StandardError = e.Stds[i]
+		}
+	}
+	return values
+}
+
+// NewEstimationInfo builds the results of a completed estimator job, which
+// has expectation values instead of counts
+func NewEstimationInfo(job *quantumv1.QiskitJob, e *Estimation, executionTime time.Duration) *quantumv1.ResultsInfo {
+	info := NewInfo(job, nil, executionTime)
+	info.ExpectationValues = ExpectationValues(e)
+	return info
+}
+
+// ParseEstimationAnnotation reads the estimation the results processor
+// recorded on a job, reporting false if there is none
+func ParseEstimationAnnotation(job *quantumv1.QiskitJob) (*Estimation, bool) {
+	value := job.Annotations[EstimationAnnotation]
+	if value == "" {
+		return nil, false
+	}
+	var e Estimation
+	if err := json.Unmarshal([]byte(value), &e); err != nil {
+		return nil, false
+	}
+	return &e, true
+}
+
+// RecordEstimation records how the estimator split the job's observables in
+// its status
+func RecordEstimation(job *quantumv1.QiskitJob, e *Estimation) {
+	job.Status.Estimation = &quantumv1.EstimationStatus{Observables: e.Observables, Calls: e.Calls}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// Result formats accepted in spec.outputs[].format
+const (
+	FormatJSON   = "json"
+	FormatCSV    = "csv"
+	FormatPickle = "pickle"
+	FormatQPY    = "qpy"
+)
+
+// EncodeDocument encodes the results document in an output format, returning
+// the file name it is stored under and its content type. CSV holds one
+// outcome,count row per outcome; pickle holds the same object as JSON, for
+// loading with pickle.load. QPY only applies to circuits, so its results are
+// stored as JSON.
+func EncodeDocument(doc *Document, format string) (string, []byte, string, error) {
+	switch format {
+	case "", FormatJSON, FormatQPY:
+		data, err := json.Marshal(doc)
+		return ResultsKey, data, "application/json", err
+	case FormatCSV:
+		data, err := encodeCSV(doc.Results.Counts)
+		return "results.csv", data, "text/csv", err
+	case FormatPickle:
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return "", nil, "", err
+		}
+		data, err = jsonToPickle(data)
+		return "results.pkl", data, "application/octet-stream", err
+	default:
+		return "", nil, "", fmt.Errorf("unsupported results format %q", format)
+	}
+}
+
+// encodeCSV writes counts as outcome,count rows, most frequent first
+func encodeCSV(counts map[string]int) ([]byte, error) {
+	outcomes := make([]string, 0, len(counts))
+	for outcome := range counts {
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		a, b := outcomes[i], outcomes[j]
+		return counts[a] > counts[b] || (counts[a] == counts[b] && a < b)
+	})
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"outcome", "count"}); err != nil {
+		return nil, err
+	}
+	for _, outcome := range outcomes {
+		if err := w.Write([]string{outcome, strconv.Itoa(counts[outcome])}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// Pickle protocol 2 opcodes used to encode JSON values
+const (
+	pickleProto      = 0x80
+	pickleStop       = '.'
+	pickleMark       = '('
+	pickleNone       = 'N'
+	pickleTrue       = 0x88
+	pickleFalse      = 0x89
+	pickleBinInt     = 'J'
+	pickleLong1      = 0x8a
+	pickleBinFloat   = 'G'
+	pickleBinUnicode = 'X'
+	pickleEmptyList  = ']'
+	pickleAppends    = 'e'
+	pickleEmptyDict  = '}'
+	pickleSetItems   = 'u'
+)
+
+// jsonToPickle re-encodes a JSON document as a protocol 2 pickle of the
+// dicts, lists, strings, numbers, booleans and Nones it decodes to in Python
+func jsonToPickle(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Write([]byte{pickleProto, 2})
+	if err := writePickle(&buf, value); err != nil {
+		return nil, err
+	}
+	buf.WriteByte(pickleStop)
+	return buf.Bytes(), nil
+}
+
+func writePickle(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteByte(pickleNone)
+	case bool:
+		if v {
+			buf.WriteByte(pickleTrue)
+		} else {
+			buf.WriteByte(pickleFalse)
+		}
+	case string:
+		buf.WriteByte(pickleBinUnicode)
+		_ = binary.Write(buf, binary.LittleEndian, uint32(len(v)))
+		buf.WriteString(v)
+	case json.Number:
+		return writePickleNumber(buf, v)
+	case []any:
+		buf.WriteByte(pickleEmptyList)
+		if len(v) == 0 {
+			return nil
+		}
+		buf.WriteByte(pickleMark)
+		for _, item := range v {
+			if err := writePickle(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(pickleAppends)
+	case map[string]any:
+		buf.WriteByte(pickleEmptyDict)
+		if len(v) == 0 {
+			return nil
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		buf.WriteByte(pickleMark)
+		for _, key := range keys {
+			if err := writePickle(buf, key); err != nil {
+				return err
+			}
+			if err := writePickle(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(pickleSetItems)
+	default:
+		return fmt.Errorf("cannot pickle %T", value)
+	}
+	return nil
+}
+
+// writePickleNumber writes integers as Python ints and other numbers as floats
+func writePickleNumber(buf *bytes.Buffer, n json.Number) error {
+	if i, ok := new(big.Int).SetString(n.String(), 10); ok {
+		if i.IsInt64() && i.Int64() >= math.MinInt32 && i.Int64() <= math.MaxInt32 {
+			buf.WriteByte(pickleBinInt)
+			return binary.Write(buf, binary.LittleEndian, int32(i.Int64()))
+		}
+		encoded := twosComplementLE(i)
+		if len(encoded) > 255 {
+			return fmt.Errorf("integer %s too large to pickle", n)
+		}
+		buf.Write([]byte{pickleLong1, byte(len(encoded))})
+		buf.Write(encoded)
+		return nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return err
+	}
+	buf.WriteByte(pickleBinFloat)
+	return binary.Write(buf, binary.BigEndian, f)
+}
+
+// twosComplementLE encodes i as the shortest little-endian two's complement
+// bytes, as pickle's LONG1 expects
+func twosComplementLE(i *big.Int) []byte {
+	size := i.BitLen()/8 + 1
+	var b []byte
+	if i.Sign() >= 0 {
+		b = i.FillBytes(make([]byte, size))
+	} else {
+		// Add 2^(8*size) to get the unsigned representation of the negative value
+		offset := new(big.Int).Lsh(big.NewInt(1), uint(8*size))
+		b = new(big.Int).Add(offset, i).FillBytes(make([]byte, size))
+	}
+	for l, r := 0, len(b)-1; l < r; l, r = l+1, r-1 {
+		b[l], b[r] = b[r], b[l]
+	}
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// gcsEndpoint is the XML API of Cloud Storage, which takes HMAC keys signed
+// the way S3 requests are
+const gcsEndpoint = "https://storage.googleapis.com"
+
+// gcsDriver stores results in the Cloud Storage bucket named by the output's
+// location, under JobPrefix, through its S3-compatible XML API. The Secret
+// holds an HMAC key under the keys of s3 outputs. Cloud Storage does not tag
+// objects, so retention is left to the bucket's lifecycle rules.
+var gcsDriver = &StoreDriver{
+	Open: func(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error) {
+		creds, err := s3Credentials(ctx, env, output, S3SecretKeys)
+		if err != nil {
+			return nil, err
+		}
+		if creds.Endpoint == "" {
+			creds.Endpoint = gcsEndpoint
+		}
+		return &s3Store{creds: creds, scheme: "gs", bucket: output.Location, prefix: env.Prefix}, nil
+	},
+	SecretKeys: S3SecretKeys,
+	Scheme:     "gs",
+	Uploaded:   true,
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// InfoAnnotation holds the results summary the results processor built for
+// a job, as a ResultsInfo in JSON
+const InfoAnnotation = "quantum.io/results-info"
+
+// OutputsAnnotation holds how the results processor's export to each of a
+// job's outputs went, as a list of OutputStatus in JSON
+const OutputsAnnotation = "quantum.io/results-outputs"
+
+// defaultShots is the number of shots executors run when the job sets none
+const defaultShots = defaults.Shots
+
+// ParseExecutionTime extracts how long the circuit took to execute from
+// execution pod logs: the "execution_time" in seconds reported alongside the
+// counts that ParseCounts returns
+func ParseExecutionTime(logs string) (time.Duration, bool) {
+	var found *float64
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if _, ok := parseCountsLine(line); !ok {
+			continue
+		}
+		var reported struct {
+			ExecutionTime *float64 `json:"execution_time"`
+		}
+		found = nil
+		if err := json.Unmarshal([]byte(line), &reported); err == nil && reported.ExecutionTime != nil && *reported.ExecutionTime >= 0 {
+			found = reported.ExecutionTime
+		}
+	}
+	if found == nil {
+		return 0, false
+	}
+	return time.Duration(*found * float64(time.Second)), true
+}
+
+// NewInfo summarizes the counts of a job's execution for its status. The
+// success rate is the fraction of the requested shots that were measured,
+// alongside the outcome distribution; an execution time of zero is left
+// out.
+func NewInfo(job *quantumv1.QiskitJob, counts map[string]int, executionTime time.Duration) *quantumv1.ResultsInfo {
+	info := &quantumv1.ResultsInfo{}
+	if len(job.Spec.Outputs) > 0 {
+		info.Location = Location(job, &job.Spec.Outputs[0])
+	}
+	for _, n := range counts {
+		info.Shots += n
+	}
+	requested := job.Spec.Execution.Shots
+	if requested <= 0 {
+		requested = defaultShots
+	}
+	info.SuccessRate = min(float64(info.Shots)/float64(requested), 1)
+	recordDistribution(info, counts)
+	if executionTime > 0 {
+		info.ExecutionTime = executionTime.Round(time.Millisecond).String()
+	}
+	return info
+}
+
+// JobPrefix returns the path under an object store or pvc output's location
+// the job's results are stored under: the output's path followed by the job's name
+func JobPrefix(job *quantumv1.QiskitJob, output *quantumv1.OutputSpec) string {
+	return strings.TrimPrefix(path.Join(output.Path, job.Name), "/") + "/"
+}
+
+// Location returns the URI of one of the sinks a job's results are exported
+// to, or nothing when the output has no location. For object store and pvc
+// outputs it is the prefix the job's results are stored under.
+func Location(job *quantumv1.QiskitJob, output *quantumv1.OutputSpec) string {
+	if output == nil || output.Location == "" {
+		return ""
+	}
+	if driver := Driver(output.Type); driver != nil && driver.Scheme != "" {
+		return driver.Scheme + "://" + output.Location + "/" + JobPrefix(job, output)
+	}
+	if output.Type == "pvc" {
+		return fmt.Sprintf("pvc://%s/%s/%s", job.Namespace, output.Location, JobPrefix(job, output))
+	}
+	return fmt.Sprintf("%s://%s/%s", output.Type, job.Namespace, output.Location)
+}
+
+// OutputName returns the name an output is reported under in the job's
+// status: its name, or its type if it has none
+func OutputName(output *quantumv1.OutputSpec) string {
+	if output.Name != "" {
+		return output.Name
+	}
+	return output.Type
+}
+
+// ExportedLocation returns the location of the first output the results
+// reached, for the job's results summary, or nothing if they reached none
+func ExportedLocation(statuses []quantumv1.OutputStatus) string {
+	for _, status := range statuses {
+		if status.State != quantumv1.OutputFailed {
+			return status.Location
+		}
+	}
+	return ""
+}
+
+// ParseInfoAnnotation reads the results summary the results processor
+// recorded on a job, reporting false if there is none
+func ParseInfoAnnotation(job *quantumv1.QiskitJob) (*quantumv1.ResultsInfo, bool) {
+	value := job.Annotations[InfoAnnotation]
+	if value == "" {
+		return nil, false
+	}
+	var info quantumv1.ResultsInfo
+	if err := json.Unmarshal([]byte(value), &info); err != nil {
+		return nil, false
+	}
+	return &info, true
+}
+
+// ParseOutputsAnnotation reads how the results processor's export to each of
+// a job's outputs went, reporting false if it recorded nothing
+func ParseOutputsAnnotation(job *quantumv1.QiskitJob) ([]quantumv1.OutputStatus, bool) {
+	value := job.Annotations[OutputsAnnotation]
+	if value == "" {
+		return nil, false
+	}
+	var statuses []quantumv1.OutputStatus
+	if err := json.Unmarshal([]byte(value), &statuses); err != nil {
+		return nil, false
+	}
+	return statuses, true
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Sources of a job's transpilation metadata
+const (
+	TranspilationByExecutor          = "executor"
+	TranspilationByValidationService = "validation-service"
+)
+
+// LayoutAnnotation holds the qubit layout the results processor read for a
+// job, as a Layout in JSON
+const LayoutAnnotation = "quantum.io/qubit-layout"
+
+// layoutPrefix starts the log line the executor reports the qubit layout on
+const layoutPrefix = `{"qubit_layout":`
+
+// Layout is how the bitstrings of a job's counts map onto the qubits the
+// circuit ran on, as reported by the executor after transpilation
+type Layout struct {
+	// PhysicalQubits holds the physical qubit each logical qubit ended up
+	// on, indexed by logical qubit
+	PhysicalQubits []int `json:"physical_qubits"`
+	// Registers are the measured classical registers in the order they
+	// appear in bitstrings, from left to right
+	Registers []Register `json:"registers"`
+	// Depth and TwoQubitGates are the shape of the transpiled circuit, when
+	// the executor reported it
+	Depth         int `json:"depth,omitempty"`
+	TwoQubitGates int `json:"two_qubit_gates,omitempty"`
+}
+
+// Register is a classical register of the counts' bitstrings. Within a
+// register, the rightmost character of the bitstring is bit 0.
+type Register struct {
+	Name string `json:"name"`
+	Size int    `json:"size"`
+	// MeasuredQubits holds the physical qubit each bit was measured from,
+	// indexed by bit, -1 for bits never measured
+	MeasuredQubits []int `json:"measured_qubits"`
+}
+
+// ParseLayout extracts the qubit layout the executor reported from
+// execution pod logs; the last report wins
+func ParseLayout(logs string) (*Layout, bool) {
+	var found *Layout
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, layoutPrefix) {
+			continue
+		}
+		var wrapped struct {
+			Layout *Layout `json:"qubit_layout"`
+		}
+		if err := json.Unmarshal([]byte(line), &wrapped); err == nil && wrapped.Layout != nil {
+			found = wrapped.Layout
+		}
+	}
+	return found, found != nil
+}
+
+// ParseLayoutAnnotation reads the qubit layout the results processor
+// recorded on a job, reporting false if there is none
+func ParseLayoutAnnotation(job *quantumv1.QiskitJob) (*Layout, bool) {
+	value := job.Annotations[LayoutAnnotation]
+	if value == "" {
+		return nil, false
+	}
+	var l Layout
+	if err := json.Unmarshal([]byte(value), &l); err != nil {
+		return nil, false
+	}
+	return &l, true
+}
+
+// RecordLayout records the qubit layout in the job's circuit metadata, from
+// where it is also written into the job's results documents, along with the
+// shape of the transpiled circuit that ran
+func RecordLayout(job *quantumv1.QiskitJob, l *Layout) {
+	if job.Status.CircuitMetadata == nil {
+		job.Status.CircuitMetadata = &quantumv1.CircuitMetadata{}
+	}
+	layout := &quantumv1.QubitLayout{PhysicalQubits: l.PhysicalQubits}
+	for _, register := range l.Registers {
+		layout.ClassicalRegisters = append(layout.ClassicalRegisters, quantumv1.ClassicalRegister{
+			Name:           register.Name,
+			Size:           register.Size,
+			MeasuredQubits: register.MeasuredQubits,
+		})
+	}
+	job.Status.CircuitMetadata.Layout = layout
+	if l.Depth > 0 {
+		job.Status.CircuitMetadata.Transpilation = &quantumv1.TranspilationMetadata{
+			Source:            TranspilationByExecutor,
+			OptimizationLevel: job.Spec.Execution.OptimizationLevel,
+			Depth:             l.Depth,
+			TwoQubitGates:     l.TwoQubitGates,
+			PhysicalQubits:    l.PhysicalQubits,
+		}
+	}
+}
+
+// recordedLayout returns the qubit layout recorded on the job, nil if none is
+func recordedLayout(job *quantumv1.QiskitJob) *Layout {
+	if job.Status.CircuitMetadata == nil || job.Status.CircuitMetadata.Layout == nil {
+		return nil
+	}
+	layout := job.Status.CircuitMetadata.Layout
+	l := &Layout{PhysicalQubits: layout.PhysicalQubits, Registers: []Register{}}
+	for _, register := range layout.ClassicalRegisters {
+		l.Registers = append(l.Registers, Register{
+			Name:           register.Name,
+			Size:           register.Size,
+			MeasuredQubits: register.MeasuredQubits,
+		})
+	}
+	return l
+}
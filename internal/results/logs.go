@@ -0,0 +1,218 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+// LogsKey is the key of the logs ConfigMap holding the tail of the
+// executor's logs
+const LogsKey = "executor.log"
+
+// DefaultLogTailBytes is how much of the end of the executor's logs is kept
+// unless configured otherwise
+const DefaultLogTailBytes = 64 * 1024
+
+// maxArtifacts is the most artifacts recorded in a job's results summary
+const maxArtifacts = 100
+
+// artifactsPrefix starts the log line the executor lists the files circuit
+// code wrote next to the results on
+const artifactsPrefix = `{"artifacts":`
+
+// LogsName names the ConfigMap the tail of a job's executor logs is kept in
+func LogsName(job *quantumv1.QiskitJob) string {
+	return job.Name + "-logs"
+}
+
+// Tail returns the last max bytes of logs, starting at a line boundary
+// unless the last line alone is longer, and whether anything was cut off
+func Tail(logs string, max int) (string, bool) {
+	if len(logs) <= max {
+		return logs, false
+	}
+	tail := logs[len(logs)-max:]
+	if i := strings.IndexByte(tail, '\n'); i >= 0 && i < len(tail)-1 {
+		tail = tail[i+1:]
+	}
+	return tail, true
+}
+
+// KeepLogs stores the tail of the logs of one of the job's executions in its
+// logs ConfigMap, owned by the job, replacing those of earlier executions.
+// Credentials are masked first: output of subprocesses bypasses the
+// executor's masking, and the ConfigMap is readable by anyone who can read
+// the job.
+func KeepLogs(ctx context.Context, c client.Client, scheme *runtime.Scheme, job *quantumv1.QiskitJob,
+	execution, logs string, max int) (*quantumv1.ExecutorLogs, error) {
+	tail, truncated := Tail(redact.String(logs), max)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LogsName(job),
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				"app":    "qiskit-operator",
+				JobLabel: job.Name,
+			},
+		},
+		Data: map[string]string{LogsKey: tail},
+	}
+	if err := applyConfigMap(ctx, c, scheme, job, cm); err != nil {
+		return nil, err
+	}
+	return &quantumv1.ExecutorLogs{
+		ConfigMap: cm.Name,
+		Execution: execution,
+		Size:      int64(len(tail)),
+		Truncated: truncated,
+	}, nil
+}
+
+// FileArtifact is a file circuit code wrote next to the results, as the
+// executor lists it
+type FileArtifact struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+}
+
+// ParseArtifacts extracts the files the executor listed from execution pod
+// logs; the last listing wins
+func ParseArtifacts(logs string) ([]FileArtifact, bool) {
+	var found []FileArtifact
+	ok := false
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, artifactsPrefix) {
+			continue
+		}
+		var wrapped struct {
+			Artifacts []FileArtifact `json:"artifacts"`
+		}
+		if err := json.Unmarshal([]byte(line), &wrapped); err == nil {
+			found, ok = wrapped.Artifacts, true
+		}
+	}
+	return found, ok
+}
+
+// DocumentName returns the name of the file the results document is stored
+// under in an output
+func DocumentName(output *quantumv1.OutputSpec) string {
+	name := ResultsKey
+	if driver := Driver(output.Type); driver == nil || !driver.IndentedJSON {
+		switch output.Format {
+		case FormatCSV:
+			name = "results.csv"
+		case FormatPickle:
+			name = "results.pkl"
+		}
+	}
+	if output.Compression != "" && output.Compression != CompressionNone {
+		name += extensions[output.Compression]
+	}
+	return name
+}
+
+// Artifacts indexes the files the job produced: the results document in
+// each output it reached, the tail of its executor's logs and the files
+// circuit code wrote to its pvc output
+func Artifacts(job *quantumv1.QiskitJob, files []FileArtifact) []quantumv1.ResultArtifact {
+	var artifacts []quantumv1.ResultArtifact
+	for _, status := range job.Status.Outputs {
+		if status.State == quantumv1.OutputFailed {
+			continue
+		}
+		output := outputNamed(job, status.Name)
+		if output == nil || Driver(output.Type) == nil {
+			continue
+		}
+		name := DocumentName(output)
+		artifacts = append(artifacts, quantumv1.ResultArtifact{
+			Name:     name,
+			Type:     quantumv1.ArtifactResults,
+			Location: within(status.Location, name),
+		})
+	}
+	if logs := job.Status.Logs; logs != nil {
+		artifacts = append(artifacts, quantumv1.ResultArtifact{
+			Name:     LogsKey,
+			Type:     quantumv1.ArtifactLogs,
+			Location: fmt.Sprintf("configmap://%s/%s", job.Namespace, logs.ConfigMap),
+			Size:     logs.Size,
+		})
+	}
+	if output := pvcOutputOf(job); output != nil {
+		for _, file := range files {
+			artifacts = append(artifacts, quantumv1.ResultArtifact{
+				Name:     file.Name,
+				Type:     quantumv1.ArtifactFile,
+				Location: within(Location(job, output), file.Name),
+				Size:     file.Size,
+			})
+		}
+	}
+	if len(artifacts) > maxArtifacts {
+		artifacts = artifacts[:maxArtifacts]
+	}
+	return artifacts
+}
+
+// within returns the URI of a file stored under a location: under the
+// prefix of object store and pvc outputs, and the location itself for
+// ConfigMaps, which hold the file under its name
+func within(location, name string) string {
+	if location == "" || !strings.HasSuffix(location, "/") {
+		return location
+	}
+	return location + name
+}
+
+// outputNamed returns the job's output reported under name in its status
+func outputNamed(job *quantumv1.QiskitJob, name string) *quantumv1.OutputSpec {
+	for i := range job.Spec.Outputs {
+		if OutputName(&job.Spec.Outputs[i]) == name {
+			return &job.Spec.Outputs[i]
+		}
+	}
+	return nil
+}
+
+// pvcOutputOf returns the pvc output circuit code writes its files to, or
+// nil if the job has none
+func pvcOutputOf(job *quantumv1.QiskitJob) *quantumv1.OutputSpec {
+	for i := range job.Spec.Outputs {
+		if output := &job.Spec.Outputs[i]; output.Type == "pvc" && output.Location != "" {
+			return output
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/pkg/lineage"
+)
+
+// Labels set on exported results so they can be searched by experiment
+// metadata. ExperimentLabel and CircuitFamilyLabel are copied from the job's
+// own labels; the others are derived from its status.
+const (
+	ExperimentLabel    = lineage.ExperimentLabel
+	CircuitFamilyLabel = builder.CircuitFamilyLabel
+	QubitsLabel        = "quantum.io/qubits"
+	BackendLabel       = "quantum.io/backend"
+	// PeriodLabel holds the month results were produced in (YYYY-MM)
+	PeriodLabel = "quantum.io/period"
+)
+
+// PeriodFormat is the time layout of PeriodLabel
+const PeriodFormat = "2006-01"
+
+// MetadataLabels returns the searchable metadata of a job's results
+func MetadataLabels(job *quantumv1.QiskitJob) map[string]string {
+	metadata := map[string]string{}
+	for _, key := range []string{ExperimentLabel, CircuitFamilyLabel} {
+		if value := labelValue(job.Labels[key]); value != "" {
+			metadata[key] = value
+		}
+	}
+	if job.Status.CircuitMetadata != nil && job.Status.CircuitMetadata.Qubits > 0 {
+		metadata[QubitsLabel] = strconv.Itoa(job.Status.CircuitMetadata.Qubits)
+	}
+	if backend := labelValue(job.Status.SelectedBackend); backend != "" {
+		metadata[BackendLabel] = backend
+	}
+
+	produced := time.Now()
+	if job.Status.CompletionTime != nil {
+		produced = job.Status.CompletionTime.Time
+	}
+	metadata[PeriodLabel] = produced.UTC().Format(PeriodFormat)
+	return metadata
+}
+
+// maxObjectTags is the most tags S3 and Azure Blob Storage keep on an object
+const maxObjectTags = 10
+
+// objectTags returns the tags of an object in an object store, its labels
+// and retention, encoded as the stores' tagging headers take them. Searchable
+// metadata comes first should the labels exceed the stores' limit.
+func objectTags(object *Object) string {
+	tags := url.Values{}
+	if object.Retention != "" {
+		tags.Set(RetentionTag, object.Retention)
+	}
+	keys := make([]string, 0, len(object.Labels))
+	for key := range object.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return searchable(keys[i]) && !searchable(keys[j])
+	})
+	for _, key := range keys {
+		if len(tags) == maxObjectTags {
+			break
+		}
+		tags.Set(key, object.Labels[key])
+	}
+	return tags.Encode()
+}
+
+// searchable reports whether a label holds searchable metadata
+func searchable(key string) bool {
+	switch key {
+	case ExperimentLabel, CircuitFamilyLabel, QubitsLabel, BackendLabel, PeriodLabel:
+		return true
+	}
+	return false
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// labelValue coerces s into a valid label value, or "" if nothing usable remains
+func labelValue(s string) string {
+	s = invalidLabelChars.ReplaceAllString(s, "-")
+	if len(s) > 63 {
+		s = s[:63]
+	}
+	return strings.Trim(s, "._-")
+}
+
+// Query selects exported results by metadata; empty fields match anything
+type Query struct {
+	Experiment    string
+	CircuitFamily string
+	Qubits        int
+	Backend       string
+	// Period restricts results to a month (YYYY-MM)
+	Period string
+}
+
+// Selector returns the label selector matching the query
+func (q Query) Selector() labels.Selector {
+	set := labels.Set{"app": "qiskit-operator"}
+	if q.Experiment != "" {
+		set[ExperimentLabel] = labelValue(q.Experiment)
+	}
+	if q.CircuitFamily != "" {
+		set[CircuitFamilyLabel] = labelValue(q.CircuitFamily)
+	}
+	if q.Qubits > 0 {
+		set[QubitsLabel] = strconv.Itoa(q.Qubits)
+	}
+	if q.Backend != "" {
+		set[BackendLabel] = labelValue(q.Backend)
+	}
+	if q.Period != "" {
+		set[PeriodLabel] = q.Period
+	}
+
+	// Shards are part of the results they belong to, not results of their own
+	selector := labels.SelectorFromSet(set)
+	if notShard, err := labels.NewRequirement(ShardLabel, selection.DoesNotExist, nil); err == nil {
+		selector = selector.Add(*notShard)
+	}
+	return selector
+}
+
+// Search lists the results ConfigMaps in namespace matching the query. An
+// empty namespace searches all namespaces.
+func Search(ctx context.Context, c client.Reader, namespace string, q Query) ([]corev1.ConfigMap, error) {
+	var list corev1.ConfigMapList
+	opts := []client.ListOption{client.MatchingLabelsSelector{Selector: q.Selector()}}
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	if err := c.List(ctx, &list, opts...); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"strings"
+)
+
+// mitigationsPrefix starts the log line the executor reports the error
+// mitigations it applied on
+const mitigationsPrefix = `{"mitigations":`
+
+// ParseMitigations extracts the names of the error mitigations the executor
+// reported it applied from execution pod logs; the last report wins
+func ParseMitigations(logs string) ([]string, bool) {
+	var found []string
+	ok := false
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, mitigationsPrefix) {
+			continue
+		}
+		var wrapped struct {
+			Mitigations []string `json:"mitigations"`
+		}
+		if err := json.Unmarshal([]byte(line), &wrapped); err == nil {
+			found, ok = wrapped.Mitigations, true
+		}
+	}
+	return found, ok
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ociSecretKeys are the keys of the Secret of oci outputs: a customer
+// secret key, and the region and Amazon S3 Compatibility API endpoint of
+// the tenancy, https://<namespace>.compat.objectstorage.<region>.oraclecloud.com
+var ociSecretKeys = []SecretKey{
+	{Key: S3AccessKeyIDKey, Env: "ACCESS_KEY_ID"},
+	{Key: S3SecretAccessKeyKey, Env: "SECRET_ACCESS_KEY"},
+	{Key: S3RegionKey, Env: "REGION"},
+	{Key: S3EndpointKey, Env: "ENDPOINT"},
+}
+
+// ociDriver stores results in the OCI Object Storage bucket named by the
+// output's location, under JobPrefix, through its Amazon S3 Compatibility
+// API, which does not tag objects
+var ociDriver = &StoreDriver{
+	Open: func(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error) {
+		creds, err := s3Credentials(ctx, env, output, ociSecretKeys)
+		if err != nil {
+			return nil, err
+		}
+		return &s3Store{creds: creds, scheme: "oci", bucket: output.Location, prefix: env.Prefix}, nil
+	},
+	SecretKeys: ociSecretKeys,
+	Scheme:     "oci",
+	Uploaded:   true,
+}
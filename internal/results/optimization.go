@@ -0,0 +1,91 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// OptimizationAnnotation holds the convergence of the optimizer loop the
+// results processor read for a job, as an Optimization in JSON
+const OptimizationAnnotation = "quantum.io/optimization"
+
+// optimizationPrefix starts the log line the executor reports its optimizer
+// loop on
+const optimizationPrefix = `{"optimization":`
+
+// Optimization is what the executor reports about its optimizer loop
+type Optimization struct {
+	Method     string             `json:"method"`
+	Iterations int                `json:"iterations"`
+	Converged  bool               `json:"converged"`
+	Value      float64            `json:"value"`
+	Parameters map[string]float64 `json:"parameters,omitempty"`
+	History    []float64          `json:"history,omitempty"`
+}
+
+// ParseOptimization extracts the optimizer loop the executor reported from
+// execution pod logs; the last report wins
+func ParseOptimization(logs string) (*Optimization, bool) {
+	var found *Optimization
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, optimizationPrefix) {
+			continue
+		}
+		var wrapped struct {
+			Optimization *Optimization `json:"optimization"`
+		}
+		if err := json.Unmarshal([]byte(line), &wrapped); err == nil && wrapped.Optimization != nil {
+			found = wrapped.Optimization
+		}
+	}
+	return found, found != nil
+}
+
+// ParseOptimizationAnnotation reads the optimizer loop the results processor
+// recorded on a job, reporting false if there is none
+func ParseOptimizationAnnotation(job *quantumv1.QiskitJob) (*Optimization, bool) {
+	value := job.Annotations[OptimizationAnnotation]
+	if value == "" {
+		return nil, false
+	}
+	var o Optimization
+	if err := json.Unmarshal([]byte(value), &o); err != nil {
+		return nil, false
+	}
+	return &o, true
+}
+
+// RecordOptimization records the convergence of the optimizer loop in the
+// job's status
+func RecordOptimization(job *quantumv1.QiskitJob, o *Optimization) {
+	job.Status.Optimization = &quantumv1.OptimizationStatus{
+		Method:     o.Method,
+		Iterations: o.Iterations,
+		Converged:  o.Converged,
+		Value:      o.Value,
+		Parameters: o.Parameters,
+		History:    o.History,
+	}
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+	"github.com/quantum-operator/qiskit-operator/pkg/work"
+)
+
+// LogReader reads execution pod logs
+type LogReader interface {
+	PodLogs(ctx context.Context, namespace, name string) (string, error)
+}
+
+// ClientsetLogReader reads pod logs through the core API. Names of batch
+// Jobs read the logs of the Job's newest pod.
+type ClientsetLogReader struct {
+	Clientset kubernetes.Interface
+}
+
+// PodLogs implements LogReader
+func (r ClientsetLogReader) PodLogs(ctx context.Context, namespace, name string) (string, error) {
+	pod, err := r.pod(ctx, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	data, err := r.Clientset.CoreV1().Pods(namespace).GetLogs(pod, nil).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// RecentPodLogs returns what the pod logged within the last since
+func (r ClientsetLogReader) RecentPodLogs(ctx context.Context, namespace, name string, since time.Duration) (string, error) {
+	pod, err := r.pod(ctx, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	seconds := int64(math.Ceil(since.Seconds()))
+	data, err := r.Clientset.CoreV1().Pods(namespace).
+		GetLogs(pod, &corev1.PodLogOptions{SinceSeconds: &seconds}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// pod returns the name of the pod to read: the named pod, or else the
+// newest pod of the named Job
+func (r ClientsetLogReader) pod(ctx context.Context, namespace, name string) (string, error) {
+	_, err := r.Clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if !apierrors.IsNotFound(err) {
+		return name, err
+	}
+	pods, listErr := r.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: batchv1.JobNameLabel + "=" + name,
+	})
+	if listErr != nil {
+		return "", listErr
+	}
+	var newest *corev1.Pod
+	for i := range pods.Items {
+		if newest == nil || newest.CreationTimestamp.Before(&pods.Items[i].CreationTimestamp) {
+			newest = &pods.Items[i]
+		}
+	}
+	if newest == nil {
+		return "", err
+	}
+	return newest.Name, nil
+}
+
+// Processor claims result parsing tasks from the work queue, exports the
+// results of each job, and hands the job back to the reconciler by
+// annotating it. It runs in the results-processor deployment, so slow log
+// reads and uploads never occupy reconcile workers.
+type Processor struct {
+	Client       client.Client
+	Scheme       *runtime.Scheme
+	Logs         LogReader
+	Queue        *work.Queue
+	PollInterval time.Duration
+	// Search indexes results of opensearch and elasticsearch outputs
+	Search *SearchIndexer
+	// Signer signs the exported results, if set
+	Signer Signer
+}
+
+// Run processes tasks until the context is cancelled
+func (p *Processor) Run(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	interval := p.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	for {
+		task, err := p.Queue.Claim(ctx, work.TaskResultParsing)
+		if err != nil {
+			logger.Error(err, "Failed to claim task")
+		}
+		if task != nil {
+			err := p.Process(ctx, task)
+			if err == nil {
+				// Look for more work right away
+				continue
+			}
+			logger.Error(err, "Failed to process results", "job", task.Job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Process exports the results of one job. Transient failures release the
+// task so it is retried; failures that cannot succeed are reported on the job.
+func (p *Processor) Process(ctx context.Context, task *work.Task) error {
+	logger := log.FromContext(ctx).WithValues("job", task.Job)
+
+	var job quantumv1.QiskitJob
+	if err := p.Client.Get(ctx, task.Job, &job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return p.Queue.Complete(ctx, task)
+		}
+		return p.release(ctx, task, err)
+	}
+
+	podName := job.Status.JobID
+	if podName == "" {
+		podName = fmt.Sprintf("qiskit-job-%s-attempt-%d", job.Name, job.Status.RetryCount+1)
+	}
+	logs, err := p.Logs.PodLogs(ctx, executionNamespace(&job), podName)
+	if apierrors.IsNotFound(err) {
+		return p.finish(ctx, task, &job, map[string]string{
+			ErrorAnnotation: fmt.Sprintf("execution pod %s not found", podName),
+		})
+	}
+	if err != nil {
+		return p.release(ctx, task, err)
+	}
+
+	counts, ok := ParseCounts(logs)
+	if !ok {
+		logger.Info("No measurement counts found in execution logs")
+	}
+
+	// A shadow run that cannot be read only loses the comparison
+	shadow, err := ReadShadow(ctx, p.Logs, &job, counts)
+	if err != nil {
+		logger.Error(err, "Failed to read shadow run results")
+	}
+
+	// Make sure the task is still ours before writing anything
+	if err := p.Queue.Renew(ctx, task); err != nil {
+		return err
+	}
+
+	// The transpiled circuit is published first, for qpy outputs to upload
+	outcome := map[string]string{}
+	if transpiled, ok := ParseTranspiled(logs); ok && PublishesTranspiled(&job) {
+		// A circuit that cannot be published still has its sizes recorded
+		err := PublishTranspiled(ctx, p.Client, p.Scheme, &job, transpiled)
+		if errors.Is(err, ErrTooLarge) || errors.Is(err, ErrNoQPY) {
+			logger.Error(err, "Transpiled circuit not published")
+		} else if err != nil {
+			return p.release(ctx, task, err)
+		}
+		data, err := json.Marshal(transpiled.Sizes())
+		if err != nil {
+			return p.release(ctx, task, err)
+		}
+		outcome[TranspiledAnnotation] = string(data)
+	}
+
+	// The layout is recorded first, for the results document to carry it
+	if layout, ok := ParseLayout(logs); ok {
+		RecordLayout(&job, layout)
+		data, err := json.Marshal(layout)
+		if err != nil {
+			return p.release(ctx, task, err)
+		}
+		outcome[LayoutAnnotation] = string(data)
+	}
+
+	doc := NewDocument(&job, counts)
+	doc.Shadow = shadow
+	estimation, estimated := ParseEstimation(logs)
+	if estimated && defaults.Estimates(&job.Spec) {
+		doc.Estimation = estimation
+	}
+	// Outputs that failed for good only fail the job if no output got the results
+	uploads, _ := ParseUploads(logs)
+	statuses, err := Export(ctx, p.Client, p.Scheme, p.Search, &job, doc, uploads)
+	if err != nil {
+		return p.release(ctx, task, err)
+	}
+	if AllFailed(statuses) {
+		return p.finish(ctx, task, &job, map[string]string{ErrorAnnotation: exportFailures(statuses)})
+	}
+
+	outcome[ProcessedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if len(statuses) > 0 {
+		data, err := json.Marshal(statuses)
+		if err != nil {
+			return p.release(ctx, task, err)
+		}
+		outcome[OutputsAnnotation] = string(data)
+	}
+	if optimization, ok := ParseOptimization(logs); ok && job.Spec.Optimizer != nil {
+		data, err := json.Marshal(optimization)
+		if err != nil {
+			return p.release(ctx, task, err)
+		}
+		outcome[OptimizationAnnotation] = string(data)
+	}
+	if doc.Estimation != nil {
+		data, err := json.Marshal(doc.Estimation.Summary())
+		if err != nil {
+			return p.release(ctx, task, err)
+		}
+		outcome[EstimationAnnotation] = string(data)
+	}
+	if counts != nil || doc.Estimation != nil {
+		executionTime, _ := ParseExecutionTime(logs)
+		info := NewInfo(&job, counts, executionTime)
+		if doc.Estimation != nil {
+			info.ExpectationValues = ExpectationValues(doc.Estimation)
+		}
+		info.Mitigations, _ = ParseMitigations(logs)
+		info.Location = ExportedLocation(statuses)
+		if err := Seal(ctx, p.Signer, &job, doc, info, statuses); err != nil {
+			return p.release(ctx, task, err)
+		}
+		data, err := json.Marshal(info)
+		if err != nil {
+			return p.release(ctx, task, err)
+		}
+		outcome[InfoAnnotation] = string(data)
+		// Results that cannot be cached only lose the reuse
+		if counts != nil && UsesCache(&job) {
+			if err := StoreCache(ctx, p.Client, &job, counts, info); err != nil {
+				logger.Error(err, "Failed to cache results")
+			}
+		}
+	}
+	if shadow != nil {
+		data, err := json.Marshal(shadow.Divergence)
+		if err != nil {
+			return p.release(ctx, task, err)
+		}
+		outcome[ShadowAnnotation] = string(data)
+	}
+	logger.Info("Results processed")
+	return p.finish(ctx, task, &job, outcome)
+}
+
+// finish records the outcome annotations on the job and removes the task.
+// The job is annotated first so the reconciler never sees a job with neither
+// a task nor an outcome.
+func (p *Processor) finish(ctx context.Context, task *work.Task, job *quantumv1.QiskitJob, outcome map[string]string) error {
+	patch := client.MergeFrom(job.DeepCopy())
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	for key, value := range outcome {
+		job.Annotations[key] = value
+	}
+	if err := p.Client.Patch(ctx, job, patch); err != nil {
+		return p.release(ctx, task, err)
+	}
+	return p.Queue.Complete(ctx, task)
+}
+
+// exportFailures describes why the export to each output failed
+func exportFailures(statuses []quantumv1.OutputStatus) string {
+	failures := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		failures = append(failures, fmt.Sprintf("output %s: %s", status.Name, status.Message))
+	}
+	return strings.Join(failures, "; ")
+}
+
+// release returns the task to the queue and passes cause through
+func (p *Processor) release(ctx context.Context, task *work.Task, cause error) error {
+	if err := p.Queue.Release(ctx, task); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to release task", "job", task.Job)
+	}
+	return cause
+}
+
+// executionNamespace returns the namespace the job's executors ran in, its
+// sandbox namespace if it was sandboxed
+func executionNamespace(job *quantumv1.QiskitJob) string {
+	if job.Status.SandboxNamespace != "" {
+		return job.Status.SandboxNamespace
+	}
+	return job.Namespace
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/work"
+)
+
+// failingLogReader fails every log read with err
+type failingLogReader struct {
+	err error
+}
+
+func (f failingLogReader) PodLogs(ctx context.Context, namespace, name string) (string, error) {
+	return "", f.err
+}
+
+var _ = Describe("Processor", func() {
+	var (
+		ctx     context.Context
+		c       client.Client
+		job     *quantumv1.QiskitJob
+		manager *work.Queue
+		worker  *work.Queue
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		job = ghzJob("ghz-3", 3).WithOutput("configmap", "ghz-3-results").Build()
+		job.UID = types.UID("ghz-3-uid")
+		job.Status.JobID = "ghz-3-pod"
+		job.Status.SelectedBackend = "ibm_torino"
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+		manager = work.NewQueue(c, scheme, "qiskit-operator", 10*time.Second)
+		worker = work.NewQueue(c, scheme, "results-processor-a", 10*time.Second)
+		Expect(manager.Enqueue(ctx, work.TaskResultParsing, job)).To(Succeed())
+	})
+
+	claim := func() *work.Task {
+		task, err := worker.Claim(ctx, work.TaskResultParsing)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(task).NotTo(BeNil())
+		Expect(task.Job).To(Equal(client.ObjectKeyFromObject(job)))
+		return task
+	}
+
+	processed := func() *quantumv1.QiskitJob {
+		var got quantumv1.QiskitJob
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(job), &got)).To(Succeed())
+		return &got
+	}
+
+	pending := func() bool {
+		pending, err := manager.Pending(ctx, work.TaskResultParsing, job)
+		Expect(err).NotTo(HaveOccurred())
+		return pending
+	}
+
+	It("Should export the results and hand the job back annotated", func() {
+		p := &Processor{Client: c, Scheme: scheme, Queue: worker,
+			Logs: fakeLogReader{"ghz-3-pod": `{"counts": {"000": 498, "111": 502}}`}}
+		Expect(p.Process(ctx, claim())).To(Succeed())
+
+		got := processed()
+		Expect(got.Annotations).To(HaveKey(ProcessedAnnotation))
+		Expect(got.Annotations).NotTo(HaveKey(ErrorAnnotation))
+		info, ok := ParseInfoAnnotation(got)
+		Expect(ok).To(BeTrue())
+		Expect(info.Shots).To(Equal(1000))
+		Expect(info.Location).To(Equal("configmap://default/ghz-3-results"))
+		statuses, ok := ParseOutputsAnnotation(got)
+		Expect(ok).To(BeTrue())
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].State).To(Equal(quantumv1.OutputExported))
+
+		Expect(c.Get(ctx, types.NamespacedName{Name: "ghz-3-results", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+		Expect(pending()).To(BeFalse(), "the task is completed once the job is annotated")
+	})
+
+	It("Should report a missing execution pod on the job and complete the task", func() {
+		p := &Processor{Client: c, Scheme: scheme, Queue: worker,
+			Logs: failingLogReader{apierrors.NewNotFound(corev1.Resource("pods"), "ghz-3-pod")}}
+		Expect(p.Process(ctx, claim())).To(Succeed())
+
+		got := processed()
+		Expect(got.Annotations).To(HaveKeyWithValue(ErrorAnnotation, "execution pod ghz-3-pod not found"))
+		Expect(got.Annotations).NotTo(HaveKey(ProcessedAnnotation))
+		Expect(pending()).To(BeFalse())
+	})
+
+	It("Should read the logs of the current attempt when no execution was recorded", func() {
+		patch := client.MergeFrom(job.DeepCopy())
+		job.Status.JobID = ""
+		job.Status.RetryCount = 1
+		Expect(c.Patch(ctx, job, patch)).To(Succeed())
+
+		p := &Processor{Client: c, Scheme: scheme, Queue: worker,
+			Logs: failingLogReader{apierrors.NewNotFound(corev1.Resource("pods"), "qiskit-job-ghz-3-attempt-2")}}
+		Expect(p.Process(ctx, claim())).To(Succeed())
+		Expect(processed().Annotations).To(HaveKeyWithValue(ErrorAnnotation, "execution pod qiskit-job-ghz-3-attempt-2 not found"))
+	})
+
+	It("Should release the task on a transient failure for any worker to retry", func() {
+		unavailable := errors.New("connection refused")
+		p := &Processor{Client: c, Scheme: scheme, Queue: worker, Logs: failingLogReader{unavailable}}
+		Expect(p.Process(ctx, claim())).To(MatchError(unavailable))
+
+		Expect(processed().Annotations).To(BeEmpty())
+		Expect(pending()).To(BeTrue())
+		var lease coordinationv1.Lease
+		Expect(c.Get(ctx, types.NamespacedName{Name: work.LeaseName(job.Name, work.TaskResultParsing), Namespace: "default"},
+			&lease)).To(Succeed())
+		Expect(lease.Spec.HolderIdentity).To(BeNil())
+
+		other := work.NewQueue(c, scheme, "results-processor-b", 10*time.Second)
+		task, err := other.Claim(ctx, work.TaskResultParsing)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(task).NotTo(BeNil(), "the released task is claimable at once")
+	})
+
+	It("Should drop the task of a job that was deleted", func() {
+		task := claim()
+		Expect(c.Delete(ctx, job)).To(Succeed())
+
+		p := &Processor{Client: c, Scheme: scheme, Queue: worker, Logs: fakeLogReader{}}
+		Expect(p.Process(ctx, task)).To(Succeed())
+		Expect(pending()).To(BeFalse())
+	})
+
+	It("Should not write results for a task another worker took over", func() {
+		task := claim()
+		task.Lease.Spec.HolderIdentity = nil
+
+		p := &Processor{Client: c, Scheme: scheme, Queue: worker,
+			Logs: fakeLogReader{"ghz-3-pod": `{"counts": {"000": 498, "111": 502}}`}}
+		Expect(p.Process(ctx, task)).NotTo(Succeed())
+		Expect(processed().Annotations).To(BeEmpty())
+		Expect(c.Get(ctx, types.NamespacedName{Name: "ghz-3-results", Namespace: "default"}, &corev1.ConfigMap{})).NotTo(Succeed())
+	})
+})
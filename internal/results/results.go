@@ -0,0 +1,303 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package results builds, parses and exports QiskitJob results. It is used by
+// the reconciler when results are processed inline and by the separate
+// results processor otherwise.
+package results
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Annotations the results processor sets on a job to hand it back to the
+// reconciler
+const (
+	// ProcessedAnnotation holds the time results were processed
+	ProcessedAnnotation = "quantum.io/results-processed"
+	// ErrorAnnotation holds why results could not be processed
+	ErrorAnnotation = "quantum.io/results-error"
+)
+
+// Document is the results.json written to output sinks
+type Document struct {
+	// SchemaVersion is the version of the document's structure; see
+	// SchemaVersion
+	SchemaVersion int `json:"schema_version"`
+
+	JobID   string `json:"job_id"`
+	JobName string `json:"job_name"`
+	Backend string `json:"backend"`
+	Shots   int    `json:"shots"`
+	Results struct {
+		Counts map[string]int `json:"counts"`
+		// Shards is the number of shards holding the counts when they are
+		// stored separately; Counts is empty then
+		Shards int `json:"shards,omitempty"`
+	} `json:"results"`
+	Status string `json:"status"`
+	// Metadata is the searchable experiment metadata, also applied as labels
+	// or tags wherever the document is stored
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Shadow holds the results of the job's shadow run, if it had one
+	Shadow *ShadowResults `json:"shadow,omitempty"`
+	// Layout tells which qubits the bits of the counts were measured from,
+	// for circuits the executor transpiled
+	Layout *Layout `json:"layout,omitempty"`
+	// Calibration is the state of the device the job ran on, for devices
+	// whose provider publishes it
+	Calibration *Calibration `json:"calibration,omitempty"`
+	// Sweep holds the counts of each binding of a sweep job, whose counts
+	// are their totals
+	Sweep []SweepResult `json:"sweep,omitempty"`
+	// Tomography holds the counts of each measurement setting of a
+	// tomography job and the state reconstructed from them; its counts are
+	// those of the setting measuring every qubit in the Z basis
+	Tomography *TomographyResult `json:"tomography,omitempty"`
+	// Split holds the counts each backend of a split job measured, whose
+	// counts are their totals
+	Split []SplitResult `json:"split,omitempty"`
+	// Estimation holds the expectation values of the observables of an
+	// estimator job, which has no counts
+	Estimation *Estimation `json:"estimation,omitempty"`
+}
+
+// NewDocument builds the results document of a completed job
+func NewDocument(job *quantumv1.QiskitJob, counts map[string]int) *Document {
+	doc := &Document{
+		SchemaVersion: SchemaVersion,
+		JobID:         job.Status.JobID,
+		JobName:       job.Name,
+		Backend:       job.Status.SelectedBackend,
+		Shots:         job.Spec.Execution.Shots,
+		Status:        "completed",
+		Metadata:      MetadataLabels(job),
+		Layout:        recordedLayout(job),
+		Calibration:   recordedCalibration(job),
+	}
+	doc.Results.Counts = counts
+	return doc
+}
+
+// withoutCounts returns a copy of the document that points at the shards
+// holding its counts instead of the counts themselves
+func (d *Document) withoutCounts(shards int) *Document {
+	manifest := *d
+	manifest.Results.Counts = nil
+	manifest.Results.Shards = shards
+	return &manifest
+}
+
+// JSON renders the document as indented JSON
+func (d *Document) JSON() (string, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// bitstringPattern matches measurement outcome keys such as "00" or "01 1"
+var bitstringPattern = regexp.MustCompile(`^[01][01 ]*$`)
+
+// ParseCounts extracts measurement counts from execution pod logs. Circuits
+// report counts by printing a JSON object, either the counts themselves or an
+// object with a "counts" field; the last such line wins.
+func ParseCounts(logs string) (map[string]int, bool) {
+	var found map[string]int
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if counts, ok := parseCountsLine(strings.TrimSpace(scanner.Text())); ok {
+			found = counts
+		}
+	}
+	return found, found != nil
+}
+
+func parseCountsLine(line string) (map[string]int, bool) {
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+
+	var wrapped struct {
+		Counts map[string]int `json:"counts"`
+	}
+	if err := json.Unmarshal([]byte(line), &wrapped); err == nil && wrapped.Counts != nil {
+		return wrapped.Counts, true
+	}
+
+	var counts map[string]int
+	if err := json.Unmarshal([]byte(line), &counts); err != nil || len(counts) == 0 {
+		return nil, false
+	}
+	for key := range counts {
+		if !bitstringPattern.MatchString(key) {
+			return nil, false
+		}
+	}
+	return counts, true
+}
+
+// Export writes the job's results document to each of its outputs and
+// reports how the export to every one went. An output that fails does not
+// keep the results from the others. The error joins the failures that may
+// succeed if the export is retried; failures that cannot are only reported
+// in the statuses. Outputs the uploader sidecar reported on in uploads keep
+// the state it reported; other sinks the operator does not write to itself
+// are left to the executor.
+func Export(ctx context.Context, c client.Client, scheme *runtime.Scheme, search *SearchIndexer,
+	job *quantumv1.QiskitJob, doc *Document, uploads []quantumv1.OutputStatus) ([]quantumv1.OutputStatus, error) {
+	var statuses []quantumv1.OutputStatus
+	var retryable []error
+	for i := range job.Spec.Outputs {
+		output := &job.Spec.Outputs[i]
+		status := quantumv1.OutputStatus{
+			Name:     OutputName(output),
+			Type:     output.Type,
+			Location: Location(job, output),
+			State:    quantumv1.OutputExported,
+		}
+		if upload, ok := uploadStatus(uploads, status.Name); ok {
+			status.State, status.Message = upload.State, upload.Message
+			statuses = append(statuses, status)
+			continue
+		}
+		delegated, err := exportOutput(ctx, c, scheme, search, job, output, doc)
+		switch {
+		case err != nil:
+			status.State = quantumv1.OutputFailed
+			status.Message = err.Error()
+			if !Permanent(err) {
+				retryable = append(retryable, fmt.Errorf("output %s: %w", status.Name, err))
+			}
+		case delegated:
+			status.State = quantumv1.OutputDelegated
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, errors.Join(retryable...)
+}
+
+// Permanent reports whether an export failed in a way retrying cannot fix
+func Permanent(err error) bool {
+	return errors.Is(err, ErrTooLarge) || errors.Is(err, ErrRejected) || errors.Is(err, ErrSearchNotConfigured)
+}
+
+// AllFailed reports whether the results reached none of the outputs
+func AllFailed(statuses []quantumv1.OutputStatus) bool {
+	for _, status := range statuses {
+		if status.State != quantumv1.OutputFailed {
+			return false
+		}
+	}
+	return len(statuses) > 0
+}
+
+// exportOutput writes the results document to one output, reporting
+// whether it is left to the executor instead
+func exportOutput(ctx context.Context, c client.Client, scheme *runtime.Scheme, search *SearchIndexer,
+	job *quantumv1.QiskitJob, output *quantumv1.OutputSpec, doc *Document) (bool, error) {
+	if output.Type == "configmap" {
+		return false, ExportConfigMap(ctx, c, scheme, job, output, doc)
+	}
+	if driver := Driver(output.Type); driver != nil && !driver.Mounted {
+		return false, ExportStore(ctx, OperatorEnv(c, scheme, job), output, doc)
+	}
+	switch output.Type {
+	case "opensearch", "elasticsearch":
+		if search == nil {
+			return false, ErrSearchNotConfigured
+		}
+		summary := NewSummary(job, doc.Results.Counts, DefaultTopK)
+		if doc.Shadow != nil {
+			summary.ShadowBackend = doc.Shadow.Backend
+			summary.ShadowDivergence = &doc.Shadow.Divergence
+		}
+		return false, search.Index(ctx, output.Location, summary)
+	}
+	return true, nil
+}
+
+// applyConfigMap creates the ConfigMap owned by the job, or brings an
+// existing one back to the payload, labels and owner it should have. It
+// only writes when something drifted, so exporting the same results again is
+// free.
+func applyConfigMap(ctx context.Context, c client.Client, scheme *runtime.Scheme, job *quantumv1.QiskitJob, cm *corev1.ConfigMap) error {
+	existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: cm.Name, Namespace: cm.Namespace}}
+	op, err := controllerutil.CreateOrUpdate(ctx, c, existing, func() error {
+		existing.Data = cm.Data
+		existing.BinaryData = cm.BinaryData
+		if existing.Labels == nil {
+			existing.Labels = map[string]string{}
+		}
+		for key, value := range cm.Labels {
+			existing.Labels[key] = value
+		}
+		for key, value := range cm.Annotations {
+			if existing.Annotations == nil {
+				existing.Annotations = map[string]string{}
+			}
+			existing.Annotations[key] = value
+		}
+		if metav1.GetControllerOf(existing) != nil {
+			// Results written to the same location by another job stay its
+			return nil
+		}
+		return controllerutil.SetControllerReference(job, existing, scheme)
+	})
+	if err != nil {
+		return err
+	}
+	if op != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("Applied results ConfigMap", "name", cm.Name, "operation", op)
+	}
+	return nil
+}
+
+// ErrTooLarge reports results that do not fit their output even when compressed
+var ErrTooLarge = errors.New("results are too large for a ConfigMap")
+
+// maxConfigMapBytes is the most data a ConfigMap can hold
+const maxConfigMapBytes = 1 << 20
+
+// configMapSize approximates the stored size of a ConfigMap's payload
+func configMapSize(cm *corev1.ConfigMap) int {
+	size := 0
+	for key, value := range cm.Data {
+		size += len(key) + len(value)
+	}
+	for key, value := range cm.BinaryData {
+		size += len(key) + base64.StdEncoding.EncodedLen(len(value))
+	}
+	return size
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Keys of the Secret named by spec.outputs[].secretName of s3, gcs and oci
+// outputs
+const (
+	S3AccessKeyIDKey     = "access-key-id"
+	S3SecretAccessKeyKey = "secret-access-key"
+	// S3SessionTokenKey holds the token of temporary credentials
+	S3SessionTokenKey = "session-token"
+	// S3RegionKey holds the bucket's region, us-east-1 by default
+	S3RegionKey = "region"
+	// S3EndpointKey holds the URL of an S3-compatible store such as MinIO;
+	// buckets are then addressed path-style
+	S3EndpointKey = "endpoint"
+)
+
+// RetentionTag tags uploaded objects with their output's retention, for bucket
+// lifecycle rules to expire them by
+const RetentionTag = "retention"
+
+// s3Timeout bounds each request to the object store
+const s3Timeout = time.Minute
+
+// s3HTTPClient uploads results to object stores
+var s3HTTPClient = &http.Client{Timeout: s3Timeout}
+
+// S3Credentials authenticate requests to S3 or an S3-compatible store
+type S3Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Region          string
+	Endpoint        string
+}
+
+// S3CredentialsFromSecret reads the credentials of an s3 output
+func S3CredentialsFromSecret(secret *corev1.Secret) (*S3Credentials, error) {
+	creds := &S3Credentials{
+		AccessKeyID:     string(secret.Data[S3AccessKeyIDKey]),
+		SecretAccessKey: string(secret.Data[S3SecretAccessKeyKey]),
+		SessionToken:    string(secret.Data[S3SessionTokenKey]),
+		Region:          string(secret.Data[S3RegionKey]),
+		Endpoint:        strings.TrimSuffix(string(secret.Data[S3EndpointKey]), "/"),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return nil, fmt.Errorf("%w: Secret %s has no %s or %s", ErrRejected, secret.Name,
+			S3AccessKeyIDKey, S3SecretAccessKeyKey)
+	}
+	if creds.Region == "" {
+		creds.Region = "us-east-1"
+	}
+	if creds.Endpoint != "" {
+		if u, err := url.Parse(creds.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%w: Secret %s has an invalid %s %q", ErrRejected, secret.Name,
+				S3EndpointKey, creds.Endpoint)
+		}
+	}
+	return creds, nil
+}
+
+// S3SecretKeys are the keys of the Secret of s3 outputs and inputs
+var S3SecretKeys = []SecretKey{
+	{Key: S3AccessKeyIDKey, Env: "ACCESS_KEY_ID"},
+	{Key: S3SecretAccessKeyKey, Env: "SECRET_ACCESS_KEY"},
+	{Key: S3SessionTokenKey, Env: "SESSION_TOKEN", Optional: true},
+	{Key: S3RegionKey, Env: "REGION", Optional: true},
+	{Key: S3EndpointKey, Env: "ENDPOINT", Optional: true},
+}
+
+// s3Driver stores results in the bucket named by the output's location,
+// under JobPrefix
+var s3Driver = &StoreDriver{
+	Open: func(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error) {
+		creds, err := s3Credentials(ctx, env, output, S3SecretKeys)
+		if err != nil {
+			return nil, err
+		}
+		return &s3Store{creds: creds, scheme: "s3", bucket: output.Location, prefix: env.Prefix, tagging: true}, nil
+	},
+	SecretKeys: S3SecretKeys,
+	Scheme:     "s3",
+	Uploaded:   true,
+}
+
+// s3Credentials reads the credentials of an output of an S3-compatible store
+func s3Credentials(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec, keys []SecretKey) (*S3Credentials, error) {
+	secret, err := env.secret(ctx, output, keys)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireKeys(secret, keys); err != nil {
+		return nil, err
+	}
+	return S3CredentialsFromSecret(secret)
+}
+
+// s3Store keeps objects in a bucket of S3 or an S3-compatible store, under
+// the job's prefix. Objects carry their checksum as x-amz-meta-* metadata,
+// and their labels as object tags where the store supports them.
+type s3Store struct {
+	creds *S3Credentials
+	// scheme names the store in messages, as in s3://<bucket>/<key>
+	scheme string
+	bucket string
+	prefix string
+	// tagging stores support object tags, which objects are tagged with
+	// their labels and retention in. Other stores keep the labels as
+	// metadata.
+	tagging bool
+}
+
+// Put implements ResultStore. Credentials and throttling failures can be
+// retried; other refusals are reported as ErrRejected.
+func (s *s3Store) Put(ctx context.Context, object *Object) error {
+	key := s.prefix + object.Key
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.creds.objectURL(s.bucket, key), bytes.NewReader(object.Data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", object.ContentType)
+	req.Header.Set("Content-MD5", contentMD5(object.Data))
+	if object.Checksum != "" {
+		req.Header.Set("X-Amz-Meta-"+metadataName(ChecksumAnnotation), object.Checksum)
+	}
+	if s.tagging {
+		if tags := objectTags(object); tags != "" {
+			req.Header.Set("X-Amz-Tagging", tags)
+		}
+	} else {
+		for name, value := range object.Labels {
+			req.Header.Set("X-Amz-Meta-"+metadataName(name), value)
+		}
+	}
+	resp, err := s.do(req, object.Data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return storeError(resp, fmt.Sprintf("uploading %s://%s/%s", s.scheme, s.bucket, key))
+}
+
+// Get implements ResultStore
+func (s *s3Store) Get(ctx context.Context, key string) (*Object, error) {
+	key = s.prefix + key
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.creds.objectURL(s.bucket, key), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := storeError(resp, fmt.Sprintf("downloading %s://%s/%s", s.scheme, s.bucket, key)); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Object{
+		Key:         strings.TrimPrefix(key, s.prefix),
+		Data:        data,
+		ContentType: resp.Header.Get("Content-Type"),
+		Checksum:    resp.Header.Get("X-Amz-Meta-" + metadataName(ChecksumAnnotation)),
+	}, nil
+}
+
+// List implements ResultStore with ListObjectsV2
+func (s *s3Store) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {s.prefix + prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.creds.objectURL(s.bucket, ""), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.URL.RawQuery = query.Encode()
+		resp, err := s.do(req, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Contents []struct {
+				Key  string `xml:"Key"`
+				Size int64  `xml:"Size"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		err = storeError(resp, fmt.Sprintf("listing %s://%s/%s", s.scheme, s.bucket, s.prefix+prefix))
+		if err == nil {
+			err = xml.NewDecoder(resp.Body).Decode(&result)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range result.Contents {
+			objects = append(objects, ObjectInfo{Key: strings.TrimPrefix(object.Key, s.prefix), Size: object.Size})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// Delete implements ResultStore
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	key = s.prefix + key
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.creds.objectURL(s.bucket, key), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return storeError(resp, fmt.Sprintf("deleting %s://%s/%s", s.scheme, s.bucket, key))
+}
+
+// do signs and sends a request to the store
+func (s *s3Store) do(req *http.Request, body []byte) (*http.Response, error) {
+	s.creds.Sign(req, body, time.Now())
+	return s3HTTPClient.Do(req)
+}
+
+// storeError reports a response of an object store that is not a success.
+// Credentials and throttling failures can be retried, as can failures of the
+// store itself; other refusals are reported as ErrRejected.
+func storeError(resp *http.Response, action string) error {
+	if resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	err := fmt.Errorf("%s failed with HTTP %d: %s", action, resp.StatusCode, strings.TrimSpace(string(body)))
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		return err
+	}
+	if resp.StatusCode < 500 {
+		return fmt.Errorf("%w: %w", ErrRejected, err)
+	}
+	return err
+}
+
+// metadataName turns a label key into the name of an HTTP metadata header,
+// which cannot hold slashes
+func metadataName(key string) string {
+	return strings.ToLower(invalidMetadataChars.ReplaceAllString(key, "-"))
+}
+
+var invalidMetadataChars = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// contentMD5 is the Content-MD5 header of data, which stores check the
+// payload they received against
+func contentMD5(data []byte) string {
+	sum := md5.Sum(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// EncodeOutput encodes the results document in the output's format and
+// compression, returning the name of the file holding it and its content
+// type. Documents that cannot be encoded are reported as ErrRejected.
+func EncodeOutput(doc *Document, output *quantumv1.OutputSpec) (string, []byte, string, error) {
+	name, data, contentType, err := EncodeDocument(doc, output.Format)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("%w: %w", ErrRejected, err)
+	}
+	if output.Compression != "" && output.Compression != CompressionNone {
+		if data, err = Compress(data, output.Compression); err != nil {
+			return "", nil, "", fmt.Errorf("%w: %w", ErrRejected, err)
+		}
+		name += extensions[output.Compression]
+	}
+	return name, data, contentType, nil
+}
+
+// PutObject uploads data to key in bucket. Credentials and throttling
+// failures can be retried; other refusals are reported as ErrRejected.
+func (s *S3Credentials) PutObject(ctx context.Context, bucket, key string, data []byte, contentType, retention string) error {
+	store := &s3Store{creds: s, scheme: "s3", bucket: bucket, tagging: true}
+	return store.Put(ctx, &Object{Key: key, Data: data, ContentType: contentType, Retention: retention})
+}
+
+// objectURL addresses the object virtual-hosted style on AWS and path-style
+// on custom endpoints or for bucket names with dots, which would not match
+// the AWS wildcard certificate
+func (s *S3Credentials) objectURL(bucket, key string) string {
+	escaped := escapeS3Path(key)
+	if s.Endpoint != "" {
+		return fmt.Sprintf("%s/%s%s", s.Endpoint, bucket, escaped)
+	}
+	if strings.Contains(bucket, ".") {
+		return fmt.Sprintf("https://s3.%s.amazonaws.com/%s%s", s.Region, bucket, escaped)
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com%s", bucket, s.Region, escaped)
+}
+
+// Sign adds an AWS Signature Version 4 for the S3 service to req, signing
+// its host and all headers it already has
+func (s *S3Credentials) Sign(req *http.Request, body []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, strings.TrimSpace(headers[name]))
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		escapeS3Path(strings.TrimPrefix(req.URL.Path, "/")),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, s.Region)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.SecretAccessKey), date)
+	key = hmacSHA256(key, s.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// escapeS3Path URI-encodes each segment of an object path the way Signature
+// Version 4 canonicalizes it, keeping the slashes between segments
+func escapeS3Path(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		var b strings.Builder
+		for _, c := range []byte(segment) {
+			if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+				c == '-' || c == '_' || c == '.' || c == '~' {
+				b.WriteByte(c)
+			} else {
+				fmt.Fprintf(&b, "%%%02X", c)
+			}
+		}
+		segments[i] = b.String()
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
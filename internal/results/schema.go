@@ -0,0 +1,88 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SchemaVersion is the version of the results documents, shards and search
+// summaries this operator writes. Bump it and publish a new schema when a
+// field is removed or changes meaning; new optional fields do not need one.
+//
+// Version 1 documents were written before versioning and carry no version.
+// They have the same fields as version 2.
+const SchemaVersion = 2
+
+// SchemaVersionLabel records the schema version on results ConfigMaps
+const SchemaVersionLabel = "quantum.io/results-schema"
+
+// Schema is the JSON Schema of SchemaVersion. The same file is published in
+// the cluster as the results-schema ConfigMap.
+//
+//go:embed schema/results-v2.schema.json
+var Schema []byte
+
+// ErrUnsupportedSchema reports results written by a newer operator than this
+// one can read
+var ErrUnsupportedSchema = errors.New("unsupported results schema version")
+
+// DecodeDocument parses a results document of any supported schema version
+// into the current Document
+func DecodeDocument(data []byte) (*Document, error) {
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse results: %w", err)
+	}
+	version, err := supportedVersion(doc.SchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	doc.SchemaVersion = version
+	return &doc, nil
+}
+
+// decodeShard parses a shard of any supported schema version into the
+// current Shard
+func decodeShard(data []byte) (*Shard, error) {
+	var shard Shard
+	if err := json.Unmarshal(data, &shard); err != nil {
+		return nil, err
+	}
+	version, err := supportedVersion(shard.SchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	shard.SchemaVersion = version
+	return &shard, nil
+}
+
+// supportedVersion returns the current version for documents of a version
+// this operator can read, upgrading their fields as needed
+func supportedVersion(version int) (int, error) {
+	switch {
+	case version > SchemaVersion:
+		return 0, fmt.Errorf("%w %d, this operator reads up to %d", ErrUnsupportedSchema, version, SchemaVersion)
+	case version < 0:
+		return 0, fmt.Errorf("%w %d", ErrUnsupportedSchema, version)
+	}
+	// Versions 0 (unversioned) and 1 have the same fields as the current one
+	return SchemaVersion, nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// DefaultTopK is how many of the most frequent outcomes a search summary keeps
+const DefaultTopK = 20
+
+// searchTimeout bounds each request to the search cluster
+const searchTimeout = 30 * time.Second
+
+var (
+	// ErrSearchNotConfigured reports a job exporting to a search index on an
+	// operator started without a search cluster
+	ErrSearchNotConfigured = errors.New("no search cluster is configured for opensearch and elasticsearch outputs")
+	// ErrRejected reports results a sink refused and will keep refusing
+	ErrRejected = errors.New("results rejected by the output sink")
+)
+
+// indexPattern matches index names OpenSearch and Elasticsearch accept
+var indexPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// Outcome is one measurement outcome of a search summary
+type Outcome struct {
+	Bitstring   string  `json:"bitstring"`
+	Count       int     `json:"count"`
+	Probability float64 `json:"probability"`
+}
+
+// Summary is the document indexed for a job: enough to search and aggregate
+// experiments by metadata, cost and duration long after the job is deleted,
+// with the most frequent outcomes instead of the full counts
+type Summary struct {
+	SchemaVersion int `json:"schema_version"`
+
+	UID         string            `json:"uid"`
+	Namespace   string            `json:"namespace"`
+	Name        string            `json:"name"`
+	JobID       string            `json:"job_id,omitempty"`
+	BackendType string            `json:"backend_type"`
+	Backend     string            `json:"backend,omitempty"`
+	Region      string            `json:"region,omitempty"`
+	Shots       int               `json:"shots"`
+	Qubits      int               `json:"qubits,omitempty"`
+	Depth       int               `json:"depth,omitempty"`
+	CircuitHash string            `json:"circuit_hash,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+
+	SubmittedAt time.Time  `json:"submitted_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt time.Time  `json:"completed_at"`
+	// Seconds the job waited in the provider queue and spent from start to completion
+	QueueSeconds    float64 `json:"queue_seconds,omitempty"`
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+
+	EstimatedCost float64 `json:"estimated_cost_usd,omitempty"`
+	ActualCost    float64 `json:"actual_cost_usd,omitempty"`
+	Retries       int     `json:"retries,omitempty"`
+
+	// TotalCounts is the number of measured shots and Outcomes the number
+	// of distinct outcomes, of which TopOutcomes are the most frequent
+	TotalCounts int       `json:"total_counts"`
+	Outcomes    int       `json:"outcomes"`
+	TopOutcomes []Outcome `json:"top_outcomes"`
+
+	// How far the job's shadow run diverged from it, if it had one
+	ShadowBackend    string      `json:"shadow_backend,omitempty"`
+	ShadowDivergence *Divergence `json:"shadow_divergence,omitempty"`
+}
+
+// NewSummary builds the search summary of a completed job, keeping the topK
+// most frequent outcomes
+func NewSummary(job *quantumv1.QiskitJob, counts map[string]int, topK int) *Summary {
+	now := time.Now().UTC()
+	s := &Summary{
+		SchemaVersion: SchemaVersion,
+		UID:           string(job.UID),
+		Namespace:     job.Namespace,
+		Name:          job.Name,
+		JobID:         job.Status.JobID,
+		BackendType:   job.Spec.Backend.Type,
+		Backend:       job.Status.SelectedBackend,
+		Region:        job.Status.Region,
+		Shots:         job.Spec.Execution.Shots,
+		Tags:          job.Spec.Execution.Tags,
+		Labels:        job.Labels,
+		Metadata:      MetadataLabels(job),
+		SubmittedAt:   job.CreationTimestamp.UTC(),
+		CompletedAt:   now,
+		Retries:       job.Status.RetryCount,
+		Outcomes:      len(counts),
+	}
+	if m := job.Status.CircuitMetadata; m != nil {
+		s.Qubits = m.Qubits
+		s.Depth = m.Depth
+		s.CircuitHash = m.Hash
+	}
+	if job.Status.CompletionTime != nil {
+		s.CompletedAt = job.Status.CompletionTime.UTC()
+	}
+	if job.Status.StartTime != nil {
+		started := job.Status.StartTime.UTC()
+		s.StartedAt = &started
+		s.DurationSeconds = s.CompletedAt.Sub(started).Seconds()
+	}
+	if m := job.Status.Metrics; m != nil {
+		if d, err := time.ParseDuration(m.QueueTime); err == nil {
+			s.QueueSeconds = d.Seconds()
+		}
+	}
+	s.EstimatedCost = parseCost(job.Status.EstimatedCost)
+	s.ActualCost = parseCost(job.Status.ActualCost)
+
+	for bitstring, count := range counts {
+		s.TotalCounts += count
+		s.TopOutcomes = append(s.TopOutcomes, Outcome{Bitstring: bitstring, Count: count})
+	}
+	sort.Slice(s.TopOutcomes, func(i, j int) bool {
+		a, b := s.TopOutcomes[i], s.TopOutcomes[j]
+		return a.Count > b.Count || (a.Count == b.Count && a.Bitstring < b.Bitstring)
+	})
+	if topK > 0 && len(s.TopOutcomes) > topK {
+		s.TopOutcomes = s.TopOutcomes[:topK]
+	}
+	for i := range s.TopOutcomes {
+		s.TopOutcomes[i].Probability = float64(s.TopOutcomes[i].Count) / float64(s.TotalCounts)
+	}
+	if s.TopOutcomes == nil {
+		s.TopOutcomes = []Outcome{}
+	}
+	return s
+}
+
+// parseCost reads a cost such as "$1.60", returning 0 if it has none
+func parseCost(cost string) float64 {
+	value, _ := strconv.ParseFloat(strings.TrimPrefix(cost, "$"), 64)
+	return value
+}
+
+// SearchIndexer indexes result summaries into OpenSearch or Elasticsearch,
+// which share the document API used here
+type SearchIndexer struct {
+	// URL of the cluster, e.g. "https://search.example.com:9200"
+	URL string
+	// APIKey is sent as an Elasticsearch API key; Username and Password as
+	// basic authentication when no key is set
+	APIKey   string
+	Username string
+	Password string
+	Client   *http.Client
+}
+
+// NewSearchIndexer returns an indexer for the cluster at rawURL with the
+// credentials in the SEARCH_API_KEY, or SEARCH_USERNAME and SEARCH_PASSWORD,
+// environment variables
+func NewSearchIndexer(rawURL string) (*SearchIndexer, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("search URL %q must be an http or https URL", rawURL)
+	}
+	return &SearchIndexer{
+		URL:      strings.TrimSuffix(rawURL, "/"),
+		APIKey:   os.Getenv("SEARCH_API_KEY"),
+		Username: os.Getenv("SEARCH_USERNAME"),
+		Password: os.Getenv("SEARCH_PASSWORD"),
+		Client:   &http.Client{Timeout: searchTimeout},
+	}, nil
+}
+
+// Index stores the summary in index under the job's UID, replacing the
+// summary of an earlier attempt
+func (s *SearchIndexer) Index(ctx context.Context, index string, summary *Summary) error {
+	if !indexPattern.MatchString(index) {
+		return fmt.Errorf("%w: %q is not a valid index name", ErrRejected, index)
+	}
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%s/%s/_doc/%s", s.URL, index, url.PathEscape(summary.UID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	switch {
+	case s.APIKey != "":
+		req.Header.Set("Authorization", "ApiKey "+s.APIKey)
+	case s.Username != "":
+		req.SetBasicAuth(s.Username, s.Password)
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	err = fmt.Errorf("indexing into %s failed with HTTP %d: %s", index, resp.StatusCode, strings.TrimSpace(string(body)))
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusTooManyRequests:
+		// Credentials and load are the operator's to fix; retry meanwhile
+		return err
+	}
+	if resp.StatusCode < 500 {
+		return fmt.Errorf("%w: %w", ErrRejected, err)
+	}
+	return err
+}
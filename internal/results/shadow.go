@@ -0,0 +1,119 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ShadowAnnotation holds the Divergence the results processor computed for a
+// job's shadow run, as JSON
+const ShadowAnnotation = "quantum.io/shadow-divergence"
+
+// Divergence measures how far the outcome distributions of two runs of the
+// same circuit are apart
+type Divergence struct {
+	// TotalVariationDistance is half the L1 distance of the distributions,
+	// from 0 (identical) to 1 (disjoint)
+	TotalVariationDistance float64 `json:"total_variation_distance"`
+	// HellingerFidelity is the squared Bhattacharyya coefficient of the
+	// distributions, from 0 (disjoint) to 1 (identical)
+	HellingerFidelity float64 `json:"hellinger_fidelity"`
+}
+
+// Compare computes the divergence of two sets of counts, normalized by their
+// own shot totals
+func Compare(a, b map[string]int) Divergence {
+	totalA, totalB := countTotal(a), countTotal(b)
+	if totalA == 0 || totalB == 0 {
+		return Divergence{TotalVariationDistance: 1}
+	}
+
+	var distance, overlap float64
+	seen := make(map[string]bool, len(a)+len(b))
+	for _, counts := range []map[string]int{a, b} {
+		for outcome := range counts {
+			if seen[outcome] {
+				continue
+			}
+			seen[outcome] = true
+			p := float64(a[outcome]) / totalA
+			q := float64(b[outcome]) / totalB
+			distance += math.Abs(p - q)
+			overlap += math.Sqrt(p * q)
+		}
+	}
+	return Divergence{
+		TotalVariationDistance: distance / 2,
+		HellingerFidelity:      math.Min(overlap*overlap, 1),
+	}
+}
+
+func countTotal(counts map[string]int) float64 {
+	var total int
+	for _, c := range counts {
+		total += c
+	}
+	return float64(total)
+}
+
+// ShadowResults are the results of a job's shadow run, stored next to the
+// primary results
+type ShadowResults struct {
+	Backend string         `json:"backend"`
+	Counts  map[string]int `json:"counts"`
+	Divergence
+}
+
+// ReadShadow reads the counts of the job's completed shadow run from its
+// execution pod and compares them with the primary counts. It returns nil if
+// the job has no completed shadow run.
+func ReadShadow(ctx context.Context, logs LogReader, job *quantumv1.QiskitJob, primary map[string]int) (*ShadowResults, error) {
+	shadow := job.Status.Shadow
+	if job.Spec.Shadow == nil || shadow == nil || shadow.Phase != "Completed" {
+		return nil, nil
+	}
+	podLogs, err := logs.PodLogs(ctx, executionNamespace(job), shadow.PodName)
+	if err != nil {
+		return nil, err
+	}
+	counts, ok := ParseCounts(podLogs)
+	if !ok {
+		return nil, fmt.Errorf("no measurement counts found in the logs of shadow pod %s", shadow.PodName)
+	}
+	return &ShadowResults{
+		Backend:    shadow.Backend,
+		Counts:     counts,
+		Divergence: Compare(primary, counts),
+	}, nil
+}
+
+// ParseDivergence reads the divergence the results processor recorded on a
+// job, reporting false if there is none
+func ParseDivergence(job *quantumv1.QiskitJob) (Divergence, bool) {
+	var d Divergence
+	value := job.Annotations[ShadowAnnotation]
+	if value == "" || json.Unmarshal([]byte(value), &d) != nil {
+		return d, false
+	}
+	return d, true
+}
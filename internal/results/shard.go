@@ -0,0 +1,112 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// JobLabel names the job results belong to
+	JobLabel = "quantum.io/job"
+	// ShardLabel holds the index of a shard ConfigMap
+	ShardLabel = "quantum.io/results-shard"
+	// ShardKey is the ConfigMap key of a shard, before any compression extension
+	ShardKey = "shard.json"
+)
+
+// Shard is one part of a job's counts, stored on its own when all counts do
+// not fit in a single object. Shards split the sorted outcomes into ranges;
+// merging sums counts, so shards from separate execution batches that share
+// outcomes merge correctly too.
+type Shard struct {
+	SchemaVersion int `json:"schema_version"`
+
+	Index int `json:"index"`
+	Total int `json:"total"`
+	// First and Last are the lowest and highest outcomes in the shard
+	First  string         `json:"first"`
+	Last   string         `json:"last"`
+	Counts map[string]int `json:"counts"`
+}
+
+// ShardName returns the name of a shard of the results stored at location
+func ShardName(location string, index int) string {
+	return fmt.Sprintf("%s-shard-%d", location, index)
+}
+
+// ShardCounts splits counts into shards of at most size outcomes. It returns
+// nil when the counts fit in one shard or size is not positive.
+func ShardCounts(counts map[string]int, size int) []Shard {
+	if size <= 0 || len(counts) <= size {
+		return nil
+	}
+
+	outcomes := make([]string, 0, len(counts))
+	for outcome := range counts {
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Strings(outcomes)
+
+	total := (len(outcomes) + size - 1) / size
+	shards := make([]Shard, 0, total)
+	for start := 0; start < len(outcomes); start += size {
+		end := min(start+size, len(outcomes))
+		shard := Shard{
+			SchemaVersion: SchemaVersion,
+			Index:         len(shards),
+			Total:         total,
+			First:         outcomes[start],
+			Last:          outcomes[end-1],
+			Counts:        make(map[string]int, end-start),
+		}
+		for _, outcome := range outcomes[start:end] {
+			shard.Counts[outcome] = counts[outcome]
+		}
+		shards = append(shards, shard)
+	}
+	return shards
+}
+
+// MergeShards combines shards back into one set of counts. It fails unless
+// every shard of the set is present exactly once.
+func MergeShards(shards []Shard) (map[string]int, error) {
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("no shards to merge")
+	}
+
+	total := shards[0].Total
+	seen := make(map[int]bool, total)
+	counts := map[string]int{}
+	for _, shard := range shards {
+		if shard.Total != total {
+			return nil, fmt.Errorf("shard %d belongs to a set of %d, not %d", shard.Index, shard.Total, total)
+		}
+		if shard.Index < 0 || shard.Index >= total || seen[shard.Index] {
+			return nil, fmt.Errorf("unexpected shard %d of %d", shard.Index, total)
+		}
+		seen[shard.Index] = true
+		for outcome, n := range shard.Counts {
+			counts[outcome] += n
+		}
+	}
+	if len(seen) != total {
+		return nil, fmt.Errorf("found %d of %d shards", len(seen), total)
+	}
+	return counts, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// signaturePrefix separates results signatures from anything else the key
+// might sign
+const signaturePrefix = "qiskit-operator-results:"
+
+// ErrSignatureMismatch reports results that do not match their signature
+var ErrSignatureMismatch = errors.New("results do not match their signature")
+
+// Signer signs the digests of results documents. KeySigner signs with a key
+// read from a file; keys held in a KMS implement it to sign there.
+type Signer interface {
+	// KeyID identifies the key, for verifiers to pick its public key
+	KeyID() string
+	// Sign signs the message
+	Sign(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// KeySigner signs with an Ed25519 private key
+type KeySigner struct {
+	key ed25519.PrivateKey
+}
+
+// LoadSigningKey reads a PEM-encoded PKCS #8 Ed25519 private key, as
+// written by "openssl genpkey -algorithm ed25519"
+func LoadSigningKey(path string) (*KeySigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("%s holds no PEM-encoded private key", path)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an Ed25519 key", path)
+	}
+	return &KeySigner{key: key}, nil
+}
+
+// KeyID implements Signer
+func (s *KeySigner) KeyID() string {
+	return KeyID(s.key.Public().(ed25519.PublicKey))
+}
+
+// Sign implements Signer
+func (s *KeySigner) Sign(_ context.Context, message []byte) ([]byte, error) {
+	return ed25519.Sign(s.key, message), nil
+}
+
+// KeyID returns the fingerprint of a public key results are verified with
+func KeyID(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return "ed25519:" + hex.EncodeToString(sum[:8])
+}
+
+// LoadVerificationKey reads the PEM-encoded public key results are verified
+// with. A private key file is accepted too, for its public half.
+func LoadVerificationKey(path string) (ed25519.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s holds no PEM-encoded key", path)
+	}
+	var parsed any
+	switch block.Type {
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "PRIVATE KEY":
+		var private any
+		if private, err = x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+			if key, ok := private.(ed25519.PrivateKey); ok {
+				parsed = key.Public()
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%s holds a %s, not a key", path, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	key, ok := parsed.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an Ed25519 key", path)
+	}
+	return key, nil
+}
+
+// Digest returns the digest of a results document: the SHA-256 of its
+// compact JSON with sharded counts merged back in. For unsharded json outputs
+// to object stores it is the digest of the uploaded object once
+// decompressed.
+func Digest(doc *Document) (string, error) {
+	canonical := *doc
+	canonical.Results.Shards = 0
+	data, err := json.Marshal(&canonical)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// Seal records the digest of the results document a job exported, and the
+// signer's signature of it, in the job's results summary. Only outputs the
+// operator can read back hold a document it vouches for; jobs whose results
+// reached none are left as they are.
+func Seal(ctx context.Context, signer Signer, job *quantumv1.QiskitJob, doc *Document, info *quantumv1.ResultsInfo,
+	statuses []quantumv1.OutputStatus) error {
+	if signer == nil || info == nil || doc == nil || doc.Results.Counts == nil {
+		return nil
+	}
+	if !slices.ContainsFunc(statuses, func(status quantumv1.OutputStatus) bool {
+		driver := Driver(status.Type)
+		return status.State == quantumv1.OutputExported && driver != nil && !driver.Mounted
+	}) {
+		return nil
+	}
+	digest, err := Digest(doc)
+	if err != nil {
+		return err
+	}
+	signature, err := signer.Sign(ctx, []byte(signaturePrefix+digest))
+	if err != nil {
+		return fmt.Errorf("failed to sign results: %w", err)
+	}
+	info.Digest = digest
+	info.Signature = base64.StdEncoding.EncodeToString(signature)
+	info.SigningKey = signer.KeyID()
+	return nil
+}
+
+// Verify checks that the results document is the one the operator signed:
+// that it has the recorded digest and the signature of that digest was made
+// with the key
+func Verify(doc *Document, info *quantumv1.ResultsInfo, key ed25519.PublicKey) error {
+	if info == nil || info.Digest == "" || info.Signature == "" {
+		return errors.New("the results were not signed")
+	}
+	if info.SigningKey != "" && info.SigningKey != KeyID(key) {
+		return fmt.Errorf("the results were signed with key %s, not %s", info.SigningKey, KeyID(key))
+	}
+	signature, err := base64.StdEncoding.DecodeString(info.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if !ed25519.Verify(key, []byte(signaturePrefix+info.Digest), signature) {
+		return fmt.Errorf("%w: the signature of digest %s is not valid", ErrSignatureMismatch, info.Digest)
+	}
+	digest, err := Digest(doc)
+	if err != nil {
+		return err
+	}
+	if digest != info.Digest {
+		return fmt.Errorf("%w: the results have digest %s, not the signed %s", ErrSignatureMismatch, digest, info.Digest)
+	}
+	return nil
+}
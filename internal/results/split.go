@@ -0,0 +1,44 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+// SplitResult holds the counts one backend of a split job measured
+type SplitResult struct {
+	// Index of the share, 0 for the job's backend
+	Index int `json:"index"`
+	// Backend that ran the share
+	Backend string `json:"backend"`
+	// Shots of the share
+	Shots int `json:"shots"`
+	// Counts the backend measured, nil if it failed or reported none
+	Counts map[string]int `json:"counts"`
+}
+
+// SplitCounts returns the counts of all backends of a split job added up,
+// so that each weighs in by the shots it ran, nil if none reported counts
+func SplitCounts(split []SplitResult) map[string]int {
+	var total map[string]int
+	for _, result := range split {
+		for outcome, count := range result.Counts {
+			if total == nil {
+				total = map[string]int{}
+			}
+			total[outcome] += count
+		}
+	}
+	return total
+}
@@ -0,0 +1,476 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ChecksumAnnotation holds the checksum of the payload of a stored object,
+// as an annotation of results ConfigMaps and as metadata of objects elsewhere
+const ChecksumAnnotation = "quantum.io/checksum"
+
+// ErrChecksumMismatch reports a stored object whose payload is not the one
+// that was written
+var ErrChecksumMismatch = errors.New("stored results do not match their checksum")
+
+// ResultStore holds the objects a job's results are stored as in one output:
+// the results document, the shards of its counts and the transpiled circuit.
+// Keys are relative to where the job's results go in the output, such as
+// results.json.gz or shard-2.json.
+type ResultStore interface {
+	// Put writes the object, replacing what is stored under its key
+	Put(ctx context.Context, object *Object) error
+	// Get reads the object stored under key, with the checksum and labels
+	// it was stored with
+	Get(ctx context.Context, key string) (*Object, error)
+	// List returns the objects whose keys start with prefix
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	// Delete removes the object stored under key, if there is one
+	Delete(ctx context.Context, key string) error
+}
+
+// Object is a file of a job's results as it is stored
+type Object struct {
+	Key         string
+	Data        []byte
+	ContentType string
+	// Labels is the metadata the object is tagged with, for searching
+	Labels map[string]string
+	// Checksum is the SHA-256 of Data as sha256:<hex>, stored with the
+	// object and checked when it is read back
+	Checksum string
+	// Retention is the output's retention, for stores that expire objects
+	// by a tag
+	Retention string
+}
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key  string
+	Size int64
+}
+
+// Checksum returns the checksum of a stored payload
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// verify checks that the object is the one that was stored. Objects stored
+// without a checksum pass.
+func (o *Object) verify() error {
+	if o.Checksum == "" || o.Checksum == Checksum(o.Data) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s has checksum %s, not the stored %s", ErrChecksumMismatch, o.Key, Checksum(o.Data), o.Checksum)
+}
+
+// SecretKey is a key of the Secret of an output, handed to the uploader
+// sidecar as the environment variable <prefix>_<Env>
+type SecretKey struct {
+	Key      string
+	Env      string
+	Optional bool
+}
+
+// StoreDriver opens the stores of one type of output. A new kind of output
+// only needs a driver registered in drivers.
+type StoreDriver struct {
+	// Open returns the store of the job's results in the output
+	Open func(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error)
+	// SecretKeys are the keys of the Secret named by the output's
+	// secretName; outputs without them take no Secret
+	SecretKeys []SecretKey
+	// Scheme prefixes the URI of the results' location, s3 for
+	// s3://<bucket>/<prefix>; outputs without one are located by the
+	// namespace and their location
+	Scheme string
+	// Uploaded outputs are written by the uploader sidecar, when the
+	// operator runs one, rather than by the operator
+	Uploaded bool
+	// Mounted outputs are volumes only the execution pod mounts, which the
+	// operator can neither write nor read
+	Mounted bool
+	// IndentedJSON stores the results document as indented JSON whatever
+	// the output's format, for people reading it with kubectl
+	IndentedJSON bool
+}
+
+// drivers holds the driver of every type of output results are stored in
+var drivers = map[string]*StoreDriver{
+	"configmap":  configMapDriver,
+	"pvc":        dirDriver,
+	"s3":         s3Driver,
+	"gcs":        gcsDriver,
+	"oci":        ociDriver,
+	"azure_blob": azureDriver,
+}
+
+// Driver returns the driver of an output type, nil for outputs that are not
+// stores, such as search indexes
+func Driver(outputType string) *StoreDriver {
+	return drivers[outputType]
+}
+
+// StoreEnv is what drivers open stores with. The operator reads the outputs'
+// Secrets with Client; the uploader sidecar gets them in its environment.
+type StoreEnv struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+	// Job owns the ConfigMaps results are stored in; its namespace holds
+	// the outputs' Secrets
+	Job *quantumv1.QiskitJob
+	// Prefix is where the job's results go in the output, JobPrefix
+	Prefix string
+	// CredentialsEnv prefixes the environment variables holding the keys
+	// of the output's Secret, read with Getenv, when it is set
+	CredentialsEnv string
+	Getenv         func(string) string
+	// Dir is where the claim of pvc outputs is mounted
+	Dir string
+}
+
+// OperatorEnv returns the environment the operator opens the stores of a
+// job's outputs in
+func OperatorEnv(c client.Client, scheme *runtime.Scheme, job *quantumv1.QiskitJob) *StoreEnv {
+	return &StoreEnv{Client: c, Scheme: scheme, Job: job}
+}
+
+// OpenStore returns the store of the job's results in the output
+func OpenStore(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec) (ResultStore, error) {
+	driver := Driver(output.Type)
+	if driver == nil {
+		return nil, fmt.Errorf("%w: outputs of type %s are not stores", ErrRejected, output.Type)
+	}
+	if env.Prefix == "" && env.Job != nil {
+		scoped := *env
+		scoped.Prefix = JobPrefix(env.Job, output)
+		env = &scoped
+	}
+	return driver.Open(ctx, env, output)
+}
+
+// secret returns the output's Secret, or one built from the environment
+// variables of the uploader sidecar holding its keys
+func (e *StoreEnv) secret(ctx context.Context, output *quantumv1.OutputSpec, keys []SecretKey) (*corev1.Secret, error) {
+	if e.CredentialsEnv != "" {
+		secret := &corev1.Secret{Data: map[string][]byte{}}
+		secret.Name = e.CredentialsEnv
+		for _, key := range keys {
+			if value := e.Getenv(e.CredentialsEnv + "_" + key.Env); value != "" {
+				secret.Data[key.Key] = []byte(value)
+			}
+		}
+		return secret, nil
+	}
+	if e.Client == nil || e.Job == nil {
+		return nil, fmt.Errorf("%w: no credentials for %s output %s", ErrRejected, output.Type, OutputName(output))
+	}
+	var secret corev1.Secret
+	if err := e.Client.Get(ctx, client.ObjectKey{Namespace: e.Job.Namespace, Name: output.SecretName}, &secret); err != nil {
+		return nil, fmt.Errorf("reading %s credentials: %w", output.Type, err)
+	}
+	return &secret, nil
+}
+
+// requireKeys reports the required keys the Secret lacks as ErrRejected
+func requireKeys(secret *corev1.Secret, keys []SecretKey) error {
+	var missing []string
+	for _, key := range keys {
+		if !key.Optional && len(secret.Data[key.Key]) == 0 {
+			missing = append(missing, key.Key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: Secret %s has no %s", ErrRejected, secret.Name, strings.Join(missing, " or "))
+}
+
+// storeAttempts is how often a write or read that may succeed when retried
+// is tried
+const storeAttempts = 3
+
+// storeRetryDelay is how long to wait between attempts
+var storeRetryDelay = time.Second
+
+// retry calls fn until it succeeds, fails permanently or for a missing
+// object, or storeAttempts ran out
+func retry(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 1; attempt <= storeAttempts; attempt++ {
+		if err = fn(); err == nil || Permanent(err) || apierrors.IsNotFound(err) || attempt == storeAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(storeRetryDelay):
+		}
+	}
+	return err
+}
+
+// storedLabels returns the labels objects holding the document are tagged
+// with: the job they belong to, the schema version and the searchable
+// metadata of the document
+func storedLabels(doc *Document) map[string]string {
+	labels := map[string]string{
+		"app":              "qiskit-operator",
+		JobLabel:           doc.JobName,
+		SchemaVersionLabel: strconv.Itoa(SchemaVersion),
+	}
+	for key, value := range doc.Metadata {
+		labels[key] = value
+	}
+	return labels
+}
+
+// shardFile is the key of a shard of the counts
+func shardFile(index int, compression string) string {
+	return fmt.Sprintf("shard-%d.json", index) + extensions[compression]
+}
+
+// shardPattern matches the keys of shards, capturing their index
+var shardPattern = regexp.MustCompile(`^shard-(\d+)\.json(\.gz|\.zst)?$`)
+
+// shardIndex returns the index of the shard stored under key, reporting
+// false for other objects
+func shardIndex(key string) (int, bool) {
+	m := shardPattern.FindStringSubmatch(key)
+	if m == nil {
+		return 0, false
+	}
+	index, err := strconv.Atoi(m[1])
+	return index, err == nil
+}
+
+// StoreDocument writes the results document to the store in the output's
+// format and compression. If the output's shardSize splits the counts of a
+// JSON document, each shard is stored as its own object and the document
+// only records their number; shards of an earlier, larger export are
+// removed. qpy outputs also get the transpiled circuit if there is one.
+// Every object is stored with its checksum and the document's labels, and
+// writes that may succeed when retried are.
+func StoreDocument(ctx context.Context, store ResultStore, output *quantumv1.OutputSpec, doc *Document, qpy []byte) error {
+	driver := Driver(output.Type)
+	indented := driver != nil && driver.IndentedJSON
+	put := func(object *Object) error {
+		object.Checksum = Checksum(object.Data)
+		object.Retention = output.Retention
+		return retry(ctx, func() error { return store.Put(ctx, object) })
+	}
+
+	manifest := doc
+	var shards []Shard
+	if indented || output.Format == "" || output.Format == FormatJSON || output.Format == FormatQPY {
+		shards = ShardCounts(doc.Results.Counts, output.ShardSize)
+	}
+	if len(shards) > 0 {
+		manifest = doc.withoutCounts(len(shards))
+		for i := range shards {
+			data, err := json.Marshal(&shards[i])
+			if err != nil {
+				return err
+			}
+			if data, err = Compress(data, output.Compression); err != nil {
+				return fmt.Errorf("%w: %w", ErrRejected, err)
+			}
+			labels := map[string]string{
+				"app":              "qiskit-operator",
+				JobLabel:           doc.JobName,
+				ShardLabel:         strconv.Itoa(shards[i].Index),
+				SchemaVersionLabel: strconv.Itoa(SchemaVersion),
+			}
+			object := &Object{Key: shardFile(shards[i].Index, output.Compression), Data: data,
+				ContentType: "application/json", Labels: labels}
+			if err := put(object); err != nil {
+				return err
+			}
+		}
+	}
+
+	object := &Object{Labels: storedLabels(doc)}
+	var err error
+	if indented {
+		object.Key, object.ContentType = ResultsKey+extensions[output.Compression], "application/json"
+		var data string
+		if data, err = manifest.JSON(); err == nil {
+			object.Data, err = Compress([]byte(data), output.Compression)
+		}
+	} else {
+		object.Key, object.Data, object.ContentType, err = EncodeOutput(manifest, output)
+	}
+	if err != nil {
+		return err
+	}
+	if err := put(object); err != nil {
+		return err
+	}
+	// Stores read with kubectl leave the transpiled circuit in the
+	// ConfigMap it was published in
+	if !indented && output.Format == FormatQPY && len(qpy) > 0 {
+		err := put(&Object{Key: TranspiledQPYKey, Data: qpy, ContentType: "application/octet-stream", Labels: storedLabels(doc)})
+		if err != nil {
+			return err
+		}
+	}
+	return deleteStaleShards(ctx, store, len(shards))
+}
+
+// deleteStaleShards removes shards left over from an earlier export that
+// used more shards than the current one
+func deleteStaleShards(ctx context.Context, store ResultStore, total int) error {
+	objects, err := store.List(ctx, "shard-")
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if index, ok := shardIndex(object.Key); ok && index >= total {
+			if err := store.Delete(ctx, object.Key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// LoadDocument reads the results document from the store, whatever it was
+// compressed with, checking the checksum of every object and merging
+// sharded counts back together. Only documents stored as JSON can be read.
+func LoadDocument(ctx context.Context, store ResultStore) (*Document, error) {
+	objects, err := store.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var manifest string
+	var shardKeys []string
+	for _, object := range objects {
+		if strings.TrimSuffix(strings.TrimSuffix(object.Key, ".gz"), ".zst") == ResultsKey {
+			manifest = object.Key
+		} else if _, ok := shardIndex(object.Key); ok {
+			shardKeys = append(shardKeys, object.Key)
+		}
+	}
+	if manifest == "" {
+		return nil, fmt.Errorf("no %s among the stored results", ResultsKey)
+	}
+
+	data, err := getObject(ctx, store, manifest)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := DecodeDocument(data)
+	if err != nil || doc.Results.Shards == 0 {
+		return doc, err
+	}
+
+	sort.Strings(shardKeys)
+	shards := make([]Shard, 0, len(shardKeys))
+	for _, key := range shardKeys {
+		data, err := getObject(ctx, store, key)
+		if err != nil {
+			return nil, err
+		}
+		shard, err := decodeShard(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+		if shard.Total == doc.Results.Shards {
+			shards = append(shards, *shard)
+		}
+	}
+	counts, err := MergeShards(shards)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge results: %w", err)
+	}
+	doc.Results.Counts = counts
+	return doc, nil
+}
+
+// getObject reads an object, checks its checksum and decompresses it
+func getObject(ctx context.Context, store ResultStore, key string) ([]byte, error) {
+	var object *Object
+	err := retry(ctx, func() error {
+		var err error
+		object, err = store.Get(ctx, key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := object.verify(); err != nil {
+		return nil, err
+	}
+	data, err := Decompress(object.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// ExportStore writes the results document to the store of an output the
+// operator writes itself. Jobs with qpy output also get the transpiled
+// circuit they published stored.
+func ExportStore(ctx context.Context, env *StoreEnv, output *quantumv1.OutputSpec, doc *Document) error {
+	store, err := OpenStore(ctx, env, output)
+	if err != nil {
+		return err
+	}
+	var qpy []byte
+	if output.Format == FormatQPY && !Driver(output.Type).IndentedJSON {
+		if qpy, err = publishedQPY(ctx, env.Client, env.Job); err != nil {
+			return err
+		}
+	}
+	return StoreDocument(ctx, store, output, doc, qpy)
+}
+
+// ReadOutput reads the results document of a job back from one of its
+// outputs
+func ReadOutput(ctx context.Context, c client.Client, job *quantumv1.QiskitJob, output *quantumv1.OutputSpec) (*Document, error) {
+	driver := Driver(output.Type)
+	switch {
+	case driver == nil || driver.Mounted:
+		return nil, fmt.Errorf("results of %s outputs cannot be read back", output.Type)
+	case !driver.IndentedJSON && output.Format != "" && output.Format != FormatJSON && output.Format != FormatQPY:
+		return nil, fmt.Errorf("results stored as %s cannot be read back, only json", output.Format)
+	}
+	store, err := OpenStore(ctx, OperatorEnv(c, nil, job), output)
+	if err != nil {
+		return nil, err
+	}
+	return LoadDocument(ctx, store)
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+// SweepResult holds the counts one binding of a sweep job measured
+type SweepResult struct {
+	// Index of the binding in the sweep
+	Index int `json:"index"`
+	// Parameters are the values the binding assigned
+	Parameters map[string]float64 `json:"parameters"`
+	// Counts the binding measured, nil if its execution reported none
+	Counts map[string]int `json:"counts"`
+}
+
+// SweepCounts returns the counts of all bindings of a sweep added up, nil if
+// none reported counts
+func SweepCounts(sweep []SweepResult) map[string]int {
+	var total map[string]int
+	for _, result := range sweep {
+		for outcome, count := range result.Counts {
+			if total == nil {
+				total = map[string]int{}
+			}
+			total[outcome] += count
+		}
+	}
+	return total
+}
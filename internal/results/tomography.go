@@ -0,0 +1,54 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import "github.com/quantum-operator/qiskit-operator/pkg/tomography"
+
+// TomographyResult holds the counts of every measurement setting of a
+// tomography job and the state reconstructed from them
+type TomographyResult struct {
+	// Qubits of the circuit whose state was reconstructed
+	Qubits []int `json:"qubits"`
+	// Settings are the counts each setting measured
+	Settings []TomographySetting `json:"settings"`
+	// State reconstructed from the counts, nil if a setting reported none
+	State *tomography.State `json:"state,omitempty"`
+}
+
+// TomographySetting holds the counts one measurement setting of a
+// tomography job measured
+type TomographySetting struct {
+	// Basis each qubit was measured in, the last letter that of the first
+	Basis string `json:"basis"`
+	// Counts the setting measured, nil if its execution reported none
+	Counts map[string]int `json:"counts"`
+}
+
+// Reconstruct reconstructs the state of the tomographed qubits from the
+// counts of the settings
+func (t *TomographyResult) Reconstruct() error {
+	counts := make(map[string]map[string]int, len(t.Settings))
+	for _, setting := range t.Settings {
+		counts[setting.Basis] = setting.Counts
+	}
+	state, err := tomography.Reconstruct(len(t.Qubits), counts)
+	if err != nil {
+		return err
+	}
+	t.State = state
+	return nil
+}
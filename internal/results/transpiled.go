@@ -0,0 +1,207 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// TranspiledAnnotation holds the sizes of the circuits the results processor
+// read for a job, as a TranspiledCircuit in JSON without the circuit itself
+const TranspiledAnnotation = "quantum.io/transpiled-circuit"
+
+const (
+	// TranspiledQPYKey is the binary data key of the transpiled circuit in
+	// QPY format
+	TranspiledQPYKey = "transpiled.qpy"
+	// TranspiledSVGKey is the data key of the transpiled circuit's diagram
+	TranspiledSVGKey = "transpiled.svg"
+)
+
+// transpiledPrefix starts the log line the executor reports the transpiled
+// circuit on
+const transpiledPrefix = `{"transpiled_circuit":`
+
+// ErrNoQPY reports a transpiled circuit the executor could not serialize
+var ErrNoQPY = errors.New("the executor reported no QPY for the transpiled circuit")
+
+// CircuitSize is the size of a circuit as measured by Qiskit
+type CircuitSize struct {
+	Depth     int            `json:"depth"`
+	Gates     int            `json:"gates"`
+	GateTypes map[string]int `json:"gate_types,omitempty"`
+}
+
+// TranspiledCircuit is what the executor reports about the circuit it ran
+// after routing and optimization
+type TranspiledCircuit struct {
+	Logical    CircuitSize `json:"logical"`
+	Transpiled CircuitSize `json:"transpiled"`
+	// QPY is the transpiled circuit serialized with qiskit.qpy
+	QPY []byte `json:"qpy,omitempty"`
+	// SVG is a diagram of the transpiled circuit, if one could be drawn
+	SVG string `json:"svg,omitempty"`
+	// ConfigMap is where the circuit was published, once it is
+	ConfigMap string `json:"config_map,omitempty"`
+}
+
+// PublishesTranspiled reports whether the job asks for its transpiled circuit
+func PublishesTranspiled(job *quantumv1.QiskitJob) bool {
+	return job.Spec.Artifacts != nil && job.Spec.Artifacts.TranspiledCircuit
+}
+
+// TranspiledName names the ConfigMap the job's transpiled circuit is
+// published in
+func TranspiledName(job *quantumv1.QiskitJob) string {
+	return job.Name + "-transpiled"
+}
+
+// ParseTranspiled extracts the transpiled circuit the executor reported from
+// execution pod logs; the last report wins
+func ParseTranspiled(logs string) (*TranspiledCircuit, bool) {
+	var found *TranspiledCircuit
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 2*maxConfigMapBytes)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, transpiledPrefix) {
+			continue
+		}
+		var wrapped struct {
+			Circuit *TranspiledCircuit `json:"transpiled_circuit"`
+		}
+		if err := json.Unmarshal([]byte(line), &wrapped); err == nil && wrapped.Circuit != nil {
+			found = wrapped.Circuit
+		}
+	}
+	return found, found != nil
+}
+
+// PublishTranspiled writes the transpiled circuit and its diagram to the
+// job's transpiled ConfigMap, creating or updating it, and records the
+// ConfigMap in t
+func PublishTranspiled(ctx context.Context, c client.Client, scheme *runtime.Scheme, job *quantumv1.QiskitJob, t *TranspiledCircuit) error {
+	if len(t.QPY) == 0 {
+		return ErrNoQPY
+	}
+	name := TranspiledName(job)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				"app":    "qiskit-operator",
+				JobLabel: job.Name,
+			},
+		},
+		BinaryData: map[string][]byte{TranspiledQPYKey: t.QPY},
+	}
+	if t.SVG != "" {
+		cm.Data = map[string]string{TranspiledSVGKey: t.SVG}
+	}
+	if size := configMapSize(cm); size > maxConfigMapBytes {
+		return fmt.Errorf("%w: %s is %d bytes", ErrTooLarge, name, size)
+	}
+	if err := applyConfigMap(ctx, c, scheme, job, cm); err != nil {
+		return err
+	}
+	t.ConfigMap = name
+	return nil
+}
+
+// publishedQPY returns the transpiled circuit the job published, nil if it
+// published none
+func publishedQPY(ctx context.Context, c client.Reader, job *quantumv1.QiskitJob) ([]byte, error) {
+	var transpiled corev1.ConfigMap
+	err := c.Get(ctx, client.ObjectKey{Namespace: job.Namespace, Name: TranspiledName(job)}, &transpiled)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	return transpiled.BinaryData[TranspiledQPYKey], nil
+}
+
+// Sizes returns t without the circuit and its diagram, for recording on the job
+func (t *TranspiledCircuit) Sizes() *TranspiledCircuit {
+	return &TranspiledCircuit{Logical: t.Logical, Transpiled: t.Transpiled, ConfigMap: t.ConfigMap}
+}
+
+// ParseTranspiledAnnotation reads the circuit sizes the results processor
+// recorded on a job, reporting false if there are none
+func ParseTranspiledAnnotation(job *quantumv1.QiskitJob) (*TranspiledCircuit, bool) {
+	value := job.Annotations[TranspiledAnnotation]
+	if value == "" {
+		return nil, false
+	}
+	var t TranspiledCircuit
+	if err := json.Unmarshal([]byte(value), &t); err != nil {
+		return nil, false
+	}
+	return &t, true
+}
+
+// RecordTranspiled records the sizes of the logical and transpiled circuits,
+// as measured by the executor, in the job's circuit metadata
+func RecordTranspiled(job *quantumv1.QiskitJob, t *TranspiledCircuit) {
+	if job.Status.CircuitMetadata == nil {
+		job.Status.CircuitMetadata = &quantumv1.CircuitMetadata{}
+	}
+	metadata := job.Status.CircuitMetadata
+	metadata.Depth = t.Logical.Depth
+	metadata.Gates = t.Logical.Gates
+	metadata.GateTypes = t.Logical.GateTypes
+	metadata.Transpiled = &quantumv1.TranspiledCircuitMetadata{
+		Depth:          t.Transpiled.Depth,
+		Gates:          t.Transpiled.Gates,
+		GateTypes:      t.Transpiled.GateTypes,
+		DepthDelta:     t.Transpiled.Depth - t.Logical.Depth,
+		GatesDelta:     t.Transpiled.Gates - t.Logical.Gates,
+		GateTypeDeltas: gateTypeDeltas(t.Logical.GateTypes, t.Transpiled.GateTypes),
+		ConfigMap:      t.ConfigMap,
+	}
+}
+
+// gateTypeDeltas returns the change in count of every gate type that changed
+func gateTypeDeltas(logical, transpiled map[string]int) map[string]int {
+	deltas := map[string]int{}
+	for gate, count := range transpiled {
+		if d := count - logical[gate]; d != 0 {
+			deltas[gate] = d
+		}
+	}
+	for gate, count := range logical {
+		if _, ok := transpiled[gate]; !ok {
+			deltas[gate] = -count
+		}
+	}
+	if len(deltas) == 0 {
+		return nil
+	}
+	return deltas
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Environment of the uploader sidecar, which converts the results the
+// executor reported and uploads them to the job's object store and pvc
+// outputs, so that the executor never holds their credentials
+const (
+	// ResultsDirEnv is the directory the executor and the uploader share
+	ResultsDirEnv = "RESULTS_DIR"
+	// UploaderOutputsEnv holds the outputs to upload to, as UploadTargets
+	// in JSON
+	UploaderOutputsEnv = "UPLOADER_OUTPUTS"
+	// UploaderDocumentEnv holds the results document without its results,
+	// which the uploader fills in from what the executor reported
+	UploaderDocumentEnv = "UPLOADER_DOCUMENT"
+)
+
+// Files of the results directory the executor hands its results over to the
+// uploader with
+const (
+	// ReportedFile holds the lines the executor printed that look like
+	// JSON, the way they appear in its logs
+	ReportedFile = "reported.jsonl"
+	// DoneFile is created by the executor once it printed everything
+	DoneFile = "done"
+	// UploadedFile is written by the uploader once it is done, with the
+	// status of every output it uploaded to in JSON
+	UploadedFile = "uploaded.json"
+)
+
+// uploadsPrefix starts the log line the executor relays the uploader's
+// statuses on
+const uploadsPrefix = `{"uploads":`
+
+// UploadTarget is an output the uploader sidecar writes the results to
+type UploadTarget struct {
+	quantumv1.OutputSpec
+	// Prefix is where the job's results go in the output, JobPrefix
+	Prefix string `json:"prefix"`
+	// CredentialsEnv prefixes the environment variables holding the keys
+	// of the output's Secret, for outputs that take one
+	CredentialsEnv string `json:"credentialsEnv,omitempty"`
+	// Dir is where the claim of pvc outputs is mounted
+	Dir string `json:"dir,omitempty"`
+}
+
+// Uploads reports whether the uploader sidecar can write the results to the
+// output, rather than the operator or the executor
+func Uploads(output *quantumv1.OutputSpec) bool {
+	driver := Driver(output.Type)
+	return driver != nil && driver.Uploaded && output.Location != ""
+}
+
+// Env returns the environment the uploader sidecar opens the store of the
+// target in
+func (t *UploadTarget) Env(getenv func(string) string) *StoreEnv {
+	return &StoreEnv{Prefix: t.Prefix, CredentialsEnv: t.CredentialsEnv, Getenv: getenv, Dir: t.Dir}
+}
+
+// UploadedDocument fills in the results document with the results the
+// executor reported, reporting false if it reported none
+func UploadedDocument(doc *Document, reported string) (*Document, bool) {
+	counts, found := ParseCounts(reported)
+	doc.Results.Counts = counts
+	if layout, ok := ParseLayout(reported); ok {
+		doc.Layout = layout
+	}
+	if estimation, ok := ParseEstimation(reported); ok {
+		doc.Estimation = estimation
+		found = true
+	}
+	return doc, found
+}
+
+// writeFile writes data to a temporary file next to name, then renames it
+func writeFile(name string, data []byte) error {
+	if err := os.WriteFile(name+".tmp", data, 0o664); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+// FormatUploads renders the log line the executor relays the uploader's
+// statuses on
+func FormatUploads(statuses []quantumv1.OutputStatus) (string, error) {
+	data, err := json.Marshal(map[string][]quantumv1.OutputStatus{"uploads": statuses})
+	return string(data), err
+}
+
+// ParseUploads extracts the statuses of the outputs the uploader sidecar
+// wrote the results to from execution pod logs; the last report wins
+func ParseUploads(logs string) ([]quantumv1.OutputStatus, bool) {
+	var found []quantumv1.OutputStatus
+	ok := false
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, uploadsPrefix) {
+			continue
+		}
+		var wrapped struct {
+			Uploads []quantumv1.OutputStatus `json:"uploads"`
+		}
+		if err := json.Unmarshal([]byte(line), &wrapped); err == nil {
+			found, ok = wrapped.Uploads, true
+		}
+	}
+	return found, ok
+}
+
+// uploadStatus returns the status the uploader reported for the named
+// output, reporting false if it reported none
+func uploadStatus(uploads []quantumv1.OutputStatus, name string) (quantumv1.OutputStatus, bool) {
+	for _, upload := range uploads {
+		if upload.Name == name {
+			if upload.State != quantumv1.OutputExported && upload.State != quantumv1.OutputFailed {
+				upload.Message = fmt.Sprintf("uploader reported unknown state %q", upload.State)
+				upload.State = quantumv1.OutputFailed
+			}
+			return upload, true
+		}
+	}
+	return quantumv1.OutputStatus{}, false
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package summary condenses QiskitJobs into the few fields job listings
+// show: phase, backend, cost and duration. The operator serves the
+// summaries of thousands of jobs in one call from its cache, so dashboards
+// and command line listings need not fetch and decode every full object.
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+)
+
+// Path is where the operator serves job summaries, next to its metrics
+const Path = "/jobs/summary"
+
+// Job is the summary of a QiskitJob
+type Job struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Phase     string `json:"phase"`
+	// Backend the job runs on, or asks for until one is selected
+	Backend string `json:"backend,omitempty"`
+	// Cost is the actual cost of the job, or its estimate until it is known
+	Cost          string `json:"cost,omitempty"`
+	CostEstimated bool   `json:"costEstimated,omitempty"`
+	// DurationSeconds is how long the job ran, or has been running
+	DurationSeconds float64     `json:"durationSeconds,omitempty"`
+	Created         metav1.Time `json:"created"`
+}
+
+// List is the response of the summary endpoint
+type List struct {
+	Items []Job `json:"items"`
+}
+
+// Summarize condenses the job, measuring the duration of a job that is
+// still running up to now
+func Summarize(job *quantumv1.QiskitJob, now time.Time) Job {
+	s := Job{
+		Namespace: job.Namespace,
+		Name:      job.Name,
+		Phase:     string(job.Status.Phase),
+		Backend:   job.Status.SelectedBackend,
+		Cost:      job.Status.ActualCost,
+		Created:   job.CreationTimestamp,
+	}
+	if s.Phase == "" {
+		s.Phase = string(controller.PhasePending)
+	}
+	if s.Backend == "" {
+		s.Backend = job.Spec.Backend.Name
+	}
+	if s.Cost == "" && job.Status.EstimatedCost != "" {
+		s.Cost = job.Status.EstimatedCost
+		s.CostEstimated = true
+	}
+	if start := job.Status.StartTime; start != nil {
+		end := now
+		if job.Status.CompletionTime != nil {
+			end = job.Status.CompletionTime.Time
+		}
+		if end.After(start.Time) {
+			s.DurationSeconds = end.Sub(start.Time).Round(time.Second).Seconds()
+		}
+	}
+	return s
+}
+
+// Summaries summarizes the jobs matching the options, in phases if any are
+// given, sorted by namespace and name. Cached jobs are read without being
+// copied, as only their summaries leave the call.
+func Summaries(ctx context.Context, lister *controller.JobLister, phases []string, opts ...client.ListOption) ([]Job, error) {
+	now := time.Now()
+	jobs := []Job{}
+	opts = append(opts, client.UnsafeDisableDeepCopy)
+	err := lister.Each(ctx, func(job *quantumv1.QiskitJob) error {
+		s := Summarize(job, now)
+		if len(phases) == 0 || slices.Contains(phases, s.Phase) {
+			jobs = append(jobs, s)
+		}
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].Namespace != jobs[j].Namespace {
+			return jobs[i].Namespace < jobs[j].Namespace
+		}
+		return jobs[i].Name < jobs[j].Name
+	})
+	return jobs, nil
+}
+
+// WriteTable prints the summaries as a table, with a namespace column when
+// they span several namespaces
+func WriteTable(w io.Writer, jobs []Job) error {
+	namespaced := false
+	for _, job := range jobs {
+		if job.Namespace != jobs[0].Namespace {
+			namespaced = true
+			break
+		}
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if namespaced {
+		fmt.Fprint(tw, "NAMESPACE\t")
+	}
+	fmt.Fprintln(tw, "NAME\tPHASE\tBACKEND\tCOST\tDURATION")
+	for _, job := range jobs {
+		if namespaced {
+			fmt.Fprintf(tw, "%s\t", job.Namespace)
+		}
+		cost := job.Cost
+		if job.CostEstimated {
+			cost = "~" + cost
+		}
+		duration := ""
+		if job.DurationSeconds > 0 {
+			duration = (time.Duration(job.DurationSeconds) * time.Second).String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", job.Name, job.Phase, job.Backend, cost, duration)
+	}
+	return tw.Flush()
+}
+
+// Handler serves the summaries of the jobs the lister lists:
+//
+//	GET /jobs/summary?namespace=<ns>&labelSelector=<selector>&phase=<phase,...>&output=json|table
+//
+// Every parameter is optional; jobs of all namespaces are summarized in
+// JSON by default.
+type Handler struct {
+	Lister *controller.JobLister
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	query := req.URL.Query()
+	output := query.Get("output")
+	if output != "" && output != "json" && output != "table" {
+		http.Error(w, fmt.Sprintf("unknown output %q, must be json or table", output), http.StatusBadRequest)
+		return
+	}
+	var opts []client.ListOption
+	if namespace := query.Get("namespace"); namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	if selector := query.Get("labelSelector"); selector != "" {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid labelSelector: %v", err), http.StatusBadRequest)
+			return
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: parsed})
+	}
+	var phases []string
+	if phase := query.Get("phase"); phase != "" {
+		phases = strings.Split(phase, ",")
+	}
+
+	jobs, err := Summaries(req.Context(), h.Lister, phases, opts...)
+	if err != nil {
+		log.FromContext(req.Context()).Error(err, "Failed to summarize jobs")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if output == "table" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_ = WriteTable(w, jobs)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(List{Items: jobs})
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+)
+
+var _ = Describe("Job summaries", func() {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	newJob := func(namespace, name string, phase quantumv1.QiskitJobPhase) *quantumv1.QiskitJob {
+		job := &quantumv1.QiskitJob{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: map[string]string{"team": "a"}},
+		}
+		job.Spec.Backend.Name = "ibm_brisbane"
+		job.Status.Phase = phase
+		return job
+	}
+
+	It("summarizes the backend, cost and duration of a job", func() {
+		job := newJob("lab", "bell", controller.PhaseCompleted)
+		job.Status.SelectedBackend = "ibm_kyiv"
+		job.Status.ActualCost = "1.50"
+		job.Status.EstimatedCost = "2.00"
+		job.Status.StartTime = &metav1.Time{Time: now.Add(-time.Hour)}
+		job.Status.CompletionTime = &metav1.Time{Time: now.Add(-50 * time.Minute)}
+
+		s := Summarize(job, now)
+		Expect(s.Phase).To(Equal(string(controller.PhaseCompleted)))
+		Expect(s.Backend).To(Equal("ibm_kyiv"))
+		Expect(s.Cost).To(Equal("1.50"))
+		Expect(s.CostEstimated).To(BeFalse())
+		Expect(s.DurationSeconds).To(Equal(600.0))
+	})
+
+	It("falls back to the requested backend and estimated cost of a running job", func() {
+		job := newJob("lab", "bell", controller.PhaseRunning)
+		job.Status.EstimatedCost = "2.00"
+		job.Status.StartTime = &metav1.Time{Time: now.Add(-90 * time.Second)}
+
+		s := Summarize(job, now)
+		Expect(s.Backend).To(Equal("ibm_brisbane"))
+		Expect(s.Cost).To(Equal("2.00"))
+		Expect(s.CostEstimated).To(BeTrue())
+		Expect(s.DurationSeconds).To(Equal(90.0))
+	})
+
+	It("reports jobs without a phase as Pending", func() {
+		Expect(Summarize(newJob("lab", "bell", ""), now).Phase).To(Equal(string(controller.PhasePending)))
+	})
+
+	Context("served", func() {
+		var handler *Handler
+
+		BeforeEach(func() {
+			other := newJob("other", "ghz", controller.PhaseFailed)
+			other.Labels = nil
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newJob("lab", "vqe", controller.PhaseRunning),
+				newJob("lab", "bell", controller.PhaseCompleted),
+				other,
+			).Build()
+			handler = &Handler{Lister: &controller.JobLister{Cache: c}}
+		})
+
+		get := func(query string) *httptest.ResponseRecorder {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path+query, nil))
+			return rec
+		}
+
+		decode := func(rec *httptest.ResponseRecorder) []string {
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			var list List
+			Expect(json.Unmarshal(rec.Body.Bytes(), &list)).To(Succeed())
+			var names []string
+			for _, job := range list.Items {
+				names = append(names, job.Namespace+"/"+job.Name)
+			}
+			return names
+		}
+
+		It("summarizes every job sorted by namespace and name", func() {
+			Expect(decode(get(""))).To(Equal([]string{"lab/bell", "lab/vqe", "other/ghz"}))
+		})
+
+		It("filters by namespace, labels and phase", func() {
+			Expect(decode(get("?namespace=lab"))).To(Equal([]string{"lab/bell", "lab/vqe"}))
+			Expect(decode(get("?labelSelector=team%3Da"))).To(Equal([]string{"lab/bell", "lab/vqe"}))
+			Expect(decode(get("?phase=Running,Failed"))).To(Equal([]string{"lab/vqe", "other/ghz"}))
+		})
+
+		It("prints a table", func() {
+			rec := get("?output=table")
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+			Expect(lines).To(HaveLen(4))
+			Expect(strings.Fields(lines[0])).To(Equal([]string{"NAMESPACE", "NAME", "PHASE", "BACKEND", "COST", "DURATION"}))
+			Expect(strings.Fields(lines[3])).To(Equal([]string{"other", "ghz", string(controller.PhaseFailed), "ibm_brisbane"}))
+		})
+
+		It("rejects invalid parameters", func() {
+			Expect(get("?output=yaml").Code).To(Equal(http.StatusBadRequest))
+			Expect(get("?labelSelector=a%3D%3D%3Db").Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	It("reads terminal jobs the cache does not hold from the API server", func() {
+		live := newJob("lab", "vqe", controller.PhaseRunning)
+		terminal := newJob("lab", "bell", controller.PhaseCompleted)
+		terminal.Labels[controller.TerminalLabel] = string(controller.PhaseCompleted)
+		live.UID, terminal.UID = "live", "terminal"
+		cache := fake.NewClientBuilder().WithScheme(scheme).WithObjects(live).Build()
+		api := fake.NewClientBuilder().WithScheme(scheme).WithObjects(live.DeepCopy(), terminal).Build()
+
+		jobs, err := Summaries(context.Background(), &controller.JobLister{Cache: cache, APIReader: api, UncachedTerminal: true},
+			nil, client.InNamespace("lab"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jobs).To(HaveLen(2))
+		Expect(jobs[0].Name).To(Equal("bell"))
+		Expect(jobs[1].Name).To(Equal("vqe"))
+	})
+})
@@ -0,0 +1,347 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package support gathers what is needed to triage a QiskitJob into a
+// support bundle: the job, its conditions and events, the specs and logs of
+// its pods and what the operator logged about it while it ran. Everything is
+// masked the way executor output is before it is written, so bundles can be
+// attached to issues.
+package support
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/internal/controller"
+	"github.com/quantum-operator/qiskit-operator/internal/results"
+	"github.com/quantum-operator/qiskit-operator/pkg/redact"
+)
+
+const (
+	// DefaultOperatorNamespace is the namespace the operator is deployed to
+	DefaultOperatorNamespace = "qiskit-operator-system"
+	// DefaultOperatorSelector selects the operator's pods
+	DefaultOperatorSelector = "control-plane=controller-manager"
+	// DefaultMargin is how far before the job was created and after it
+	// finished operator logs are gathered
+	DefaultMargin = 10 * time.Minute
+)
+
+// File is a file of a bundle
+type File struct {
+	Name string
+	Data []byte
+}
+
+// Bundle is what was gathered about a job
+type Bundle struct {
+	// Name of the bundle's top-level directory
+	Name  string
+	Files []File
+}
+
+// add adds a file, masking anything that looks like a credential
+func (b *Bundle) add(name, text string) {
+	b.Files = append(b.Files, File{Name: name, Data: []byte(redact.String(text))})
+}
+
+// Write writes the bundle as a gzipped tar archive
+func (b *Bundle) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, f := range b.Files {
+		header := &tar.Header{
+			Name:    b.Name + "/" + f.Name,
+			Mode:    0o644,
+			Size:    int64(len(f.Data)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.Data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Gatherer gathers support bundles
+type Gatherer struct {
+	Client client.Reader
+	// Clientset reads pod logs
+	Clientset kubernetes.Interface
+	// OperatorNamespace and OperatorSelector find the operator's pods;
+	// DefaultOperatorNamespace and DefaultOperatorSelector if empty
+	OperatorNamespace string
+	OperatorSelector  string
+	// Margin widens the window operator logs are gathered from;
+	// DefaultMargin if zero
+	Margin time.Duration
+}
+
+// Window returns the time range the job is of interest: from its creation
+// until it finished, or until now, widened by margin on both ends
+func Window(job *quantumv1.QiskitJob, margin time.Duration, now time.Time) (time.Time, time.Time) {
+	end := now
+	if job.Status.CompletionTime != nil {
+		end = job.Status.CompletionTime.Add(margin)
+		if end.After(now) {
+			end = now
+		}
+	}
+	return job.CreationTimestamp.Add(-margin), end
+}
+
+// Gather collects the bundle of the job. What cannot be read, such as the
+// logs of pods that never started or of an operator the caller may not read,
+// is listed in errors.txt instead of failing the bundle; only the job
+// itself must be readable.
+func (g *Gatherer) Gather(ctx context.Context, key client.ObjectKey) (*Bundle, error) {
+	var job quantumv1.QiskitJob
+	if err := g.Client.Get(ctx, key, &job); err != nil {
+		return nil, err
+	}
+	margin := g.Margin
+	if margin == 0 {
+		margin = DefaultMargin
+	}
+	start, end := Window(&job, margin, time.Now())
+
+	bundle := &Bundle{Name: fmt.Sprintf("%s-%s-support-bundle", job.Namespace, job.Name)}
+	var problems []string
+	problem := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	bundle.add("summary.txt", summary(&job, start, end))
+	record := job.DeepCopy()
+	record.APIVersion = quantumv1.GroupVersion.String()
+	record.Kind = "QiskitJob"
+	record.ManagedFields = nil
+	redact.Env(record.Spec.Execution.Env)
+	if err := g.addYAML(bundle, "job.yaml", record); err != nil {
+		return nil, err
+	}
+
+	namespace := controller.ExecutionNamespace(&job)
+	var pods corev1.PodList
+	if err := g.Client.List(ctx, &pods, client.InNamespace(namespace),
+		client.MatchingLabels{results.JobLabel: job.Name}); err != nil {
+		problem("listing pods of %s: %v", namespace, err)
+	}
+	involved := map[string]bool{"QiskitJob/" + job.Namespace + "/" + job.Name: true}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		involved["Pod/"+pod.Namespace+"/"+pod.Name] = true
+		spec := pod.DeepCopy()
+		spec.APIVersion, spec.Kind = "v1", "Pod"
+		spec.ManagedFields = nil
+		for j := range spec.Spec.InitContainers {
+			redact.Env(spec.Spec.InitContainers[j].Env)
+		}
+		for j := range spec.Spec.Containers {
+			redact.Env(spec.Spec.Containers[j].Env)
+		}
+		if err := g.addYAML(bundle, "pods/"+pod.Name+".yaml", spec); err != nil {
+			return nil, err
+		}
+		for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			logs, err := g.Clientset.CoreV1().Pods(pod.Namespace).
+				GetLogs(pod.Name, &corev1.PodLogOptions{Container: container.Name}).DoRaw(ctx)
+			if err != nil {
+				problem("reading logs of pod %s, container %s: %v", pod.Name, container.Name, err)
+				continue
+			}
+			bundle.add("pods/"+pod.Name+"/"+container.Name+".log", string(logs))
+		}
+	}
+
+	events, err := g.events(ctx, involved, job.Namespace, namespace)
+	if err != nil {
+		problem("listing events: %v", err)
+	}
+	if err := g.addYAML(bundle, "events.yaml", events); err != nil {
+		return nil, err
+	}
+
+	operatorLogs, err := g.operatorLogs(ctx, &job, start, end)
+	if err != nil {
+		problem("reading operator logs: %v", err)
+	}
+	bundle.add("operator.log", operatorLogs)
+
+	if len(problems) > 0 {
+		bundle.add("errors.txt", strings.Join(problems, "\n")+"\n")
+	}
+	return bundle, nil
+}
+
+// addYAML adds obj as a YAML file
+func (g *Gatherer) addYAML(bundle *Bundle, name string, obj any) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", name, err)
+	}
+	bundle.add(name, string(data))
+	return nil
+}
+
+// events returns the events of the involved objects, given as
+// Kind/namespace/name, oldest first
+func (g *Gatherer) events(ctx context.Context, involved map[string]bool, namespaces ...string) ([]corev1.Event, error) {
+	var events []corev1.Event
+	seen := map[string]bool{}
+	for _, namespace := range namespaces {
+		if seen[namespace] {
+			continue
+		}
+		seen[namespace] = true
+		var list corev1.EventList
+		if err := g.Client.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+			return events, err
+		}
+		for _, event := range list.Items {
+			object := event.InvolvedObject
+			if involved[object.Kind+"/"+object.Namespace+"/"+object.Name] {
+				event.ManagedFields = nil
+				events = append(events, event)
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+	return events, nil
+}
+
+// eventTime returns when the event last happened
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// operatorLogs returns the lines the operator's pods logged about the job
+// between start and end
+func (g *Gatherer) operatorLogs(ctx context.Context, job *quantumv1.QiskitJob, start, end time.Time) (string, error) {
+	namespace, selector := g.OperatorNamespace, g.OperatorSelector
+	if namespace == "" {
+		namespace = DefaultOperatorNamespace
+	}
+	if selector == "" {
+		selector = DefaultOperatorSelector
+	}
+	pods, err := g.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return "", err
+	}
+	since := metav1.NewTime(start)
+	var b strings.Builder
+	var errs []string
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			logs, err := g.Clientset.CoreV1().Pods(namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container:  container.Name,
+				SinceTime:  &since,
+				Timestamps: true,
+			}).DoRaw(ctx)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("pod %s, container %s: %v", pod.Name, container.Name, err))
+				continue
+			}
+			for _, line := range aboutJob(string(logs), job, end) {
+				fmt.Fprintf(&b, "%s/%s %s\n", pod.Name, container.Name, line)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return b.String(), fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return b.String(), nil
+}
+
+// aboutJob returns the timestamped log lines that name the job and its
+// namespace, up to end
+func aboutJob(logs string, job *quantumv1.QiskitJob, end time.Time) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(line, job.Name) || !strings.Contains(line, job.Namespace) {
+			continue
+		}
+		stamp, _, _ := strings.Cut(line, " ")
+		if t, err := time.Parse(time.RFC3339Nano, stamp); err == nil && t.After(end) {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// summary describes the job's state at a glance
+func summary(job *quantumv1.QiskitJob, start, end time.Time) string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "QiskitJob:\t%s/%s\n", job.Namespace, job.Name)
+	fmt.Fprintf(w, "UID:\t%s\n", job.UID)
+	fmt.Fprintf(w, "Phase:\t%s\n", job.Status.Phase)
+	fmt.Fprintf(w, "Message:\t%s\n", job.Status.Message)
+	fmt.Fprintf(w, "Backend:\t%s\n", job.Status.SelectedBackend)
+	if job.Status.JobID != "" {
+		fmt.Fprintf(w, "Backend job ID:\t%s\n", job.Status.JobID)
+	}
+	if job.Status.RetryCount > 0 {
+		fmt.Fprintf(w, "Retries:\t%d\n", job.Status.RetryCount)
+	}
+	fmt.Fprintf(w, "Window:\t%s to %s\n", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+	_ = w.Flush()
+
+	b.WriteString("\nConditions:\n")
+	w = tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tLAST TRANSITION\tMESSAGE")
+	for _, c := range job.Status.Conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", c.Type, c.Status, c.Reason,
+			c.LastTransitionTime.UTC().Format(time.RFC3339), c.Message)
+	}
+	_ = w.Flush()
+	return b.String()
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package support
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("Support bundles", func() {
+	ctx := context.Background()
+
+	newJob := func() *quantumv1.QiskitJob {
+		job := builder.NewBellStateJob("hello-quantum", "quantum-lab").Build()
+		job.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		job.Spec.Execution.Env = []corev1.EnvVar{
+			{Name: "SHOTS", Value: "1024"},
+			{Name: "IBM_API_TOKEN", Value: "s3cr3t-t0ken-value"},
+		}
+		job.Status.Phase = "Failed"
+		job.Status.Message = "Backend rejected the job"
+		job.Status.Conditions = []metav1.Condition{{
+			Type: "Ready", Status: metav1.ConditionFalse, Reason: "ExecutionFailed", Message: "exit code 1",
+		}}
+		return job
+	}
+
+	It("should gather the job, its pods, events and operator logs, masking credentials", func() {
+		job := newJob()
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "qiskit-job-hello-quantum", Namespace: "quantum-lab",
+				Labels: map[string]string{"quantum.io/job": "hello-quantum"},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name: "executor",
+				Env:  []corev1.EnvVar{{Name: "QISKIT_IBM_TOKEN", Value: "another-secret-value"}},
+			}}},
+		}
+		events := []client.Object{
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: "job-failed", Namespace: "quantum-lab"},
+				InvolvedObject: corev1.ObjectReference{Kind: "QiskitJob", Namespace: "quantum-lab", Name: "hello-quantum"},
+				Reason:         "ExecutionFailed",
+			},
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: "pod-pulled", Namespace: "quantum-lab"},
+				InvolvedObject: corev1.ObjectReference{Kind: "Pod", Namespace: "quantum-lab", Name: pod.Name},
+				Reason:         "Pulled",
+			},
+			&corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: "unrelated", Namespace: "quantum-lab"},
+				InvolvedObject: corev1.ObjectReference{Kind: "QiskitJob", Namespace: "quantum-lab", Name: "other"},
+				Reason:         "Unrelated",
+			},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(events, job, pod)...).Build()
+		operator := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "controller-manager-0", Namespace: DefaultOperatorNamespace,
+				Labels: map[string]string{"control-plane": "controller-manager"},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "manager"}}},
+		}
+		g := &Gatherer{Client: c, Clientset: kubefake.NewClientset(operator)}
+
+		bundle, err := g.Gather(ctx, client.ObjectKeyFromObject(job))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bundle.Name).To(Equal("quantum-lab-hello-quantum-support-bundle"))
+
+		var buf bytes.Buffer
+		Expect(bundle.Write(&buf)).To(Succeed())
+		files := map[string]string{}
+		gz, err := gzip.NewReader(&buf)
+		Expect(err).NotTo(HaveOccurred())
+		tr := tar.NewReader(gz)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+			data, err := io.ReadAll(tr)
+			Expect(err).NotTo(HaveOccurred())
+			files[header.Name] = string(data)
+		}
+
+		dir := bundle.Name + "/"
+		Expect(files).To(HaveKey(dir + "summary.txt"))
+		Expect(files[dir+"summary.txt"]).To(ContainSubstring("Backend rejected the job"))
+		Expect(files[dir+"summary.txt"]).To(MatchRegexp(`Ready\s+False\s+ExecutionFailed`))
+		Expect(files[dir+"job.yaml"]).To(ContainSubstring("value: \"1024\""))
+		Expect(files[dir+"job.yaml"]).NotTo(ContainSubstring("s3cr3t-t0ken-value"))
+		Expect(files[dir+"pods/qiskit-job-hello-quantum.yaml"]).NotTo(ContainSubstring("another-secret-value"))
+		Expect(files).To(HaveKey(dir + "pods/qiskit-job-hello-quantum/executor.log"))
+		Expect(files[dir+"events.yaml"]).To(ContainSubstring("ExecutionFailed"))
+		Expect(files[dir+"events.yaml"]).To(ContainSubstring("Pulled"))
+		Expect(files[dir+"events.yaml"]).NotTo(ContainSubstring("Unrelated"))
+		Expect(files).To(HaveKey(dir + "operator.log"))
+		Expect(files).NotTo(HaveKey(dir + "errors.txt"))
+	})
+
+	It("should keep only the operator's log lines about the job within the window", func() {
+		job := newJob()
+		end := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		logs := "2025-06-01T11:00:00Z INFO Reconciling {\"QiskitJob\": {\"name\":\"hello-quantum\",\"namespace\":\"quantum-lab\"}}\n" +
+			"2025-06-01T11:00:01Z INFO Reconciling {\"QiskitJob\": {\"name\":\"other\",\"namespace\":\"quantum-lab\"}}\n" +
+			"2025-06-01T13:00:00Z INFO Reconciling {\"QiskitJob\": {\"name\":\"hello-quantum\",\"namespace\":\"quantum-lab\"}}\n"
+
+		lines := aboutJob(logs, job, end)
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(HavePrefix("2025-06-01T11:00:00Z"))
+	})
+
+	It("should end the window a margin after the job finished, but not in the future", func() {
+		job := newJob()
+		now := time.Now()
+		start, end := Window(job, time.Minute, now)
+		Expect(start).To(Equal(job.CreationTimestamp.Add(-time.Minute)))
+		Expect(end).To(Equal(now))
+
+		job.Status.CompletionTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+		_, end = Window(job, time.Minute, now)
+		Expect(end).To(Equal(now.Add(-29 * time.Minute)))
+	})
+})
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/approval"
+)
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// approvalChanged reports whether a write sets, changes or removes the
+// decision on a job
+func approvalChanged(oldAnnotations, annotations map[string]string) bool {
+	for _, key := range []string{approval.Annotation, approval.MessageAnnotation, approval.DecidedByAnnotation} {
+		if oldAnnotations[key] != annotations[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// recordApprover stamps the user deciding on a job onto it, so the operator
+// reports who approved or denied the job
+func recordApprover(ctx context.Context, job *quantumv1.QiskitJob) {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return
+	}
+	var oldJob quantumv1.QiskitJob
+	if len(req.OldObject.Raw) > 0 {
+		if err := json.Unmarshal(req.OldObject.Raw, &oldJob); err != nil {
+			return
+		}
+	}
+	if !approvalChanged(oldJob.Annotations, job.Annotations) {
+		return
+	}
+	if _, ok := job.Annotations[approval.Annotation]; !ok {
+		delete(job.Annotations, approval.DecidedByAnnotation)
+		return
+	}
+	job.Annotations[approval.DecidedByAnnotation] = req.UserInfo.Username
+}
+
+// validateApproval admits decisions on a job only from users allowed to
+// approve qiskitjobs in its namespace, and only under their own name
+func (v *QiskitJobCustomValidator) validateApproval(ctx context.Context, oldJob, job *quantumv1.QiskitJob) error {
+	var oldAnnotations map[string]string
+	if oldJob != nil {
+		oldAnnotations = oldJob.Annotations
+	}
+	if !approvalChanged(oldAnnotations, job.Annotations) {
+		return nil
+	}
+	// Anyone who may update a job may take a decision off it
+	value, decided := job.Annotations[approval.Annotation]
+	if !decided {
+		return nil
+	}
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	annotations := field.NewPath("metadata", "annotations")
+	if _, ok := approval.Normalize(value); !ok {
+		allErrs = append(allErrs, field.NotSupported(annotations.Key(approval.Annotation), value,
+			[]string{approval.Approved, approval.Denied}))
+	}
+	if job.Annotations[approval.DecidedByAnnotation] != req.UserInfo.Username {
+		allErrs = append(allErrs, field.Forbidden(annotations.Key(approval.DecidedByAnnotation),
+			"is set to the user deciding on the job"))
+	}
+	if len(allErrs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+			job.Name, allErrs)
+	}
+
+	if v.Authorizer == nil {
+		return nil
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(req.UserInfo.Extra))
+	for key, values := range req.UserInfo.Extra {
+		extra[key] = authorizationv1.ExtraValue(values)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+			UID:    req.UserInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: job.Namespace,
+				Verb:      approval.Verb,
+				Group:     quantumv1.GroupVersion.Group,
+				Resource:  "qiskitjobs",
+				Name:      job.Name,
+			},
+		},
+	}
+	if err := v.Authorizer.Create(ctx, review); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to check approval permission: %w", err))
+	}
+	if !review.Status.Allowed {
+		return apierrors.NewForbidden(quantumv1.GroupVersion.WithResource("qiskitjobs").GroupResource(), job.Name,
+			fmt.Errorf("user %q may not %s qiskitjobs in namespace %s", req.UserInfo.Username, approval.Verb, job.Namespace))
+	}
+	return nil
+}
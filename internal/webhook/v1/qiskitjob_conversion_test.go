@@ -0,0 +1,137 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	quantumv1alpha1 "github.com/quantum-operator/qiskit-operator/api/v1alpha1"
+)
+
+var _ = Describe("QiskitJob conversion", func() {
+	It("serves v1alpha1 QiskitJobs through the conversion webhook", func() {
+		Expect(conversion.IsConvertible(scheme, &quantumv1.QiskitJob{})).To(BeTrue())
+	})
+
+	It("converts v1 jobs to v1alpha1 and back without losing anything", func() {
+		started := metav1.NewTime(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC))
+		job := &quantumv1.QiskitJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "bell",
+				Namespace:   "default",
+				Labels:      map[string]string{"team": "research"},
+				Annotations: map[string]string{"note": "kept"},
+			},
+			Spec: quantumv1.QiskitJobSpec{
+				Backend: quantumv1.BackendSpec{Type: "ibm_quantum", Name: "ibm_brisbane", Hub: "ibm-q"},
+				Circuit: quantumv1.CircuitSpec{Source: "inline", Code: "qc = QuantumCircuit(2)"},
+				Execution: quantumv1.ExecutionSpec{
+					Shots: 2048, OptimizationLevel: 2, Priority: "high", Tags: []string{"bell"},
+				},
+				Outputs: []quantumv1.OutputSpec{
+					{Type: "configmap", Location: "bell-results", Format: "json"},
+					{Type: "s3", Location: "results-bucket", SecretName: "s3-creds"},
+				},
+			},
+			Status: quantumv1.QiskitJobStatus{
+				Phase:              quantumv1.QiskitJobRunning,
+				ObservedGeneration: 2,
+				StartTime:          &started,
+				SelectedBackend:    "ibm_brisbane",
+				RetryCount:         1,
+				Conditions: []metav1.Condition{{
+					Type: "Ready", Status: metav1.ConditionFalse, Reason: "Running", LastTransitionTime: started,
+				}},
+			},
+		}
+
+		alpha := &quantumv1alpha1.QiskitJob{}
+		Expect(alpha.ConvertFrom(job)).To(Succeed())
+		Expect(alpha.Spec.Backend.Hub).To(Equal("ibm-q"))
+		Expect(alpha.Spec.Execution.Shots).To(Equal(2048))
+		Expect(alpha.Spec.Output).To(Equal(&quantumv1alpha1.OutputSpec{
+			Type: "configmap", Location: "bell-results", Format: "json",
+		}))
+		Expect(alpha.Status.Phase).To(Equal("Running"))
+		Expect(alpha.Annotations).To(HaveKey(quantumv1alpha1.ConversionDataAnnotation))
+
+		back := &quantumv1.QiskitJob{}
+		Expect(alpha.ConvertTo(back)).To(Succeed())
+		Expect(back.ObjectMeta).To(Equal(job.ObjectMeta))
+		Expect(back.Spec).To(Equal(job.Spec))
+		Expect(back.Status).To(Equal(job.Status))
+	})
+
+	It("applies edits made at v1alpha1 on top of the v1 fields", func() {
+		job := &quantumv1.QiskitJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "bell", Namespace: "default"},
+			Spec: quantumv1.QiskitJobSpec{
+				Backend:   quantumv1.BackendSpec{Type: "local_simulator"},
+				Circuit:   quantumv1.CircuitSpec{Source: "inline", Code: "qc = QuantumCircuit(2)"},
+				Execution: quantumv1.ExecutionSpec{Shots: 1024, DisableFallback: true},
+				Outputs:   []quantumv1.OutputSpec{{Type: "configmap", Location: "bell-results"}},
+			},
+		}
+		alpha := &quantumv1alpha1.QiskitJob{}
+		Expect(alpha.ConvertFrom(job)).To(Succeed())
+		alpha.Spec.Execution.Shots = 4096
+		alpha.Spec.Output.Location = "renamed-results"
+
+		back := &quantumv1.QiskitJob{}
+		Expect(alpha.ConvertTo(back)).To(Succeed())
+		Expect(back.Spec.Execution.Shots).To(Equal(4096))
+		Expect(back.Spec.Execution.DisableFallback).To(BeTrue())
+		Expect(back.Spec.Outputs).To(Equal([]quantumv1.OutputSpec{{Type: "configmap", Location: "renamed-results"}}))
+		Expect(back.Annotations).To(BeNil())
+	})
+
+	It("converts v1alpha1 jobs created at v1alpha1", func() {
+		alpha := &quantumv1alpha1.QiskitJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "legacy", Namespace: "default"},
+			Spec: quantumv1alpha1.QiskitJobSpec{
+				Backend: quantumv1alpha1.BackendSpec{
+					Type: "ibm_quantum", Name: "ibm_kyoto", Hub: "ibm-q", Group: "open", Project: "main",
+				},
+				Circuit: quantumv1alpha1.CircuitSpec{
+					Source: "configmap", ConfigMapRef: &quantumv1alpha1.ConfigMapRef{Name: "circuits", Key: "bell.py"},
+				},
+				Execution: quantumv1alpha1.ExecutionSpec{Shots: 512, ResilienceLevel: 1},
+				Output:    &quantumv1alpha1.OutputSpec{Type: "configmap", Location: "legacy-results"},
+			},
+			Status: quantumv1alpha1.QiskitJobStatus{Phase: "Completed", JobID: "abc123"},
+		}
+
+		job := &quantumv1.QiskitJob{}
+		Expect(alpha.ConvertTo(job)).To(Succeed())
+		Expect(job.Spec.Backend).To(Equal(quantumv1.BackendSpec{
+			Type: "ibm_quantum", Name: "ibm_kyoto", Hub: "ibm-q", Group: "open", Project: "main",
+		}))
+		Expect(job.Spec.Circuit.ConfigMapRef).To(Equal(&quantumv1.ConfigMapRef{Name: "circuits", Key: "bell.py"}))
+		Expect(job.Spec.Execution.Shots).To(Equal(512))
+		Expect(job.Spec.Execution.ResilienceLevel).To(Equal(1))
+		Expect(job.Spec.Output).To(Equal(&quantumv1.OutputSpec{Type: "configmap", Location: "legacy-results"}))
+		Expect(job.Spec.Outputs).To(BeEmpty())
+		Expect(job.Status.Phase).To(Equal(quantumv1.QiskitJobCompleted))
+		Expect(job.Status.JobID).To(Equal("abc123"))
+	})
+})
@@ -0,0 +1,605 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backendref"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+	"github.com/quantum-operator/qiskit-operator/pkg/hints"
+	"github.com/quantum-operator/qiskit-operator/pkg/jobtemplate"
+	"github.com/quantum-operator/qiskit-operator/pkg/lineage"
+	"github.com/quantum-operator/qiskit-operator/pkg/lint"
+	"github.com/quantum-operator/qiskit-operator/pkg/migration"
+	"github.com/quantum-operator/qiskit-operator/pkg/nsprofile"
+	"github.com/quantum-operator/qiskit-operator/pkg/offload"
+	"github.com/quantum-operator/qiskit-operator/pkg/packages"
+	"github.com/quantum-operator/qiskit-operator/pkg/region"
+	"github.com/quantum-operator/qiskit-operator/pkg/residency"
+	"github.com/quantum-operator/qiskit-operator/pkg/validation"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var qiskitjoblog = logf.Log.WithName("qiskitjob-resource")
+
+// SetupQiskitJobWebhookWithManager registers the webhook for QiskitJob in the manager.
+func SetupQiskitJobWebhookWithManager(mgr ctrl.Manager, allowedPackages packages.Allowlist) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&quantumv1.QiskitJob{}).
+		WithValidator(&QiskitJobCustomValidator{
+			Reader:          mgr.GetAPIReader(),
+			Authorizer:      mgr.GetClient(),
+			AllowedPackages: allowedPackages,
+		}).
+		WithDefaulter(&QiskitJobCustomDefaulter{Reader: mgr.GetAPIReader()}).
+		Complete()
+}
+
+// +kubebuilder:rbac:groups=quantum.quantum.io,resources=quantumnamespaceprofiles,verbs=get;list;watch
+
+// +kubebuilder:webhook:path=/mutate-quantum-quantum-io-v1-qiskitjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=quantum.quantum.io,resources=qiskitjobs,verbs=create;update,versions=v1,name=mqiskitjob-v1.kb.io,admissionReviewVersions=v1
+
+// QiskitJobCustomDefaulter struct is responsible for setting default values on the custom resource of the
+// Kind QiskitJob when those are created or updated.
+type QiskitJobCustomDefaulter struct {
+	// Reader is used to look up job templates and namespace profiles
+	Reader client.Reader
+}
+
+var _ webhook.CustomDefaulter = &QiskitJobCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind QiskitJob.
+func (d *QiskitJobCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	qiskitjob, ok := obj.(*quantumv1.QiskitJob)
+	if !ok {
+		return fmt.Errorf("expected an QiskitJob object but got %T", obj)
+	}
+	qiskitjoblog.Info("Defaulting for QiskitJob", "name", qiskitjob.GetName())
+
+	// Instantiate the referenced template; the validator reports why if it cannot be
+	if d.Reader != nil {
+		if applied, err := jobtemplate.Resolve(ctx, d.Reader, qiskitjob); err != nil {
+			qiskitjoblog.Info("Could not apply job template", "name", qiskitjob.GetName(), "reason", err.Error())
+		} else if applied {
+			qiskitjoblog.Info("Applied job template", "name", qiskitjob.GetName(),
+				"template", qiskitjob.Annotations[jobtemplate.AppliedAnnotation])
+		}
+	}
+
+	// Rewrite deprecated fields on write so stored objects only use current fields
+	if migrated := migration.Migrate(qiskitjob); len(migrated) > 0 {
+		qiskitjoblog.Info("Migrated deprecated fields", "name", qiskitjob.GetName(), "fields", migrated)
+	}
+
+	// Decisions on jobs waiting for approval are recorded under the deciding user
+	recordApprover(ctx, qiskitjob)
+
+	// Defaults are only written into new jobs; filling them in on update
+	// would change what running jobs do and the settings templates own
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation != admissionv1.Create {
+		return nil
+	}
+	// Namespace defaults come before the operator's own, so a profile's
+	// outputs replace the default results ConfigMap
+	if d.Reader != nil {
+		if injected, err := nsprofile.Inject(ctx, d.Reader, qiskitjob); err != nil {
+			qiskitjoblog.Info("Could not read namespace profiles", "name", qiskitjob.GetName(), "reason", err.Error())
+		} else if len(injected) > 0 {
+			qiskitjoblog.Info("Injected namespace defaults", "name", qiskitjob.GetName(), "fields", injected)
+		}
+	}
+	defaults.Apply(qiskitjob)
+	lineage.ApplyLabels(qiskitjob)
+	if len(qiskitjob.Spec.Outputs) == 0 && qiskitjob.Name != "" {
+		output := defaults.Output(qiskitjob)
+		if d.outputAllowed(ctx, qiskitjob.Namespace, output) {
+			qiskitjob.Spec.Outputs = []quantumv1.OutputSpec{*output}
+		}
+	}
+	return nil
+}
+
+// outputAllowed reports whether the namespace's data residency policy
+// allows the default output. Jobs whose namespace does not, or whose policy
+// cannot be read, are left without an output rather than denied for a
+// setting they did not ask for.
+func (d *QiskitJobCustomDefaulter) outputAllowed(ctx context.Context, namespace string, output *quantumv1.OutputSpec) bool {
+	if d.Reader == nil {
+		return true
+	}
+	policy, err := residency.PolicyForNamespace(ctx, d.Reader, namespace)
+	return err == nil && policy.Check(output) == nil
+}
+
+// +kubebuilder:webhook:path=/validate-quantum-quantum-io-v1-qiskitjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=quantum.quantum.io,resources=qiskitjobs,verbs=create;update,versions=v1,name=vqiskitjob-v1.kb.io,admissionReviewVersions=v1
+
+// QiskitJobCustomValidator struct is responsible for validating the QiskitJob resource
+// when it is created, updated, or deleted.
+type QiskitJobCustomValidator struct {
+	// Reader is used to look up namespace-level configuration
+	Reader client.Reader
+
+	// Authorizer creates the SubjectAccessReviews that check users approving
+	// or denying jobs; nil admits every decision
+	Authorizer client.Client
+
+	// AllowedPackages lists the extra packages jobs may install
+	AllowedPackages packages.Allowlist
+}
+
+var _ webhook.CustomValidator = &QiskitJobCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type QiskitJob.
+func (v *QiskitJobCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	qiskitjob, ok := obj.(*quantumv1.QiskitJob)
+	if !ok {
+		return nil, fmt.Errorf("expected a QiskitJob object but got %T", obj)
+	}
+	qiskitjoblog.Info("Validation for QiskitJob upon creation", "name", qiskitjob.GetName())
+
+	if err := v.validateTemplate(ctx, qiskitjob); err != nil {
+		return nil, err
+	}
+	if err := validateQiskitJob(qiskitjob, nil); err != nil {
+		return nil, err
+	}
+	if err := validateCodeSize(qiskitjob); err != nil {
+		return nil, err
+	}
+	if err := v.validateApproval(ctx, nil, qiskitjob); err != nil {
+		return nil, err
+	}
+	if err := validateBackendOwner(qiskitjob); err != nil {
+		return nil, err
+	}
+	if err := v.validateCredentialsNamespace(ctx, qiskitjob); err != nil {
+		return nil, err
+	}
+	if err := v.validatePackages(qiskitjob); err != nil {
+		return nil, err
+	}
+	if err := validateOutput(qiskitjob); err != nil {
+		return nil, err
+	}
+	if err := v.validateResidency(ctx, qiskitjob); err != nil {
+		return nil, err
+	}
+	warnings := v.lintWarnings(ctx, qiskitjob)
+	if offload.Needed(qiskitjob) {
+		warnings = append(warnings, fmt.Sprintf("inline code of %d bytes will be moved into ConfigMap %s",
+			len(qiskitjob.Spec.Circuit.Code), offload.ConfigMapName(qiskitjob)))
+	}
+	return warnings, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type QiskitJob.
+func (v *QiskitJobCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	qiskitjob, ok := newObj.(*quantumv1.QiskitJob)
+	if !ok {
+		return nil, fmt.Errorf("expected a QiskitJob object for the newObj but got %T", newObj)
+	}
+	qiskitjoblog.Info("Validation for QiskitJob upon update", "name", qiskitjob.GetName())
+
+	// Jobs being deleted are only updated to drop their finalizers, which
+	// must never be refused
+	if !qiskitjob.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+
+	oldJob, ok := oldObj.(*quantumv1.QiskitJob)
+	// Jobs admitted under earlier rules stay updatable as long as their
+	// spec does not change; hints set on them are still checked
+	switch {
+	case !ok:
+		if err := validateQiskitJob(qiskitjob, nil); err != nil {
+			return nil, err
+		}
+	case !equality.Semantic.DeepEqual(oldJob.Spec, qiskitjob.Spec):
+		if err := validateQiskitJob(qiskitjob, oldJob); err != nil {
+			return nil, err
+		}
+	case hintsChanged(oldJob, qiskitjob):
+		if errs := hints.Validate(qiskitjob); len(errs) > 0 {
+			return nil, apierrors.NewInvalid(
+				schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+				qiskitjob.Name, errs)
+		}
+	}
+
+	if ok {
+		if err := v.validateApproval(ctx, oldJob, qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if ok {
+		if err := validateRerun(oldJob, qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if ok && jobtemplate.Applied(oldJob) {
+		if err := validateTemplateLock(oldJob, qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if ok && backendref.Applied(oldJob) {
+		if err := validateBackendRefLock(oldJob, qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if !ok || !equality.Semantic.DeepEqual(oldJob.Spec.Credentials, qiskitjob.Spec.Credentials) ||
+		oldJob.Annotations[backendref.AppliedAnnotation] != qiskitjob.Annotations[backendref.AppliedAnnotation] {
+		if err := v.validateCredentialsNamespace(ctx, qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if !ok || !equality.Semantic.DeepEqual(oldJob.Spec.Execution.ExtraPackages, qiskitjob.Spec.Execution.ExtraPackages) {
+		if err := v.validatePackages(qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if !ok || !equality.Semantic.DeepEqual(migration.Outputs(&oldJob.Spec), qiskitjob.Spec.Outputs) {
+		if err := validateOutput(qiskitjob); err != nil {
+			return nil, err
+		}
+		if err := v.validateResidency(ctx, qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if !ok || oldJob.Spec.Circuit.Code != qiskitjob.Spec.Circuit.Code {
+		if err := validateCodeSize(qiskitjob); err != nil {
+			return nil, err
+		}
+	}
+	if ok && oldJob.Spec.Circuit.Code == qiskitjob.Spec.Circuit.Code {
+		// Only re-lint when the circuit changed, so status-driven updates stay quiet
+		return nil, nil
+	}
+	return v.lintWarnings(ctx, qiskitjob), nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type QiskitJob.
+func (v *QiskitJobCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateQiskitJob performs hard validation that rejects the request.
+// oldJob is the job an update replaces, nil on create.
+func validateQiskitJob(job, oldJob *quantumv1.QiskitJob) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, validation.ValidateBackend(&job.Spec.Backend, specPath.Child("backend"))...)
+	allErrs = append(allErrs, validation.ValidateBackendRef(job.Spec.BackendRef, specPath.Child("backendRef"))...)
+	allErrs = append(allErrs, validation.ValidateCredentials(job.Spec.Credentials, &job.Spec.Backend, specPath.Child("credentials"))...)
+	allErrs = append(allErrs, validation.ValidateCircuit(&job.Spec.Circuit, specPath.Child("circuit"))...)
+	allErrs = append(allErrs, validation.ValidateShadow(job.Spec.Shadow, specPath.Child("shadow"))...)
+	allErrs = append(allErrs, validation.ValidateVerify(&job.Spec, specPath.Child("verify"))...)
+	allErrs = append(allErrs, validation.ValidateArtifacts(job.Spec.Artifacts, &job.Spec.Backend, specPath.Child("artifacts"))...)
+	allErrs = append(allErrs, validation.ValidateOptimizer(job.Spec.Optimizer, &job.Spec.Backend, specPath.Child("optimizer"))...)
+	allErrs = append(allErrs, validation.ValidateSweep(&job.Spec, specPath.Child("sweep"))...)
+	allErrs = append(allErrs, validation.ValidateTomography(&job.Spec, specPath.Child("tomography"))...)
+	allErrs = append(allErrs, validation.ValidateSplit(&job.Spec, specPath.Child("split"))...)
+	allErrs = append(allErrs, validation.ValidatePrimitive(&job.Spec, specPath)...)
+	allErrs = append(allErrs, validation.ValidateTranspiler(&job.Spec, specPath.Child("execution", "transpiler"))...)
+	allErrs = append(allErrs, validation.ValidateErrorMitigation(job.Spec.Execution.ErrorMitigation, specPath.Child("execution", "errorMitigation"))...)
+	allErrs = append(allErrs, validation.ValidateScratch(job.Spec.Execution.Scratch, specPath.Child("execution", "scratch"))...)
+	allErrs = append(allErrs, validation.ValidateAccelerator(&job.Spec, specPath.Child("execution", "accelerator"))...)
+	allErrs = append(allErrs, validation.ValidateEnv(&job.Spec.Execution, specPath.Child("execution"))...)
+	// Resources admitted before the executor's minimums were raised are
+	// kept until they change
+	if oldJob == nil || !equality.Semantic.DeepEqual(oldJob.Spec.Resources, job.Spec.Resources) {
+		allErrs = append(allErrs, validation.ValidateResources(job.Spec.Resources, specPath.Child("resources"))...)
+	}
+	allErrs = append(allErrs, validation.ValidateScheduling(job.Spec.Scheduling, specPath.Child("scheduling"))...)
+	allErrs = append(allErrs, validation.ValidateSecurity(&job.Spec, specPath.Child("security"))...)
+	allErrs = append(allErrs, validation.ValidateBudget(job.Spec.Budget, specPath.Child("budget"))...)
+	allErrs = append(allErrs, validation.ValidateRetryPolicy(job.Spec.RetryPolicy, specPath.Child("retryPolicy"))...)
+	allErrs = append(allErrs, validation.ValidateNotifications(job.Spec.Notifications, specPath.Child("notifications"))...)
+	allErrs = append(allErrs, validation.ValidateInputs(job.Spec.Inputs, specPath.Child("inputs"))...)
+	allErrs = append(allErrs, hints.Validate(job)...)
+
+	if job.Spec.Placement != nil {
+		if _, err := region.Route(&job.Spec.Backend, job.Spec.Placement); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("placement", "allowedRegions"),
+				job.Spec.Placement.AllowedRegions, err.Error()))
+		}
+		// Spoke clusters are named like the namespaces their ManifestWorks live in
+		if cluster := job.Spec.Placement.Cluster; cluster != "" {
+			for _, msg := range utilvalidation.IsDNS1123Label(cluster) {
+				allErrs = append(allErrs, field.Invalid(specPath.Child("placement", "cluster"), cluster, msg))
+			}
+		}
+	}
+
+	for i, requirement := range job.Spec.Execution.ExtraPackages {
+		if _, err := packages.Name(requirement); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("execution", "extraPackages").Index(i),
+				requirement, err.Error()))
+		}
+	}
+
+	versionPath := specPath.Child("execution", "qiskitVersion")
+	rt, err := compat.Resolve(job.Spec.Execution.QiskitVersion)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(versionPath, job.Spec.Execution.QiskitVersion, err.Error()))
+	} else if err := rt.CheckBackend(job.Spec.Backend.Type); err != nil {
+		allErrs = append(allErrs, field.Invalid(versionPath, job.Spec.Execution.QiskitVersion, err.Error()))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+		job.Name, allErrs)
+}
+
+// hintsChanged reports whether an update sets or changes the scheduling
+// hints of the job
+func hintsChanged(oldJob, job *quantumv1.QiskitJob) bool {
+	for _, annotation := range []string{hints.TargetBackendAnnotation, hints.QueueAnnotation} {
+		oldValue, oldOK := oldJob.Annotations[annotation]
+		value, ok := job.Annotations[annotation]
+		if oldValue != value || oldOK != ok {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTemplate rejects jobs whose template is missing or does not allow
+// their overrides. The defaulter has already applied any template it could,
+// so this only sees jobs it had to leave alone.
+func (v *QiskitJobCustomValidator) validateTemplate(ctx context.Context, job *quantumv1.QiskitJob) error {
+	if v.Reader == nil || job.Spec.TemplateRef == nil || jobtemplate.Applied(job) {
+		return nil
+	}
+
+	_, err := jobtemplate.Resolve(ctx, v.Reader, job.DeepCopy())
+	var overrideErr *jobtemplate.OverrideError
+	var fieldErr *field.Error
+	switch {
+	case err == nil:
+		return nil
+	case apierrors.IsNotFound(err):
+		fieldErr = field.NotFound(field.NewPath("spec", "templateRef", "name"), job.Spec.TemplateRef.Name)
+	case errors.As(err, &overrideErr):
+		fieldErr = field.Forbidden(field.NewPath("spec", "overrides"), err.Error())
+	default:
+		return apierrors.NewInternalError(fmt.Errorf("failed to load job template: %w", err))
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+		job.Name, field.ErrorList{fieldErr})
+}
+
+// validateRerun keeps the spec of finished jobs as they ran: it can only be
+// edited along with RerunAnnotation, which runs the job again with it. The
+// annotation is not accepted on jobs that have not finished.
+func validateRerun(oldJob, job *quantumv1.QiskitJob) error {
+	_, rerun := job.Annotations[quantumv1.RerunAnnotation]
+	var allErrs field.ErrorList
+	if !oldJob.Status.Phase.Finished() {
+		if _, requested := oldJob.Annotations[quantumv1.RerunAnnotation]; rerun && !requested {
+			allErrs = append(allErrs, field.Forbidden(
+				field.NewPath("metadata", "annotations").Key(quantumv1.RerunAnnotation),
+				"only jobs that finished can be run again"))
+		}
+	} else if !rerun && !equality.Semantic.DeepEqual(migration.Spec(&oldJob.Spec), migration.Spec(&job.Spec)) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"),
+			fmt.Sprintf("may not change after the job finished, unless it is annotated with %s to run it again",
+				quantumv1.RerunAnnotation)))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+		job.Name, allErrs)
+}
+
+// validateTemplateLock keeps instantiated jobs consistent with their
+// template: settings the template owns, the reference itself and the
+// overrides cannot change after the template was applied.
+func validateTemplateLock(oldJob, job *quantumv1.QiskitJob) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	const msg = "may not change after the job template was applied"
+
+	if !jobtemplate.Applied(job) {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("metadata", "annotations").Key(jobtemplate.AppliedAnnotation), "may not be removed"))
+	}
+	if !equality.Semantic.DeepEqual(oldJob.Spec.TemplateRef, job.Spec.TemplateRef) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("templateRef"), msg))
+	}
+	if !equality.Semantic.DeepEqual(oldJob.Spec.Overrides, job.Spec.Overrides) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("overrides"), msg))
+	}
+	if !equality.Semantic.DeepEqual(jobtemplate.Settings(&oldJob.Spec), jobtemplate.Settings(&job.Spec)) ||
+		!equality.Semantic.DeepEqual(migration.Outputs(&oldJob.Spec), migration.Outputs(&job.Spec)) {
+		allErrs = append(allErrs, field.Forbidden(specPath,
+			"settings owned by the job template "+msg))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+		job.Name, allErrs)
+}
+
+// validateBackendOwner rejects new jobs that configure a backend of their
+// own next to a backendRef, whose QuantumBackend supplies it
+func validateBackendOwner(job *quantumv1.QiskitJob) error {
+	if job.Spec.BackendRef == nil || backendref.Applied(job) || job.Spec.Backend.Type == "" {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+		job.Name, field.ErrorList{field.Forbidden(field.NewPath("spec", "backend"),
+			"may not be set together with backendRef, the QuantumBackend supplies it")})
+}
+
+// validateBackendRefLock keeps jobs on the QuantumBackend they resolved: the
+// reference, the record of the resolution and the copied backend cannot
+// change afterwards
+func validateBackendRefLock(oldJob, job *quantumv1.QiskitJob) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	const msg = "may not change after the QuantumBackend was resolved"
+
+	if oldJob.Annotations[backendref.AppliedAnnotation] != job.Annotations[backendref.AppliedAnnotation] {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("metadata", "annotations").Key(backendref.AppliedAnnotation), msg))
+	}
+	if !equality.Semantic.DeepEqual(oldJob.Spec.BackendRef, job.Spec.BackendRef) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("backendRef"), msg))
+	}
+	if !equality.Semantic.DeepEqual(oldJob.Spec.Backend, job.Spec.Backend) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("backend"), msg))
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+		job.Name, allErrs)
+}
+
+// validateCredentialsNamespace rejects credentials naming a Secret outside
+// the job's namespace, unless the QuantumBackend the job resolved shares it
+// with the job's namespace. Otherwise any user could have the operator read
+// another namespace's credentials and send them to a backend of their own.
+func (v *QiskitJobCustomValidator) validateCredentialsNamespace(ctx context.Context, job *quantumv1.QiskitJob) error {
+	err := backendref.CheckSecrets(ctx, v.Reader, job)
+	var crossNamespace *backendref.CrossNamespaceError
+	switch {
+	case errors.As(err, &crossNamespace):
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+			job.Name, field.ErrorList{field.Forbidden(field.NewPath("spec", "credentials"), err.Error())})
+	case err != nil:
+		return apierrors.NewInternalError(fmt.Errorf("failed to load QuantumBackend: %w", err))
+	}
+	return nil
+}
+
+// validateOutput rejects outputs the operator cannot write to. Like the
+// residency policy it is only checked when the outputs change, so jobs
+// admitted before s3 outputs needed credentials can still be updated.
+// validateCodeSize rejects inline code too large to keep in the job. It is
+// only checked when the code is set, so jobs stored before the limit can
+// still be updated.
+func validateCodeSize(job *quantumv1.QiskitJob) error {
+	if errs := validation.ValidateInlineCodeSize(job, field.NewPath("spec", "circuit", "code")); len(errs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+			job.Name, errs)
+	}
+	return nil
+}
+
+func validateOutput(job *quantumv1.QiskitJob) error {
+	if errs := validation.ValidateOutputs(job.Spec.Outputs, field.NewPath("spec", "outputs")); len(errs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+			job.Name, errs)
+	}
+	return nil
+}
+
+// validateResidency rejects outputs the namespace's data residency policy
+// does not allow. Unlike linting it fails closed: if the policy cannot be
+// read, the job is denied rather than admitted unchecked.
+func (v *QiskitJobCustomValidator) validateResidency(ctx context.Context, job *quantumv1.QiskitJob) error {
+	if v.Reader == nil || len(job.Spec.Outputs) == 0 {
+		return nil
+	}
+
+	policy, err := residency.PolicyForNamespace(ctx, v.Reader, job.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to load data residency policy: %w", err))
+	}
+	for i := range job.Spec.Outputs {
+		if err := policy.Check(&job.Spec.Outputs[i]); err != nil {
+			return apierrors.NewInvalid(
+				schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+				job.Name, field.ErrorList{field.Forbidden(field.NewPath("spec", "outputs").Index(i), err.Error())})
+		}
+	}
+	return nil
+}
+
+// validatePackages rejects extra packages that are not on the operator's
+// allow-list. Malformed requirements were already reported by validateQiskitJob.
+func (v *QiskitJobCustomValidator) validatePackages(job *quantumv1.QiskitJob) error {
+	if err := v.AllowedPackages.Check(job.Spec.Execution.ExtraPackages); err != nil {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: quantumv1.GroupVersion.Group, Kind: "QiskitJob"},
+			job.Name, field.ErrorList{field.Forbidden(field.NewPath("spec", "execution", "extraPackages"), err.Error())})
+	}
+	return nil
+}
+
+// lintWarnings runs the namespace's lint rule set against inline circuit code.
+// Lint never rejects a job; configuration errors are reported as warnings too.
+func (v *QiskitJobCustomValidator) lintWarnings(ctx context.Context, job *quantumv1.QiskitJob) admission.Warnings {
+	if job.Spec.Circuit.Source != "inline" || job.Spec.Circuit.Code == "" {
+		return nil
+	}
+	// The rules read Python
+	if f := job.Spec.Circuit.Format; f != "" && f != "python" {
+		return nil
+	}
+
+	ruleIDs := lint.RuleIDs()
+	if v.Reader != nil {
+		ids, err := lint.RulesForNamespace(ctx, v.Reader, job.Namespace)
+		if err != nil {
+			qiskitjoblog.Error(err, "Failed to load namespace lint rules, using all rules", "namespace", job.Namespace)
+		} else {
+			ruleIDs = ids
+		}
+	}
+
+	var warnings admission.Warnings
+	for _, finding := range lint.Run(job.Spec.Circuit.Code, ruleIDs) {
+		warnings = append(warnings, "circuit lint: "+finding.String())
+	}
+	return warnings
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approval gates jobs above a qubit or cost tier behind an
+// approval, as change-approval processes do for expensive hardware time.
+// Jobs are approved or denied by annotating them, which only users allowed
+// the approve verb on qiskitjobs may do, or by an external approval webhook
+// the operator asks.
+package approval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// Annotation approves or denies a job waiting for approval. Its value is
+	// Approved or Denied, case-insensitively.
+	Annotation = "quantum.io/approval"
+	// DecidedByAnnotation records who set Annotation. The admission webhook
+	// sets it to the requesting user.
+	DecidedByAnnotation = "quantum.io/approval-decided-by"
+	// MessageAnnotation optionally says why a job was approved or denied
+	MessageAnnotation = "quantum.io/approval-message"
+)
+
+// Verb is the RBAC verb on qiskitjobs that allows approving and denying jobs
+const Verb = "approve"
+
+// Decisions on a job
+const (
+	Approved = "Approved"
+	Denied   = "Denied"
+)
+
+// maxResponseBytes bounds the size of a webhook response read into memory
+const maxResponseBytes = 1 << 20
+
+// defaultTimeout bounds a request to the webhook without a client of its own
+const defaultTimeout = 30 * time.Second
+
+// Tier is the size of job that runs without approval. Zero limits are not
+// enforced.
+type Tier struct {
+	// Qubits is the most qubits a circuit may use
+	Qubits int
+	// Cost is the most a job may be estimated to cost, in US dollars
+	Cost float64
+}
+
+// Enabled reports whether the tier requires approval of any job
+func (t Tier) Enabled() bool {
+	return t.Qubits > 0 || t.Cost > 0
+}
+
+// Exceeds reports whether a job of the given qubits and estimated cost
+// needs approval, and why
+func (t Tier) Exceeds(qubits int, cost float64) (string, bool) {
+	switch {
+	case t.Qubits > 0 && qubits > t.Qubits:
+		return fmt.Sprintf("circuit uses %d qubits, above the approval tier of %d", qubits, t.Qubits), true
+	case t.Cost > 0 && cost > t.Cost:
+		return fmt.Sprintf("estimated cost $%.2f is above the approval tier of $%.2f", cost, t.Cost), true
+	}
+	return "", false
+}
+
+// Normalize returns the decision an annotation value stands for, or false
+// if it is not one
+func Normalize(value string) (string, bool) {
+	switch {
+	case strings.EqualFold(value, Approved):
+		return Approved, true
+	case strings.EqualFold(value, Denied):
+		return Denied, true
+	}
+	return "", false
+}
+
+// Decision returns the decision annotated on a job, who made it and why, or
+// an empty decision if there is none
+func Decision(annotations map[string]string) (decision, decidedBy, message string) {
+	decision, ok := Normalize(annotations[Annotation])
+	if !ok {
+		return "", "", ""
+	}
+	return decision, annotations[DecidedByAnnotation], annotations[MessageAnnotation]
+}
+
+// Request is what the approval webhook is asked about a job
+type Request struct {
+	Namespace     string `json:"namespace"`
+	Name          string `json:"name"`
+	UID           string `json:"uid"`
+	Backend       string `json:"backend"`
+	Qubits        int    `json:"qubits"`
+	EstimatedCost string `json:"estimatedCost"`
+	// Reason is why the job needs approval
+	Reason string `json:"reason"`
+}
+
+// Response is the approval webhook's answer. A decision other than Approved
+// or Denied leaves the job waiting, to be asked about again later.
+type Response struct {
+	Decision string `json:"decision"`
+	// Approver names who approved or denied the job, if not the webhook
+	Approver string `json:"approver,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Webhook asks an external approval service about jobs waiting for approval
+type Webhook struct {
+	// URL the requests are POSTed to
+	URL string
+	// Token is sent as a bearer token, if set
+	Token string
+	// Client sends the requests; nil uses one with a 30s timeout
+	Client *http.Client
+}
+
+// Review asks the webhook about a job
+func (w *Webhook) Review(ctx context.Context, request Request) (Response, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return Response{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(data))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.Token)
+	}
+
+	client := w.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Response{}, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return Response{}, err
+	}
+	if resp.StatusCode >= 300 {
+		return Response{}, fmt.Errorf("approval webhook returned %s", resp.Status)
+	}
+	var response Response
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Response{}, fmt.Errorf("decoding approval webhook response: %w", err)
+	}
+	if decision, ok := Normalize(response.Decision); ok {
+		response.Decision = decision
+	} else {
+		response.Decision = ""
+	}
+	return response, nil
+}
@@ -27,8 +27,10 @@ type BackendType string
 const (
 	IBMQuantum      BackendType = "ibm_quantum"
 	IBMSimulator    BackendType = "ibm_simulator"
+	IBMLocalTesting BackendType = "ibm_local_testing"
 	AWSBraket       BackendType = "aws_braket"
 	LocalSimulator  BackendType = "local_simulator"
+	GenericHTTP     BackendType = "generic_http"
 )
 
 // Backend is the main interface for all quantum computing backends
@@ -87,6 +89,46 @@ type QuantumJob struct {
 	ResilienceLevel   int
 	MaxExecutionTime  time.Duration
 	Metadata          map[string]string
+	Tags              []string // Provider job tags, e.g. IBM Runtime job tags
+	RequestID         string   // Client request ID of the submission, unique per attempt
+	SessionID         string   // Provider session to run in, e.g. an IBM Runtime session
+	ErrorMitigation   ErrorMitigation
+}
+
+// ErrorMitigation is the error suppression and mitigation requested for a
+// job, next to its resilience level. It is passed to generic_http templates
+// and the executor in this JSON form.
+type ErrorMitigation struct {
+	DynamicalDecoupling   string `json:"dynamicalDecoupling,omitempty"` // Sequence padding idle qubits: XX, XpXm or XY4; none if empty
+	GateTwirling          bool   `json:"gateTwirling,omitempty"`
+	MeasureTwirling       bool   `json:"measureTwirling,omitempty"`
+	MeasurementMitigation bool   `json:"measurementMitigation,omitempty"`
+}
+
+// Names of the mitigations recorded in status.results.mitigations
+const (
+	MitigationResilienceLevel     = "resilience-level"     // followed by ":<level>"
+	MitigationDynamicalDecoupling = "dynamical-decoupling" // followed by ":<sequence>"
+	MitigationGateTwirling        = "gate-twirling"
+	MitigationMeasureTwirling     = "measure-twirling"
+	MitigationMeasurement         = "measurement-mitigation"
+)
+
+// Mitigator is implemented by backends that know which of the requested
+// mitigations they apply to a job
+type Mitigator interface {
+	// Mitigations returns the names of the mitigations applied to the job,
+	// Mitigation* constants
+	Mitigations(job *QuantumJob) []string
+}
+
+// Finder is implemented by backends that can find the job a submission
+// created from its client request ID, so that a submission whose response
+// was lost is not sent twice
+type Finder interface {
+	// FindJob returns the ID of the job submitted with the request ID, nil
+	// if the provider has none
+	FindJob(ctx context.Context, requestID string) (*JobID, error)
 }
 
 // JobID is a unique identifier for a submitted job
@@ -147,3 +189,20 @@ type Credentials struct {
 	Extra    map[string]string
 }
 
+
+// Calibration summarizes the latest calibration of a device
+type Calibration struct {
+	Version             string // Version of the device the calibration is of
+	Qubits              int
+	LastUpdated         time.Time
+	MedianT1            time.Duration
+	MedianT2            time.Duration
+	MedianReadoutError  float64
+	MedianTwoQubitError float64
+}
+
+// CalibrationReader is implemented by backends whose provider publishes the
+// calibration of its devices
+type CalibrationReader interface {
+	GetCalibration(ctx context.Context) (*Calibration, error)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credentials fetches the credentials backends authenticate with,
+// from Kubernetes Secrets or from HashiCorp Vault. Credentials are key/value
+// data with the same keys wherever they are kept, e.g. api-key and instance
+// for IBM Quantum.
+package credentials
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Ref says where credentials are kept: the Secret Name in Namespace, or the
+// Vault Path read on behalf of Namespace
+type Ref struct {
+	Namespace string
+	Name      string
+	Path      string
+}
+
+// Provider fetches the data of credentials
+type Provider interface {
+	Fetch(ctx context.Context, ref Ref) (map[string][]byte, error)
+}
+
+// Secrets fetches credentials from Kubernetes Secrets. Reads are served by
+// the reader, the manager's informer cache, so they are not cached again.
+type Secrets struct {
+	Reader client.Reader
+}
+
+// Fetch returns the data of the Secret ref names
+func (s Secrets) Fetch(ctx context.Context, ref Ref) (map[string][]byte, error) {
+	var secret corev1.Secret
+	if err := s.Reader.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, &secret); err != nil {
+		return nil, err
+	}
+	return secret.Data, nil
+}
+
+// cached are credentials and when they were fetched
+type cached struct {
+	data    map[string][]byte
+	fetched time.Time
+}
+
+// Cache keeps the credentials a provider fetched in memory for a TTL, so
+// jobs reconciled every few seconds do not read them each time. Failed
+// fetches are not cached.
+type Cache struct {
+	provider Provider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[Ref]cached
+}
+
+// NewCache caches what provider fetches for ttl
+func NewCache(provider Provider, ttl time.Duration) *Cache {
+	return &Cache{provider: provider, ttl: ttl, now: time.Now, entries: map[Ref]cached{}}
+}
+
+// Fetch returns the cached credentials of ref, fetching them if they are
+// missing or older than the TTL
+func (c *Cache) Fetch(ctx context.Context, ref Ref) (map[string][]byte, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[ref]
+	c.mu.Unlock()
+	if ok && c.now().Sub(entry.fetched) < c.ttl {
+		return entry.data, nil
+	}
+
+	data, err := c.provider.Fetch(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[ref] = cached{data: data, fetched: c.now()}
+	// Drop what expired so paths no job uses any more do not pile up
+	for key, entry := range c.entries {
+		if c.now().Sub(entry.fetched) >= c.ttl {
+			delete(c.entries, key)
+		}
+	}
+	return data, nil
+}
+
+// Forget drops the cached credentials of ref, e.g. after the backend
+// rejected them, so the next fetch reads them again
+func (c *Cache) Forget(ref Ref) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, ref)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// countingProvider returns the same credentials, counting the fetches
+type countingProvider struct {
+	fetches int
+	err     error
+}
+
+func (p *countingProvider) Fetch(_ context.Context, _ Ref) (map[string][]byte, error) {
+	p.fetches++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return map[string][]byte{"api-key": []byte("key")}, nil
+}
+
+var _ = Describe("Secrets", func() {
+	It("should return the data of the referenced Secret", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ibm", Namespace: "team-a"},
+			Data:       map[string][]byte{"api-key": []byte("key")},
+		}
+		provider := Secrets{Reader: fake.NewClientBuilder().WithObjects(secret).Build()}
+		data, err := provider.Fetch(context.Background(), Ref{Namespace: "team-a", Name: "ibm"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveKeyWithValue("api-key", []byte("key")))
+
+		_, err = provider.Fetch(context.Background(), Ref{Namespace: "team-b", Name: "ibm"})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Cache", func() {
+	ctx := context.Background()
+	ref := Ref{Namespace: "team-a", Path: "quantum/ibm"}
+
+	It("should fetch credentials again once they are older than the TTL", func() {
+		provider := &countingProvider{}
+		cache := NewCache(provider, time.Minute)
+		now := time.Now()
+		cache.now = func() time.Time { return now }
+
+		for range 3 {
+			data, err := cache.Fetch(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(HaveKeyWithValue("api-key", []byte("key")))
+		}
+		Expect(provider.fetches).To(Equal(1))
+
+		now = now.Add(time.Minute)
+		_, err := cache.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider.fetches).To(Equal(2))
+
+		By("fetching forgotten credentials at once")
+		cache.Forget(ref)
+		_, err = cache.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider.fetches).To(Equal(3))
+	})
+
+	It("should not cache failed fetches", func() {
+		provider := &countingProvider{err: errors.New("sealed")}
+		cache := NewCache(provider, time.Minute)
+		_, err := cache.Fetch(ctx, ref)
+		Expect(err).To(MatchError("sealed"))
+		_, err = cache.Fetch(ctx, ref)
+		Expect(err).To(MatchError("sealed"))
+		Expect(provider.fetches).To(Equal(2))
+	})
+})
+
+var _ = Describe("Vault", func() {
+	ctx := context.Background()
+
+	var (
+		logins, renewals, reads int
+		readStatus              int
+		lastToken               string
+		tokenFile               string
+	)
+
+	serve := func(secret string, opts VaultOptions) *Vault {
+		logins, renewals, reads, readStatus = 0, 0, 0, http.StatusOK
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/v1/auth/kubernetes/login":
+				var login map[string]string
+				Expect(json.NewDecoder(r.Body).Decode(&login)).To(Succeed())
+				Expect(login).To(Equal(map[string]string{"role": "qiskit-operator", "jwt": "sa-token"}))
+				logins++
+				_, _ = w.Write([]byte(`{"auth": {"client_token": "login-token", "lease_duration": 3600, "renewable": true}}`))
+			case "/v1/auth/token/renew-self":
+				Expect(r.Header.Get("X-Vault-Token")).To(Equal("login-token"))
+				renewals++
+				_, _ = w.Write([]byte(`{"auth": {"client_token": "login-token", "lease_duration": 3600, "renewable": true}}`))
+			default:
+				reads++
+				lastToken = r.Header.Get("X-Vault-Token")
+				if readStatus != http.StatusOK {
+					w.WriteHeader(readStatus)
+					readStatus = http.StatusOK
+					_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+					return
+				}
+				Expect(r.URL.Path).To(Equal("/v1/secret/data/quantum/team-a/ibm"))
+				_, _ = w.Write([]byte(secret))
+			}
+		}))
+		DeferCleanup(srv.Close)
+
+		tokenFile = filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("sa-token\n"), 0o600)).To(Succeed())
+		opts.Address = srv.URL + "/"
+		opts.Role = "qiskit-operator"
+		opts.TokenFile = tokenFile
+		return NewVault(opts)
+	}
+
+	It("should read version 2 secrets below the namespace's prefix", func() {
+		vault := serve(`{"data": {"data": {"api-key": "key", "instance": "crn:v1", "port": 8443}, "metadata": {"version": 3}}}`,
+			VaultOptions{PathPrefix: "secret/data/quantum/{namespace}"})
+		data, err := vault.Fetch(ctx, Ref{Namespace: "team-a", Path: "ibm"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(map[string][]byte{
+			"api-key":  []byte("key"),
+			"instance": []byte("crn:v1"),
+			"port":     []byte("8443"),
+		}))
+		Expect(lastToken).To(Equal("login-token"))
+
+		By("refusing paths that climb out of the prefix")
+		_, err = vault.Fetch(ctx, Ref{Namespace: "team-a", Path: "../team-b/ibm"})
+		Expect(err).To(MatchError(ContainSubstring("invalid Vault path")))
+	})
+
+	It("should read version 1 secrets at the path as given", func() {
+		vault := serve(`{"data": {"api-key": "key"}}`, VaultOptions{})
+		data, err := vault.Fetch(ctx, Ref{Namespace: "team-b", Path: "secret/data/quantum/team-a/ibm"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(map[string][]byte{"api-key": []byte("key")}))
+	})
+
+	It("should renew the token late in its lease and log in again once it expired", func() {
+		vault := serve(`{"data": {"api-key": "key"}}`, VaultOptions{})
+		now := time.Now()
+		vault.now = func() time.Time { return now }
+		ref := Ref{Path: "secret/data/quantum/team-a/ibm"}
+
+		_, err := vault.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		now = now.Add(30 * time.Minute)
+		_, err = vault.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logins).To(Equal(1))
+		Expect(renewals).To(Equal(0))
+
+		By("renewing after two thirds of the lease")
+		now = now.Add(15 * time.Minute)
+		_, err = vault.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(renewals).To(Equal(1))
+		Expect(logins).To(Equal(1))
+
+		By("logging in again once the lease ran out")
+		now = now.Add(2 * time.Hour)
+		_, err = vault.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logins).To(Equal(2))
+		Expect(reads).To(Equal(4))
+	})
+
+	It("should log in again when Vault revoked the token", func() {
+		vault := serve(`{"data": {"api-key": "key"}}`, VaultOptions{})
+		ref := Ref{Path: "secret/data/quantum/team-a/ibm"}
+		_, err := vault.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+
+		readStatus = http.StatusForbidden
+		data, err := vault.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(HaveKey("api-key"))
+		Expect(logins).To(Equal(2))
+	})
+
+	It("should classify a rejected login as an authentication error", func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+		}))
+		DeferCleanup(srv.Close)
+		tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("sa-token"), 0o600)).To(Succeed())
+
+		vault := NewVault(VaultOptions{Address: srv.URL, Role: "unknown", TokenFile: tokenFile})
+		_, err := vault.Fetch(ctx, Ref{Path: "secret/quantum/ibm"})
+		Expect(errors.Is(err, backend.ErrAuth)).To(BeTrue())
+	})
+})
@@ -0,0 +1,275 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// DefaultVaultAuthMount is where Vault's Kubernetes auth method is mounted
+// unless configured otherwise
+const DefaultVaultAuthMount = "kubernetes"
+
+// DefaultTokenFile holds the token of the operator's service account
+const DefaultTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// NamespacePlaceholder in a Vault path prefix is replaced by the namespace
+// of the job reading the path
+const NamespacePlaceholder = "{namespace}"
+
+// maxResponseBytes caps the Vault responses read
+const maxResponseBytes = 1 << 20
+
+// VaultErrors classifies Vault's error responses, which carry no codes
+var VaultErrors = backend.Catalog{Statuses: backend.HTTPStatuses}
+
+// VaultOptions configure how the operator reaches Vault
+type VaultOptions struct {
+	// Address of the Vault server, e.g. https://vault.example.com:8200
+	Address string
+	// AuthMount is where the Kubernetes auth method is mounted,
+	// DefaultVaultAuthMount if empty
+	AuthMount string
+	// Role is the Vault role the operator's service account logs in as
+	Role string
+	// TokenFile holds the service account token the operator logs in with,
+	// DefaultTokenFile if empty. It is read at every login, so projected
+	// tokens are rotated.
+	TokenFile string
+	// Namespace is the Vault Enterprise namespace, if any
+	Namespace string
+	// PathPrefix, when set, is prepended to the paths jobs read, with
+	// NamespacePlaceholder replaced by the job's namespace, to confine the
+	// jobs of each namespace to its own secrets
+	PathPrefix string
+	// Client sends the requests, a client with a 30s timeout if nil
+	Client *http.Client
+}
+
+// Vault fetches credentials from Vault's key/value secrets engine, version
+// 1 or 2, logging in with the operator's service account through Vault's
+// Kubernetes auth method. The token is renewed once two thirds of its lease
+// have passed, and replaced by logging in again when it cannot be renewed
+// or Vault revoked it.
+type Vault struct {
+	opts   VaultOptions
+	client *http.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	renewable bool
+	renewAt   time.Time
+	expires   time.Time
+}
+
+// NewVault returns a provider reading credentials from the Vault of opts
+func NewVault(opts VaultOptions) *Vault {
+	opts.Address = strings.TrimSuffix(opts.Address, "/")
+	if opts.AuthMount == "" {
+		opts.AuthMount = DefaultVaultAuthMount
+	}
+	if opts.TokenFile == "" {
+		opts.TokenFile = DefaultTokenFile
+	}
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Vault{opts: opts, client: client, now: time.Now}
+}
+
+// Path returns the Vault path read for ref, or an error if ref's path
+// climbs out of the namespace's prefix
+func (v *Vault) Path(ref Ref) (string, error) {
+	path := strings.Trim(ref.Path, "/")
+	if path == "" || slices.Contains(strings.Split(path, "/"), "..") {
+		return "", fmt.Errorf("invalid Vault path %q", ref.Path)
+	}
+	if v.opts.PathPrefix == "" {
+		return path, nil
+	}
+	prefix := strings.ReplaceAll(v.opts.PathPrefix, NamespacePlaceholder, ref.Namespace)
+	return strings.Trim(prefix, "/") + "/" + path, nil
+}
+
+// Fetch reads the secret at ref's path. String values are returned as is
+// and other values as JSON.
+func (v *Vault) Fetch(ctx context.Context, ref Ref) (map[string][]byte, error) {
+	path, err := v.Path(ref)
+	if err != nil {
+		return nil, err
+	}
+	var secret struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	err = v.read(ctx, path, &secret)
+	if errors.Is(err, backend.ErrAuth) {
+		// The token may have been revoked before its lease ran out
+		v.mu.Lock()
+		v.token = ""
+		v.mu.Unlock()
+		err = v.read(ctx, path, &secret)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields := secret.Data
+	// Version 2 of the engine nests the secret's data next to its metadata
+	if nested, ok := fields["data"]; ok && fields["metadata"] != nil {
+		fields = nil
+		if err := json.Unmarshal(nested, &fields); err != nil {
+			return nil, fmt.Errorf("Vault secret %s: %w", path, err)
+		}
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("Vault secret %s has no data", path)
+	}
+	data := make(map[string][]byte, len(fields))
+	for key, value := range fields {
+		var s string
+		if json.Unmarshal(value, &s) == nil {
+			data[key] = []byte(s)
+		} else {
+			data[key] = value
+		}
+	}
+	return data, nil
+}
+
+// read reads the secret at path
+func (v *Vault) read(ctx context.Context, path string, out any) error {
+	v.mu.Lock()
+	token, err := v.clientToken(ctx)
+	v.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.opts.Address+"/v1/"+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	return v.send(req, "read "+path, out)
+}
+
+// clientToken returns a Vault token, renewing or replacing the current one
+// when it is due; v.mu must be held
+func (v *Vault) clientToken(ctx context.Context) (string, error) {
+	now := v.now()
+	if v.token != "" && (v.renewAt.IsZero() || now.Before(v.renewAt)) {
+		return v.token, nil
+	}
+	if v.token != "" && v.renewable && now.Before(v.expires) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.opts.Address+"/v1/auth/token/renew-self",
+			strings.NewReader("{}"))
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("X-Vault-Token", v.token)
+		if err := v.authenticate(req, "token renewal"); err == nil {
+			return v.token, nil
+		}
+	}
+
+	jwt, err := os.ReadFile(v.opts.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("service account token: %w", err)
+	}
+	body, err := json.Marshal(map[string]string{"role": v.opts.Role, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		v.opts.Address+"/v1/auth/"+strings.Trim(v.opts.AuthMount, "/")+"/login", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	if err := v.authenticate(req, "login"); err != nil {
+		v.token = ""
+		return "", err
+	}
+	return v.token, nil
+}
+
+// authenticate sends a login or renewal request and keeps the token it
+// returns; v.mu must be held
+func (v *Vault) authenticate(req *http.Request, name string) error {
+	var response struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+			Renewable     bool   `json:"renewable"`
+		} `json:"auth"`
+	}
+	if err := v.send(req, name, &response); err != nil {
+		return err
+	}
+	if response.Auth.ClientToken == "" {
+		return fmt.Errorf("%s response: no client token", name)
+	}
+	now := v.now()
+	lease := time.Duration(response.Auth.LeaseDuration) * time.Second
+	v.token = response.Auth.ClientToken
+	v.renewable = response.Auth.Renewable
+	v.renewAt, v.expires = time.Time{}, time.Time{}
+	if lease > 0 {
+		v.renewAt = now.Add(lease * 2 / 3)
+		v.expires = now.Add(lease)
+	}
+	return nil
+}
+
+// send sends a request to Vault and decodes its JSON response into out
+func (v *Vault) send(req *http.Request, name string, out any) error {
+	req.Header.Set("Content-Type", "application/json")
+	if v.opts.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.opts.Namespace)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Vault %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("Vault %s response: %w", name, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return VaultErrors.Error(resp.StatusCode, "", resp.Header.Get("Retry-After"),
+			fmt.Sprintf("Vault %s: %s: %s", name, resp.Status, bytes.TrimSpace(data)))
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("Vault %s response is not JSON: %w", name, err)
+	}
+	return nil
+}
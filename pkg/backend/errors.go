@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Classes of provider errors. Adapters classify the errors of their
+// provider's API with a Catalog, so callers can react to them with
+// errors.Is whatever the provider and however it words its messages.
+var (
+	// ErrQueueFull means the provider accepts no more jobs from the caller
+	// until some of its queued or running jobs finish
+	ErrQueueFull = errors.New("provider queue is full")
+	// ErrDeviceOffline means the device is down, in maintenance or retired
+	ErrDeviceOffline = errors.New("device is offline")
+	// ErrAuth means the credentials were rejected or lack access
+	ErrAuth = errors.New("credentials were rejected")
+	// ErrCircuitTooLarge means the circuit exceeds what the device runs,
+	// in qubits, depth or payload size
+	ErrCircuitTooLarge = errors.New("circuit is too large for the device")
+	// ErrThrottled means the caller sent too many requests
+	ErrThrottled = errors.New("provider is throttling requests")
+)
+
+// Error is an error response of a provider's API
+type Error struct {
+	// Class is the class of the error, one of the Err variables, nil if
+	// the provider's catalog does not know the error
+	Class error
+	// StatusCode is the HTTP status of the response
+	StatusCode int
+	// Code is the provider's error code, if the response carries one
+	Code string
+	// RetryAfter is how long the provider asks to wait before retrying, if it says
+	RetryAfter time.Duration
+	// Message describes the request and the response
+	Message string
+}
+
+// Error returns the message
+func (e *Error) Error() string { return e.Message }
+
+// Unwrap returns the class, so errors.Is matches it
+func (e *Error) Unwrap() error { return e.Class }
+
+// Catalog maps the error responses of a provider's API onto classes
+type Catalog struct {
+	// Codes maps the provider's error codes
+	Codes map[string]error
+	// Statuses maps HTTP statuses, for responses without a known code
+	Statuses map[int]error
+}
+
+// HTTPStatuses classifies error responses by their HTTP status alone; it
+// serves providers without error codes of their own
+var HTTPStatuses = map[int]error{
+	http.StatusUnauthorized:          ErrAuth,
+	http.StatusForbidden:             ErrAuth,
+	http.StatusRequestEntityTooLarge: ErrCircuitTooLarge,
+	http.StatusTooManyRequests:       ErrThrottled,
+	http.StatusServiceUnavailable:    ErrDeviceOffline,
+}
+
+// Error returns the classified error of a response with the given status,
+// provider error code and Retry-After header
+func (c Catalog) Error(status int, code, retryAfter, message string) *Error {
+	err := &Error{StatusCode: status, Code: code, Message: message}
+	if class, ok := c.Codes[code]; ok && code != "" {
+		err.Class = class
+	} else {
+		err.Class = c.Statuses[status]
+	}
+	if seconds, convErr := strconv.Atoi(retryAfter); convErr == nil && seconds > 0 {
+		err.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	return err
+}
+
+// Transient reports whether the error is one of the provider's to come back
+// from by waiting: a full queue or throttling. Such errors do not count as
+// failed attempts.
+func Transient(err error) bool {
+	return errors.Is(err, ErrQueueFull) || errors.Is(err, ErrThrottled)
+}
+
+// Permanent reports whether the error would recur on every attempt:
+// rejected credentials or a circuit too large for the device
+func Permanent(err error) bool {
+	return errors.Is(err, ErrAuth) || errors.Is(err, ErrCircuitTooLarge)
+}
+
+// RetryAfter returns how long the provider asked to wait, 0 if it did not
+func RetryAfter(err error) time.Duration {
+	var providerErr *Error
+	if errors.As(err, &providerErr) {
+		return providerErr.RetryAfter
+	}
+	return 0
+}
@@ -0,0 +1,458 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package generichttp drives QPUs behind in-house control stacks through a
+// JSON-over-HTTP API described by a generic_http BackendSpec, so labs can
+// integrate their hardware without writing Go code.
+package generichttp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+// defaultTimeout bounds each request when the spec sets no timeout
+const defaultTimeout = 30 * time.Second
+
+// maxResponseBytes bounds the size of a response body read into memory
+const maxResponseBytes = 16 << 20
+
+// ErrNotSupported is returned for operations the backend does not configure
+var ErrNotSupported = errors.New("not supported by this generic_http backend")
+
+// Errors classifies the error responses of generic_http backends, which
+// have no common error codes, by their HTTP status
+var Errors = backend.Catalog{Statuses: backend.HTTPStatuses}
+
+// Request holds the values URL and body templates are rendered with
+type Request struct {
+	Name      string
+	Namespace string
+	UID       string
+	Shots     int
+	Circuit   string
+	JobID     string
+	Tags      []string
+	// RequestID is the client request ID of the submission, also sent as
+	// its Idempotency-Key header
+	RequestID string
+	// MaxExecutionSeconds is how long the job may run, 0 if unlimited
+	MaxExecutionSeconds int
+	// ResilienceLevel and ErrorMitigation are the job's requested error
+	// mitigation. The operator cannot tell which the provider applied, so
+	// none are recorded in the job's results.
+	ResilienceLevel int
+	ErrorMitigation backend.ErrorMitigation
+}
+
+// ParseTemplate parses a URL or body template with the functions available
+// to generic_http templates
+func ParseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=error").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}).Parse(text)
+}
+
+// Backend is a backend.Backend for a generic_http BackendSpec
+type Backend struct {
+	name        string
+	spec        *quantumv1.HTTPBackendSpec
+	client      *http.Client
+	credentials *backend.Credentials
+	request     Request
+}
+
+var _ backend.Backend = &Backend{}
+var _ backend.Finder = &Backend{}
+
+// New returns a Backend for spec. The request fills the job-specific template
+// values; client defaults to a client with the spec's timeout.
+func New(name string, spec *quantumv1.HTTPBackendSpec, request Request, client *http.Client) *Backend {
+	if client == nil {
+		client = Client(spec, nil)
+	}
+	if name == "" {
+		name = string(backend.GenericHTTP)
+	}
+	return &Backend{name: name, spec: spec, client: client, request: request}
+}
+
+// Client returns a client with the spec's timeout. A TLS config, e.g. with
+// the client certificate of a control stack requiring mutual TLS, replaces
+// that of the default transport.
+func Client(spec *quantumv1.HTTPBackendSpec, config *tls.Config) *http.Client {
+	timeout := defaultTimeout
+	if spec.Timeout != nil && spec.Timeout.Duration > 0 {
+		timeout = spec.Timeout.Duration
+	}
+	client := &http.Client{Timeout: timeout}
+	if config != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config
+		client.Transport = transport
+	}
+	return client
+}
+
+// Name returns the backend name from the job's BackendSpec
+func (b *Backend) Name() string { return b.name }
+
+// Type returns generic_http
+func (b *Backend) Type() backend.BackendType { return backend.GenericHTTP }
+
+// Provider returns the provider name
+func (b *Backend) Provider() string { return "generic_http" }
+
+// GetCapabilities is not described by the spec and returns empty capabilities
+func (b *Backend) GetCapabilities(ctx context.Context) (*backend.BackendCapabilities, error) {
+	return &backend.BackendCapabilities{}, nil
+}
+
+// IsAvailable assumes the control stack is reachable; failures surface on submission
+func (b *Backend) IsAvailable(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+// GetQueueStatus is not described by the spec
+func (b *Backend) GetQueueStatus(ctx context.Context) (*backend.QueueStatus, error) {
+	return nil, ErrNotSupported
+}
+
+// SubmitJob renders the submit endpoint and returns the provider job ID
+func (b *Backend) SubmitJob(ctx context.Context, job *backend.QuantumJob) (*backend.JobID, error) {
+	req := b.request
+	req.Circuit = job.CircuitCode
+	req.Shots = job.Shots
+	req.Tags = job.Tags
+	req.RequestID = job.RequestID
+	req.MaxExecutionSeconds = int(math.Ceil(job.MaxExecutionTime.Seconds()))
+	req.ResilienceLevel = job.ResilienceLevel
+	req.ErrorMitigation = job.ErrorMitigation
+
+	body, err := b.do(ctx, "submit", &b.spec.Submit, http.MethodPost, req)
+	if err != nil {
+		return nil, err
+	}
+	value, err := lookup(body, b.spec.Mapping.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("submit response: %w", err)
+	}
+	id := backend.JobID(scalar(value))
+	if id == "" {
+		return nil, fmt.Errorf("submit response: empty job ID at %q", b.spec.Mapping.JobID)
+	}
+	return &id, nil
+}
+
+// FindJob calls the lookup endpoint for the job submitted with the request
+// ID. Without a lookup endpoint it finds nothing, and the submission is sent
+// again with the same Idempotency-Key.
+func (b *Backend) FindJob(ctx context.Context, requestID string) (*backend.JobID, error) {
+	if b.spec.Lookup == nil {
+		return nil, nil
+	}
+	req := b.request
+	req.RequestID = requestID
+	body, err := b.do(ctx, "lookup", b.spec.Lookup, http.MethodGet, req)
+	var providerErr *backend.Error
+	switch {
+	case errors.As(err, &providerErr) && providerErr.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	// A search without results has no job ID to find
+	value, err := lookup(body, b.spec.Mapping.JobID)
+	if err != nil {
+		return nil, nil
+	}
+	id := backend.JobID(scalar(value))
+	if id == "" {
+		return nil, nil
+	}
+	return &id, nil
+}
+
+// GetJobStatus polls the status endpoint. The phase is Completed or Failed
+// when the provider state is listed in the mapping, and Running otherwise.
+func (b *Backend) GetJobStatus(ctx context.Context, jobID backend.JobID) (*backend.JobStatus, error) {
+	body, err := b.status(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	value, err := lookup(body, b.spec.Mapping.State)
+	if err != nil {
+		return nil, fmt.Errorf("status response: %w", err)
+	}
+	state := scalar(value)
+
+	status := &backend.JobStatus{ID: jobID, Phase: "Running", Message: state}
+	switch {
+	case slices.Contains(b.spec.Mapping.CompletedStates, state):
+		status.Phase = "Completed"
+	case slices.Contains(b.spec.Mapping.FailedStates, state):
+		status.Phase = "Failed"
+	case slices.Contains(b.spec.Mapping.QueuedStates, state):
+		status.Phase = "Queued"
+	}
+	if b.spec.Mapping.Message != "" {
+		if message, err := lookup(body, b.spec.Mapping.Message); err == nil && scalar(message) != "" {
+			status.Message = scalar(message)
+		}
+	}
+	// Queue details are informational; responses without them are fine
+	if b.spec.Mapping.QueuePosition != "" {
+		if value, err := lookup(body, b.spec.Mapping.QueuePosition); err == nil {
+			if position, err := strconv.Atoi(scalar(value)); err == nil && position > 0 {
+				status.QueuePosition = &position
+			}
+		}
+	}
+	if b.spec.Mapping.EstimatedStartTime != "" {
+		if value, err := lookup(body, b.spec.Mapping.EstimatedStartTime); err == nil {
+			if start, err := time.Parse(time.RFC3339, scalar(value)); err == nil {
+				status.EstimatedStart = &start
+			}
+		}
+	}
+	return status, nil
+}
+
+// GetJobResult reads the measurement counts from the result endpoint, or
+// from the status response when no result endpoint is configured
+func (b *Backend) GetJobResult(ctx context.Context, jobID backend.JobID) (*backend.JobResult, error) {
+	var body any
+	var err error
+	if b.spec.Result != nil {
+		req := b.request
+		req.JobID = string(jobID)
+		body, err = b.do(ctx, "result", b.spec.Result, http.MethodGet, req)
+	} else {
+		body, err = b.status(ctx, jobID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := lookup(body, b.spec.Mapping.Counts)
+	if err != nil {
+		return nil, fmt.Errorf("result response: %w", err)
+	}
+	raw, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("result response: %q is not an object of counts", b.spec.Mapping.Counts)
+	}
+	counts := make(map[string]int, len(raw))
+	for bitstring, v := range raw {
+		n, ok := v.(float64)
+		if !ok {
+			return nil, fmt.Errorf("result response: count of %q is not a number", bitstring)
+		}
+		counts[bitstring] = int(n)
+	}
+
+	data, _ := json.Marshal(body)
+	return &backend.JobResult{JobID: jobID, Success: true, Counts: counts, RawData: data}, nil
+}
+
+// CancelJob calls the cancel endpoint
+func (b *Backend) CancelJob(ctx context.Context, jobID backend.JobID) error {
+	if b.spec.Cancel == nil {
+		return ErrNotSupported
+	}
+	req := b.request
+	req.JobID = string(jobID)
+	_, err := b.do(ctx, "cancel", b.spec.Cancel, http.MethodPost, req)
+	return err
+}
+
+// EstimateCost reports on-premises hardware as free
+func (b *Backend) EstimateCost(ctx context.Context, job *backend.QuantumJob) (*backend.CostEstimate, error) {
+	return &backend.CostEstimate{Currency: "USD", Confidence: 1}, nil
+}
+
+// GetActualCost reports on-premises hardware as free
+func (b *Backend) GetActualCost(ctx context.Context, jobID backend.JobID) (*backend.Cost, error) {
+	return &backend.Cost{Currency: "USD"}, nil
+}
+
+// Authenticate stores the credentials sent with every request
+func (b *Backend) Authenticate(ctx context.Context, credentials *backend.Credentials) error {
+	switch b.spec.Auth {
+	case quantumv1.HTTPAuthBearer, quantumv1.HTTPAuthHeader:
+		if credentials == nil || credentials.APIKey == "" {
+			return fmt.Errorf("%s authentication requires an api-key", b.spec.Auth)
+		}
+	case quantumv1.HTTPAuthBasic:
+		if credentials == nil || credentials.Extra["username"] == "" {
+			return errors.New("basic authentication requires a username")
+		}
+	}
+	b.credentials = credentials
+	return nil
+}
+
+// RefreshCredentials is a no-op; static credentials are sent with every request
+func (b *Backend) RefreshCredentials(ctx context.Context) error {
+	return nil
+}
+
+func (b *Backend) status(ctx context.Context, jobID backend.JobID) (any, error) {
+	req := b.request
+	req.JobID = string(jobID)
+	return b.do(ctx, "status", &b.spec.Status, http.MethodGet, req)
+}
+
+// do renders and sends an endpoint's request and decodes the JSON response
+func (b *Backend) do(ctx context.Context, name string, endpoint *quantumv1.HTTPEndpoint, method string, req Request) (any, error) {
+	url, err := render(name+" url", endpoint.URL, req)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Method != "" {
+		method = endpoint.Method
+	}
+	var body io.Reader
+	if endpoint.Body != "" {
+		rendered, err := render(name+" body", endpoint.Body, req)
+		if err != nil {
+			return nil, err
+		}
+		body = strings.NewReader(rendered)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if name == "submit" && req.RequestID != "" {
+		httpReq.Header.Set("Idempotency-Key", req.RequestID)
+	}
+	b.authorize(httpReq)
+
+	resp, err := b.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, Errors.Error(resp.StatusCode, "", resp.Header.Get("Retry-After"),
+			fmt.Sprintf("%s request: %s: %s", name, resp.Status, bytes.TrimSpace(data)))
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("%s response is not JSON: %w", name, err)
+	}
+	return decoded, nil
+}
+
+func (b *Backend) authorize(req *http.Request) {
+	if b.credentials == nil {
+		return
+	}
+	switch b.spec.Auth {
+	case quantumv1.HTTPAuthBearer:
+		req.Header.Set("Authorization", "Bearer "+b.credentials.APIKey)
+	case quantumv1.HTTPAuthBasic:
+		req.SetBasicAuth(b.credentials.Extra["username"], b.credentials.Extra["password"])
+	case quantumv1.HTTPAuthHeader:
+		header := b.spec.AuthHeader
+		if header == "" {
+			header = "Authorization"
+		}
+		req.Header.Set(header, b.credentials.APIKey)
+	}
+}
+
+func render(name, text string, req Request) (string, error) {
+	tmpl, err := ParseTemplate(name, text)
+	if err != nil {
+		return "", err
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, req); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// lookup resolves a dotted path in a decoded JSON document
+func lookup(doc any, path string) (any, error) {
+	value := doc
+	for _, segment := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			next, ok := v[segment]
+			if !ok {
+				return nil, fmt.Errorf("no field %q in %q", segment, path)
+			}
+			value = next
+		case []any:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, fmt.Errorf("no element %q in %q", segment, path)
+			}
+			value = v[i]
+		default:
+			return nil, fmt.Errorf("cannot resolve %q in %q", segment, path)
+		}
+	}
+	return value, nil
+}
+
+// scalar renders a JSON scalar as a string
+func scalar(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generichttp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+var _ = Describe("Generic HTTP backend", func() {
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		state  string
+		queue  string
+		spec   *quantumv1.HTTPBackendSpec
+		seen   map[string]any
+		key    string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		state = "QUEUED"
+		queue = ""
+		seen = nil
+		key = ""
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /api/jobs", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&seen)).To(Succeed())
+			key = r.Header.Get("Idempotency-Key")
+			_, _ = w.Write([]byte(`{"data": {"job": {"id": 42}}}`))
+		})
+		mux.HandleFunc("GET /api/jobs/by-request/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if r.PathValue("id") != key {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data": {"job": {"id": 42}}}`))
+		})
+		mux.HandleFunc("GET /api/jobs/42", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"state": "` + state + `", "detail": "calibrating"` + queue + `}`))
+		})
+		mux.HandleFunc("GET /api/jobs/42/result", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"results": [{"counts": {"00": 510, "11": 514}}]}`))
+		})
+		server = httptest.NewServer(mux)
+
+		spec = &quantumv1.HTTPBackendSpec{
+			Submit: quantumv1.HTTPEndpoint{
+				URL:  server.URL + "/api/jobs",
+				Body: `{"program": {{ json .Circuit }}, "shots": {{ .Shots }}, "owner": {{ json .Namespace }}}`,
+			},
+			Status: quantumv1.HTTPEndpoint{URL: server.URL + "/api/jobs/{{ .JobID }}"},
+			Result: &quantumv1.HTTPEndpoint{URL: server.URL + "/api/jobs/{{ .JobID }}/result"},
+			Auth:   quantumv1.HTTPAuthBearer,
+			Mapping: quantumv1.HTTPResponseMapping{
+				JobID:           "data.job.id",
+				State:           "state",
+				CompletedStates: []string{"DONE"},
+				FailedStates:    []string{"ERROR"},
+				Message:         "detail",
+				Counts:          "results.0.counts",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newBackend := func() *Backend {
+		b := New("lab-qpu", spec, Request{Name: "bell", Namespace: "team-a"}, nil)
+		Expect(b.Authenticate(ctx, &backend.Credentials{APIKey: "secret"})).To(Succeed())
+		return b
+	}
+
+	It("should submit, poll and read results through the mapped endpoints", func() {
+		b := newBackend()
+
+		id, err := b.SubmitJob(ctx, &backend.QuantumJob{CircuitCode: "OPENQASM 3.0;", Shots: 1024})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*id).To(Equal(backend.JobID("42")))
+		Expect(seen).To(Equal(map[string]any{"program": "OPENQASM 3.0;", "shots": 1024.0, "owner": "team-a"}))
+
+		status, err := b.GetJobStatus(ctx, *id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Phase).To(Equal("Running"))
+		Expect(status.Message).To(Equal("calibrating"))
+
+		state = "DONE"
+		status, err = b.GetJobStatus(ctx, *id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Phase).To(Equal("Completed"))
+
+		result, err := b.GetJobResult(ctx, *id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Counts).To(Equal(map[string]int{"00": 510, "11": 514}))
+	})
+
+	It("should find a submission by its request ID", func() {
+		b := newBackend()
+		id, err := b.FindJob(ctx, "uid-1-0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(BeNil(), "nothing is found without a lookup endpoint")
+
+		spec.Lookup = &quantumv1.HTTPEndpoint{URL: server.URL + "/api/jobs/by-request/{{ .RequestID }}"}
+		id, err = b.FindJob(ctx, "uid-1-0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(BeNil())
+
+		_, err = b.SubmitJob(ctx, &backend.QuantumJob{CircuitCode: "OPENQASM 3.0;", Shots: 1024, RequestID: "uid-1-0"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key).To(Equal("uid-1-0"))
+		id, err = b.FindJob(ctx, "uid-1-0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(HaveValue(Equal(backend.JobID("42"))))
+	})
+
+	It("should report the queue position and estimated start of queued jobs", func() {
+		spec.Mapping.QueuedStates = []string{"QUEUED"}
+		spec.Mapping.QueuePosition = "queue.position"
+		spec.Mapping.EstimatedStartTime = "queue.start"
+		status, err := newBackend().GetJobStatus(ctx, "42")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Phase).To(Equal("Queued"))
+		By("ignoring details the response does not have")
+		Expect(status.QueuePosition).To(BeNil())
+		Expect(status.EstimatedStart).To(BeNil())
+
+		queue = `, "queue": {"position": 3, "start": "2025-06-01T12:30:00Z"}`
+		status, err = newBackend().GetJobStatus(ctx, "42")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.QueuePosition).To(HaveValue(Equal(3)))
+		Expect(status.EstimatedStart).To(HaveValue(BeTemporally("==", time.Date(2025, 6, 1, 12, 30, 0, 0, time.UTC))))
+	})
+
+	It("should pass the job's execution time limit", func() {
+		spec.Submit.Body = `{"program": {{ json .Circuit }}, "timeout": {{ .MaxExecutionSeconds }}}`
+		_, err := newBackend().SubmitJob(ctx, &backend.QuantumJob{CircuitCode: "OPENQASM 3.0;", MaxExecutionTime: 90 * time.Second})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(seen).To(HaveKeyWithValue("timeout", 90.0))
+	})
+
+	It("should pass the job's error mitigation", func() {
+		spec.Submit.Body = `{"program": {{ json .Circuit }}, "resilience": {{ .ResilienceLevel }}, "mitigation": {{ json .ErrorMitigation }}}`
+		_, err := newBackend().SubmitJob(ctx, &backend.QuantumJob{
+			CircuitCode: "OPENQASM 3.0;", ResilienceLevel: 2,
+			ErrorMitigation: backend.ErrorMitigation{DynamicalDecoupling: "XX", MeasurementMitigation: true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(seen).To(HaveKeyWithValue("resilience", 2.0))
+		Expect(seen).To(HaveKeyWithValue("mitigation",
+			map[string]any{"dynamicalDecoupling": "XX", "measurementMitigation": true}))
+	})
+
+	It("should report failed states", func() {
+		state = "ERROR"
+		status, err := newBackend().GetJobStatus(ctx, "42")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Phase).To(Equal("Failed"))
+	})
+
+	It("should surface HTTP errors", func() {
+		b := New("lab-qpu", spec, Request{}, nil)
+		Expect(b.Authenticate(ctx, &backend.Credentials{APIKey: "wrong"})).To(Succeed())
+		_, err := b.SubmitJob(ctx, &backend.QuantumJob{})
+		Expect(err).To(MatchError(ContainSubstring("401")))
+		Expect(err).To(MatchError(backend.ErrAuth))
+		Expect(backend.Permanent(err)).To(BeTrue())
+	})
+
+	It("should require credentials for authenticated backends", func() {
+		Expect(New("lab-qpu", spec, Request{}, nil).Authenticate(ctx, nil)).NotTo(Succeed())
+	})
+
+	It("should not cancel without a cancel endpoint", func() {
+		Expect(newBackend().CancelJob(ctx, "42")).To(MatchError(ErrNotSupported))
+	})
+})
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ibm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+var _ backend.CalibrationReader = &Backend{}
+
+// property is a calibrated value of a qubit or gate
+type property struct {
+	Name  string  `json:"name"`
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+}
+
+// backendProperties are the calibration data of a device
+type backendProperties struct {
+	BackendVersion string       `json:"backend_version"`
+	LastUpdateDate time.Time    `json:"last_update_date"`
+	Qubits         [][]property `json:"qubits"`
+	Gates          []struct {
+		Gate       string     `json:"gate"`
+		Qubits     []int      `json:"qubits"`
+		Parameters []property `json:"parameters"`
+	} `json:"gates"`
+}
+
+// timeUnits are the units coherence times are reported in
+var timeUnits = map[string]time.Duration{
+	"s":  time.Second,
+	"ms": time.Millisecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ns": time.Nanosecond,
+}
+
+// GetCalibration reads the device properties and summarizes them by the
+// median over qubits and two-qubit gates
+func (b *Backend) GetCalibration(ctx context.Context) (*backend.Calibration, error) {
+	var properties backendProperties
+	if err := b.do(ctx, http.MethodGet, "/v1/backends/"+url.PathEscape(b.name)+"/properties", nil, &properties); err != nil {
+		return nil, err
+	}
+
+	var t1, t2, readout, twoQubit []float64
+	for _, qubit := range properties.Qubits {
+		for _, p := range qubit {
+			switch p.Name {
+			case "T1":
+				t1 = append(t1, p.Value*float64(timeUnits[p.Unit]))
+			case "T2":
+				t2 = append(t2, p.Value*float64(timeUnits[p.Unit]))
+			case "readout_error":
+				readout = append(readout, p.Value)
+			}
+		}
+	}
+	for _, gate := range properties.Gates {
+		if len(gate.Qubits) != 2 {
+			continue
+		}
+		for _, p := range gate.Parameters {
+			if p.Name == "gate_error" {
+				twoQubit = append(twoQubit, p.Value)
+			}
+		}
+	}
+	return &backend.Calibration{
+		Version:             properties.BackendVersion,
+		Qubits:              len(properties.Qubits),
+		LastUpdated:         properties.LastUpdateDate,
+		MedianT1:            time.Duration(median(t1)),
+		MedianT2:            time.Duration(median(t2)),
+		MedianReadoutError:  median(readout),
+		MedianTwoQubitError: median(twoQubit),
+	}, nil
+}
+
+// median returns the median of the values, 0 if there are none
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	slices.Sort(values)
+	middle := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[middle]
+	}
+	return (values[middle-1] + values[middle]) / 2
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ibm runs circuits on IBM Quantum hardware through the Qiskit
+// Runtime REST API. Circuits are submitted to the Sampler V2 primitive as
+// OpenQASM programs that must already be transpiled for the backend (ISA
+// circuits); the API neither runs Python nor transpiles.
+package ibm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+const (
+	// DefaultURL is the Qiskit Runtime API of the us-east region
+	DefaultURL = "https://quantum.cloud.ibm.com/api"
+	// DefaultIAMURL exchanges IBM Cloud API keys for bearer tokens
+	DefaultIAMURL = "https://iam.cloud.ibm.com/identity/token"
+	// APIVersion is the dated version of the Qiskit Runtime API requested
+	APIVersion = "2025-05-01"
+	// PricePerSecond is the Pay-As-You-Go price of a second of quantum time in USD
+	PricePerSecond = 1.60
+	// RequestTagPrefix prefixes the job tag carrying the client request ID
+	// of its submission
+	RequestTagPrefix = "k8s-request:"
+)
+
+// defaultTimeout bounds each request
+const defaultTimeout = 30 * time.Second
+
+// maxResponseBytes bounds the size of a response body read into memory
+const maxResponseBytes = 64 << 20
+
+// tokenRefreshMargin renews bearer tokens this long before they expire
+const tokenRefreshMargin = 5 * time.Minute
+
+// Rough quantum time of a job, for estimates before it runs: a fixed
+// overhead per job plus the repetition time of each shot
+const (
+	jobOverhead = 2 * time.Second
+	shotTime    = 250 * time.Microsecond
+)
+
+// ErrNotQASM is returned when a circuit is not an OpenQASM program
+var ErrNotQASM = errors.New("ibm_quantum circuits must be OpenQASM programs transpiled for the backend")
+
+// Errors classifies the error responses of the Qiskit Runtime API by their
+// error code, or their HTTP status for responses without a known one
+var Errors = backend.Catalog{
+	Codes: map[string]error{
+		"1001": backend.ErrCircuitTooLarge, // payload larger than the maximum size
+		"1004": backend.ErrDeviceOffline,   // backend not available
+		"1012": backend.ErrQueueFull,       // maximum number of concurrent jobs reached
+		"1105": backend.ErrCircuitTooLarge, // more qubits than the backend has
+		"1108": backend.ErrCircuitTooLarge, // payload larger than the backend supports
+	},
+	Statuses: backend.HTTPStatuses,
+}
+
+// URL returns the Qiskit Runtime API serving a region, "" being us-east
+func URL(region string) string {
+	if region == "" || region == "us-east" {
+		return DefaultURL
+	}
+	return "https://" + region + ".quantum.cloud.ibm.com/api"
+}
+
+// IsQASM reports whether a circuit is an OpenQASM program
+func IsQASM(circuit string) bool {
+	for _, line := range strings.Split(circuit, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		return strings.HasPrefix(line, "OPENQASM")
+	}
+	return false
+}
+
+// Options configure where a Backend connects to
+type Options struct {
+	// URL of the Qiskit Runtime API, DefaultURL if empty
+	URL string
+	// IAMURL is the IBM Cloud IAM token endpoint, DefaultIAMURL if empty
+	IAMURL string
+	// Client sends the requests, a client with a 30s timeout if nil
+	Client *http.Client
+}
+
+// Backend is a backend.Backend for an IBM Quantum device
+type Backend struct {
+	name     string
+	instance string
+	url      string
+	iamURL   string
+	client   *http.Client
+
+	mu      sync.Mutex
+	apiKey  string
+	token   string
+	expires time.Time
+}
+
+var _ backend.Backend = &Backend{}
+
+// New returns a Backend for the named device of a Qiskit Runtime instance,
+// identified by its IBM Cloud CRN
+func New(name, instance string, opts Options) *Backend {
+	b := &Backend{name: name, instance: instance, url: opts.URL, iamURL: opts.IAMURL, client: opts.Client}
+	if b.url == "" {
+		b.url = DefaultURL
+	}
+	if b.iamURL == "" {
+		b.iamURL = DefaultIAMURL
+	}
+	if b.client == nil {
+		b.client = &http.Client{Timeout: defaultTimeout}
+	}
+	b.url = strings.TrimSuffix(b.url, "/")
+	return b
+}
+
+// Name returns the device name, e.g. ibm_brisbane
+func (b *Backend) Name() string { return b.name }
+
+// Type returns ibm_quantum
+func (b *Backend) Type() backend.BackendType { return backend.IBMQuantum }
+
+// Provider returns the provider name
+func (b *Backend) Provider() string { return "ibm" }
+
+// GetCapabilities reads the device configuration
+func (b *Backend) GetCapabilities(ctx context.Context) (*backend.BackendCapabilities, error) {
+	var config struct {
+		NumQubits             int      `json:"n_qubits"`
+		MaxShots              int      `json:"max_shots"`
+		BasisGates            []string `json:"basis_gates"`
+		CouplingMap           [][]int  `json:"coupling_map"`
+		SupportedInstructions []string `json:"supported_instructions"`
+	}
+	if err := b.do(ctx, http.MethodGet, "/v1/backends/"+url.PathEscape(b.name)+"/configuration", nil, &config); err != nil {
+		return nil, err
+	}
+	capabilities := &backend.BackendCapabilities{
+		MaxQubits:    config.NumQubits,
+		MaxShots:     config.MaxShots,
+		GateSet:      config.BasisGates,
+		Connectivity: config.CouplingMap,
+	}
+	for _, instruction := range config.SupportedInstructions {
+		if instruction == "if_else" {
+			capabilities.SupportsDynamicCircuits = true
+		}
+	}
+	return capabilities, nil
+}
+
+// backendStatus is the status of a device
+type backendStatus struct {
+	State       bool   `json:"state"`
+	Status      string `json:"status"`
+	QueueLength int    `json:"length_queue"`
+}
+
+// IsAvailable reports whether the device is online and accepting jobs
+func (b *Backend) IsAvailable(ctx context.Context) (bool, error) {
+	var status backendStatus
+	if err := b.do(ctx, http.MethodGet, "/v1/backends/"+url.PathEscape(b.name)+"/status", nil, &status); err != nil {
+		return false, err
+	}
+	return status.State && status.Status == "active", nil
+}
+
+// GetQueueStatus reports the number of jobs queued on the device
+func (b *Backend) GetQueueStatus(ctx context.Context) (*backend.QueueStatus, error) {
+	var status backendStatus
+	if err := b.do(ctx, http.MethodGet, "/v1/backends/"+url.PathEscape(b.name)+"/status", nil, &status); err != nil {
+		return nil, err
+	}
+	return &backend.QueueStatus{QueueLength: status.QueueLength}, nil
+}
+
+// SubmitJob runs the circuit with the Sampler V2 primitive
+func (b *Backend) SubmitJob(ctx context.Context, job *backend.QuantumJob) (*backend.JobID, error) {
+	if !IsQASM(job.CircuitCode) {
+		return nil, ErrNotQASM
+	}
+	params := map[string]any{
+		// A pub is a circuit, its parameter values and its shots
+		"pubs":    [][]any{{job.CircuitCode, nil, job.Shots}},
+		"version": 2,
+	}
+	if options := samplerOptions(job.ErrorMitigation); options != nil {
+		params["options"] = options
+	}
+	request := map[string]any{
+		"program_id": "sampler",
+		"backend":    b.name,
+		"params":     params,
+	}
+	tags := job.Tags
+	if job.RequestID != "" {
+		tags = append(slices.Clip(tags), RequestTagPrefix+job.RequestID)
+	}
+	if len(tags) > 0 {
+		request["tags"] = tags
+	}
+	if job.SessionID != "" {
+		request["session_id"] = job.SessionID
+	}
+	if job.MaxExecutionTime > 0 {
+		request["max_execution_time"] = int(job.MaxExecutionTime.Seconds())
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	if err := b.do(ctx, http.MethodPost, "/v1/jobs", request, &response); err != nil {
+		return nil, err
+	}
+	if response.ID == "" {
+		return nil, errors.New("submit response: empty job ID")
+	}
+	id := backend.JobID(response.ID)
+	return &id, nil
+}
+
+// GetJobStatus maps the Runtime job status onto Queued, Running,
+// Completed, Failed and Cancelled
+func (b *Backend) GetJobStatus(ctx context.Context, jobID backend.JobID) (*backend.JobStatus, error) {
+	var response struct {
+		Status string `json:"status"`
+		State  struct {
+			Reason string `json:"reason"`
+		} `json:"state"`
+		EstimatedStartTime *time.Time `json:"estimated_start_time"`
+	}
+	if err := b.do(ctx, http.MethodGet, jobPath(jobID), nil, &response); err != nil {
+		return nil, err
+	}
+
+	status := &backend.JobStatus{ID: jobID, Phase: "Running", Message: response.Status,
+		EstimatedStart: response.EstimatedStartTime}
+	switch state := strings.ToLower(response.Status); {
+	case state == "queued":
+		status.Phase = "Queued"
+	case state == "completed":
+		status.Phase = "Completed"
+	case state == "failed":
+		status.Phase = "Failed"
+	case strings.HasPrefix(state, "cancelled"):
+		status.Phase = "Cancelled"
+	}
+	if response.State.Reason != "" {
+		status.Message = response.Status + ": " + response.State.Reason
+	}
+	return status, nil
+}
+
+// GetJobResult decodes the measurement counts of the Sampler result
+func (b *Backend) GetJobResult(ctx context.Context, jobID backend.JobID) (*backend.JobResult, error) {
+	var raw json.RawMessage
+	if err := b.do(ctx, http.MethodGet, jobPath(jobID)+"/results", nil, &raw); err != nil {
+		return nil, err
+	}
+	counts, err := SamplerCounts(raw)
+	if err != nil {
+		return nil, fmt.Errorf("result response: %w", err)
+	}
+	return &backend.JobResult{JobID: jobID, Success: true, Counts: counts, RawData: raw}, nil
+}
+
+// CancelJob cancels a queued or running job
+func (b *Backend) CancelJob(ctx context.Context, jobID backend.JobID) error {
+	return b.do(ctx, http.MethodPost, jobPath(jobID)+"/cancel", nil, nil)
+}
+
+// EstimateCost prices a rough estimate of the job's quantum time at the
+// Pay-As-You-Go rate. Jobs on plans billed otherwise cost nothing extra.
+func (b *Backend) EstimateCost(ctx context.Context, job *backend.QuantumJob) (*backend.CostEstimate, error) {
+	quantumTime := jobOverhead + time.Duration(job.Shots)*shotTime
+	return &backend.CostEstimate{
+		Amount:      quantumTime.Seconds() * PricePerSecond,
+		Currency:    "USD",
+		QuantumTime: quantumTime,
+		Confidence:  0.5,
+	}, nil
+}
+
+// GetActualCost prices the quantum time the job was billed for
+func (b *Backend) GetActualCost(ctx context.Context, jobID backend.JobID) (*backend.Cost, error) {
+	var metrics struct {
+		Usage struct {
+			QuantumSeconds float64 `json:"quantum_seconds"`
+		} `json:"usage"`
+	}
+	if err := b.do(ctx, http.MethodGet, jobPath(jobID)+"/metrics", nil, &metrics); err != nil {
+		return nil, err
+	}
+	amount := metrics.Usage.QuantumSeconds * PricePerSecond
+	return &backend.Cost{
+		Amount:      amount,
+		Currency:    "USD",
+		QuantumTime: time.Duration(metrics.Usage.QuantumSeconds * float64(time.Second)),
+		Breakdown:   map[string]float64{"quantum_time": amount},
+	}, nil
+}
+
+// Authenticate exchanges the API key for a bearer token, so a bad key fails
+// before anything is submitted
+func (b *Backend) Authenticate(ctx context.Context, credentials *backend.Credentials) error {
+	if credentials == nil || credentials.APIKey == "" {
+		return errors.New("ibm_quantum backends require an api-key")
+	}
+	if credentials.Instance != "" {
+		b.instance = credentials.Instance
+	}
+	if b.instance == "" {
+		return errors.New("ibm_quantum backends require the instance CRN")
+	}
+	b.mu.Lock()
+	b.apiKey = credentials.APIKey
+	b.mu.Unlock()
+	return b.RefreshCredentials(ctx)
+}
+
+// RefreshCredentials exchanges the API key for a new bearer token
+func (b *Backend) RefreshCredentials(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.refresh(ctx)
+}
+
+// refresh requests a bearer token; b.mu must be held
+func (b *Backend) refresh(ctx context.Context) error {
+	if b.apiKey == "" {
+		return errors.New("not authenticated")
+	}
+	form := url.Values{
+		"grant_type": {"urn:ibm:params:oauth:grant-type:apikey"},
+		"apikey":     {b.apiKey},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.iamURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := b.send(req, "token", &token); err != nil {
+		// IAM rejects unknown, revoked and malformed API keys alike
+		var providerErr *backend.Error
+		if errors.As(err, &providerErr) && providerErr.StatusCode < http.StatusInternalServerError {
+			providerErr.Class = backend.ErrAuth
+		}
+		return err
+	}
+	if token.AccessToken == "" {
+		return errors.New("token response: no access token")
+	}
+	b.token = token.AccessToken
+	b.expires = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return nil
+}
+
+// bearer returns a token valid for at least tokenRefreshMargin
+func (b *Backend) bearer(ctx context.Context) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.token == "" || time.Until(b.expires) < tokenRefreshMargin {
+		if err := b.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return b.token, nil
+}
+
+func jobPath(jobID backend.JobID) string {
+	return "/v1/jobs/" + url.PathEscape(string(jobID))
+}
+
+// do sends an authenticated API request with an optional JSON body and
+// decodes the JSON response into out, if not nil
+func (b *Backend) do(ctx context.Context, method, path string, in, out any) error {
+	token, err := b.bearer(ctx)
+	if err != nil {
+		return err
+	}
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, b.url+path, body)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Service-CRN", b.instance)
+	req.Header.Set("IBM-API-Version", APIVersion)
+	return b.send(req, method+" "+path, out)
+}
+
+// send sends a request and decodes the JSON response into out, if not nil
+func (b *Backend) send(req *http.Request, name string, out any) error {
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("%s response: %w", name, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return Errors.Error(resp.StatusCode, errorCode(data), resp.Header.Get("Retry-After"),
+			fmt.Sprintf("%s: %s: %s", name, resp.Status, bytes.TrimSpace(data)))
+	}
+	if out == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("%s response is not JSON: %w", name, err)
+	}
+	return nil
+}
+
+// errorCode returns the code of the first error of an error response, ""
+// if it has none
+func errorCode(data []byte) string {
+	var response struct {
+		Errors []struct {
+			Code json.RawMessage `json:"code"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(data, &response) != nil || len(response.Errors) == 0 {
+		return ""
+	}
+	return strings.Trim(string(response.Errors[0].Code), `"`)
+}
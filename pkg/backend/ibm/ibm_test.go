@@ -0,0 +1,379 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ibm
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+const bellQASM = `OPENQASM 3.0;
+include "stdgates.inc";
+bit[2] meas;
+rz(pi/2) $0;
+sx $0;
+rz(pi/2) $0;
+cz $0, $1;
+meas[0] = measure $0;
+meas[1] = measure $1;
+`
+
+// encodeNPY serializes a uint8 array the way numpy.save does
+func encodeNPY(rows [][]byte) []byte {
+	header := fmt.Sprintf("{'descr': '|u1', 'fortran_order': False, 'shape': (%d, %d), }", len(rows), len(rows[0]))
+	// The header is padded with spaces and a newline to a multiple of 64 bytes
+	pad := 64 - (10+len(header)+1)%64
+	header += strings.Repeat(" ", pad%64) + "\n"
+
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+	for _, row := range rows {
+		buf.Write(row)
+	}
+	return buf.Bytes()
+}
+
+// encodeBitArray encodes a BitArray field as qiskit-ibm-runtime's RuntimeEncoder does
+func encodeBitArray(numBits int, rows [][]byte) string {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	_, _ = zw.Write(encodeNPY(rows))
+	_ = zw.Close()
+	return fmt.Sprintf(`{"__type__": "BitArray", "__value__": {"array": {"__type__": "ndarray", "__value__": %q}, "num_bits": %d}}`,
+		base64.StdEncoding.EncodeToString(compressed.Bytes()), numBits)
+}
+
+// samplerResult wraps DataBin fields into a Sampler V2 PrimitiveResult
+func samplerResult(fields string) string {
+	return `{"__type__": "PrimitiveResult", "__value__": {"pub_results": [{"__type__": "SamplerPubResult", "__value__": {` +
+		`"data": {"__type__": "DataBin", "__value__": {"shape": [], "fields": {` + fields + `}}}, "metadata": {}}}], "metadata": {"version": 2}}}`
+}
+
+var _ = Describe("IBM Quantum backend", func() {
+	var (
+		ctx       context.Context
+		server    *httptest.Server
+		status    string
+		submitted map[string]any
+		exchanges int
+		cancelled bool
+		rejection string
+		adapter   *Backend
+
+		sessionClosed bool
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		status = "Queued"
+		submitted = nil
+		exchanges = 0
+		cancelled = false
+		rejection = ""
+		sessionClosed = false
+
+		authorized := func(r *http.Request) bool {
+			return r.Header.Get("Authorization") == "Bearer token-1" &&
+				r.Header.Get("Service-CRN") == "crn:v1:bluemix:public:quantum-computing:us-east:a/abc:def::" &&
+				r.Header.Get("IBM-API-Version") == APIVersion
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /identity/token", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.ParseForm()).To(Succeed())
+			if r.Form.Get("apikey") != "secret" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			exchanges++
+			_, _ = w.Write([]byte(`{"access_token": "token-1", "expires_in": 3600}`))
+		})
+		mux.HandleFunc("POST /api/v1/jobs", func(w http.ResponseWriter, r *http.Request) {
+			if !authorized(r) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&submitted)).To(Succeed())
+			if rejection != "" {
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(rejection))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id": "d1abc", "backend": "ibm_torino"}`))
+		})
+		mux.HandleFunc("GET /api/v1/jobs/d1abc", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"id": "d1abc", "status": "` + status + `", "state": {"status": "` + status + `"}}`))
+		})
+		mux.HandleFunc("GET /api/v1/jobs/d1abc/results", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(samplerResult(`"meas": ` + encodeBitArray(2, [][]byte{{0}, {3}, {3}, {0}, {3}}))))
+		})
+		mux.HandleFunc("GET /api/v1/jobs/d1abc/metrics", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"usage": {"quantum_seconds": 5, "seconds": 5}}`))
+		})
+		mux.HandleFunc("POST /api/v1/jobs/d1abc/cancel", func(w http.ResponseWriter, r *http.Request) {
+			cancelled = authorized(r)
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("GET /api/v1/jobs", func(w http.ResponseWriter, r *http.Request) {
+			if tags := r.URL.Query()["tags"]; len(tags) == 1 && strings.HasPrefix(tags[0], RequestTagPrefix) {
+				if tags[0] != RequestTagPrefix+"uid-1-0" {
+					_, _ = w.Write([]byte(`{"jobs": [], "count": 0}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"jobs": [{"id": "d1abc", "status": "Queued", "tags": ["` + tags[0] + `"]}], "count": 1}`))
+				return
+			}
+			Expect(r.URL.Query()["tags"]).To(Equal([]string{"qiskit-operator", "k8s-cluster:c1"}))
+			Expect(r.URL.Query().Get("limit")).To(Equal("50"))
+			_, _ = w.Write([]byte(`{"jobs": [{"id": "d1abc", "status": "Completed", "session_id": "s1", ` +
+				`"tags": ["qiskit-operator", "k8s-cluster:c1"], "created": "2025-06-01T10:00:00Z"}], "count": 1}`))
+		})
+		mux.HandleFunc("POST /api/v1/sessions", func(w http.ResponseWriter, r *http.Request) {
+			if !authorized(r) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&submitted)).To(Succeed())
+			_, _ = w.Write([]byte(`{"id": "s1", "mode": "dedicated"}`))
+		})
+		mux.HandleFunc("GET /api/v1/sessions/s1", func(w http.ResponseWriter, r *http.Request) {
+			state := "inactive"
+			if sessionClosed {
+				state = "closed"
+			}
+			_, _ = w.Write([]byte(`{"id": "s1", "state": "` + state + `", "mode": "dedicated"}`))
+		})
+		mux.HandleFunc("DELETE /api/v1/sessions/s1/close", func(w http.ResponseWriter, r *http.Request) {
+			sessionClosed = authorized(r)
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("GET /api/v1/backends/ibm_torino/status", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"state": true, "status": "active", "length_queue": 7}`))
+		})
+		mux.HandleFunc("GET /api/v1/backends/ibm_torino/properties", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"backend_version": "1.3.7", "last_update_date": "2025-06-01T08:00:00Z", "qubits": [` +
+				`[{"name": "T1", "unit": "us", "value": 100}, {"name": "T2", "unit": "us", "value": 80}, {"name": "readout_error", "unit": "", "value": 0.01}],` +
+				`[{"name": "T1", "unit": "us", "value": 200}, {"name": "T2", "unit": "us", "value": 120}, {"name": "readout_error", "unit": "", "value": 0.03}],` +
+				`[{"name": "T1", "unit": "ms", "value": 0.3}, {"name": "T2", "unit": "us", "value": 90}, {"name": "readout_error", "unit": "", "value": 0.02}]], ` +
+				`"gates": [{"gate": "sx", "qubits": [0], "parameters": [{"name": "gate_error", "value": 0.0002}]},` +
+				`{"gate": "cz", "qubits": [0, 1], "parameters": [{"name": "gate_error", "value": 0.004}]},` +
+				`{"gate": "cz", "qubits": [1, 2], "parameters": [{"name": "gate_error", "value": 0.006}]}]}`))
+		})
+		server = httptest.NewServer(mux)
+
+		adapter = New("ibm_torino", "crn:v1:bluemix:public:quantum-computing:us-east:a/abc:def::", Options{
+			URL:    server.URL + "/api",
+			IAMURL: server.URL + "/identity/token",
+		})
+		Expect(adapter.Authenticate(ctx, &backend.Credentials{APIKey: "secret"})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should reject a bad API key", func() {
+		other := New("ibm_torino", "crn:v1:x", Options{URL: server.URL + "/api", IAMURL: server.URL + "/identity/token"})
+		err := other.Authenticate(ctx, &backend.Credentials{APIKey: "wrong"})
+		Expect(err).To(MatchError(ContainSubstring("400")))
+		Expect(err).To(MatchError(backend.ErrAuth))
+	})
+
+	It("should classify rejected submissions by their error code", func() {
+		rejection = `{"errors": [{"code": 1012, "message": "Max concurrent jobs reached"}], "status_code": 400}`
+		_, err := adapter.SubmitJob(ctx, &backend.QuantumJob{CircuitCode: bellQASM, Shots: 5})
+		Expect(err).To(MatchError(backend.ErrQueueFull))
+		Expect(err).To(MatchError(ContainSubstring("Max concurrent jobs reached")))
+		Expect(backend.Transient(err)).To(BeTrue())
+		Expect(backend.RetryAfter(err)).To(Equal(30 * time.Second))
+
+		rejection = `{"errors": [{"code": 1105, "message": "Too many qubits"}], "status_code": 400}`
+		_, err = adapter.SubmitJob(ctx, &backend.QuantumJob{CircuitCode: bellQASM, Shots: 5})
+		Expect(err).To(MatchError(backend.ErrCircuitTooLarge))
+		Expect(backend.Permanent(err)).To(BeTrue())
+
+		rejection = `{"errors": [{"code": 9999, "message": "Something else"}], "status_code": 400}`
+		_, err = adapter.SubmitJob(ctx, &backend.QuantumJob{CircuitCode: bellQASM, Shots: 5})
+		Expect(backend.Transient(err) || backend.Permanent(err)).To(BeFalse())
+	})
+
+	It("should run a circuit through the Sampler primitive", func() {
+		id, err := adapter.SubmitJob(ctx, &backend.QuantumJob{
+			CircuitCode: bellQASM, Shots: 5, Tags: []string{"team-a"}, MaxExecutionTime: 10 * time.Minute,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*id).To(Equal(backend.JobID("d1abc")))
+		Expect(submitted).To(HaveKeyWithValue("program_id", "sampler"))
+		Expect(submitted).To(HaveKeyWithValue("max_execution_time", BeNumerically("==", 600)))
+		Expect(submitted).To(HaveKeyWithValue("backend", "ibm_torino"))
+		Expect(submitted).To(HaveKeyWithValue("tags", ConsistOf("team-a")))
+		params := submitted["params"].(map[string]any)
+		Expect(params).To(HaveKeyWithValue("version", BeNumerically("==", 2)))
+		Expect(params["pubs"]).To(Equal([]any{[]any{bellQASM, nil, float64(5)}}))
+		Expect(params).NotTo(HaveKey("options"))
+
+		jobStatus, err := adapter.GetJobStatus(ctx, *id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jobStatus.Phase).To(Equal("Queued"))
+
+		status = "Completed"
+		jobStatus, err = adapter.GetJobStatus(ctx, *id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jobStatus.Phase).To(Equal("Completed"))
+
+		result, err := adapter.GetJobResult(ctx, *id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Counts).To(Equal(map[string]int{"00": 2, "11": 3}))
+
+		cost, err := adapter.GetActualCost(ctx, *id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cost.Amount).To(BeNumerically("~", 5*PricePerSecond))
+
+		Expect(exchanges).To(Equal(1), "the token is reused until it nears expiry")
+	})
+
+	It("should pass error suppression to the Sampler and report what it applies", func() {
+		job := &backend.QuantumJob{
+			CircuitCode: bellQASM, Shots: 5, ResilienceLevel: 1,
+			ErrorMitigation: backend.ErrorMitigation{
+				DynamicalDecoupling: "XY4", GateTwirling: true, MeasurementMitigation: true,
+			},
+		}
+		_, err := adapter.SubmitJob(ctx, job)
+		Expect(err).NotTo(HaveOccurred())
+		options := submitted["params"].(map[string]any)["options"].(map[string]any)
+		Expect(options).To(HaveKeyWithValue("dynamical_decoupling",
+			map[string]any{"enable": true, "sequence_type": "XY4"}))
+		Expect(options).To(HaveKeyWithValue("twirling",
+			map[string]any{"enable_gates": true, "enable_measure": false}))
+		Expect(options).NotTo(HaveKey("resilience_level"))
+
+		Expect(adapter.Mitigations(job)).To(Equal([]string{"dynamical-decoupling:XY4", "gate-twirling"}),
+			"the Sampler neither has a resilience level nor mitigates readout errors")
+	})
+
+	It("should refuse circuits that are not OpenQASM", func() {
+		_, err := adapter.SubmitJob(ctx, &backend.QuantumJob{CircuitCode: "from qiskit import QuantumCircuit", Shots: 5})
+		Expect(err).To(MatchError(ErrNotQASM))
+	})
+
+	It("should map cancelled jobs and cancel on request", func() {
+		status = "Cancelled - Ran too long"
+		jobStatus, err := adapter.GetJobStatus(ctx, "d1abc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jobStatus.Phase).To(Equal("Cancelled"))
+
+		Expect(adapter.CancelJob(ctx, "d1abc")).To(Succeed())
+		Expect(cancelled).To(BeTrue())
+	})
+
+	It("should find and close sessions of tagged jobs", func() {
+		jobs, err := adapter.ListJobs(ctx, []string{"qiskit-operator", "k8s-cluster:c1"}, 50)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jobs).To(HaveLen(1))
+		Expect(jobs[0].SessionID).To(Equal("s1"))
+		Expect(jobs[0].Created.IsZero()).To(BeFalse())
+
+		session, err := adapter.GetSession(ctx, "s1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(session.Closed()).To(BeFalse())
+
+		Expect(adapter.CloseSession(ctx, "s1")).To(Succeed())
+		session, err = adapter.GetSession(ctx, "s1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(session.Closed()).To(BeTrue())
+	})
+
+	It("should tag submissions with their request ID and find them by it", func() {
+		_, err := adapter.SubmitJob(ctx, &backend.QuantumJob{
+			CircuitCode: bellQASM, Shots: 5, Tags: []string{"team-a"}, RequestID: "uid-1-0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(submitted).To(HaveKeyWithValue("tags", ConsistOf("team-a", "k8s-request:uid-1-0")))
+
+		id, err := adapter.FindJob(ctx, "uid-1-0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(HaveValue(Equal(backend.JobID("d1abc"))))
+
+		id, err = adapter.FindJob(ctx, "uid-1-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(BeNil())
+	})
+
+	It("should open sessions and submit jobs into them", func() {
+		session, err := adapter.OpenSession(ctx, "dedicated", 2*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(session.ID).To(Equal("s1"))
+		Expect(session.State).To(Equal(SessionOpen))
+		Expect(submitted).To(HaveKeyWithValue("backend", "ibm_torino"))
+		Expect(submitted).To(HaveKeyWithValue("mode", "dedicated"))
+		Expect(submitted).To(HaveKeyWithValue("max_ttl", BeNumerically("==", 7200)))
+
+		_, err = adapter.SubmitJob(ctx, &backend.QuantumJob{CircuitCode: bellQASM, Shots: 5, SessionID: session.ID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(submitted).To(HaveKeyWithValue("session_id", "s1"))
+	})
+
+	It("should report the device queue", func() {
+		available, err := adapter.IsAvailable(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(available).To(BeTrue())
+		queue, err := adapter.GetQueueStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(queue.QueueLength).To(Equal(7))
+	})
+
+	It("should summarize the device calibration by its medians", func() {
+		calibration, err := adapter.GetCalibration(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calibration.Version).To(Equal("1.3.7"))
+		Expect(calibration.Qubits).To(Equal(3))
+		Expect(calibration.LastUpdated).To(Equal(time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)))
+		Expect(calibration.MedianT1).To(Equal(200 * time.Microsecond))
+		Expect(calibration.MedianT2).To(Equal(90 * time.Microsecond))
+		Expect(calibration.MedianReadoutError).To(BeNumerically("~", 0.02, 1e-9))
+		Expect(calibration.MedianTwoQubitError).To(BeNumerically("~", 0.005, 1e-9))
+	})
+
+	It("should join classical registers with the first rightmost", func() {
+		result := samplerResult(`"c": ` + encodeBitArray(1, [][]byte{{1}, {0}}) + `, "d": ` + encodeBitArray(10, [][]byte{{2, 1}, {0, 0}}))
+		counts, err := SamplerCounts([]byte(result))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counts).To(Equal(map[string]int{"10000000011": 1, "00000000000": 1}))
+	})
+
+	It("should pick the regional endpoint", func() {
+		Expect(URL("")).To(Equal(DefaultURL))
+		Expect(URL("eu-de")).To(Equal("https://eu-de.quantum.cloud.ibm.com/api"))
+	})
+})
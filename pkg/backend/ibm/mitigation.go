@@ -0,0 +1,62 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ibm
+
+import (
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+var _ backend.Mitigator = &Backend{}
+
+// samplerOptions returns the Sampler V2 options applying the job's error
+// suppression, nil if it requests none. The Sampler has no resilience level
+// and does not mitigate readout errors; those are left to the estimator.
+func samplerOptions(mitigation backend.ErrorMitigation) map[string]any {
+	options := map[string]any{}
+	if mitigation.DynamicalDecoupling != "" {
+		options["dynamical_decoupling"] = map[string]any{
+			"enable":        true,
+			"sequence_type": mitigation.DynamicalDecoupling,
+		}
+	}
+	if mitigation.GateTwirling || mitigation.MeasureTwirling {
+		options["twirling"] = map[string]any{
+			"enable_gates":   mitigation.GateTwirling,
+			"enable_measure": mitigation.MeasureTwirling,
+		}
+	}
+	if len(options) == 0 {
+		return nil
+	}
+	return options
+}
+
+// Mitigations returns the mitigations the Sampler applies to the job: its
+// dynamical decoupling and twirling
+func (b *Backend) Mitigations(job *backend.QuantumJob) []string {
+	var applied []string
+	if sequence := job.ErrorMitigation.DynamicalDecoupling; sequence != "" {
+		applied = append(applied, backend.MitigationDynamicalDecoupling+":"+sequence)
+	}
+	if job.ErrorMitigation.GateTwirling {
+		applied = append(applied, backend.MitigationGateTwirling)
+	}
+	if job.ErrorMitigation.MeasureTwirling {
+		applied = append(applied, backend.MitigationMeasureTwirling)
+	}
+	return applied
+}
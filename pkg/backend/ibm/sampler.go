@@ -0,0 +1,252 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ibm
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// encoded is a value serialized by qiskit-ibm-runtime's RuntimeEncoder
+type encoded struct {
+	Type  string          `json:"__type__"`
+	Value json.RawMessage `json:"__value__"`
+}
+
+// bitArray holds the bits a classical register measured in every shot
+type bitArray struct {
+	numBits int
+	// rows holds each shot's bits as big-endian bytes
+	rows [][]byte
+}
+
+// SamplerCounts returns the measurement counts of the first pub of a
+// Sampler V2 result. Bitstrings join the classical registers with the first
+// register rightmost, as Qiskit prints them.
+func SamplerCounts(data []byte) (map[string]int, error) {
+	var result encoded
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	if result.Type != "PrimitiveResult" {
+		return nil, fmt.Errorf("expected a PrimitiveResult, got %q", result.Type)
+	}
+	var primitive struct {
+		PubResults []encoded `json:"pub_results"`
+	}
+	if err := json.Unmarshal(result.Value, &primitive); err != nil {
+		return nil, err
+	}
+	if len(primitive.PubResults) == 0 {
+		return nil, errors.New("result has no pub results")
+	}
+
+	var pub struct {
+		Data encoded `json:"data"`
+	}
+	if err := json.Unmarshal(primitive.PubResults[0].Value, &pub); err != nil {
+		return nil, err
+	}
+	if pub.Data.Type != "DataBin" {
+		return nil, fmt.Errorf("expected a DataBin, got %q", pub.Data.Type)
+	}
+	var bin struct {
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(pub.Data.Value, &bin); err != nil {
+		return nil, err
+	}
+	registers, err := bitArrays(bin.Fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(registers) == 0 {
+		return nil, errors.New("result has no measurements")
+	}
+
+	shots := len(registers[0].rows)
+	counts := map[string]int{}
+	for shot := 0; shot < shots; shot++ {
+		var bitstring strings.Builder
+		for i := len(registers) - 1; i >= 0; i-- {
+			if len(registers[i].rows) != shots {
+				return nil, errors.New("registers were measured a different number of times")
+			}
+			bitstring.WriteString(registers[i].bitstring(shot))
+		}
+		counts[bitstring.String()]++
+	}
+	return counts, nil
+}
+
+// bitArrays decodes the BitArray fields of a DataBin in the order they
+// appear, which is the order of the circuit's classical registers
+func bitArrays(fields json.RawMessage) ([]bitArray, error) {
+	dec := json.NewDecoder(bytes.NewReader(fields))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("DataBin fields are not an object")
+	}
+	var registers []bitArray
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, _ := tok.(string)
+		var field encoded
+		if err := dec.Decode(&field); err != nil {
+			return nil, err
+		}
+		if field.Type != "BitArray" {
+			continue
+		}
+		register, err := decodeBitArray(field.Value)
+		if err != nil {
+			return nil, fmt.Errorf("register %s: %w", name, err)
+		}
+		registers = append(registers, register)
+	}
+	return registers, nil
+}
+
+func decodeBitArray(value json.RawMessage) (bitArray, error) {
+	var v struct {
+		Array   encoded `json:"array"`
+		NumBits int     `json:"num_bits"`
+	}
+	if err := json.Unmarshal(value, &v); err != nil {
+		return bitArray{}, err
+	}
+	if v.Array.Type != "ndarray" {
+		return bitArray{}, fmt.Errorf("expected an ndarray, got %q", v.Array.Type)
+	}
+	var b64 string
+	if err := json.Unmarshal(v.Array.Value, &b64); err != nil {
+		return bitArray{}, errors.New("ndarray is not base64 encoded")
+	}
+	compressed, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return bitArray{}, err
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return bitArray{}, err
+	}
+	defer zr.Close()
+	npy, err := io.ReadAll(io.LimitReader(zr, maxResponseBytes))
+	if err != nil {
+		return bitArray{}, err
+	}
+
+	shape, data, err := parseNPY(npy)
+	if err != nil {
+		return bitArray{}, err
+	}
+	if len(shape) < 2 {
+		return bitArray{}, fmt.Errorf("unexpected shape %v", shape)
+	}
+	width := shape[len(shape)-1]
+	if width*8 < v.NumBits {
+		return bitArray{}, fmt.Errorf("%d bytes cannot hold %d bits", width, v.NumBits)
+	}
+	register := bitArray{numBits: v.NumBits}
+	for offset := 0; offset+width <= len(data) && width > 0; offset += width {
+		register.rows = append(register.rows, data[offset:offset+width])
+	}
+	return register, nil
+}
+
+// bitstring renders a shot's bits, the most significant first
+func (a bitArray) bitstring(shot int) string {
+	row := a.rows[shot]
+	out := make([]byte, a.numBits)
+	for i := 0; i < a.numBits; i++ {
+		bit := row[len(row)-1-i/8] >> (i % 8) & 1
+		out[a.numBits-1-i] = '0' + bit
+	}
+	return string(out)
+}
+
+var (
+	npyMagic   = []byte("\x93NUMPY")
+	npyDescr   = regexp.MustCompile(`'descr':\s*'([^']*)'`)
+	npyFortran = regexp.MustCompile(`'fortran_order':\s*(True|False)`)
+	npyShape   = regexp.MustCompile(`'shape':\s*\(([^)]*)\)`)
+)
+
+// parseNPY reads a C-ordered uint8 array in NumPy's .npy format, returning
+// its shape and data
+func parseNPY(npy []byte) ([]int, []byte, error) {
+	if !bytes.HasPrefix(npy, npyMagic) || len(npy) < 10 {
+		return nil, nil, errors.New("not a NumPy array")
+	}
+	var headerLen, start int
+	switch npy[6] {
+	case 1:
+		headerLen, start = int(binary.LittleEndian.Uint16(npy[8:10])), 10
+	case 2, 3:
+		if len(npy) < 12 {
+			return nil, nil, errors.New("truncated NumPy array")
+		}
+		headerLen, start = int(binary.LittleEndian.Uint32(npy[8:12])), 12
+	default:
+		return nil, nil, fmt.Errorf("unsupported NumPy format version %d", npy[6])
+	}
+	if len(npy) < start+headerLen {
+		return nil, nil, errors.New("truncated NumPy array")
+	}
+	header := string(npy[start : start+headerLen])
+
+	descr := npyDescr.FindStringSubmatch(header)
+	if descr == nil || strings.TrimLeft(descr[1], "|<>=") != "u1" {
+		return nil, nil, fmt.Errorf("expected a uint8 array, got header %s", strings.TrimSpace(header))
+	}
+	if fortran := npyFortran.FindStringSubmatch(header); fortran == nil || fortran[1] != "False" {
+		return nil, nil, errors.New("expected a C-ordered array")
+	}
+	match := npyShape.FindStringSubmatch(header)
+	if match == nil {
+		return nil, nil, errors.New("NumPy header has no shape")
+	}
+	var shape []int
+	size := 1
+	for _, dim := range strings.Split(match[1], ",") {
+		if dim = strings.TrimSpace(dim); dim == "" {
+			continue
+		}
+		n, err := strconv.Atoi(dim)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid shape %q", match[1])
+		}
+		shape = append(shape, n)
+		size *= n
+	}
+	data := npy[start+headerLen:]
+	if len(data) < size {
+		return nil, nil, errors.New("truncated NumPy array")
+	}
+	return shape, data[:size], nil
+}
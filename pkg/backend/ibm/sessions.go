@@ -0,0 +1,133 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ibm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/backend"
+)
+
+var _ backend.Finder = &Backend{}
+
+// Session states reported by the Runtime API. Open and active sessions
+// accept jobs; inactive ones wait out their interactive timeout.
+const (
+	SessionOpen     = "open"
+	SessionActive   = "active"
+	SessionInactive = "inactive"
+	SessionClosed   = "closed"
+)
+
+// Job is a Runtime job as listed by ListJobs
+type Job struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	SessionID string    `json:"session_id"`
+	Tags      []string  `json:"tags"`
+	Created   time.Time `json:"created"`
+}
+
+// Session is a Runtime session
+type Session struct {
+	ID        string     `json:"id"`
+	State     string     `json:"state"`
+	Mode      string     `json:"mode"`
+	StartedAt *time.Time `json:"started_at"`
+}
+
+// Closed reports whether the session neither runs nor accepts jobs any more
+func (s *Session) Closed() bool {
+	return s.State == SessionClosed
+}
+
+// ListJobs returns up to limit of the instance's most recent jobs carrying
+// all of the tags, newest first
+func (b *Backend) ListJobs(ctx context.Context, tags []string, limit int) ([]Job, error) {
+	query := url.Values{
+		"limit":          {strconv.Itoa(limit)},
+		"sort":           {"DESC"},
+		"exclude_params": {"true"},
+	}
+	for _, tag := range tags {
+		query.Add("tags", tag)
+	}
+	var response struct {
+		Jobs []Job `json:"jobs"`
+	}
+	if err := b.do(ctx, http.MethodGet, "/v1/jobs?"+query.Encode(), nil, &response); err != nil {
+		return nil, err
+	}
+	return response.Jobs, nil
+}
+
+// FindJob returns the job tagged with the request ID by SubmitJob
+func (b *Backend) FindJob(ctx context.Context, requestID string) (*backend.JobID, error) {
+	jobs, err := b.ListJobs(ctx, []string{RequestTagPrefix + requestID}, 1)
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	id := backend.JobID(jobs[0].ID)
+	return &id, nil
+}
+
+// OpenSession opens a session on the device in the given mode, dedicated or
+// batch. The provider closes it once maxTTL has passed, if positive.
+func (b *Backend) OpenSession(ctx context.Context, mode string, maxTTL time.Duration) (*Session, error) {
+	request := map[string]any{
+		"backend": b.name,
+		"mode":    mode,
+	}
+	if maxTTL > 0 {
+		request["max_ttl"] = int(maxTTL.Seconds())
+	}
+	var session Session
+	if err := b.do(ctx, http.MethodPost, "/v1/sessions", request, &session); err != nil {
+		return nil, err
+	}
+	if session.ID == "" {
+		return nil, errors.New("session response: empty session ID")
+	}
+	if session.State == "" {
+		session.State = SessionOpen
+	}
+	return &session, nil
+}
+
+// GetSession returns the session's state
+func (b *Backend) GetSession(ctx context.Context, id string) (*Session, error) {
+	var session Session
+	if err := b.do(ctx, http.MethodGet, sessionPath(id), nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// CloseSession closes the session. Jobs already queued in it still run, but
+// it accepts no new ones and stops reserving the device.
+func (b *Backend) CloseSession(ctx context.Context, id string) error {
+	return b.do(ctx, http.MethodDelete, sessionPath(id)+"/close", nil, nil)
+}
+
+func sessionPath(id string) string {
+	return "/v1/sessions/" + url.PathEscape(id)
+}
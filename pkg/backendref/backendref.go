@@ -0,0 +1,267 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backendref resolves the QuantumBackends QiskitJobs reference. The
+// registered backend and its credentials are copied into the job spec once,
+// when the job is first reconciled, so later edits of the QuantumBackend
+// never move jobs that already picked it.
+package backendref
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// AppliedAnnotation records the QuantumBackend a job's backend was copied from
+const AppliedAnnotation = "quantum.io/quantum-backend"
+
+// ConditionAvailable is True while a QuantumBackend accepts jobs
+const ConditionAvailable = "Available"
+
+// ErrNoneAvailable means no QuantumBackend matching the job's selector is
+// available at the moment
+var ErrNoneAvailable = errors.New("no available QuantumBackend matches spec.backendRef.selector")
+
+// LimitError reports a job exceeding the limits of its QuantumBackend
+type LimitError struct {
+	Backend  string
+	Shots    int
+	MaxShots int32
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("QuantumBackend %q accepts at most %d shots, the job requests %d", e.Backend, e.MaxShots, e.Shots)
+}
+
+// NotAllowedError reports a job referencing a QuantumBackend its namespace
+// may not use
+type NotAllowedError struct {
+	Backend   string
+	Namespace string
+}
+
+func (e *NotAllowedError) Error() string {
+	return fmt.Sprintf("QuantumBackend %q does not allow jobs of namespace %s", e.Backend, e.Namespace)
+}
+
+// CrossNamespaceError reports credentials referencing a Secret outside the
+// job's namespace that no QuantumBackend shares with it
+type CrossNamespaceError struct {
+	Namespace string
+	Name      string
+}
+
+func (e *CrossNamespaceError) Error() string {
+	return fmt.Sprintf("Secret %s/%s is outside the job's namespace; only a QuantumBackend allowing the job's "+
+		"namespace may share credentials across namespaces", e.Namespace, e.Name)
+}
+
+// Applied reports whether the job's backend reference was already resolved
+func Applied(job *quantumv1.QiskitJob) bool {
+	_, ok := job.Annotations[AppliedAnnotation]
+	return ok
+}
+
+// Resolve picks the QuantumBackend the job references and applies it. It
+// returns false without changing the job if the job references none or the
+// reference was already resolved.
+func Resolve(ctx context.Context, r client.Reader, job *quantumv1.QiskitJob) (bool, error) {
+	if job.Spec.BackendRef == nil || Applied(job) {
+		return false, nil
+	}
+	registered, err := Pick(ctx, r, job)
+	if err != nil {
+		return false, err
+	}
+	if err := Apply(job, registered); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Pick returns the QuantumBackend the job references. A backend referenced
+// by name is returned whether or not it is available, so the job queues on
+// it. Among those matching a selector only available ones qualify: the first
+// of the job's preferred backends, else the one with the shortest queue.
+// Excluded backends, and those not allowing the job's namespace, never
+// qualify.
+func Pick(ctx context.Context, r client.Reader, job *quantumv1.QiskitJob) (*quantumv1.QuantumBackend, error) {
+	ref := job.Spec.BackendRef
+	if ref.Name != "" {
+		var registered quantumv1.QuantumBackend
+		if err := r.Get(ctx, client.ObjectKey{Name: ref.Name}, &registered); err != nil {
+			return nil, err
+		}
+		return &registered, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ref.Selector)
+	if err != nil {
+		return nil, err
+	}
+	var registered quantumv1.QuantumBackendList
+	if err := r.List(ctx, &registered, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	var preferred, excluded []string
+	if selection := job.Spec.BackendSelection; selection != nil {
+		preferred, excluded = selection.PreferredBackends, selection.ExcludedBackends
+	}
+
+	var candidates []*quantumv1.QuantumBackend
+	for i := range registered.Items {
+		candidate := &registered.Items[i]
+		if !Allows(candidate, job.Namespace) ||
+			!meta.IsStatusConditionTrue(candidate.Status.Conditions, ConditionAvailable) ||
+			slices.Contains(excluded, candidate.Name) || slices.Contains(excluded, candidate.Spec.Backend.Name) {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+	if len(candidates) == 0 {
+		return nil, ErrNoneAvailable
+	}
+	for _, name := range preferred {
+		for _, candidate := range candidates {
+			if candidate.Name == name || candidate.Spec.Backend.Name == name {
+				return candidate, nil
+			}
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b *quantumv1.QuantumBackend) int {
+		if queue := queueLength(a) - queueLength(b); queue != 0 {
+			return int(queue)
+		}
+		if a.Name < b.Name {
+			return -1
+		}
+		return 1
+	})
+	return candidates[0], nil
+}
+
+// Apply copies the registered backend and its credentials into the job spec
+func Apply(job *quantumv1.QiskitJob, registered *quantumv1.QuantumBackend) error {
+	if !Allows(registered, job.Namespace) {
+		return &NotAllowedError{Backend: registered.Name, Namespace: job.Namespace}
+	}
+	if limits := registered.Spec.Limits; limits != nil && limits.MaxShots > 0 &&
+		job.Spec.Execution.Shots > int(limits.MaxShots) {
+		return &LimitError{Backend: registered.Name, Shots: job.Spec.Execution.Shots, MaxShots: limits.MaxShots}
+	}
+
+	job.Spec.Backend = *registered.Spec.Backend.DeepCopy()
+	if registered.Spec.Credentials != nil {
+		job.Spec.Credentials = registered.Spec.Credentials.DeepCopy()
+	}
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[AppliedAnnotation] = registered.Name
+	return nil
+}
+
+// Allows reports whether jobs of the namespace may use the registered
+// backend, and with it its credentials
+func Allows(registered *quantumv1.QuantumBackend, namespace string) bool {
+	if allowed := registered.Spec.AllowedNamespaces; len(allowed) > 0 {
+		return slices.Contains(allowed, "*") || slices.Contains(allowed, namespace)
+	}
+	for _, ref := range SecretRefs(registered.Spec.Credentials) {
+		if ref.Namespace != "" && ref.Namespace != namespace {
+			return false
+		}
+	}
+	return true
+}
+
+// SecretRefs returns the Secrets credentials reference, in any region, and
+// that of their client certificate
+func SecretRefs(creds *quantumv1.CredentialsSpec) []quantumv1.SecretRef {
+	if creds == nil {
+		return nil
+	}
+	refs := make([]quantumv1.SecretRef, 0, len(creds.RegionalSecretRefs)+2)
+	if creds.SecretRef != nil {
+		refs = append(refs, *creds.SecretRef)
+	}
+	regions := make([]string, 0, len(creds.RegionalSecretRefs))
+	for region := range creds.RegionalSecretRefs {
+		regions = append(regions, region)
+	}
+	slices.Sort(regions)
+	for _, region := range regions {
+		refs = append(refs, creds.RegionalSecretRefs[region])
+	}
+	if creds.ClientCertificate != nil {
+		refs = append(refs, creds.ClientCertificate.SecretRef)
+	}
+	return refs
+}
+
+// SecretNamespace returns the namespace of a Secret the job's credentials
+// reference. A Secret outside the job's namespace is only ever read for jobs
+// whose credentials were copied from a QuantumBackend that still references
+// the Secret and allows the job's namespace; for any other job it is a
+// CrossNamespaceError.
+func SecretNamespace(ctx context.Context, r client.Reader, job *quantumv1.QiskitJob, ref quantumv1.SecretRef) (string, error) {
+	if ref.Namespace == "" || ref.Namespace == job.Namespace {
+		return job.Namespace, nil
+	}
+	crossNamespace := &CrossNamespaceError{Namespace: ref.Namespace, Name: ref.Name}
+	if !Applied(job) || r == nil {
+		return "", crossNamespace
+	}
+	var registered quantumv1.QuantumBackend
+	if err := r.Get(ctx, client.ObjectKey{Name: job.Annotations[AppliedAnnotation]}, &registered); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", crossNamespace
+		}
+		return "", err
+	}
+	if !Allows(&registered, job.Namespace) || !slices.Contains(SecretRefs(registered.Spec.Credentials), ref) {
+		return "", crossNamespace
+	}
+	return ref.Namespace, nil
+}
+
+// CheckSecrets returns the first error of SecretNamespace for the Secrets
+// the job's credentials reference
+func CheckSecrets(ctx context.Context, r client.Reader, job *quantumv1.QiskitJob) error {
+	for _, ref := range SecretRefs(job.Spec.Credentials) {
+		if _, err := SecretNamespace(ctx, r, job, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queueLength returns the backend's queue, 0 if it reports none
+func queueLength(registered *quantumv1.QuantumBackend) int32 {
+	if registered.Status.QueueLength == nil {
+		return 0
+	}
+	return *registered.Status.QueueLength
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package breaker implements circuit breakers around the operator's calls to
+// quantum backends. A backend's breaker opens after a run of consecutive
+// failures, so that during a provider outage jobs stop hammering it with
+// submissions and polls; once a cooldown has passed a single call probes
+// whether the backend recovered.
+package breaker
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// State of a breaker
+type State string
+
+const (
+	// Closed lets every call through
+	Closed State = "Closed"
+	// Open lets no call through until the cooldown has passed
+	Open State = "Open"
+	// HalfOpen lets a single probe through, which closes the breaker if it
+	// succeeds and opens it again if it fails
+	HalfOpen State = "HalfOpen"
+)
+
+// Status is the state of a backend's breaker
+type Status struct {
+	// Backend the breaker guards
+	Backend string
+	// State of the breaker
+	State State
+	// ConsecutiveFailures is the length of the current run of failures
+	ConsecutiveFailures int
+	// OpenedAt is when the breaker last opened, zero if it never did
+	OpenedAt time.Time
+	// RetryAt is when an open breaker lets a probe through
+	RetryAt time.Time
+}
+
+// breaker is the state of one backend's breaker
+type breaker struct {
+	Status
+	// probeUntil is when the probe of a half-open breaker is given up on, for
+	// another call to probe
+	probeUntil time.Time
+}
+
+// Set holds the breakers of every backend, created on first use. It is safe
+// for concurrent use.
+type Set struct {
+	// Threshold is the number of consecutive failures that opens a breaker
+	Threshold int
+	// Cooldown is how long a breaker stays open before it is probed
+	Cooldown time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+// New returns breakers that open after threshold consecutive failures and
+// stay open for cooldown
+func New(threshold int, cooldown time.Duration) *Set {
+	return &Set{Threshold: threshold, Cooldown: cooldown, breakers: map[string]*breaker{}}
+}
+
+// get returns the backend's breaker, creating a closed one; s.mu is held
+func (s *Set) get(backend string) *breaker {
+	b, ok := s.breakers[backend]
+	if !ok {
+		b = &breaker{Status: Status{Backend: backend, State: Closed}}
+		s.breakers[backend] = b
+	}
+	return b
+}
+
+// Allow reports whether a call to the backend may go ahead: always while
+// its breaker is closed, and once its cooldown has passed for a single probe
+// at a time. The status tells when to try again if not.
+func (s *Set) Allow(backend string) (Status, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.get(backend)
+	now := time.Now()
+	switch {
+	case b.State == Closed:
+		return b.Status, true
+	case now.Before(b.RetryAt), b.State == HalfOpen && now.Before(b.probeUntil):
+		return b.Status, false
+	}
+	b.State = HalfOpen
+	b.probeUntil = now.Add(s.Cooldown)
+	return b.Status, true
+}
+
+// Success records a successful call to the backend, which closes its
+// breaker. It reports whether the breaker was not closed before.
+func (s *Set) Success(backend string) (Status, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.get(backend)
+	changed := b.State != Closed
+	b.State = Closed
+	b.ConsecutiveFailures = 0
+	b.RetryAt = time.Time{}
+	return b.Status, changed
+}
+
+// Failure records a failed call to the backend. Its breaker opens once the
+// failures reach the threshold, or again when its probe failed. It reports
+// whether the breaker opened.
+func (s *Set) Failure(backend string) (Status, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.get(backend)
+	b.ConsecutiveFailures++
+	if b.State == Open || (b.State == Closed && b.ConsecutiveFailures < s.Threshold) {
+		return b.Status, false
+	}
+	now := time.Now()
+	b.State = Open
+	b.OpenedAt = now
+	b.RetryAt = now.Add(s.Cooldown)
+	return b.Status, true
+}
+
+// Statuses returns the state of every breaker, by backend
+func (s *Set) Statuses() []Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]Status, 0, len(s.breakers))
+	for _, b := range s.breakers {
+		statuses = append(statuses, b.Status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Backend < statuses[j].Backend })
+	return statuses
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package calendar evaluates QiskitCalendars: whether a backend is inside a
+// blackout or peak pricing window, or outside its allowed execution windows,
+// and when a job held back by one may be submitted.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// DefaultPeakMultiplier is the cost multiplier of peak windows that do not set one
+const DefaultPeakMultiplier = 2.0
+
+// Reasons a job is held back, used as condition reasons
+const (
+	ReasonBlackout    = "Blackout"
+	ReasonPeakPricing = "PeakPricing"
+)
+
+// maxSteps bounds the search for the end of back-to-back windows
+const maxSteps = 64
+
+// Decision is the outcome of evaluating calendars for a job
+type Decision struct {
+	// Until is when the job may be submitted; zero if it may be submitted now
+	Until time.Time
+	// Reason is ReasonBlackout or ReasonPeakPricing when the job is held back
+	Reason string
+	// Window names the calendar window holding the job back
+	Window string
+	// Multiplier is the cost multiplier in effect now
+	Multiplier float64
+}
+
+// Delayed reports whether the job should wait before being submitted
+func (d Decision) Delayed() bool {
+	return !d.Until.IsZero()
+}
+
+// window is a CalendarWindow resolved for evaluation
+type window struct {
+	name       string
+	blackout   bool
+	days       map[time.Weekday]bool
+	start, end int // minutes after midnight
+	multiplier float64
+	loc        *time.Location
+}
+
+// Evaluate decides whether a job for the given backend names and types may
+// be submitted at now. Blackouts always hold a job back; a peak window only
+// does if a cheaper window opens no later than the deadline. Invalid windows
+// are skipped and reported in the returned error alongside the decision.
+func Evaluate(calendars []quantumv1.QiskitCalendar, backends []string, now time.Time, deadline *time.Time) (Decision, error) {
+	windows, err := compile(calendars, backends, false)
+	decision := Decision{Multiplier: multiplier(windows, now)}
+
+	if until, name, ok := blackoutEnd(windows, now); ok {
+		decision.Until, decision.Reason, decision.Window = until, ReasonBlackout, name
+		return decision, err
+	}
+	if decision.Multiplier > 1 && deadline != nil {
+		if until, ok := cheaperWindow(windows, now, *deadline, decision.Multiplier); ok {
+			decision.Until, decision.Reason = until, ReasonPeakPricing
+			decision.Window = peakWindow(windows, now)
+		}
+	}
+	return decision, err
+}
+
+// Eligible decides when a job for the given backend names and types may
+// start under the Allowed windows of the calendars: zero if now, because it
+// is inside one or no Allowed windows apply, otherwise the opening of the
+// next one, which is named. Invalid windows are skipped and reported in the
+// returned error.
+func Eligible(calendars []quantumv1.QiskitCalendar, backends []string, now time.Time) (time.Time, string, error) {
+	windows, err := compile(calendars, backends, true)
+	if len(windows) == 0 {
+		return time.Time{}, "", err
+	}
+	var next time.Time
+	var name string
+	for _, w := range windows {
+		if _, ok := w.activeUntil(now); ok {
+			return time.Time{}, "", err
+		}
+		if start, ok := w.nextStart(now); ok && (next.IsZero() || start.Before(next)) {
+			next, name = start, w.name
+		}
+	}
+	return next, name, err
+}
+
+// ForNamespace returns the calendars whose namespace selector matches a
+// namespace with the given labels. Calendars with invalid selectors are left
+// out and reported in the returned error.
+func ForNamespace(calendars []quantumv1.QiskitCalendar, namespaceLabels map[string]string) ([]quantumv1.QiskitCalendar, error) {
+	var matching []quantumv1.QiskitCalendar
+	var errs []error
+	for i := range calendars {
+		cal := &calendars[i]
+		if cal.Spec.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(cal.Spec.NamespaceSelector)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("calendar %s: %w", cal.Name, err))
+				continue
+			}
+			if !selector.Matches(labels.Set(namespaceLabels)) {
+				continue
+			}
+		}
+		matching = append(matching, *cal)
+	}
+	return matching, errors.Join(errs...)
+}
+
+// Applies reports whether the calendar covers any of the backend names or types
+func Applies(cal *quantumv1.QiskitCalendar, backends []string) bool {
+	if len(cal.Spec.Backends) == 0 {
+		return true
+	}
+	for _, pattern := range cal.Spec.Backends {
+		for _, backend := range backends {
+			if ok, _ := path.Match(pattern, backend); ok && backend != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compile resolves the Allowed windows of the calendars covering the
+// backends if allowed is set, and their Peak and Blackout windows otherwise
+func compile(calendars []quantumv1.QiskitCalendar, backends []string, allowed bool) ([]window, error) {
+	var windows []window
+	var errs []error
+	for i := range calendars {
+		cal := &calendars[i]
+		if !Applies(cal, backends) {
+			continue
+		}
+		loc, err := time.LoadLocation(cal.Spec.TimeZone)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("calendar %s: %w", cal.Name, err))
+			continue
+		}
+		for _, spec := range cal.Spec.Windows {
+			if (spec.Type == quantumv1.CalendarWindowAllowed) != allowed {
+				continue
+			}
+			w, err := compileWindow(spec, loc)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("calendar %s window %s: %w", cal.Name, spec.Name, err))
+				continue
+			}
+			windows = append(windows, w)
+		}
+	}
+	return windows, errors.Join(errs...)
+}
+
+func compileWindow(spec quantumv1.CalendarWindow, loc *time.Location) (window, error) {
+	w := window{
+		name:       spec.Name,
+		blackout:   spec.Type == quantumv1.CalendarWindowBlackout,
+		multiplier: 1,
+		loc:        loc,
+	}
+	var err error
+	if w.start, err = parseClock(spec.Start); err != nil {
+		return w, err
+	}
+	if w.end, err = parseClock(spec.End); err != nil {
+		return w, err
+	}
+	if len(spec.Days) > 0 {
+		w.days = map[time.Weekday]bool{}
+		for _, day := range spec.Days {
+			weekday, ok := weekdays[strings.ToLower(string(day))]
+			if !ok {
+				return w, fmt.Errorf("unknown day %q", day)
+			}
+			w.days[weekday] = true
+		}
+	}
+	if spec.Type == quantumv1.CalendarWindowPeak {
+		w.multiplier = DefaultPeakMultiplier
+		if spec.CostMultiplier != "" {
+			if w.multiplier, err = strconv.ParseFloat(spec.CostMultiplier, 64); err != nil {
+				return w, fmt.Errorf("invalid cost multiplier %q", spec.CostMultiplier)
+			}
+		}
+	}
+	return w, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+	"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+}
+
+// parseClock converts "HH:MM" into minutes after midnight
+func parseClock(clock string) (int, error) {
+	h, m, ok := strings.Cut(clock, ":")
+	hours, err1 := strconv.Atoi(h)
+	minutes, err2 := strconv.Atoi(m)
+	if !ok || err1 != nil || err2 != nil || hours < 0 || minutes < 0 || minutes > 59 ||
+		hours*60+minutes > 24*60 {
+		return 0, fmt.Errorf("invalid time of day %q", clock)
+	}
+	return hours*60 + minutes, nil
+}
+
+// activeUntil returns the end of the window occurrence containing t
+func (w window) activeUntil(t time.Time) (time.Time, bool) {
+	lt := t.In(w.loc)
+	// An occurrence containing t started today or, if it wraps past
+	// midnight, yesterday
+	for _, offset := range []int{0, -1} {
+		day := time.Date(lt.Year(), lt.Month(), lt.Day()+offset, 0, 0, 0, 0, w.loc)
+		if w.days != nil && !w.days[day.Weekday()] {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(), w.start/60, w.start%60, 0, 0, w.loc)
+		endDay := day.Day()
+		if w.end <= w.start {
+			endDay++
+		}
+		end := time.Date(day.Year(), day.Month(), endDay, w.end/60, w.end%60, 0, 0, w.loc)
+		if !lt.Before(start) && lt.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// nextStart returns the first start of the window at or after t
+func (w window) nextStart(t time.Time) (time.Time, bool) {
+	lt := t.In(w.loc)
+	for offset := 0; offset <= 7; offset++ {
+		day := time.Date(lt.Year(), lt.Month(), lt.Day()+offset, 0, 0, 0, 0, w.loc)
+		if w.days != nil && !w.days[day.Weekday()] {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(), w.start/60, w.start%60, 0, 0, w.loc)
+		if !start.Before(t) {
+			return start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// blackoutEnd returns when back-to-back blackouts active at t are over
+func blackoutEnd(windows []window, t time.Time) (time.Time, string, bool) {
+	var name string
+	until := t
+	for i := 0; i < maxSteps; i++ {
+		extended := false
+		for _, w := range windows {
+			if !w.blackout {
+				continue
+			}
+			if end, ok := w.activeUntil(until); ok {
+				if name == "" {
+					name = w.name
+				}
+				until, extended = end, true
+			}
+		}
+		if !extended {
+			break
+		}
+	}
+	return until, name, until.After(t)
+}
+
+// multiplier returns the highest cost multiplier in effect at t
+func multiplier(windows []window, t time.Time) float64 {
+	m := 1.0
+	for _, w := range windows {
+		if _, ok := w.activeUntil(t); ok && !w.blackout && w.multiplier > m {
+			m = w.multiplier
+		}
+	}
+	return m
+}
+
+// peakWindow names the most expensive peak window active at t
+func peakWindow(windows []window, t time.Time) string {
+	name, m := "", 0.0
+	for _, w := range windows {
+		if _, ok := w.activeUntil(t); ok && !w.blackout && w.multiplier > m {
+			name, m = w.name, w.multiplier
+		}
+	}
+	return name
+}
+
+// cheaperWindow finds the first time after now, and no later than deadline,
+// at which submission is allowed and costs less than current
+func cheaperWindow(windows []window, now, deadline time.Time, current float64) (time.Time, bool) {
+	t := now
+	for i := 0; i < maxSteps && !t.After(deadline); i++ {
+		if end, _, ok := blackoutEnd(windows, t); ok {
+			t = end
+			continue
+		}
+		if t.After(now) && multiplier(windows, t) < current {
+			return t, true
+		}
+
+		// Skip to the earliest end of the peak windows active at t
+		next := time.Time{}
+		for _, w := range windows {
+			if end, ok := w.activeUntil(t); ok && !w.blackout && (next.IsZero() || end.Before(next)) {
+				next = end
+			}
+		}
+		if next.IsZero() {
+			break
+		}
+		t = next
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clientutil follows QiskitJobs for Go programs that embed the
+// operator's CRDs, without polling: it waits for phases, streams condition
+// changes and calls back when jobs finish. The helpers run informers over
+// any client that can watch, such as one made with client.NewWithWatch with
+// a scheme the api/v1 types were added to.
+package clientutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Terminal phases of a job
+const (
+	PhaseCompleted = quantumv1.QiskitJobCompleted
+	PhaseFailed    = quantumv1.QiskitJobFailed
+	PhaseCancelled = quantumv1.QiskitJobCancelled
+)
+
+// ConditionFinished is the condition the operator sets once a job finished
+// for good
+const ConditionFinished = "Finished"
+
+// ErrDeleted is returned when the job followed was deleted
+var ErrDeleted = errors.New("QiskitJob was deleted")
+
+// Finished reports whether the job finished for good: it completed, was
+// cancelled, or failed with no retries left. A failed job the operator
+// retries moves on to Retrying instead.
+func Finished(job *quantumv1.QiskitJob) bool {
+	switch job.Status.Phase {
+	case PhaseCompleted, PhaseCancelled:
+		return true
+	case PhaseFailed:
+		return meta.IsStatusConditionTrue(job.Status.Conditions, ConditionFinished)
+	}
+	return false
+}
+
+// observer is handed every version of the jobs an informer sees, with
+// whether it is the last of a deleted job and whether it was listed when
+// the informer started
+type observer func(job *quantumv1.QiskitJob, deleted, initial bool)
+
+// informJobs runs an informer over the QiskitJobs opts select until ctx is
+// done, handing each version of a job to observe, one at a time
+func informJobs(ctx context.Context, c client.WithWatch, observe observer, opts ...client.ListOption) {
+	selected := &client.ListOptions{}
+	selected.ApplyOptions(opts)
+	withRaw := func(raw metav1.ListOptions) *client.ListOptions {
+		listOpts := *selected
+		listOpts.Raw = &raw
+		return &listOpts
+	}
+	lw := &toolscache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			list := &quantumv1.QiskitJobList{}
+			return list, c.List(ctx, list, withRaw(options))
+		},
+		WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+			return c.Watch(ctx, &quantumv1.QiskitJobList{}, withRaw(options))
+		},
+	}
+
+	handler := toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj any, initial bool) {
+			if job, ok := obj.(*quantumv1.QiskitJob); ok {
+				observe(job, false, initial)
+			}
+		},
+		UpdateFunc: func(_, obj any) {
+			if job, ok := obj.(*quantumv1.QiskitJob); ok {
+				observe(job, false, false)
+			}
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if job, ok := obj.(*quantumv1.QiskitJob); ok {
+				observe(job, true, false)
+			}
+		},
+	}
+	_, informer := toolscache.NewInformerWithOptions(toolscache.InformerOptions{
+		ListerWatcher: lw,
+		ObjectType:    &quantumv1.QiskitJob{},
+		Handler:       handler,
+	})
+	informer.RunWithContext(ctx)
+}
+
+// informJob runs an informer over the job key names until ctx is done. Jobs
+// are selected by name on the API server, and again here for clients that
+// do not filter watches.
+func informJob(ctx context.Context, c client.WithWatch, key types.NamespacedName, observe observer) {
+	informJobs(ctx, c, func(job *quantumv1.QiskitJob, deleted, initial bool) {
+		if job.Name == key.Name && job.Namespace == key.Namespace {
+			observe(job, deleted, initial)
+		}
+	}, client.InNamespace(key.Namespace), client.MatchingFields{"metadata.name": key.Name})
+}
+
+// WaitForPhase waits until the job key names is in one of the phases and
+// returns it. It fails if the job finishes for good in another phase or is
+// deleted, and waits for a job that does not exist yet to be created.
+func WaitForPhase(ctx context.Context, c client.WithWatch, key types.NamespacedName, phases ...quantumv1.QiskitJobPhase) (*quantumv1.QiskitJob, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type outcome struct {
+		job *quantumv1.QiskitJob
+		err error
+	}
+	outcomes := make(chan outcome, 1)
+	done := false
+	settle := func(job *quantumv1.QiskitJob, err error) {
+		done = true
+		outcomes <- outcome{job: job, err: err}
+	}
+	go informJob(ctx, c, key, func(job *quantumv1.QiskitJob, deleted, _ bool) {
+		switch {
+		case done:
+		case deleted:
+			settle(nil, fmt.Errorf("%w: %s", ErrDeleted, key))
+		case slices.Contains(phases, job.Status.Phase):
+			settle(job.DeepCopy(), nil)
+		case Finished(job):
+			settle(job.DeepCopy(), fmt.Errorf("QiskitJob %s finished as %s: %s", key, job.Status.Phase, job.Status.Message))
+		}
+	})
+
+	select {
+	case o := <-outcomes:
+		return o.job, o.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// StreamConditions sends the conditions of the job key names as they are
+// set or change, starting with those it has. The channel is closed once the
+// job finished for good, was deleted, or ctx is done.
+func StreamConditions(ctx context.Context, c client.WithWatch, key types.NamespacedName) <-chan metav1.Condition {
+	ctx, cancel := context.WithCancel(ctx)
+	conditions := make(chan metav1.Condition, 16)
+	seen := map[string]metav1.Condition{}
+	go func() {
+		defer close(conditions)
+		informJob(ctx, c, key, func(job *quantumv1.QiskitJob, deleted, _ bool) {
+			if ctx.Err() != nil {
+				return
+			}
+			for _, condition := range job.Status.Conditions {
+				last, ok := seen[condition.Type]
+				if ok && last.Status == condition.Status && last.Reason == condition.Reason &&
+					last.Message == condition.Message && last.ObservedGeneration == condition.ObservedGeneration {
+					continue
+				}
+				seen[condition.Type] = condition
+				select {
+				case conditions <- condition:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if deleted || Finished(job) {
+				cancel()
+			}
+		})
+	}()
+	return conditions
+}
+
+// OnCompletion calls fn with each job opts select, e.g. client.InNamespace
+// and client.MatchingLabels, once it finished for good, until ctx is done.
+// Jobs that had finished before are skipped. fn is called for one job at a
+// time and should return quickly.
+func OnCompletion(ctx context.Context, c client.WithWatch, fn func(job *quantumv1.QiskitJob), opts ...client.ListOption) {
+	reported := map[types.NamespacedName]bool{}
+	informJobs(ctx, c, func(job *quantumv1.QiskitJob, deleted, initial bool) {
+		key := client.ObjectKeyFromObject(job)
+		switch {
+		case deleted:
+			delete(reported, key)
+		case reported[key] || !Finished(job):
+		case initial:
+			reported[key] = true
+		default:
+			reported[key] = true
+			fn(job.DeepCopy())
+		}
+	}, opts...)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientutil
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+// watchingClient tells when a watch was opened, as the fake client does not
+// replay changes made between an informer's list and its watch
+type watchingClient struct {
+	client.WithWatch
+	watching chan struct{}
+}
+
+func (c *watchingClient) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	w, err := c.WithWatch.Watch(ctx, list, opts...)
+	c.watching <- struct{}{}
+	return w, err
+}
+
+var _ = Describe("Following QiskitJobs", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		c      *watchingClient
+		job    *quantumv1.QiskitJob
+		key    = types.NamespacedName{Name: "bell", Namespace: "quantum-lab"}
+	)
+
+	// moveTo sets the job's phase and conditions as the operator would
+	moveTo := func(phase quantumv1.QiskitJobPhase, conditions ...metav1.Condition) {
+		GinkgoHelper()
+		Expect(c.Get(ctx, key, job)).To(Succeed())
+		job.Status.Phase = phase
+		for _, condition := range conditions {
+			condition.LastTransitionTime = metav1.Now()
+			job.Status.Conditions = append(job.Status.Conditions, condition)
+		}
+		Expect(c.Update(ctx, job)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(func() { cancel() })
+		c = &watchingClient{watching: make(chan struct{}, 1)}
+		c.WithWatch = fake.NewClientBuilder().WithScheme(scheme).
+			WithIndex(&quantumv1.QiskitJob{}, "metadata.name", func(obj client.Object) []string {
+				return []string{obj.GetName()}
+			}).
+			Build()
+		job = builder.NewBellStateJob(key.Name, key.Namespace).Build()
+		Expect(c.Create(ctx, job)).To(Succeed())
+	})
+
+	Context("waiting for a phase", func() {
+		It("should return the job once it reaches one of the phases", func() {
+			go func() {
+				defer GinkgoRecover()
+				<-c.watching
+				moveTo("Running")
+			}()
+			running, err := WaitForPhase(ctx, c, key, "Running", PhaseCompleted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(running.Status.Phase).To(Equal(quantumv1.QiskitJobRunning))
+		})
+
+		It("should fail once the job finished for good in another phase", func() {
+			go func() {
+				defer GinkgoRecover()
+				<-c.watching
+				moveTo(PhaseFailed, metav1.Condition{Type: "Failed", Status: metav1.ConditionTrue, Reason: "ExecutionFailed"})
+				moveTo(PhaseFailed, metav1.Condition{Type: ConditionFinished, Status: metav1.ConditionTrue, Reason: "NoRetriesLeft"})
+			}()
+			_, err := WaitForPhase(ctx, c, key, PhaseCompleted)
+			Expect(err).To(MatchError(ContainSubstring("finished as Failed")))
+		})
+
+		It("should fail once the job is deleted", func() {
+			go func() {
+				defer GinkgoRecover()
+				<-c.watching
+				Expect(c.Delete(ctx, job)).To(Succeed())
+			}()
+			_, err := WaitForPhase(ctx, c, key, PhaseCompleted)
+			Expect(err).To(MatchError(ErrDeleted))
+		})
+
+		It("should give up when the context is done", func() {
+			short, stop := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer stop()
+			_, err := WaitForPhase(short, c, key, PhaseCompleted)
+			Expect(err).To(MatchError(context.DeadlineExceeded))
+		})
+	})
+
+	It("should stream condition changes until the job finished for good", func() {
+		conditions := StreamConditions(ctx, c, key)
+		<-c.watching
+		moveTo("Validating", metav1.Condition{Type: "Validated", Status: metav1.ConditionUnknown, Reason: "Validating"})
+		Eventually(conditions).Should(Receive(HaveField("Type", "Validated")))
+
+		moveTo(PhaseCompleted,
+			metav1.Condition{Type: "Completed", Status: metav1.ConditionTrue, Reason: "Succeeded"},
+			metav1.Condition{Type: ConditionFinished, Status: metav1.ConditionTrue, Reason: "Succeeded"})
+		Eventually(conditions).Should(Receive(HaveField("Type", "Completed")))
+		Eventually(conditions).Should(Receive(HaveField("Type", ConditionFinished)))
+		Eventually(conditions).Should(BeClosed())
+	})
+
+	It("should call back once for each job that finishes for good", func() {
+		done := builder.NewBellStateJob("done", key.Namespace).Build()
+		Expect(c.Create(ctx, done)).To(Succeed())
+		done.Status.Phase = PhaseCompleted
+		Expect(c.Update(ctx, done)).To(Succeed())
+
+		finished := make(chan string, 10)
+		go OnCompletion(ctx, c, func(job *quantumv1.QiskitJob) {
+			finished <- job.Name + "/" + string(job.Status.Phase)
+		}, client.InNamespace(key.Namespace))
+
+		By("skipping jobs that had finished before, and failures that are retried")
+		<-c.watching
+		Consistently(finished, 200*time.Millisecond).ShouldNot(Receive())
+		moveTo(PhaseFailed, metav1.Condition{Type: "Failed", Status: metav1.ConditionTrue, Reason: "ExecutionFailed"})
+		Consistently(finished, 200*time.Millisecond).ShouldNot(Receive())
+
+		moveTo(PhaseCancelled)
+		Eventually(finished).Should(Receive(Equal("bell/Cancelled")))
+		moveTo(PhaseCancelled, metav1.Condition{Type: ConditionFinished, Status: metav1.ConditionTrue, Reason: "Cancelled"})
+		Consistently(finished, 200*time.Millisecond).ShouldNot(Receive())
+	})
+})
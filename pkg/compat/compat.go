@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package compat holds the Qiskit version compatibility matrix: which Qiskit
+// releases the operator can execute, on which runtime channel, with which
+// executor image, and which backends each release can target.
+package compat
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Runtime channels
+const (
+	ChannelStable     = "stable"
+	ChannelLegacy     = "legacy"
+	ChannelPreview    = "preview"
+	DefaultQiskitLine = "1.0"
+)
+
+// Runtime describes one supported Qiskit release line
+type Runtime struct {
+	// Qiskit release line (major.minor)
+	Line string
+	// Runtime channel the line is published on
+	Channel string
+	// Executor base image
+	Image string
+	// Pinned pip requirements installed into the executor
+	Requirements []string
+	// Whether the line supports the V2 Sampler/Estimator primitives
+	PrimitivesV2 bool
+	// Pinned qiskit-ibm-runtime requirement for backends that use the
+	// Runtime client in the executor; empty if the line has none
+	RuntimeClient string
+}
+
+// matrix lists every supported Qiskit release line
+var matrix = map[string]Runtime{
+	"0.46": {
+		Line:         "0.46",
+		Channel:      ChannelLegacy,
+		Image:        "python:3.11-slim",
+		Requirements: []string{"qiskit==0.46.3", "qiskit-aer==0.13.3"},
+	},
+	"1.0": {
+		Line:          "1.0",
+		Channel:       ChannelStable,
+		Image:         "python:3.11-slim",
+		Requirements:  []string{"qiskit==1.0.0", "qiskit-aer==0.13.0"},
+		PrimitivesV2:  true,
+		RuntimeClient: "qiskit-ibm-runtime==0.23.0",
+	},
+	"1.2": {
+		Line:          "1.2",
+		Channel:       ChannelStable,
+		Image:         "python:3.11-slim",
+		Requirements:  []string{"qiskit==1.2.4", "qiskit-aer==0.15.1"},
+		PrimitivesV2:  true,
+		RuntimeClient: "qiskit-ibm-runtime==0.32.0",
+	},
+	"1.3": {
+		Line:          "1.3",
+		Channel:       ChannelPreview,
+		Image:         "python:3.12-slim",
+		Requirements:  []string{"qiskit==1.3.1", "qiskit-aer==0.15.1"},
+		PrimitivesV2:  true,
+		RuntimeClient: "qiskit-ibm-runtime==0.34.0",
+	},
+}
+
+// backendsRequiringPrimitivesV2 are submitted through IBM Runtime, which only
+// accepts V2 primitives
+var backendsRequiringPrimitivesV2 = map[string]bool{
+	"ibm_quantum":       true,
+	"ibm_simulator":     true,
+	"ibm_local_testing": true,
+}
+
+// SupportedLines returns the supported Qiskit release lines in ascending order
+func SupportedLines() []string {
+	lines := make([]string, 0, len(matrix))
+	for line := range matrix {
+		lines = append(lines, line)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return lessVersion(lines[i], lines[j])
+	})
+	return lines
+}
+
+// Resolve returns the runtime for a requested version such as "1.2" or
+// "1.2.4". An empty version resolves to the default release line.
+func Resolve(version string) (*Runtime, error) {
+	if version == "" {
+		version = DefaultQiskitLine
+	}
+	line, err := releaseLine(version)
+	if err != nil {
+		return nil, err
+	}
+	rt, ok := matrix[line]
+	if !ok {
+		return nil, fmt.Errorf("qiskit version %q is not supported, supported versions: %s",
+			version, strings.Join(SupportedLines(), ", "))
+	}
+	return &rt, nil
+}
+
+// CheckBackend verifies the runtime can target the given backend type
+func (rt *Runtime) CheckBackend(backendType string) error {
+	if backendsRequiringPrimitivesV2[backendType] && !rt.PrimitivesV2 {
+		return fmt.Errorf("backend type %q requires Runtime primitives V2 (qiskit >= 1.0), got qiskit %s",
+			backendType, rt.Line)
+	}
+	return nil
+}
+
+// RequirementsFor returns the pip requirements of the executor for a backend
+// type, adding the Runtime client for backends driven through it
+func (rt *Runtime) RequirementsFor(backendType string) []string {
+	reqs := slices.Clone(rt.Requirements)
+	if backendsRequiringPrimitivesV2[backendType] && rt.RuntimeClient != "" {
+		reqs = append(reqs, rt.RuntimeClient)
+	}
+	return reqs
+}
+
+// AerGPU returns the requirements with qiskit-aer replaced by the same
+// release of qiskit-aer-gpu, which simulates on NVIDIA GPUs with CUDA
+func AerGPU(requirements []string) []string {
+	reqs := slices.Clone(requirements)
+	for i, req := range reqs {
+		if version, ok := strings.CutPrefix(req, "qiskit-aer=="); ok {
+			reqs[i] = "qiskit-aer-gpu==" + version
+		}
+	}
+	return reqs
+}
+
+// releaseLine reduces a version to its major.minor release line
+func releaseLine(version string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) < 2 {
+		return "", fmt.Errorf("invalid qiskit version %q, expected major.minor[.patch]", version)
+	}
+	for _, p := range parts {
+		if _, err := strconv.Atoi(p); err != nil {
+			return "", fmt.Errorf("invalid qiskit version %q, expected major.minor[.patch]", version)
+		}
+	}
+	return parts[0] + "." + parts[1], nil
+}
+
+// lessVersion compares two major.minor release lines numerically
+func lessVersion(a, b string) bool {
+	pa, pb := strings.SplitN(a, ".", 2), strings.SplitN(b, ".", 2)
+	for i := 0; i < 2; i++ {
+		x, _ := strconv.Atoi(pa[i])
+		y, _ := strconv.Atoi(pb[i])
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
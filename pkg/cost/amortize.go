@@ -0,0 +1,73 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cost attributes provider charges to the QiskitJobs that incurred
+// them.
+package cost
+
+import (
+	"math"
+	"sort"
+)
+
+// Amortize splits total (in dollars) into one share per weight, proportional
+// to the weights, such as the quantum seconds of each job in a session.
+// Shares are whole cents that add up to total exactly; leftover cents go to
+// the largest remainders. Without a positive weight, total is split evenly.
+func Amortize(total float64, weights []float64) []float64 {
+	shares := make([]float64, len(weights))
+	if len(weights) == 0 {
+		return shares
+	}
+
+	var sum float64
+	for _, w := range weights {
+		if w > 0 {
+			sum += w
+		}
+	}
+	exact := make([]float64, len(weights))
+	for i, w := range weights {
+		switch {
+		case sum == 0:
+			exact[i] = 1 / float64(len(weights))
+		case w > 0:
+			exact[i] = w / sum
+		}
+	}
+
+	cents := int64(math.Round(total * 100))
+	assigned := make([]int64, len(weights))
+	remainders := make([]int, 0, len(weights))
+	var allocated int64
+	for i, f := range exact {
+		assigned[i] = int64(math.Floor(f * float64(cents)))
+		allocated += assigned[i]
+		remainders = append(remainders, i)
+	}
+	sort.SliceStable(remainders, func(a, b int) bool {
+		i, j := remainders[a], remainders[b]
+		return exact[i]*float64(cents)-float64(assigned[i]) > exact[j]*float64(cents)-float64(assigned[j])
+	})
+	for k := int64(0); k < cents-allocated; k++ {
+		assigned[remainders[k%int64(len(remainders))]]++
+	}
+
+	for i, c := range assigned {
+		shares[i] = float64(c) / 100
+	}
+	return shares
+}
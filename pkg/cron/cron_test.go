@@ -0,0 +1,120 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cron
+
+import (
+	"time"
+	_ "time/tzdata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// at returns the time on the clock of the named location, failing on
+// times the clock skips or repeats unless offset names which one is meant
+func at(location, clock string) time.Time {
+	loc, err := time.LoadLocation(location)
+	Expect(err).NotTo(HaveOccurred())
+	t, err := time.ParseInLocation("2006-01-02 15:04 -0700", clock, loc)
+	if err != nil {
+		t, err = time.ParseInLocation("2006-01-02 15:04", clock, loc)
+	}
+	Expect(err).NotTo(HaveOccurred())
+	return t
+}
+
+var _ = Describe("Parse", func() {
+	DescribeTable("should accept the expressions CronJobs take",
+		func(spec string) {
+			_, err := Parse(spec)
+			Expect(err).NotTo(HaveOccurred())
+		},
+		Entry("every minute", "* * * * *"),
+		Entry("lists, ranges and steps", "0,15,30-45/5 */2 1-7 * mon-fri"),
+		Entry("names of months and days", "0 9 * JAN,jul sun"),
+		Entry("a start with a step", "5/15 * * * *"),
+		Entry("Sunday as 7", "0 0 * * 7"),
+		Entry("question marks", "0 0 ? * ?"),
+		Entry("a shorthand", "@weekly"),
+		Entry("surrounding space", "  @Daily "),
+	)
+
+	DescribeTable("should reject expressions that would never be what was meant",
+		func(spec, message string) {
+			_, err := Parse(spec)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("too few fields", "* * * *", "expected 5 fields"),
+		Entry("seconds", "0 * * * * *", "expected 5 fields"),
+		Entry("a time zone", "CRON_TZ=UTC 0 * * * *", "time zones are set with timeZone"),
+		Entry("an hour out of range", "0 24 * * *", "hour 24 is out of range 0-23"),
+		Entry("day of month zero", "0 0 0 * *", "day of month 0 is out of range 1-31"),
+		Entry("an unknown name", "0 0 * foo *", `invalid value "foo" in month field`),
+		Entry("a zero step", "*/0 * * * *", `invalid step "0" in minute field`),
+		Entry("a backwards range", "0 0 * * fri-mon", `range "fri-mon" in day of week field ends before it starts`),
+	)
+
+	It("should leave the days to the other day field when one starts with a star", func() {
+		s, err := Parse("0 0 */2 * *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.domAny).To(BeTrue())
+		Expect(s.dowAny).To(BeTrue())
+
+		s, err = Parse("0 0 1,15 * */2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.domAny).To(BeFalse())
+		Expect(s.dowAny).To(BeTrue())
+	})
+})
+
+var _ = Describe("Next", func() {
+	DescribeTable("should find the next time the schedule matches",
+		func(spec, location, from, want string) {
+			s, err := Parse(spec)
+			Expect(err).NotTo(HaveOccurred())
+			next := s.Next(at(location, from))
+			Expect(next.Format("2006-01-02 15:04 -0700")).To(Equal(want))
+		},
+		Entry("the next minute", "* * * * *", "UTC", "2025-03-10 10:15", "2025-03-10 10:16 +0000"),
+		Entry("later in the hour", "30 * * * *", "UTC", "2025-03-10 10:15", "2025-03-10 10:30 +0000"),
+		Entry("the next hour", "5 * * * *", "UTC", "2025-03-10 10:15", "2025-03-10 11:05 +0000"),
+		Entry("a step of hours", "0 */6 * * *", "UTC", "2025-03-10 13:00", "2025-03-10 18:00 +0000"),
+		Entry("the next month", "0 0 1 * *", "UTC", "2025-01-31 12:00", "2025-02-01 00:00 +0000"),
+		Entry("the next year", "@yearly", "UTC", "2025-06-01 00:00", "2026-01-01 00:00 +0000"),
+		Entry("a leap day", "0 0 29 feb *", "UTC", "2025-03-01 00:00", "2028-02-29 00:00 +0000"),
+		Entry("Sunday as 7", "0 0 * * 7", "UTC", "2025-03-10 00:00", "2025-03-16 00:00 +0000"),
+		Entry("either restricted day field", "0 0 13 * fri", "UTC", "2025-06-01 00:00", "2025-06-06 00:00 +0000"),
+		Entry("the day of week only when the day of month steps from a star",
+			"0 0 */2 * mon", "UTC", "2025-06-01 00:00", "2025-06-09 00:00 +0000"),
+		Entry("the day of month only when the day of week steps from a star",
+			"0 0 10 * */2", "UTC", "2025-06-01 00:00", "2025-06-10 00:00 +0000"),
+		Entry("the next hour in a half-hour offset zone", "0 * * * *", "Asia/Kolkata", "2025-03-10 10:15", "2025-03-10 11:00 +0530"),
+		Entry("the next hour in a 45 minute offset zone", "15 */2 * * *", "Asia/Kathmandu", "2025-03-10 10:20", "2025-03-10 12:15 +0545"),
+		Entry("midnight in a half-hour offset zone", "@daily", "Australia/Adelaide", "2025-03-10 10:15", "2025-03-11 00:00 +1030"),
+		Entry("across the hour the clock skips", "30 * * * *", "America/New_York", "2025-03-09 01:45", "2025-03-09 03:30 -0400"),
+		Entry("not at a time the clock skips", "30 2 * * *", "America/New_York", "2025-03-09 00:00", "2025-03-10 02:30 -0400"),
+		Entry("once at a time the clock repeats", "30 1 * * *", "America/New_York", "2025-11-02 01:30 -0400", "2025-11-03 01:30 -0500"),
+		Entry("after the hour the clock repeats", "0 * * * *", "America/New_York", "2025-11-02 01:10 -0400", "2025-11-02 02:00 -0500"),
+		Entry("across a half-hour change of the clock", "45 1 * * *", "Australia/Lord_Howe", "2025-04-06 01:50 +1100", "2025-04-07 01:45 +1030"),
+	)
+
+	It("should give up on schedules that match no time", func() {
+		s, err := Parse("0 0 30 feb *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.Next(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))).To(BeZero())
+	})
+})
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package defaults holds the values the operator assumes for QiskitJob
+// settings left unset. The defaulting webhook writes them into the spec of
+// new jobs so the stored object shows what runs; the reconciler falls back to
+// the same values for jobs admitted without the webhook.
+package defaults
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Execution settings of jobs that set none
+const (
+	Shots             = 1024
+	OptimizationLevel = 1
+	Priority          = "normal"
+)
+
+// Qiskit primitives the executor drives
+const (
+	PrimitiveSampler   = "sampler"
+	PrimitiveEstimator = "estimator"
+)
+
+// OutputType is the type of the output of jobs that set none
+const OutputType = "configmap"
+
+// ExecutorRequests are the resources the executor requests unless the job
+// sets its own
+var ExecutorRequests = map[string]string{"cpu": "500m", "memory": "1Gi"}
+
+// ExecutorLimits limit the executor unless the job sets its own limits or
+// requests more
+var ExecutorLimits = map[string]string{"cpu": "2", "memory": "4Gi"}
+
+// ExecutorMinimums are the least the executor can start Python and Qiskit
+// with; jobs may neither request nor limit it to less
+var ExecutorMinimums = map[string]string{"cpu": "100m", "memory": "256Mi"}
+
+// OutputConfigMap names the ConfigMap the results of a job without an
+// output are stored in
+func OutputConfigMap(job string) string {
+	return job + "-results"
+}
+
+// Output returns the output of a job that sets none
+func Output(job *quantumv1.QiskitJob) *quantumv1.OutputSpec {
+	return &quantumv1.OutputSpec{Type: OutputType, Location: OutputConfigMap(job.Name), Format: "json"}
+}
+
+// Primitive returns the Qiskit primitive the job drives: the one it sets,
+// otherwise the estimator for jobs with estimator settings and the sampler
+// for the others
+func Primitive(spec *quantumv1.QiskitJobSpec) string {
+	switch {
+	case spec.Execution.Primitive != "":
+		return spec.Execution.Primitive
+	case spec.Estimator != nil:
+		return PrimitiveEstimator
+	default:
+		return PrimitiveSampler
+	}
+}
+
+// Estimates reports whether the job drives the estimator primitive
+func Estimates(spec *quantumv1.QiskitJobSpec) bool {
+	return Primitive(spec) == PrimitiveEstimator
+}
+
+// ResilienceLevel returns the IBM Quantum resilience level of the job: the
+// one its error mitigation sets, otherwise execution.resilienceLevel
+func ResilienceLevel(execution *quantumv1.ExecutionSpec) int {
+	if mitigation := execution.ErrorMitigation; mitigation != nil && mitigation.ResilienceLevel != nil {
+		return *mitigation.ResilienceLevel
+	}
+	return execution.ResilienceLevel
+}
+
+// Apply fills the unset execution settings and executor resources of the
+// job. A default limit below what the job requests is raised to the
+// request, the way the executor's resources are built.
+func Apply(job *quantumv1.QiskitJob) {
+	execution := &job.Spec.Execution
+	if execution.Shots == 0 {
+		execution.Shots = Shots
+	}
+	if execution.OptimizationLevel == 0 {
+		execution.OptimizationLevel = OptimizationLevel
+	}
+	if execution.Priority == "" {
+		execution.Priority = Priority
+	}
+	execution.Primitive = Primitive(&job.Spec)
+
+	if job.Spec.Resources == nil {
+		job.Spec.Resources = &quantumv1.ResourceRequirements{}
+	}
+	resources := job.Spec.Resources
+	if resources.Requests == nil {
+		resources.Requests = map[string]string{}
+	}
+	if resources.Limits == nil {
+		resources.Limits = map[string]string{}
+	}
+	for name, value := range ExecutorRequests {
+		if _, ok := resources.Requests[name]; !ok {
+			resources.Requests[name] = value
+		}
+	}
+	for name, value := range ExecutorLimits {
+		if _, ok := resources.Limits[name]; ok {
+			continue
+		}
+		resources.Limits[name] = value
+		request, err := resource.ParseQuantity(resources.Requests[name])
+		if err == nil && request.Cmp(resource.MustParse(value)) > 0 {
+			resources.Limits[name] = resources.Requests[name]
+		}
+	}
+}
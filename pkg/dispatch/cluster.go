@@ -0,0 +1,95 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ClusterSpoke dispatches jobs by creating their copies in the spoke's API
+// server directly
+type ClusterSpoke struct {
+	// Client reaches the spoke's API server
+	Client client.Client
+	// Cluster names the spoke in errors
+	Cluster string
+}
+
+// Dispatch creates the job's copy in the spoke. A job of the same name that
+// was not dispatched from this hub job is left alone and reported.
+func (s *ClusterSpoke) Dispatch(ctx context.Context, job *quantumv1.QiskitJob) error {
+	err := s.Client.Create(ctx, Copy(job))
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	if _, err := s.get(ctx, job); errors.Is(err, ErrNotDispatched) {
+		return fmt.Errorf("cluster %s already has a job %s/%s that was not dispatched from this one",
+			s.Cluster, job.Namespace, job.Name)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Status returns the status of the job's copy
+func (s *ClusterSpoke) Status(ctx context.Context, job *quantumv1.QiskitJob) (*quantumv1.QiskitJobStatus, error) {
+	remote, err := s.get(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if remote.Status.Phase == "" {
+		return nil, nil
+	}
+	return &remote.Status, nil
+}
+
+// Withdraw deletes the job's copy from the spoke
+func (s *ClusterSpoke) Withdraw(ctx context.Context, job *quantumv1.QiskitJob) error {
+	remote, err := s.get(ctx, job)
+	if errors.Is(err, ErrNotDispatched) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return client.IgnoreNotFound(s.Client.Delete(ctx, remote,
+		client.Preconditions{UID: &remote.UID}))
+}
+
+// get reads the job's copy, reporting ErrNotDispatched for jobs of the same
+// name dispatched from elsewhere
+func (s *ClusterSpoke) get(ctx context.Context, job *quantumv1.QiskitJob) (*quantumv1.QiskitJob, error) {
+	var remote quantumv1.QiskitJob
+	err := s.Client.Get(ctx, client.ObjectKeyFromObject(job), &remote)
+	if apierrors.IsNotFound(err) {
+		return nil, ErrNotDispatched
+	}
+	if err != nil {
+		return nil, err
+	}
+	if remote.Labels[HubJobLabel] != string(job.UID) {
+		return nil, ErrNotDispatched
+	}
+	return &remote, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dispatch runs QiskitJobs submitted to a hub cluster in spoke
+// clusters. The hub hands a copy of the job to the operator in the spoke,
+// either directly through a kubeconfig or through an Open Cluster Management
+// ManifestWork, and reads back the copy's status for the hub job to mirror.
+package dispatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/jobtemplate"
+)
+
+// HubJobLabel marks a job copy with the UID of the hub job it was dispatched
+// from
+const HubJobLabel = "quantum.io/hub-job-uid"
+
+// ErrNotDispatched reports that a spoke holds no copy of the job, because it
+// was never dispatched or was deleted there
+var ErrNotDispatched = errors.New("job is not dispatched to the cluster")
+
+// Spoke is a cluster jobs are dispatched to
+type Spoke interface {
+	// Dispatch hands a copy of the job to the spoke; dispatching it again
+	// is a no-op
+	Dispatch(ctx context.Context, job *quantumv1.QiskitJob) error
+	// Status returns the status of the job's copy, nil until the spoke
+	// reports one, or ErrNotDispatched
+	Status(ctx context.Context, job *quantumv1.QiskitJob) (*quantumv1.QiskitJobStatus, error)
+	// Withdraw deletes the job's copy, if any
+	Withdraw(ctx context.Context, job *quantumv1.QiskitJob) error
+}
+
+// Copy returns the job as it is run in a spoke: the same name, namespace,
+// labels and spec, without the placement cluster so the spoke runs it
+// itself. Templates were already applied in the hub and are not applied
+// again.
+func Copy(job *quantumv1.QiskitJob) *quantumv1.QiskitJob {
+	remote := &quantumv1.QiskitJob{
+		TypeMeta: metav1.TypeMeta{APIVersion: quantumv1.GroupVersion.String(), Kind: "QiskitJob"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+			Labels:    map[string]string{},
+		},
+		Spec: *job.Spec.DeepCopy(),
+	}
+	for k, v := range job.Labels {
+		remote.Labels[k] = v
+	}
+	remote.Labels[HubJobLabel] = string(job.UID)
+	if applied, ok := job.Annotations[jobtemplate.AppliedAnnotation]; ok {
+		remote.Annotations = map[string]string{jobtemplate.AppliedAnnotation: applied}
+	}
+	if remote.Spec.Placement != nil {
+		remote.Spec.Placement.Cluster = ""
+	}
+	return remote
+}
+
+// LoadKubeconfigs returns a spoke for each kubeconfig file in dir, named
+// after the file. Hidden files, such as the links of a mounted Secret, are
+// skipped.
+func LoadKubeconfigs(dir string, scheme *runtime.Scheme) (map[string]Spoke, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	spokes := map[string]Spoke{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		config, err := clientcmd.RESTConfigFromKubeConfig(data)
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig of cluster %s: %w", entry.Name(), err)
+		}
+		c, err := client.New(config, client.Options{Scheme: scheme})
+		if err != nil {
+			return nil, fmt.Errorf("client of cluster %s: %w", entry.Name(), err)
+		}
+		spokes[entry.Name()] = &ClusterSpoke{Client: c, Cluster: entry.Name()}
+	}
+	return spokes, nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/pkg/jobtemplate"
+)
+
+var _ = Describe("Job dispatch", func() {
+	var (
+		ctx = context.Background()
+		job *quantumv1.QiskitJob
+	)
+
+	BeforeEach(func() {
+		job = builder.NewBellStateJob("bell", "research").
+			WithAllowedRegions("us-east").
+			WithCluster("eu-spoke").
+			Build()
+		job.UID = types.UID("hub-uid")
+		job.Labels = map[string]string{"team": "physics"}
+		job.Annotations = map[string]string{jobtemplate.AppliedAnnotation: "bell/1", "note": "hub only"}
+	})
+
+	It("should copy the job for the spoke to run itself", func() {
+		remote := Copy(job)
+		Expect(remote.Name).To(Equal("bell"))
+		Expect(remote.Namespace).To(Equal("research"))
+		Expect(remote.Labels).To(Equal(map[string]string{"team": "physics", HubJobLabel: "hub-uid"}))
+		Expect(remote.Annotations).To(Equal(map[string]string{jobtemplate.AppliedAnnotation: "bell/1"}))
+		Expect(remote.Spec.Placement.Cluster).To(BeEmpty())
+		Expect(remote.Spec.Placement.AllowedRegions).To(Equal([]string{"us-east"}))
+		Expect(job.Spec.Placement.Cluster).To(Equal("eu-spoke"))
+	})
+
+	Context("through a kubeconfig", func() {
+		var (
+			c     client.Client
+			spoke *ClusterSpoke
+		)
+
+		BeforeEach(func() {
+			c = fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&quantumv1.QiskitJob{}).Build()
+			spoke = &ClusterSpoke{Client: c, Cluster: "eu-spoke"}
+		})
+
+		It("should create the copy once and mirror its status", func() {
+			Expect(spoke.Dispatch(ctx, job)).To(Succeed())
+			Expect(spoke.Dispatch(ctx, job)).To(Succeed())
+
+			status, err := spoke.Status(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(BeNil())
+
+			var remote quantumv1.QiskitJob
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(job), &remote)).To(Succeed())
+			remote.Status.Phase = "Completed"
+			remote.Status.Results = &quantumv1.ResultsInfo{Shots: 1024}
+			Expect(c.Status().Update(ctx, &remote)).To(Succeed())
+
+			status, err = spoke.Status(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Phase).To(Equal(quantumv1.QiskitJobCompleted))
+			Expect(status.Results.Shots).To(Equal(1024))
+
+			Expect(spoke.Withdraw(ctx, job)).To(Succeed())
+			_, err = spoke.Status(ctx, job)
+			Expect(err).To(MatchError(ErrNotDispatched))
+			Expect(spoke.Withdraw(ctx, job)).To(Succeed())
+		})
+
+		It("should leave jobs of the same name from elsewhere alone", func() {
+			other := Copy(job)
+			other.Labels[HubJobLabel] = "other-uid"
+			Expect(c.Create(ctx, other)).To(Succeed())
+
+			Expect(spoke.Dispatch(ctx, job)).To(MatchError(ContainSubstring("not dispatched from this one")))
+			_, err := spoke.Status(ctx, job)
+			Expect(err).To(MatchError(ErrNotDispatched))
+			Expect(spoke.Withdraw(ctx, job)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(job), &quantumv1.QiskitJob{})).To(Succeed())
+		})
+	})
+
+	Context("through a ManifestWork", func() {
+		var (
+			c     client.Client
+			spoke *ManifestWorkSpoke
+		)
+
+		BeforeEach(func() {
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(ManifestWorkGVK, meta.RESTScopeNamespace)
+			c = fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).Build()
+			spoke = &ManifestWorkSpoke{Client: c, Cluster: "eu-spoke"}
+		})
+
+		getWork := func() *unstructured.Unstructured {
+			work := &unstructured.Unstructured{}
+			work.SetGroupVersionKind(ManifestWorkGVK)
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "eu-spoke", Name: "qiskitjob-hub-uid"}, work)).To(Succeed())
+			return work
+		}
+
+		It("should create the work in the cluster's namespace with status feedback", func() {
+			Expect(spoke.Dispatch(ctx, job)).To(Succeed())
+			Expect(spoke.Dispatch(ctx, job)).To(Succeed())
+
+			work := getWork()
+			Expect(work.GetLabels()).To(HaveKeyWithValue(HubJobLabel, "hub-uid"))
+			manifests, _, _ := unstructured.NestedSlice(work.Object, "spec", "workload", "manifests")
+			Expect(manifests).To(HaveLen(1))
+			manifest := manifests[0].(map[string]interface{})
+			Expect(manifest).To(HaveKeyWithValue("kind", "QiskitJob"))
+			Expect(manifest).NotTo(HaveKey("status"))
+			cluster, _, _ := unstructured.NestedString(manifest, "spec", "placement", "cluster")
+			Expect(cluster).To(BeEmpty())
+			configs, _, _ := unstructured.NestedSlice(work.Object, "spec", "manifestConfigs")
+			Expect(configs).To(HaveLen(1))
+			name, _, _ := unstructured.NestedString(configs[0].(map[string]interface{}), "resourceIdentifier", "name")
+			Expect(name).To(Equal("bell"))
+
+			status, err := spoke.Status(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(BeNil())
+		})
+
+		It("should read the copy's status from the feedback", func() {
+			Expect(spoke.Dispatch(ctx, job)).To(Succeed())
+			work := getWork()
+			Expect(unstructured.SetNestedSlice(work.Object, []interface{}{map[string]interface{}{
+				"resourceMeta": map[string]interface{}{"kind": "QiskitJob", "name": "bell"},
+				"statusFeedback": map[string]interface{}{"values": []interface{}{
+					map[string]interface{}{"name": "phase", "fieldValue": map[string]interface{}{"type": "String", "string": "Failed"}},
+					map[string]interface{}{"name": "retryCount", "fieldValue": map[string]interface{}{"type": "Integer", "integer": int64(3)}},
+					map[string]interface{}{"name": "selectedBackend", "fieldValue": map[string]interface{}{"type": "String", "string": "aer_simulator"}},
+					map[string]interface{}{"name": "resultsShots", "fieldValue": map[string]interface{}{"type": "Integer", "integer": int64(512)}},
+				}},
+			}}, "status", "resourceStatus", "manifests")).To(Succeed())
+			Expect(c.Update(ctx, work)).To(Succeed())
+
+			status, err := spoke.Status(ctx, job)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Phase).To(Equal(quantumv1.QiskitJobFailed))
+			Expect(status.RetryCount).To(Equal(3))
+			Expect(status.SelectedBackend).To(Equal("aer_simulator"))
+			Expect(status.Results).To(Equal(&quantumv1.ResultsInfo{Shots: 512}))
+
+			Expect(spoke.Withdraw(ctx, job)).To(Succeed())
+			_, err = spoke.Status(ctx, job)
+			Expect(err).To(MatchError(ErrNotDispatched))
+		})
+	})
+})
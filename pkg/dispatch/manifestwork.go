@@ -0,0 +1,196 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatch
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ManifestWorkGVK is the kind of Open Cluster Management ManifestWorks
+var ManifestWorkGVK = schema.GroupVersionKind{
+	Group:   "work.open-cluster-management.io",
+	Version: "v1",
+	Kind:    "ManifestWork",
+}
+
+// feedback lists the status fields of a job copy the spoke's work agent
+// reports back, by feedback value name. ManifestWork feedback only carries
+// scalars, so results are summarized by their location and shots.
+var feedback = []struct{ name, path string }{
+	{"phase", ".status.phase"},
+	{"message", ".status.message"},
+	{"retryCount", ".status.retryCount"},
+	{"selectedBackend", ".status.selectedBackend"},
+	{"region", ".status.region"},
+	{"actualCost", ".status.actualCost"},
+	{"resultsLocation", ".status.results.location"},
+	{"resultsShots", ".status.results.shots"},
+	{"resultsExecutionTime", ".status.results.executionTime"},
+}
+
+// ManifestWorkSpoke dispatches jobs to an Open Cluster Management managed
+// cluster by creating ManifestWorks in the cluster's namespace of the hub.
+// The cluster's work agent applies the job copy and reports its status
+// fields back as feedback.
+type ManifestWorkSpoke struct {
+	// Client manages ManifestWorks in the hub
+	Client client.Client
+	// Cluster is the managed cluster, whose hub namespace holds its works
+	Cluster string
+}
+
+// WorkName names the ManifestWork of a job. It is derived from the job's
+// UID, so it is unique within the cluster namespace.
+func WorkName(job *quantumv1.QiskitJob) string {
+	return "qiskitjob-" + string(job.UID)
+}
+
+// Dispatch creates the job's ManifestWork
+func (s *ManifestWorkSpoke) Dispatch(ctx context.Context, job *quantumv1.QiskitJob) error {
+	manifest, err := runtime.DefaultUnstructuredConverter.ToUnstructured(Copy(job))
+	if err != nil {
+		return err
+	}
+	delete(manifest, "status")
+	if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTimestamp")
+	}
+
+	jsonPaths := make([]interface{}, 0, len(feedback))
+	for _, f := range feedback {
+		jsonPaths = append(jsonPaths, map[string]interface{}{"name": f.name, "path": f.path})
+	}
+	work := &unstructured.Unstructured{}
+	work.SetGroupVersionKind(ManifestWorkGVK)
+	work.SetName(WorkName(job))
+	work.SetNamespace(s.Cluster)
+	work.SetLabels(map[string]string{HubJobLabel: string(job.UID)})
+	work.Object["spec"] = map[string]interface{}{
+		"workload": map[string]interface{}{
+			"manifests": []interface{}{manifest},
+		},
+		"manifestConfigs": []interface{}{map[string]interface{}{
+			"resourceIdentifier": map[string]interface{}{
+				"group":     quantumv1.GroupVersion.Group,
+				"resource":  "qiskitjobs",
+				"namespace": job.Namespace,
+				"name":      job.Name,
+			},
+			"feedbackRules": []interface{}{map[string]interface{}{
+				"type":      "JSONPaths",
+				"jsonPaths": jsonPaths,
+			}},
+		}},
+	}
+	return client.IgnoreAlreadyExists(s.Client.Create(ctx, work))
+}
+
+// Status returns the job copy's status fields the work agent reported
+func (s *ManifestWorkSpoke) Status(ctx context.Context, job *quantumv1.QiskitJob) (*quantumv1.QiskitJobStatus, error) {
+	work, err := s.get(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	manifests, _, _ := unstructured.NestedSlice(work.Object, "status", "resourceStatus", "manifests")
+	for _, m := range manifests {
+		manifest, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if kind, _, _ := unstructured.NestedString(manifest, "resourceMeta", "kind"); kind != "QiskitJob" {
+			continue
+		}
+		values, _, _ := unstructured.NestedSlice(manifest, "statusFeedback", "values")
+		return feedbackStatus(values), nil
+	}
+	return nil, nil
+}
+
+// Withdraw deletes the job's ManifestWork, which has the work agent delete
+// the copy
+func (s *ManifestWorkSpoke) Withdraw(ctx context.Context, job *quantumv1.QiskitJob) error {
+	work := &unstructured.Unstructured{}
+	work.SetGroupVersionKind(ManifestWorkGVK)
+	work.SetName(WorkName(job))
+	work.SetNamespace(s.Cluster)
+	return client.IgnoreNotFound(s.Client.Delete(ctx, work))
+}
+
+// get reads the job's ManifestWork
+func (s *ManifestWorkSpoke) get(ctx context.Context, job *quantumv1.QiskitJob) (*unstructured.Unstructured, error) {
+	work := &unstructured.Unstructured{}
+	work.SetGroupVersionKind(ManifestWorkGVK)
+	err := s.Client.Get(ctx, client.ObjectKey{Namespace: s.Cluster, Name: WorkName(job)}, work)
+	if apierrors.IsNotFound(err) {
+		return nil, ErrNotDispatched
+	}
+	if err != nil {
+		return nil, err
+	}
+	return work, nil
+}
+
+// feedbackStatus builds a status from feedback values, nil until the spoke
+// reported a phase
+func feedbackStatus(values []interface{}) *quantumv1.QiskitJobStatus {
+	status := &quantumv1.QiskitJobStatus{}
+	results := &quantumv1.ResultsInfo{}
+	for _, v := range values {
+		value, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(value, "name")
+		str, _, _ := unstructured.NestedString(value, "fieldValue", "string")
+		num, _, _ := unstructured.NestedInt64(value, "fieldValue", "integer")
+		switch name {
+		case "phase":
+			status.Phase = quantumv1.QiskitJobPhase(str)
+		case "message":
+			status.Message = str
+		case "retryCount":
+			status.RetryCount = int(num)
+		case "selectedBackend":
+			status.SelectedBackend = str
+		case "region":
+			status.Region = str
+		case "actualCost":
+			status.ActualCost = str
+		case "resultsLocation":
+			results.Location = str
+		case "resultsShots":
+			results.Shots = int(num)
+		case "resultsExecutionTime":
+			results.ExecutionTime = str
+		}
+	}
+	if status.Phase == "" {
+		return nil
+	}
+	if results.Location != "" || results.Shots != 0 || results.ExecutionTime != "" {
+		status.Results = results
+	}
+	return status
+}
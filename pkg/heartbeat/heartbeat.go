@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package heartbeat lets the operator tell a slow execution from a hung one.
+// The executor prints a marker line at a fixed interval, optionally carrying
+// the stage the circuit reported; the operator looks for it in the recent
+// logs of running execution pods.
+package heartbeat
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Marker starts every heartbeat line: "<Marker> <unix seconds> <progress>"
+const Marker = "QISKIT_OPERATOR_HEARTBEAT"
+
+// DefaultInterval is how often the executor emits a heartbeat
+const DefaultInterval = 30 * time.Second
+
+// Environment variables configuring the executor
+const (
+	// IntervalEnv carries the heartbeat interval in seconds
+	IntervalEnv = "HEARTBEAT_INTERVAL"
+	// HangDumpEnv is "1" when a py-spy dump is taken of hung executors
+	HangDumpEnv = "HANG_DUMP"
+)
+
+// UsageMarker starts every resource usage line executors with GPUs print
+// alongside their heartbeats: "<UsageMarker> <unix seconds> gpu=<utilization>",
+// the mean utilization of the pod's GPUs from 0 to 1
+const UsageMarker = "QISKIT_OPERATOR_USAGE"
+
+// DumpMarker precedes the py-spy dump of a hung executor in the pod logs
+const DumpMarker = "QISKIT_OPERATOR_HANG_DUMP"
+
+// Prologue is prepended to the circuit code. A daemon thread prints a
+// heartbeat every interval, so heartbeats stop when the interpreter freezes,
+// and the utilization of the pod's GPUs when nvidia-smi is available.
+// Circuits may call report_progress(stage) to publish what they are doing.
+const Prologue = `import os as _hb_os
+import shutil as _hb_shutil
+import subprocess as _hb_subprocess
+import threading as _hb_threading
+import time as _hb_time
+
+_hb_progress = ['running']
+
+def _hb_beat():
+    print('` + Marker + ` %d %s' % (_hb_time.time(), _hb_progress[0]), flush=True)
+
+def report_progress(stage):
+    _hb_progress[0] = ' '.join(str(stage).split())
+    _hb_beat()
+
+def _hb_usage():
+    try:
+        result = _hb_subprocess.run(['nvidia-smi', '--query-gpu=utilization.gpu', '--format=csv,noheader,nounits'],
+            capture_output=True, text=True, timeout=10)
+        samples = [float(line) for line in result.stdout.split() if line.strip()]
+    except Exception:
+        return
+    if samples:
+        print('` + UsageMarker + ` %d gpu=%.3f' % (_hb_time.time(), sum(samples) / len(samples) / 100), flush=True)
+
+_hb_gpus = _hb_shutil.which('nvidia-smi') is not None
+
+def _hb_loop():
+    while True:
+        _hb_beat()
+        if _hb_gpus:
+            _hb_usage()
+        _hb_time.sleep(int(_hb_os.environ.get('` + IntervalEnv + `', '30')))
+
+_hb_threading.Thread(target=_hb_loop, daemon=True).start()
+
+# Let py-spy, started by the shell rather than an ancestor, attach for a hang dump
+if _hb_os.environ.get('` + HangDumpEnv + `') == '1':
+    try:
+        import ctypes as _hb_ctypes
+        _hb_ctypes.CDLL(None).prctl(0x59616d61, _hb_ctypes.c_ulong(-1), 0, 0, 0)
+    except Exception:
+        pass
+
+`
+
+// Beat is a heartbeat found in the logs
+type Beat struct {
+	// Time the executor emitted the heartbeat
+	Time time.Time
+	// Stage last reported by the circuit, "running" if none
+	Progress string
+}
+
+// Last returns the most recent heartbeat in logs
+func Last(logs string) (Beat, bool) {
+	var last Beat
+	found := false
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		rest, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), Marker+" ")
+		if !ok {
+			continue
+		}
+		stamp, progress, _ := strings.Cut(rest, " ")
+		seconds, err := strconv.ParseInt(stamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		last, found = Beat{Time: time.Unix(seconds, 0), Progress: progress}, true
+	}
+	return last, found
+}
+
+// Usage is a resource usage sample found in the logs
+type Usage struct {
+	// Time the executor took the sample
+	Time time.Time
+	// GPUUtilization is the mean utilization of the pod's GPUs from 0 to 1
+	GPUUtilization float64
+}
+
+// LastUsage returns the most recent resource usage sample in logs
+func LastUsage(logs string) (Usage, bool) {
+	var last Usage
+	found := false
+	scanner := bufio.NewScanner(strings.NewReader(logs))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		rest, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), UsageMarker+" ")
+		if !ok {
+			continue
+		}
+		stamp, sample, _ := strings.Cut(rest, " ")
+		seconds, err := strconv.ParseInt(stamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		value, ok := strings.CutPrefix(sample, "gpu=")
+		if !ok {
+			continue
+		}
+		utilization, err := strconv.ParseFloat(value, 64)
+		if err != nil || utilization < 0 || utilization > 1 {
+			continue
+		}
+		last, found = Usage{Time: time.Unix(seconds, 0), GPUUtilization: utilization}, true
+	}
+	return last, found
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hints defines the annotations external schedulers, and people,
+// set on QiskitJobs to steer their admission and backend choice at runtime.
+// A job's spec says what it runs and stays as it was submitted; hints only
+// decide when the job starts and which of the backends its spec allows it
+// runs on, and can be set, changed and removed at any time.
+package hints
+
+import (
+	"slices"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+const (
+	// HoldAnnotation holds a job before its next attempt for as long as it
+	// is set. Its value, if any, is the reason recorded on the job.
+	HoldAnnotation = "quantum.io/hold"
+	// TargetBackendAnnotation names the candidate backend the job runs on,
+	// in place of the one its backend selection scores best
+	TargetBackendAnnotation = "quantum.io/target-backend"
+	// QueueAnnotation names the queue of an external batch scheduler the
+	// job's execution pods are admitted through
+	QueueAnnotation = "quantum.io/queue"
+)
+
+const (
+	// QueueLabel carries a job's queue onto its executions and their pods
+	QueueLabel = "quantum.io/queue"
+	// KueueQueueLabel hands an execution to the Kueue LocalQueue it names
+	KueueQueueLabel = "kueue.x-k8s.io/queue-name"
+)
+
+// Hold reports whether the job is held by HoldAnnotation, and why
+func Hold(job *quantumv1.QiskitJob) (string, bool) {
+	reason, ok := job.Annotations[HoldAnnotation]
+	return reason, ok
+}
+
+// TargetBackend returns the backend the job is pinned to, if any
+func TargetBackend(job *quantumv1.QiskitJob) string {
+	return job.Annotations[TargetBackendAnnotation]
+}
+
+// Queue returns the external scheduler queue of the job, if any
+func Queue(job *quantumv1.QiskitJob) string {
+	return job.Annotations[QueueAnnotation]
+}
+
+// Candidates returns the backends the job may run on, by name: its
+// spec.backend and, for ibm_quantum jobs with a backend selection, the
+// preferred devices of the same instance, less the excluded ones
+func Candidates(job *quantumv1.QiskitJob) []string {
+	own := job.Spec.Backend.Name
+	if own == "" {
+		own = job.Spec.Backend.Type
+	}
+	names := []string{own}
+	selection := job.Spec.BackendSelection
+	if selection == nil {
+		return names
+	}
+	if job.Spec.Backend.Type == "ibm_quantum" {
+		for _, name := range selection.PreferredBackends {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return slices.DeleteFunc(names, func(name string) bool {
+		return slices.Contains(selection.ExcludedBackends, name)
+	})
+}
+
+// Validate checks the hints set on the job. A target backend must be one
+// of the job's candidates, so hints never move a job to a backend its spec
+// does not allow; jobs whose backend a QuantumBackend supplies cannot be
+// targeted.
+func Validate(job *quantumv1.QiskitJob) field.ErrorList {
+	var allErrs field.ErrorList
+	annotations := field.NewPath("metadata", "annotations")
+
+	if target := TargetBackend(job); target != "" {
+		path := annotations.Key(TargetBackendAnnotation)
+		switch {
+		case job.Spec.BackendRef != nil:
+			allErrs = append(allErrs, field.Forbidden(path, "not supported for jobs with spec.backendRef"))
+		case !slices.Contains(Candidates(job), target):
+			allErrs = append(allErrs, field.NotSupported(path, target, Candidates(job)))
+		}
+	}
+	if queue, ok := job.Annotations[QueueAnnotation]; ok {
+		path := annotations.Key(QueueAnnotation)
+		if queue == "" {
+			allErrs = append(allErrs, field.Required(path, "must name a queue"))
+		}
+		for _, msg := range utilvalidation.IsValidLabelValue(queue) {
+			allErrs = append(allErrs, field.Invalid(path, queue, msg))
+		}
+	}
+	return allErrs
+}
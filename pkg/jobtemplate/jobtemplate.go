@@ -0,0 +1,205 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jobtemplate instantiates QiskitJobs from QiskitJobTemplates. The
+// template's settings are copied into the job spec once, when the job is
+// admitted or first reconciled, so later template edits never change jobs
+// that already exist.
+package jobtemplate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// AppliedAnnotation records the template and generation a job was
+// instantiated from as "<name>/<generation>"
+const AppliedAnnotation = "quantum.io/template-applied"
+
+// OverrideError reports overrides the template does not allow
+type OverrideError struct {
+	Template string
+	Paths    []string
+}
+
+func (e *OverrideError) Error() string {
+	return fmt.Sprintf("template %q does not allow overriding %s", e.Template, strings.Join(e.Paths, ", "))
+}
+
+// Applied reports whether a template has already been applied to the job
+func Applied(job *quantumv1.QiskitJob) bool {
+	_, ok := job.Annotations[AppliedAnnotation]
+	return ok
+}
+
+// Resolve fetches the job's template and applies it. It returns false without
+// changing the job if the job references no template or was already
+// instantiated.
+func Resolve(ctx context.Context, r client.Reader, job *quantumv1.QiskitJob) (bool, error) {
+	if job.Spec.TemplateRef == nil || Applied(job) {
+		return false, nil
+	}
+
+	var tmpl quantumv1.QiskitJobTemplate
+	if err := r.Get(ctx, client.ObjectKey{Name: job.Spec.TemplateRef.Name}, &tmpl); err != nil {
+		return false, err
+	}
+	if err := Apply(job, &tmpl); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Apply replaces the template-owned fields of the job spec with the
+// template's settings plus the job's permitted overrides. The circuit,
+// session and deduplication policy always come from the job.
+func Apply(job *quantumv1.QiskitJob, tmpl *quantumv1.QiskitJobTemplate) error {
+	overrides, err := toMap(job.Spec.Overrides)
+	if err != nil {
+		return err
+	}
+	if denied := deniedPaths(leafPaths(overrides, ""), tmpl.Spec.AllowedOverrides); len(denied) > 0 {
+		return &OverrideError{Template: tmpl.Name, Paths: denied}
+	}
+
+	settings, err := toMap(&tmpl.Spec)
+	if err != nil {
+		return err
+	}
+	delete(settings, "allowedOverrides")
+	merge(settings, overrides)
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	var effective quantumv1.QiskitJobTemplateSpec
+	if err := json.Unmarshal(data, &effective); err != nil {
+		return err
+	}
+
+	job.Spec.Backend = effective.Backend
+	job.Spec.Execution = effective.Execution
+	job.Spec.Resources = effective.Resources
+	job.Spec.Budget = effective.Budget
+	job.Spec.Output = nil
+	job.Spec.Outputs = nil
+	if effective.Output != nil {
+		job.Spec.Outputs = []quantumv1.OutputSpec{*effective.Output}
+	}
+	job.Spec.Credentials = effective.Credentials
+	job.Spec.Security = effective.Security
+	job.Spec.BackendSelection = effective.BackendSelection
+	job.Spec.Placement = effective.Placement
+	job.Spec.Scheduling = effective.Scheduling
+
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[AppliedAnnotation] = fmt.Sprintf("%s/%d", tmpl.Name, tmpl.Generation)
+	return nil
+}
+
+// Settings returns the fields of a job spec that a template owns, but for
+// its outputs: templates have one output, which jobs store in a list
+func Settings(spec *quantumv1.QiskitJobSpec) quantumv1.QiskitJobTemplateSpec {
+	return quantumv1.QiskitJobTemplateSpec{
+		Backend:          spec.Backend,
+		Execution:        spec.Execution,
+		Resources:        spec.Resources,
+		Budget:           spec.Budget,
+		Credentials:      spec.Credentials,
+		Security:         spec.Security,
+		BackendSelection: spec.BackendSelection,
+		Placement:        spec.Placement,
+		Scheduling:       spec.Scheduling,
+	}
+}
+
+// toMap converts an API struct into its JSON object form
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// leafPaths lists the dotted paths of every value set in m. Empty strings are
+// treated as unset, since some API fields lack omitempty.
+func leafPaths(m map[string]interface{}, prefix string) []string {
+	var paths []string
+	for key, value := range m {
+		path := prefix + key
+		switch v := value.(type) {
+		case map[string]interface{}:
+			paths = append(paths, leafPaths(v, path+".")...)
+		case string:
+			if v != "" {
+				paths = append(paths, path)
+			}
+		default:
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// deniedPaths returns the paths not covered by an allowed path or one of its parents
+func deniedPaths(paths, allowed []string) []string {
+	var denied []string
+	for _, path := range paths {
+		permitted := false
+		for _, a := range allowed {
+			if path == a || strings.HasPrefix(path, a+".") {
+				permitted = true
+				break
+			}
+		}
+		if !permitted {
+			denied = append(denied, path)
+		}
+	}
+	sort.Strings(denied)
+	return denied
+}
+
+// merge overlays src onto dst, descending into nested objects
+func merge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if s, ok := value.(string); ok && s == "" {
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			if existing, ok := dst[key].(map[string]interface{}); ok {
+				merge(existing, nested)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
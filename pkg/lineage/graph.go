@@ -0,0 +1,409 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lineage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Kinds of the nodes of a provenance graph
+const (
+	KindJob        = "QiskitJob"
+	KindTemplate   = "QiskitJobTemplate"
+	KindSession    = "QiskitSession"
+	KindExperiment = "Experiment"
+	KindCode       = "Code"
+	KindDevice     = "Device"
+)
+
+// Kinds of the edges of a provenance graph, from a job to what it came from
+const (
+	EdgeRerunOf            = "rerunOf"
+	EdgeDependsOn          = "dependsOn"
+	EdgeDuplicateOf        = "duplicateOf"
+	EdgeMemberOfExperiment = "memberOfExperiment"
+	EdgeUsedTemplate       = "usedTemplate"
+	EdgeUsedSession        = "usedSession"
+	EdgeRanCode            = "ranCode"
+	EdgeRanOn              = "ranOn"
+)
+
+// MaxJobs is the most jobs a graph walks back through
+const MaxJobs = 100
+
+// Node is a job, or something a job came from
+type Node struct {
+	// ID identifies the node in the graph's edges
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Attributes describe the node, e.g. a job's phase and results digest
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Missing marks jobs that are referenced but no longer exist
+	Missing bool `json:"missing,omitempty"`
+}
+
+// Edge leads from a job to a node it came from
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+// Graph is the provenance of a job's results
+type Graph struct {
+	// Root is the ID of the job the graph was built for
+	Root  string `json:"root"`
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+	// Truncated is set when the graph stopped at MaxJobs jobs
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// graphBuilder accumulates the nodes and edges of a graph
+type graphBuilder struct {
+	graph *Graph
+	nodes map[string]int
+}
+
+// node adds a node unless the graph has it, and returns its ID
+func (b *graphBuilder) node(n Node) string {
+	if _, ok := b.nodes[n.ID]; !ok {
+		b.nodes[n.ID] = len(b.graph.Nodes)
+		b.graph.Nodes = append(b.graph.Nodes, n)
+	}
+	return n.ID
+}
+
+// edge adds an edge from a job
+func (b *graphBuilder) edge(from, to, kind string) {
+	b.graph.Edges = append(b.graph.Edges, Edge{From: from, To: to, Kind: kind})
+}
+
+// JobID returns the ID of a job's node
+func JobID(namespace, name string) string {
+	return KindJob + "/" + namespace + "/" + name
+}
+
+// Build walks the provenance of the named job back through the jobs it
+// reruns, depends on or duplicates, all in its namespace. It returns the
+// error of reading the job itself; jobs it references that are gone are
+// marked missing.
+func Build(ctx context.Context, r client.Reader, namespace, name string) (*Graph, error) {
+	var root quantumv1.QiskitJob
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &root); err != nil {
+		return nil, err
+	}
+	b := &graphBuilder{graph: &Graph{Root: JobID(namespace, name)}, nodes: map[string]int{}}
+	queue := []*quantumv1.QiskitJob{&root}
+	visited := map[string]bool{name: true}
+	for len(queue) > 0 {
+		job := queue[0]
+		queue = queue[1:]
+		for _, upstream := range b.addJob(job) {
+			if visited[upstream] {
+				continue
+			}
+			visited[upstream] = true
+			if len(visited) > MaxJobs {
+				b.graph.Truncated = true
+				continue
+			}
+			var next quantumv1.QiskitJob
+			err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: upstream}, &next)
+			switch {
+			case apierrors.IsNotFound(err):
+				b.graph.Nodes[b.nodes[JobID(namespace, upstream)]].Missing = true
+			case err != nil:
+				return nil, err
+			default:
+				queue = append(queue, &next)
+			}
+		}
+	}
+	b.graph.Edges = uniqueEdges(b.graph.Edges)
+	return b.graph, nil
+}
+
+// addJob adds a job, its edges and the nodes they lead to, and returns the
+// names of the jobs it came from
+func (b *graphBuilder) addJob(job *quantumv1.QiskitJob) []string {
+	id := JobID(job.Namespace, job.Name)
+	if i, ok := b.nodes[id]; ok {
+		b.graph.Nodes[i].Attributes = jobAttributes(job)
+	} else {
+		b.node(Node{ID: id, Kind: KindJob, Name: job.Namespace + "/" + job.Name, Attributes: jobAttributes(job)})
+	}
+
+	var upstream []string
+	jobEdge := func(name, kind string) {
+		to := b.node(Node{ID: JobID(job.Namespace, name), Kind: KindJob, Name: job.Namespace + "/" + name})
+		b.edge(id, to, kind)
+		upstream = append(upstream, name)
+	}
+	lineage := Of(job)
+	if lineage == nil {
+		lineage = &quantumv1.JobLineage{}
+	}
+	if lineage.RerunOf != "" && lineage.RerunOf != job.Name {
+		jobEdge(lineage.RerunOf, EdgeRerunOf)
+	}
+	for _, name := range lineage.DependsOn {
+		jobEdge(name, EdgeDependsOn)
+	}
+	if duplicate := job.Status.DuplicateOf; duplicate != "" && duplicate != job.Name {
+		jobEdge(duplicate, EdgeDuplicateOf)
+	}
+	if experiment := lineage.MemberOfExperiment; experiment != "" {
+		b.edge(id, b.node(Node{ID: KindExperiment + "/" + job.Namespace + "/" + experiment,
+			Kind: KindExperiment, Name: experiment}), EdgeMemberOfExperiment)
+	}
+	if template := lineage.UsedTemplate; template != "" {
+		name, generation, _ := strings.Cut(template, "/")
+		node := Node{ID: KindTemplate + "/" + template, Kind: KindTemplate, Name: name}
+		if generation != "" {
+			node.Attributes = map[string]string{"generation": generation}
+		}
+		b.edge(id, b.node(node), EdgeUsedTemplate)
+	}
+	if session := lineage.UsedSession; session != "" {
+		b.edge(id, b.node(Node{ID: KindSession + "/" + job.Namespace + "/" + session,
+			Kind: KindSession, Name: session}), EdgeUsedSession)
+	}
+	b.edge(id, b.node(codeNode(job)), EdgeRanCode)
+	if device := deviceNode(job); device != nil {
+		b.edge(id, b.node(*device), EdgeRanOn)
+	}
+	return upstream
+}
+
+// jobAttributes describes what a job produced and under which conditions
+func jobAttributes(job *quantumv1.QiskitJob) map[string]string {
+	status := &job.Status
+	attributes := map[string]string{
+		"phase":         string(status.Phase),
+		"executorImage": status.ExecutorImage,
+		"qiskitVersion": status.QiskitVersion,
+		"jobId":         status.JobID,
+		"sessionId":     status.SessionID,
+	}
+	if !job.CreationTimestamp.IsZero() {
+		attributes["created"] = job.CreationTimestamp.UTC().Format(time.RFC3339)
+	}
+	if status.CompletionTime != nil {
+		attributes["completed"] = status.CompletionTime.UTC().Format(time.RFC3339)
+	}
+	if info := status.BackendInfo; info != nil {
+		attributes["backendVersion"] = info.Version
+		if info.CalibrationTimestamp != nil {
+			attributes["calibrated"] = info.CalibrationTimestamp.UTC().Format(time.RFC3339)
+		}
+	}
+	if results := status.Results; results != nil {
+		attributes["results"] = results.Location
+		attributes["digest"] = results.Digest
+		attributes["signingKey"] = results.SigningKey
+		if len(results.Mitigations) > 0 {
+			attributes["mitigations"] = strings.Join(results.Mitigations, ",")
+		}
+	}
+	for key, value := range attributes {
+		if value == "" {
+			delete(attributes, key)
+		}
+	}
+	return attributes
+}
+
+// codeNode describes the code a job ran. Jobs running the same code share
+// the node through the circuit's hash.
+func codeNode(job *quantumv1.QiskitJob) Node {
+	circuit := &job.Spec.Circuit
+	node := Node{
+		ID:         KindCode + "/" + job.Namespace + "/" + job.Name,
+		Kind:       KindCode,
+		Name:       circuit.Source,
+		Attributes: map[string]string{"source": circuit.Source},
+	}
+	if metadata := job.Status.CircuitMetadata; metadata != nil && metadata.Hash != "" {
+		node.ID = KindCode + "/" + metadata.Hash
+		node.Attributes["hash"] = metadata.Hash
+	}
+	switch {
+	case circuit.GitRef != nil:
+		node.Name = circuit.GitRef.Repository
+		node.Attributes["repository"] = circuit.GitRef.Repository
+		node.Attributes["branch"] = circuit.GitRef.Branch
+		node.Attributes["path"] = circuit.GitRef.Path
+		node.Attributes["commit"] = job.Status.CircuitCommit
+		if commit := job.Status.CircuitCommit; commit != "" {
+			// The same branch runs different code once it moves on
+			node.ID = KindCode + "/" + circuit.GitRef.Repository + "@" + commit
+		}
+	case circuit.URL != "":
+		node.Name = circuit.URL
+		node.Attributes["url"] = circuit.URL
+		node.Attributes["sha256"] = circuit.SHA256
+	case circuit.ConfigMapRef != nil:
+		node.Name = "configmap " + circuit.ConfigMapRef.Name
+		node.Attributes["configMap"] = circuit.ConfigMapRef.Name + "/" + circuit.ConfigMapRef.Key
+	case circuit.Bundle != nil && circuit.Bundle.ConfigMapRef != nil:
+		node.Name = "bundle " + circuit.Bundle.ConfigMapRef.Name
+		node.Attributes["bundle"] = circuit.Bundle.ConfigMapRef.Name + "/" + circuit.Bundle.ConfigMapRef.Key
+	}
+	node.Attributes["format"] = circuit.Format
+	node.Attributes["entrypoint"] = circuit.Entrypoint
+	for key, value := range node.Attributes {
+		if value == "" {
+			delete(node.Attributes, key)
+		}
+	}
+	return node
+}
+
+// deviceNode describes the backend a job ran on, or nil before one was
+// selected
+func deviceNode(job *quantumv1.QiskitJob) *Node {
+	backend := job.Status.SelectedBackend
+	if backend == "" {
+		return nil
+	}
+	node := &Node{ID: KindDevice + "/" + backend, Kind: KindDevice, Name: backend,
+		Attributes: map[string]string{"type": job.Spec.Backend.Type}}
+	if info := job.Status.BackendInfo; info != nil && info.Qubits > 0 {
+		node.Attributes["qubits"] = fmt.Sprint(info.Qubits)
+	}
+	if node.Attributes["type"] == "" {
+		node.Attributes = nil
+	}
+	return node
+}
+
+// uniqueEdges drops repeated edges, keeping the first of each
+func uniqueEdges(edges []Edge) []Edge {
+	seen := map[Edge]bool{}
+	unique := edges[:0]
+	for _, edge := range edges {
+		if !seen[edge] {
+			seen[edge] = true
+			unique = append(unique, edge)
+		}
+	}
+	return unique
+}
+
+// WriteText prints the graph as a tree from its root. Nodes reached again
+// are printed without what they came from.
+func WriteText(w io.Writer, g *Graph) error {
+	nodes := map[string]*Node{}
+	for i := range g.Nodes {
+		nodes[g.Nodes[i].ID] = &g.Nodes[i]
+	}
+	children := map[string][]Edge{}
+	for _, edge := range g.Edges {
+		children[edge.From] = append(children[edge.From], edge)
+	}
+	printed := map[string]bool{}
+	var walk func(id, via, indent string) error
+	walk = func(id, via, indent string) error {
+		node := nodes[id]
+		if node == nil {
+			return nil
+		}
+		line := indent + via + node.Kind + " " + node.Name
+		if attributes := formatAttributes(node); attributes != "" {
+			line += " (" + attributes + ")"
+		}
+		if node.Missing {
+			line += " [missing]"
+		}
+		if printed[id] && len(children[id]) > 0 {
+			line += " [see above]"
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+		if printed[id] {
+			return nil
+		}
+		printed[id] = true
+		for _, edge := range children[id] {
+			if err := walk(edge.To, edge.Kind+" ", indent+"  "); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(g.Root, "", ""); err != nil {
+		return err
+	}
+	if g.Truncated {
+		_, err := fmt.Fprintf(w, "... stopped after %d jobs\n", MaxJobs)
+		return err
+	}
+	return nil
+}
+
+// formatAttributes lists a node's attributes by name
+func formatAttributes(node *Node) string {
+	keys := make([]string, 0, len(node.Attributes))
+	for key := range node.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+"="+node.Attributes[key])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// WriteDOT prints the graph in Graphviz's DOT language, edges pointing from
+// jobs to what they came from
+func WriteDOT(w io.Writer, g *Graph) error {
+	var out strings.Builder
+	out.WriteString("digraph lineage {\n  rankdir=LR;\n")
+	for _, node := range g.Nodes {
+		label := node.Kind + "\n" + node.Name
+		if digest := node.Attributes["digest"]; digest != "" {
+			label += "\n" + digest
+		}
+		style := ""
+		if node.ID == g.Root {
+			style = ", style=bold"
+		} else if node.Missing {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&out, "  %q [label=%q%s];\n", node.ID, label, style)
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&out, "  %q -> %q [label=%q];\n", edge.From, edge.To, edge.Kind)
+	}
+	out.WriteString("}\n")
+	_, err := io.WriteString(w, out.String())
+	return err
+}
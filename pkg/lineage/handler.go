@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lineage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Path is where the operator serves provenance graphs, next to its metrics
+const Path = "/jobs/lineage"
+
+// Handler serves the provenance graph of a job:
+//
+//	GET /jobs/lineage?namespace=<ns>&name=<job>&output=json|dot|text
+//
+// The namespace and name are required; graphs are JSON by default.
+type Handler struct {
+	Reader client.Reader
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	query := req.URL.Query()
+	namespace, name, output := query.Get("namespace"), query.Get("name"), query.Get("output")
+	if namespace == "" || name == "" {
+		http.Error(w, "namespace and name are required", http.StatusBadRequest)
+		return
+	}
+	if output != "" && output != "json" && output != "dot" && output != "text" {
+		http.Error(w, fmt.Sprintf("unknown output %q, must be json, dot or text", output), http.StatusBadRequest)
+		return
+	}
+
+	graph, err := Build(req.Context(), h.Reader, namespace, name)
+	if apierrors.IsNotFound(err) {
+		http.Error(w, fmt.Sprintf("QiskitJob %s/%s not found", namespace, name), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.FromContext(req.Context()).Error(err, "Failed to build provenance graph")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	switch output {
+	case "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		_ = WriteDOT(w, graph)
+	case "text":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_ = WriteText(w, graph)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(graph)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lineage records where QiskitJobs come from and builds the
+// provenance graph behind a job's results: the jobs it reruns or consumed,
+// the experiment, template and session it ran under, and the code and
+// device that produced it. Audits start from a published number's job and
+// walk the graph back.
+package lineage
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/jobtemplate"
+)
+
+// Labels and annotations lineage is read from. Users set the rerun and
+// experiment labels; the operator sets the template and session labels so
+// jobs can be selected on them.
+const (
+	// RerunOfLabel names the job a job reruns
+	RerunOfLabel = "quantum.io/rerun-of"
+	// ExperimentLabel names the experiment a job belongs to
+	ExperimentLabel = "quantum.io/experiment"
+	// TemplateLabel names the QiskitJobTemplate a job was instantiated from
+	TemplateLabel = "quantum.io/template"
+	// SessionLabel names the QiskitSession a job runs in
+	SessionLabel = "quantum.io/session"
+	// DependsOnAnnotation lists the comma-separated names of the jobs whose
+	// results a job depends on
+	DependsOnAnnotation = "quantum.io/depends-on"
+)
+
+// maxDependsOn is the most dependencies recorded in a job's status
+const maxDependsOn = 50
+
+// Of returns the lineage of the job, or nil if it has none
+func Of(job *quantumv1.QiskitJob) *quantumv1.JobLineage {
+	lineage := &quantumv1.JobLineage{
+		RerunOf:            job.Labels[RerunOfLabel],
+		DependsOn:          DependsOn(job),
+		MemberOfExperiment: job.Labels[ExperimentLabel],
+		UsedTemplate:       usedTemplate(job),
+	}
+	if job.Spec.Session != nil {
+		lineage.UsedSession = job.Spec.Session.Name
+	}
+	if lineage.RerunOf == "" && len(lineage.DependsOn) == 0 && lineage.MemberOfExperiment == "" &&
+		lineage.UsedTemplate == "" && lineage.UsedSession == "" {
+		return nil
+	}
+	return lineage
+}
+
+// DependsOn returns the jobs the job's annotation says it depends on, in
+// order and without duplicates
+func DependsOn(job *quantumv1.QiskitJob) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, name := range strings.Split(job.Annotations[DependsOnAnnotation], ",") {
+		if name = strings.TrimSpace(name); name != "" && name != job.Name && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) > maxDependsOn {
+		names = names[:maxDependsOn]
+	}
+	return names
+}
+
+// usedTemplate returns the template the job was instantiated from, with its
+// generation once it was applied
+func usedTemplate(job *quantumv1.QiskitJob) string {
+	if applied := job.Annotations[jobtemplate.AppliedAnnotation]; applied != "" {
+		return applied
+	}
+	if job.Spec.TemplateRef != nil {
+		return job.Spec.TemplateRef.Name
+	}
+	return ""
+}
+
+// ApplyLabels sets the template and session labels of the job, and reports
+// whether it changed it. Names too long for a label value are left out.
+func ApplyLabels(job *quantumv1.QiskitJob) bool {
+	lineage := Of(job)
+	if lineage == nil {
+		return false
+	}
+	changed := false
+	set := func(key, value string) {
+		if value == "" || job.Labels[key] == value || len(validation.IsValidLabelValue(value)) > 0 {
+			return
+		}
+		if job.Labels == nil {
+			job.Labels = map[string]string{}
+		}
+		job.Labels[key] = value
+		changed = true
+	}
+	template, _, _ := strings.Cut(lineage.UsedTemplate, "/")
+	set(TemplateLabel, template)
+	set(SessionLabel, lineage.UsedSession)
+	return changed
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lineage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+	"github.com/quantum-operator/qiskit-operator/pkg/jobtemplate"
+)
+
+var _ = Describe("Lineage", func() {
+	ctx := context.Background()
+
+	It("should read the lineage of a job from its labels, annotations and spec", func() {
+		job := builder.NewBellStateJob("vqe-final", "research").
+			WithLabels(map[string]string{RerunOfLabel: "vqe-draft", ExperimentLabel: "h2-energy"}).
+			WithAnnotations(map[string]string{
+				DependsOnAnnotation:           "vqe-prep, vqe-calibrate,vqe-prep,vqe-final",
+				jobtemplate.AppliedAnnotation: "vqe/3",
+			}).
+			WithTemplate("vqe").
+			WithSession("h2-session", "dedicated", 3600).
+			Build()
+
+		Expect(Of(job)).To(Equal(&quantumv1.JobLineage{
+			RerunOf:            "vqe-draft",
+			DependsOn:          []string{"vqe-prep", "vqe-calibrate"},
+			MemberOfExperiment: "h2-energy",
+			UsedTemplate:       "vqe/3",
+			UsedSession:        "h2-session",
+		}))
+		Expect(Of(builder.NewBellStateJob("plain", "research").Build())).To(BeNil())
+	})
+
+	It("should label jobs with their template and session once", func() {
+		job := builder.NewBellStateJob("templated", "research").
+			WithTemplate("vqe").
+			WithSession(strings.Repeat("s", 64), "batch", 60).
+			Build()
+
+		Expect(ApplyLabels(job)).To(BeTrue())
+		Expect(job.Labels).To(HaveKeyWithValue(TemplateLabel, "vqe"))
+		Expect(job.Labels).NotTo(HaveKey(SessionLabel), "names too long for a label are left out")
+		Expect(ApplyLabels(job)).To(BeFalse())
+
+		job.Annotations = map[string]string{jobtemplate.AppliedAnnotation: "vqe/3"}
+		Expect(ApplyLabels(job)).To(BeFalse(), "the label names the template without its generation")
+	})
+
+	Context("When building the provenance graph of a job", func() {
+		var published *quantumv1.QiskitJob
+
+		BeforeEach(func() {
+			published = builder.NewBellStateJob("published", "research").
+				WithGitCircuit("https://github.com/lab/vqe", "main", "vqe.py").
+				WithLabels(map[string]string{RerunOfLabel: "first-try", ExperimentLabel: "h2-energy"}).
+				WithAnnotations(map[string]string{DependsOnAnnotation: "calibration,deleted-step"}).
+				Build()
+			published.Status.Phase = "Completed"
+			published.Status.SelectedBackend = "ibm_torino"
+			published.Status.CircuitCommit = "1a2b3c4"
+			published.Status.ExecutorImage = "ghcr.io/lab/executor:1.2"
+			published.Status.Results = &quantumv1.ResultsInfo{
+				Location: "configmap://research/published-results",
+				Digest:   "sha256:feed",
+			}
+		})
+
+		build := func(objects ...*quantumv1.QiskitJob) *Graph {
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			for _, job := range objects {
+				builder = builder.WithObjects(job)
+			}
+			graph, err := Build(ctx, builder.Build(), "research", "published")
+			Expect(err).NotTo(HaveOccurred())
+			return graph
+		}
+
+		node := func(graph *Graph, id string) *Node {
+			for i := range graph.Nodes {
+				if graph.Nodes[i].ID == id {
+					return &graph.Nodes[i]
+				}
+			}
+			return nil
+		}
+
+		It("should walk back through the jobs it came from and the code and device behind them", func() {
+			firstTry := builder.NewBellStateJob("first-try", "research").
+				WithGitCircuit("https://github.com/lab/vqe", "main", "vqe.py").
+				WithLabels(map[string]string{ExperimentLabel: "h2-energy"}).
+				Build()
+			firstTry.Status.SelectedBackend = "ibm_torino"
+			firstTry.Status.CircuitCommit = "1a2b3c4"
+			calibration := builder.NewBellStateJob("calibration", "research").
+				WithLabels(map[string]string{RerunOfLabel: "published"}).
+				Build()
+			calibration.Status.CircuitMetadata = &quantumv1.CircuitMetadata{Hash: "abc123"}
+
+			graph := build(published, firstTry, calibration)
+			Expect(graph.Root).To(Equal("QiskitJob/research/published"))
+			Expect(graph.Truncated).To(BeFalse())
+			Expect(graph.Edges).To(ContainElements(
+				Edge{From: "QiskitJob/research/published", To: "QiskitJob/research/first-try", Kind: EdgeRerunOf},
+				Edge{From: "QiskitJob/research/published", To: "QiskitJob/research/calibration", Kind: EdgeDependsOn},
+				Edge{From: "QiskitJob/research/published", To: "QiskitJob/research/deleted-step", Kind: EdgeDependsOn},
+				Edge{From: "QiskitJob/research/published", To: "Experiment/research/h2-energy", Kind: EdgeMemberOfExperiment},
+				Edge{From: "QiskitJob/research/published", To: "Code/https://github.com/lab/vqe@1a2b3c4", Kind: EdgeRanCode},
+				Edge{From: "QiskitJob/research/published", To: "Device/ibm_torino", Kind: EdgeRanOn},
+				Edge{From: "QiskitJob/research/first-try", To: "Code/https://github.com/lab/vqe@1a2b3c4", Kind: EdgeRanCode},
+				Edge{From: "QiskitJob/research/calibration", To: "Code/abc123", Kind: EdgeRanCode},
+				Edge{From: "QiskitJob/research/calibration", To: "QiskitJob/research/published", Kind: EdgeRerunOf},
+			))
+			Expect(node(graph, "QiskitJob/research/published").Attributes).To(And(
+				HaveKeyWithValue("digest", "sha256:feed"),
+				HaveKeyWithValue("executorImage", "ghcr.io/lab/executor:1.2"),
+			))
+			Expect(node(graph, "QiskitJob/research/deleted-step").Missing).To(BeTrue())
+			Expect(node(graph, "Code/https://github.com/lab/vqe@1a2b3c4").Attributes).To(
+				HaveKeyWithValue("commit", "1a2b3c4"))
+
+			var text strings.Builder
+			Expect(WriteText(&text, graph)).To(Succeed())
+			Expect(text.String()).To(HavePrefix("QiskitJob research/published ("))
+			Expect(text.String()).To(ContainSubstring("\n  rerunOf QiskitJob research/first-try\n    memberOfExperiment Experiment h2-energy\n"))
+			Expect(text.String()).To(ContainSubstring("\n  dependsOn QiskitJob research/deleted-step [missing]\n"))
+			Expect(text.String()).To(ContainSubstring("\n    rerunOf QiskitJob research/published (" +
+				"digest=sha256:feed, executorImage=ghcr.io/lab/executor:1.2, phase=Completed, " +
+				"results=configmap://research/published-results) [see above]\n"))
+
+			var dot strings.Builder
+			Expect(WriteDOT(&dot, graph)).To(Succeed())
+			Expect(dot.String()).To(HavePrefix("digraph lineage {"))
+			Expect(dot.String()).To(ContainSubstring(
+				`"QiskitJob/research/published" -> "Device/ibm_torino" [label="ranOn"];`))
+			Expect(dot.String()).To(ContainSubstring(`"QiskitJob/research/deleted-step" [label="QiskitJob\nresearch/deleted-step", style=dashed];`))
+		})
+
+		It("should serve the graph next to the metrics", func() {
+			handler := &Handler{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(published).Build()}
+			serve := func(query string) *httptest.ResponseRecorder {
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, Path+"?"+query, nil))
+				return recorder
+			}
+
+			response := serve("namespace=research&name=published")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			var graph Graph
+			Expect(json.Unmarshal(response.Body.Bytes(), &graph)).To(Succeed())
+			Expect(graph.Root).To(Equal("QiskitJob/research/published"))
+
+			response = serve("namespace=research&name=published&output=dot")
+			Expect(response.Header().Get("Content-Type")).To(Equal("text/vnd.graphviz"))
+
+			Expect(serve("namespace=research&name=unknown").Code).To(Equal(http.StatusNotFound))
+			Expect(serve("name=published").Code).To(Equal(http.StatusBadRequest))
+			Expect(serve("namespace=research&name=published&output=svg").Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,231 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lint implements non-blocking static checks on Qiskit circuit code.
+// Unlike validation, lint findings never reject a job; they are surfaced as
+// admission warnings and as a status condition.
+package lint
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RulesAnnotation on a Namespace selects the lint rules applied to its jobs.
+// The value is a comma-separated list of rule IDs, "all" (the default) or "none".
+const RulesAnnotation = "quantum.io/lint-rules"
+
+// Rule IDs
+const (
+	RuleMeasurementMissing = "measurement-missing"
+	RuleUnusedQubits       = "unused-qubits"
+	RuleDeprecatedAPI      = "deprecated-api"
+)
+
+// Finding is a single lint warning
+type Finding struct {
+	Rule    string
+	Message string
+}
+
+// String formats the finding as "[rule] message"
+func (f Finding) String() string {
+	return fmt.Sprintf("[%s] %s", f.Rule, f.Message)
+}
+
+// Rule checks circuit code and reports findings
+type Rule struct {
+	ID          string
+	Description string
+	Check       func(code string) []Finding
+}
+
+// rules holds all known rules keyed by ID
+var rules = map[string]Rule{
+	RuleMeasurementMissing: {
+		ID:          RuleMeasurementMissing,
+		Description: "Circuit never measures, so the job returns no counts",
+		Check:       checkMeasurementMissing,
+	},
+	RuleUnusedQubits: {
+		ID:          RuleUnusedQubits,
+		Description: "Circuit allocates qubits that no instruction touches",
+		Check:       checkUnusedQubits,
+	},
+	RuleDeprecatedAPI: {
+		ID:          RuleDeprecatedAPI,
+		Description: "Circuit uses Qiskit APIs removed in Qiskit 1.0",
+		Check:       checkDeprecatedAPI,
+	},
+}
+
+// RuleIDs returns all known rule IDs in sorted order
+func RuleIDs() []string {
+	ids := make([]string, 0, len(rules))
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ParseRuleSet turns a rule set annotation value into the list of enabled rule IDs
+func ParseRuleSet(value string) ([]string, error) {
+	value = strings.TrimSpace(value)
+	switch value {
+	case "", "all":
+		return RuleIDs(), nil
+	case "none":
+		return nil, nil
+	}
+
+	var ids []string
+	for _, id := range strings.Split(value, ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if _, ok := rules[id]; !ok {
+			return nil, fmt.Errorf("unknown lint rule %q, must be one of: %s", id, strings.Join(RuleIDs(), ", "))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// RulesForNamespace returns the rule set configured on a namespace
+func RulesForNamespace(ctx context.Context, c client.Reader, namespace string) ([]string, error) {
+	var ns corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		return nil, err
+	}
+	return ParseRuleSet(ns.Annotations[RulesAnnotation])
+}
+
+// Run applies the given rules to the circuit code
+func Run(code string, ruleIDs []string) []Finding {
+	var findings []Finding
+	for _, id := range ruleIDs {
+		rule, ok := rules[id]
+		if !ok {
+			continue
+		}
+		findings = append(findings, rule.Check(code)...)
+	}
+	return findings
+}
+
+var measurePattern = regexp.MustCompile(`\.measure(_all|_active)?\s*\(`)
+
+func checkMeasurementMissing(code string) []Finding {
+	if measurePattern.MatchString(code) {
+		return nil
+	}
+	return []Finding{{
+		Rule:    RuleMeasurementMissing,
+		Message: "no measure() or measure_all() call found; results will contain no counts",
+	}}
+}
+
+var (
+	circuitSizePattern = regexp.MustCompile(`QuantumCircuit\(\s*(\d+)`)
+	gateCallPattern    = regexp.MustCompile(`\.\w+\(([^()]*)\)`)
+	qubitIndexPattern  = regexp.MustCompile(`\b\d+\b`)
+	dynamicPattern     = regexp.MustCompile(`range\(|measure_all|measure_active|compose\(|append\(|QuantumRegister|\[[^\]]*\bfor\b`)
+)
+
+// DeclaredQubits returns the size of the first QuantumCircuit constructed
+// with a literal qubit count in code
+func DeclaredQubits(code string) (int, bool) {
+	size := circuitSizePattern.FindStringSubmatch(code)
+	if size == nil {
+		return 0, false
+	}
+	qubits, err := strconv.Atoi(size[1])
+	if err != nil {
+		return 0, false
+	}
+	return qubits, true
+}
+
+func checkUnusedQubits(code string) []Finding {
+	qubits, ok := DeclaredQubits(code)
+	if !ok || dynamicPattern.MatchString(code) {
+		// Qubit usage cannot be determined statically
+		return nil
+	}
+
+	used := map[int]bool{}
+	for _, call := range gateCallPattern.FindAllStringSubmatch(code, -1) {
+		if strings.Contains(call[0], "QuantumCircuit(") {
+			continue
+		}
+		for _, idx := range qubitIndexPattern.FindAllString(call[1], -1) {
+			if n, err := strconv.Atoi(idx); err == nil {
+				used[n] = true
+			}
+		}
+	}
+
+	var unused []string
+	for q := 0; q < qubits; q++ {
+		if !used[q] {
+			unused = append(unused, strconv.Itoa(q))
+		}
+	}
+	if len(unused) == 0 {
+		return nil
+	}
+	return []Finding{{
+		Rule:    RuleUnusedQubits,
+		Message: fmt.Sprintf("qubits %s are allocated but never used", strings.Join(unused, ", ")),
+	}}
+}
+
+// deprecatedAPIs maps a usage pattern to its replacement hint
+var deprecatedAPIs = []struct {
+	pattern *regexp.Regexp
+	hint    string
+}{
+	{regexp.MustCompile(`\bexecute\s*\(`), "execute() was removed; use backend.run() or the Sampler/Estimator primitives"},
+	{regexp.MustCompile(`from\s+qiskit\s+import\s+[^\n]*\bAer\b`), "qiskit.Aer was removed; import AerSimulator from qiskit_aer"},
+	{regexp.MustCompile(`\bBasicAer\b`), "BasicAer was removed; use qiskit.providers.basic_provider or qiskit_aer"},
+	{regexp.MustCompile(`\bIBMQ\b`), "IBMQ was removed; use QiskitRuntimeService from qiskit_ibm_runtime"},
+	{regexp.MustCompile(`qiskit\.opflow`), "qiskit.opflow was removed; use qiskit.quantum_info.SparsePauliOp"},
+	{regexp.MustCompile(`\.(cnot|toffoli|fredkin)\s*\(`), "gate aliases were removed; use cx(), ccx() or cswap()"},
+	{regexp.MustCompile(`\.u[123]\s*\(`), "u1/u2/u3 were removed; use p() or u()"},
+	{regexp.MustCompile(`\.bind_parameters\s*\(`), "bind_parameters() was removed; use assign_parameters()"},
+}
+
+func checkDeprecatedAPI(code string) []Finding {
+	var findings []Finding
+	for _, api := range deprecatedAPIs {
+		if match := api.pattern.FindString(code); match != "" {
+			findings = append(findings, Finding{
+				Rule:    RuleDeprecatedAPI,
+				Message: fmt.Sprintf("%q: %s", strings.TrimSpace(match), api.hint),
+			})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	namespaceSpendDesc = prometheus.NewDesc(
+		"qiskit_operator_namespace_spend_dollars",
+		"Actual cost of the jobs of a namespace that finished in the current billing period",
+		[]string{"namespace"}, nil,
+	)
+	costCenterSpendDesc = prometheus.NewDesc(
+		"qiskit_operator_cost_center_spend_dollars",
+		"Actual cost of the jobs charged to a cost center that finished in the current billing period",
+		[]string{"cost_center"}, nil,
+	)
+	costCenterThresholdDesc = prometheus.NewDesc(
+		"qiskit_operator_cost_center_threshold_dollars",
+		"Monthly spend above which a cost center alerts",
+		[]string{"cost_center"}, nil,
+	)
+)
+
+// Spend is what namespaces and cost centers spent in the current billing
+// period, in dollars, and the thresholds of cost centers that have one
+type Spend struct {
+	Namespaces  map[string]float64
+	CostCenters map[string]float64
+	Thresholds  map[string]float64
+}
+
+// SpendFunc reports the spend of the current billing period, nil if there
+// is none to report
+type SpendFunc func(ctx context.Context) (*Spend, error)
+
+// SpendCollector exports the spend of the current billing period, read
+// when Prometheus scrapes, for chargeback dashboards and threshold alerts
+type SpendCollector struct {
+	spend SpendFunc
+}
+
+var _ prometheus.Collector = &SpendCollector{}
+
+// NewSpendCollector returns a collector of the spend f reports
+func NewSpendCollector(f SpendFunc) *SpendCollector {
+	return &SpendCollector{spend: f}
+}
+
+// RegisterSpend serves the spend f reports on the manager's metrics endpoint
+func RegisterSpend(f SpendFunc) {
+	metrics.Registry.MustRegister(NewSpendCollector(f))
+}
+
+// Describe implements prometheus.Collector
+func (c *SpendCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- namespaceSpendDesc
+	ch <- costCenterSpendDesc
+	ch <- costCenterThresholdDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *SpendCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	spend, err := c.spend(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(namespaceSpendDesc, err)
+		return
+	}
+	if spend == nil {
+		return
+	}
+	for namespace, dollars := range spend.Namespaces {
+		ch <- prometheus.MustNewConstMetric(namespaceSpendDesc, prometheus.GaugeValue, dollars, namespace)
+	}
+	for center, dollars := range spend.CostCenters {
+		ch <- prometheus.MustNewConstMetric(costCenterSpendDesc, prometheus.GaugeValue, dollars, center)
+	}
+	for center, dollars := range spend.Thresholds {
+		ch <- prometheus.MustNewConstMetric(costCenterThresholdDesc, prometheus.GaugeValue, dollars, center)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// scrapeTimeout bounds how long a scrape waits for the demand or usage it
+// exports
+const scrapeTimeout = 10 * time.Second
+
+var (
+	demandLabels = []string{"namespace", "backend_type"}
+
+	pendingJobsDesc = prometheus.NewDesc(
+		"qiskit_operator_pending_executor_jobs",
+		"Number of jobs waiting for an execution pod to be scheduled",
+		demandLabels, nil,
+	)
+	pendingCPUDesc = prometheus.NewDesc(
+		"qiskit_operator_pending_executor_cpu_cores",
+		"CPU cores requested by execution pods of waiting jobs",
+		demandLabels, nil,
+	)
+	pendingMemoryDesc = prometheus.NewDesc(
+		"qiskit_operator_pending_executor_memory_bytes",
+		"Memory requested by execution pods of waiting jobs",
+		demandLabels, nil,
+	)
+	pendingGPUDesc = prometheus.NewDesc(
+		"qiskit_operator_pending_executor_gpus",
+		"GPUs requested by execution pods of waiting jobs",
+		demandLabels, nil,
+	)
+)
+
+// Demand is what the jobs of a namespace and backend type that wait for an
+// execution pod to be scheduled request for their executors
+type Demand struct {
+	Namespace   string
+	BackendType string
+	Jobs        int
+	CPU         resource.Quantity
+	Memory      resource.Quantity
+	GPU         resource.Quantity
+}
+
+// DemandFunc reports the current demand
+type DemandFunc func(ctx context.Context) ([]Demand, error)
+
+// DemandCollector exports the executor demand of waiting jobs, read when
+// Prometheus scrapes, so autoscalers can add nodes before execution pods
+// are created and found unschedulable
+type DemandCollector struct {
+	demand DemandFunc
+}
+
+var _ prometheus.Collector = &DemandCollector{}
+
+// NewDemandCollector returns a collector of the demand f reports
+func NewDemandCollector(f DemandFunc) *DemandCollector {
+	return &DemandCollector{demand: f}
+}
+
+// RegisterDemand serves the demand f reports on the manager's metrics endpoint
+func RegisterDemand(f DemandFunc) {
+	metrics.Registry.MustRegister(NewDemandCollector(f))
+}
+
+// Describe implements prometheus.Collector
+func (c *DemandCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pendingJobsDesc
+	ch <- pendingCPUDesc
+	ch <- pendingMemoryDesc
+	ch <- pendingGPUDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *DemandCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	demand, err := c.demand(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(pendingJobsDesc, err)
+		return
+	}
+	for _, d := range demand {
+		ch <- prometheus.MustNewConstMetric(pendingJobsDesc, prometheus.GaugeValue, float64(d.Jobs),
+			d.Namespace, d.BackendType)
+		ch <- prometheus.MustNewConstMetric(pendingCPUDesc, prometheus.GaugeValue, d.CPU.AsApproximateFloat64(),
+			d.Namespace, d.BackendType)
+		ch <- prometheus.MustNewConstMetric(pendingMemoryDesc, prometheus.GaugeValue, d.Memory.AsApproximateFloat64(),
+			d.Namespace, d.BackendType)
+		ch <- prometheus.MustNewConstMetric(pendingGPUDesc, prometheus.GaugeValue, d.GPU.AsApproximateFloat64(),
+			d.Namespace, d.BackendType)
+	}
+}
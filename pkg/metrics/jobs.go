@@ -0,0 +1,195 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// JobPhaseTransitions counts the jobs entering each phase
+	JobPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_job_phase_transitions_total",
+			Help: "Number of times QiskitJobs entered the phase",
+		},
+		[]string{"phase", "backend_type"},
+	)
+
+	// JobValidationDuration observes how long jobs took from submission to
+	// the end of their validation
+	JobValidationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "qiskit_operator_job_validation_duration_seconds",
+			Help:    "Time from the submission of a QiskitJob until its validation ended",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+		},
+		[]string{"backend_type"},
+	)
+
+	// JobQueueDuration observes how long execution pods waited to start
+	JobQueueDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "qiskit_operator_job_queue_duration_seconds",
+			Help:    "Time the execution pod of a QiskitJob waited between creation and the start of its container",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 16),
+		},
+		[]string{"backend_type"},
+	)
+
+	// JobExecutionDuration observes how long finished jobs ran
+	JobExecutionDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "qiskit_operator_job_execution_duration_seconds",
+			Help:    "Execution time of QiskitJobs that completed or failed",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 16),
+		},
+		[]string{"backend_type", "phase"},
+	)
+
+	// JobCost accumulates the actual cost of completed jobs
+	JobCost = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_job_cost_dollars_total",
+			Help: "Actual cost of completed QiskitJobs in US dollars",
+		},
+		[]string{"backend", "cost_center"},
+	)
+
+	// JobRequeues counts the reconciles of jobs that requeued them, by why
+	JobRequeues = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_job_requeues_total",
+			Help: "Number of reconciles that requeued a QiskitJob, by the reason they waited for",
+		},
+		[]string{"reason"},
+	)
+
+	// JobNotifications counts the attempts to deliver notifications of
+	// finished jobs, by type and result (sent, retried or failed)
+	JobNotifications = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_job_notifications_total",
+			Help: "Number of attempts to deliver the notification of a finished QiskitJob, by type and result",
+		},
+		[]string{"type", "result"},
+	)
+
+	// JobDrift counts the resources of jobs found deleted or modified behind
+	// the operator's back, by resource and what was done about it
+	JobDrift = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_job_drift_total",
+			Help: "Number of QiskitJob executions and results ConfigMaps found deleted or modified, by resource and action",
+		},
+		[]string{"resource", "action"},
+	)
+
+	// ReconcilerStalled is 1 while the watchdog considers a controller
+	// stalled
+	ReconcilerStalled = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "qiskit_operator_reconciler_stalled",
+			Help: "Whether the controller made no progress for longer than the stall timeout",
+		},
+		[]string{"controller"},
+	)
+
+	// ReconcilerStalls counts the stalls the watchdog detected
+	ReconcilerStalls = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_reconciler_stalls_total",
+			Help: "Number of times the controller was found stalled",
+		},
+		[]string{"controller"},
+	)
+)
+
+var activeJobsDesc = prometheus.NewDesc(
+	"qiskit_operator_active_jobs",
+	"Number of QiskitJobs that have not finished",
+	[]string{"namespace", "phase"}, nil,
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		JobPhaseTransitions,
+		JobValidationDuration,
+		JobQueueDuration,
+		JobExecutionDuration,
+		JobCost,
+		JobRequeues,
+		JobNotifications,
+		JobDrift,
+		ReconcilerStalled,
+		ReconcilerStalls,
+	)
+}
+
+// ActiveJobs is the number of unfinished jobs of a namespace in a phase
+type ActiveJobs struct {
+	Namespace string
+	Phase     string
+	Jobs      int
+}
+
+// ActiveJobsFunc reports the current number of active jobs
+type ActiveJobsFunc func(ctx context.Context) ([]ActiveJobs, error)
+
+// ActiveJobsCollector exports the number of active jobs, counted when
+// Prometheus scrapes so jobs deleted or finished while the operator was down
+// are never left behind in a gauge
+type ActiveJobsCollector struct {
+	active ActiveJobsFunc
+}
+
+var _ prometheus.Collector = &ActiveJobsCollector{}
+
+// NewActiveJobsCollector returns a collector of the active jobs f reports
+func NewActiveJobsCollector(f ActiveJobsFunc) *ActiveJobsCollector {
+	return &ActiveJobsCollector{active: f}
+}
+
+// RegisterActiveJobs serves the active jobs f reports on the manager's
+// metrics endpoint
+func RegisterActiveJobs(f ActiveJobsFunc) {
+	metrics.Registry.MustRegister(NewActiveJobsCollector(f))
+}
+
+// Describe implements prometheus.Collector
+func (c *ActiveJobsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeJobsDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *ActiveJobsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	active, err := c.active(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(activeJobsDesc, err)
+		return
+	}
+	for _, a := range active {
+		ch <- prometheus.MustNewConstMetric(activeJobsDesc, prometheus.GaugeValue, float64(a.Jobs),
+			a.Namespace, a.Phase)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the operator's Prometheus metrics. They are
+// registered with the controller-runtime registry and served on the
+// manager's metrics endpoint.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// DeprecatedFieldUsage counts writes that used a deprecated spec field
+	DeprecatedFieldUsage = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_deprecated_field_usage_total",
+			Help: "Number of QiskitJob writes that used a deprecated spec field",
+		},
+		[]string{"field"},
+	)
+
+	// BackendBreakerState is the state of each backend's circuit breaker
+	BackendBreakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "qiskit_operator_backend_circuit_breaker_state",
+			Help: "State of the backend's circuit breaker: 0 closed, 1 half-open, 2 open",
+		},
+		[]string{"backend"},
+	)
+
+	// BackendBreakerTrips counts the times each backend's circuit breaker opened
+	BackendBreakerTrips = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "qiskit_operator_backend_circuit_breaker_trips_total",
+			Help: "Number of times the backend's circuit breaker opened",
+		},
+		[]string{"backend"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		DeprecatedFieldUsage,
+		BackendBreakerState,
+		BackendBreakerTrips,
+	)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	usageLabels = []string{"namespace", "job", "backend"}
+
+	executorCPUDesc = prometheus.NewDesc(
+		"qiskit_operator_executor_cpu_cores",
+		"CPU cores the execution pod of a running job is using",
+		usageLabels, nil,
+	)
+	executorMemoryDesc = prometheus.NewDesc(
+		"qiskit_operator_executor_memory_bytes",
+		"Memory the execution pod of a running job is using",
+		usageLabels, nil,
+	)
+	executorGPUDesc = prometheus.NewDesc(
+		"qiskit_operator_executor_gpu_utilization_ratio",
+		"Mean utilization of the GPUs of a running job's execution pod, from 0 to 1",
+		usageLabels, nil,
+	)
+)
+
+// Usage is what the execution pod of a running job is using. Values that
+// could not be measured are nil.
+type Usage struct {
+	Namespace string
+	Job       string
+	Backend   string
+	CPU       *resource.Quantity
+	Memory    *resource.Quantity
+	// GPUUtilization is the mean utilization of the pod's GPUs from 0 to 1
+	GPUUtilization *float64
+}
+
+// UsageFunc reports the current usage of running executors
+type UsageFunc func(ctx context.Context) ([]Usage, error)
+
+// UsageCollector exports the live resource usage of running executors,
+// read when Prometheus scrapes, so dashboards show busy jobs while they run
+type UsageCollector struct {
+	usage UsageFunc
+}
+
+var _ prometheus.Collector = &UsageCollector{}
+
+// NewUsageCollector returns a collector of the usage f reports
+func NewUsageCollector(f UsageFunc) *UsageCollector {
+	return &UsageCollector{usage: f}
+}
+
+// RegisterUsage serves the usage f reports on the manager's metrics endpoint
+func RegisterUsage(f UsageFunc) {
+	metrics.Registry.MustRegister(NewUsageCollector(f))
+}
+
+// Describe implements prometheus.Collector
+func (c *UsageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- executorCPUDesc
+	ch <- executorMemoryDesc
+	ch <- executorGPUDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *UsageCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	usage, err := c.usage(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(executorCPUDesc, err)
+		return
+	}
+	for _, u := range usage {
+		if u.CPU != nil {
+			ch <- prometheus.MustNewConstMetric(executorCPUDesc, prometheus.GaugeValue, u.CPU.AsApproximateFloat64(),
+				u.Namespace, u.Job, u.Backend)
+		}
+		if u.Memory != nil {
+			ch <- prometheus.MustNewConstMetric(executorMemoryDesc, prometheus.GaugeValue, u.Memory.AsApproximateFloat64(),
+				u.Namespace, u.Job, u.Backend)
+		}
+		if u.GPUUtilization != nil {
+			ch <- prometheus.MustNewConstMetric(executorGPUDesc, prometheus.GaugeValue, *u.GPUUtilization,
+				u.Namespace, u.Job, u.Backend)
+		}
+	}
+}
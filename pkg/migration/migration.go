@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration rewrites deprecated QiskitJob spec fields to their
+// replacements so the legacy fields can eventually be removed from the API.
+package migration
+
+import (
+	"sort"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// MigratedFieldsAnnotation records which deprecated fields were rewritten
+const MigratedFieldsAnnotation = "quantum.io/migrated-fields"
+
+// Migrate rewrites deprecated fields of the job in place and returns the
+// paths of the fields that were migrated. The paths are also recorded in the
+// MigratedFieldsAnnotation and counted in the deprecation metric.
+func Migrate(job *quantumv1.QiskitJob) []string {
+	var migrated []string
+	migrated = append(migrated, migrateLegacyIBMAuth(&job.Spec.Backend)...)
+	migrated = append(migrated, migrateOutput(&job.Spec)...)
+
+	if len(migrated) == 0 {
+		return nil
+	}
+	for _, path := range migrated {
+		metrics.DeprecatedFieldUsage.WithLabelValues(path).Inc()
+	}
+	recordAnnotation(job, migrated)
+	return migrated
+}
+
+// migrateLegacyIBMAuth replaces IBM Quantum Network hub/group/project with the
+// equivalent "hub/group/project" instance accepted by IBM Runtime. An explicit
+// instance always wins over the legacy fields.
+func migrateLegacyIBMAuth(backend *quantumv1.BackendSpec) []string {
+	if backend.Type != "ibm_quantum" && backend.Type != "ibm_simulator" {
+		// Legacy fields on other backends are invalid rather than deprecated
+		return nil
+	}
+
+	var migrated []string
+	if backend.Hub != "" {
+		migrated = append(migrated, "spec.backend.hub")
+	}
+	if backend.Group != "" {
+		migrated = append(migrated, "spec.backend.group")
+	}
+	if backend.Project != "" {
+		migrated = append(migrated, "spec.backend.project")
+	}
+	if len(migrated) == 0 {
+		return nil
+	}
+
+	if backend.Instance == "" && backend.Hub != "" && backend.Group != "" && backend.Project != "" {
+		backend.Instance = strings.Join([]string{backend.Hub, backend.Group, backend.Project}, "/")
+	}
+	backend.Hub = ""
+	backend.Group = ""
+	backend.Project = ""
+	return migrated
+}
+
+// migrateOutput moves the single output into the list of outputs. Outputs
+// already listed win over the legacy field, like an explicit instance does.
+func migrateOutput(spec *quantumv1.QiskitJobSpec) []string {
+	if spec.Output == nil {
+		return nil
+	}
+	spec.Outputs = Outputs(spec)
+	spec.Output = nil
+	return []string{"spec.output"}
+}
+
+// Outputs returns the outputs of a job spec as they are once migrated, for
+// comparing specs that may not have been
+func Outputs(spec *quantumv1.QiskitJobSpec) []quantumv1.OutputSpec {
+	if len(spec.Outputs) > 0 || spec.Output == nil {
+		return spec.Outputs
+	}
+	return []quantumv1.OutputSpec{*spec.Output}
+}
+
+// Spec returns a copy of a job spec as it is once migrated, for comparing
+// specs that may not have been
+func Spec(spec *quantumv1.QiskitJobSpec) *quantumv1.QiskitJobSpec {
+	migrated := spec.DeepCopy()
+	migrateLegacyIBMAuth(&migrated.Backend)
+	migrateOutput(migrated)
+	return migrated
+}
+
+// recordAnnotation merges the migrated field paths into the annotation
+func recordAnnotation(job *quantumv1.QiskitJob, migrated []string) {
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	seen := map[string]bool{}
+	for _, path := range strings.Split(job.Annotations[MigratedFieldsAnnotation], ",") {
+		if path != "" {
+			seen[path] = true
+		}
+	}
+	for _, path := range migrated {
+		seen[path] = true
+	}
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	job.Annotations[MigratedFieldsAnnotation] = strings.Join(paths, ",")
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notify tells HTTP webhooks and Slack-compatible endpoints when
+// QiskitJobs finish, so that users waiting out long hardware queues need
+// not poll the cluster for their results.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Notification types
+const (
+	// TypeWebhook posts the Payload as JSON
+	TypeWebhook = "webhook"
+	// TypeSlack posts a message to a Slack-compatible incoming webhook
+	TypeSlack = "slack"
+)
+
+// URLKey is the key of a notification Secret holding the URL to post to;
+// every other key is sent as an HTTP header
+const URLKey = "url"
+
+// requestTimeout bounds each notification request
+const requestTimeout = 30 * time.Second
+
+// maxResponseBytes is the most of a response kept for error messages
+const maxResponseBytes = 4096
+
+// Payload is what a webhook is told about a finished job
+type Payload struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+	Phase     string `json:"phase"`
+	Message   string `json:"message,omitempty"`
+	// Backend the job ran on
+	Backend string `json:"backend,omitempty"`
+	// Cost is the actual cost of the job, or its estimate if it is unknown
+	Cost string `json:"cost,omitempty"`
+	// ResultsLocation is where the job's results are stored
+	ResultsLocation string     `json:"resultsLocation,omitempty"`
+	StartTime       *time.Time `json:"startTime,omitempty"`
+	CompletionTime  *time.Time `json:"completionTime,omitempty"`
+}
+
+// PayloadFromJob describes a finished job
+func PayloadFromJob(job *quantumv1.QiskitJob) Payload {
+	p := Payload{
+		Namespace:       job.Namespace,
+		Name:            job.Name,
+		UID:             string(job.UID),
+		Phase:           string(job.Status.Phase),
+		Message:         job.Status.Message,
+		Backend:         job.Status.SelectedBackend,
+		Cost:            job.Status.ActualCost,
+		ResultsLocation: resultsLocation(job),
+	}
+	if p.Backend == "" {
+		p.Backend = job.Spec.Backend.Name
+	}
+	if p.Cost == "" {
+		p.Cost = job.Status.EstimatedCost
+	}
+	if t := job.Status.StartTime; t != nil {
+		p.StartTime = &t.Time
+	}
+	if t := job.Status.CompletionTime; t != nil {
+		p.CompletionTime = &t.Time
+	}
+	return p
+}
+
+// resultsLocation returns where the job's results are stored: the location
+// recorded with its results, or that of the first output they were
+// exported to
+func resultsLocation(job *quantumv1.QiskitJob) string {
+	if r := job.Status.Results; r != nil && r.Location != "" {
+		return r.Location
+	}
+	for _, output := range job.Status.Outputs {
+		if output.State != quantumv1.OutputFailed && output.Location != "" {
+			return output.Location
+		}
+	}
+	return ""
+}
+
+// Target is an endpoint notifications are posted to
+type Target struct {
+	// Type is TypeWebhook or TypeSlack
+	Type string
+	URL  string
+	// Headers are sent with every request, e.g. Authorization
+	Headers map[string]string
+}
+
+// RejectedError is returned when the endpoint rejected a notification, or
+// it could not be sent, in a way sending it again would not change
+type RejectedError struct {
+	// Status is the HTTP status the endpoint answered with, 0 if the
+	// notification was not sent
+	Status int
+	Body   string
+}
+
+func (e *RejectedError) Error() string {
+	if e.Status == 0 {
+		return e.Body
+	}
+	if e.Body == "" {
+		return fmt.Sprintf("notification rejected: %d %s", e.Status, http.StatusText(e.Status))
+	}
+	return fmt.Sprintf("notification rejected: %d %s: %s", e.Status, http.StatusText(e.Status), e.Body)
+}
+
+// Rejected reports whether the notification failed for good
+func Rejected(err error) bool {
+	var rejected *RejectedError
+	return errors.As(err, &rejected)
+}
+
+// Notifier posts notifications
+type Notifier struct {
+	// Client sends the requests, one with a timeout if nil
+	Client *http.Client
+}
+
+// Send posts the payload to the target in the target's format. Endpoints
+// that answer with a client error other than 408 or 429 rejected it for
+// good; other failures are worth retrying.
+func (n *Notifier) Send(ctx context.Context, target Target, payload Payload) error {
+	var body any = payload
+	switch target.Type {
+	case TypeWebhook, "":
+	case TypeSlack:
+		body = slackMessage(payload)
+	default:
+		return &RejectedError{Body: fmt.Sprintf("unknown notification type %q", target.Type)}
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(data))
+	if err != nil {
+		return &RejectedError{Body: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range target.Headers {
+		req.Header.Set(name, value)
+	}
+
+	client := n.Client
+	if client == nil {
+		client = &http.Client{Timeout: requestTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+		return nil
+	}
+	text, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return &RejectedError{Status: resp.StatusCode, Body: strings.TrimSpace(string(text))}
+	}
+	return fmt.Errorf("notification endpoint answered %s", resp.Status)
+}
+
+// slackMessage is the message posted to Slack-compatible webhooks
+func slackMessage(p Payload) map[string]string {
+	var text strings.Builder
+	fmt.Fprintf(&text, "QiskitJob *%s/%s* %s", p.Namespace, p.Name, strings.ToLower(p.Phase))
+	if p.Backend != "" {
+		fmt.Fprintf(&text, " on %s", p.Backend)
+	}
+	if p.StartTime != nil && p.CompletionTime != nil {
+		fmt.Fprintf(&text, " after %s", p.CompletionTime.Sub(*p.StartTime).Round(time.Second))
+	}
+	if p.Message != "" {
+		fmt.Fprintf(&text, ": %s", p.Message)
+	}
+	if p.Cost != "" {
+		fmt.Fprintf(&text, "\nCost: %s", p.Cost)
+	}
+	if p.ResultsLocation != "" {
+		fmt.Fprintf(&text, "\nResults: %s", p.ResultsLocation)
+	}
+	return map[string]string{"text": text.String()}
+}
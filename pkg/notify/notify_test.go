@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+var _ = Describe("Notifications", func() {
+	var job *quantumv1.QiskitJob
+
+	BeforeEach(func() {
+		start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		job = &quantumv1.QiskitJob{ObjectMeta: metav1.ObjectMeta{Namespace: "lab", Name: "bell", UID: "uid-1"}}
+		job.Spec.Backend.Name = "ibm_brisbane"
+		job.Status.Phase = "Completed"
+		job.Status.SelectedBackend = "ibm_kyiv"
+		job.Status.EstimatedCost = "2.00"
+		job.Status.ActualCost = "1.50"
+		job.Status.StartTime = &metav1.Time{Time: start}
+		job.Status.CompletionTime = &metav1.Time{Time: start.Add(90 * time.Second)}
+		job.Status.Outputs = []quantumv1.OutputStatus{
+			{Name: "s3", Type: "s3", Location: "s3://results/bell/", State: quantumv1.OutputExported},
+		}
+	})
+
+	It("describes a finished job", func() {
+		p := PayloadFromJob(job)
+		Expect(p.Name).To(Equal("bell"))
+		Expect(p.UID).To(Equal("uid-1"))
+		Expect(p.Backend).To(Equal("ibm_kyiv"))
+		Expect(p.Cost).To(Equal("1.50"))
+		Expect(p.ResultsLocation).To(Equal("s3://results/bell/"))
+
+		job.Status.SelectedBackend = ""
+		job.Status.ActualCost = ""
+		job.Status.Results = &quantumv1.ResultsInfo{Location: "configmap://lab/bell-results"}
+		p = PayloadFromJob(job)
+		Expect(p.Backend).To(Equal("ibm_brisbane"))
+		Expect(p.Cost).To(Equal("2.00"))
+		Expect(p.ResultsLocation).To(Equal("configmap://lab/bell-results"))
+	})
+
+	Context("sent", func() {
+		var (
+			server   *httptest.Server
+			status   int
+			received []byte
+			headers  http.Header
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				headers = r.Header.Clone()
+				received, _ = io.ReadAll(r.Body)
+				w.WriteHeader(status)
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("posts the payload to webhooks with the target's headers", func() {
+			target := Target{Type: TypeWebhook, URL: server.URL, Headers: map[string]string{"Authorization": "Bearer t0ken"}}
+			Expect((&Notifier{}).Send(context.Background(), target, PayloadFromJob(job))).To(Succeed())
+			Expect(headers.Get("Authorization")).To(Equal("Bearer t0ken"))
+			Expect(headers.Get("Content-Type")).To(Equal("application/json"))
+			var p Payload
+			Expect(json.Unmarshal(received, &p)).To(Succeed())
+			Expect(p.Phase).To(Equal("Completed"))
+			Expect(p.ResultsLocation).To(Equal("s3://results/bell/"))
+		})
+
+		It("posts a message to Slack", func() {
+			target := Target{Type: TypeSlack, URL: server.URL}
+			Expect((&Notifier{}).Send(context.Background(), target, PayloadFromJob(job))).To(Succeed())
+			var message map[string]string
+			Expect(json.Unmarshal(received, &message)).To(Succeed())
+			Expect(message).To(HaveKeyWithValue("text",
+				"QiskitJob *lab/bell* completed on ibm_kyiv after 1m30s\nCost: 1.50\nResults: s3://results/bell/"))
+		})
+
+		It("tells rejections from failures worth retrying", func() {
+			target := Target{URL: server.URL}
+			status = http.StatusForbidden
+			err := (&Notifier{}).Send(context.Background(), target, PayloadFromJob(job))
+			Expect(Rejected(err)).To(BeTrue())
+
+			for _, status = range []int{http.StatusTooManyRequests, http.StatusBadGateway} {
+				err = (&Notifier{}).Send(context.Background(), target, PayloadFromJob(job))
+				Expect(err).To(HaveOccurred())
+				Expect(Rejected(err)).To(BeFalse())
+			}
+		})
+
+		It("rejects invalid targets", func() {
+			Expect(Rejected((&Notifier{}).Send(context.Background(), Target{Type: "email", URL: server.URL}, PayloadFromJob(job)))).To(BeTrue())
+			Expect(Rejected((&Notifier{}).Send(context.Background(), Target{URL: "://nowhere"}, PayloadFromJob(job)))).To(BeTrue())
+		})
+	})
+})
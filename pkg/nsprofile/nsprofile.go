@@ -0,0 +1,84 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nsprofile injects the credentials and outputs namespace
+// administrators set in QuantumNamespaceProfiles into the namespace's
+// QiskitJobs that leave them unset. The defaulting webhook injects them when
+// jobs are created, so users never have to know where the namespace's
+// credentials live or where its results go.
+package nsprofile
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Annotations naming the QuantumNamespaceProfile the job's settings were
+// injected from
+const (
+	CredentialsFromAnnotation = "quantum.io/credentials-from"
+	OutputsFromAnnotation     = "quantum.io/outputs-from"
+)
+
+// Inject fills the settings the job leaves unset from the
+// QuantumNamespaceProfiles of its namespace. It returns the fields injected.
+func Inject(ctx context.Context, r client.Reader, job *quantumv1.QiskitJob) ([]string, error) {
+	var profiles quantumv1.QuantumNamespaceProfileList
+	if err := r.List(ctx, &profiles, client.InNamespace(job.Namespace)); err != nil {
+		return nil, err
+	}
+	return Apply(job, profiles.Items), nil
+}
+
+// Apply fills the credentials and outputs the job leaves unset from the
+// first of the profiles, by name, that sets them, and records the profile in
+// the job's annotations. Local simulator jobs need no credentials and get
+// none. It returns the fields injected.
+func Apply(job *quantumv1.QiskitJob, profiles []quantumv1.QuantumNamespaceProfile) []string {
+	var credentials, outputs *quantumv1.QuantumNamespaceProfile
+	for i := range profiles {
+		profile := &profiles[i]
+		if profile.Spec.Credentials != nil && (credentials == nil || profile.Name < credentials.Name) {
+			credentials = profile
+		}
+		if len(profile.Spec.Outputs) > 0 && (outputs == nil || profile.Name < outputs.Name) {
+			outputs = profile
+		}
+	}
+
+	var injected []string
+	if credentials != nil && job.Spec.Credentials == nil && job.Spec.Backend.Type != "local_simulator" {
+		job.Spec.Credentials = credentials.Spec.Credentials.DeepCopy()
+		annotate(job, CredentialsFromAnnotation, credentials.Name)
+		injected = append(injected, "credentials")
+	}
+	if outputs != nil && len(job.Spec.Outputs) == 0 && job.Spec.Output == nil {
+		job.Spec.Outputs = outputs.DeepCopy().Spec.Outputs
+		annotate(job, OutputsFromAnnotation, outputs.Name)
+		injected = append(injected, "outputs")
+	}
+	return injected
+}
+
+func annotate(job *quantumv1.QiskitJob, key, value string) {
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[key] = value
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package offload keeps large inline circuit code out of QiskitJobs. Inline
+// code is limited to MaxInlineBytes, well within the size limit of etcd
+// objects and of the last-applied-configuration annotation kubectl apply
+// writes. Jobs that opt in may carry up to MaxOffloadedBytes, which the
+// operator moves into a ConfigMap owned by the job before running it, so
+// the stored job stays small.
+package offload
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Annotation opts a job into having inline code over MaxInlineBytes
+// offloaded into a ConfigMap when set to "true"
+const Annotation = "quantum.io/offload-code"
+
+// OffloadedAnnotation records the size in bytes of the inline code a job's
+// ConfigMap took over
+const OffloadedAnnotation = "quantum.io/offloaded-code-bytes"
+
+// MaxInlineBytes is the most inline code a job may carry
+const MaxInlineBytes = 128 * 1024
+
+// MaxOffloadedBytes is the most inline code a job that opts into offloading
+// may be created with, leaving room in the ConfigMap it is moved into
+const MaxOffloadedBytes = 512 * 1024
+
+// Requested reports whether the job opts into offloading its inline code
+func Requested(job *quantumv1.QiskitJob) bool {
+	return job.Annotations[Annotation] == "true"
+}
+
+// Needed reports whether the job's inline code should be offloaded
+func Needed(job *quantumv1.QiskitJob) bool {
+	circuit := &job.Spec.Circuit
+	return circuit.Source == "inline" && len(circuit.Code) > MaxInlineBytes && Requested(job)
+}
+
+// ConfigMapName names the ConfigMap the job's inline code is moved into
+func ConfigMapName(job *quantumv1.QiskitJob) string {
+	return job.Name + "-inline-circuit"
+}
+
+// Key returns the ConfigMap key the circuit's code is stored under
+func Key(circuit *quantumv1.CircuitSpec) string {
+	if circuit.Format == "qasm2" || circuit.Format == "qasm3" {
+		return "circuit.qasm"
+	}
+	return "circuit.py"
+}
+
+// ConfigMap returns the ConfigMap holding the job's inline code. The caller
+// makes the job its owner.
+func ConfigMap(job *quantumv1.QiskitJob) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName(job),
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				"app":            "qiskit-operator",
+				"quantum.io/job": job.Name,
+			},
+		},
+		Data: map[string]string{Key(&job.Spec.Circuit): job.Spec.Circuit.Code},
+	}
+}
+
+// Apply points the job's circuit at the ConfigMap its inline code was moved
+// into and drops the code from the spec
+func Apply(job *quantumv1.QiskitJob) {
+	circuit := &job.Spec.Circuit
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[OffloadedAnnotation] = strconv.Itoa(len(circuit.Code))
+	circuit.Source = "configmap"
+	circuit.ConfigMapRef = &quantumv1.ConfigMapRef{Name: ConfigMapName(job), Key: Key(circuit)}
+	circuit.Code = ""
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package packages checks the extra Python packages jobs ask to install into
+// their executor against the operator's allow-list, and configures the
+// package index executors install from.
+package packages
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// requirementPattern accepts a package name with optional extras and version
+// specifiers, e.g. "qiskit-nature" or "qiskit-optimization[cplex]>=0.6,<0.7".
+// URLs, local paths and pip options are rejected, as is anything that would
+// need escaping in the executor's shell.
+var requirementPattern = regexp.MustCompile(
+	`^([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)(\[[A-Za-z0-9._,-]+\])?` +
+		`((?:~=|==|!=|<=|>=|<|>)[A-Za-z0-9.*+!]+(?:,(?:~=|==|!=|<=|>=|<|>)[A-Za-z0-9.*+!]+)*)?$`)
+
+var separators = regexp.MustCompile(`[-_.]+`)
+
+// Normalize returns the normalized form of a package name (PEP 503), so
+// "Qiskit_Nature" and "qiskit-nature" compare equal
+func Normalize(name string) string {
+	return separators.ReplaceAllString(strings.ToLower(name), "-")
+}
+
+// Name returns the normalized package name of a requirement
+func Name(requirement string) (string, error) {
+	match := requirementPattern.FindStringSubmatch(requirement)
+	if match == nil {
+		return "", fmt.Errorf("%q is not a package name with optional version specifiers (e.g. qiskit-nature>=0.7)", requirement)
+	}
+	return Normalize(match[1]), nil
+}
+
+// Allowlist holds the packages jobs may install, as package names or glob
+// patterns over normalized names (e.g. "qiskit-*"). An empty allow-list
+// allows no extra packages.
+type Allowlist []string
+
+// ParseAllowlist reads a comma-separated allow-list
+func ParseAllowlist(value string) (Allowlist, error) {
+	var allowlist Allowlist
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		pattern := Normalize(entry)
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid allowed package pattern %q: %w", entry, err)
+		}
+		allowlist = append(allowlist, pattern)
+	}
+	return allowlist, nil
+}
+
+// Allows reports whether the normalized package name is on the allow-list
+func (a Allowlist) Allows(name string) bool {
+	for _, pattern := range a {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns an error naming the requirements that are malformed or not
+// on the allow-list
+func (a Allowlist) Check(requirements []string) error {
+	if len(requirements) == 0 {
+		return nil
+	}
+	if len(a) == 0 {
+		return fmt.Errorf("this operator allows no extra packages")
+	}
+	var denied []string
+	for _, requirement := range requirements {
+		name, err := Name(requirement)
+		if err != nil {
+			return err
+		}
+		if !a.Allows(name) {
+			denied = append(denied, name)
+		}
+	}
+	if len(denied) > 0 {
+		return fmt.Errorf("not on the package allow-list: %s (allowed: %s)",
+			strings.Join(denied, ", "), strings.Join(a, ", "))
+	}
+	return nil
+}
+
+// Index configures where executors install packages from
+type Index struct {
+	// URL of the package index replacing PyPI, e.g. an internal mirror
+	URL string
+	// Proxy is the HTTP proxy pip connects through
+	Proxy string
+}
+
+// Env returns the pip environment variables that apply the index
+func (i Index) Env() []corev1.EnvVar {
+	var env []corev1.EnvVar
+	if i.URL != "" {
+		env = append(env, corev1.EnvVar{Name: "PIP_INDEX_URL", Value: i.URL})
+	}
+	if i.Proxy != "" {
+		env = append(env, corev1.EnvVar{Name: "PIP_PROXY", Value: i.Proxy})
+	}
+	return env
+}
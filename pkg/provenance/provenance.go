@@ -0,0 +1,107 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provenance identifies the QiskitJob behind a provider job. The
+// identity travels as IBM Runtime job tags and session metadata, so jobs seen
+// in the IBM Quantum dashboard can be traced back to the cluster resource
+// that created them.
+package provenance
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Tags identifying a QiskitJob. OperatorTag marks every job the operator
+// submits; the prefixed tags carry the job's identity and, when known, the
+// cluster it runs in.
+const (
+	OperatorTag        = "qiskit-operator"
+	ClusterTagPrefix   = "k8s-cluster:"
+	NamespaceTagPrefix = "k8s-namespace:"
+	NameTagPrefix      = "k8s-name:"
+	UIDTagPrefix       = "k8s-uid:"
+)
+
+// ClusterTag returns the tag marking provider jobs submitted from the
+// cluster with the given ID, the UID of its kube-system namespace
+func ClusterTag(clusterID string) string {
+	return ClusterTagPrefix + clusterID
+}
+
+// JobTags returns the provider job tags of a QiskitJob: its identity
+// followed by the user-supplied spec.execution.tags, without duplicates
+func JobTags(job *quantumv1.QiskitJob) []string {
+	tags := []string{
+		OperatorTag,
+		NamespaceTagPrefix + job.Namespace,
+		NameTagPrefix + job.Name,
+	}
+	if job.UID != "" {
+		tags = append(tags, UIDTagPrefix+string(job.UID))
+	}
+
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		seen[tag] = true
+	}
+	for _, tag := range job.Spec.Execution.Tags {
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// SessionMetadata returns the metadata recorded on the Runtime session a job
+// runs in, or nil if the job does not use a session
+func SessionMetadata(job *quantumv1.QiskitJob) map[string]string {
+	if job.Spec.Session == nil {
+		return nil
+	}
+	metadata := map[string]string{
+		"k8s_namespace": job.Namespace,
+		"k8s_name":      job.Name,
+		"k8s_uid":       string(job.UID),
+		"created_by":    OperatorTag,
+	}
+	if job.Spec.Session.Name != "" {
+		metadata["session"] = job.Spec.Session.Name
+	}
+	return metadata
+}
+
+// FromTags recovers the QiskitJob a provider job was submitted for. It
+// reports false if the tags do not identify one.
+func FromTags(tags []string) (types.NamespacedName, types.UID, bool) {
+	var key types.NamespacedName
+	var uid types.UID
+	for _, tag := range tags {
+		switch {
+		case strings.HasPrefix(tag, NamespaceTagPrefix):
+			key.Namespace = strings.TrimPrefix(tag, NamespaceTagPrefix)
+		case strings.HasPrefix(tag, NameTagPrefix):
+			key.Name = strings.TrimPrefix(tag, NameTagPrefix)
+		case strings.HasPrefix(tag, UIDTagPrefix):
+			uid = types.UID(strings.TrimPrefix(tag, UIDTagPrefix))
+		}
+	}
+	return key, uid, key.Namespace != "" && key.Name != ""
+}
@@ -0,0 +1,726 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package qasm checks the structure of OpenQASM 2 and 3 programs without
+// loading them into Qiskit: the version they declare, their registers, and
+// that every gate they apply is defined and applied to as many qubits as it
+// acts on, within the bounds of its registers. It reports the shape of the
+// circuit as the validation service does for Python circuits. Expressions,
+// such as gate parameters and loop bounds, are left to Qiskit's loaders.
+package qasm
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Program is the shape of the circuit a program builds
+type Program struct {
+	// Version of OpenQASM, 2 or 3
+	Version int
+	Qubits  int
+	Clbits  int
+	// Gates counts the instructions, a gate applied to whole registers once
+	// per qubit as Qiskit broadcasts it
+	Gates int
+	// Depth of the circuit, not counting barriers. Control-flow blocks
+	// count as one instruction and do not add to it.
+	Depth     int
+	GateTypes map[string]int
+}
+
+// qelib1 are the gates of OpenQASM 2's qelib1.inc as Qiskit defines them,
+// with the number of qubits each acts on
+var qelib1 = map[string]int{
+	"u3": 1, "u2": 1, "u1": 1, "cx": 2, "id": 1, "u0": 1, "u": 1, "p": 1,
+	"x": 1, "y": 1, "z": 1, "h": 1, "s": 1, "sdg": 1, "t": 1, "tdg": 1,
+	"rx": 1, "ry": 1, "rz": 1, "sx": 1, "sxdg": 1,
+	"cz": 2, "cy": 2, "swap": 2, "ch": 2, "crx": 2, "cry": 2, "crz": 2,
+	"cu1": 2, "cp": 2, "cu3": 2, "csx": 2, "cu": 2, "rxx": 2, "rzz": 2,
+	"ccx": 3, "cswap": 3, "rccx": 3, "rc3x": 4, "c3x": 4, "c3sqrtx": 4, "c4x": 5,
+}
+
+// stdgates are the gates of OpenQASM 3's stdgates.inc
+var stdgates = map[string]int{
+	"p": 1, "x": 1, "y": 1, "z": 1, "h": 1, "s": 1, "sdg": 1, "t": 1, "tdg": 1,
+	"sx": 1, "rx": 1, "ry": 1, "rz": 1, "id": 1, "u1": 1, "u2": 1, "u3": 1, "phase": 1,
+	"cx": 2, "CX": 2, "cy": 2, "cz": 2, "cp": 2, "cphase": 2, "crx": 2, "cry": 2, "crz": 2,
+	"ch": 2, "swap": 2, "cu": 2,
+	"ccx": 3, "cswap": 3,
+}
+
+// builtins are the gates every program may apply
+var builtins = map[int]map[string]int{
+	2: {"U": 1, "CX": 2},
+	3: {"U": 1, "gphase": 0},
+}
+
+// standardInclude is the gate library each version may include
+var standardInclude = map[int]string{2: "qelib1.inc", 3: "stdgates.inc"}
+
+// qasm3Only are statements OpenQASM 2 does not have
+var qasm3Only = map[string]bool{
+	"qubit": true, "bit": true, "def": true, "for": true, "while": true, "box": true,
+	"input": true, "output": true, "let": true, "const": true, "int": true, "uint": true,
+	"float": true, "angle": true, "bool": true, "complex": true, "duration": true,
+	"stretch": true, "array": true, "delay": true, "extern": true, "end": true,
+	"break": true, "continue": true, "return": true, "cal": true, "defcal": true,
+	"defcalgrammar": true, "inv": true, "pow": true, "ctrl": true, "negctrl": true,
+}
+
+// ignored are statements that declare or compute classical values, or
+// calibrate, and build no instructions of the circuit
+var ignored = map[string]bool{
+	"input": true, "output": true, "let": true, "const": true, "int": true, "uint": true,
+	"float": true, "angle": true, "bool": true, "complex": true, "duration": true,
+	"stretch": true, "array": true, "extern": true, "end": true, "break": true,
+	"continue": true, "return": true, "cal": true, "defcal": true, "defcalgrammar": true,
+	"pragma": true,
+}
+
+var (
+	commentPattern   = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	wordPattern      = regexp.MustCompile(`^#?[A-Za-z_][A-Za-z0-9_]*`)
+	versionPattern   = regexp.MustCompile(`^OPENQASM\s+(\d+)(?:\.(\d+))?$`)
+	includePattern   = regexp.MustCompile(`^include\s+"([^"]*)"$`)
+	oldRegPattern    = regexp.MustCompile(`^(qreg|creg)\s+([A-Za-z_]\w*)\s*\[\s*(\d+)\s*\]$`)
+	newRegPattern    = regexp.MustCompile(`^(qubit|bit)\s*(?:\[\s*([^\]]*?)\s*\])?\s+([A-Za-z_]\w*)(?:\s*=.*)?$`)
+	gatePattern      = regexp.MustCompile(`(?s)^gate\s+([A-Za-z_]\w*)\s*(?:\([^)]*\))?\s*([^{]*?)\s*\{(.*)\}$`)
+	opaquePattern    = regexp.MustCompile(`^opaque\s+([A-Za-z_]\w*)\s*(?:\([^)]*\))?\s*(.*)$`)
+	defPattern       = regexp.MustCompile(`^def\s+([A-Za-z_]\w*)`)
+	arrowPattern     = regexp.MustCompile(`^measure\s+(.+?)\s*->\s*(.+)$`)
+	assignPattern    = regexp.MustCompile(`^(.+?)\s*=\s*measure\s+(.+)$`)
+	classicalPattern = regexp.MustCompile(`^[A-Za-z_]\w*\s*(?:\[[^\]]*\])?\s*(?:[-+*/%&|^]|<<|>>|\*\*)?=`)
+	modifierPattern  = regexp.MustCompile(`^(inv|pow\s*\([^)]*\)|(?:neg)?ctrl(?:\s*\(\s*([^)]*?)\s*\))?)\s*@\s*`)
+	operandPattern   = regexp.MustCompile(`^([A-Za-z_]\w*)\s*(?:\[\s*([^\]]+?)\s*\])?$`)
+	physicalPattern  = regexp.MustCompile(`^\$(\d+)$`)
+)
+
+// statement is a statement of a program, without its semicolon
+type statement struct {
+	text string
+	line int
+}
+
+// register is a declared quantum or classical register; single qubits and
+// bits are registers of one
+type register struct {
+	size    int
+	quantum bool
+}
+
+// operand is a register or one of its elements an instruction acts on.
+// Index is -1 for the whole register and -2 for an index that is not a
+// literal, such as a loop variable.
+type operand struct {
+	name  string
+	index int
+}
+
+type parser struct {
+	program   *Program
+	gates     map[string]int
+	defs      map[string]bool
+	registers map[string]register
+	physical  int
+	levels    map[string]int
+}
+
+// Parse checks a program written in the given OpenQASM version, 2 or 3,
+// and returns the shape of its circuit
+func Parse(source string, version int) (*Program, error) {
+	if version != 2 && version != 3 {
+		return nil, fmt.Errorf("OpenQASM %d is not supported", version)
+	}
+	p := &parser{
+		program:   &Program{Version: version, GateTypes: map[string]int{}},
+		gates:     map[string]int{},
+		defs:      map[string]bool{},
+		registers: map[string]register{},
+		levels:    map[string]int{},
+	}
+	for name, qubits := range builtins[version] {
+		p.gates[name] = qubits
+	}
+
+	// Comments are blanked out keeping their newlines, so lines still count
+	source = commentPattern.ReplaceAllStringFunc(source, func(comment string) string {
+		return strings.Repeat("\n", strings.Count(comment, "\n"))
+	})
+	statements, err := split(source, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("program is empty")
+	}
+	for i, s := range statements {
+		if strings.HasPrefix(s.text, "OPENQASM") {
+			if i > 0 {
+				return nil, fmt.Errorf("line %d: OPENQASM must be the first statement", s.line)
+			}
+			if err := checkVersion(s, version); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if i == 0 && version == 2 {
+			return nil, fmt.Errorf("line %d: OpenQASM 2 programs must start with OPENQASM 2.0;", s.line)
+		}
+		if err := p.statement(s, true); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, r := range p.registers {
+		if r.quantum {
+			p.program.Qubits += r.size
+		} else {
+			p.program.Clbits += r.size
+		}
+	}
+	p.program.Qubits += p.physical
+	return p.program, nil
+}
+
+// split splits source into statements: up to a semicolon, or up to the
+// closing brace of a block and any else block after it. first is the line
+// source starts on.
+func split(source string, first int) ([]statement, error) {
+	var statements []statement
+	depth, start, line := 0, 0, first
+	quoted := false
+	end := func(i int) {
+		text := source[start:i]
+		trimmed := strings.TrimSpace(text)
+		if trimmed != "" {
+			leading := len(text) - len(strings.TrimLeft(text, " \t\r\n"))
+			statements = append(statements, statement{
+				text: trimmed,
+				line: line + strings.Count(text[:leading], "\n"),
+			})
+		}
+		line += strings.Count(source[start:i], "\n")
+		start = i
+	}
+	for i := 0; i < len(source); i++ {
+		switch c := source[i]; {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("line %d: unbalanced }", line+strings.Count(source[start:i], "\n"))
+			}
+			if depth == 0 && !strings.HasPrefix(strings.TrimSpace(source[i+1:]), "else") &&
+				!strings.HasPrefix(strings.TrimSpace(source[i+1:]), ";") {
+				end(i + 1)
+			}
+		case c == ';' && depth == 0:
+			end(i)
+			start = i + 1
+		}
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: missing }", line)
+	}
+	if rest := strings.TrimSpace(source[start:]); rest != "" {
+		leading := len(source[start:]) - len(strings.TrimLeft(source[start:], " \t\r\n"))
+		return nil, fmt.Errorf("line %d: missing ; after %q", line+strings.Count(source[start:start+leading], "\n"), rest)
+	}
+	return statements, nil
+}
+
+// checkVersion checks that the program declares the version it is loaded as
+func checkVersion(s statement, version int) error {
+	m := versionPattern.FindStringSubmatch(s.text)
+	if m == nil {
+		return fmt.Errorf("line %d: %q is not a version declaration", s.line, s.text)
+	}
+	if declared, _ := strconv.Atoi(m[1]); declared != version {
+		return fmt.Errorf("line %d: program declares OpenQASM %s but is loaded as OpenQASM %d; set spec.circuit.format to qasm%s",
+			s.line, strings.TrimPrefix(s.text, "OPENQASM "), version, m[1])
+	}
+	return nil
+}
+
+// statement checks a statement and, if record is set, adds the instructions
+// it builds to the program. Statements within control-flow blocks are
+// checked, but not recorded.
+func (p *parser) statement(s statement, record bool) error {
+	version := p.program.Version
+	keyword := wordPattern.FindString(s.text)
+	if version == 2 && qasm3Only[keyword] {
+		return fmt.Errorf("line %d: %s is OpenQASM 3; set spec.circuit.format to qasm3", s.line, keyword)
+	}
+
+	switch keyword {
+	case "include":
+		m := includePattern.FindStringSubmatch(s.text)
+		if m == nil {
+			return fmt.Errorf("line %d: include needs a quoted file name", s.line)
+		}
+		if m[1] != standardInclude[version] {
+			return fmt.Errorf("line %d: cannot include %q, only %s is available", s.line, m[1], standardInclude[version])
+		}
+		library := qelib1
+		if version == 3 {
+			library = stdgates
+		}
+		for name, qubits := range library {
+			p.gates[name] = qubits
+		}
+		return nil
+	case "qreg", "creg":
+		m := oldRegPattern.FindStringSubmatch(s.text)
+		if m == nil {
+			return fmt.Errorf("line %d: %s needs a name and a size, e.g. %s q[2]", s.line, keyword, keyword)
+		}
+		size, _ := strconv.Atoi(m[3])
+		return p.declare(s, m[2], size, keyword == "qreg")
+	case "qubit", "bit":
+		m := newRegPattern.FindStringSubmatch(s.text)
+		if m == nil {
+			return fmt.Errorf("line %d: %s needs a name, e.g. %s[2] q", s.line, keyword, keyword)
+		}
+		size := 1
+		if m[2] != "" {
+			n, err := strconv.Atoi(m[2])
+			if err != nil {
+				return fmt.Errorf("line %d: size of %s must be an integer literal", s.line, m[3])
+			}
+			size = n
+		}
+		return p.declare(s, m[3], size, keyword == "qubit")
+	case "gate":
+		return p.gate(s)
+	case "opaque":
+		m := opaquePattern.FindStringSubmatch(s.text)
+		if m == nil || strings.TrimSpace(m[2]) == "" {
+			return fmt.Errorf("line %d: opaque needs a name and qubit arguments", s.line)
+		}
+		p.gates[m[1]] = len(strings.Split(m[2], ","))
+		return nil
+	case "def":
+		if m := defPattern.FindStringSubmatch(s.text); m != nil {
+			p.defs[m[1]] = true
+		}
+		return nil
+	case "if", "for", "while", "box":
+		return p.block(s, keyword, record)
+	case "measure":
+		if m := arrowPattern.FindStringSubmatch(s.text); m != nil {
+			return p.measure(s, m[1], m[2], record)
+		}
+		return p.measure(s, strings.TrimSpace(strings.TrimPrefix(s.text, "measure")), "", record)
+	case "reset", "barrier", "delay":
+		rest := strings.TrimSpace(s.text[len(keyword):])
+		if keyword == "delay" {
+			// The duration is in brackets: delay[100ns] q[0]
+			if _, after, ok := strings.Cut(rest, "]"); ok {
+				rest = strings.TrimSpace(after)
+			}
+		}
+		operands, err := p.operands(s, rest, true)
+		if err != nil {
+			return err
+		}
+		if keyword == "barrier" && len(operands) == 0 && version == 2 {
+			return fmt.Errorf("line %d: barrier needs qubits", s.line)
+		}
+		if record {
+			p.record(keyword, operands, nil)
+		}
+		return nil
+	}
+	if ignored[strings.TrimPrefix(keyword, "#")] {
+		return nil
+	}
+	if m := assignPattern.FindStringSubmatch(s.text); m != nil {
+		return p.measure(s, m[2], m[1], record)
+	}
+	if classicalPattern.MatchString(s.text) {
+		return nil
+	}
+	return p.call(s, record)
+}
+
+// declare adds a register
+func (p *parser) declare(s statement, name string, size int, quantum bool) error {
+	if _, ok := p.registers[name]; ok {
+		return fmt.Errorf("line %d: %s is already declared", s.line, name)
+	}
+	if size < 1 {
+		return fmt.Errorf("line %d: %s must have at least one element", s.line, name)
+	}
+	p.registers[name] = register{size: size, quantum: quantum}
+	return nil
+}
+
+// gate checks a gate definition: its body may only apply gates defined
+// before it to its own qubit arguments
+func (p *parser) gate(s statement) error {
+	m := gatePattern.FindStringSubmatch(s.text)
+	if m == nil {
+		return fmt.Errorf("line %d: gate needs a name, qubit arguments and a body in braces", s.line)
+	}
+	name := m[1]
+	args := map[string]bool{}
+	for _, arg := range strings.Split(m[2], ",") {
+		if arg = strings.TrimSpace(arg); arg != "" {
+			args[arg] = true
+		}
+	}
+	if len(args) == 0 && p.program.Version == 2 {
+		return fmt.Errorf("line %d: gate %s needs qubit arguments", s.line, name)
+	}
+	body, err := split(m[3], s.line)
+	if err != nil {
+		return err
+	}
+	for _, b := range body {
+		c, err := p.parseCall(b)
+		if err != nil {
+			return err
+		}
+		if c.name == "barrier" {
+			continue
+		}
+		if err := p.checkArity(b, c); err != nil {
+			return err
+		}
+		for _, operand := range c.operands {
+			if !args[operand] {
+				return fmt.Errorf("line %d: gate %s applies %s to %s, which is not one of its qubit arguments",
+					b.line, name, c.name, operand)
+			}
+		}
+	}
+	p.gates[name] = len(args)
+	return nil
+}
+
+// block checks the statements of a control-flow block and records the
+// block as one instruction. OpenQASM 2's if applies a single gate, which is
+// recorded as it is.
+func (p *parser) block(s statement, keyword string, record bool) error {
+	open := strings.IndexByte(s.text, '{')
+	if open < 0 {
+		if keyword != "if" {
+			return fmt.Errorf("line %d: %s needs a body in braces", s.line, keyword)
+		}
+		// if (c == 1) x q[0];
+		closing := matchingParen(s.text)
+		if closing < 0 {
+			return fmt.Errorf("line %d: if needs a condition in parentheses", s.line)
+		}
+		return p.statement(statement{text: strings.TrimSpace(s.text[closing+1:]), line: s.line}, record)
+	}
+	if p.program.Version == 2 {
+		return fmt.Errorf("line %d: blocks are OpenQASM 3; set spec.circuit.format to qasm3", s.line)
+	}
+	line := s.line + strings.Count(s.text[:open], "\n")
+	// The body, and that of an else, are checked statement by statement
+	for _, body := range blockBodies(s.text[open:]) {
+		statements, err := split(body, line)
+		if err != nil {
+			return err
+		}
+		for _, inner := range statements {
+			if err := p.statement(inner, false); err != nil {
+				return err
+			}
+		}
+		line += strings.Count(body, "\n")
+	}
+	if record {
+		name := map[string]string{"if": "if_else", "for": "for_loop", "while": "while_loop", "box": "box"}[keyword]
+		p.program.GateTypes[name]++
+		p.program.Gates++
+	}
+	return nil
+}
+
+// blockBodies returns the contents of the top-level braces of text
+func blockBodies(text string) []string {
+	var bodies []string
+	depth, start := 0, 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '{':
+			if depth == 0 {
+				start = i + 1
+			}
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				bodies = append(bodies, text[start:i])
+			}
+		}
+	}
+	return bodies
+}
+
+// matchingParen returns the index of the parenthesis closing the first one
+// of text, or -1
+func matchingParen(text string) int {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// measure checks a measurement of qubits into bits, which may be omitted in
+// OpenQASM 3
+func (p *parser) measure(s statement, qubits, bits string, record bool) error {
+	q, err := p.operands(s, qubits, true)
+	if err != nil {
+		return err
+	}
+	if len(q) != 1 {
+		return fmt.Errorf("line %d: measure takes one qubit or register", s.line)
+	}
+	var c []operand
+	if bits != "" {
+		if c, err = p.operands(s, bits, false); err != nil {
+			return err
+		}
+		if len(c) != 1 {
+			return fmt.Errorf("line %d: measure stores into one bit or register", s.line)
+		}
+		if qs, cs := p.width(q[0]), p.width(c[0]); qs != cs {
+			return fmt.Errorf("line %d: measure of %d qubits into %d bits", s.line, qs, cs)
+		}
+	} else if p.program.Version == 2 {
+		return fmt.Errorf("line %d: measure needs -> and the bits to store into", s.line)
+	}
+	if record {
+		p.record("measure", q, c)
+	}
+	return nil
+}
+
+// call is a gate applied to qubits
+type call struct {
+	name string
+	// controls are the qubits ctrl and negctrl modifiers add
+	controls int
+	operands []string
+}
+
+// parseCall parses a gate call: modifiers, the gate, its parameters and its
+// qubits
+func (p *parser) parseCall(s statement) (call, error) {
+	var c call
+	text := s.text
+	for {
+		m := modifierPattern.FindStringSubmatchIndex(text)
+		if m == nil {
+			break
+		}
+		if p.program.Version == 2 {
+			return c, fmt.Errorf("line %d: gate modifiers are OpenQASM 3; set spec.circuit.format to qasm3", s.line)
+		}
+		if strings.Contains(text[m[2]:m[3]], "ctrl") {
+			controls := 1
+			if m[4] >= 0 {
+				n, err := strconv.Atoi(text[m[4]:m[5]])
+				if err != nil || n < 1 {
+					return c, fmt.Errorf("line %d: number of controls must be a positive integer literal", s.line)
+				}
+				controls = n
+			}
+			c.controls += controls
+		}
+		text = text[m[1]:]
+	}
+
+	c.name = wordPattern.FindString(text)
+	if c.name == "" {
+		return c, fmt.Errorf("line %d: %q is not a statement", s.line, s.text)
+	}
+	text = strings.TrimSpace(text[len(c.name):])
+	if strings.HasPrefix(text, "(") {
+		closing := matchingParen(text)
+		if closing < 0 {
+			return c, fmt.Errorf("line %d: unbalanced parentheses in %q", s.line, s.text)
+		}
+		text = strings.TrimSpace(text[closing+1:])
+	}
+	if text != "" {
+		for _, operand := range strings.Split(text, ",") {
+			c.operands = append(c.operands, strings.TrimSpace(operand))
+		}
+	}
+	return c, nil
+}
+
+// checkArity checks that the gate is defined and applied to as many qubits
+// as it acts on
+func (p *parser) checkArity(s statement, c call) error {
+	qubits, ok := p.gates[c.name]
+	if !ok {
+		hint := ""
+		library := qelib1
+		if p.program.Version == 3 {
+			library = stdgates
+		}
+		if _, standard := library[c.name]; standard {
+			hint = fmt.Sprintf("; include %q for the standard gates", standardInclude[p.program.Version])
+		}
+		return fmt.Errorf("line %d: gate %s is not defined%s", s.line, c.name, hint)
+	}
+	if want := qubits + c.controls; len(c.operands) != want {
+		return fmt.Errorf("line %d: %s acts on %d qubits, but is applied to %d", s.line, c.name, want, len(c.operands))
+	}
+	return nil
+}
+
+// call checks a gate call. Calls of subroutines are left to the loader.
+func (p *parser) call(s statement, record bool) error {
+	c, err := p.parseCall(s)
+	if err != nil {
+		return err
+	}
+	if p.defs[c.name] {
+		return nil
+	}
+	if err := p.checkArity(s, c); err != nil {
+		return err
+	}
+	operands, err := p.operands(s, strings.Join(c.operands, ","), true)
+	if err != nil {
+		return err
+	}
+	if record {
+		p.record(c.name, operands, nil)
+	}
+	return nil
+}
+
+// operands parses comma-separated registers and register elements, which
+// must be declared, of the given kind and within bounds
+func (p *parser) operands(s statement, text string, quantum bool) ([]operand, error) {
+	var operands []operand
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	whole := 0
+	for _, raw := range strings.Split(text, ",") {
+		raw = strings.TrimSpace(raw)
+		if m := physicalPattern.FindStringSubmatch(raw); m != nil && quantum && p.program.Version == 3 {
+			n, _ := strconv.Atoi(m[1])
+			p.physical = max(p.physical, n+1)
+			operands = append(operands, operand{name: raw, index: 0})
+			continue
+		}
+		m := operandPattern.FindStringSubmatch(raw)
+		if m == nil {
+			return nil, fmt.Errorf("line %d: %q is not a register or an element of one", s.line, raw)
+		}
+		r, ok := p.registers[m[1]]
+		if !ok {
+			return nil, fmt.Errorf("line %d: %s is not declared", s.line, m[1])
+		}
+		if r.quantum != quantum {
+			kind := "qubit"
+			if !quantum {
+				kind = "bit"
+			}
+			return nil, fmt.Errorf("line %d: %s is not a %s register", s.line, m[1], kind)
+		}
+		o := operand{name: m[1], index: -1}
+		switch index, err := strconv.Atoi(m[2]); {
+		case m[2] == "":
+			if whole > 0 && whole != r.size {
+				return nil, fmt.Errorf("line %d: registers applied together must have the same size", s.line)
+			}
+			whole = r.size
+		case err != nil:
+			o.index = -2
+		case index < 0 || index >= r.size:
+			return nil, fmt.Errorf("line %d: %s[%d] is out of range, %s has %d", s.line, m[1], index, m[1], r.size)
+		default:
+			o.index = index
+		}
+		operands = append(operands, o)
+	}
+	return operands, nil
+}
+
+// width returns how many qubits or bits an operand stands for
+func (p *parser) width(o operand) int {
+	if o.index == -1 {
+		return p.registers[o.name].size
+	}
+	return 1
+}
+
+// record adds an instruction, broadcast over whole registers, to the
+// program's counts and depth
+func (p *parser) record(name string, qubits, bits []operand) {
+	operands := append(append([]operand{}, qubits...), bits...)
+	n := 1
+	for _, o := range qubits {
+		if o.index == -1 && name != "barrier" {
+			n = p.width(o)
+		}
+	}
+	if name == "barrier" {
+		p.program.GateTypes[name]++
+		p.program.Gates++
+		return
+	}
+	p.program.GateTypes[name] += n
+	p.program.Gates += n
+	for i := 0; i < n; i++ {
+		var wires []string
+		for _, o := range operands {
+			switch {
+			case strings.HasPrefix(o.name, "$"):
+				wires = append(wires, o.name)
+			case o.index == -1:
+				wires = append(wires, fmt.Sprintf("%s[%d]", o.name, i))
+			case o.index >= 0:
+				wires = append(wires, fmt.Sprintf("%s[%d]", o.name, o.index))
+			}
+		}
+		level := 0
+		for _, w := range wires {
+			level = max(level, p.levels[w])
+		}
+		level++
+		for _, w := range wires {
+			p.levels[w] = level
+		}
+		p.program.Depth = max(p.program.Depth, level)
+	}
+}
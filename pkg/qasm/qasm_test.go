@@ -0,0 +1,92 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qasm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parse", func() {
+	It("should report the shape of an OpenQASM 2 circuit", func() {
+		program, err := Parse(`OPENQASM 2.0;
+include "qelib1.inc";
+// Bell state
+qreg q[2];
+creg c[2];
+h q[0];
+cx q[0], q[1];
+barrier q;
+measure q -> c;
+`, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(program.Qubits).To(Equal(2))
+		Expect(program.Clbits).To(Equal(2))
+		Expect(program.GateTypes).To(Equal(map[string]int{"h": 1, "cx": 1, "barrier": 1, "measure": 2}))
+		Expect(program.Gates).To(Equal(5))
+		Expect(program.Depth).To(Equal(3))
+	})
+
+	It("should report the shape of an OpenQASM 3 circuit with its own gates and control flow", func() {
+		program, err := Parse(`OPENQASM 3.0;
+include "stdgates.inc";
+input float theta;
+qubit[3] q;
+bit[3] c;
+gate entangle(a) x, y { ry(a) x; cx x, y; }
+entangle(theta) q[0], q[1];
+ctrl @ x q[1], q[2];
+/* Measure and correct */
+c[0] = measure q[0];
+if (c[0] == 1) { x q[2]; } else { z q[2]; }
+for int i in [0:2] { h q[i]; }
+c = measure q;
+`, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(program.Qubits).To(Equal(3))
+		Expect(program.Clbits).To(Equal(3))
+		Expect(program.GateTypes).To(Equal(map[string]int{
+			"entangle": 1, "x": 1, "measure": 4, "if_else": 1, "for_loop": 1,
+		}))
+		Expect(program.Depth).To(Equal(3))
+	})
+
+	DescribeTable("should reject programs that would not load",
+		func(source string, version int, message string) {
+			_, err := Parse(source, version)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("version mismatch", "OPENQASM 3.0;\nqubit q;\n", 2,
+			"line 1: program declares OpenQASM 3.0 but is loaded as OpenQASM 2; set spec.circuit.format to qasm3"),
+		Entry("missing header", "qreg q[1];\n", 2, "must start with OPENQASM 2.0;"),
+		Entry("standard gate without include", "OPENQASM 2.0;\nqreg q[1];\nh q[0];\n", 2,
+			`line 3: gate h is not defined; include "qelib1.inc" for the standard gates`),
+		Entry("unknown gate", "OPENQASM 3;\ninclude \"stdgates.inc\";\nqubit q;\nfoo q;\n", 3, "line 4: gate foo is not defined"),
+		Entry("wrong number of qubits", "OPENQASM 2.0;\ninclude \"qelib1.inc\";\nqreg q[2];\ncx q[0];\n", 2,
+			"line 4: cx acts on 2 qubits, but is applied to 1"),
+		Entry("out of range", "OPENQASM 2.0;\ninclude \"qelib1.inc\";\nqreg q[2];\nx q[2];\n", 2,
+			"line 4: q[2] is out of range, q has 2"),
+		Entry("undeclared register", "OPENQASM 2.0;\ninclude \"qelib1.inc\";\nx r[0];\n", 2, "line 3: r is not declared"),
+		Entry("other includes", "OPENQASM 2.0;\ninclude \"mygates.inc\";\n", 2, `cannot include "mygates.inc"`),
+		Entry("OpenQASM 3 in 2", "OPENQASM 2.0;\nqubit[2] q;\n", 2, "line 2: qubit is OpenQASM 3"),
+		Entry("missing semicolon", "OPENQASM 2.0;\nqreg q[1]\n", 2, `line 2: missing ; after "qreg q[1]"`),
+		Entry("gate body outside its arguments", "OPENQASM 2.0;\ngate g a { CX a, b; }\n", 2,
+			"b, which is not one of its qubit arguments"),
+		Entry("measure into fewer bits", "OPENQASM 2.0;\nqreg q[2];\ncreg c[1];\nmeasure q -> c;\n", 2,
+			"line 4: measure of 2 qubits into 1 bits"),
+	)
+})
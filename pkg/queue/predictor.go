@@ -0,0 +1,106 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queue predicts backend queue wait from waits the operator observed
+// itself, since provider estimates are often missing or wrong.
+package queue
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultWindow is the number of recent observations averaged per backend
+const DefaultWindow = 20
+
+// Prediction is the predicted queue wait for a backend
+type Prediction struct {
+	// Moving average of the observed waits
+	Wait time.Duration
+	// Number of observations the average is based on
+	Samples int
+	// Most recent observation
+	Last time.Duration
+}
+
+// Predictor keeps a simple moving average of observed queue waits per backend.
+// It is safe for concurrent use.
+type Predictor struct {
+	mu      sync.Mutex
+	window  int
+	samples map[string][]time.Duration
+}
+
+// NewPredictor returns a predictor averaging the last window observations
+func NewPredictor(window int) *Predictor {
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	return &Predictor{
+		window:  window,
+		samples: map[string][]time.Duration{},
+	}
+}
+
+// Observe records the queue wait of one submission to a backend
+func (p *Predictor) Observe(backend string, wait time.Duration) {
+	if wait < 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := append(p.samples[backend], wait)
+	if len(s) > p.window {
+		s = s[len(s)-p.window:]
+	}
+	p.samples[backend] = s
+}
+
+// Predict returns the predicted wait for a backend, or false if the backend
+// has no observations yet
+func (p *Predictor) Predict(backend string) (Prediction, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.samples[backend]
+	if len(s) == 0 {
+		return Prediction{}, false
+	}
+	var total time.Duration
+	for _, w := range s {
+		total += w
+	}
+	return Prediction{
+		Wait:    total / time.Duration(len(s)),
+		Samples: len(s),
+		Last:    s[len(s)-1],
+	}, true
+}
+
+// Backends returns the backends with observations, sorted by name
+func (p *Predictor) Backends() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	names := make([]string, 0, len(p.samples))
+	for name := range p.samples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
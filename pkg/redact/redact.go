@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package redact scrubs credentials from what executors print. Users print
+// their environment and backend configuration while debugging, and executor
+// output ends up in logs and stored artifacts that are shared widely. The
+// executor masks what it knows to be secret as it prints; the operator masks
+// what looks like a credential again before it stores any output.
+package redact
+
+import (
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Mask replaces a credential
+const Mask = "[REDACTED]"
+
+// MinValueLength is the length below which known secret values are not
+// masked, as they would mask common words and numbers too
+const MinValueLength = 8
+
+// patterns find credentials by their shape. Each has three groups: the text
+// before the credential, the credential and the text after it, which are
+// kept.
+var patterns = []*regexp.Regexp{
+	// key=value and "key": "value" pairs whose key names a credential
+	regexp.MustCompile(`(?i)((?:api[_-]?key|access[_-]?key|token|secret|password|passwd|credentials?)["']?[ \t]*[:=][ \t]*["']?)([^"'\s,;&}]{4,})()`),
+	// Bearer tokens of Authorization headers
+	regexp.MustCompile(`(?i)(bearer[ \t]+)([A-Za-z0-9._~+/=-]{8,})()`),
+	// Passwords of URLs
+	regexp.MustCompile(`(://[^/\s:@]+:)([^/\s@]+)(@)`),
+	// JSON Web Tokens, like IAM access tokens
+	regexp.MustCompile(`()(eyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+)()`),
+	// AWS access key IDs and GitHub tokens
+	regexp.MustCompile(`()(AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{36,})()`),
+}
+
+// String masks the given secret values and anything that looks like a
+// credential in text
+func String(text string, values ...string) string {
+	for _, value := range values {
+		if len(value) >= MinValueLength {
+			text = strings.ReplaceAll(text, value, Mask)
+		}
+	}
+	for _, pattern := range patterns {
+		text = pattern.ReplaceAllString(text, "${1}"+Mask+"${3}")
+	}
+	return text
+}
+
+// Env masks the literal values of environment variables named like
+// credentials, or holding something that looks like one
+func Env(env []corev1.EnvVar) {
+	for i := range env {
+		if env[i].Value != "" && String(env[i].Name+"="+env[i].Value) != env[i].Name+"="+env[i].Value {
+			env[i].Value = Mask
+		}
+	}
+}
+
+// Prologue is prepended to the executor code. It masks, in everything
+// written to standard output and standard error, the values of environment
+// variables named like credentials, the contents of the mounted credential
+// files and anything that looks like a credential, the way String does.
+// Output of subprocesses bypasses it; the operator masks it when storing it.
+const Prologue = `import os as _rd_os
+import re as _rd_re
+import sys as _rd_sys
+
+_rd_quotes = chr(34) + chr(39)
+_rd_space = ' ' + chr(9)
+_rd_patterns = [_rd_re.compile(p) for p in [
+    '(?i)((?:api[_-]?key|access[_-]?key|token|secret|password|passwd|credentials?)[' + _rd_quotes + ']?[' + _rd_space +
+        ']*[:=][' + _rd_space + ']*[' + _rd_quotes + ']?)([^' + _rd_quotes + _rd_space + chr(10) + chr(13) + ',;&}]{4,})()',
+    '(?i)(bearer[' + _rd_space + ']+)([A-Za-z0-9._~+/=-]{8,})()',
+    '(://[^/:@' + _rd_space + chr(10) + ']+:)([^/@' + _rd_space + chr(10) + ']+)(@)',
+    '()(eyJ[A-Za-z0-9_-]+[.]eyJ[A-Za-z0-9_-]+[.][A-Za-z0-9_-]+)()',
+    '()(AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{36,})()',
+]]
+_rd_named = _rd_re.compile('(?i)(key|token|secret|password|passwd|credential)')
+
+def _rd_values():
+    values = [v for k, v in _rd_os.environ.items() if _rd_named.search(k)]
+    directory = _rd_os.environ.get('QISKIT_CREDENTIALS_DIR')
+    if directory and _rd_os.path.isdir(directory):
+        for name in _rd_os.listdir(directory):
+            try:
+                with open(_rd_os.path.join(directory, name)) as f:
+                    values.append(f.read(4096).strip())
+            except Exception:
+                pass
+    return sorted({v for v in values if len(v) >= 8}, key=len, reverse=True)
+
+_rd_secrets = _rd_values()
+
+def redact(text):
+    for value in _rd_secrets:
+        text = text.replace(value, '` + Mask + `')
+    for pattern in _rd_patterns:
+        text = pattern.sub(lambda m: m.group(1) + '` + Mask + `' + m.group(3), text)
+    return text
+
+class _RedactingStream:
+    def __init__(self, stream):
+        self._stream = stream
+
+    def write(self, text):
+        if isinstance(text, str):
+            self._stream.write(redact(text))
+            return len(text)
+        return self._stream.write(text)
+
+    def __getattr__(self, name):
+        return getattr(self._stream, name)
+
+_rd_sys.stdout = _RedactingStream(_rd_sys.stdout)
+_rd_sys.stderr = _RedactingStream(_rd_sys.stderr)
+
+`
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package region routes jobs to provider regions. The region comes from the
+// backend spec when it names one and otherwise from the job's placement
+// constraints, and it must always satisfy those constraints.
+package region
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// defaultRegions is used for regional backends when nothing selects a region
+var defaultRegions = map[string]string{
+	"ibm_quantum":   "us-east",
+	"ibm_simulator": "us-east",
+	"aws_braket":    "us-east-1",
+}
+
+// IBMRegions lists the regions IBM Quantum Platform serves Qiskit Runtime from
+var IBMRegions = []string{"us-east", "eu-de"}
+
+// Regional reports whether a backend type has regional endpoints. Jobs on
+// other backends run inside the cluster and are not routed.
+func Regional(backendType string) bool {
+	_, ok := defaultRegions[backendType]
+	return ok
+}
+
+// Requested returns the region fixed by the backend spec, if any: the region
+// field, or the region embedded in an IBM instance CRN or Braket device ARN
+func Requested(spec *quantumv1.BackendSpec) string {
+	if spec.Region != "" {
+		return spec.Region
+	}
+	switch {
+	case strings.HasPrefix(spec.Instance, "crn:"):
+		// crn:v1:<cloud>:<type>:quantum-computing:<region>:...
+		if parts := strings.Split(spec.Instance, ":"); len(parts) > 5 {
+			return parts[5]
+		}
+	case strings.HasPrefix(spec.DeviceARN, "arn:"):
+		// arn:aws:braket:<region>::device/...
+		if parts := strings.Split(spec.DeviceARN, ":"); len(parts) > 3 {
+			return parts[3]
+		}
+	}
+	return ""
+}
+
+// Route returns the region a job must execute in, or an error if its backend
+// cannot satisfy the placement constraints. Non-regional backends route to "".
+func Route(spec *quantumv1.BackendSpec, placement *quantumv1.PlacementSpec) (string, error) {
+	if !Regional(spec.Type) {
+		return "", nil
+	}
+
+	var allowed []string
+	if placement != nil {
+		allowed = placement.AllowedRegions
+	}
+
+	if requested := Requested(spec); requested != "" {
+		if len(allowed) > 0 && !slices.Contains(allowed, requested) {
+			return "", fmt.Errorf("backend region %q is not in allowed regions %s",
+				requested, strings.Join(allowed, ", "))
+		}
+		return requested, nil
+	}
+
+	if len(allowed) == 0 {
+		return defaultRegions[spec.Type], nil
+	}
+	for _, r := range allowed {
+		if Supported(spec.Type, r) {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("backend type %s is not available in any allowed region (%s)",
+		spec.Type, strings.Join(allowed, ", "))
+}
+
+// Supported reports whether a backend type can be served from a region
+func Supported(backendType, region string) bool {
+	switch backendType {
+	case "ibm_quantum", "ibm_simulator":
+		return slices.Contains(IBMRegions, region)
+	case "aws_braket":
+		// Braket device availability is per device; any region may host one
+		return region != ""
+	default:
+		return false
+	}
+}
+
+// Credentials returns the secret to authenticate with in a region: the
+// region's entry in RegionalSecretRefs if present, otherwise SecretRef
+func Credentials(creds *quantumv1.CredentialsSpec, region string) *quantumv1.SecretRef {
+	if creds == nil {
+		return nil
+	}
+	if ref, ok := creds.RegionalSecretRefs[region]; ok && region != "" {
+		return &ref
+	}
+	return creds.SecretRef
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package residency enforces per-namespace data residency policy on job
+// outputs. The policy is read from Namespace annotations and is checked both
+// at admission and immediately before results are exported.
+package residency
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Namespace annotations holding the residency policy. Both take a
+// comma-separated list; an absent annotation places no restriction.
+const (
+	// AllowedOutputTypesAnnotation lists the output types jobs may use (e.g., "s3,configmap")
+	AllowedOutputTypesAnnotation = "quantum.io/allowed-output-types"
+	// AllowedOutputLocationsAnnotation lists glob patterns output locations
+	// must match (e.g., "eu-*,results-eu")
+	AllowedOutputLocationsAnnotation = "quantum.io/allowed-output-locations"
+)
+
+// Policy restricts where a namespace's job results may be written
+type Policy struct {
+	Types     []string
+	Locations []string
+}
+
+// ParsePolicy reads a policy from namespace annotations. It returns nil when
+// the namespace sets no residency annotations.
+func ParsePolicy(annotations map[string]string) (*Policy, error) {
+	types := splitList(annotations[AllowedOutputTypesAnnotation])
+	locations := splitList(annotations[AllowedOutputLocationsAnnotation])
+	if types == nil && locations == nil {
+		return nil, nil
+	}
+	for _, pattern := range locations {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid %s pattern %q: %w", AllowedOutputLocationsAnnotation, pattern, err)
+		}
+	}
+	return &Policy{Types: types, Locations: locations}, nil
+}
+
+// PolicyForNamespace returns the policy configured on a namespace, or nil if
+// it has none
+func PolicyForNamespace(ctx context.Context, c client.Reader, namespace string) (*Policy, error) {
+	var ns corev1.Namespace
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ParsePolicy(ns.Annotations)
+}
+
+// Check returns an error describing why the output violates the policy
+func (p *Policy) Check(output *quantumv1.OutputSpec) error {
+	if p == nil || output == nil {
+		return nil
+	}
+	if len(p.Types) > 0 && !slices.Contains(p.Types, output.Type) {
+		return fmt.Errorf("output type %s is not allowed in this namespace (allowed: %s)",
+			output.Type, strings.Join(p.Types, ", "))
+	}
+	if len(p.Locations) > 0 && !p.locationAllowed(output.Location) {
+		return fmt.Errorf("output location %q does not match the namespace's allowed locations (%s)",
+			output.Location, strings.Join(p.Locations, ", "))
+	}
+	return nil
+}
+
+// CheckAll returns an error describing why the first of the outputs that
+// violates the policy does
+func (p *Policy) CheckAll(outputs []quantumv1.OutputSpec) error {
+	for i := range outputs {
+		if err := p.Check(&outputs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Policy) locationAllowed(location string) bool {
+	for _, pattern := range p.Locations {
+		if ok, _ := path.Match(pattern, location); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList splits a comma-separated annotation value, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
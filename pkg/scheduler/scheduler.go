@@ -0,0 +1,210 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scheduler scores the backends a job may run on by cost, queue
+// time, capability and availability, weighted as the job's backend
+// selection asks. Each part of a score lies between 0 and 1, 1 being best,
+// relative to the other candidates; parts a candidate has no data for score
+// 0.5.
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// unknown is the score of a part a candidate has no data for
+const unknown = 0.5
+
+// Weights are how much each part counts towards the total score. Negative
+// weights count as zero; without a positive weight every part counts the
+// same.
+type Weights struct {
+	Cost         float64
+	QueueTime    float64
+	Capability   float64
+	Availability float64
+}
+
+// Candidate is a backend a job may run on and what is known about it
+type Candidate struct {
+	// Name identifies the backend, e.g. the device
+	Name string
+
+	// Qubits the backend has; zero if unknown
+	Qubits int
+
+	// TwoQubitError is the median two-qubit gate error of the backend, if
+	// it reports calibration data
+	TwoQubitError *float64
+
+	// QueueWait is how long jobs are expected to wait for the backend
+	QueueWait *time.Duration
+
+	// Cost is the estimated price of the job on the backend in dollars
+	Cost float64
+
+	// Available reports whether the backend accepts jobs, if known
+	Available *bool
+}
+
+// Score is the breakdown of a candidate's score
+type Score struct {
+	Name         string
+	Cost         float64
+	QueueTime    float64
+	Capability   float64
+	Availability float64
+	Total        float64
+
+	// Ineligible explains why the candidate cannot run the job at all
+	Ineligible string
+}
+
+// Rank scores the candidates for a circuit on the given number of qubits
+// and returns the scores best first. Candidates with too few qubits are
+// ineligible and ranked last. Equal totals go to the earliest preferred
+// backend, then by name.
+func Rank(candidates []Candidate, qubits int, weights Weights, preferred []string) []Score {
+	var eligible []Candidate
+	var ineligible []Score
+	for _, c := range candidates {
+		if c.Qubits > 0 && qubits > c.Qubits {
+			ineligible = append(ineligible, Score{Name: c.Name,
+				Ineligible: fmt.Sprintf("has %d qubits, the circuit needs %d", c.Qubits, qubits)})
+			continue
+		}
+		eligible = append(eligible, c)
+	}
+
+	costs := spread(eligible, func(c Candidate) (float64, bool) { return c.Cost, true })
+	waits := spread(eligible, func(c Candidate) (float64, bool) {
+		if c.QueueWait == nil {
+			return 0, false
+		}
+		return c.QueueWait.Seconds(), true
+	})
+	gateErrors := spread(eligible, func(c Candidate) (float64, bool) {
+		if c.TwoQubitError == nil {
+			return 0, false
+		}
+		return *c.TwoQubitError, true
+	})
+	w := normalize(weights)
+
+	scores := make([]Score, 0, len(candidates))
+	for _, c := range eligible {
+		score := Score{
+			Name:         c.Name,
+			Cost:         costs.score(c.Cost),
+			Availability: unknown,
+		}
+		if c.QueueWait != nil {
+			score.QueueTime = waits.score(c.QueueWait.Seconds())
+		} else {
+			score.QueueTime = unknown
+		}
+		if c.TwoQubitError != nil {
+			score.Capability = gateErrors.score(*c.TwoQubitError)
+		} else {
+			score.Capability = unknown
+		}
+		if c.Available != nil {
+			score.Availability = 0
+			if *c.Available {
+				score.Availability = 1
+			}
+		}
+		score.Total = w.Cost*score.Cost + w.QueueTime*score.QueueTime +
+			w.Capability*score.Capability + w.Availability*score.Availability
+		scores = append(scores, score)
+	}
+
+	rank := func(name string) int {
+		if i := slices.Index(preferred, name); i >= 0 {
+			return i
+		}
+		return len(preferred)
+	}
+	slices.SortStableFunc(scores, func(a, b Score) int {
+		switch {
+		case a.Total > b.Total:
+			return -1
+		case a.Total < b.Total:
+			return 1
+		}
+		if r := rank(a.Name) - rank(b.Name); r != 0 {
+			return r
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return append(scores, ineligible...)
+}
+
+// normalize scales the weights to add up to 1
+func normalize(weights Weights) Weights {
+	parts := []*float64{&weights.Cost, &weights.QueueTime, &weights.Capability, &weights.Availability}
+	var sum float64
+	for _, p := range parts {
+		*p = max(*p, 0)
+		sum += *p
+	}
+	for _, p := range parts {
+		if sum == 0 {
+			*p = 1 / float64(len(parts))
+		} else {
+			*p /= sum
+		}
+	}
+	return weights
+}
+
+// valueRange is the lowest and highest value of a part over the candidates
+type valueRange struct {
+	min, max float64
+	known    bool
+}
+
+// spread returns the range of the values the candidates report
+func spread(candidates []Candidate, value func(Candidate) (float64, bool)) valueRange {
+	var r valueRange
+	for _, c := range candidates {
+		v, ok := value(c)
+		if !ok {
+			continue
+		}
+		if !r.known {
+			r = valueRange{min: v, max: v, known: true}
+			continue
+		}
+		r.min, r.max = min(r.min, v), max(r.max, v)
+	}
+	return r
+}
+
+// score places a value where lower is better within the range: the lowest
+// scores 1 and the highest 0
+func (r valueRange) score(v float64) float64 {
+	if !r.known {
+		return unknown
+	}
+	if r.max == r.min {
+		return 1
+	}
+	return (r.max - v) / (r.max - r.min)
+}
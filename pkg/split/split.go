@@ -0,0 +1,90 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package split divides the shots of QiskitJobs split across several
+// backends into the shares their executions run.
+package split
+
+import (
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// MaxBackends bounds the backends of a split job, its own included
+const MaxBackends = 8
+
+// Part is the share of the shots one backend of a split job runs
+type Part struct {
+	// Backend running the share
+	Backend quantumv1.BackendSpec
+	// Shots of the share
+	Shots int
+}
+
+// Parts returns the share of every backend of the split job out of its
+// shots, the job's backend first and then spec.split.backends in order
+func Parts(spec *quantumv1.QiskitJobSpec, shots int) []Part {
+	backends := []quantumv1.BackendSpec{spec.Backend}
+	weights := []int{weight(spec.Split.Weight)}
+	for _, backend := range spec.Split.Backends {
+		backends = append(backends, backend.Backend)
+		weights = append(weights, weight(backend.Weight))
+	}
+	shares := Shares(shots, weights)
+	parts := make([]Part, len(backends))
+	for i := range backends {
+		parts[i] = Part{Backend: backends[i], Shots: shares[i]}
+	}
+	return parts
+}
+
+// Shares divides total in proportion to weights by the largest remainder,
+// ties going to the earlier share, so that the shares add up to total
+func Shares(total int, weights []int) []int {
+	sum := 0
+	for _, w := range weights {
+		sum += w
+	}
+	shares := make([]int, len(weights))
+	if sum == 0 {
+		return shares
+	}
+	left := total
+	remainders := make([]int, len(weights))
+	for i, w := range weights {
+		shares[i] = total * w / sum
+		remainders[i] = total * w % sum
+		left -= shares[i]
+	}
+	for ; left > 0; left-- {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+		shares[largest]++
+		remainders[largest] = -1
+	}
+	return shares
+}
+
+// weight returns a backend's weight, 1 unless it has one
+func weight(w int) int {
+	if w <= 0 {
+		return 1
+	}
+	return w
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package split
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+var _ = Describe("Shot splitting", func() {
+	It("divides the shots in proportion to the weights", func() {
+		Expect(Shares(1000, []int{1, 1})).To(Equal([]int{500, 500}))
+		Expect(Shares(1000, []int{3, 1})).To(Equal([]int{750, 250}))
+		Expect(Shares(100, []int{1, 1, 1})).To(Equal([]int{34, 33, 33}))
+		Expect(Shares(10, []int{1, 2, 4})).To(Equal([]int{1, 3, 6}))
+	})
+
+	It("lists the job's backend first with its weight", func() {
+		spec := &quantumv1.QiskitJobSpec{
+			Backend: quantumv1.BackendSpec{Type: "local_simulator"},
+			Split: &quantumv1.SplitSpec{
+				Weight: 3,
+				Backends: []quantumv1.SplitBackend{
+					{Backend: quantumv1.BackendSpec{Type: "ibm_local_testing", Name: "fake_brisbane"}},
+				},
+			},
+		}
+		parts := Parts(spec, 1024)
+		Expect(parts).To(HaveLen(2))
+		Expect(parts[0].Backend.Type).To(Equal("local_simulator"))
+		Expect(parts[0].Shots).To(Equal(768))
+		Expect(parts[1].Backend.Name).To(Equal("fake_brisbane"))
+		Expect(parts[1].Shots).To(Equal(256))
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sweep expands the parameter sweeps of QiskitJobs into the
+// bindings their executions run: every set of parameter values combined
+// with every point of the grid the ranges span.
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// MaxBindings bounds the executions of a sweep, and with them the size of
+// its status and results
+const MaxBindings = 1000
+
+// DefaultParallelism is how many bindings execute at once unless the sweep
+// says otherwise
+const DefaultParallelism = 4
+
+// Binding assigns values to circuit parameters by name
+type Binding map[string]float64
+
+// Count returns the number of bindings of the sweep
+func Count(spec *quantumv1.SweepSpec) (int, error) {
+	if len(spec.Parameters) == 0 && len(spec.Ranges) == 0 {
+		return 0, errors.New("a sweep needs parameters or ranges")
+	}
+	count := max(len(spec.Parameters), 1)
+	seen := map[string]bool{}
+	for _, r := range spec.Ranges {
+		if r.Name == "" {
+			return 0, errors.New("swept ranges need a parameter name")
+		}
+		if seen[r.Name] {
+			return 0, fmt.Errorf("parameter %s is swept by more than one range", r.Name)
+		}
+		seen[r.Name] = true
+		for _, set := range spec.Parameters {
+			if _, ok := set[r.Name]; ok {
+				return 0, fmt.Errorf("parameter %s is both set and swept by a range", r.Name)
+			}
+		}
+		if r.Steps < 1 {
+			return 0, fmt.Errorf("range of parameter %s needs at least 1 step", r.Name)
+		}
+		// Checked before multiplying so the count cannot overflow
+		if r.Steps > MaxBindings || count*r.Steps > MaxBindings {
+			return 0, fmt.Errorf("a sweep may have at most %d bindings", MaxBindings)
+		}
+		count *= r.Steps
+	}
+	if count > MaxBindings {
+		return 0, fmt.Errorf("a sweep may have at most %d bindings", MaxBindings)
+	}
+	return count, nil
+}
+
+// Bindings returns the bindings of the sweep in order: the sets of
+// parameters in turn, each with the points of the ranges, the last range
+// varying fastest
+func Bindings(spec *quantumv1.SweepSpec) ([]Binding, error) {
+	count, err := Count(spec)
+	if err != nil {
+		return nil, err
+	}
+	sets := spec.Parameters
+	if len(sets) == 0 {
+		sets = []map[string]float64{nil}
+	}
+
+	bindings := make([]Binding, 0, count)
+	for _, set := range sets {
+		points := []Binding{{}}
+		maps.Copy(points[0], set)
+		for _, r := range spec.Ranges {
+			next := make([]Binding, 0, len(points)*r.Steps)
+			for _, point := range points {
+				for k := 0; k < r.Steps; k++ {
+					binding := maps.Clone(point)
+					binding[r.Name] = Value(r, k)
+					next = append(next, binding)
+				}
+			}
+			points = next
+		}
+		bindings = append(bindings, points...)
+	}
+	return bindings, nil
+}
+
+// Value returns the k-th of the evenly spaced values of the range
+func Value(r quantumv1.SweepRange, k int) float64 {
+	if r.Steps <= 1 {
+		return r.Start
+	}
+	return r.Start + (r.Stop-r.Start)*float64(k)/float64(r.Steps-1)
+}
+
+// Parallelism returns how many bindings of the sweep execute at once
+func Parallelism(spec *quantumv1.SweepSpec) int {
+	if spec.Parallelism <= 0 {
+		return DefaultParallelism
+	}
+	return spec.Parallelism
+}
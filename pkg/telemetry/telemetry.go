@@ -0,0 +1,177 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package telemetry reports how an operator is used to an endpoint chosen by
+// the platform owners who run it, so they can aggregate usage across the
+// clusters they manage. Nothing is reported unless an endpoint is
+// configured. Reports carry counts only: no names, namespaces, circuits,
+// messages or credentials, and the cluster is identified by a hash of its
+// ID rather than the ID itself.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// SchemaVersion is the version of the report format, raised on changes that
+// receivers must tell apart
+const SchemaVersion = 1
+
+// DefaultInterval is how often usage is reported unless configured otherwise
+const DefaultInterval = 24 * time.Hour
+
+// defaultTimeout bounds each report
+const defaultTimeout = 30 * time.Second
+
+// Usage is what the jobs of a cluster add up to
+type Usage struct {
+	// Jobs counts jobs by backend type, then by phase
+	Jobs map[string]map[string]int `json:"jobs"`
+
+	// Failures counts failed jobs by failure class
+	Failures map[string]int `json:"failures"`
+
+	// Namespaces is the number of namespaces with jobs
+	Namespaces int `json:"namespaces"`
+}
+
+// Report is the body posted to the endpoint
+type Report struct {
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Cluster identifies the cluster by a hash of its ID
+	Cluster string `json:"cluster,omitempty"`
+
+	// OperatorVersion is the version of the reporting operator
+	OperatorVersion string `json:"operatorVersion"`
+
+	// Time is when the report was made
+	Time time.Time `json:"time"`
+
+	Usage
+}
+
+// UsageFunc returns the current usage of the cluster
+type UsageFunc func(ctx context.Context) (*Usage, error)
+
+// Reporter periodically posts a Report of the cluster's usage to Endpoint
+// as JSON
+type Reporter struct {
+	// Endpoint reports are posted to
+	Endpoint string
+
+	// Interval is how often to report
+	Interval time.Duration
+
+	// Version of the operator
+	Version string
+
+	// ClusterID identifies the cluster; only its hash is reported
+	ClusterID string
+
+	// Usage collects what is reported
+	Usage UsageFunc
+
+	// Client sends the reports, a client with a 30s timeout if nil
+	Client *http.Client
+}
+
+var _ manager.LeaderElectionRunnable = &Reporter{}
+
+// NeedLeaderElection makes only the elected leader report, so a cluster
+// reports once per interval
+func (r *Reporter) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable. A report that fails is logged and
+// dropped; the next one carries the usage at that time.
+func (r *Reporter) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("telemetry")
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Send(ctx); err != nil {
+			logger.Error(err, "Failed to report usage", "endpoint", r.Endpoint)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Send posts a report of the current usage once
+func (r *Reporter) Send(ctx context.Context) error {
+	usage, err := r.Usage(ctx)
+	if err != nil {
+		return err
+	}
+	report := Report{
+		SchemaVersion:   SchemaVersion,
+		Cluster:         AnonymousID(r.ClusterID),
+		OperatorVersion: r.Version,
+		Time:            time.Now().UTC(),
+		Usage:           *usage,
+	}
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := r.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("telemetry endpoint returned %s", resp.Status)
+	}
+	return nil
+}
+
+// AnonymousID returns the hash clusters are reported by: stable for a
+// cluster, so its reports can be told apart from other clusters', but not
+// revealing its ID. Clusters of unknown ID are reported without one.
+func AnonymousID(clusterID string) string {
+	if clusterID == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte("qiskit-operator-telemetry:" + clusterID))
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tomography expands the state tomography of QiskitJobs into the
+// measurement settings their executions run, and reconstructs the density
+// matrix of the tomographed qubits from the counts of every setting.
+//
+// Bases and Pauli strings are written like Qiskit labels: the last letter
+// is that of the first tomographed qubit, whose bit is the last of each
+// outcome, and its index is the least significant in the density matrix.
+package tomography
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// MaxQubits bounds the qubits of a tomography, which needs 3^n settings
+const MaxQubits = 4
+
+// DefaultParallelism is how many settings execute at once unless the
+// tomography says otherwise
+const DefaultParallelism = 4
+
+// bases are the measurement bases of each qubit
+const bases = "XYZ"
+
+// Settings returns the measurement settings of n qubits in order, every
+// combination of bases with the last letter varying fastest
+func Settings(n int) []string {
+	settings := []string{""}
+	for range n {
+		next := make([]string, 0, len(settings)*len(bases))
+		for _, setting := range settings {
+			for _, basis := range bases {
+				next = append(next, setting+string(basis))
+			}
+		}
+		settings = next
+	}
+	return settings
+}
+
+// Parallelism returns how many settings of the tomography execute at once
+func Parallelism(spec *quantumv1.TomographySpec) int {
+	if spec.Parallelism <= 0 {
+		return DefaultParallelism
+	}
+	return spec.Parallelism
+}
+
+// Matrix is a complex matrix split into its real and imaginary parts
+type Matrix struct {
+	Real [][]float64 `json:"real"`
+	Imag [][]float64 `json:"imag"`
+}
+
+// State is the reconstructed state of the tomographed qubits
+type State struct {
+	// Expectations are the expectation values of every Pauli string other
+	// than the identity
+	Expectations map[string]float64 `json:"expectations"`
+	// DensityMatrix is the linear inversion estimate of the state. It has
+	// unit trace but, with finite shots, may have small negative eigenvalues.
+	DensityMatrix Matrix `json:"densityMatrix"`
+	// Purity is Tr(rho^2)
+	Purity float64 `json:"purity"`
+}
+
+// Reconstruct estimates the state of n qubits from the counts each setting
+// measured. The expectation value of a Pauli string pools the counts of
+// every setting measuring its qubits in its bases.
+func Reconstruct(n int, counts map[string]map[string]int) (*State, error) {
+	if n < 1 || n > MaxQubits {
+		return nil, fmt.Errorf("tomography needs between 1 and %d qubits", MaxQubits)
+	}
+	for _, setting := range Settings(n) {
+		if len(counts[setting]) == 0 {
+			return nil, fmt.Errorf("setting %s reported no counts", setting)
+		}
+		for outcome := range counts[setting] {
+			if len(strings.ReplaceAll(outcome, " ", "")) != n {
+				return nil, fmt.Errorf("setting %s reported outcome %q, not one of %d bits", setting, outcome, n)
+			}
+		}
+	}
+
+	dim := 1 << n
+	state := &State{Expectations: map[string]float64{}}
+	rho := make([][]complex128, dim)
+	for r := range rho {
+		rho[r] = make([]complex128, dim)
+	}
+	purity := 0.0
+	for _, pauli := range paulis(n) {
+		value := 1.0
+		if strings.Trim(pauli, "I") != "" {
+			var err error
+			if value, err = expectation(pauli, counts); err != nil {
+				return nil, err
+			}
+			state.Expectations[pauli] = value
+		}
+		purity += value * value
+		for r := range dim {
+			for c := range dim {
+				rho[r][c] += complex(value, 0) * element(pauli, r, c)
+			}
+		}
+	}
+
+	scale := complex(1/float64(dim), 0)
+	state.DensityMatrix = Matrix{Real: make([][]float64, dim), Imag: make([][]float64, dim)}
+	for r := range dim {
+		state.DensityMatrix.Real[r] = make([]float64, dim)
+		state.DensityMatrix.Imag[r] = make([]float64, dim)
+		for c := range dim {
+			v := rho[r][c] * scale
+			state.DensityMatrix.Real[r][c] = real(v)
+			state.DensityMatrix.Imag[r][c] = imag(v)
+		}
+	}
+	state.Purity = purity / float64(dim)
+	return state, nil
+}
+
+// paulis returns every Pauli string of n qubits
+func paulis(n int) []string {
+	strs := []string{""}
+	for range n {
+		next := make([]string, 0, len(strs)*4)
+		for _, s := range strs {
+			for _, p := range "IXYZ" {
+				next = append(next, s+string(p))
+			}
+		}
+		strs = next
+	}
+	return strs
+}
+
+// expectation returns the expectation value of the Pauli string from the
+// counts of the settings measuring it: the mean parity of the bits of its
+// qubits other than those it acts on as the identity
+func expectation(pauli string, counts map[string]map[string]int) (float64, error) {
+	sum, shots := 0, 0
+	for setting, measured := range counts {
+		if !measures(setting, pauli) {
+			continue
+		}
+		for outcome, count := range measured {
+			bits := strings.ReplaceAll(outcome, " ", "")
+			parity := 0
+			for i, p := range pauli {
+				if p != 'I' && bits[i] == '1' {
+					parity ^= 1
+				}
+			}
+			sum += count * (1 - 2*parity)
+			shots += count
+		}
+	}
+	if shots == 0 {
+		return 0, errors.New("no setting measured " + pauli)
+	}
+	return float64(sum) / float64(shots), nil
+}
+
+// measures reports whether the setting measures the qubits of the Pauli
+// string in its bases
+func measures(setting, pauli string) bool {
+	if len(setting) != len(pauli) {
+		return false
+	}
+	for i := range pauli {
+		if pauli[i] != 'I' && pauli[i] != setting[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// element returns the element in row r and column c of the tensor product of
+// the Pauli string's matrices
+func element(pauli string, r, c int) complex128 {
+	v := complex(1, 0)
+	n := len(pauli)
+	for i := range n {
+		// The last letter acts on the least significant bit
+		rb, cb := (r>>(n-1-i))&1, (c>>(n-1-i))&1
+		switch pauli[i] {
+		case 'I':
+			if rb != cb {
+				return 0
+			}
+		case 'X':
+			if rb == cb {
+				return 0
+			}
+		case 'Y':
+			if rb == cb {
+				return 0
+			}
+			// <0|Y|1> = -i, <1|Y|0> = i
+			if rb == 0 {
+				v *= complex(0, -1)
+			} else {
+				v *= complex(0, 1)
+			}
+		case 'Z':
+			if rb != cb {
+				return 0
+			}
+			if rb == 1 {
+				v = -v
+			}
+		}
+	}
+	return v
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tomography
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State tomography", func() {
+	// uniform are the counts of a basis the state is random in
+	uniform := func(n int) map[string]int {
+		counts := map[string]int{}
+		for i := range 1 << n {
+			bits := ""
+			for b := n - 1; b >= 0; b-- {
+				bits += string("01"[(i>>b)&1])
+			}
+			counts[bits] = 25
+		}
+		return counts
+	}
+
+	It("lists every combination of bases", func() {
+		Expect(Settings(1)).To(Equal([]string{"X", "Y", "Z"}))
+		settings := Settings(2)
+		Expect(settings).To(HaveLen(9))
+		Expect(settings[:4]).To(Equal([]string{"XX", "XY", "XZ", "YX"}))
+		Expect(Settings(4)).To(HaveLen(81))
+	})
+
+	It("reconstructs a single qubit state with its phase", func() {
+		state, err := Reconstruct(1, map[string]map[string]int{
+			"X": {"0": 50, "1": 50},
+			"Y": {"0": 100},
+			"Z": {"0": 50, "1": 50},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Expectations).To(Equal(map[string]float64{"X": 0, "Y": 1, "Z": 0}))
+		// |+i><+i| = [[1, -i], [i, 1]] / 2
+		Expect(state.DensityMatrix.Real).To(Equal([][]float64{{0.5, 0}, {0, 0.5}}))
+		Expect(state.DensityMatrix.Imag).To(Equal([][]float64{{0, -0.5}, {0.5, 0}}))
+		Expect(state.Purity).To(Equal(1.0))
+	})
+
+	It("reconstructs a Bell state from the counts of every setting", func() {
+		counts := map[string]map[string]int{}
+		for _, setting := range Settings(2) {
+			counts[setting] = uniform(2)
+		}
+		counts["XX"] = map[string]int{"00": 50, "11": 50}
+		counts["YY"] = map[string]int{"01": 50, "10": 50}
+		counts["ZZ"] = map[string]int{"00": 50, "11": 50}
+
+		state, err := Reconstruct(2, counts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Expectations).To(HaveLen(15))
+		Expect(state.Expectations).To(HaveKeyWithValue("XX", 1.0))
+		Expect(state.Expectations).To(HaveKeyWithValue("YY", -1.0))
+		Expect(state.Expectations).To(HaveKeyWithValue("IZ", 0.0))
+		Expect(state.DensityMatrix.Real).To(Equal([][]float64{
+			{0.5, 0, 0, 0.5},
+			{0, 0, 0, 0},
+			{0, 0, 0, 0},
+			{0.5, 0, 0, 0.5},
+		}))
+		Expect(state.Purity).To(Equal(1.0))
+	})
+
+	It("reads the first qubit from the last bit", func() {
+		// |01>: the first qubit is 1, the second 0
+		counts := map[string]map[string]int{}
+		for _, setting := range Settings(2) {
+			counts[setting] = uniform(2)
+		}
+		counts["ZZ"] = map[string]int{"01": 100}
+		counts["XZ"] = map[string]int{"01": 50, "11": 50}
+		counts["YZ"] = map[string]int{"01": 50, "11": 50}
+		counts["ZX"] = map[string]int{"00": 50, "01": 50}
+		counts["ZY"] = map[string]int{"00": 50, "01": 50}
+
+		state, err := Reconstruct(2, counts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Expectations).To(HaveKeyWithValue("IZ", -1.0))
+		Expect(state.Expectations).To(HaveKeyWithValue("ZI", 1.0))
+		Expect(state.DensityMatrix.Real[1][1]).To(Equal(1.0))
+	})
+
+	It("needs the counts of every setting", func() {
+		_, err := Reconstruct(1, map[string]map[string]int{"X": {"0": 1}, "Z": {"0": 1}})
+		Expect(err).To(MatchError("setting Y reported no counts"))
+
+		_, err = Reconstruct(1, map[string]map[string]int{"X": {"0": 1}, "Y": {"0": 1}, "Z": {"00": 1}})
+		Expect(err).To(MatchError(ContainSubstring("not one of 1 bits")))
+
+		_, err = Reconstruct(5, nil)
+		Expect(err).To(MatchError(ContainSubstring("between 1 and 4 qubits")))
+	})
+})
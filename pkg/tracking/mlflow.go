@@ -0,0 +1,248 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// maxResponseBytes bounds the size of a response body read into memory
+const maxResponseBytes = 1 << 20
+
+// Tags MLflow runs carry besides the run's own
+const (
+	// ResultsURITag links a run to the job's results
+	ResultsURITag = "qiskit.results_uri"
+	// LabelTagPrefix prefixes a tag set for each run label
+	LabelTagPrefix = "qiskit.tag."
+)
+
+// MLflow logs runs through the REST API of an MLflow tracking server
+type MLflow struct {
+	// URI of the tracking server, e.g. "http://mlflow.mlops:5000"
+	URI string
+	// Experiment runs are logged to; created if it does not exist
+	Experiment string
+	// Token is sent as a bearer token; Username and Password as basic
+	// authentication when no token is set
+	Token    string
+	Username string
+	Password string
+	Client   *http.Client
+}
+
+var _ Tracker = &MLflow{}
+
+type mlflowKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type mlflowMetric struct {
+	Key       string  `json:"key"`
+	Value     float64 `json:"value"`
+	Timestamp int64   `json:"timestamp"`
+	Step      int64   `json:"step"`
+}
+
+// LogRun creates the run, or finds the one logged for the job before, and
+// records its parameters, metrics and final status
+func (m *MLflow) LogRun(ctx context.Context, run Run) (string, error) {
+	experimentID, err := m.experimentID(ctx)
+	if err != nil {
+		return "", err
+	}
+	runID, err := m.findRun(ctx, experimentID, run.ID)
+	if err != nil {
+		return "", err
+	}
+
+	tags := keyValues(run.Tags)
+	for _, label := range run.Labels {
+		tags = append(tags, mlflowKeyValue{Key: LabelTagPrefix + label, Value: "true"})
+	}
+	if run.ArtifactURI != "" {
+		tags = append(tags, mlflowKeyValue{Key: ResultsURITag, Value: run.ArtifactURI})
+	}
+	if runID == "" {
+		var created struct {
+			Run struct {
+				Info struct {
+					RunID string `json:"run_id"`
+				} `json:"info"`
+			} `json:"run"`
+		}
+		if err := m.call(ctx, http.MethodPost, "runs/create", map[string]any{
+			"experiment_id": experimentID,
+			"run_name":      run.Name,
+			"start_time":    run.Start.UnixMilli(),
+			"tags":          tags,
+		}, &created); err != nil {
+			return "", err
+		}
+		runID = created.Run.Info.RunID
+	}
+
+	metrics := make([]mlflowMetric, 0, len(run.Metrics))
+	for _, key := range sortedKeys(run.Metrics) {
+		metrics = append(metrics, mlflowMetric{Key: key, Value: run.Metrics[key], Timestamp: run.End.UnixMilli()})
+	}
+	if err := m.call(ctx, http.MethodPost, "runs/log-batch", map[string]any{
+		"run_id":  runID,
+		"params":  keyValues(run.Params),
+		"metrics": metrics,
+		"tags":    tags,
+	}, nil); err != nil {
+		return "", err
+	}
+	if err := m.call(ctx, http.MethodPost, "runs/update", map[string]any{
+		"run_id":   runID,
+		"status":   run.Status,
+		"end_time": run.End.UnixMilli(),
+	}, nil); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/#/experiments/%s/runs/%s", m.URI, experimentID, runID), nil
+}
+
+// experimentID looks up the experiment by name, creating it if needed
+func (m *MLflow) experimentID(ctx context.Context) (string, error) {
+	var found struct {
+		Experiment struct {
+			ExperimentID string `json:"experiment_id"`
+		} `json:"experiment"`
+	}
+	err := m.call(ctx, http.MethodGet,
+		"experiments/get-by-name?experiment_name="+url.QueryEscape(m.Experiment), nil, &found)
+	if err == nil {
+		return found.Experiment.ExperimentID, nil
+	}
+	var apiErr *mlflowError
+	if !errors.As(err, &apiErr) || apiErr.Code != "RESOURCE_DOES_NOT_EXIST" {
+		return "", err
+	}
+
+	var created struct {
+		ExperimentID string `json:"experiment_id"`
+	}
+	if err := m.call(ctx, http.MethodPost, "experiments/create", map[string]any{"name": m.Experiment}, &created); err != nil {
+		return "", err
+	}
+	return created.ExperimentID, nil
+}
+
+// findRun returns the ID of the run logged for a job before, so retried
+// logging does not create duplicates
+func (m *MLflow) findRun(ctx context.Context, experimentID, id string) (string, error) {
+	var found struct {
+		Runs []struct {
+			Info struct {
+				RunID string `json:"run_id"`
+			} `json:"info"`
+		} `json:"runs"`
+	}
+	if err := m.call(ctx, http.MethodPost, "runs/search", map[string]any{
+		"experiment_ids": []string{experimentID},
+		"filter":         fmt.Sprintf("tags.`k8s.uid` = '%s'", id),
+		"max_results":    1,
+	}, &found); err != nil {
+		return "", err
+	}
+	if len(found.Runs) == 0 {
+		return "", nil
+	}
+	return found.Runs[0].Info.RunID, nil
+}
+
+// mlflowError is an error response of the MLflow REST API
+type mlflowError struct {
+	Status  int
+	Code    string `json:"error_code"`
+	Message string `json:"message"`
+}
+
+func (e *mlflowError) Error() string {
+	return fmt.Sprintf("mlflow: %s (HTTP %d): %s", e.Code, e.Status, e.Message)
+}
+
+func (m *MLflow) call(ctx context.Context, method, endpoint string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, m.URI+"/api/2.0/mlflow/"+endpoint, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	switch {
+	case m.Token != "":
+		req.Header.Set("Authorization", "Bearer "+m.Token)
+	case m.Username != "":
+		req.SetBasicAuth(m.Username, m.Password)
+	}
+
+	resp, err := m.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		apiErr := &mlflowError{Status: resp.StatusCode}
+		if json.Unmarshal(data, apiErr) != nil || apiErr.Code == "" {
+			apiErr.Code = http.StatusText(resp.StatusCode)
+		}
+		return apiErr
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+func keyValues(values map[string]string) []mlflowKeyValue {
+	kvs := make([]mlflowKeyValue, 0, len(values))
+	for _, key := range sortedKeys(values) {
+		kvs = append(kvs, mlflowKeyValue{Key: key, Value: values[key]})
+	}
+	return kvs
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
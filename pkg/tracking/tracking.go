@@ -0,0 +1,226 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracking logs finished QiskitJobs as runs of an external experiment
+// tracker (MLflow or Weights & Biases), so quantum runs appear alongside the
+// classical experiments teams already track there.
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// DefaultExperiment is the MLflow experiment runs are logged to unless
+// configured otherwise
+const DefaultExperiment = "qiskit-operator"
+
+// requestTimeout bounds each request to a tracker
+const requestTimeout = 30 * time.Second
+
+// Run statuses
+const (
+	StatusFinished = "FINISHED"
+	StatusFailed   = "FAILED"
+)
+
+// Run is a finished job as logged to a tracker
+type Run struct {
+	// ID identifies the run; logging the same ID again updates the run
+	ID   string
+	Name string
+	// Status is StatusFinished or StatusFailed
+	Status  string
+	Start   time.Time
+	End     time.Time
+	Params  map[string]string
+	Metrics map[string]float64
+	Tags    map[string]string
+	// Labels are free-form tags, e.g. the job's provider job tags
+	Labels []string
+	// ArtifactURI links to the job's results
+	ArtifactURI string
+}
+
+// Tracker logs runs to an experiment tracker
+type Tracker interface {
+	// LogRun records the run and returns a link to it
+	LogRun(ctx context.Context, run Run) (string, error)
+}
+
+// FromURI returns the tracker for a tracking URI: "wandb://<entity>/<project>"
+// logs to Weights & Biases, an http(s) URI to the MLflow tracking server at
+// that address. Credentials are read from the environment variables the
+// tools' own clients use: MLFLOW_TRACKING_TOKEN (or MLFLOW_TRACKING_USERNAME
+// and MLFLOW_TRACKING_PASSWORD), and WANDB_API_KEY with an optional
+// WANDB_BASE_URL.
+func FromURI(uri, experiment string) (Tracker, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracking URI: %w", err)
+	}
+	client := &http.Client{Timeout: requestTimeout}
+
+	switch u.Scheme {
+	case "wandb":
+		project := strings.Trim(u.Path, "/")
+		if u.Host == "" || project == "" || strings.Contains(project, "/") {
+			return nil, fmt.Errorf("tracking URI %q must have the form wandb://<entity>/<project>", uri)
+		}
+		apiKey := os.Getenv("WANDB_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("WANDB_API_KEY must be set to log runs to Weights & Biases")
+		}
+		return &WandB{
+			BaseURL: os.Getenv("WANDB_BASE_URL"),
+			APIKey:  apiKey,
+			Entity:  u.Host,
+			Project: project,
+			Client:  client,
+		}, nil
+	case "http", "https":
+		if experiment == "" {
+			experiment = DefaultExperiment
+		}
+		return &MLflow{
+			URI:        strings.TrimSuffix(uri, "/"),
+			Experiment: experiment,
+			Token:      os.Getenv("MLFLOW_TRACKING_TOKEN"),
+			Username:   os.Getenv("MLFLOW_TRACKING_USERNAME"),
+			Password:   os.Getenv("MLFLOW_TRACKING_PASSWORD"),
+			Client:     client,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tracking URI scheme %q (use http, https or wandb)", u.Scheme)
+	}
+}
+
+// RunFromJob describes a finished job as a run: its execution settings as
+// parameters, its timings and cost as metrics, and its identity as tags
+func RunFromJob(job *quantumv1.QiskitJob) Run {
+	run := Run{
+		ID:     string(job.UID),
+		Name:   job.Namespace + "/" + job.Name,
+		Status: StatusFinished,
+		Params: map[string]string{
+			"backend.type":       job.Spec.Backend.Type,
+			"backend.name":       job.Spec.Backend.Name,
+			"circuit.source":     job.Spec.Circuit.Source,
+			"execution.shots":    strconv.Itoa(effectiveShots(job)),
+			"optimization_level": strconv.Itoa(job.Spec.Execution.OptimizationLevel),
+			"resilience_level":   strconv.Itoa(defaults.ResilienceLevel(&job.Spec.Execution)),
+		},
+		Metrics: map[string]float64{
+			"retries": float64(job.Status.RetryCount),
+		},
+		Tags: map[string]string{
+			"k8s.namespace": job.Namespace,
+			"k8s.name":      job.Name,
+			"k8s.uid":       string(job.UID),
+		},
+		ArtifactURI: artifactURI(job),
+	}
+	if job.Status.Phase == "Failed" {
+		run.Status = StatusFailed
+	}
+	if job.Status.StartTime != nil {
+		run.Start = job.Status.StartTime.Time
+	} else {
+		run.Start = job.CreationTimestamp.Time
+	}
+	if job.Status.CompletionTime != nil {
+		run.End = job.Status.CompletionTime.Time
+	} else {
+		run.End = time.Now()
+	}
+
+	if v := job.Spec.Execution.QiskitVersion; v != "" {
+		run.Params["qiskit_version"] = v
+	}
+	if b := job.Status.SelectedBackend; b != "" {
+		run.Params["backend.selected"] = b
+	}
+	if job.Spec.Circuit.Entrypoint != "" {
+		run.Params["circuit.entrypoint"] = job.Spec.Circuit.Entrypoint
+	}
+	if len(job.Spec.Circuit.Args) > 0 {
+		run.Params["circuit.args"] = strings.Join(job.Spec.Circuit.Args, " ")
+	}
+	run.Labels = append(run.Labels, job.Spec.Execution.Tags...)
+
+	if m := job.Status.CircuitMetadata; m != nil {
+		run.Params["circuit.hash"] = m.Hash
+		run.Metrics["circuit.qubits"] = float64(m.Qubits)
+		run.Metrics["circuit.depth"] = float64(m.Depth)
+	}
+	if m := job.Status.Metrics; m != nil {
+		addSeconds(run.Metrics, "queue_time_seconds", m.QueueTime)
+		addSeconds(run.Metrics, "execution_time_seconds", m.ExecutionTime)
+		addSeconds(run.Metrics, "total_time_seconds", m.TotalTime)
+	}
+	if cost, err := strconv.ParseFloat(strings.TrimPrefix(job.Status.ActualCost, "$"), 64); err == nil {
+		run.Metrics["cost_usd"] = cost
+	}
+	if r := job.Status.Results; r != nil && r.SuccessRate > 0 {
+		run.Metrics["success_rate"] = r.SuccessRate
+	}
+	return run
+}
+
+// artifactURI links to where the job's results are stored
+func artifactURI(job *quantumv1.QiskitJob) string {
+	if r := job.Status.Results; r != nil && r.Location != "" {
+		return r.Location
+	}
+	if len(job.Spec.Outputs) == 0 || job.Spec.Outputs[0].Location == "" {
+		return ""
+	}
+	output := job.Spec.Outputs[0]
+	switch output.Type {
+	case "s3":
+		return "s3://" + output.Location
+	case "gcs":
+		return "gs://" + output.Location
+	case "oci":
+		return "oci://" + output.Location
+	case "azure_blob":
+		return "azure://" + output.Location
+	default:
+		return fmt.Sprintf("%s://%s/%s", output.Type, job.Namespace, output.Location)
+	}
+}
+
+func effectiveShots(job *quantumv1.QiskitJob) int {
+	if job.Spec.Execution.Shots > 0 {
+		return job.Spec.Execution.Shots
+	}
+	return defaults.Shots
+}
+
+func addSeconds(metrics map[string]float64, key, duration string) {
+	if d, err := time.ParseDuration(duration); err == nil {
+		metrics[key] = d.Seconds()
+	}
+}
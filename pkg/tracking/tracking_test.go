@@ -0,0 +1,204 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("Experiment tracking", func() {
+	var (
+		ctx context.Context
+		job *quantumv1.QiskitJob
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		job = builder.NewBellStateJob("bell", "research").
+			WithBackend("ibm_quantum", "ibm_torino").
+			WithShots(2048).
+			WithTags("sweep-7").
+			WithOutput("s3", "results/bell").
+			Build()
+		job.UID = types.UID("0b6f2a9e-bell")
+		job.Status.Phase = "Completed"
+		job.Status.StartTime = &metav1.Time{Time: start}
+		job.Status.CompletionTime = &metav1.Time{Time: start.Add(90 * time.Second)}
+		job.Status.ActualCost = "$1.60"
+		job.Status.Metrics = &quantumv1.ExecutionMetrics{QueueTime: "30s", TotalTime: "1m30s"}
+	})
+
+	Describe("RunFromJob", func() {
+		It("should describe the job's settings, timings and results", func() {
+			run := RunFromJob(job)
+			Expect(run.ID).To(Equal("0b6f2a9e-bell"))
+			Expect(run.Name).To(Equal("research/bell"))
+			Expect(run.Status).To(Equal(StatusFinished))
+			Expect(run.Params).To(HaveKeyWithValue("backend.name", "ibm_torino"))
+			Expect(run.Params).To(HaveKeyWithValue("execution.shots", "2048"))
+			Expect(run.Metrics).To(HaveKeyWithValue("cost_usd", 1.6))
+			Expect(run.Metrics).To(HaveKeyWithValue("queue_time_seconds", 30.0))
+			Expect(run.Metrics).To(HaveKeyWithValue("total_time_seconds", 90.0))
+			Expect(run.Labels).To(ConsistOf("sweep-7"))
+			Expect(run.ArtifactURI).To(Equal("s3://results/bell"))
+		})
+
+		It("should mark failed jobs as failed runs", func() {
+			job.Status.Phase = "Failed"
+			Expect(RunFromJob(job).Status).To(Equal(StatusFailed))
+		})
+	})
+
+	Describe("MLflow", func() {
+		var (
+			server   *httptest.Server
+			calls    []string
+			existing bool
+		)
+
+		BeforeEach(func() {
+			calls = nil
+			existing = false
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+				calls = append(calls, r.URL.Path)
+				switch r.URL.Path {
+				case "/api/2.0/mlflow/experiments/get-by-name":
+					Expect(r.URL.Query().Get("experiment_name")).To(Equal("quantum"))
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"error_code":"RESOURCE_DOES_NOT_EXIST","message":"not found"}`))
+				case "/api/2.0/mlflow/experiments/create":
+					_, _ = w.Write([]byte(`{"experiment_id":"7"}`))
+				case "/api/2.0/mlflow/runs/search":
+					if existing {
+						_, _ = w.Write([]byte(`{"runs":[{"info":{"run_id":"r1"}}]}`))
+					} else {
+						_, _ = w.Write([]byte(`{}`))
+					}
+				case "/api/2.0/mlflow/runs/create":
+					_, _ = w.Write([]byte(`{"run":{"info":{"run_id":"r1"}}}`))
+				case "/api/2.0/mlflow/runs/log-batch":
+					var body map[string]any
+					Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+					Expect(body["run_id"]).To(Equal("r1"))
+					Expect(body["tags"]).To(ContainElement(HaveKeyWithValue("key", ResultsURITag)))
+					_, _ = w.Write([]byte(`{}`))
+				case "/api/2.0/mlflow/runs/update":
+					var body map[string]any
+					Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+					Expect(body["status"]).To(Equal(StatusFinished))
+					_, _ = w.Write([]byte(`{}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		tracker := func() *MLflow {
+			return &MLflow{URI: server.URL, Experiment: "quantum", Token: "token", Client: server.Client()}
+		}
+
+		It("should create the experiment and run and log the job", func() {
+			link, err := tracker().LogRun(ctx, RunFromJob(job))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link).To(Equal(server.URL + "/#/experiments/7/runs/r1"))
+			Expect(calls).To(ContainElements("/api/2.0/mlflow/experiments/create", "/api/2.0/mlflow/runs/create"))
+		})
+
+		It("should update the run logged for the job before", func() {
+			existing = true
+			_, err := tracker().LogRun(ctx, RunFromJob(job))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).NotTo(ContainElement("/api/2.0/mlflow/runs/create"))
+		})
+	})
+
+	Describe("Weights & Biases", func() {
+		It("should upsert the run under the job's UID", func() {
+			var variables map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				user, key, ok := r.BasicAuth()
+				Expect(ok).To(BeTrue())
+				Expect(user).To(Equal("api"))
+				Expect(key).To(Equal("secret"))
+				Expect(r.URL.Path).To(Equal("/graphql"))
+
+				var body struct {
+					Variables map[string]any `json:"variables"`
+				}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				variables = body.Variables
+				_, _ = w.Write([]byte(`{"data":{"upsertBucket":{"bucket":{"name":"0b6f2a9e-bell"}}}}`))
+			}))
+			defer server.Close()
+
+			tracker := &WandB{BaseURL: server.URL, APIKey: "secret", Entity: "lab", Project: "vqe", Client: server.Client()}
+			link, err := tracker.LogRun(ctx, RunFromJob(job))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link).To(Equal(server.URL + "/lab/vqe/runs/0b6f2a9e-bell"))
+			Expect(variables).To(HaveKeyWithValue("name", "0b6f2a9e-bell"))
+			Expect(variables).To(HaveKeyWithValue("project", "vqe"))
+			Expect(variables).To(HaveKeyWithValue("state", "finished"))
+			Expect(variables["summaryMetrics"]).To(ContainSubstring(`"cost_usd":1.6`))
+		})
+
+		It("should report GraphQL errors", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"errors":[{"message":"project not found"}]}`))
+			}))
+			defer server.Close()
+
+			tracker := &WandB{BaseURL: server.URL, APIKey: "secret", Entity: "lab", Project: "vqe", Client: server.Client()}
+			_, err := tracker.LogRun(ctx, RunFromJob(job))
+			Expect(err).To(MatchError(ContainSubstring("project not found")))
+		})
+	})
+
+	Describe("FromURI", func() {
+		It("should select the tracker from the URI scheme", func() {
+			GinkgoT().Setenv("WANDB_API_KEY", "secret")
+			tracker, err := FromURI("wandb://lab/vqe", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tracker).To(BeAssignableToTypeOf(&WandB{}))
+
+			tracker, err = FromURI("http://mlflow.mlops:5000/", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tracker.(*MLflow).Experiment).To(Equal(DefaultExperiment))
+			Expect(tracker.(*MLflow).URI).To(Equal("http://mlflow.mlops:5000"))
+
+			_, err = FromURI("wandb://lab", "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
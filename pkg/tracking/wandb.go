@@ -0,0 +1,161 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultWandBBaseURL is the Weights & Biases API used unless configured
+// otherwise, e.g. for a self-hosted server
+const DefaultWandBBaseURL = "https://api.wandb.ai"
+
+// upsertBucket creates or updates a W&B run ("bucket" in the W&B API)
+const upsertBucket = `mutation UpsertBucket($name: String, $project: String, $entity: String,
+  $displayName: String, $config: JSONString, $summaryMetrics: JSONString, $tags: [String!], $state: String) {
+  upsertBucket(input: {name: $name, modelName: $project, entityName: $entity, displayName: $displayName,
+    config: $config, summaryMetrics: $summaryMetrics, tags: $tags, state: $state}) {
+    bucket { name }
+  }
+}`
+
+// WandB logs runs to a Weights & Biases project through its GraphQL API
+type WandB struct {
+	// BaseURL of the W&B API; DefaultWandBBaseURL if empty
+	BaseURL string
+	APIKey  string
+	Entity  string
+	Project string
+	Client  *http.Client
+}
+
+var _ Tracker = &WandB{}
+
+// wandbValue wraps a config entry the way W&B clients store them
+type wandbValue struct {
+	Value any `json:"value"`
+}
+
+// LogRun upserts the run under the run's ID, so logging a job again updates
+// the same W&B run. Parameters and tags become the run config, metrics its
+// summary.
+func (w *WandB) LogRun(ctx context.Context, run Run) (string, error) {
+	config := map[string]wandbValue{}
+	for key, value := range run.Params {
+		config[key] = wandbValue{Value: value}
+	}
+	for key, value := range run.Tags {
+		config[key] = wandbValue{Value: value}
+	}
+	if run.ArtifactURI != "" {
+		config[ResultsURITag] = wandbValue{Value: run.ArtifactURI}
+	}
+	summary := map[string]any{"_runtime": run.End.Sub(run.Start).Seconds()}
+	for key, value := range run.Metrics {
+		summary[key] = value
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	summaryJSON, err := json.Marshal(summary)
+	if err != nil {
+		return "", err
+	}
+	state := "finished"
+	if run.Status == StatusFailed {
+		state = "failed"
+	}
+	tags := run.Labels
+	if tags == nil {
+		tags = []string{}
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"query": upsertBucket,
+		"variables": map[string]any{
+			"name":           run.ID,
+			"project":        w.Project,
+			"entity":         w.Entity,
+			"displayName":    run.Name,
+			"config":         string(configJSON),
+			"summaryMetrics": string(summaryJSON),
+			"tags":           tags,
+			"state":          state,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := w.post(ctx, body); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s/%s/runs/%s", w.appURL(), w.Entity, w.Project, run.ID), nil
+}
+
+func (w *WandB) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.baseURL()+"/graphql", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth("api", w.APIKey)
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("wandb: HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	var result struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("wandb: invalid response: %w", err)
+	}
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("wandb: %s", result.Errors[0].Message)
+	}
+	return nil
+}
+
+func (w *WandB) baseURL() string {
+	if w.BaseURL == "" {
+		return DefaultWandBBaseURL
+	}
+	return strings.TrimSuffix(w.BaseURL, "/")
+}
+
+// appURL is the web UI runs are linked to: api.wandb.ai is served on
+// wandb.ai, self-hosted servers serve both from one address
+func (w *WandB) appURL() string {
+	return strings.Replace(w.baseURL(), "://api.wandb.ai", "://wandb.ai", 1)
+}
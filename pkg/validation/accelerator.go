@@ -0,0 +1,51 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// ValidateAccelerator validates the device a job simulates on. Only the
+// local simulator samples on Aer, and the optimizer loop and the estimator
+// estimate with the statevector primitives instead.
+func ValidateAccelerator(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	accelerator := job.Execution.Accelerator
+	if accelerator == "" || accelerator == "cpu" {
+		return nil
+	}
+	if accelerator != "gpu" {
+		return field.ErrorList{field.NotSupported(path, accelerator, []string{"cpu", "gpu"})}
+	}
+	var allErrs field.ErrorList
+	if job.Backend.Type != "local_simulator" {
+		allErrs = append(allErrs, field.Invalid(path, accelerator,
+			fmt.Sprintf("GPU simulation runs Aer locally and does not apply to %s backends", job.Backend.Type)))
+	}
+	if job.Optimizer != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "GPU simulation cannot be combined with the optimizer loop"))
+	}
+	if defaults.Estimates(job) {
+		allErrs = append(allErrs, field.Forbidden(path, "GPU simulation cannot be combined with the estimator"))
+	}
+	return allErrs
+}
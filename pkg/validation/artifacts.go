@@ -0,0 +1,43 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// transpilingBackendTypes are the backends the operator transpiles circuits
+// for, so there is a transpiled circuit to publish
+var transpilingBackendTypes = []string{"ibm_local_testing"}
+
+// ValidateArtifacts validates the artifacts a job publishes for its backend
+func ValidateArtifacts(spec *quantumv1.ArtifactsSpec, backend *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	if spec == nil || !spec.TranspiledCircuit {
+		return nil
+	}
+	for _, t := range transpilingBackendTypes {
+		if backend.Type == t {
+			return nil
+		}
+	}
+	return field.ErrorList{field.Invalid(path.Child("transpiledCircuit"), spec.TranspiledCircuit,
+		fmt.Sprintf("the operator does not transpile circuits for %s backends", backend.Type))}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation holds QiskitJob spec validation shared by the admission
+// webhook and the controller.
+package validation
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/backend/generichttp"
+	"github.com/quantum-operator/qiskit-operator/pkg/region"
+)
+
+// BackendValidator validates the provider-specific fields of a BackendSpec.
+// Errors must name the offending field under path.
+type BackendValidator func(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList
+
+// backendValidators holds the validator registered for each backend type
+var backendValidators = map[string]BackendValidator{}
+
+// RegisterBackendValidator installs the validator for a backend type,
+// replacing any validator registered before
+func RegisterBackendValidator(backendType string, v BackendValidator) {
+	backendValidators[backendType] = v
+}
+
+// ValidateBackend runs the validator registered for the spec's backend type
+func ValidateBackend(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.HTTP != nil && spec.Type != "generic_http" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("http"), "only valid for generic_http backends"))
+	}
+	if v, ok := backendValidators[spec.Type]; ok {
+		allErrs = append(allErrs, v(spec, path)...)
+	}
+	return allErrs
+}
+
+func init() {
+	RegisterBackendValidator("ibm_quantum", validateIBMBackend)
+	RegisterBackendValidator("ibm_simulator", validateIBMBackend)
+	RegisterBackendValidator("aws_braket", validateBraketBackend)
+	RegisterBackendValidator("local_simulator", validateLocalBackend)
+	RegisterBackendValidator("generic_http", validateHTTPBackend)
+	RegisterBackendValidator("ibm_local_testing", validateLocalTestingBackend)
+}
+
+var (
+	// IBM Cloud CRN of a Qiskit Runtime instance
+	ibmCRNPattern = regexp.MustCompile(`^crn:v1:[a-z-]+:[a-z-]+:quantum-computing:[a-z0-9-]*:a/[0-9a-f]+:[0-9a-f-]+::$`)
+	// IBM Quantum Platform instance in hub/group/project form
+	ibmHGPPattern    = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+	awsRegionPattern = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-[0-9]$`)
+	braketARNPattern = regexp.MustCompile(`^arn:aws:braket:([a-z0-9-]*):([0-9]*):device/.+$`)
+	// IBM backend, or the qiskit-ibm-runtime fake backend modelling one
+	localTestingNamePattern = regexp.MustCompile(`^(ibm|fake)_[a-z0-9_]+$`)
+)
+
+// ibmOnlyDetail explains why IBM fields are rejected on other backends
+const ibmOnlyDetail = "only valid for ibm_quantum and ibm_simulator backends"
+
+func validateIBMBackend(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.Instance != "" && !ibmCRNPattern.MatchString(spec.Instance) && !ibmHGPPattern.MatchString(spec.Instance) {
+		allErrs = append(allErrs, field.Invalid(path.Child("instance"), spec.Instance,
+			"must be an IBM Cloud CRN (crn:v1:bluemix:public:quantum-computing:<region>:a/<account>:<id>::) or hub/group/project"))
+	}
+	if spec.Region != "" {
+		if !slices.Contains(region.IBMRegions, spec.Region) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("region"), spec.Region, region.IBMRegions))
+		} else if ibmCRNPattern.MatchString(spec.Instance) && region.Requested(&quantumv1.BackendSpec{Instance: spec.Instance}) != spec.Region {
+			allErrs = append(allErrs, field.Invalid(path.Child("instance"), spec.Instance,
+				"instance region must match spec.backend.region "+spec.Region))
+		}
+	}
+	if spec.DeviceARN != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("deviceArn"), "only valid for aws_braket backends"))
+	}
+	return allErrs
+}
+
+func validateBraketBackend(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case spec.Region == "":
+		allErrs = append(allErrs, field.Required(path.Child("region"), "aws_braket backends require a region"))
+	case !awsRegionPattern.MatchString(spec.Region):
+		allErrs = append(allErrs, field.Invalid(path.Child("region"), spec.Region, "must be an AWS region such as us-east-1"))
+	}
+
+	switch match := braketARNPattern.FindStringSubmatch(spec.DeviceARN); {
+	case spec.DeviceARN == "":
+		allErrs = append(allErrs, field.Required(path.Child("deviceArn"), "aws_braket backends require a device ARN"))
+	case match == nil:
+		allErrs = append(allErrs, field.Invalid(path.Child("deviceArn"), spec.DeviceARN,
+			"must be a Braket device ARN such as arn:aws:braket:us-east-1::device/qpu/ionq/Aria-1"))
+	case match[1] != "" && spec.Region != "" && match[1] != spec.Region:
+		allErrs = append(allErrs, field.Invalid(path.Child("deviceArn"), spec.DeviceARN,
+			"device region must match spec.backend.region "+spec.Region))
+	}
+
+	allErrs = append(allErrs, forbidIBMFields(spec, path)...)
+	return allErrs
+}
+
+func validateLocalBackend(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	allErrs := forbidIBMFields(spec, path)
+	if spec.Region != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("region"), spec.Type+" backends have no region"))
+	}
+	if spec.DeviceARN != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("deviceArn"), "only valid for aws_braket backends"))
+	}
+	return allErrs
+}
+
+func validateLocalTestingBackend(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	allErrs := validateLocalBackend(spec, path)
+	switch {
+	case spec.Name == "":
+		allErrs = append(allErrs, field.Required(path.Child("name"),
+			"ibm_local_testing backends require the IBM backend to model, such as ibm_brisbane"))
+	case !localTestingNamePattern.MatchString(spec.Name):
+		allErrs = append(allErrs, field.Invalid(path.Child("name"), spec.Name,
+			"must be an IBM backend such as ibm_brisbane or a fake backend such as fake_brisbane"))
+	}
+	return allErrs
+}
+
+func validateHTTPBackend(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	allErrs := forbidIBMFields(spec, path)
+	if spec.Region != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("region"), "generic_http backends have no region"))
+	}
+	if spec.DeviceARN != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("deviceArn"), "only valid for aws_braket backends"))
+	}
+
+	httpSpec, httpPath := spec.HTTP, path.Child("http")
+	if httpSpec == nil {
+		return append(allErrs, field.Required(httpPath, "generic_http backends require endpoints"))
+	}
+	allErrs = append(allErrs, validateHTTPEndpoint(&httpSpec.Submit, httpPath.Child("submit"))...)
+	allErrs = append(allErrs, validateHTTPEndpoint(&httpSpec.Status, httpPath.Child("status"))...)
+	if httpSpec.Result != nil {
+		allErrs = append(allErrs, validateHTTPEndpoint(httpSpec.Result, httpPath.Child("result"))...)
+	}
+	if httpSpec.Cancel != nil {
+		allErrs = append(allErrs, validateHTTPEndpoint(httpSpec.Cancel, httpPath.Child("cancel"))...)
+	}
+	if httpSpec.Lookup != nil {
+		allErrs = append(allErrs, validateHTTPEndpoint(httpSpec.Lookup, httpPath.Child("lookup"))...)
+	}
+	if httpSpec.AuthHeader != "" && httpSpec.Auth != quantumv1.HTTPAuthHeader {
+		allErrs = append(allErrs, field.Forbidden(httpPath.Child("authHeader"), "only valid with Header authentication"))
+	}
+
+	mapping, mappingPath := &httpSpec.Mapping, httpPath.Child("mapping")
+	for name, value := range map[string]string{"jobId": mapping.JobID, "state": mapping.State, "counts": mapping.Counts} {
+		if value == "" {
+			allErrs = append(allErrs, field.Required(mappingPath.Child(name), "must locate a response field"))
+		}
+	}
+	if len(mapping.CompletedStates) == 0 {
+		allErrs = append(allErrs, field.Required(mappingPath.Child("completedStates"), "must list at least one state"))
+	}
+	return allErrs
+}
+
+// validateHTTPEndpoint checks that an endpoint's URL and body templates parse
+func validateHTTPEndpoint(endpoint *quantumv1.HTTPEndpoint, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if _, err := generichttp.ParseTemplate("url", endpoint.URL); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("url"), endpoint.URL, err.Error()))
+	} else if !strings.HasPrefix(endpoint.URL, "http://") && !strings.HasPrefix(endpoint.URL, "https://") {
+		allErrs = append(allErrs, field.Invalid(path.Child("url"), endpoint.URL, "must be an http:// or https:// URL"))
+	}
+	if _, err := generichttp.ParseTemplate("body", endpoint.Body); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("body"), endpoint.Body, err.Error()))
+	}
+	return allErrs
+}
+
+// forbidIBMFields rejects IBM-specific fields on non-IBM backends
+func forbidIBMFields(spec *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.Instance != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("instance"), ibmOnlyDetail))
+	}
+	if spec.Hub != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("hub"), ibmOnlyDetail))
+	}
+	if spec.Group != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("group"), ibmOnlyDetail))
+	}
+	if spec.Project != "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("project"), ibmOnlyDetail))
+	}
+	return allErrs
+}
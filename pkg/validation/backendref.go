@@ -0,0 +1,41 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ValidateBackendRef validates the reference to registered QuantumBackends,
+// so a selector that does not parse fails the job instead of retrying the
+// lookup forever
+func ValidateBackendRef(ref *quantumv1.QuantumBackendRef, path *field.Path) field.ErrorList {
+	if ref == nil {
+		return nil
+	}
+	if (ref.Name == "") == (ref.Selector == nil) {
+		return field.ErrorList{field.Invalid(path, ref, "exactly one of name and selector is required")}
+	}
+	if ref.Selector == nil {
+		return nil
+	}
+	return metav1validation.ValidateLabelSelector(ref.Selector, metav1validation.LabelSelectorValidationOptions{},
+		path.Child("selector"))
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// costPattern matches costs in the "$10.00" form used throughout the API
+var costPattern = regexp.MustCompile(`^\$?[0-9]+(\.[0-9]+)?$`)
+
+// ValidateBudget validates the job's cost constraints
+func ValidateBudget(spec *quantumv1.BudgetSpec, path *field.Path) field.ErrorList {
+	if spec == nil || spec.MaxCost == "" {
+		return nil
+	}
+	if !costPattern.MatchString(spec.MaxCost) {
+		return field.ErrorList{field.Invalid(path.Child("maxCost"), spec.MaxCost, `must be an amount in dollars, e.g. "$10.00"`)}
+	}
+	return nil
+}
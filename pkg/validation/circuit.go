@@ -0,0 +1,238 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/offload"
+	"github.com/quantum-operator/qiskit-operator/pkg/qasm"
+)
+
+var (
+	// Python module of an entrypoint, e.g. "vqe.main"
+	modulePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	// Function called by an entrypoint
+	functionPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// File within a project; the characters are safe to pass to the executor
+	projectFilePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_./-]*$`)
+	// Git branch; a leading dash would be read as an option
+	branchPattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_./-]*$`)
+	// Hex-encoded SHA-256 digest
+	sha256Pattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+)
+
+// ValidateCircuit validates the source-specific fields of a CircuitSpec
+func ValidateCircuit(spec *quantumv1.CircuitSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch spec.Source {
+	case "git", "bundle":
+		if spec.Entrypoint != "" {
+			allErrs = append(allErrs, validateEntrypoint(spec.Entrypoint, path.Child("entrypoint"))...)
+		} else if spec.Source == "bundle" {
+			allErrs = append(allErrs, field.Required(path.Child("entrypoint"), "module or file that runs the bundle"))
+		}
+	default:
+		if spec.Entrypoint != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("entrypoint"), "only valid for git and bundle sources"))
+		}
+		if len(spec.Args) > 0 {
+			allErrs = append(allErrs, field.Forbidden(path.Child("args"), "only valid for git and bundle sources"))
+		}
+	}
+
+	switch {
+	case spec.Format == "" || spec.Format == "python":
+	case spec.Source == "git" || spec.Source == "bundle":
+		allErrs = append(allErrs, field.Forbidden(path.Child("format"), "OpenQASM is only valid for inline, configmap and url sources"))
+	case spec.Source == "inline" && spec.Code != "":
+		// Programs of other sources are checked once the operator reads them
+		version := 2
+		if spec.Format == "qasm3" {
+			version = 3
+		}
+		if _, err := qasm.Parse(spec.Code, version); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("code"), "", err.Error()))
+		}
+	}
+
+	switch {
+	case spec.Source == "bundle" && spec.Bundle == nil:
+		allErrs = append(allErrs, field.Required(path.Child("bundle"), "required for the bundle source"))
+	case spec.Source == "bundle":
+		allErrs = append(allErrs, validateBundle(spec.Bundle, path.Child("bundle"))...)
+	case spec.Bundle != nil:
+		allErrs = append(allErrs, field.Forbidden(path.Child("bundle"), "only valid for the bundle source"))
+	}
+
+	switch {
+	case spec.Source == "configmap" && spec.ConfigMapRef == nil:
+		allErrs = append(allErrs, field.Required(path.Child("configMapRef"), "required for the configmap source"))
+	case spec.Source == "configmap":
+		if spec.ConfigMapRef.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("configMapRef", "name"), ""))
+		}
+		if spec.ConfigMapRef.Key == "" {
+			allErrs = append(allErrs, field.Required(path.Child("configMapRef", "key"), ""))
+		}
+	case spec.ConfigMapRef != nil:
+		allErrs = append(allErrs, field.Forbidden(path.Child("configMapRef"), "only valid for the configmap source"))
+	}
+
+	switch {
+	case spec.Source == "url" && spec.URL == "":
+		allErrs = append(allErrs, field.Required(path.Child("url"), "required for the url source"))
+	case spec.Source == "url":
+		if !isHTTPURL(spec.URL) {
+			allErrs = append(allErrs, field.Invalid(path.Child("url"), spec.URL, "must be an http or https URL"))
+		}
+	case spec.URL != "":
+		allErrs = append(allErrs, field.Forbidden(path.Child("url"), "only valid for the url source"))
+	}
+	switch {
+	case spec.SHA256 == "" && spec.Source == "url":
+		allErrs = append(allErrs, field.Required(path.Child("sha256"), "required for the url source"))
+	case spec.SHA256 != "" && spec.Source != "url":
+		allErrs = append(allErrs, field.Forbidden(path.Child("sha256"), "only valid for the url source"))
+	case spec.SHA256 != "" && !sha256Pattern.MatchString(spec.SHA256):
+		allErrs = append(allErrs, field.Invalid(path.Child("sha256"), spec.SHA256, "must be a hex-encoded SHA-256 digest"))
+	}
+
+	switch {
+	case spec.Source == "git" && spec.GitRef == nil:
+		allErrs = append(allErrs, field.Required(path.Child("gitRef"), "required for the git source"))
+	case spec.Source == "git":
+		allErrs = append(allErrs, validateGitRef(spec.GitRef, spec.Entrypoint == "", path.Child("gitRef"))...)
+	case spec.GitRef != nil:
+		allErrs = append(allErrs, field.Forbidden(path.Child("gitRef"), "only valid for the git source"))
+	}
+	return allErrs
+}
+
+// ValidateInlineCodeSize limits the inline code of a job, which it carries
+// in its spec. Jobs that opt into offloading may carry more, which the
+// operator moves into a ConfigMap.
+func ValidateInlineCodeSize(job *quantumv1.QiskitJob, path *field.Path) field.ErrorList {
+	size := len(job.Spec.Circuit.Code)
+	switch {
+	case job.Spec.Circuit.Source != "inline" || size <= offload.MaxInlineBytes:
+		return nil
+	case !offload.Requested(job):
+		return field.ErrorList{field.Invalid(path, "", fmt.Sprintf(
+			"%d bytes of inline code exceed the limit of %d; store the code in a ConfigMap and use the configmap "+
+				"source, or annotate the job %s=true to have the operator do so", size, offload.MaxInlineBytes, offload.Annotation))}
+	case size > offload.MaxOffloadedBytes:
+		return field.ErrorList{field.Invalid(path, "", fmt.Sprintf(
+			"%d bytes of inline code exceed the limit of %d for offloaded code", size, offload.MaxOffloadedBytes))}
+	}
+	return nil
+}
+
+// validateEntrypoint accepts a module or .py file, optionally followed by
+// ":function"
+func validateEntrypoint(entrypoint string, path *field.Path) field.ErrorList {
+	target, function, hasFunction := strings.Cut(entrypoint, ":")
+	switch {
+	case strings.HasSuffix(target, ".py"):
+		if !isProjectFile(target) {
+			return field.ErrorList{field.Invalid(path, entrypoint, "file must be a relative path within the project")}
+		}
+	case !modulePattern.MatchString(target):
+		return field.ErrorList{field.Invalid(path, entrypoint,
+			"must be a Python module (e.g. vqe.main) or a .py file, optionally followed by :function")}
+	}
+	if hasFunction && !functionPattern.MatchString(function) {
+		return field.ErrorList{field.Invalid(path, entrypoint, "function must be a Python identifier")}
+	}
+	return nil
+}
+
+func validateBundle(bundle *quantumv1.BundleSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	sources := 0
+	if bundle.ConfigMapRef != nil {
+		sources++
+	}
+	if bundle.Image != "" {
+		sources++
+	}
+	if bundle.URL != "" {
+		sources++
+		if u, err := url.Parse(bundle.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("url"), bundle.URL, "must be an http or https URL"))
+		}
+	}
+	if sources != 1 {
+		allErrs = append(allErrs, field.Invalid(path, "", "exactly one of configMapRef, image and url must be set"))
+	}
+	if bundle.Path != "" && bundle.Image == "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("path"), "only valid for image bundles"))
+	}
+	if bundle.Path != "" && !isProjectFile(bundle.Path) {
+		allErrs = append(allErrs, field.Invalid(path.Child("path"), bundle.Path, "must be a relative path within the image"))
+	}
+	if bundle.Requirements != "" && !isProjectFile(bundle.Requirements) {
+		allErrs = append(allErrs, field.Invalid(path.Child("requirements"), bundle.Requirements,
+			"must be a relative path within the bundle"))
+	}
+	return allErrs
+}
+
+// validateGitRef accepts HTTP(S) repositories, whose credentials are a
+// token, and a file within the repository, which must be a Python file when
+// it is what runs
+func validateGitRef(ref *quantumv1.GitRef, runsPath bool, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if u, err := url.Parse(ref.Repository); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(path.Child("repository"), ref.Repository, "must be an http or https URL"))
+	} else if u.User != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("repository"), ref.Repository,
+			"must not contain credentials; put a git-token in the credentials Secret instead"))
+	}
+	if ref.Branch != "" && (!branchPattern.MatchString(ref.Branch) || strings.Contains(ref.Branch, "..")) {
+		allErrs = append(allErrs, field.Invalid(path.Child("branch"), ref.Branch, "must be a branch name"))
+	}
+	switch {
+	case !isProjectFile(ref.Path):
+		allErrs = append(allErrs, field.Invalid(path.Child("path"), ref.Path, "must be a relative path within the repository"))
+	case runsPath && !strings.HasSuffix(ref.Path, ".py"):
+		allErrs = append(allErrs, field.Invalid(path.Child("path"), ref.Path, "must be a .py file unless an entrypoint is set"))
+	}
+	return allErrs
+}
+
+// isHTTPURL reports whether raw is an absolute http or https URL
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+// isProjectFile reports whether name is a clean relative path that stays
+// within the project
+func isProjectFile(name string) bool {
+	return projectFilePattern.MatchString(name) && path.Clean(name) == name
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ValidateCredentials validates the job's credentials against its backend.
+// A Vault path replaces the credentials Secrets. Client certificates are only presented by generic_http backends; jobs
+// whose backend is still to be resolved from a QuantumBackend are not
+// checked.
+func ValidateCredentials(creds *quantumv1.CredentialsSpec, backend *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	if creds == nil {
+		return nil
+	}
+	errs := validateVaultPath(creds, path.Child("vaultPath"))
+	if creds.ClientCertificate == nil {
+		return errs
+	}
+	certPath := path.Child("clientCertificate")
+	if backend.Type != "" && backend.Type != "generic_http" {
+		errs = append(errs, field.Forbidden(certPath, "only valid for generic_http backends"))
+	}
+	if creds.ClientCertificate.SecretRef.Name == "" {
+		errs = append(errs, field.Required(certPath.Child("secretRef", "name"), ""))
+	}
+	return errs
+}
+
+// validateVaultPath checks that a Vault path is relative, stays below where
+// it starts and is not combined with credentials Secrets
+func validateVaultPath(creds *quantumv1.CredentialsSpec, path *field.Path) field.ErrorList {
+	if creds.VaultPath == "" {
+		return nil
+	}
+	var errs field.ErrorList
+	if creds.SecretRef != nil || len(creds.RegionalSecretRefs) > 0 {
+		errs = append(errs, field.Forbidden(path, "cannot be combined with secretRef or regionalSecretRefs"))
+	}
+	for _, segment := range strings.Split(creds.VaultPath, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return append(errs, field.Invalid(path, creds.VaultPath,
+				"must be a relative path without empty, . or .. segments, e.g. secret/data/quantum/ibm"))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// reservedEnv are the executor environment variables the operator sets
+var reservedEnv = map[string]bool{
+	"AER_DEVICE":             true,
+	"BACKEND_NAME":           true,
+	"ENTRYPOINT":             true,
+	"ENTRYPOINT_ARGS":        true,
+	"ERROR_MITIGATION":       true,
+	"EXECUTOR_SCRIPT":        true,
+	"HANG_DUMP":              true,
+	"HEARTBEAT_INTERVAL":     true,
+	"HOME":                   true,
+	"INPUTS":                 true,
+	"INPUTS_DIR":             true,
+	"JOB_TAGS":               true,
+	"OPTIMIZATION_LEVEL":     true,
+	"OUTPUT_COMPRESSION":     true,
+	"OUTPUT_DIR":             true,
+	"OUTPUT_DOCUMENT":        true,
+	"OUTPUT_FORMAT":          true,
+	"PROJECT_DIR":            true,
+	"QISKIT_CREDENTIALS_DIR": true,
+	"QISKIT_VERSION":         true,
+	"SCRATCH_DIR":            true,
+	"SESSION_METADATA":       true,
+	"SHOTS":                  true,
+	"SIMULATOR_SEED":         true,
+	"TMPDIR":                 true,
+	"TOMOGRAPHY_BASIS":       true,
+	"TOMOGRAPHY_QUBITS":      true,
+}
+
+// reservedEnvPrefixes are reserved as a whole. PIP_ variables would let a job
+// install from another index than the operator's and bypass its allow-list.
+var reservedEnvPrefixes = []string{"BUNDLE_", "ESTIMATOR_", "OPTIMIZER_", "PIP_", "QISKIT_OPERATOR_", "SWEEP_", "TRANSPILER_"}
+
+// ReservedEnv reports whether the operator owns the environment variable name
+func ReservedEnv(name string) bool {
+	if reservedEnv[name] {
+		return true
+	}
+	for _, prefix := range reservedEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateEnv validates the environment variables a job passes to its executor
+func ValidateEnv(execution *quantumv1.ExecutionSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, env := range execution.Env {
+		if ReservedEnv(env.Name) {
+			errs = append(errs, field.Forbidden(path.Child("env").Index(i).Child("name"),
+				env.Name+" is reserved by the operator"))
+		}
+	}
+	for i, source := range execution.EnvFrom {
+		if source.Prefix != "" && ReservedEnv(source.Prefix) {
+			errs = append(errs, field.Forbidden(path.Child("envFrom").Index(i).Child("prefix"),
+				source.Prefix+" is reserved by the operator"))
+		}
+	}
+	return errs
+}
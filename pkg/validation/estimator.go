@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// Pauli operator on each qubit of a term
+var pauliPattern = regexp.MustCompile(`^[IXYZ]+$`)
+
+// ValidatePrimitive validates the Qiskit primitive a job drives and, for the
+// estimator, its settings and observables. The estimator runs on the
+// backends the optimizer loop runs on, in place of sampling, so it cannot be
+// combined with anything that samples or compares counts. Errors are
+// reported under path, the job's spec.
+func ValidatePrimitive(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	primitivePath := path.Child("execution", "primitive")
+	observablesPath := path.Child("execution", "observables")
+
+	switch job.Execution.Primitive {
+	case "", defaults.PrimitiveSampler, defaults.PrimitiveEstimator:
+	default:
+		return field.ErrorList{field.NotSupported(primitivePath, job.Execution.Primitive,
+			[]string{defaults.PrimitiveSampler, defaults.PrimitiveEstimator})}
+	}
+	if !defaults.Estimates(job) {
+		if job.Estimator != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("estimator"), "only valid for the estimator primitive"))
+		}
+		if len(job.Execution.Observables) > 0 {
+			allErrs = append(allErrs, field.Forbidden(observablesPath, "only valid for the estimator primitive"))
+		}
+		return allErrs
+	}
+
+	// Errors about the estimator as a whole go where it was asked for
+	estimatorPath := primitivePath
+	if job.Estimator != nil {
+		estimatorPath = path.Child("estimator")
+	}
+	if !slices.Contains(optimizingBackendTypes, job.Backend.Type) {
+		allErrs = append(allErrs, field.Invalid(estimatorPath, job.Backend.Type,
+			fmt.Sprintf("the estimator does not run on %s backends", job.Backend.Type)))
+	}
+	if job.Optimizer != nil {
+		allErrs = append(allErrs, field.Forbidden(estimatorPath, "the estimator cannot be combined with the optimizer loop"))
+	}
+	if job.Sweep != nil {
+		allErrs = append(allErrs, field.Forbidden(estimatorPath, "the estimator cannot be combined with a parameter sweep"))
+	}
+	if job.Tomography != nil {
+		allErrs = append(allErrs, field.Forbidden(estimatorPath, "the estimator cannot be combined with state tomography"))
+	}
+	if job.Shadow != nil {
+		allErrs = append(allErrs, field.Forbidden(estimatorPath, "the estimator cannot be combined with a shadow run"))
+	}
+	if job.Verify != nil {
+		allErrs = append(allErrs, field.Forbidden(estimatorPath, "the estimator cannot be combined with verify mode"))
+	}
+	if job.Estimator != nil && (job.Estimator.MaxObservablesPerCall < 0 || job.Estimator.MaxObservablesPerCall > 10000) {
+		allErrs = append(allErrs, field.Invalid(path.Child("estimator", "maxObservablesPerCall"),
+			job.Estimator.MaxObservablesPerCall, "must be between 1 and 10000"))
+	}
+	return append(allErrs, validateObservables(job.Execution.Observables, observablesPath)...)
+}
+
+// validateObservables checks that the observables are uniquely named sums
+// of Pauli strings over the same number of qubits
+func validateObservables(observables []quantumv1.Observable, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(observables) > 1000 {
+		return field.ErrorList{field.TooMany(path, len(observables), 1000)}
+	}
+	names := map[string]bool{}
+	qubits := 0
+	for i, observable := range observables {
+		observablePath := path.Index(i)
+		if observable.Name != "" {
+			if names[observable.Name] {
+				allErrs = append(allErrs, field.Duplicate(observablePath.Child("name"), observable.Name))
+			}
+			names[observable.Name] = true
+		}
+		switch {
+		case len(observable.Terms) == 0:
+			allErrs = append(allErrs, field.Required(observablePath.Child("terms"), "at least one Pauli string"))
+		case len(observable.Terms) > 1000:
+			allErrs = append(allErrs, field.TooMany(observablePath.Child("terms"), len(observable.Terms), 1000))
+		}
+		for j, term := range observable.Terms {
+			termPath := observablePath.Child("terms").Index(j).Child("pauli")
+			switch {
+			case !pauliPattern.MatchString(term.Pauli):
+				allErrs = append(allErrs, field.Invalid(termPath, term.Pauli, "must be a string of I, X, Y and Z"))
+			case qubits == 0:
+				qubits = len(term.Pauli)
+			case len(term.Pauli) != qubits:
+				allErrs = append(allErrs, field.Invalid(termPath, term.Pauli,
+					fmt.Sprintf("must act on %d qubits like the other Pauli strings", qubits)))
+			}
+		}
+	}
+	return allErrs
+}
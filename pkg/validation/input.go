@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// inputNamePattern matches the names of data inputs, which name a file or
+// directory of the executor's workspace
+var inputNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9_.]*[a-z0-9])?$`)
+
+// ValidateInputs validates the data inputs of a job: each has a name of its
+// own and exactly one source
+func ValidateInputs(specs []quantumv1.InputSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	names := map[string]bool{}
+	for i := range specs {
+		spec := &specs[i]
+		errs = append(errs, ValidateInput(spec, path.Index(i))...)
+		if names[spec.Name] {
+			errs = append(errs, field.Duplicate(path.Index(i).Child("name"), spec.Name))
+		}
+		names[spec.Name] = true
+	}
+	return errs
+}
+
+// ValidateInput validates a data input. URIs are http, https or
+// s3://<bucket>/<key>, and only s3 URIs take the Secret with their
+// credentials, which they need.
+func ValidateInput(spec *quantumv1.InputSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	switch {
+	case spec.Name == "":
+		errs = append(errs, field.Required(path.Child("name"), ""))
+	case len(spec.Name) > 63 || !inputNamePattern.MatchString(spec.Name):
+		errs = append(errs, field.Invalid(path.Child("name"), spec.Name,
+			"must be at most 63 lowercase alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character"))
+	}
+
+	sources := 0
+	for _, ref := range []struct {
+		ref   *quantumv1.InputObjectRef
+		child string
+	}{{spec.ConfigMapRef, "configMapRef"}, {spec.SecretRef, "secretRef"}} {
+		if ref.ref == nil {
+			continue
+		}
+		sources++
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(ref.ref.Name) {
+			errs = append(errs, field.Invalid(path.Child(ref.child, "name"), ref.ref.Name, msg))
+		}
+		if ref.ref.Key != "" {
+			for _, msg := range utilvalidation.IsConfigMapKey(ref.ref.Key) {
+				errs = append(errs, field.Invalid(path.Child(ref.child, "key"), ref.ref.Key, msg))
+			}
+		}
+	}
+	if claim := spec.PersistentVolumeClaim; claim != nil {
+		sources++
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(claim.ClaimName) {
+			errs = append(errs, field.Invalid(path.Child("persistentVolumeClaim", "claimName"), claim.ClaimName, msg))
+		}
+		if claim.Path != "" && !isProjectFile(claim.Path) {
+			errs = append(errs, field.Invalid(path.Child("persistentVolumeClaim", "path"), claim.Path,
+				"must be a relative path within the claim"))
+		}
+	}
+	s3 := false
+	if spec.URI != "" {
+		sources++
+		u, err := url.Parse(spec.URI)
+		switch {
+		case err == nil && u.Scheme == "s3" && u.Host != "" && strings.TrimPrefix(u.Path, "/") != "" && u.User == nil:
+			s3 = true
+		case err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != "":
+			if u.User != nil {
+				errs = append(errs, field.Invalid(path.Child("uri"), spec.URI, "must not contain credentials"))
+			}
+		default:
+			errs = append(errs, field.Invalid(path.Child("uri"), spec.URI, "must be an http, https or s3://<bucket>/<key> URI"))
+		}
+	}
+	if sources != 1 {
+		errs = append(errs, field.Invalid(path, spec.Name,
+			"exactly one of configMapRef, secretRef, persistentVolumeClaim and uri must be set"))
+	}
+
+	switch {
+	case spec.SecretName != "" && !s3:
+		errs = append(errs, field.Forbidden(path.Child("secretName"), "only valid for s3 URIs"))
+	case spec.SecretName != "":
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(spec.SecretName) {
+			errs = append(errs, field.Invalid(path.Child("secretName"), spec.SecretName, msg))
+		}
+	case s3:
+		errs = append(errs, field.Required(path.Child("secretName"), "Secret with the credentials of the s3 URI"))
+	}
+	if spec.SHA256 != "" && !sha256Pattern.MatchString(spec.SHA256) {
+		errs = append(errs, field.Invalid(path.Child("sha256"), spec.SHA256, "must be a hex-encoded SHA-256 digest"))
+	}
+	return errs
+}
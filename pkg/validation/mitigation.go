@@ -0,0 +1,47 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// dynamicalDecouplingSequences are the sequences IBM Runtime pads idle
+// qubits with
+var dynamicalDecouplingSequences = []string{"XX", "XpXm", "XY4"}
+
+// ValidateErrorMitigation validates the error suppression and mitigation a
+// job requests. Backends that do not support an option leave it out rather
+// than fail the job, so only the values are checked.
+func ValidateErrorMitigation(spec *quantumv1.ErrorMitigationSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	if level := spec.ResilienceLevel; level != nil && (*level < 0 || *level > 2) {
+		errs = append(errs, field.Invalid(path.Child("resilienceLevel"), *level, "must be between 0 and 2"))
+	}
+	if spec.DynamicalDecoupling != "" && !slices.Contains(dynamicalDecouplingSequences, spec.DynamicalDecoupling) {
+		errs = append(errs, field.NotSupported(path.Child("dynamicalDecoupling"), spec.DynamicalDecoupling,
+			dynamicalDecouplingSequences))
+	}
+	return errs
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net/url"
+	"slices"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// notifiedPhases are the phases notifications can be sent for
+var notifiedPhases = []string{"Completed", "Failed", "Cancelled"}
+
+// ValidateNotifications validates the notifications sent when a job
+// finishes. Each needs a name of its own, notifications of the same type
+// therefore explicit ones.
+func ValidateNotifications(specs []quantumv1.NotificationSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	names := map[string]bool{}
+	for i := range specs {
+		spec := &specs[i]
+		errs = append(errs, ValidateNotification(spec, path.Index(i))...)
+		name := spec.Name
+		if name == "" {
+			name = spec.Type
+		}
+		if name == "" {
+			name = "webhook"
+		}
+		if names[name] {
+			errs = append(errs, field.Duplicate(path.Index(i).Child("name"), name))
+		}
+		names[name] = true
+	}
+	return errs
+}
+
+// ValidateNotification validates a notification: it needs an http or https
+// URL unless its Secret holds one, and can only be sent for terminal phases
+func ValidateNotification(spec *quantumv1.NotificationSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	switch {
+	case spec.URL != "":
+		u, err := url.Parse(spec.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, field.Invalid(path.Child("url"), spec.URL, "must be an http or https URL"))
+		}
+	case spec.SecretName == "":
+		errs = append(errs, field.Required(path.Child("url"), "notifications need a URL, or a Secret holding one under url"))
+	}
+	if spec.SecretName != "" {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(spec.SecretName) {
+			errs = append(errs, field.Invalid(path.Child("secretName"), spec.SecretName, msg))
+		}
+	}
+	for i, phase := range spec.Phases {
+		if !slices.Contains(notifiedPhases, phase) {
+			errs = append(errs, field.NotSupported(path.Child("phases").Index(i), phase, notifiedPhases))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// optimizingBackendTypes are the backends whose circuits run in an
+// execution pod, where the optimizer loop runs
+var optimizingBackendTypes = []string{"local_simulator", "ibm_local_testing"}
+
+// ValidateOptimizer validates the optimizer loop of a job for its backend
+func ValidateOptimizer(spec *quantumv1.OptimizerSpec, backend *quantumv1.BackendSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+
+	switch spec.Method {
+	case "", "COBYLA", "SPSA":
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("method"), spec.Method, []string{"COBYLA", "SPSA"}))
+	}
+	if spec.MaxIterations < 0 || spec.MaxIterations > 1000 {
+		allErrs = append(allErrs, field.Invalid(path.Child("maxIterations"), spec.MaxIterations, "must be between 1 and 1000"))
+	}
+	if spec.Tolerance < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("tolerance"), spec.Tolerance, "must not be negative"))
+	}
+
+	supported := false
+	for _, t := range optimizingBackendTypes {
+		if backend.Type == t {
+			supported = true
+		}
+	}
+	if !supported {
+		allErrs = append(allErrs, field.Invalid(path, backend.Type,
+			fmt.Sprintf("the optimizer loop does not run on %s backends", backend.Type)))
+	}
+	return allErrs
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+var (
+	// bucketPattern matches S3 bucket names
+	bucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	// gcsBucketPattern matches Cloud Storage bucket names
+	gcsBucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,61}[a-z0-9]$`)
+	// ociBucketPattern matches OCI Object Storage bucket names
+	ociBucketPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,256}$`)
+	// containerPattern matches Blob Storage container names
+	containerPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9]|-[a-z0-9]){2,62}$`)
+	// retentionPattern matches retention periods in days, as lifecycle rules expire objects
+	retentionPattern = regexp.MustCompile(`^[1-9][0-9]*d$`)
+)
+
+// objectStores describes the locations of outputs to object stores, which
+// take a Secret with credentials
+var objectStores = map[string]struct {
+	pattern     *regexp.Regexp
+	description string
+}{
+	"s3":         {bucketPattern, "an S3 bucket name"},
+	"gcs":        {gcsBucketPattern, "a Cloud Storage bucket name"},
+	"oci":        {ociBucketPattern, "an OCI Object Storage bucket name"},
+	"azure_blob": {containerPattern, "a Blob Storage container name"},
+}
+
+// ValidateOutputs validates the outputs results are stored in. Each needs a
+// name of its own, outputs of the same type therefore explicit ones, and
+// only one may be a pvc, which the executor writes itself.
+func ValidateOutputs(specs []quantumv1.OutputSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	names := map[string]bool{}
+	pvc := false
+	for i := range specs {
+		spec := &specs[i]
+		errs = append(errs, ValidateOutput(spec, path.Index(i))...)
+		name := spec.Name
+		if name == "" {
+			name = spec.Type
+		}
+		if names[name] {
+			errs = append(errs, field.Duplicate(path.Index(i).Child("name"), name))
+		}
+		names[name] = true
+		if spec.Type == "pvc" {
+			if pvc {
+				errs = append(errs, field.Forbidden(path.Index(i).Child("type"), "only one output may be a pvc"))
+			}
+			pvc = true
+		}
+	}
+	return errs
+}
+
+// ValidateOutput validates where results are stored. Outputs to object
+// stores (s3, gcs, oci, azure_blob) need a bucket or container name, a
+// Secret with credentials and a retention in days if any; other outputs
+// take no Secret. Outputs to a pvc need a claim name and a path within it,
+// and cannot be compressed with zstd, which the executor lacks.
+func ValidateOutput(spec *quantumv1.OutputSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	if spec.Type == "pvc" {
+		errs = append(errs, validatePVCOutput(spec, path)...)
+	}
+	store, ok := objectStores[spec.Type]
+	if !ok {
+		if spec.SecretName != "" {
+			errs = append(errs, field.Forbidden(path.Child("secretName"), "only object store outputs take a Secret"))
+		}
+		return errs
+	}
+	if !store.pattern.MatchString(spec.Location) || strings.Contains(spec.Location, "..") {
+		errs = append(errs, field.Invalid(path.Child("location"), spec.Location, "must be "+store.description))
+	}
+	if spec.SecretName == "" {
+		errs = append(errs, field.Required(path.Child("secretName"), spec.Type+" outputs need a Secret with credentials"))
+	}
+	if spec.Retention != "" && !retentionPattern.MatchString(spec.Retention) {
+		errs = append(errs, field.Invalid(path.Child("retention"), spec.Retention, "must be a number of days, e.g. 30d"))
+	}
+	return errs
+}
+
+func validatePVCOutput(spec *quantumv1.OutputSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for _, msg := range utilvalidation.IsDNS1123Subdomain(spec.Location) {
+		errs = append(errs, field.Invalid(path.Child("location"), spec.Location, msg))
+	}
+	if slices.Contains(strings.Split(spec.Path, "/"), "..") {
+		errs = append(errs, field.Invalid(path.Child("path"), spec.Path, "must stay within the claim"))
+	}
+	if spec.Compression == "zstd" {
+		errs = append(errs, field.NotSupported(path.Child("compression"), spec.Compression, []string{"none", "gzip"}))
+	}
+	return errs
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+)
+
+// ValidateResources validates the resources requested for the execution
+// pod: every quantity must parse and be positive, cpu and memory may not be
+// below the executor's minimums, extended resources such as GPUs must be
+// whole numbers, and no limit may be below its request
+func ValidateResources(spec *quantumv1.ResourceRequirements, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	requests, requestErrs := parseResources(spec.Requests, path.Child("requests"))
+	limits, limitErrs := parseResources(spec.Limits, path.Child("limits"))
+	errs = append(errs, requestErrs...)
+	errs = append(errs, limitErrs...)
+	for _, name := range sortedNames(requests) {
+		if limit, ok := limits[name]; ok && limit.Cmp(requests[name]) < 0 {
+			errs = append(errs, field.Invalid(path.Child("limits").Key(name), spec.Limits[name],
+				"must be greater than or equal to the request"))
+		}
+	}
+	return errs
+}
+
+func parseResources(values map[string]string, path *field.Path) (map[string]resource.Quantity, field.ErrorList) {
+	var errs field.ErrorList
+	parsed := map[string]resource.Quantity{}
+	for _, name := range sortedNames(values) {
+		value := values[name]
+		for _, msg := range utilvalidation.IsQualifiedName(name) {
+			errs = append(errs, field.Invalid(path.Key(name), name, msg))
+		}
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			errs = append(errs, field.Invalid(path.Key(name), value, "must be a quantity such as 500m or 4Gi"))
+			continue
+		}
+		if q.Sign() <= 0 {
+			errs = append(errs, field.Invalid(path.Key(name), value, "must be greater than zero"))
+			continue
+		}
+		if minimum, ok := defaults.ExecutorMinimums[name]; ok && q.Cmp(resource.MustParse(minimum)) < 0 {
+			errs = append(errs, field.Invalid(path.Key(name), value, fmt.Sprintf("must be at least %s", minimum)))
+			continue
+		}
+		if strings.Contains(name, "/") && q.MilliValue()%1000 != 0 {
+			errs = append(errs, field.Invalid(path.Key(name), value, "extended resources must be whole numbers"))
+			continue
+		}
+		parsed[name] = q
+	}
+	return parsed, errs
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ValidateRetryPolicy validates the job's retry backoff, whose delays the
+// schema only types as durations
+func ValidateRetryPolicy(spec *quantumv1.RetryPolicy, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	if spec.InitialDelay != nil && spec.InitialDelay.Duration <= 0 {
+		errs = append(errs, field.Invalid(path.Child("initialDelay"), spec.InitialDelay.Duration.String(), "must be positive"))
+	}
+	if spec.MaxDelay != nil && spec.MaxDelay.Duration <= 0 {
+		errs = append(errs, field.Invalid(path.Child("maxDelay"), spec.MaxDelay.Duration.String(), "must be positive"))
+	}
+	if spec.InitialDelay != nil && spec.MaxDelay != nil && spec.InitialDelay.Duration > spec.MaxDelay.Duration {
+		errs = append(errs, field.Invalid(path.Child("maxDelay"), spec.MaxDelay.Duration.String(),
+			"must not be shorter than initialDelay"))
+	}
+	return errs
+}
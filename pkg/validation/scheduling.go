@@ -0,0 +1,98 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ValidateScheduling validates the placement of the execution pod onto
+// nodes, so mistakes fail the job instead of leaving its pod unschedulable
+// or rejected by the API server: node selectors must be valid labels,
+// tolerations well-formed, and class names valid object names
+func ValidateScheduling(spec *quantumv1.SchedulingSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	selectorPath := path.Child("nodeSelector")
+	for _, key := range sortedNames(spec.NodeSelector) {
+		for _, msg := range utilvalidation.IsQualifiedName(key) {
+			errs = append(errs, field.Invalid(selectorPath, key, msg))
+		}
+		for _, msg := range utilvalidation.IsValidLabelValue(spec.NodeSelector[key]) {
+			errs = append(errs, field.Invalid(selectorPath.Key(key), spec.NodeSelector[key], msg))
+		}
+	}
+	for i, toleration := range spec.Tolerations {
+		errs = append(errs, validateToleration(toleration, path.Child("tolerations").Index(i))...)
+	}
+	if spec.RuntimeClassName != nil {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(*spec.RuntimeClassName) {
+			errs = append(errs, field.Invalid(path.Child("runtimeClassName"), *spec.RuntimeClassName, msg))
+		}
+	}
+	if spec.PriorityClassName != "" {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(spec.PriorityClassName) {
+			errs = append(errs, field.Invalid(path.Child("priorityClassName"), spec.PriorityClassName, msg))
+		}
+	}
+	return errs
+}
+
+func validateToleration(toleration corev1.Toleration, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if toleration.Key != "" {
+		for _, msg := range utilvalidation.IsQualifiedName(toleration.Key) {
+			errs = append(errs, field.Invalid(path.Child("key"), toleration.Key, msg))
+		}
+	}
+	switch toleration.Operator {
+	case corev1.TolerationOpEqual, "":
+		if toleration.Key == "" {
+			errs = append(errs, field.Invalid(path.Child("operator"), toleration.Operator,
+				"must be Exists when the key is empty"))
+		}
+		for _, msg := range utilvalidation.IsValidLabelValue(toleration.Value) {
+			errs = append(errs, field.Invalid(path.Child("value"), toleration.Value, msg))
+		}
+	case corev1.TolerationOpExists:
+		if toleration.Value != "" {
+			errs = append(errs, field.Invalid(path.Child("value"), toleration.Value,
+				"must be empty when the operator is Exists"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(path.Child("operator"), toleration.Operator,
+			[]string{string(corev1.TolerationOpEqual), string(corev1.TolerationOpExists)}))
+	}
+	switch toleration.Effect {
+	case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		errs = append(errs, field.NotSupported(path.Child("effect"), toleration.Effect,
+			[]string{string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule),
+				string(corev1.TaintEffectNoExecute)}))
+	}
+	if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+		errs = append(errs, field.Invalid(path.Child("effect"), toleration.Effect,
+			"must be NoExecute when tolerationSeconds is set"))
+	}
+	return errs
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ValidateScratch validates the execution pod's scratch space
+func ValidateScratch(spec *quantumv1.ScratchSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	if spec.Size.Sign() <= 0 {
+		errs = append(errs, field.Invalid(path.Child("size"), spec.Size.String(), "must be greater than zero"))
+	}
+	if spec.StorageClassName != nil && *spec.StorageClassName == "" {
+		errs = append(errs, field.Invalid(path.Child("storageClassName"), "", "must not be empty when set"))
+	}
+	return errs
+}
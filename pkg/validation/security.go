@@ -0,0 +1,53 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// ValidateSecurity validates the security restrictions of a job. An
+// executor cut off from the network cannot clone a repository or download
+// a circuit, bundle or input, so jobs needing to are rejected rather than
+// failing in their pod.
+func ValidateSecurity(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	if job.Security == nil || job.Security.AllowNetworkEgress == nil || *job.Security.AllowNetworkEgress {
+		return nil
+	}
+	egressPath := path.Child("allowNetworkEgress")
+	var allErrs field.ErrorList
+	if job.Circuit.GitRef != nil {
+		allErrs = append(allErrs, field.Forbidden(egressPath, "must be allowed to clone the circuit's git repository"))
+	}
+	if job.Circuit.Source == "url" {
+		allErrs = append(allErrs, field.Forbidden(egressPath, "must be allowed to fetch the circuit's url"))
+	}
+	if job.Circuit.Bundle != nil && job.Circuit.Bundle.URL != "" {
+		allErrs = append(allErrs, field.Forbidden(egressPath, "must be allowed to download the circuit's bundle"))
+	}
+	for i := range job.Inputs {
+		if job.Inputs[i].URI != "" {
+			allErrs = append(allErrs, field.Forbidden(egressPath,
+				fmt.Sprintf("must be allowed to download input %s", job.Inputs[i].Name)))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// shadowBackendTypes are the backends a shadow run may use: they execute in
+// an execution pod and cost nothing
+var shadowBackendTypes = []string{"local_simulator", "ibm_local_testing"}
+
+// ValidateShadow validates the shadow run of a job, if it has one
+func ValidateShadow(spec *quantumv1.ShadowSpec, path *field.Path) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	backendPath := path.Child("backend")
+	for _, t := range shadowBackendTypes {
+		if spec.Backend.Type == t {
+			return ValidateBackend(&spec.Backend, backendPath)
+		}
+	}
+	return field.ErrorList{field.NotSupported(backendPath.Child("type"), spec.Backend.Type, shadowBackendTypes)}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/defaults"
+	"github.com/quantum-operator/qiskit-operator/pkg/split"
+)
+
+// splittingBackendTypes are the backends a share of a split job runs on:
+// those running circuits in an execution pod, and the remote backends whose
+// providers the operator submits the share to
+var splittingBackendTypes = append(slices.Clone(optimizingBackendTypes), "ibm_quantum", "generic_http")
+
+// ValidateSplit validates the shot splitting of a job, if it has one. Every
+// backend runs its share in an execution pod or on its provider, so all of
+// them must be of a type shares run on. Splitting merges sampled counts, so it
+// cannot be combined with the optimizer, the estimator, a parameter sweep,
+// state tomography, a shadow run, verify mode or backend selection, and
+// every backend needs at least one shot.
+func ValidateSplit(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	spec := job.Split
+	if spec == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+
+	if !slices.Contains(splittingBackendTypes, job.Backend.Type) {
+		allErrs = append(allErrs, field.Invalid(path, job.Backend.Type,
+			fmt.Sprintf("shot splitting does not run on %s backends", job.Backend.Type)))
+	}
+	if job.Optimizer != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "shot splitting cannot be combined with the optimizer loop"))
+	}
+	if defaults.Estimates(job) {
+		allErrs = append(allErrs, field.Forbidden(path, "shot splitting only applies to sampling, not to the estimator"))
+	}
+	if job.Sweep != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "shot splitting cannot be combined with a parameter sweep"))
+	}
+	if job.Tomography != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "shot splitting cannot be combined with state tomography"))
+	}
+	if job.Shadow != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "shot splitting cannot be combined with a shadow run"))
+	}
+	if job.Verify != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "shot splitting cannot be combined with verify mode"))
+	}
+	if job.BackendSelection != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "shot splitting names its backends and cannot be combined with backend selection"))
+	}
+	if spec.Weight < 0 || spec.Weight > 100 {
+		allErrs = append(allErrs, field.Invalid(path.Child("weight"), spec.Weight, "must be between 1 and 100"))
+	}
+
+	backendsPath := path.Child("backends")
+	switch {
+	case len(spec.Backends) == 0:
+		allErrs = append(allErrs, field.Required(backendsPath, "shot splitting needs backends to split the shots with"))
+	case len(spec.Backends) >= split.MaxBackends:
+		allErrs = append(allErrs, field.TooMany(backendsPath, len(spec.Backends), split.MaxBackends-1))
+	}
+	for i := range spec.Backends {
+		backend := &spec.Backends[i]
+		backendPath := backendsPath.Index(i).Child("backend")
+		if !slices.Contains(splittingBackendTypes, backend.Backend.Type) {
+			allErrs = append(allErrs, field.Invalid(backendPath.Child("type"), backend.Backend.Type,
+				fmt.Sprintf("shot splitting does not run on %s backends", backend.Backend.Type)))
+		} else {
+			allErrs = append(allErrs, ValidateBackend(&backend.Backend, backendPath)...)
+		}
+		if backend.Weight < 0 || backend.Weight > 100 {
+			allErrs = append(allErrs, field.Invalid(backendsPath.Index(i).Child("weight"), backend.Weight,
+				"must be between 1 and 100"))
+		}
+	}
+
+	shots := job.Execution.Shots
+	if shots == 0 {
+		shots = defaults.Shots
+	}
+	if len(spec.Backends) > 0 && len(spec.Backends) < split.MaxBackends {
+		for _, part := range split.Parts(job, shots) {
+			if part.Shots == 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("backends"), len(spec.Backends),
+					fmt.Sprintf("%d shots are too few to give every backend a share at these weights", shots)))
+				break
+			}
+		}
+	}
+	return allErrs
+}
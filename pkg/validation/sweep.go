@@ -0,0 +1,60 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/sweep"
+)
+
+// ValidateSweep validates the parameter sweep of a job, if it has one. The
+// operator binds the parameters of the circuits it samples itself, on the
+// backends the optimizer loop runs on. Each binding is a single run, so a
+// sweep cannot be combined with the optimizer, a shadow run or verify mode.
+func ValidateSweep(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	spec := job.Sweep
+	if spec == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+
+	if !slices.Contains(optimizingBackendTypes, job.Backend.Type) {
+		allErrs = append(allErrs, field.Invalid(path, job.Backend.Type,
+			fmt.Sprintf("parameter sweeps do not run on %s backends", job.Backend.Type)))
+	}
+	if job.Optimizer != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "a parameter sweep cannot be combined with the optimizer loop"))
+	}
+	if job.Shadow != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "a parameter sweep cannot be combined with a shadow run"))
+	}
+	if job.Verify != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "a parameter sweep cannot be combined with verify mode"))
+	}
+	if spec.Parallelism < 0 || spec.Parallelism > 100 {
+		allErrs = append(allErrs, field.Invalid(path.Child("parallelism"), spec.Parallelism, "must be between 1 and 100"))
+	}
+	if _, err := sweep.Count(spec); err != nil {
+		allErrs = append(allErrs, field.Invalid(path, len(spec.Parameters), err.Error()))
+	}
+	return allErrs
+}
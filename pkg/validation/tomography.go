@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/tomography"
+)
+
+// ValidateTomography validates the state tomography of a job, if it has
+// one. The operator rewrites the measurements of the circuits it samples
+// itself, on the backends the optimizer loop runs on. Each setting is a
+// single run, so tomography cannot be combined with the optimizer, a
+// parameter sweep, a shadow run or verify mode.
+func ValidateTomography(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	spec := job.Tomography
+	if spec == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+
+	if !slices.Contains(optimizingBackendTypes, job.Backend.Type) {
+		allErrs = append(allErrs, field.Invalid(path, job.Backend.Type,
+			fmt.Sprintf("state tomography does not run on %s backends", job.Backend.Type)))
+	}
+	if job.Optimizer != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "state tomography cannot be combined with the optimizer loop"))
+	}
+	if job.Sweep != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "state tomography cannot be combined with a parameter sweep"))
+	}
+	if job.Shadow != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "state tomography cannot be combined with a shadow run"))
+	}
+	if job.Verify != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "state tomography cannot be combined with verify mode"))
+	}
+	if spec.Parallelism < 0 || spec.Parallelism > 100 {
+		allErrs = append(allErrs, field.Invalid(path.Child("parallelism"), spec.Parallelism, "must be between 1 and 100"))
+	}
+
+	qubitsPath := path.Child("qubits")
+	switch {
+	case len(spec.Qubits) == 0:
+		allErrs = append(allErrs, field.Required(qubitsPath, "state tomography needs the qubits to reconstruct"))
+	case len(spec.Qubits) > tomography.MaxQubits:
+		allErrs = append(allErrs, field.TooMany(qubitsPath, len(spec.Qubits), tomography.MaxQubits))
+	}
+	seen := map[int]bool{}
+	for i, qubit := range spec.Qubits {
+		switch {
+		case qubit < 0:
+			allErrs = append(allErrs, field.Invalid(qubitsPath.Index(i), qubit, "must not be negative"))
+		case seen[qubit]:
+			allErrs = append(allErrs, field.Duplicate(qubitsPath.Index(i), qubit))
+		}
+		seen[qubit] = true
+	}
+	return allErrs
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// Name of a gate as Qiskit knows it, e.g. cx or rz
+var gateNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ValidateTranspiler validates the transpiler options of a job. The
+// executor transpiles with them only on the backends the optimizer loop runs
+// on; other backends transpile the circuit themselves.
+func ValidateTranspiler(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	transpiler := job.Execution.Transpiler
+	if transpiler == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if !slices.Contains(optimizingBackendTypes, job.Backend.Type) {
+		allErrs = append(allErrs, field.Invalid(path, job.Backend.Type,
+			fmt.Sprintf("%s backends transpile circuits themselves", job.Backend.Type)))
+	}
+
+	methods := []struct {
+		name      string
+		value     string
+		supported []string
+	}{
+		{"layoutMethod", transpiler.LayoutMethod, []string{"trivial", "dense", "sabre"}},
+		{"routingMethod", transpiler.RoutingMethod, []string{"basic", "lookahead", "stochastic", "sabre", "none"}},
+		{"couplingMap", transpiler.CouplingMap, []string{"backend", "none"}},
+	}
+	for _, method := range methods {
+		if method.value != "" && !slices.Contains(method.supported, method.value) {
+			allErrs = append(allErrs, field.NotSupported(path.Child(method.name), method.value, method.supported))
+		}
+	}
+	if transpiler.Seed != nil && *transpiler.Seed < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("seed"), *transpiler.Seed, "must not be negative"))
+	}
+
+	gatesPath := path.Child("basisGates")
+	if len(transpiler.BasisGates) > 50 {
+		return append(allErrs, field.TooMany(gatesPath, len(transpiler.BasisGates), 50))
+	}
+	seen := map[string]bool{}
+	for i, gate := range transpiler.BasisGates {
+		switch {
+		case !gateNamePattern.MatchString(gate):
+			allErrs = append(allErrs, field.Invalid(gatesPath.Index(i), gate, "must be a gate name such as cx or rz"))
+		case seen[gate]:
+			allErrs = append(allErrs, field.Duplicate(gatesPath.Index(i), gate))
+		}
+		seen[gate] = true
+	}
+	return allErrs
+}
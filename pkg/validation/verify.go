@@ -0,0 +1,57 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/pkg/compat"
+)
+
+// ValidateVerify validates the verify mode of a job, if it is enabled. Only
+// local simulation is seeded, and a job has a single second run, so verify
+// jobs have no shadow run. The optimizer samples unseeded.
+func ValidateVerify(job *quantumv1.QiskitJobSpec, path *field.Path) field.ErrorList {
+	spec := job.Verify
+	if spec == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+
+	if job.Backend.Type != "local_simulator" {
+		allErrs = append(allErrs, field.Invalid(path, job.Backend.Type,
+			fmt.Sprintf("verify mode needs seeded local simulation and does not run on %s backends", job.Backend.Type)))
+	}
+	if job.Shadow != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "verify mode cannot be combined with a shadow run"))
+	}
+	if job.Optimizer != nil {
+		allErrs = append(allErrs, field.Forbidden(path, "verify mode cannot be combined with the optimizer loop"))
+	}
+	if spec.Seed < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("seed"), spec.Seed, "must not be negative"))
+	}
+	if spec.QiskitVersion != "" {
+		if _, err := compat.Resolve(spec.QiskitVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("qiskitVersion"), spec.QiskitVersion, err.Error()))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validationservice is a client of the circuit validation service
+// in validation-service/, which parses circuit code, builds the circuit in a
+// restricted environment and reports its shape without executing it.
+package validationservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultTimeout bounds each request unless the client sets its own
+const defaultTimeout = 10 * time.Second
+
+// maxResponseBytes bounds the size of a response body read into memory
+const maxResponseBytes = 1 << 20
+
+// Request asks the service to validate circuit code
+type Request struct {
+	Code              string `json:"code"`
+	BackendName       string `json:"backend_name,omitempty"`
+	OptimizationLevel int    `json:"optimization_level"`
+	// Format of the code: python, the default, qasm2 or qasm3
+	Format string `json:"format,omitempty"`
+	// Transpiler options the circuit is transpiled with for BackendName
+	Transpiler *TranspilerOptions `json:"transpiler,omitempty"`
+}
+
+// TranspilerOptions tune the transpilation the service predicts, like the
+// options the executor transpiles with
+type TranspilerOptions struct {
+	LayoutMethod  string   `json:"layout_method,omitempty"`
+	RoutingMethod string   `json:"routing_method,omitempty"`
+	Seed          *int64   `json:"seed_transpiler,omitempty"`
+	BasisGates    []string `json:"basis_gates,omitempty"`
+	CouplingMap   string   `json:"coupling_map,omitempty"`
+}
+
+// Transpiled is the shape of the circuit as transpiled for the backend
+type Transpiled struct {
+	Depth          int   `json:"depth"`
+	TwoQubitGates  int   `json:"two_qubit_gates"`
+	PhysicalQubits []int `json:"physical_qubits,omitempty"`
+}
+
+// Response is the service's verdict on a circuit. Invalid circuits carry the
+// reasons in Errors; the shape is only reported for valid ones.
+type Response struct {
+	Valid       bool           `json:"valid"`
+	CircuitHash string         `json:"circuit_hash"`
+	Depth       int            `json:"depth"`
+	Qubits      int            `json:"qubits"`
+	Gates       int            `json:"gates"`
+	GateTypes   map[string]int `json:"gate_types"`
+	// EstimatedExecutionTime is a rough estimate in seconds
+	EstimatedExecutionTime float64 `json:"estimated_execution_time"`
+	// Transpiled is reported when the service could transpile the circuit
+	// for the backend
+	Transpiled *Transpiled `json:"transpiled,omitempty"`
+	Errors     []string    `json:"errors"`
+	Warnings   []string    `json:"warnings"`
+}
+
+// UnavailableError reports that the service could not give a verdict: it
+// was unreachable, timed out or failed itself. Validating again later may
+// succeed.
+type UnavailableError struct {
+	Err error
+}
+
+func (e *UnavailableError) Error() string {
+	return "validation service unavailable: " + e.Err.Error()
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// Client calls the validation service
+type Client struct {
+	// URL of the service, e.g. "http://validation-service:8000"
+	URL    string
+	Client *http.Client
+}
+
+// New returns a client of the service at url; client defaults to one with a
+// 10 second timeout
+func New(url string, client *http.Client) *Client {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{URL: strings.TrimSuffix(url, "/"), Client: client}
+}
+
+// Validate has the service validate the circuit. Errors the service may
+// recover from are UnavailableErrors; other errors mean it rejected the
+// request itself.
+func (c *Client) Validate(ctx context.Context, request Request) (*Response, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL+"/validate", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, &UnavailableError{Err: err}
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, &UnavailableError{Err: err}
+	}
+
+	switch {
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusRequestTimeout:
+		return nil, &UnavailableError{Err: fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))}
+	case resp.StatusCode >= 300:
+		return nil, fmt.Errorf("validation service rejected the request: %s: %s",
+			resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var response Response
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, &UnavailableError{Err: fmt.Errorf("malformed response: %w", err)}
+	}
+	return &response, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validationservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validation service client", func() {
+	ctx := context.Background()
+
+	serve := func(handler http.HandlerFunc) *Client {
+		srv := httptest.NewServer(handler)
+		DeferCleanup(srv.Close)
+		return New(srv.URL+"/", nil)
+	}
+
+	It("should post the circuit and return the verdict", func() {
+		var received Request
+		client := serve(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/validate"))
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			_, _ = w.Write([]byte(`{"valid": true, "circuit_hash": "abc", "depth": 3, "qubits": 2, "gates": 4,
+				"gate_types": {"h": 1, "cx": 1, "measure": 2}, "warnings": ["deep"]}`))
+		})
+
+		response, err := client.Validate(ctx, Request{Code: "qc = QuantumCircuit(2)", BackendName: "ibm_brisbane", OptimizationLevel: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(received).To(Equal(Request{Code: "qc = QuantumCircuit(2)", BackendName: "ibm_brisbane", OptimizationLevel: 1}))
+		Expect(response.Valid).To(BeTrue())
+		Expect(response.Depth).To(Equal(3))
+		Expect(response.GateTypes).To(Equal(map[string]int{"h": 1, "cx": 1, "measure": 2}))
+		Expect(response.Warnings).To(Equal([]string{"deep"}))
+	})
+
+	It("should return the errors of invalid circuits", func() {
+		client := serve(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"valid": false, "circuit_hash": "abc", "errors": ["Python syntax error at line 2: invalid syntax"]}`))
+		})
+
+		response, err := client.Validate(ctx, Request{Code: "qc = ("})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Valid).To(BeFalse())
+		Expect(response.Errors).To(ConsistOf(ContainSubstring("line 2")))
+	})
+
+	It("should report server errors and unreachable services as unavailable", func() {
+		client := serve(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "overloaded", http.StatusServiceUnavailable)
+		})
+		_, err := client.Validate(ctx, Request{Code: "qc"})
+		var unavailable *UnavailableError
+		Expect(err).To(BeAssignableToTypeOf(unavailable))
+		Expect(err).To(MatchError(ContainSubstring("overloaded")))
+
+		srv := httptest.NewServer(http.NotFoundHandler())
+		srv.Close()
+		_, err = New(srv.URL, nil).Validate(ctx, Request{Code: "qc"})
+		Expect(err).To(BeAssignableToTypeOf(unavailable))
+	})
+
+	It("should not retry requests the service rejects", func() {
+		client := serve(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, `{"detail": "optimization_level out of range"}`, http.StatusUnprocessableEntity)
+		})
+		_, err := client.Validate(ctx, Request{Code: "qc", OptimizationLevel: 7})
+		var unavailable *UnavailableError
+		Expect(err).To(HaveOccurred())
+		Expect(err).NotTo(BeAssignableToTypeOf(unavailable))
+		Expect(err).To(MatchError(ContainSubstring("optimization_level")))
+	})
+})
@@ -0,0 +1,238 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package watchdog is a dead-man's switch for the operator's reconcilers. It
+// follows the work queue metrics controller-runtime keeps for every
+// controller, and reports a controller stalled when one reconcile has been
+// running for too long, or when requests wait in its queue while none has
+// been processed for too long. A stalled controller fails the health check,
+// so that the kubelet restarts the pod rather than jobs silently freezing.
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+// DefaultTimeout is how long a controller may make no progress before it is
+// considered stalled unless configured otherwise
+const DefaultTimeout = 15 * time.Minute
+
+// Work queue metrics controller-runtime registers, labelled by controller
+const (
+	depthMetric          = "workqueue_depth"
+	workDurationMetric   = "workqueue_work_duration_seconds"
+	longestRunningMetric = "workqueue_longest_running_processor_seconds"
+)
+
+// queue is what was last observed of a controller's work queue
+type queue struct {
+	// processed is the number of requests processed so far
+	processed uint64
+	// progressed is when the queue was last seen empty or processing
+	progressed time.Time
+}
+
+// Watchdog checks the operator's controllers for stalls. It is safe for
+// concurrent use.
+type Watchdog struct {
+	// Gatherer provides the work queue metrics, usually metrics.Registry of
+	// controller-runtime
+	Gatherer prometheus.Gatherer
+	// Timeout is how long a controller may make no progress
+	Timeout time.Duration
+	// Interval is how often the controllers are checked, a tenth of the
+	// timeout if zero
+	Interval time.Duration
+	// Recorder, if set, records events on Pod as controllers stall and
+	// recover
+	Recorder record.EventRecorder
+	// Pod is the operator's pod, usually given by the POD_NAME and
+	// POD_NAMESPACE environment variables
+	Pod *corev1.ObjectReference
+
+	// now is replaced in tests
+	now func() time.Time
+
+	mu      sync.Mutex
+	queues  map[string]*queue
+	stalled map[string]string
+}
+
+var _ manager.LeaderElectionRunnable = &Watchdog{}
+
+// New returns a watchdog that considers controllers stalled after timeout
+// without progress
+func New(gatherer prometheus.Gatherer, timeout time.Duration) *Watchdog {
+	return &Watchdog{Gatherer: gatherer, Timeout: timeout}
+}
+
+// NeedLeaderElection makes the watchdog run on every replica, as each one
+// reports its own health
+func (w *Watchdog) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable
+func (w *Watchdog) Start(ctx context.Context) error {
+	logger := logf.FromContext(ctx).WithName("watchdog")
+	interval := w.Interval
+	if interval <= 0 {
+		interval = w.Timeout / 10
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := w.Observe(ctx); err != nil {
+			logger.Error(err, "Failed to read the work queue metrics")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Observe reads the work queue metrics and updates which controllers are
+// stalled
+func (w *Watchdog) Observe(ctx context.Context) error {
+	families, err := w.Gatherer.Gather()
+	if err != nil {
+		return err
+	}
+	depths := map[string]float64{}
+	processed := map[string]uint64{}
+	running := map[string]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			controller := label(m, "controller")
+			if controller == "" {
+				controller = label(m, "name")
+			}
+			switch family.GetName() {
+			case depthMetric:
+				// Priority queues report a depth per priority
+				depths[controller] += m.GetGauge().GetValue()
+			case workDurationMetric:
+				processed[controller] = m.GetHistogram().GetSampleCount()
+			case longestRunningMetric:
+				running[controller] = m.GetGauge().GetValue()
+			}
+		}
+	}
+
+	logger := logf.FromContext(ctx).WithName("watchdog")
+	now := w.clock()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.queues == nil {
+		w.queues = map[string]*queue{}
+		w.stalled = map[string]string{}
+	}
+	for controller, depth := range depths {
+		q, ok := w.queues[controller]
+		if !ok || depth == 0 || processed[controller] != q.processed {
+			q = &queue{processed: processed[controller], progressed: now}
+			w.queues[controller] = q
+		}
+
+		reason := ""
+		if longest := time.Duration(running[controller] * float64(time.Second)); longest > w.Timeout {
+			reason = fmt.Sprintf("a reconcile has been running for %s", longest.Round(time.Second))
+		} else if idle := now.Sub(q.progressed); idle > w.Timeout {
+			reason = fmt.Sprintf("%d requests waited %s without any being processed", int(depth), idle.Round(time.Second))
+		}
+
+		_, wasStalled := w.stalled[controller]
+		switch {
+		case reason != "" && !wasStalled:
+			logger.Error(nil, "Controller stalled", "controller", controller, "reason", reason)
+			metrics.ReconcilerStalls.WithLabelValues(controller).Inc()
+			metrics.ReconcilerStalled.WithLabelValues(controller).Set(1)
+			w.event(corev1.EventTypeWarning, "ReconcilerStalled", "Controller %s stalled: %s", controller, reason)
+		case reason == "" && wasStalled:
+			logger.Info("Controller recovered", "controller", controller)
+			metrics.ReconcilerStalled.WithLabelValues(controller).Set(0)
+			w.event(corev1.EventTypeNormal, "ReconcilerRecovered", "Controller %s is processing again", controller)
+		}
+		if reason != "" {
+			w.stalled[controller] = reason
+		} else {
+			delete(w.stalled, controller)
+		}
+	}
+	return nil
+}
+
+// Check fails while any controller is stalled. It is a healthz.Checker, to
+// be added as a liveness check.
+func (w *Watchdog) Check(_ *http.Request) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.stalled) == 0 {
+		return nil
+	}
+	controllers := make([]string, 0, len(w.stalled))
+	for controller := range w.stalled {
+		controllers = append(controllers, controller)
+	}
+	sort.Strings(controllers)
+	stalls := make([]string, 0, len(controllers))
+	for _, controller := range controllers {
+		stalls = append(stalls, fmt.Sprintf("%s: %s", controller, w.stalled[controller]))
+	}
+	return fmt.Errorf("controllers stalled: %s", strings.Join(stalls, "; "))
+}
+
+// event records an event on the operator's pod, if it is known
+func (w *Watchdog) event(eventType, reason, messageFmt string, args ...any) {
+	if w.Recorder == nil || w.Pod == nil {
+		return
+	}
+	w.Recorder.Eventf(w.Pod, eventType, reason, messageFmt, args...)
+}
+
+func (w *Watchdog) clock() time.Time {
+	if w.now != nil {
+		return w.now()
+	}
+	return time.Now()
+}
+
+// label returns the value of the metric's label name
+func label(m *dto.Metric, name string) string {
+	for _, pair := range m.GetLabel() {
+		if pair.GetName() == name {
+			return pair.GetValue()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watchdog
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/quantum-operator/qiskit-operator/pkg/metrics"
+)
+
+var _ = Describe("Watchdog", func() {
+	var (
+		ctx      context.Context
+		now      time.Time
+		depth    *prometheus.GaugeVec
+		work     *prometheus.HistogramVec
+		longest  *prometheus.GaugeVec
+		recorder *record.FakeRecorder
+		w        *Watchdog
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		labels := []string{"name", "controller"}
+		depth = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: depthMetric}, append(labels, "priority"))
+		work = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: workDurationMetric}, labels)
+		longest = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: longestRunningMetric}, labels)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(depth, work, longest)
+
+		recorder = record.NewFakeRecorder(10)
+		w = New(registry, 10*time.Minute)
+		w.Recorder = recorder
+		w.Pod = &corev1.ObjectReference{Kind: "Pod", APIVersion: "v1", Namespace: "qiskit-operator-system", Name: "manager-0"}
+		w.now = func() time.Time { return now }
+
+		depth.WithLabelValues("qiskitjob", "qiskitjob", "").Set(0)
+		work.WithLabelValues("qiskitjob", "qiskitjob")
+		longest.WithLabelValues("qiskitjob", "qiskitjob").Set(0)
+	})
+
+	observeAfter := func(d time.Duration) {
+		now = now.Add(d)
+		Expect(w.Observe(ctx)).To(Succeed())
+	}
+
+	It("should stay healthy while requests are processed", func() {
+		observeAfter(0)
+		depth.WithLabelValues("qiskitjob", "qiskitjob", "").Set(40)
+		for range 5 {
+			work.WithLabelValues("qiskitjob", "qiskitjob").Observe(0.5)
+			observeAfter(5 * time.Minute)
+		}
+		Expect(w.Check(nil)).To(Succeed())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should stay healthy while the queue is empty", func() {
+		observeAfter(0)
+		observeAfter(time.Hour)
+		Expect(w.Check(nil)).To(Succeed())
+	})
+
+	It("should report a controller whose queue is not processed, until it recovers", func() {
+		observeAfter(0)
+		depth.WithLabelValues("qiskitjob", "qiskitjob", "").Set(3)
+		observeAfter(time.Minute)
+		observeAfter(5 * time.Minute)
+		Expect(w.Check(nil)).To(Succeed())
+
+		observeAfter(6 * time.Minute)
+		Expect(w.Check(nil)).To(MatchError(ContainSubstring("qiskitjob: 3 requests waited 12m0s without any being processed")))
+		Expect(testutil.ToFloat64(metrics.ReconcilerStalled.WithLabelValues("qiskitjob"))).To(Equal(1.0))
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning ReconcilerStalled Controller qiskitjob stalled")))
+
+		By("reporting the stall once")
+		observeAfter(time.Minute)
+		Expect(recorder.Events).NotTo(Receive())
+
+		By("recovering once requests are processed again")
+		work.WithLabelValues("qiskitjob", "qiskitjob").Observe(0.5)
+		observeAfter(time.Minute)
+		Expect(w.Check(nil)).To(Succeed())
+		Expect(testutil.ToFloat64(metrics.ReconcilerStalled.WithLabelValues("qiskitjob"))).To(BeZero())
+		Expect(recorder.Events).To(Receive(HavePrefix("Normal ReconcilerRecovered")))
+	})
+
+	It("should report a reconcile that runs for too long", func() {
+		observeAfter(0)
+		longest.WithLabelValues("qiskitjob", "qiskitjob").Set((11 * time.Minute).Seconds())
+		observeAfter(time.Second)
+		Expect(w.Check(nil)).To(MatchError(ContainSubstring("qiskitjob: a reconcile has been running for 11m0s")))
+	})
+
+	It("should sum the depth of priority queues", func() {
+		depth.WithLabelValues("qiskitjob", "qiskitjob", "").Set(0)
+		depth.WithLabelValues("qiskitjob", "qiskitjob", "10").Set(2)
+		observeAfter(0)
+		observeAfter(11 * time.Minute)
+		Expect(w.Check(nil)).To(MatchError(ContainSubstring("2 requests waited")))
+	})
+})
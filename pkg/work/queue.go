@@ -0,0 +1,212 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package work hands heavy per-job tasks (result parsing, uploads,
+// transpilation) to worker deployments running separately from the
+// controller. Each task is a coordination.k8s.io Lease in the job's
+// namespace, owned by the job; a worker claims a task by becoming the Lease
+// holder, keeps it by renewing, and completes it by deleting the Lease. A
+// worker that dies stops renewing and its task is claimed by another.
+package work
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+)
+
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
+
+// TaskType identifies the kind of work a task carries
+type TaskType string
+
+// Task types
+const (
+	TaskResultParsing TaskType = "result-parsing"
+	TaskUpload        TaskType = "upload"
+	TaskTranspile     TaskType = "transpile"
+)
+
+// Labels identifying task Leases
+const (
+	TaskTypeLabel = "quantum.io/task-type"
+	JobLabel      = "quantum.io/job"
+)
+
+// DefaultLeaseDuration is how long a claim lasts without renewal
+const DefaultLeaseDuration = 30 * time.Second
+
+// Task is a unit of work backed by a Lease
+type Task struct {
+	Type  TaskType
+	Job   types.NamespacedName
+	Lease *coordinationv1.Lease
+}
+
+// Queue enqueues and claims tasks. The controller only enqueues; workers
+// claim, renew and complete.
+type Queue struct {
+	client        client.Client
+	scheme        *runtime.Scheme
+	identity      string
+	leaseDuration time.Duration
+	now           func() time.Time
+}
+
+// NewQueue returns a queue acting as identity, typically the worker pod name
+func NewQueue(c client.Client, scheme *runtime.Scheme, identity string, leaseDuration time.Duration) *Queue {
+	if leaseDuration <= 0 {
+		leaseDuration = DefaultLeaseDuration
+	}
+	return &Queue{
+		client:        c,
+		scheme:        scheme,
+		identity:      identity,
+		leaseDuration: leaseDuration,
+		now:           time.Now,
+	}
+}
+
+// LeaseName returns the name of the Lease backing a job's task
+func LeaseName(jobName string, taskType TaskType) string {
+	return fmt.Sprintf("%s-%s", jobName, taskType)
+}
+
+// Enqueue creates an unclaimed task for the job. Enqueueing a task that
+// already exists is a no-op, so the reconciler may call it on every pass.
+func (q *Queue) Enqueue(ctx context.Context, taskType TaskType, job *quantumv1.QiskitJob) error {
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LeaseName(job.Name, taskType),
+			Namespace: job.Namespace,
+			Labels: map[string]string{
+				TaskTypeLabel: string(taskType),
+				JobLabel:      job.Name,
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			LeaseDurationSeconds: ptr.To(int32(q.leaseDuration.Seconds())),
+		},
+	}
+	// Tasks are garbage collected with their job
+	if err := controllerutil.SetControllerReference(job, lease, q.scheme); err != nil {
+		return err
+	}
+	if err := q.client.Create(ctx, lease); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// Pending reports whether a job's task is still enqueued or in progress
+func (q *Queue) Pending(ctx context.Context, taskType TaskType, job *quantumv1.QiskitJob) (bool, error) {
+	var lease coordinationv1.Lease
+	err := q.client.Get(ctx, types.NamespacedName{Name: LeaseName(job.Name, taskType), Namespace: job.Namespace}, &lease)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Claim takes the oldest claimable task of the given type in any namespace.
+// It returns nil if there is none. Claims race through optimistic
+// concurrency: a worker that loses the race moves on to the next task.
+func (q *Queue) Claim(ctx context.Context, taskType TaskType) (*Task, error) {
+	var leases coordinationv1.LeaseList
+	if err := q.client.List(ctx, &leases, client.MatchingLabels{TaskTypeLabel: string(taskType)}); err != nil {
+		return nil, err
+	}
+	sort.Slice(leases.Items, func(i, j int) bool {
+		return leases.Items[i].CreationTimestamp.Before(&leases.Items[j].CreationTimestamp)
+	})
+
+	now := q.now()
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+		if !q.claimable(lease, now) {
+			continue
+		}
+
+		if lease.Spec.HolderIdentity != nil && *lease.Spec.HolderIdentity != q.identity {
+			lease.Spec.LeaseTransitions = ptr.To(ptr.Deref(lease.Spec.LeaseTransitions, 0) + 1)
+		}
+		lease.Spec.HolderIdentity = ptr.To(q.identity)
+		lease.Spec.LeaseDurationSeconds = ptr.To(int32(q.leaseDuration.Seconds()))
+		lease.Spec.AcquireTime = &metav1.MicroTime{Time: now}
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: now}
+
+		if err := q.client.Update(ctx, lease); err != nil {
+			if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		return &Task{
+			Type:  taskType,
+			Job:   types.NamespacedName{Name: lease.Labels[JobLabel], Namespace: lease.Namespace},
+			Lease: lease,
+		}, nil
+	}
+	return nil, nil
+}
+
+// claimable reports whether the lease is unheld, expired, or already ours
+func (q *Queue) claimable(lease *coordinationv1.Lease, now time.Time) bool {
+	holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+	if holder == "" || holder == q.identity {
+		return true
+	}
+	if lease.Spec.RenewTime == nil {
+		return true
+	}
+	duration := time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second
+	return now.After(lease.Spec.RenewTime.Add(duration))
+}
+
+// Renew extends the claim on a task. It fails if another worker took the
+// task over, in which case the caller must stop working on it.
+func (q *Queue) Renew(ctx context.Context, task *Task) error {
+	if ptr.Deref(task.Lease.Spec.HolderIdentity, "") != q.identity {
+		return fmt.Errorf("task %s is not held by %s", task.Lease.Name, q.identity)
+	}
+	task.Lease.Spec.RenewTime = &metav1.MicroTime{Time: q.now()}
+	return q.client.Update(ctx, task.Lease)
+}
+
+// Release gives a claimed task back to the queue without completing it
+func (q *Queue) Release(ctx context.Context, task *Task) error {
+	task.Lease.Spec.HolderIdentity = nil
+	task.Lease.Spec.RenewTime = nil
+	return q.client.Update(ctx, task.Lease)
+}
+
+// Complete removes a finished task from the queue
+func (q *Queue) Complete(ctx context.Context, task *Task) error {
+	err := q.client.Delete(ctx, task.Lease, client.Preconditions{UID: &task.Lease.UID})
+	return client.IgnoreNotFound(err)
+}
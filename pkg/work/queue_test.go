@@ -0,0 +1,118 @@
+/*
+Copyright 2025 Quantum Operator Team.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package work
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	quantumv1 "github.com/quantum-operator/qiskit-operator/api/v1"
+	"github.com/quantum-operator/qiskit-operator/api/v1/builder"
+)
+
+var _ = Describe("Work Queue", func() {
+	var (
+		ctx     context.Context
+		c       client.Client
+		job     *quantumv1.QiskitJob
+		manager *Queue
+		workerA *Queue
+		workerB *Queue
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		job = builder.NewBellStateJob("work-test", "default").Build()
+		job.UID = "work-test-uid"
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()
+		manager = NewQueue(c, scheme, "controller", 0)
+		workerA = NewQueue(c, scheme, "worker-a", 10*time.Second)
+		workerB = NewQueue(c, scheme, "worker-b", 10*time.Second)
+	})
+
+	It("Should enqueue a task once and track it until completion", func() {
+		Expect(manager.Enqueue(ctx, TaskResultParsing, job)).To(Succeed())
+		Expect(manager.Enqueue(ctx, TaskResultParsing, job)).To(Succeed())
+
+		pending, err := manager.Pending(ctx, TaskResultParsing, job)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(BeTrue())
+
+		task, err := workerA.Claim(ctx, TaskResultParsing)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(task).NotTo(BeNil())
+		Expect(task.Job.Name).To(Equal(job.Name))
+		Expect(task.Lease.OwnerReferences).To(HaveLen(1))
+
+		Expect(workerA.Complete(ctx, task)).To(Succeed())
+		pending, err = manager.Pending(ctx, TaskResultParsing, job)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(BeFalse())
+	})
+
+	It("Should not hand a held task to another worker", func() {
+		Expect(manager.Enqueue(ctx, TaskUpload, job)).To(Succeed())
+
+		task, err := workerA.Claim(ctx, TaskUpload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(task).NotTo(BeNil())
+
+		other, err := workerB.Claim(ctx, TaskUpload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other).To(BeNil())
+
+		other, err = workerB.Claim(ctx, TaskTranspile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other).To(BeNil())
+	})
+
+	It("Should let another worker take over an expired claim", func() {
+		Expect(manager.Enqueue(ctx, TaskUpload, job)).To(Succeed())
+
+		task, err := workerA.Claim(ctx, TaskUpload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(task).NotTo(BeNil())
+
+		workerB.now = func() time.Time { return time.Now().Add(time.Minute) }
+		taken, err := workerB.Claim(ctx, TaskUpload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(taken).NotTo(BeNil())
+		Expect(ptr.Deref(taken.Lease.Spec.HolderIdentity, "")).To(Equal("worker-b"))
+		Expect(ptr.Deref(taken.Lease.Spec.LeaseTransitions, 0)).To(Equal(int32(1)))
+
+		By("rejecting renewal by the worker that lost the claim")
+		Expect(workerA.Renew(ctx, task)).NotTo(Succeed())
+	})
+
+	It("Should return a released task to the queue", func() {
+		Expect(manager.Enqueue(ctx, TaskTranspile, job)).To(Succeed())
+
+		task, err := workerA.Claim(ctx, TaskTranspile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workerA.Release(ctx, task)).To(Succeed())
+
+		task, err = workerB.Claim(ctx, TaskTranspile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(task).NotTo(BeNil())
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -263,6 +264,65 @@ var _ = Describe("Manager", Ordered, func() {
 			Eventually(verifyMetricsAvailable, 2*time.Minute).Should(Succeed())
 		})
 
+		It("should converge jobs with fault injection enabled", func() {
+			const jobName = "chaos-e2e"
+
+			By("restarting the controller-manager with fault injection")
+			cmd := exec.Command("kubectl", "patch", "deployment", "qiskit-operator-controller-manager",
+				"-n", namespace, "--type=json",
+				"-p", `[{"op":"add","path":"/spec/template/spec/containers/0/args/-","value":"--fault-injection"}]`)
+			_, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to enable fault injection")
+			cmd = exec.Command("kubectl", "rollout", "status", "deployment/qiskit-operator-controller-manager",
+				"-n", namespace, "--timeout=3m")
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Controller-manager did not roll out")
+
+			By("submitting a QiskitJob")
+			cmd = exec.Command("kubectl", "apply", "-n", "default", "-f", "-")
+			cmd.Stdin = strings.NewReader(fmt.Sprintf(`apiVersion: quantum.quantum.io/v1
+kind: QiskitJob
+metadata:
+  name: %s
+spec:
+  backend:
+    type: local_simulator
+  circuit:
+    source: inline
+    code: |
+      from qiskit import QuantumCircuit
+      qc = QuantumCircuit(2)
+      qc.h(0)
+      qc.cx(0, 1)
+      qc.measure_all()
+  execution:
+    shots: 100
+`, jobName))
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create QiskitJob")
+			DeferCleanup(func() {
+				cmd := exec.Command("kubectl", "delete", "qiskitjob", jobName, "-n", "default", "--ignore-not-found")
+				_, _ = utils.Run(cmd)
+			})
+
+			By("waiting for the job to complete despite injected faults")
+			verifyJobCompleted := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "qiskitjob", jobName, "-n", "default",
+					"-o", "jsonpath={.status.phase}")
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(Equal("Completed"), "QiskitJob has not completed")
+			}
+			Eventually(verifyJobCompleted, 10*time.Minute).Should(Succeed())
+
+			By("verifying the job executed exactly once")
+			cmd = exec.Command("kubectl", "get", "pods", "-n", "default",
+				"-l", "quantum.io/job="+jobName, "-o", "name")
+			output, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(utils.GetNonEmptyLines(output)).To(HaveLen(1))
+		})
+
 		// +kubebuilder:scaffold:e2e-webhooks-checks
 
 		// TODO: Customize the e2e test suite with scenarios specific to your project.
@@ -11,7 +11,7 @@ Licensed under the Apache License, Version 2.0.
 
 from fastapi import FastAPI, HTTPException
 from pydantic import BaseModel, Field
-from typing import Dict, List, Optional
+from typing import Dict, List, Literal, Optional
 import ast
 import hashlib
 import logging
@@ -27,11 +27,27 @@ app = FastAPI(
     version="1.0.0"
 )
 
+class TranspilerOptions(BaseModel):
+    """Transpiler options of a job, as the executor transpiles with them"""
+    layout_method: Optional[Literal["trivial", "dense", "sabre"]] = None
+    routing_method: Optional[Literal["basic", "lookahead", "stochastic", "sabre", "none"]] = None
+    seed_transpiler: Optional[int] = Field(None, ge=0)
+    basis_gates: Optional[List[str]] = None
+    coupling_map: Literal["backend", "none"] = "backend"
+
+class TranspiledShape(BaseModel):
+    """Shape of the circuit as transpiled for the backend"""
+    depth: int
+    two_qubit_gates: int
+    physical_qubits: List[int] = []
+
 class CircuitValidationRequest(BaseModel):
     """Request model for circuit validation"""
-    code: str = Field(..., description="Qiskit Python circuit code")
+    code: str = Field(..., description="Qiskit Python circuit code, or an OpenQASM 2 or 3 program")
     backend_name: Optional[str] = Field(None, description="Target backend name")
     optimization_level: int = Field(1, ge=0, le=3, description="Optimization level")
+    format: Literal["python", "qasm2", "qasm3"] = Field("python", description="Language of the code")
+    transpiler: Optional[TranspilerOptions] = Field(None, description="Transpiler options for the backend")
 
 class CircuitValidationResponse(BaseModel):
     """Response model for circuit validation"""
@@ -42,6 +58,7 @@ class CircuitValidationResponse(BaseModel):
     gates: int = 0
     gate_types: Dict[str, int] = {}
     estimated_execution_time: float = 0.0
+    transpiled: Optional[TranspiledShape] = None
     errors: List[str] = []
     warnings: List[str] = []
 
@@ -69,16 +86,117 @@ async def health_check():
         version="1.0.0"
     )
 
+def transpile_for_backend(circuit, req: CircuitValidationRequest, warnings: List[str]) -> Optional[TranspiledShape]:
+    """Transpile the circuit as the executor would for the fake backend modelling the target"""
+    if not req.backend_name:
+        return None
+    try:
+        from qiskit.transpiler.preset_passmanagers import generate_preset_pass_manager
+        from qiskit_ibm_runtime.fake_provider import FakeProviderForBackendV2
+    except ImportError:
+        return None
+    name = req.backend_name
+    if not name.startswith("fake_"):
+        name = "fake_" + name.removeprefix("ibm_")
+    try:
+        backend = FakeProviderForBackendV2().backend(name)
+    except Exception:
+        # No model of the backend to transpile for
+        return None
+
+    options = req.transpiler or TranspilerOptions()
+    kwargs = {"optimization_level": req.optimization_level}
+    for option in ("layout_method", "routing_method", "seed_transpiler", "basis_gates"):
+        value = getattr(options, option)
+        if value is not None:
+            kwargs[option] = value
+    try:
+        if options.coupling_map == "none":
+            kwargs.setdefault("basis_gates", list(backend.operation_names))
+            pass_manager = generate_preset_pass_manager(**kwargs)
+        else:
+            pass_manager = generate_preset_pass_manager(backend=backend, **kwargs)
+        transpiled = pass_manager.run(circuit)
+    except Exception as e:
+        warnings.append(f"Transpilation for {req.backend_name} failed: {type(e).__name__}: {str(e)}")
+        return None
+
+    layout = getattr(transpiled, "layout", None)
+    physical = layout.final_index_layout() if layout is not None else list(range(circuit.num_qubits))
+    two_qubit_gates = sum(1 for instruction in transpiled.data
+                          if len(instruction.qubits) == 2 and instruction.operation.name != "barrier")
+    logger.info(f"✓ Transpiled for {name}: {transpiled.depth()}d, {two_qubit_gates} two-qubit gates")
+    return TranspiledShape(depth=transpiled.depth(), two_qubit_gates=two_qubit_gates, physical_qubits=physical)
+
+def analyze_circuit(circuit, circuit_hash: str, req: CircuitValidationRequest, warnings: List[str]) -> CircuitValidationResponse:
+    """Report the shape of a valid circuit, check it against the backend and
+    predict its shape once transpiled for it"""
+    backend_name = req.backend_name
+    # Layer 3: Circuit Analysis
+    try:
+        depth = circuit.depth()
+        qubits = circuit.num_qubits
+        gates = len(circuit.data)
+        
+        # Count gate types
+        gate_types = {}
+        for instruction in circuit.data:
+            gate_name = instruction.operation.name
+            gate_types[gate_name] = gate_types.get(gate_name, 0) + 1
+        
+        # Estimate execution time (very rough)
+        estimated_time = depth * 0.1 + gates * 0.01
+        
+        logger.info(f"✓ Circuit analysis complete: {qubits}q, {depth}d, {gates}g")
+        
+        # Layer 4: Backend Compatibility Check
+        if backend_name:
+            # TODO: Implement actual backend compatibility checking
+            # For now, just check if qubit count is reasonable
+            if qubits > 127:  # IBM's largest current processor
+                warnings.append(f"Circuit requires {qubits} qubits, which exceeds most backend capabilities")
+        
+        return CircuitValidationResponse(
+            valid=True,
+            circuit_hash=circuit_hash,
+            depth=depth,
+            qubits=qubits,
+            gates=gates,
+            gate_types=gate_types,
+            estimated_execution_time=estimated_time,
+            transpiled=transpile_for_backend(circuit, req, warnings),
+            warnings=warnings
+        )
+        
+    except Exception as e:
+        error_msg = f"Circuit analysis failed: {type(e).__name__}: {str(e)}"
+        logger.error(error_msg)
+        return CircuitValidationResponse(
+            valid=False,
+            circuit_hash=circuit_hash,
+            errors=[error_msg]
+        )
+
+def load_qasm(code: str, fmt: str):
+    """Load an OpenQASM program with Qiskit's loader for its version"""
+    if fmt == "qasm2":
+        from qiskit import qasm2
+        return qasm2.loads(code, custom_instructions=qasm2.LEGACY_CUSTOM_INSTRUCTIONS)
+    from qiskit import qasm3
+    return qasm3.loads(code)
+
 @app.post("/validate", response_model=CircuitValidationResponse)
 async def validate_circuit(req: CircuitValidationRequest):
     """
     Validate a Qiskit quantum circuit
     
-    This endpoint performs multi-layer validation:
+    This endpoint performs multi-layer validation; OpenQASM programs are
+    loaded by Qiskit's qasm2 or qasm3 loader instead of layers 1 and 2:
     1. Python syntax validation
     2. Safe execution in restricted environment
     3. Circuit analysis (depth, gates, qubits)
     4. Backend compatibility check (if backend specified)
+    5. Transpilation for the backend with the job's transpiler options
     """
     errors = []
     warnings = []
@@ -88,6 +206,20 @@ async def validate_circuit(req: CircuitValidationRequest):
     
     logger.info(f"Validating circuit with hash: {circuit_hash[:16]}...")
     
+    # OpenQASM programs are loaded by Qiskit's loaders instead of executed
+    if req.format != "python":
+        try:
+            circuit = load_qasm(req.code, req.format)
+        except Exception as e:
+            error_msg = f"OpenQASM error: {type(e).__name__}: {str(e)}"
+            logger.error(error_msg)
+            return CircuitValidationResponse(
+                valid=False,
+                circuit_hash=circuit_hash,
+                errors=[error_msg]
+            )
+        return analyze_circuit(circuit, circuit_hash, req, warnings)
+
     # Layer 1: Python Syntax Validation
     try:
         ast.parse(req.code)
@@ -208,49 +340,8 @@ async def validate_circuit(req: CircuitValidationRequest):
             errors=[error_msg]
         )
     
-    # Layer 3: Circuit Analysis
-    try:
-        depth = circuit.depth()
-        qubits = circuit.num_qubits
-        gates = len(circuit.data)
-        
-        # Count gate types
-        gate_types = {}
-        for instruction in circuit.data:
-            gate_name = instruction.operation.name
-            gate_types[gate_name] = gate_types.get(gate_name, 0) + 1
-        
-        # Estimate execution time (very rough)
-        estimated_time = depth * 0.1 + gates * 0.01
-        
-        logger.info(f"✓ Circuit analysis complete: {qubits}q, {depth}d, {gates}g")
-        
-        # Layer 4: Backend Compatibility Check
-        if req.backend_name:
-            # TODO: Implement actual backend compatibility checking
-            # For now, just check if qubit count is reasonable
-            if qubits > 127:  # IBM's largest current processor
-                warnings.append(f"Circuit requires {qubits} qubits, which exceeds most backend capabilities")
-        
-        return CircuitValidationResponse(
-            valid=True,
-            circuit_hash=circuit_hash,
-            depth=depth,
-            qubits=qubits,
-            gates=gates,
-            gate_types=gate_types,
-            estimated_execution_time=estimated_time,
-            warnings=warnings
-        )
-        
-    except Exception as e:
-        error_msg = f"Circuit analysis failed: {type(e).__name__}: {str(e)}"
-        logger.error(error_msg)
-        return CircuitValidationResponse(
-            valid=False,
-            circuit_hash=circuit_hash,
-            errors=[error_msg]
-        )
+    # Layers 3 and 4: Circuit Analysis and Backend Compatibility
+    return analyze_circuit(circuit, circuit_hash, req, warnings)
 
 if __name__ == "__main__":
     import uvicorn